@@ -0,0 +1,130 @@
+package recon
+
+import "strings"
+
+// AppLinks holds the page's App Links (al:) meta tags, which describe how to open the page in native apps (see
+// https://developers.facebook.com/docs/applinks/metadata-reference/). Each platform may list several apps, in order
+// of preference.
+type AppLinks struct {
+	IOS              []AppLink `json:"ios,omitempty"`
+	IPhone           []AppLink `json:"iphone,omitempty"`
+	IPad             []AppLink `json:"ipad,omitempty"`
+	Android          []AppLink `json:"android,omitempty"`
+	WindowsPhone     []AppLink `json:"windows_phone,omitempty"`
+	Windows          []AppLink `json:"windows,omitempty"`
+	WindowsUniversal []AppLink `json:"windows_universal,omitempty"`
+
+	// Web is where to send users who don't have any of the apps.
+	Web *WebAppLink `json:"web,omitempty"`
+}
+
+// AppLink is a native app the page can be opened in, and how to open it.
+type AppLink struct {
+	// URL is the URL that opens the page in the app, often with a custom scheme.
+	URL     string `json:"url,omitempty"`
+	AppName string `json:"app_name,omitempty"`
+
+	// AppStoreID is the app's ID in the App Store, for iOS apps.
+	AppStoreID string `json:"app_store_id,omitempty"`
+
+	// Package and Class are the app's package name and activity class, for Android apps.
+	Package string `json:"package,omitempty"`
+	Class   string `json:"class,omitempty"`
+
+	// AppID is the app's ID in the Microsoft Store, for Windows apps.
+	AppID string `json:"app_id,omitempty"`
+}
+
+// WebAppLink is the web fallback of the page's App Links.
+type WebAppLink struct {
+	URL string `json:"url,omitempty"`
+
+	// ShouldFallback is whether users without the apps should be sent to URL, which defaults to the page itself. It's
+	// true unless the page sets al:web:should_fallback to false.
+	ShouldFallback bool `json:"should_fallback"`
+}
+
+// field returns the field for the app link's al: property, e.g. "app_store_id", or nil if it's unknown.
+func (a *AppLink) field(prop string) *string {
+	switch prop {
+	case "url":
+		return &a.URL
+	case "app_name":
+		return &a.AppName
+	case "app_store_id":
+		return &a.AppStoreID
+	case "package":
+		return &a.Package
+	case "class":
+		return &a.Class
+	case "app_id":
+		return &a.AppID
+	}
+
+	return nil
+}
+
+// appLinksExtractor collects the page's al: meta tags into Result.AppLinks.
+type appLinksExtractor struct{}
+
+func (appLinksExtractor) Extract(doc *Document, res *Result) error {
+	var links AppLinks
+	platforms := map[string]*[]AppLink{
+		"ios":               &links.IOS,
+		"iphone":            &links.IPhone,
+		"ipad":              &links.IPad,
+		"android":           &links.Android,
+		"windows_phone":     &links.WindowsPhone,
+		"windows":           &links.Windows,
+		"windows_universal": &links.WindowsUniversal,
+	}
+
+	found := false
+	for _, m := range doc.Meta {
+		name := strings.ToLower(m.Name)
+		if !strings.HasPrefix(name, "al:") {
+			continue
+		}
+
+		parts := strings.SplitN(strings.TrimPrefix(name, "al:"), ":", 2)
+		if len(parts) < 2 || m.Content == "" {
+			continue
+		}
+		platform, prop := parts[0], parts[1]
+
+		if platform == "web" {
+			if links.Web == nil {
+				links.Web = &WebAppLink{ShouldFallback: true}
+			}
+
+			switch prop {
+			case "url":
+				links.Web.URL = m.Content
+			case "should_fallback":
+				links.Web.ShouldFallback = !(strings.EqualFold(m.Content, "false") || m.Content == "0")
+			}
+
+			found = true
+			continue
+		}
+
+		apps, ok := platforms[platform]
+		if !ok || (&AppLink{}).field(prop) == nil {
+			continue
+		}
+
+		// an app's properties are grouped together, so a property that's already set starts the next app
+		if len(*apps) == 0 || *(*apps)[len(*apps)-1].field(prop) != "" {
+			*apps = append(*apps, AppLink{})
+		}
+		*(*apps)[len(*apps)-1].field(prop) = m.Content
+
+		found = true
+	}
+
+	if found {
+		res.AppLinks = &links
+	}
+
+	return nil
+}
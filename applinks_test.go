@@ -0,0 +1,48 @@
+package recon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppLinks(t *testing.T) {
+	srv := newTestServer("text/html", `<html><head>
+		<meta property="al:ios:url" content="example://docs">
+		<meta property="al:ios:app_store_id" content="12345">
+		<meta property="al:ios:app_name" content="Example">
+		<meta property="al:ios:url" content="example-lite://docs">
+		<meta property="al:ios:app_name" content="Example Lite">
+		<meta property="al:android:package" content="com.example">
+		<meta property="al:android:url" content="example://docs">
+		<meta property="al:android:unknown" content="ignored">
+		<meta property="al:web:should_fallback" content="false">
+	</head><body></body></html>`)
+	defer srv.Close()
+
+	res, err := Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, &AppLinks{
+		IOS: []AppLink{
+			{URL: "example://docs", AppStoreID: "12345", AppName: "Example"},
+			{URL: "example-lite://docs", AppName: "Example Lite"},
+		},
+		Android: []AppLink{{URL: "example://docs", Package: "com.example"}},
+		Web:     &WebAppLink{ShouldFallback: false},
+	}, res.AppLinks)
+
+	srv2 := newTestServer("text/html", `<html><head><meta property="al:web:url" content="https://example.com/docs">
+		</head></html>`)
+	defer srv2.Close()
+
+	res, err = Parse(srv2.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, &AppLinks{Web: &WebAppLink{URL: "https://example.com/docs", ShouldFallback: true}}, res.AppLinks)
+
+	srv3 := newTestServer("text/html", `<html><head><meta property="og:title" content="No app links"></head></html>`)
+	defer srv3.Close()
+
+	res, err = Parse(srv3.URL)
+	assert.Nil(t, err)
+	assert.Nil(t, res.AppLinks)
+}
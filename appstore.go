@@ -0,0 +1,113 @@
+package recon
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// AppPlatform is an app store's platform.
+type AppPlatform string
+
+// App platforms.
+const (
+	AppPlatformIOS     AppPlatform = "ios"
+	AppPlatformAndroid AppPlatform = "android"
+)
+
+// AppReference is an app in the App Store or Google Play that the page refers to.
+type AppReference struct {
+	Platform AppPlatform `json:"platform"`
+
+	// ID is the app's ID in its store: its numeric ID in the App Store, or its package name in Google Play.
+	ID string `json:"id"`
+
+	// URL is the app's page in its store.
+	URL string `json:"url"`
+}
+
+// appStoreID matches the ID in an App Store URL, e.g. https://apps.apple.com/us/app/example/id123456789.
+var appStoreID = regexp.MustCompile(`/id(\d+)(?:$|[/?])`)
+
+// appReference returns the app an App Store or Google Play URL is the page of, if it's one.
+func appReference(rawURL string) (AppReference, bool) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return AppReference{}, false
+	}
+
+	switch host := strings.ToLower(u.Hostname()); {
+	case host == "apps.apple.com" || host == "itunes.apple.com":
+		if m := appStoreID.FindStringSubmatch(u.Path); m != nil {
+			return newAppReference(AppPlatformIOS, m[1]), true
+		}
+
+	case host == "play.google.com" && strings.HasPrefix(u.Path, "/store/apps/details"):
+		if id := u.Query().Get("id"); id != "" {
+			return newAppReference(AppPlatformAndroid, id), true
+		}
+	}
+
+	return AppReference{}, false
+}
+
+// newAppReference returns a reference to the app with the given ID, linking to its page in its store.
+func newAppReference(platform AppPlatform, id string) AppReference {
+	ref := AppReference{Platform: platform, ID: id}
+	switch platform {
+	case AppPlatformIOS:
+		ref.URL = "https://apps.apple.com/app/id" + id
+	case AppPlatformAndroid:
+		ref.URL = "https://play.google.com/store/apps/details?id=" + url.QueryEscape(id)
+	}
+
+	return ref
+}
+
+// smartBannerID reads the app-id from a smart app banner tag's content, e.g. "app-id=123456789, app-argument=...".
+func smartBannerID(content string) string {
+	for _, param := range strings.Split(content, ",") {
+		if k, v, ok := strings.Cut(strings.TrimSpace(param), "="); ok && strings.TrimSpace(k) == "app-id" {
+			return strings.TrimSpace(v)
+		}
+	}
+
+	return ""
+}
+
+// appStoreExtractor collects the App Store and Google Play apps the page refers to into Result.Apps: those it links
+// to, those its smart app banner tags (apple-itunes-app and google-play-app) promote, and those its App Links name.
+// It must run after appLinksExtractor.
+type appStoreExtractor struct{}
+
+func (appStoreExtractor) Extract(doc *Document, res *Result) error {
+	seen := map[AppReference]bool{}
+	add := func(ref AppReference) {
+		if ref.ID != "" && !seen[ref] {
+			seen[ref] = true
+			res.Apps = append(res.Apps, ref)
+		}
+	}
+
+	add(newAppReference(AppPlatformIOS, smartBannerID(doc.MetaContent("apple-itunes-app"))))
+	add(newAppReference(AppPlatformAndroid, smartBannerID(doc.MetaContent("google-play-app"))))
+
+	if al := res.AppLinks; al != nil {
+		for _, apps := range [][]AppLink{al.IOS, al.IPhone, al.IPad} {
+			for _, app := range apps {
+				add(newAppReference(AppPlatformIOS, app.AppStoreID))
+			}
+		}
+		for _, app := range al.Android {
+			add(newAppReference(AppPlatformAndroid, app.Package))
+		}
+	}
+
+	for _, l := range doc.links {
+		if ref, ok := appReference(doc.resolve(l.href)); ok {
+			add(ref)
+		}
+	}
+
+	return nil
+}
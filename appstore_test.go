@@ -0,0 +1,51 @@
+package recon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppReference(t *testing.T) {
+	tests := []struct {
+		url  string
+		want AppReference
+		ok   bool
+	}{
+		{"https://apps.apple.com/us/app/example/id123456789", newAppReference(AppPlatformIOS, "123456789"), true},
+		{"https://itunes.apple.com/app/id42?mt=8", newAppReference(AppPlatformIOS, "42"), true},
+		{"https://play.google.com/store/apps/details?id=com.example&hl=en", newAppReference(AppPlatformAndroid, "com.example"), true},
+		{"https://apps.apple.com/us/developer/example/", AppReference{}, false},
+		{"https://play.google.com/store/apps/developer?id=Example", AppReference{}, false},
+		{"https://example.com/id123", AppReference{}, false},
+	}
+
+	for _, test := range tests {
+		ref, ok := appReference(test.url)
+		assert.Equal(t, test.ok, ok, test.url)
+		assert.Equal(t, test.want, ref, test.url)
+	}
+}
+
+func TestApps(t *testing.T) {
+	srv := newTestServer("text/html", `<html><head>
+		<meta name="apple-itunes-app" content="app-id=123456789, app-argument=example://home">
+		<meta property="al:android:package" content="com.example">
+	</head><body>
+		<a href="https://apps.apple.com/us/app/example/id123456789">Download on the App Store</a>
+		<a href="https://play.google.com/store/apps/details?id=com.example.other">Get it on Google Play</a>
+	</body></html>`)
+	defer srv.Close()
+
+	res, err := Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, []AppReference{
+		{Platform: AppPlatformIOS, ID: "123456789", URL: "https://apps.apple.com/app/id123456789"},
+		{Platform: AppPlatformAndroid, ID: "com.example", URL: "https://play.google.com/store/apps/details?id=com.example"},
+		{
+			Platform: AppPlatformAndroid,
+			ID:       "com.example.other",
+			URL:      "https://play.google.com/store/apps/details?id=com.example.other",
+		},
+	}, res.Apps)
+}
@@ -0,0 +1,193 @@
+package recon
+
+import (
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+)
+
+// Audio is an audio file found on a page, such as a podcast episode.
+type Audio struct {
+	URL   string `json:"url"`
+	Type  string `json:"type,omitempty"`
+	Title string `json:"title,omitempty"`
+
+	// Duration is the length of the audio in seconds, if the page declares it.
+	Duration int `json:"duration,omitempty"`
+}
+
+// audioExtensions are the file extensions of audio files linked without a media type.
+var audioExtensions = map[string]bool{
+	".mp3":  true,
+	".m4a":  true,
+	".aac":  true,
+	".ogg":  true,
+	".oga":  true,
+	".opus": true,
+	".wav":  true,
+	".flac": true,
+}
+
+// audioTag records an <audio> or <source> tag inside an <audio> player.
+func audioTag(t html.Token) Audio {
+	return Audio{URL: getAttr(t, "src"), Type: getAttr(t, "type")}
+}
+
+// isAudioLink reports whether a link with the given href and type attribute points at an audio file.
+func isAudioLink(href, mediaType string) bool {
+	if mediaType != "" {
+		return strings.HasPrefix(strings.ToLower(mediaType), "audio/")
+	}
+
+	if i := strings.IndexAny(href, "?#"); i >= 0 {
+		href = href[:i]
+	}
+
+	return audioExtensions[strings.ToLower(path.Ext(href))]
+}
+
+var isoDuration = regexp.MustCompile(`^P(?:(\d+(?:\.\d+)?)D)?(?:T(?:(\d+(?:\.\d+)?)H)?(?:(\d+(?:\.\d+)?)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
+
+// parseDuration parses a duration written as ISO 8601 (e.g. "PT1H2M3S", as used by schema.org), as a clock time
+// (e.g. "1:02:03", as used by podcast feeds) or as a number of seconds.
+func parseDuration(s string) (time.Duration, bool) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	if s == "" {
+		return 0, false
+	}
+
+	if m := isoDuration.FindStringSubmatch(s); m != nil && s != "P" && s != "PT" {
+		var d time.Duration
+		for i, unit := range []time.Duration{24 * time.Hour, time.Hour, time.Minute, time.Second} {
+			if m[i+1] != "" {
+				f, _ := strconv.ParseFloat(m[i+1], 64)
+				d += time.Duration(f * float64(unit))
+			}
+		}
+		return d, true
+	}
+
+	parts := strings.Split(s, ":")
+	if len(parts) > 3 {
+		return 0, false
+	}
+
+	var d time.Duration
+	for _, part := range parts {
+		f, err := strconv.ParseFloat(part, 64)
+		if err != nil || f < 0 {
+			return 0, false
+		}
+		d = d*60 + time.Duration(f*float64(time.Second))
+	}
+
+	return d, true
+}
+
+// durationSeconds returns the duration written in s in whole seconds, or 0 if it can't be parsed.
+func durationSeconds(s string) int {
+	d, _ := parseDuration(s)
+	return int(d.Round(time.Second) / time.Second)
+}
+
+// audioExtractor collects the page's audio from og:audio tags, JSON-LD audio objects, <audio> players and enclosure
+// links into Result.Audio.
+type audioExtractor struct{}
+
+func (audioExtractor) Extract(doc *Document, res *Result) error {
+	var audio []Audio
+	index := map[string]int{}
+
+	// add records a, or fills in the blanks of an earlier entry for the same URL
+	add := func(a Audio) {
+		if a.URL == "" {
+			return
+		}
+		a.URL = doc.resolve(a.URL)
+
+		if i, ok := index[a.URL]; ok {
+			prev := &audio[i]
+			if prev.Type == "" {
+				prev.Type = a.Type
+			}
+			if prev.Title == "" {
+				prev.Title = a.Title
+			}
+			if prev.Duration == 0 {
+				prev.Duration = a.Duration
+			}
+			return
+		}
+
+		index[a.URL] = len(audio)
+		audio = append(audio, a)
+	}
+
+	var current *Audio
+	for _, m := range doc.Meta {
+		switch m.Name {
+		case "og:audio", "og:audio:url":
+			if current != nil {
+				add(*current)
+			}
+			current = &Audio{URL: m.Content}
+
+		case "og:audio:secure_url":
+			if current != nil {
+				current.URL = m.Content
+			}
+
+		case "og:audio:type":
+			if current != nil {
+				current.Type = m.Content
+			}
+		}
+	}
+	if current != nil {
+		add(*current)
+	}
+
+	// music.song pages declare the song's duration separately from its audio
+	if len(audio) > 0 && audio[0].Duration == 0 {
+		audio[0].Duration = durationSeconds(doc.MetaContent("music:duration"))
+	}
+
+	for _, o := range doc.jsonLD("AudioObject", "PodcastEpisode", "Episode", "MusicRecording") {
+		media := []ldObject{o}
+		if !o.is("AudioObject") {
+			media = append(o.objs("associatedMedia"), o.objs("audio")...)
+		}
+
+		for _, m := range media {
+			a := Audio{
+				URL:      m.str("contentUrl"),
+				Type:     m.str("encodingFormat"),
+				Title:    m.str("name"),
+				Duration: durationSeconds(m.str("duration")),
+			}
+			if a.Title == "" {
+				a.Title = o.str("name")
+			}
+			if a.Duration == 0 {
+				a.Duration = durationSeconds(o.str("duration"))
+			}
+			if !strings.Contains(a.Type, "/") {
+				a.Type = ""
+			}
+
+			add(a)
+		}
+	}
+
+	for _, a := range doc.audio {
+		add(a)
+	}
+
+	res.Audio = audio
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package recon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAudio(t *testing.T) {
+	srv := newTestServer("text/html", `<html><head>
+		<meta property="og:audio" content="https://cdn.example.com/episode-12.mp3">
+		<meta property="og:audio:type" content="audio/mpeg">
+		<script type="application/ld+json">{
+			"@context": "https://schema.org",
+			"@type": "PodcastEpisode",
+			"name": "Episode 12",
+			"timeRequired": "PT45M",
+			"associatedMedia": {
+				"@type": "MediaObject",
+				"contentUrl": "https://cdn.example.com/episode-12.mp3",
+				"duration": "PT45M12S"
+			}
+		}</script>
+		<link rel="enclosure" type="audio/ogg" href="/episode-12.ogg">
+	</head><body>
+		<audio controls>
+			<source src="/episode-12.m4a" type="audio/mp4">
+		</audio>
+		<video><source src="/trailer.mp4" type="video/mp4"></video>
+		<a rel="enclosure" href="/transcript.pdf">Transcript</a>
+	</body></html>`)
+	defer srv.Close()
+
+	res, err := Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, []Audio{
+		{URL: "https://cdn.example.com/episode-12.mp3", Type: "audio/mpeg", Title: "Episode 12", Duration: 2712},
+		{URL: srv.URL + "/episode-12.ogg", Type: "audio/ogg"},
+		{URL: srv.URL + "/episode-12.m4a", Type: "audio/mp4"},
+	}, res.Audio)
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := map[string]time.Duration{
+		"PT1H2M3S": time.Hour + 2*time.Minute + 3*time.Second,
+		"P1DT2H":   26 * time.Hour,
+		"PT45M":    45 * time.Minute,
+		"pt1.5s":   1500 * time.Millisecond,
+		"1:02:03":  time.Hour + 2*time.Minute + 3*time.Second,
+		"45:12":    45*time.Minute + 12*time.Second,
+		"2710":     2710 * time.Second,
+	}
+
+	for in, want := range tests {
+		d, ok := parseDuration(in)
+		assert.True(t, ok, in)
+		assert.Equal(t, want, d, in)
+	}
+
+	for _, in := range []string{"", "P", "PT", "soon", "1:2:3:4"} {
+		_, ok := parseDuration(in)
+		assert.False(t, ok, in)
+	}
+}
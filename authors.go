@@ -0,0 +1,116 @@
+package recon
+
+import (
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// Author identifies the author of a page.
+type Author struct {
+	Name string `json:"name,omitempty"`
+	URL  string `json:"url,omitempty"`
+}
+
+// bylineClasses are class names commonly used on elements that contain an article's byline.
+var bylineClasses = []string{"byline", "author", "author-name", "byline-name", "byline__name", "article-author", "post-author"}
+
+var bylinePrefix = regexp.MustCompile(`(?i)^(written\s+)?by\s+`)
+
+// isByline reports whether the element looks like it contains an article's byline. Void elements never do, since
+// they have no text.
+func isByline(t html.Token) bool {
+	if voidElements[t.Data] {
+		return false
+	}
+
+	if getAttr(t, "itemprop") == "author" {
+		return true
+	}
+
+	for _, class := range strings.Fields(getAttr(t, "class")) {
+		for _, b := range bylineClasses {
+			if strings.EqualFold(class, b) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func hasRel(t html.Token, rel string) bool {
+	for _, r := range strings.Fields(getAttr(t, "rel")) {
+		if strings.EqualFold(r, rel) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func cleanByline(s string) string {
+	return bylinePrefix.ReplaceAllString(collapseWhitespace(s), "")
+}
+
+// authorExtractor builds a structured byline from, in order of preference, JSON-LD author data, rel="author"
+// links, elements with common byline class names and finally the author meta tags.
+type authorExtractor struct {
+	parser *Parser
+}
+
+func (e authorExtractor) Extract(doc *Document, res *Result) error {
+	var byline *Author
+
+	for _, o := range doc.jsonLD() {
+		if _, ok := o["author"]; !ok {
+			continue
+		}
+
+		a := Author{Name: o.str("author")}
+		if obj := o.obj("author"); obj != nil {
+			a.URL = obj.str("url")
+		}
+		if a.Name != "" || a.URL != "" {
+			byline = &a
+			break
+		}
+	}
+
+	if byline == nil && e.parser.heuristics {
+		for _, a := range doc.authorLinks {
+			if a.Name != "" {
+				a := a
+				byline = &a
+				break
+			}
+		}
+
+		if byline == nil && doc.byline != "" {
+			byline = &Author{Name: doc.byline}
+			if len(doc.authorLinks) > 0 {
+				byline.URL = doc.authorLinks[0].URL
+			}
+		}
+	}
+
+	if byline == nil && res.Author != "" {
+		if strings.HasPrefix(res.Author, "http://") || strings.HasPrefix(res.Author, "https://") {
+			byline = &Author{URL: res.Author}
+		} else {
+			byline = &Author{Name: res.Author}
+		}
+	}
+
+	if byline != nil && byline.URL != "" {
+		byline.URL = doc.resolve(byline.URL)
+	}
+
+	res.Byline = byline
+	if res.Author == "" && byline != nil {
+		res.Author = byline.Name
+	}
+
+	return nil
+}
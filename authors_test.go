@@ -0,0 +1,80 @@
+package recon
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestByline(t *testing.T) {
+	tests := []struct {
+		name string
+		html string
+		want *Author
+	}{
+		{
+			name: "json-ld",
+			html: `<script type="application/ld+json">{"@type":"Article","author":[{"@type":"Person","name":"Jane Doe","url":"https://example.com/jane"}]}</script>
+				<meta name="author" content="Someone Else">`,
+			want: &Author{Name: "Jane Doe", URL: "https://example.com/jane"},
+		},
+		{
+			name: "rel author",
+			html: `<p>By <a rel="author" href="/staff/jane">Jane Doe</a></p>`,
+			want: &Author{Name: "Jane Doe", URL: "/staff/jane"},
+		},
+		{
+			name: "byline class",
+			html: `<div class="article-meta byline"><span>By</span> <div>John Smith</div></div><p class="author">Not this one</p>`,
+			want: &Author{Name: "John Smith"},
+		},
+		{
+			name: "void elements",
+			html: `<img class="author" src="/jane.jpg"><meta itemprop="author" content="Jane"><div class="byline">By Jane Doe</div><p>The rest of the article.</p>`,
+			want: &Author{Name: "Jane Doe"},
+		},
+		{
+			name: "meta",
+			html: `<meta name="author" content="Mike Hale">`,
+			want: &Author{Name: "Mike Hale"},
+		},
+		{
+			name: "meta url",
+			html: `<meta property="og:author" content="https://www.facebook.com/jane">`,
+			want: &Author{URL: "https://www.facebook.com/jane"},
+		},
+		{
+			name: "none",
+			html: `<p>Nothing to see here.</p>`,
+		},
+	}
+
+	for _, test := range tests {
+		srv := newTestServer("text/html", `<html><head><title>Authors</title></head><body>`+test.html+`</body></html>`)
+
+		if test.want != nil && strings.HasPrefix(test.want.URL, "/") {
+			test.want.URL = srv.URL + test.want.URL
+		}
+
+		res, err := Parse(srv.URL)
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, test.want, res.Byline, test.name)
+
+		srv.Close()
+	}
+}
+
+func TestBylineFillsAuthor(t *testing.T) {
+	srv := newTestServer("text/html", `<html><body><span class="byline">Written by Jane Doe</span></body></html>`)
+	defer srv.Close()
+
+	res, err := Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "Jane Doe", res.Author)
+
+	res, err = NewParser().WithHeuristics(false).Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "", res.Author)
+	assert.Nil(t, res.Byline)
+}
@@ -0,0 +1,198 @@
+package recon
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultBatchConcurrency is the number of URLs parsed at once by ParseAll and Stream when BatchOptions doesn't say
+// otherwise.
+const DefaultBatchConcurrency = 4
+
+// BatchOptions controls how ParseAll and Stream parse a batch of URLs.
+type BatchOptions struct {
+	// Concurrency is the maximum number of URLs parsed at once. If it's 0, DefaultBatchConcurrency is used.
+	Concurrency int
+
+	// Timeout, if set, bounds the time spent parsing each URL.
+	Timeout time.Duration
+}
+
+// BatchResult is the outcome of parsing one URL of a batch.
+type BatchResult struct {
+	// URL is the URL as-passed.
+	URL string
+
+	// Index is the position of URL in the batch.
+	Index int
+
+	// Result is the URL's Result, which may be partial if Err is set; see ParseContext.
+	Result Result
+
+	// Err is the error parsing the URL failed with, if any.
+	Err error
+
+	// Duration is the time spent parsing the URL.
+	Duration time.Duration
+}
+
+// BatchStats summarizes the outcome of a batch.
+type BatchStats struct {
+	URLs      int
+	Succeeded int
+	Failed    int
+
+	// Duration is the total time spent parsing the batch's URLs, which may exceed the batch's wall time since URLs
+	// are parsed concurrently.
+	Duration time.Duration
+}
+
+// SummarizeBatch returns aggregate statistics for the results of a batch.
+func SummarizeBatch(results []BatchResult) BatchStats {
+	stats := BatchStats{URLs: len(results)}
+	for _, r := range results {
+		if r.Err != nil {
+			stats.Failed++
+		} else {
+			stats.Succeeded++
+		}
+		stats.Duration += r.Duration
+	}
+
+	return stats
+}
+
+// ParseAll parses urls concurrently, returning a BatchResult for each, in the same order as urls. A URL that fails
+// to parse doesn't affect the others. If ctx is canceled, URLs that haven't been parsed yet fail with ctx's error.
+func (p *Parser) ParseAll(ctx context.Context, urls []string, opts BatchOptions) []BatchResult {
+	jobs := make(chan batchJob)
+	go func() {
+		defer close(jobs)
+		for i, u := range urls {
+			select {
+			case jobs <- batchJob{index: i, url: u}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	results := make([]BatchResult, len(urls))
+	done := make([]bool, len(urls))
+	for res := range p.batch(ctx, jobs, opts) {
+		results[res.Index] = res
+		done[res.Index] = true
+	}
+
+	for i, u := range urls {
+		if !done[i] {
+			results[i] = BatchResult{URL: u, Index: i, Err: ctx.Err()}
+		}
+	}
+
+	return results
+}
+
+// Stream parses the URLs received from urls concurrently, sending a BatchResult on the returned channel as each one
+// completes, so long-running crawlers can consume results without buffering them. Results arrive in the order they
+// complete, and Index is the position of the URL in the stream. The returned channel is closed once urls is closed
+// and every URL received from it has been parsed, or once ctx is canceled. At most one BatchOptions may be passed.
+func (p *Parser) Stream(ctx context.Context, urls <-chan string, opts ...BatchOptions) <-chan BatchResult {
+	var o BatchOptions
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+
+	jobs := make(chan batchJob)
+	go func() {
+		defer close(jobs)
+		for i := 0; ; i++ {
+			select {
+			case u, ok := <-urls:
+				if !ok {
+					return
+				}
+
+				select {
+				case jobs <- batchJob{index: i, url: u}:
+				case <-ctx.Done():
+					return
+				}
+
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return p.batch(ctx, jobs, o)
+}
+
+type batchJob struct {
+	index int
+	url   string
+}
+
+// batch parses the URLs received from jobs with a pool of workers, sending each BatchResult on the returned channel
+// as it completes. The channel is closed once jobs is closed and drained, or ctx is canceled.
+func (p *Parser) batch(ctx context.Context, jobs <-chan batchJob, opts BatchOptions) <-chan BatchResult {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultBatchConcurrency
+	}
+
+	out := make(chan BatchResult)
+	wg := sync.WaitGroup{}
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				var job batchJob
+				var ok bool
+				select {
+				case job, ok = <-jobs:
+					if !ok {
+						return
+					}
+				case <-ctx.Done():
+					return
+				}
+
+				res := p.parseBatchJob(ctx, job, opts)
+				select {
+				case out <- res:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	return out
+}
+
+func (p *Parser) parseBatchJob(ctx context.Context, job batchJob, opts BatchOptions) BatchResult {
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	res, err := p.ParseContext(ctx, job.url)
+
+	return BatchResult{
+		URL:      job.url,
+		Index:    job.index,
+		Result:   res,
+		Err:      err,
+		Duration: time.Since(start),
+	}
+}
@@ -0,0 +1,112 @@
+package recon
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newBatchServer(inFlight, maxInFlight *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(inFlight, 1)
+		defer atomic.AddInt32(inFlight, -1)
+		for {
+			max := atomic.LoadInt32(maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(maxInFlight, max, n) {
+				break
+			}
+		}
+
+		time.Sleep(10 * time.Millisecond)
+
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprintf(w, `<html><head><title>%s</title></head></html>`, r.URL.Path)
+	}))
+}
+
+func TestParseAll(t *testing.T) {
+	var inFlight, maxInFlight int32
+	srv := newBatchServer(&inFlight, &maxInFlight)
+	defer srv.Close()
+
+	urls := []string{srv.URL + "/a", srv.URL + "/missing", srv.URL + "/b", srv.URL + "/c", srv.URL + "/d"}
+	results := NewParser().ParseAll(context.Background(), urls, BatchOptions{Concurrency: 2})
+
+	if assert.Len(t, results, len(urls)) {
+		for i, res := range results {
+			assert.Equal(t, urls[i], res.URL)
+			assert.Equal(t, i, res.Index)
+		}
+
+		assert.Equal(t, "/a", results[0].Result.Title)
+		assert.NotNil(t, results[1].Err)
+		assert.Equal(t, "/d", results[4].Result.Title)
+	}
+
+	assert.True(t, maxInFlight <= 2, "at most 2 requests should be in flight, saw %d", maxInFlight)
+
+	stats := SummarizeBatch(results)
+	assert.Equal(t, 5, stats.URLs)
+	assert.Equal(t, 4, stats.Succeeded)
+	assert.Equal(t, 1, stats.Failed)
+}
+
+func TestParseAllCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results := NewParser().ParseAll(ctx, []string{"http://example.invalid/a", "http://example.invalid/b"}, BatchOptions{})
+	if assert.Len(t, results, 2) {
+		assert.NotNil(t, results[0].Err)
+		assert.NotNil(t, results[1].Err)
+	}
+}
+
+func TestStream(t *testing.T) {
+	var inFlight, maxInFlight int32
+	srv := newBatchServer(&inFlight, &maxInFlight)
+	defer srv.Close()
+
+	urls := make(chan string)
+	go func() {
+		defer close(urls)
+		for _, path := range []string{"/a", "/b", "/missing", "/c"} {
+			urls <- srv.URL + path
+		}
+	}()
+
+	titles := map[string]string{}
+	failed := 0
+	for res := range NewParser().Stream(context.Background(), urls, BatchOptions{Concurrency: 3}) {
+		if res.Err != nil {
+			failed++
+			continue
+		}
+		titles[res.URL] = res.Result.Title
+	}
+
+	assert.Equal(t, 1, failed)
+	assert.Equal(t, map[string]string{srv.URL + "/a": "/a", srv.URL + "/b": "/b", srv.URL + "/c": "/c"}, titles)
+	assert.True(t, maxInFlight <= 3, "at most 3 requests should be in flight, saw %d", maxInFlight)
+}
+
+func TestStreamCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	urls := make(chan string)
+	results := NewParser().Stream(ctx, urls)
+
+	cancel()
+	for range results {
+	}
+}
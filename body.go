@@ -0,0 +1,255 @@
+package recon
+
+import (
+	"bufio"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+	"github.com/pkg/errors"
+)
+
+// ErrCompressionBomb is returned when a compressed response decodes to a suspiciously large multiple of its size on
+// the wire.
+var ErrCompressionBomb = errors.New("compression ratio exceeded; possible compression bomb")
+
+// ErrBodyTooLarge is returned when a page's body is larger than the limit set with WithMaxBodySize.
+var ErrBodyTooLarge = errors.New("response body too large")
+
+// ErrUnsupportedEncoding is returned when a response uses a Content-Encoding the parser can't decode, rather than
+// tokenizing its compressed bytes.
+var ErrUnsupportedEncoding = errors.New("unsupported content encoding")
+
+// ContentDecoder wraps a response body compressed with a particular Content-Encoding, returning a reader of its
+// decoded bytes.
+type ContentDecoder func(io.Reader) (io.Reader, error)
+
+// builtinDecoders are the content encodings every parser can decode.
+var builtinDecoders = map[string]ContentDecoder{
+	"gzip":    decodeGzip,
+	"x-gzip":  decodeGzip,
+	"deflate": decodeDeflate,
+	"br":      decodeBrotli,
+}
+
+// DefaultMaxCompressionRatio is the largest ratio of decoded bytes to on-the-wire bytes recon accepts from a
+// compressed response.
+var DefaultMaxCompressionRatio = 100.0
+
+// compressionCheckThreshold is the number of decoded bytes a response may produce before its compression ratio is
+// checked, so that small, highly compressible responses aren't mistaken for bombs.
+const compressionCheckThreshold = 1 << 20
+
+// WithMaxCompressionRatio sets the largest ratio of decoded bytes to on-the-wire bytes the parser accepts from a
+// compressed page or image response before aborting with ErrCompressionBomb. A ratio of 0 disables the check.
+func (p *Parser) WithMaxCompressionRatio(r float64) *Parser {
+	p.maxCompressionRatio = r
+	return p
+}
+
+// WithMaxBodySize limits the number of (decoded) bytes the parser reads from a page's body. Pages that are larger
+// fail with ErrBodyTooLarge as soon as the limit is exceeded. A limit of 0 means no limit.
+func (p *Parser) WithMaxBodySize(n int64) *Parser {
+	p.maxBodySize = n
+	return p
+}
+
+// WithContentDecoder teaches the parser to decode responses with the given Content-Encoding (e.g. "zstd"), and adds
+// the encoding to the parser's Accept-Encoding header. gzip, deflate and br are supported out of the box; a decoder
+// given for one of them replaces the built-in one.
+func (p *Parser) WithContentDecoder(encoding string, fn ContentDecoder) *Parser {
+	encoding = strings.ToLower(encoding)
+
+	decoders := map[string]ContentDecoder{}
+	for k, v := range p.decoders {
+		decoders[k] = v
+	}
+	decoders[encoding] = fn
+	p.decoders = decoders
+
+	if _, builtin := builtinDecoders[encoding]; !builtin {
+		p.encodings = append(append([]string{}, p.encodings...), encoding)
+	}
+
+	return p
+}
+
+// acceptEncoding returns the Accept-Encoding header value listing every encoding the parser can decode.
+func (p *Parser) acceptEncoding() string {
+	return strings.Join(append([]string{"gzip", "deflate", "br"}, p.encodings...), ", ")
+}
+
+// decoder returns the ContentDecoder for the encoding, if the parser has one.
+func (p *Parser) decoder(encoding string) (ContentDecoder, bool) {
+	if fn, ok := p.decoders[encoding]; ok {
+		return fn, true
+	}
+
+	fn, ok := builtinDecoders[encoding]
+	return fn, ok
+}
+
+// limitBody caps the number of bytes that can be read from the response's body at n.
+func limitBody(resp *http.Response, n int64) {
+	resp.Body = &wrappedBody{Reader: &maxBytesReader{r: resp.Body, remaining: n}, closer: resp.Body}
+}
+
+// decodeBody replaces the response's body with a decoded version of itself according to its Content-Encoding. recon
+// asks for compressed responses explicitly (rather than letting the transport decode them) so that it can compare
+// the decoded size against the size on the wire. Responses with an encoding the parser can't decode fail with
+// ErrUnsupportedEncoding, even if the parser didn't ask for it.
+func (p *Parser) decodeBody(resp *http.Response) error {
+	var encodings []string
+	for _, header := range resp.Header.Values("Content-Encoding") {
+		for _, enc := range strings.Split(header, ",") {
+			enc = strings.ToLower(strings.TrimSpace(enc))
+			if enc != "" && enc != "identity" {
+				encodings = append(encodings, enc)
+			}
+		}
+	}
+
+	if len(encodings) == 0 {
+		return nil
+	}
+
+	wire := &countingReader{r: resp.Body}
+
+	// encodings are listed in the order they were applied, so they're undone in reverse
+	var decoded io.Reader = wire
+	for i := len(encodings) - 1; i >= 0; i-- {
+		fn, ok := p.decoder(encodings[i])
+		if !ok {
+			return errors.Wrapf(ErrUnsupportedEncoding, "%q", encodings[i])
+		}
+
+		r, err := fn(decoded)
+		if err != nil {
+			return errors.Wrap(err, encodings[i])
+		}
+		decoded = r
+	}
+
+	if p.maxCompressionRatio > 0 {
+		decoded = &ratioGuard{r: decoded, wire: wire, maxRatio: p.maxCompressionRatio}
+	}
+
+	resp.Body = &wrappedBody{Reader: decoded, closer: resp.Body}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+
+	return nil
+}
+
+func decodeGzip(r io.Reader) (io.Reader, error) {
+	return gzip.NewReader(r)
+}
+
+func decodeBrotli(r io.Reader) (io.Reader, error) {
+	return brotli.NewReader(r), nil
+}
+
+// decodeDeflate decodes a "deflate" body, which is meant to be zlib-wrapped but is sent as a raw deflate stream by
+// some servers.
+func decodeDeflate(r io.Reader) (io.Reader, error) {
+	br := bufio.NewReader(r)
+
+	header, err := br.Peek(2)
+	if err == nil && header[0]&0x0f == 8 && (uint16(header[0])<<8|uint16(header[1]))%31 == 0 {
+		return zlib.NewReader(br)
+	}
+
+	return flate.NewReader(br), nil
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(b []byte) (int, error) {
+	n, err := c.r.Read(b)
+	c.n += int64(n)
+	return n, err
+}
+
+// ratioGuard fails with ErrCompressionBomb once the bytes read from r outnumber the bytes read from wire by more
+// than maxRatio.
+type ratioGuard struct {
+	r        io.Reader
+	wire     *countingReader
+	decoded  int64
+	maxRatio float64
+}
+
+func (g *ratioGuard) Read(b []byte) (int, error) {
+	n, err := g.r.Read(b)
+	g.decoded += int64(n)
+
+	if g.decoded > compressionCheckThreshold && float64(g.decoded) > g.maxRatio*float64(g.wire.n) {
+		return n, ErrCompressionBomb
+	}
+
+	return n, err
+}
+
+// maxBytesReader fails with ErrBodyTooLarge once more than its remaining bytes have been read from r.
+type maxBytesReader struct {
+	r         io.Reader
+	remaining int64
+}
+
+func (m *maxBytesReader) Read(b []byte) (int, error) {
+	if int64(len(b)) > m.remaining+1 {
+		b = b[:m.remaining+1]
+	}
+
+	n, err := m.r.Read(b)
+	if int64(n) > m.remaining {
+		n = int(m.remaining)
+		m.remaining = 0
+		return n, ErrBodyTooLarge
+	}
+
+	m.remaining -= int64(n)
+	return n, err
+}
+
+type wrappedBody struct {
+	io.Reader
+	closer io.Closer
+}
+
+func (d *wrappedBody) Close() error {
+	return d.closer.Close()
+}
+
+// limitedWriter writes to w until n bytes have been written, then discards the rest without failing, so that it can
+// be used with io.TeeReader to keep the start of a body while the whole body is read.
+type limitedWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (l *limitedWriter) Write(p []byte) (int, error) {
+	size := len(p)
+	if int64(len(p)) > l.n {
+		p = p[:l.n]
+	}
+
+	if len(p) > 0 {
+		n, err := l.w.Write(p)
+		l.n -= int64(n)
+		if err != nil {
+			return n, err
+		}
+	}
+
+	return size, nil
+}
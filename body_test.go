@@ -0,0 +1,164 @@
+package recon
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func newGzipServer(body []byte) *httptest.Server {
+	buf := &bytes.Buffer{}
+	zw := gzip.NewWriter(buf)
+	zw.Write(body)
+	zw.Close()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Header().Set("Content-Encoding", "gzip")
+		w.Write(buf.Bytes())
+	}))
+}
+
+func TestGzipBody(t *testing.T) {
+	srv := newGzipServer([]byte(`<html><head><title>Compressed</title></head></html>`))
+	defer srv.Close()
+
+	res, err := Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "Compressed", res.Title)
+}
+
+func TestContentEncodings(t *testing.T) {
+	page := []byte(`<html><head><title>Compressed</title></head></html>`)
+
+	zlibBuf := &bytes.Buffer{}
+	zw := zlib.NewWriter(zlibBuf)
+	zw.Write(page)
+	zw.Close()
+
+	flateBuf := &bytes.Buffer{}
+	fw, _ := flate.NewWriter(flateBuf, flate.DefaultCompression)
+	fw.Write(page)
+	fw.Close()
+
+	brBuf := &bytes.Buffer{}
+	bw := brotli.NewWriter(brBuf)
+	bw.Write(page)
+	bw.Close()
+
+	// "rev" is a toy encoding that reverses the body, standing in for something like zstd
+	reversed := make([]byte, len(page))
+	for i, b := range page {
+		reversed[len(page)-1-i] = b
+	}
+
+	bodies := map[string][]byte{
+		"/zlib":  zlibBuf.Bytes(),
+		"/flate": flateBuf.Bytes(),
+		"/br":    brBuf.Bytes(),
+		"/rev":   reversed,
+	}
+	encodings := map[string]string{
+		"/zlib":  "deflate",
+		"/flate": "deflate",
+		"/br":    "br",
+		"/rev":   "rev",
+	}
+
+	var accept string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		accept = r.Header.Get("Accept-Encoding")
+		w.Header().Set("Content-Type", "text/html")
+		w.Header().Set("Content-Encoding", encodings[r.URL.Path])
+		w.Write(bodies[r.URL.Path])
+	}))
+	defer srv.Close()
+
+	for _, path := range []string{"/zlib", "/flate", "/br"} {
+		res, err := Parse(srv.URL + path)
+		assert.Nil(t, err, path)
+		assert.Equal(t, "Compressed", res.Title, path)
+		assert.Equal(t, "gzip, deflate, br", accept)
+	}
+
+	_, err := Parse(srv.URL + "/rev")
+	assert.True(t, errors.Is(err, ErrUnsupportedEncoding), "expected ErrUnsupportedEncoding, got %v", err)
+
+	res, err := NewParser().WithContentDecoder("rev", func(r io.Reader) (io.Reader, error) {
+		b, err := io.ReadAll(r)
+		for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+			b[i], b[j] = b[j], b[i]
+		}
+		return bytes.NewReader(b), err
+	}).Parse(srv.URL + "/rev")
+	assert.Nil(t, err)
+	assert.Equal(t, "Compressed", res.Title)
+	assert.Equal(t, "gzip, deflate, br, rev", accept)
+}
+
+func TestCompressionBomb(t *testing.T) {
+	body := "<html><head><title>Bomb</title></head><body>" + strings.Repeat(" ", 32<<20) + "</body></html>"
+	srv := newGzipServer([]byte(body))
+	defer srv.Close()
+
+	_, err := Parse(srv.URL)
+	assert.True(t, errors.Is(err, ErrCompressionBomb), "expected ErrCompressionBomb, got %v", err)
+
+	res, err := NewParser().WithMaxCompressionRatio(0).Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "Bomb", res.Title)
+}
+
+func TestMaxBodySize(t *testing.T) {
+	srv := newTestServer("text/html", "<html><head><title>Big</title></head><body>"+strings.Repeat("<p>filler</p>", 1000)+"</body></html>")
+	defer srv.Close()
+
+	_, err := NewParser().WithMaxBodySize(1024).Parse(srv.URL)
+	assert.True(t, errors.Is(err, ErrBodyTooLarge), "expected ErrBodyTooLarge, got %v", err)
+
+	res, err := NewParser().WithMaxBodySize(1 << 20).Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "Big", res.Title)
+}
+
+func TestMaxBytesReader(t *testing.T) {
+	buf := make([]byte, 16)
+
+	r := &maxBytesReader{r: strings.NewReader("exactly"), remaining: 7}
+	n, err := r.Read(buf)
+	assert.Equal(t, 7, n)
+	assert.Nil(t, err)
+
+	r = &maxBytesReader{r: strings.NewReader("exactly!"), remaining: 7}
+	n, err = r.Read(buf)
+	assert.Equal(t, 7, n)
+	assert.Equal(t, ErrBodyTooLarge, err)
+}
+
+func TestLimitedWriter(t *testing.T) {
+	buf := &bytes.Buffer{}
+	w := &limitedWriter{w: buf, n: 5}
+
+	n, err := w.Write([]byte("abc"))
+	assert.Equal(t, 3, n)
+	assert.Nil(t, err)
+
+	n, err = w.Write([]byte("defg"))
+	assert.Equal(t, 4, n)
+	assert.Nil(t, err)
+
+	n, err = w.Write([]byte("hij"))
+	assert.Equal(t, 3, n)
+	assert.Nil(t, err)
+	assert.Equal(t, "abcde", buf.String())
+}
@@ -0,0 +1,115 @@
+package recon
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// Cache stores previously built Results along with the HTTP validators (ETag and Last-Modified) needed to
+// revalidate them. When a parser has a Cache, it makes conditional requests for pages it has seen before and
+// returns the cached Result if the server responds 304 Not Modified.
+//
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the entry stored under key, and false if there isn't one.
+	Get(ctx context.Context, key string) (CacheEntry, bool, error)
+
+	// Set stores an entry under key.
+	Set(ctx context.Context, key string, entry CacheEntry) error
+}
+
+// CacheEntry is a Result stored in a Cache, along with the validators of the response it was built from.
+type CacheEntry struct {
+	Result       Result `json:"result"`
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"last_modified,omitempty"`
+}
+
+// WithCache sets the cache the parser uses to revalidate pages it has parsed before. Errors from the cache are
+// treated as cache misses.
+func (p *Parser) WithCache(c Cache) *Parser {
+	p.cache = c
+	return p
+}
+
+// ParseIfModified parses url only if it has changed since it was last seen, as identified by the time it was last
+// modified and/or its ETag (either may be empty). If the server reports that the page hasn't changed, the returned
+// bool is true and the Result only describes the response (URL, Host, StatusCode and Scraped), so the caller should
+// keep using the Result it already has.
+func (p *Parser) ParseIfModified(url string, since time.Time, etag string) (Result, bool, error) {
+	cond := validators{etag: etag}
+	if !since.IsZero() {
+		cond.lastModified = since.UTC().Format(http.TimeFormat)
+	}
+
+	ctx, cancel := p.withTimeout(context.Background())
+	defer cancel()
+
+	rec := &statsRecorder{stats: ParseStats{URL: url}}
+	res, job, err := p.parseConditional(ctx, url, cond, rec)
+	rec.report(p.stats, err)
+	if err != nil {
+		return res, false, err
+	}
+
+	if !job.notModified {
+		p.storeEntry(ctx, url, res, job.response)
+	}
+
+	return res, job.notModified, nil
+}
+
+// validators are the conditions of a conditional GET.
+type validators struct {
+	etag         string
+	lastModified string
+}
+
+func (v validators) empty() bool {
+	return v.etag == "" && v.lastModified == ""
+}
+
+func (v validators) apply(req *http.Request) {
+	if v.etag != "" {
+		req.Header.Set("If-None-Match", v.etag)
+	}
+	if v.lastModified != "" {
+		req.Header.Set("If-Modified-Since", v.lastModified)
+	}
+}
+
+func responseValidators(resp *http.Response) validators {
+	return validators{
+		etag:         resp.Header.Get("ETag"),
+		lastModified: resp.Header.Get("Last-Modified"),
+	}
+}
+
+// cachedEntry looks up the cached entry for url, if the parser has a cache.
+func (p *Parser) cachedEntry(ctx context.Context, url string) (CacheEntry, bool) {
+	if p.cache == nil {
+		return CacheEntry{}, false
+	}
+
+	entry, ok, err := p.cache.Get(ctx, p.cleanURL(url))
+	if err != nil || !ok {
+		return CacheEntry{}, false
+	}
+
+	return entry, true
+}
+
+// storeEntry caches res under url if the parser has a cache and the response can be revalidated later.
+func (p *Parser) storeEntry(ctx context.Context, url string, res Result, resp *http.Response) {
+	v := responseValidators(resp)
+	if p.cache == nil || v.empty() {
+		return
+	}
+
+	p.cache.Set(ctx, p.cleanURL(url), CacheEntry{
+		Result:       res,
+		ETag:         v.etag,
+		LastModified: v.lastModified,
+	})
+}
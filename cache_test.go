@@ -0,0 +1,145 @@
+package recon
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mapCache struct {
+	mu      sync.Mutex
+	entries map[string]CacheEntry
+}
+
+func (c *mapCache) Get(ctx context.Context, key string) (CacheEntry, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	return e, ok, nil
+}
+
+func (c *mapCache) Set(ctx context.Context, key string, entry CacheEntry) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = entry
+	return nil
+}
+
+// newValidatingServer serves a page with an ETag, responding 304 Not Modified to matching conditional requests.
+func newValidatingServer(etag *string, fullResponses *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", *etag)
+		if r.Header.Get("If-None-Match") == *etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		*fullResponses++
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<html><head><title>Version ` + *etag + `</title></head></html>`))
+	}))
+}
+
+func TestCache(t *testing.T) {
+	etag := `"v1"`
+	fullResponses := 0
+	srv := newValidatingServer(&etag, &fullResponses)
+	defer srv.Close()
+
+	cache := &mapCache{entries: map[string]CacheEntry{}}
+	p := NewParser().WithCache(cache)
+
+	res, err := p.Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, `Version "v1"`, res.Title)
+	assert.Equal(t, `"v1"`, cache.entries[srv.URL].ETag)
+
+	res, err = p.Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, `Version "v1"`, res.Title)
+	assert.Equal(t, 1, fullResponses)
+
+	etag = `"v2"`
+	res, err = p.Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, `Version "v2"`, res.Title)
+	assert.Equal(t, 2, fullResponses)
+	assert.Equal(t, `"v2"`, cache.entries[srv.URL].ETag)
+}
+
+func TestCacheAlternate(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"`+r.URL.Path+`"`)
+		w.Header().Set("Content-Type", "text/html")
+		if r.URL.Path == "/fr/" {
+			w.Write([]byte(`<html lang="fr"><head><title>Bonjour</title></head></html>`))
+			return
+		}
+
+		w.Write([]byte(`<html lang="en"><head><title>Hello</title>
+			<link rel="alternate" hreflang="fr" href="/fr/"></head></html>`))
+	}))
+	defer srv.Close()
+
+	cache := &mapCache{entries: map[string]CacheEntry{}}
+	p := NewParser().WithCache(cache).WithAcceptLanguage("fr")
+
+	res, err := p.Parse(srv.URL + "/")
+	assert.Nil(t, err)
+	assert.Equal(t, "Bonjour", res.Title)
+
+	// the translation is cached under its own URL, and the page that pointed to it isn't cached at all
+	assert.Len(t, cache.entries, 1)
+	if entry, ok := cache.entries[srv.URL+"/fr/"]; assert.True(t, ok) {
+		assert.Equal(t, "Bonjour", entry.Result.Title)
+		assert.Equal(t, `"/fr/"`, entry.ETag)
+	}
+
+	res, err = p.Parse(srv.URL + "/")
+	assert.Nil(t, err)
+	assert.Equal(t, "Bonjour", res.Title)
+}
+
+func TestParseIfModified(t *testing.T) {
+	lastModified := time.Date(2016, 10, 1, 12, 0, 0, 0, time.UTC)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.ServeContent(w, r, "page.html", lastModified, strings.NewReader(`<html><head><title>Modified</title></head></html>`))
+	}))
+	defer srv.Close()
+
+	p := NewParser()
+
+	res, notModified, err := p.ParseIfModified(srv.URL, time.Time{}, "")
+	assert.Nil(t, err)
+	assert.False(t, notModified)
+	assert.Equal(t, "Modified", res.Title)
+
+	res, notModified, err = p.ParseIfModified(srv.URL, lastModified, "")
+	assert.Nil(t, err)
+	assert.True(t, notModified)
+	assert.Equal(t, http.StatusNotModified, res.StatusCode)
+	assert.Equal(t, "", res.Title)
+
+	res, notModified, err = p.ParseIfModified(srv.URL, lastModified.Add(-time.Hour), "")
+	assert.Nil(t, err)
+	assert.False(t, notModified)
+	assert.Equal(t, "Modified", res.Title)
+
+	etag := `"v1"`
+	fullResponses := 0
+	etagSrv := newValidatingServer(&etag, &fullResponses)
+	defer etagSrv.Close()
+
+	_, notModified, err = p.ParseIfModified(etagSrv.URL, time.Time{}, `"v1"`)
+	assert.Nil(t, err)
+	assert.True(t, notModified)
+	assert.Equal(t, 0, fullResponses)
+}
@@ -0,0 +1,116 @@
+package recon
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ErrBotChallenge is returned when a site answers with a bot challenge, like Cloudflare's "Just a moment..." page,
+// instead of the page itself. Such pages can usually only be read by a browser, e.g. via WithRenderer.
+var ErrBotChallenge = errors.New("bot challenge")
+
+// maxChallengeBody is how much of an error response's body is searched for challenge markers.
+const maxChallengeBody = 64 * 1024
+
+// challengeMarkers are found in the markup, scripts and forms of Cloudflare's and Akamai's challenge pages.
+var challengeMarkers = []string{
+	"/cdn-cgi/challenge-platform/",
+	"_cf_chl_opt",
+	"cf-browser-verification",
+	"sec-if-cpt-container",
+	"/_sec/cp_challenge/",
+	"bm-verify",
+}
+
+// challengeTitles are the lower-cased titles of Cloudflare's challenge pages.
+var challengeTitles = []string{
+	"just a moment...",
+	"attention required! | cloudflare",
+}
+
+// challengeServer returns whether the response came from a CDN known to serve challenge pages.
+func challengeServer(resp *http.Response) bool {
+	server := strings.ToLower(resp.Header.Get("Server"))
+	return strings.HasPrefix(server, "cloudflare") || strings.HasPrefix(server, "akamaighost")
+}
+
+// mitigated returns whether the response's headers declare it a challenge, as Cloudflare's do.
+func mitigated(resp *http.Response) bool {
+	return strings.EqualFold(resp.Header.Get("Cf-Mitigated"), "challenge")
+}
+
+// isChallengeResponse returns whether an error response is a bot challenge, reading the start of its body if its
+// headers don't say. The response's body must already be decoded.
+func isChallengeResponse(resp *http.Response) bool {
+	if mitigated(resp) {
+		return true
+	}
+
+	switch resp.StatusCode {
+	case http.StatusForbidden, http.StatusTooManyRequests, http.StatusServiceUnavailable:
+	default:
+		return false
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxChallengeBody))
+	body = bytes.ToLower(body)
+	for _, marker := range challengeMarkers {
+		if bytes.Contains(body, []byte(marker)) {
+			return true
+		}
+	}
+
+	if challengeServer(resp) {
+		for _, title := range challengeTitles {
+			if bytes.Contains(body, []byte("<title>"+title+"</title>")) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// challenged returns whether a page that was served successfully is actually a bot challenge, judging by its
+// headers, title and scripts.
+func (d *Document) challenged() bool {
+	if d.Response == nil {
+		return false
+	}
+	if mitigated(d.Response) {
+		return true
+	}
+
+	title := ""
+	for _, tag := range d.metaTags {
+		if tag.name == "title" {
+			title = strings.ToLower(strings.TrimSpace(tag.value))
+			break
+		}
+	}
+
+	known := false
+	for _, t := range challengeTitles {
+		known = known || title == t
+	}
+	if !known {
+		return false
+	}
+
+	if challengeServer(d.Response) {
+		return true
+	}
+	for _, asset := range d.assets {
+		for _, marker := range challengeMarkers {
+			if strings.Contains(strings.ToLower(asset), marker) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
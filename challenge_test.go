@@ -0,0 +1,104 @@
+package recon
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBotChallenge(t *testing.T) {
+	cloudflare := `<!DOCTYPE html><html lang="en-US"><head><title>Just a moment...</title></head><body>
+		<script>(function(){window._cf_chl_opt={cvId: '3'};})();</script></body></html>`
+
+	gzipped := &bytes.Buffer{}
+	gz := gzip.NewWriter(gzipped)
+	gz.Write([]byte(cloudflare))
+	gz.Close()
+
+	tests := []struct {
+		name      string
+		status    int
+		header    http.Header
+		body      string
+		challenge bool
+	}{
+		{
+			name:      "cf-mitigated",
+			status:    http.StatusForbidden,
+			header:    http.Header{"Cf-Mitigated": {"challenge"}},
+			challenge: true,
+		},
+		{
+			name:      "cloudflare body",
+			status:    http.StatusServiceUnavailable,
+			header:    http.Header{"Server": {"cloudflare"}},
+			body:      cloudflare,
+			challenge: true,
+		},
+		{
+			name:      "compressed body",
+			status:    http.StatusForbidden,
+			header:    http.Header{"Content-Encoding": {"gzip"}},
+			body:      gzipped.String(),
+			challenge: true,
+		},
+		{
+			name:      "akamai body",
+			status:    http.StatusForbidden,
+			header:    http.Header{"Server": {"AkamaiGHost"}},
+			body:      `<html><body><div id="sec-if-cpt-container">Checking your browser</div></body></html>`,
+			challenge: true,
+		},
+		{
+			name:      "challenge served as 200",
+			status:    http.StatusOK,
+			header:    http.Header{"Server": {"cloudflare"}},
+			body:      cloudflare,
+			challenge: true,
+		},
+		{
+			name:      "challenge script served as 200",
+			status:    http.StatusOK,
+			body:      `<html><head><title>Just a moment...</title><script src="/cdn-cgi/challenge-platform/h/b/orchestrate/chl_page/v1"></script></head></html>`,
+			challenge: true,
+		},
+		{
+			name:   "plain forbidden",
+			status: http.StatusForbidden,
+			header: http.Header{"Server": {"cloudflare"}},
+			body:   `<html><head><title>Forbidden</title></head></html>`,
+		},
+		{
+			name:   "page with the same title",
+			status: http.StatusOK,
+			body:   `<html><head><title>Just a moment...</title></head><body><p>A short story.</p></body></html>`,
+		},
+	}
+
+	for _, test := range tests {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			for k, v := range test.header {
+				w.Header()[k] = v
+			}
+			w.Header().Set("Content-Type", "text/html")
+			w.WriteHeader(test.status)
+			w.Write([]byte(test.body))
+		}))
+
+		res, err := Parse(srv.URL)
+		assert.Equal(t, test.challenge, errors.Is(err, ErrBotChallenge), "%s: %v", test.name, err)
+		if test.challenge {
+			assert.Equal(t, "", res.Title, test.name)
+		} else if test.status == http.StatusOK {
+			assert.Nil(t, err, test.name)
+			assert.Equal(t, "Just a moment...", res.Title, test.name)
+		}
+
+		srv.Close()
+	}
+}
@@ -0,0 +1,439 @@
+package recon
+
+import (
+	"bytes"
+	"math"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Content is the main content of a page, with navigation, sidebars, comments and other boilerplate removed.
+type Content struct {
+	// Text is the content's text, with paragraphs separated by blank lines.
+	Text string `json:"text"`
+
+	// HTML is the content as simplified HTML: only structural tags, links and images remain, without attributes other
+	// than href, src and alt. Links and images are absolute http or https URLs; others, like javascript: links and
+	// data: images, are removed.
+	HTML string `json:"html"`
+}
+
+// WithTextExtraction enables extracting the page's main content into Result.Content, in the style of Readability:
+// the element that contains the most paragraph-like text is picked as the article, and everything else is discarded
+// as boilerplate. The page is parsed into a DOM to do this, so it's noticeably more expensive than a plain parse.
+func (p *Parser) WithTextExtraction() *Parser {
+	p.textExtraction = true
+	return p
+}
+
+// minContentParagraphLength is the shortest text, in bytes, that scores as a paragraph of content.
+const minContentParagraphLength = 25
+
+var (
+	// unlikelyContent matches the classes and IDs of elements that are almost never part of an article.
+	unlikelyContent = regexp.MustCompile(`(?i)banner|breadcrumb|combx|comment|community|cookie|disqus|extra|foot|header` +
+		`|legends|menu|modal|related|remark|replies|rss|share|shoutbox|sidebar|skyscraper|social|sponsor|ad-break` +
+		`|agegate|pagination|pager|popup|newsletter|subscribe`)
+
+	// maybeContent matches the classes and IDs that rescue an element matched by unlikelyContent.
+	maybeContent = regexp.MustCompile(`(?i)and|article|body|column|content|main|shadow`)
+
+	// positiveContent and negativeContent match the classes and IDs that make an element more or less likely to be the
+	// article.
+	positiveContent = regexp.MustCompile(`(?i)article|body|content|entry|hentry|h-entry|main|page|post|text|blog|story`)
+	negativeContent = regexp.MustCompile(`(?i)-ad-|hidden|\bhid\b|banner|combx|comment|com-|contact|foot|footnote|gdpr` +
+		`|masthead|media|meta|outbrain|promo|related|scroll|share|shoutbox|sidebar|skyscraper|sponsor|shopping|tags` +
+		`|tool|widget`)
+)
+
+// boilerplateTags are elements that are removed before the content is scored.
+var boilerplateTags = map[atom.Atom]bool{
+	atom.Script: true, atom.Style: true, atom.Noscript: true, atom.Template: true, atom.Nav: true, atom.Aside: true,
+	atom.Footer: true, atom.Form: true, atom.Iframe: true, atom.Svg: true, atom.Button: true, atom.Input: true,
+	atom.Select: true, atom.Textarea: true, atom.Object: true, atom.Embed: true,
+}
+
+// scoredTags are the elements whose text is scored as paragraphs.
+var scoredTags = map[atom.Atom]bool{
+	atom.P: true, atom.Pre: true, atom.Td: true, atom.Blockquote: true, atom.Li: true, atom.H2: true, atom.H3: true,
+}
+
+// keptTags are the elements kept in Content.HTML. Other elements are replaced by their children.
+var keptTags = map[atom.Atom]bool{
+	atom.P: true, atom.H2: true, atom.H3: true, atom.H4: true, atom.H5: true, atom.H6: true, atom.Ul: true,
+	atom.Ol: true, atom.Li: true, atom.Blockquote: true, atom.Pre: true, atom.Code: true, atom.Em: true,
+	atom.Strong: true, atom.B: true, atom.I: true, atom.A: true, atom.Img: true, atom.Figure: true,
+	atom.Figcaption: true, atom.Table: true, atom.Tr: true, atom.Td: true, atom.Th: true, atom.Br: true,
+}
+
+// blockTags are the elements that separate paragraphs in Content.Text.
+var blockTags = map[atom.Atom]bool{
+	atom.P: true, atom.Div: true, atom.H1: true, atom.H2: true, atom.H3: true, atom.H4: true, atom.H5: true,
+	atom.H6: true, atom.Ul: true, atom.Ol: true, atom.Li: true, atom.Blockquote: true, atom.Pre: true,
+	atom.Figure: true, atom.Figcaption: true, atom.Table: true, atom.Tr: true, atom.Section: true,
+	atom.Article: true,
+}
+
+// extractContent finds the main content of the parsed page, returning nil if there's nothing that looks like an
+// article. Links and images in it are resolved with resolve.
+func extractContent(root *html.Node, resolve func(string) string) *Content {
+	body := findElement(root, atom.Body)
+	if body == nil {
+		return nil
+	}
+
+	removeBoilerplate(body)
+
+	scores := map[*html.Node]float64{}
+	var candidates []*html.Node
+	addScore := func(n *html.Node, score float64) {
+		if n == nil || n.Type != html.ElementNode {
+			return
+		}
+		if _, ok := scores[n]; !ok {
+			scores[n] = initialScore(n)
+			candidates = append(candidates, n)
+		}
+		scores[n] += score
+	}
+
+	walk(body, func(n *html.Node) bool {
+		if n.Type != html.ElementNode || !scoredTags[n.DataAtom] {
+			return true
+		}
+
+		text := collapseWhitespace(textOf(n))
+		if len(text) < minContentParagraphLength {
+			return false
+		}
+
+		score := 1 + float64(strings.Count(text, ",")) + math.Min(float64(len(text))/100, 3)
+		addScore(n.Parent, score)
+		if n.Parent != nil {
+			addScore(n.Parent.Parent, score/2)
+		}
+
+		return false
+	})
+
+	var top *html.Node
+	for _, n := range candidates {
+		scores[n] *= 1 - linkDensity(n)
+		if top == nil || scores[n] > scores[top] {
+			top = n
+		}
+	}
+
+	if top == nil {
+		return nil
+	}
+
+	// siblings of the top candidate that score well, or that are substantial paragraphs, are part of the article too
+	threshold := math.Max(10, scores[top]*0.2)
+	var nodes []*html.Node
+	for n := top.Parent.FirstChild; n != nil; n = n.NextSibling {
+		if n == top {
+			nodes = append(nodes, n)
+			continue
+		}
+
+		if n.Type != html.ElementNode {
+			continue
+		}
+
+		if score, ok := scores[n]; ok && score >= threshold {
+			nodes = append(nodes, n)
+			continue
+		}
+
+		if n.DataAtom == atom.P {
+			text := collapseWhitespace(textOf(n))
+			density := linkDensity(n)
+			if (len(text) > 80 && density < 0.25) || (density == 0 && strings.Contains(text, ". ")) {
+				nodes = append(nodes, n)
+			}
+		}
+	}
+
+	content := &Content{}
+	htmlBuf := getBuffer()
+	defer putBuffer(htmlBuf)
+
+	var paragraphs []string
+	for _, n := range nodes {
+		simplified := simplify(n, resolve)
+		for _, s := range simplified {
+			html.Render(htmlBuf, s)
+		}
+
+		paragraphs = append(paragraphs, blockText(n)...)
+	}
+
+	content.HTML = htmlBuf.String()
+	content.Text = strings.Join(paragraphs, "\n\n")
+	if content.Text == "" {
+		return nil
+	}
+
+	return content
+}
+
+// initialScore is a candidate's score before its paragraphs are counted, based on what kind of element it is and
+// its class and ID.
+func initialScore(n *html.Node) float64 {
+	score := 0.0
+	switch n.DataAtom {
+	case atom.Article:
+		score += 10
+	case atom.Div, atom.Main, atom.Section:
+		score += 5
+	case atom.Pre, atom.Td, atom.Blockquote:
+		score += 3
+	case atom.Address, atom.Ol, atom.Ul, atom.Dl, atom.Dd, atom.Dt, atom.Li, atom.Form:
+		score -= 3
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6, atom.Th:
+		score -= 5
+	}
+
+	for _, attr := range []string{"class", "id"} {
+		v := nodeAttr(n, attr)
+		if v == "" {
+			continue
+		}
+		if negativeContent.MatchString(v) {
+			score -= 25
+		}
+		if positiveContent.MatchString(v) {
+			score += 25
+		}
+	}
+
+	return score
+}
+
+// removeBoilerplate removes elements that are never part of the content from the tree under n.
+func removeBoilerplate(n *html.Node) {
+	for c := n.FirstChild; c != nil; {
+		next := c.NextSibling
+
+		if c.Type == html.CommentNode || (c.Type == html.ElementNode && isBoilerplate(c)) {
+			n.RemoveChild(c)
+		} else {
+			removeBoilerplate(c)
+		}
+
+		c = next
+	}
+}
+
+func isBoilerplate(n *html.Node) bool {
+	if boilerplateTags[n.DataAtom] || hasNodeAttr(n, "hidden") || nodeAttr(n, "aria-hidden") == "true" {
+		return true
+	}
+
+	if n.DataAtom == atom.Body || n.DataAtom == atom.Article || n.DataAtom == atom.Main || n.DataAtom == atom.A {
+		return false
+	}
+
+	id := nodeAttr(n, "class") + " " + nodeAttr(n, "id")
+	return unlikelyContent.MatchString(id) && !maybeContent.MatchString(id)
+}
+
+// linkDensity is the fraction of n's text that's inside links.
+func linkDensity(n *html.Node) float64 {
+	total := len(collapseWhitespace(textOf(n)))
+	if total == 0 {
+		return 0
+	}
+
+	links := 0
+	walk(n, func(c *html.Node) bool {
+		if c.Type == html.ElementNode && c.DataAtom == atom.A {
+			links += len(collapseWhitespace(textOf(c)))
+			return false
+		}
+		return true
+	})
+
+	return float64(links) / float64(total)
+}
+
+// simplify returns copies of n with only keptTags and their essential attributes; other elements are replaced by
+// their simplified children. Links and images are resolved with resolve, and dropped unless they're http or https;
+// an image without one is dropped altogether.
+func simplify(n *html.Node, resolve func(string) string) []*html.Node {
+	switch n.Type {
+	case html.TextNode:
+		return []*html.Node{{Type: html.TextNode, Data: n.Data}}
+
+	case html.ElementNode:
+		var children []*html.Node
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			children = append(children, simplify(c, resolve)...)
+		}
+
+		if !keptTags[n.DataAtom] {
+			return children
+		}
+
+		out := &html.Node{Type: html.ElementNode, Data: n.Data, DataAtom: n.DataAtom}
+		for _, key := range []string{"href", "src", "alt"} {
+			v := nodeAttr(n, key)
+			if v != "" && key != "alt" {
+				v = resolve(v)
+				if !isHTTPURL(v) {
+					v = ""
+				}
+			}
+			if v != "" {
+				out.Attr = append(out.Attr, html.Attribute{Key: key, Val: v})
+			}
+		}
+		if n.DataAtom == atom.Img && !hasNodeAttr(out, "src") {
+			return nil
+		}
+		for _, c := range children {
+			out.AppendChild(c)
+		}
+
+		return []*html.Node{out}
+	}
+
+	return nil
+}
+
+// isHTTPURL reports whether rawURL is an absolute http or https URL.
+func isHTTPURL(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// blockText returns the text of n split into paragraphs at block-level elements.
+func blockText(n *html.Node) []string {
+	var paragraphs []string
+	var current strings.Builder
+
+	flush := func() {
+		if text := collapseWhitespace(current.String()); text != "" {
+			paragraphs = append(paragraphs, text)
+		}
+		current.Reset()
+	}
+
+	var visit func(*html.Node)
+	visit = func(n *html.Node) {
+		switch n.Type {
+		case html.TextNode:
+			current.WriteString(n.Data)
+			return
+		case html.ElementNode:
+			if n.DataAtom == atom.Br {
+				current.WriteString(" ")
+			}
+		}
+
+		block := n.Type == html.ElementNode && blockTags[n.DataAtom]
+		if block {
+			flush()
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			visit(c)
+		}
+		if block {
+			flush()
+		}
+	}
+
+	visit(n)
+	flush()
+
+	return paragraphs
+}
+
+// walk calls fn for n and each of its descendants in document order, skipping the descendants of nodes for which fn
+// returns false.
+func walk(n *html.Node, fn func(*html.Node) bool) {
+	if !fn(n) {
+		return
+	}
+
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		walk(c, fn)
+	}
+}
+
+func findElement(n *html.Node, a atom.Atom) *html.Node {
+	var found *html.Node
+	walk(n, func(c *html.Node) bool {
+		if found != nil {
+			return false
+		}
+		if c.Type == html.ElementNode && c.DataAtom == a {
+			found = c
+			return false
+		}
+		return true
+	})
+
+	return found
+}
+
+func textOf(n *html.Node) string {
+	var b strings.Builder
+	walk(n, func(c *html.Node) bool {
+		if c.Type == html.TextNode {
+			b.WriteString(c.Data)
+		}
+		return true
+	})
+
+	return b.String()
+}
+
+func nodeAttr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+
+	return ""
+}
+
+func hasNodeAttr(n *html.Node, key string) bool {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+// contentExtractor extracts the page's main content if it's enabled or a Summarizer needs it.
+type contentExtractor struct {
+	parser *Parser
+}
+
+func (e contentExtractor) Extract(doc *Document, res *Result) error {
+	// the page may also have been kept for the DOM fallback
+	if doc.raw == nil || (!e.parser.textExtraction && e.parser.summarizer == nil) {
+		return nil
+	}
+
+	root, err := html.Parse(bytes.NewReader(doc.raw.Bytes()))
+	if err != nil {
+		return nil
+	}
+
+	doc.content = extractContent(root, doc.resolve)
+	if e.parser.textExtraction {
+		res.Content = doc.content
+	}
+
+	return nil
+}
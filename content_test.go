@@ -0,0 +1,83 @@
+package recon
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const contentPage = `<html><head><title>A story</title></head><body>
+	<header class="site-header"><nav><a href="/">Home</a> <a href="/news">News</a></nav></header>
+	<div class="sidebar"><p>Popular stories, trending topics, and other things you might like, all in one place.</p></div>
+	<div id="main">
+		<article class="post">
+			<h1>A story</h1>
+			<p>The first paragraph of the story is long enough to count, with a comma or two, and then some.</p>
+			<p>The second paragraph continues the story, with <a href="/more">a link</a> and <em>emphasis</em>.</p>
+			<script>track();</script>
+			<div class="share-buttons"><a href="https://twitter.com/share">Share</a></div>
+			<p>The third paragraph wraps things up, and it has a comma too, for good measure.</p>
+		</article>
+		<div class="comments"><p>This is a comment that's long enough to be a paragraph, but it isn't content.</p></div>
+	</div>
+	<footer><p>Copyright 2024, Example Publishing, all rights reserved, and so on and so forth.</p></footer>
+</body></html>`
+
+func TestTextExtraction(t *testing.T) {
+	srv := newTestServer("text/html", contentPage)
+	defer srv.Close()
+
+	res, err := NewParser().WithTextExtraction().Parse(srv.URL)
+	assert.Nil(t, err)
+	if assert.NotNil(t, res.Content) {
+		paragraphs := strings.Split(res.Content.Text, "\n\n")
+		assert.Equal(t, []string{
+			"A story",
+			"The first paragraph of the story is long enough to count, with a comma or two, and then some.",
+			"The second paragraph continues the story, with a link and emphasis.",
+			"The third paragraph wraps things up, and it has a comma too, for good measure.",
+		}, paragraphs)
+
+		assert.Contains(t, res.Content.HTML, `<a href="`+srv.URL+`/more">a link</a>`)
+		assert.Contains(t, res.Content.HTML, `<em>emphasis</em>`)
+		assert.NotContains(t, res.Content.HTML, "class=")
+		assert.NotContains(t, res.Content.HTML, "track()")
+		assert.NotContains(t, res.Content.HTML, "Share")
+		assert.NotContains(t, res.Content.Text, "comment")
+		assert.NotContains(t, res.Content.Text, "Copyright")
+	}
+
+	res, err = NewParser().Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Nil(t, res.Content)
+}
+
+func TestTextExtractionNoContent(t *testing.T) {
+	srv := newTestServer("text/html", `<html><body><nav><a href="/">Home</a></nav><p>Short.</p></body></html>`)
+	defer srv.Close()
+
+	res, err := NewParser().WithTextExtraction().Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Nil(t, res.Content)
+}
+
+func TestTextExtractionURLs(t *testing.T) {
+	srv := newTestServer("text/html", `<html><head><title>Links</title></head><body><article>
+		<p>The first paragraph links <a href="/about">somewhere relative</a>, and it is long enough, with commas, to count.</p>
+		<p>The second paragraph has <a href="javascript:alert(1)">a script link</a>, which is dropped, but its text is kept.</p>
+		<p>The third paragraph has images, <img src="images/a.jpg" alt="Relative"><img src="data:image/gif;base64,R0lGOD" alt="Inline"> and so on.</p>
+	</article></body></html>`)
+	defer srv.Close()
+
+	res, err := NewParser().WithTextExtraction().Parse(srv.URL + "/posts/1")
+	assert.Nil(t, err)
+	if assert.NotNil(t, res.Content) {
+		assert.Contains(t, res.Content.HTML, `<a href="`+srv.URL+`/about">somewhere relative</a>`)
+		assert.Contains(t, res.Content.HTML, `<a>a script link</a>`)
+		assert.Contains(t, res.Content.HTML, `<img src="`+srv.URL+`/posts/images/a.jpg" alt="Relative"/>`)
+		assert.NotContains(t, res.Content.HTML, "javascript:")
+		assert.NotContains(t, res.Content.HTML, "data:")
+		assert.NotContains(t, res.Content.HTML, "Inline")
+	}
+}
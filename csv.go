@@ -0,0 +1,84 @@
+package recon
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+// DefaultCSVColumns are the columns written by a CSVEncoder when none are specified.
+var DefaultCSVColumns = []string{"url", "title", "description", "image", "status"}
+
+// CSVEncoder writes Results as rows of a CSV (or TSV) document, one row per parsed URL, preceded by a header row.
+//
+// Columns may be any key produced by Result.Flatten (e.g. "site_name" or "images.0.width"), plus a few computed
+// columns:
+//
+//	image   the URL of the best image found on the page
+//	status  "ok" if the URL parsed successfully, "error" otherwise
+//	error   the error message, if the URL failed to parse
+type CSVEncoder struct {
+	w           *csv.Writer
+	columns     []string
+	wroteHeader bool
+}
+
+// NewCSVEncoder returns a CSVEncoder that writes comma-separated rows with the given columns to w. If no columns
+// are given, DefaultCSVColumns is used.
+func NewCSVEncoder(w io.Writer, columns ...string) *CSVEncoder {
+	if len(columns) == 0 {
+		columns = DefaultCSVColumns
+	}
+
+	return &CSVEncoder{
+		w:       csv.NewWriter(w),
+		columns: columns,
+	}
+}
+
+// NewTSVEncoder returns a CSVEncoder that writes tab-separated rows with the given columns to w.
+func NewTSVEncoder(w io.Writer, columns ...string) *CSVEncoder {
+	e := NewCSVEncoder(w, columns...)
+	e.w.Comma = '\t'
+	return e
+}
+
+// Encode writes a row for the result of parsing a single URL. err is the error returned alongside res, if any.
+func (e *CSVEncoder) Encode(res Result, err error) error {
+	if !e.wroteHeader {
+		if err := e.w.Write(e.columns); err != nil {
+			return err
+		}
+		e.wroteHeader = true
+	}
+
+	flat := res.Flatten()
+	row := make([]string, len(e.columns))
+	for i, col := range e.columns {
+		switch col {
+		case "image":
+			row[i] = flat["images.0.url"]
+
+		case "status":
+			row[i] = "ok"
+			if err != nil {
+				row[i] = "error"
+			}
+
+		case "error":
+			if err != nil {
+				row[i] = err.Error()
+			}
+
+		default:
+			row[i] = flat[col]
+		}
+	}
+
+	return e.w.Write(row)
+}
+
+// Flush writes any buffered rows to the underlying writer and reports any error that occurred while writing.
+func (e *CSVEncoder) Flush() error {
+	e.w.Flush()
+	return e.w.Error()
+}
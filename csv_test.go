@@ -0,0 +1,38 @@
+package recon
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCSVEncoder(t *testing.T) {
+	buf := &bytes.Buffer{}
+	enc := NewCSVEncoder(buf)
+
+	assert.Nil(t, enc.Encode(Result{
+		URL:         "https://example.com/",
+		Title:       "Example, Inc.",
+		Description: "An example",
+		Images:      []Image{{URL: "https://example.com/a.png"}},
+	}, nil))
+	assert.Nil(t, enc.Encode(Result{URL: "https://example.com/404"}, errors.New("404 Not Found")))
+	assert.Nil(t, enc.Flush())
+
+	assert.Equal(t, "url,title,description,image,status\n"+
+		"https://example.com/,\"Example, Inc.\",An example,https://example.com/a.png,ok\n"+
+		"https://example.com/404,,,,error\n", buf.String())
+}
+
+func TestTSVEncoderColumns(t *testing.T) {
+	buf := &bytes.Buffer{}
+	enc := NewTSVEncoder(buf, "title", "site_name", "error")
+
+	assert.Nil(t, enc.Encode(Result{Title: "Example", Site: "Example Site"}, nil))
+	assert.Nil(t, enc.Encode(Result{}, errors.New("timeout")))
+	assert.Nil(t, enc.Flush())
+
+	assert.Equal(t, "title\tsite_name\terror\nExample\tExample Site\t\n\t\ttimeout\n", buf.String())
+}
@@ -0,0 +1,132 @@
+package recon
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type timeTag struct {
+	datetime  string
+	published bool
+}
+
+// Sources of Result.PublishedSource.
+const (
+	PublishedSourceMeta   = "meta"
+	PublishedSourceJSONLD = "json-ld"
+	PublishedSourceTime   = "time"
+	PublishedSourceURL    = "url"
+)
+
+// publishedMetaNames are the meta tags that may carry a page's publish date, in order of preference.
+var publishedMetaNames = []string{
+	"article:published_time",
+	"date",
+	"pubdate",
+	"publishdate",
+	"publish-date",
+	"parsely-pub-date",
+	"sailthru.date",
+	"dc.date.issued",
+	"DC.date.issued",
+}
+
+var dateLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+	time.RFC1123Z,
+	time.RFC1123,
+	"January 2, 2006",
+	"Jan 2, 2006",
+}
+
+var urlDatePattern = regexp.MustCompile(`/((?:19|20)\d{2})[/-](0?[1-9]|1[0-2])(?:[/-](0?[1-9]|[12]\d|3[01]))?(?:/|$)`)
+
+// parseDate parses a date in any of the formats commonly used for publish dates.
+func parseDate(s string) (time.Time, bool) {
+	s = strings.TrimSpace(s)
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// dateFromURL finds a date in a URL path like /2015/04/10/ or /2016/10/.
+func dateFromURL(rawURL string) (time.Time, bool) {
+	m := urlDatePattern.FindStringSubmatch(rawURL)
+	if m == nil {
+		return time.Time{}, false
+	}
+
+	year, _ := strconv.Atoi(m[1])
+	month, _ := strconv.Atoi(m[2])
+	day := 1
+	if m[3] != "" {
+		day, _ = strconv.Atoi(m[3])
+	}
+
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC), true
+}
+
+// dateExtractor finds the page's publish date, trying declared metadata first and falling back to heuristics.
+type dateExtractor struct {
+	parser *Parser
+}
+
+func (e dateExtractor) Extract(doc *Document, res *Result) error {
+	set := func(t time.Time, source string) {
+		res.Published = &t
+		res.PublishedSource = source
+	}
+
+	for _, name := range publishedMetaNames {
+		if t, ok := parseDate(doc.MetaContent(name)); ok {
+			set(t, PublishedSourceMeta)
+			return nil
+		}
+	}
+
+	for _, o := range doc.jsonLD() {
+		if t, ok := parseDate(o.str("datePublished")); ok {
+			set(t, PublishedSourceJSONLD)
+			return nil
+		}
+	}
+
+	if !e.parser.heuristics {
+		return nil
+	}
+
+	for _, preferred := range []bool{true, false} {
+		for _, tag := range doc.times {
+			if tag.published != preferred {
+				continue
+			}
+
+			if t, ok := parseDate(tag.datetime); ok {
+				set(t, PublishedSourceTime)
+				return nil
+			}
+		}
+	}
+
+	for _, u := range []string{res.URL, doc.URL.String()} {
+		if t, ok := dateFromURL(u); ok {
+			set(t, PublishedSourceURL)
+			return nil
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package recon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateFromURL(t *testing.T) {
+	d, ok := dateFromURL("https://www.nytimes.com/2015/04/10/arts/television/on-game-of-thrones-season-5-a-change-of-scene.html")
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2015, 4, 10, 0, 0, 0, 0, time.UTC), d)
+
+	d, ok = dateFromURL("https://section411.com/2016/10/running-the-towpath/")
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2016, 10, 1, 0, 0, 0, 0, time.UTC), d)
+
+	_, ok = dateFromURL("https://example.com/products/1234/5678/")
+	assert.False(t, ok)
+}
+
+func TestPublishedSources(t *testing.T) {
+	tests := []struct {
+		path   string
+		head   string
+		body   string
+		source string
+		want   time.Time
+	}{
+		{
+			path:   "/2015/04/10/post",
+			head:   `<meta property="article:published_time" content="2016-01-02T03:04:05Z"><meta name="date" content="2017-01-01">`,
+			source: PublishedSourceMeta,
+			want:   time.Date(2016, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+		{
+			path:   "/2015/04/10/post",
+			head:   `<script type="application/ld+json">{"@context":"https://schema.org","@graph":[{"@type":"NewsArticle","datePublished":"2018-05-06"}]}</script>`,
+			source: PublishedSourceJSONLD,
+			want:   time.Date(2018, 5, 6, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			path:   "/2015/04/10/post",
+			body:   `<time datetime="2020-01-01">Updated</time> <time itemprop="datePublished" datetime="2019-07-08T09:10:11Z">Published</time>`,
+			source: PublishedSourceTime,
+			want:   time.Date(2019, 7, 8, 9, 10, 11, 0, time.UTC),
+		},
+		{
+			path:   "/2015/04/10/post",
+			source: PublishedSourceURL,
+			want:   time.Date(2015, 4, 10, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, test := range tests {
+		srv := newTestServer("text/html", `<html><head><title>Dates</title>`+test.head+`</head><body>`+test.body+`</body></html>`)
+
+		res, err := Parse(srv.URL + test.path)
+		assert.Nil(t, err)
+		if assert.NotNil(t, res.Published, test.source) {
+			assert.True(t, test.want.Equal(*res.Published), test.source)
+		}
+		assert.Equal(t, test.source, res.PublishedSource)
+
+		srv.Close()
+	}
+}
+
+func TestPublishedNoHeuristics(t *testing.T) {
+	srv := newTestServer("text/html", `<html><body><time datetime="2019-07-08">Published</time></body></html>`)
+	defer srv.Close()
+
+	res, err := NewParser().WithHeuristics(false).Parse(srv.URL + "/2015/04/10/post")
+	assert.Nil(t, err)
+	assert.Nil(t, res.Published)
+	assert.Equal(t, "", res.PublishedSource)
+}
@@ -0,0 +1,59 @@
+package recon
+
+import "unicode/utf8"
+
+// DuplicateStrategy decides which value the parser uses when a meta property appears more than once on a page (see
+// WithDuplicateStrategy).
+type DuplicateStrategy string
+
+// Strategies for resolving duplicate meta properties.
+const (
+	// DuplicateFirst uses the first of the values, in document order. It's the default.
+	DuplicateFirst DuplicateStrategy = "first"
+
+	// DuplicateLast uses the last of the values, e.g. for pages whose templates append a page's own tags after the
+	// site's defaults.
+	DuplicateLast DuplicateStrategy = "last"
+
+	// DuplicateLongest uses the longest of the values, or the first of the longest if several are as long.
+	DuplicateLongest DuplicateStrategy = "longest"
+
+	// DuplicateAll uses the first of the values, like DuplicateFirst, and also lists every value in Result.AllValues.
+	DuplicateAll DuplicateStrategy = "all"
+)
+
+// WithDuplicateStrategy sets how the parser resolves a meta property that appears more than once on a page, such as
+// two og:title tags. It applies to the properties of the Result's core fields and to those registered via
+// WithProperties. A property with a higher priority still wins over one with a lower priority, e.g. og:title over
+// title, whatever the strategy. Unknown strategies are ignored.
+func (p *Parser) WithDuplicateStrategy(s DuplicateStrategy) *Parser {
+	switch s {
+	case DuplicateFirst, DuplicateLast, DuplicateLongest, DuplicateAll:
+		p.duplicates = s
+	}
+
+	return p
+}
+
+// pick returns the value the strategy chooses out of values, which are in document order.
+func (s DuplicateStrategy) pick(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	switch s {
+	case DuplicateLast:
+		return values[len(values)-1]
+
+	case DuplicateLongest:
+		longest := values[0]
+		for _, v := range values[1:] {
+			if utf8.RuneCountInString(v) > utf8.RuneCountInString(longest) {
+				longest = v
+			}
+		}
+		return longest
+	}
+
+	return values[0]
+}
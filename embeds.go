@@ -0,0 +1,47 @@
+package recon
+
+import "strings"
+
+// Embed is an <iframe> embedded in a page, such as a map, a video player or a social media widget.
+type Embed struct {
+	// URL is the iframe's src, resolved against the page's URL.
+	URL string `json:"url"`
+
+	// Title is the iframe's title attribute, which describes it to screen readers, e.g. "YouTube video player".
+	Title string `json:"title,omitempty"`
+
+	Width  int `json:"width,omitempty"`
+	Height int `json:"height,omitempty"`
+}
+
+// embedExtractor collects the page's iframes into Result.Embeds.
+type embedExtractor struct{}
+
+func (embedExtractor) Extract(doc *Document, res *Result) error {
+	var embeds []Embed
+	seen := map[string]bool{}
+
+	for _, frame := range doc.iframes {
+		// iframes that are filled in by script, rather than loaded, have nothing to report
+		if lower := strings.ToLower(frame.src); strings.HasPrefix(lower, "javascript:") || strings.HasPrefix(lower, "data:") {
+			continue
+		}
+
+		u := doc.resolve(frame.src)
+		if seen[u] {
+			continue
+		}
+		seen[u] = true
+
+		embeds = append(embeds, Embed{
+			URL:    u,
+			Title:  collapseWhitespace(frame.title),
+			Width:  frame.width,
+			Height: frame.height,
+		})
+	}
+
+	res.Embeds = embeds
+
+	return nil
+}
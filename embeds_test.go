@@ -0,0 +1,36 @@
+package recon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmbeds(t *testing.T) {
+	srv := newTestServer("text/html", `<html><head></head><body>
+		<iframe src="https://www.google.com/maps/embed?pb=abc" width="600" height="450" title="Map of
+			the venue"></iframe>
+		<iframe width="560" height="315" src="https://www.youtube.com/embed/dQw4w9WgXcQ" title="YouTube video player"></iframe>
+		<iframe src="about:blank" data-src="/widgets/poll"></iframe>
+		<iframe src="javascript:void(0)"></iframe>
+		<iframe src="https://www.youtube.com/embed/dQw4w9WgXcQ"></iframe>
+		<iframe></iframe>
+	</body></html>`)
+	defer srv.Close()
+
+	res, err := Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, []Embed{
+		{URL: "https://www.google.com/maps/embed?pb=abc", Title: "Map of the venue", Width: 600, Height: 450},
+		{URL: "https://www.youtube.com/embed/dQw4w9WgXcQ", Title: "YouTube video player", Width: 560, Height: 315},
+		{URL: srv.URL + "/widgets/poll"},
+	}, res.Embeds)
+	assert.Len(t, res.Videos, 1)
+
+	srv = newTestServer("text/html", `<html><head></head><body><p>No embeds</p></body></html>`)
+	defer srv.Close()
+
+	res, err = Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Nil(t, res.Embeds)
+}
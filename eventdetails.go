@@ -0,0 +1,113 @@
+package recon
+
+import (
+	"strings"
+	"time"
+)
+
+// EventDetails describes an event declared on the page via schema.org Event JSON-LD or microdata. (Event describes
+// something that happened while parsing a page.)
+type EventDetails struct {
+	Name  string     `json:"name,omitempty"`
+	Start *time.Time `json:"start,omitempty"`
+	End   *time.Time `json:"end,omitempty"`
+
+	// Venue is where the event takes place. Online events have a venue with only a URL.
+	Venue *Venue `json:"venue,omitempty"`
+
+	// TicketURL is where tickets for the event are sold, from its first offer with a URL.
+	TicketURL string `json:"ticket_url,omitempty"`
+}
+
+// Venue is the place an event takes place.
+type Venue struct {
+	Name string `json:"name,omitempty"`
+
+	// Address is the venue's postal address, formatted on one line.
+	Address string `json:"address,omitempty"`
+
+	// URL is the venue's URL, or the URL to attend an online event at.
+	URL string `json:"url,omitempty"`
+}
+
+// eventTypes are schema.org's Event type and its subtypes.
+var eventTypes = []string{
+	"Event", "BusinessEvent", "ChildrensEvent", "ComedyEvent", "CourseInstance", "DanceEvent", "DeliveryEvent",
+	"EducationEvent", "EventSeries", "ExhibitionEvent", "Festival", "FoodEvent", "Hackathon", "LiteraryEvent",
+	"MusicEvent", "PublicationEvent", "SaleEvent", "ScreeningEvent", "SocialEvent", "SportsEvent", "TheaterEvent",
+	"VisualArtsEvent",
+}
+
+// ldVenue reads an event's location, which may be a Place, a VirtualLocation or just text.
+func ldVenue(v interface{}) *Venue {
+	if s, ok := v.(string); ok {
+		if s = strings.TrimSpace(s); s != "" {
+			return &Venue{Name: s}
+		}
+		return nil
+	}
+
+	objs := ldObjects(v)
+	if len(objs) == 0 {
+		return nil
+	}
+
+	o := objs[0]
+	venue := &Venue{Name: o.str("name"), URL: o.str("url"), Address: ldAddress(o["address"])}
+	if *venue == (Venue{}) {
+		return nil
+	}
+
+	return venue
+}
+
+// ldAddress formats a PostalAddress, or an address given as text, on one line.
+func ldAddress(v interface{}) string {
+	objs := ldObjects(v)
+	if len(objs) == 0 {
+		return collapseWhitespace(ldString(v))
+	}
+
+	var parts []string
+	for _, key := range []string{"streetAddress", "addressLocality", "addressRegion", "postalCode", "addressCountry"} {
+		if part := objs[0].str(key); part != "" {
+			parts = append(parts, part)
+		}
+	}
+
+	return collapseWhitespace(strings.Join(parts, ", "))
+}
+
+// eventExtractor collects the page's schema.org Event into Result.Event.
+type eventExtractor struct{}
+
+func (eventExtractor) Extract(doc *Document, res *Result) error {
+	events := doc.jsonLD(eventTypes...)
+	if len(events) == 0 {
+		return nil
+	}
+
+	o := events[0]
+	e := &EventDetails{
+		Name:  o.str("name"),
+		Venue: ldVenue(o["location"]),
+	}
+
+	if t, ok := parseDate(o.str("startDate")); ok {
+		e.Start = &t
+	}
+	if t, ok := parseDate(o.str("endDate")); ok {
+		e.End = &t
+	}
+
+	for _, offer := range o.objs("offers") {
+		if u := offer.str("url"); u != "" {
+			e.TicketURL = doc.resolve(u)
+			break
+		}
+	}
+
+	res.Event = e
+
+	return nil
+}
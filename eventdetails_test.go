@@ -0,0 +1,75 @@
+package recon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventDetails(t *testing.T) {
+	start := time.Date(2024, 7, 4, 19, 30, 0, 0, time.FixedZone("", -4*60*60))
+	end := time.Date(2024, 7, 4, 23, 0, 0, 0, time.FixedZone("", -4*60*60))
+
+	tests := []struct {
+		name string
+		page string
+		want *EventDetails
+	}{
+		{
+			name: "json-ld",
+			page: `<script type="application/ld+json">{"@context": "https://schema.org", "@type": "MusicEvent",
+				"name": "Summer Concert", "startDate": "2024-07-04T19:30:00-04:00", "endDate": "2024-07-04T23:00:00-04:00",
+				"location": {"@type": "Place", "name": "The Hall", "address": {"@type": "PostalAddress",
+					"streetAddress": "1 Main St", "addressLocality": "Springfield", "addressRegion": "OH"}},
+				"offers": [{"@type": "Offer", "price": "20"}, {"@type": "Offer", "url": "/tickets"}]}</script>`,
+			want: &EventDetails{
+				Name:      "Summer Concert",
+				Start:     &start,
+				End:       &end,
+				Venue:     &Venue{Name: "The Hall", Address: "1 Main St, Springfield, OH"},
+				TicketURL: "/tickets",
+			},
+		},
+		{
+			name: "online",
+			page: `<script type="application/ld+json">{"@type": "Event", "name": "Webinar",
+				"location": {"@type": "VirtualLocation", "url": "https://example.com/live"}}</script>`,
+			want: &EventDetails{Name: "Webinar", Venue: &Venue{URL: "https://example.com/live"}},
+		},
+		{
+			name: "microdata",
+			page: `<div itemscope itemtype="http://schema.org/Event"><h1 itemprop="name">Book Signing</h1>
+				<time itemprop="startDate" datetime="2024-07-04T19:30:00-04:00">July 4</time>
+				<span itemprop="location">The Bookstore</span></div>`,
+			want: &EventDetails{Name: "Book Signing", Start: &start, Venue: &Venue{Name: "The Bookstore"}},
+		},
+	}
+
+	for _, test := range tests {
+		srv := newTestServer("text/html", `<html><head></head><body>`+test.page+`</body></html>`)
+
+		res, err := NewParser().Parse(srv.URL)
+		assert.Nil(t, err, test.name)
+		if test.want != nil && test.want.TicketURL != "" {
+			test.want.TicketURL = srv.URL + test.want.TicketURL
+		}
+		if assert.NotNil(t, res.Event, test.name) {
+			assert.Equal(t, test.want.Name, res.Event.Name, test.name)
+			assert.Equal(t, test.want.Venue, res.Event.Venue, test.name)
+			assert.Equal(t, test.want.TicketURL, res.Event.TicketURL, test.name)
+			assert.True(t, timesEqual(test.want.Start, res.Event.Start), test.name)
+			assert.True(t, timesEqual(test.want.End, res.Event.End), test.name)
+		}
+
+		srv.Close()
+	}
+}
+
+func timesEqual(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Equal(*b)
+}
@@ -0,0 +1,63 @@
+package recon
+
+import "time"
+
+// EventType identifies the kind of an Event.
+type EventType string
+
+const (
+	// EventFetchStarted is emitted when recon starts fetching a page or an image.
+	EventFetchStarted EventType = "fetch_started"
+
+	// EventRedirectFollowed is emitted when recon follows a redirect. URL is the redirect's target and From is the
+	// URL that redirected.
+	EventRedirectFollowed EventType = "redirect_followed"
+
+	// EventTagExtracted is emitted for each targeted meta tag found on a page. Name and Value are the tag's
+	// property and content.
+	EventTagExtracted EventType = "tag_extracted"
+
+	// EventImageRanked is emitted for each image once the page's images have been ranked. Image is the image and
+	// Rank is its position in Result.Images.
+	EventImageRanked EventType = "image_ranked"
+)
+
+// Event describes something that happened while parsing a page.
+type Event struct {
+	Type EventType `json:"type"`
+	Time time.Time `json:"time"`
+
+	// URL is the URL of the page, image or redirect target the event relates to.
+	URL string `json:"url"`
+
+	// From is the URL that issued the redirect, for EventRedirectFollowed.
+	From string `json:"from,omitempty"`
+
+	// Name and Value are the extracted tag's property and content, for EventTagExtracted.
+	Name  string `json:"name,omitempty"`
+	Value string `json:"value,omitempty"`
+
+	// Image and Rank are the ranked image and its position, for EventImageRanked.
+	Image *Image `json:"image,omitempty"`
+	Rank  int    `json:"rank,omitempty"`
+}
+
+// WithEventHandler registers a function that's called with an Event at each notable step of a parse, which is useful
+// for building debugging tools and audit trails. The handler may be called from multiple goroutines at once and
+// should return quickly.
+func (p *Parser) WithEventHandler(fn func(Event)) *Parser {
+	p.events = fn
+	return p
+}
+
+func emit(handler func(Event), e Event) {
+	if handler == nil {
+		return
+	}
+
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+
+	handler(e)
+}
@@ -0,0 +1,77 @@
+package recon
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventHandler(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.Handle("/start", http.RedirectHandler("/page", http.StatusFound))
+	mux.HandleFunc("/page", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `<html><head>
+			<meta property="og:title" content="Events">
+			<meta property="og:image" content="%s">
+		</head></html>`, obnoxiouslyLongDataURL)
+	})
+
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	mu := sync.Mutex{}
+	events := []Event{}
+	p := NewParser().WithEventHandler(func(e Event) {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, e)
+	})
+
+	_, err := p.Parse(srv.URL + "/start")
+	assert.Nil(t, err)
+
+	types := []EventType{}
+	for _, e := range events {
+		types = append(types, e.Type)
+		assert.False(t, e.Time.IsZero())
+	}
+	assert.Equal(t, []EventType{
+		EventFetchStarted,
+		EventRedirectFollowed,
+		EventTagExtracted,
+		EventTagExtracted,
+		EventImageRanked,
+	}, types)
+
+	assert.Equal(t, srv.URL+"/start", events[1].From)
+	assert.Equal(t, srv.URL+"/page", events[1].URL)
+	assert.Equal(t, "og:title", events[2].Name)
+	assert.Equal(t, "Events", events[2].Value)
+	assert.Equal(t, 500, events[4].Image.Width)
+}
+
+func TestRedirectChain(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.Handle("/short", http.RedirectHandler("/long", http.StatusMovedPermanently))
+	mux.Handle("/long", http.RedirectHandler("/final", http.StatusFound))
+	mux.HandleFunc("/final", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<html><head><title>Final</title></head></html>`))
+	})
+
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	res, err := Parse(srv.URL + "/short")
+	assert.Nil(t, err)
+	assert.Equal(t, "Final", res.Title)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, []string{srv.URL + "/short", srv.URL + "/long"}, res.Redirects)
+
+	res, err = Parse(srv.URL + "/final")
+	assert.Nil(t, err)
+	assert.Nil(t, res.Redirects)
+}
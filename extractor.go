@@ -0,0 +1,286 @@
+package recon
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+)
+
+// Extractor populates a Result from a tokenized Document. A Parser runs its extractors in order, so each extractor
+// can read and override the fields set by the extractors before it.
+type Extractor interface {
+	Extract(*Document, *Result) error
+}
+
+// ExtractorFunc adapts an ordinary function to the Extractor interface.
+type ExtractorFunc func(*Document, *Result) error
+
+// Extract calls f(doc, res).
+func (f ExtractorFunc) Extract(doc *Document, res *Result) error {
+	return f(doc, res)
+}
+
+// Document holds the information collected from a page while it was tokenized.
+type Document struct {
+	// URL is the URL the page was requested from.
+	URL *url.URL
+
+	// Response is the HTTP response the page was read from. Its body has already been consumed.
+	Response *http.Response
+
+	// Meta contains every <meta> tag on the page that has a property or name attribute, in document order.
+	Meta []Meta
+
+	metaTags       []metaTag
+	imgTags        []imgTag
+	links          []link
+	h1             string
+	paragraph      string
+	wordCount      int
+	ld             []ldObject
+	times          []timeTag
+	authorLinks    []Author
+	byline         string
+	assets         []string
+	lang           string
+	alternates     []alternate
+	stats          *statsRecorder
+	oembed         string
+	iframes        []iframe
+	audio          []Audio
+	paywallMarkers int
+	raw            *bytes.Buffer
+	content        *Content
+
+	// base is the page's <base href>, if it has one, which relative URLs on the page are resolved against.
+	base *url.URL
+}
+
+// release returns the document's pooled buffers once the page has been extracted.
+func (d *Document) release() {
+	putBuffer(d.raw)
+	d.raw = nil
+}
+
+// Meta is a <meta> tag found on a page.
+type Meta struct {
+	// Name is the tag's property or name attribute.
+	Name string
+
+	// Content is the tag's content attribute.
+	Content string
+}
+
+// MetaContent returns the content of the first <meta> tag with the given property or name, or an empty string if
+// there isn't one.
+func (d *Document) MetaContent(name string) string {
+	for _, m := range d.Meta {
+		if m.Name == name {
+			return m.Content
+		}
+	}
+
+	return ""
+}
+
+// MetaContents returns the content of every <meta> tag with the given property or name, in document order.
+func (d *Document) MetaContents(name string) []string {
+	var out []string
+	for _, m := range d.Meta {
+		if m.Name == name {
+			out = append(out, m.Content)
+		}
+	}
+
+	return out
+}
+
+func (d *Document) context() context.Context {
+	if d.Response != nil && d.Response.Request != nil {
+		return d.Response.Request.Context()
+	}
+
+	return context.Background()
+}
+
+// baseURL returns the URL that relative URLs on the page are resolved against: its <base href>, if it has one, or
+// the document's URL otherwise.
+func (d *Document) baseURL() *url.URL {
+	if d.base != nil {
+		return d.URL.ResolveReference(d.base)
+	}
+
+	return d.URL
+}
+
+// resolve returns href as an absolute URL, resolved against the document's base URL.
+func (d *Document) resolve(href string) string {
+	u, err := url.Parse(href)
+	if err != nil {
+		return href
+	}
+
+	return d.baseURL().ResolveReference(u).String()
+}
+
+// propertyValues returns the values of the meta tags with the highest priority among the properties of the given
+// field of propertyMap, in document order.
+func (d *Document) propertyValues(key string) []string {
+	var values []string
+	maxWeight := 0.0
+
+	for _, searchTag := range propertyMap[key] {
+		for _, tag := range d.metaTags {
+			if tag.name != searchTag {
+				continue
+			}
+
+			if tag.priority > maxWeight {
+				values = values[:0]
+				maxWeight = tag.priority
+			}
+			if tag.priority == maxWeight {
+				values = append(values, tag.value)
+			}
+		}
+	}
+
+	return values
+}
+
+// metaExtractor fills in the Result's core fields from OpenGraph and other targeted meta tags.
+type metaExtractor struct {
+	parser *Parser
+}
+
+func (e metaExtractor) Extract(doc *Document, res *Result) error {
+	var strategy DuplicateStrategy
+	if e.parser != nil {
+		strategy = e.parser.duplicates
+	}
+
+	property := func(key, field string) string {
+		values := doc.propertyValues(key)
+		if strategy == DuplicateAll && len(values) > 0 {
+			if res.AllValues == nil {
+				res.AllValues = map[string][]string{}
+			}
+			res.AllValues[field] = values
+		}
+
+		return strategy.pick(values)
+	}
+
+	if canonicalURLStr := property("URL", "url"); canonicalURLStr != "" {
+		canonicalURL, err := url.Parse(asciiURL(canonicalURLStr))
+		if err == nil {
+			res.URL = canonicalURL.String()
+			res.CanonicalURL = res.URL
+			res.setHost(canonicalURL.Host)
+		}
+	}
+
+	res.Site = property("Site", "site_name")
+	res.Title = property("Title", "title")
+	res.Type = property("Type", "type")
+	res.Description = property("Description", "description")
+	res.Author = property("Author", "author")
+	res.Publisher = property("Publisher", "publisher")
+
+	res.Section = doc.MetaContent("article:section")
+	if res.Section == "" {
+		for _, o := range doc.jsonLD() {
+			if res.Section = o.str("articleSection"); res.Section != "" {
+				break
+			}
+		}
+	}
+
+	values := map[string][]string{}
+	for _, tag := range doc.metaTags {
+		if _, builtin := targetedProperties[tag.name]; builtin || tag.name == "" {
+			continue
+		}
+
+		values[tag.name] = append(values[tag.name], tag.value)
+	}
+
+	for name, v := range values {
+		if res.Extras == nil {
+			res.Extras = map[string]string{}
+		}
+		res.Extras[name] = strategy.pick(v)
+
+		if strategy == DuplicateAll {
+			if res.AllValues == nil {
+				res.AllValues = map[string][]string{}
+			}
+			res.AllValues[name] = v
+		}
+	}
+
+	return nil
+}
+
+// heuristicExtractor fills in fields that the page's metadata left empty using clues from the page's content.
+type heuristicExtractor struct {
+	parser *Parser
+}
+
+func (e heuristicExtractor) Extract(doc *Document, res *Result) error {
+	if !e.parser.heuristics {
+		return nil
+	}
+
+	if res.Title == "" {
+		res.Title = doc.h1
+	}
+
+	if res.Description == "" && e.parser.descriptionFallback {
+		res.Description = truncateWords(doc.paragraph, maxFallbackDescriptionLength)
+	}
+
+	return nil
+}
+
+// textExtractor fills in statistics about the page's body text.
+type textExtractor struct{}
+
+func (textExtractor) Extract(doc *Document, res *Result) error {
+	if doc.wordCount > 0 {
+		res.WordCount = doc.wordCount
+		res.ReadingTime = (doc.wordCount + wordsPerMinute - 1) / wordsPerMinute
+	}
+
+	return nil
+}
+
+// imageExtractor downloads and analyzes the page's candidate images and ranks them into Result.Images.
+type imageExtractor struct {
+	parser *Parser
+}
+
+func (e imageExtractor) Extract(doc *Document, res *Result) error {
+	if !e.parser.images {
+		res.Images = []Image{}
+		return nil
+	}
+
+	tags := doc.imageCandidates()
+	if e.parser.maxImages > 0 && len(tags) > e.parser.maxImages {
+		tags = append([]imgTag{}, tags...)
+		sort.SliceStable(tags, func(a, b int) bool {
+			return tags[a].tier > tags[b].tier
+		})
+		tags = tags[:e.parser.maxImages]
+	}
+
+	start := time.Now()
+	res.Images = e.parser.analyzeImages(doc.context(), doc.baseURL(), tags, doc.stats)
+	e.parser.proxyImages(res.Images)
+	doc.stats.observeImages(start, len(doc.imgTags), measuredImages(res.Images))
+	return nil
+}
@@ -0,0 +1,278 @@
+package recon
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithExtractors(t *testing.T) {
+	srv := newTestServer("text/html", `<html><head>
+		<title>Example Post | Example</title>
+		<meta name="sailthru.author" content="Jane Doe">
+		<meta property="og:description" content="A description">
+	</head></html>`)
+	defer srv.Close()
+
+	p := NewParser().WithExtractors(
+		ExtractorFunc(func(doc *Document, res *Result) error {
+			res.Author = doc.MetaContent("sailthru.author")
+			return nil
+		}),
+		ExtractorFunc(func(doc *Document, res *Result) error {
+			res.Title = strings.TrimSuffix(res.Title, " | Example")
+			return nil
+		}),
+	)
+
+	res, err := p.Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "Example Post", res.Title)
+	assert.Equal(t, "Jane Doe", res.Author)
+	assert.Equal(t, "A description", res.Description)
+}
+
+func TestExtractorError(t *testing.T) {
+	srv := newTestServer("text/html", `<html><head><title>Test</title></head></html>`)
+	defer srv.Close()
+
+	p := NewParser().WithExtractors(ExtractorFunc(func(doc *Document, res *Result) error {
+		return errors.New("boom")
+	}))
+
+	_, err := p.Parse(srv.URL)
+	assert.NotNil(t, err)
+}
+
+func TestH1TitleFallback(t *testing.T) {
+	srv := newTestServer("text/html", `<html><head></head><body>
+		<h1><img src="data:,"></h1>
+		<h1>
+			<a href="/">Landing   Page</a>
+		</h1>
+		<h1>Second Heading</h1>
+	</body></html>`)
+	defer srv.Close()
+
+	res, err := Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "Landing Page", res.Title)
+
+	res, err = NewParser().WithHeuristics(false).Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "", res.Title)
+}
+
+func TestDescriptionFallback(t *testing.T) {
+	paragraph := "The towpath trail runs for more than eighty miles along the old canal, and most of it is flat, shaded and quiet. "
+	srv := newTestServer("text/html", `<html><head><title>Towpath</title></head><body>
+		<p class="byline">By Jane Doe</p>
+		<p>`+paragraph+paragraph+paragraph+`</p>
+	</body></html>`)
+	defer srv.Close()
+
+	res, err := Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "", res.Description)
+
+	res, err = NewParser().WithDescriptionFallback().Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(res.Description, "The towpath trail runs"))
+	assert.True(t, strings.HasSuffix(res.Description, "…"))
+	assert.True(t, len([]rune(res.Description)) <= maxFallbackDescriptionLength)
+
+	res, err = NewParser().WithDescriptionFallback().WithHeuristics(false).Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "", res.Description)
+}
+
+func TestItempropMeta(t *testing.T) {
+	tests := []struct {
+		name        string
+		head        string
+		title       string
+		description string
+	}{
+		{
+			name: "itemprop only",
+			head: `<meta itemprop="name" content="Google Title">
+				<meta itemprop="description" content="Google description">`,
+			title:       "Google Title",
+			description: "Google description",
+		},
+		{
+			name: "og wins",
+			head: `<meta itemprop="name" content="Google Title">
+				<meta property="og:title" content="OG Title">
+				<meta itemprop="description" content="Google description">
+				<meta name="description" content="Meta description">`,
+			title:       "OG Title",
+			description: "Meta description",
+		},
+		{
+			name:        "title element wins",
+			head:        `<title>Page Title</title><meta itemprop="name headline" content="Google Title">`,
+			title:       "Page Title",
+			description: "",
+		},
+		{
+			name:        "not a meta tag",
+			head:        `<link itemprop="name" href="/name">`,
+			title:       "",
+			description: "",
+		},
+	}
+
+	for _, test := range tests {
+		srv := newTestServer("text/html", `<html itemscope itemtype="https://schema.org/WebPage"><head>`+test.head+`</head></html>`)
+
+		res, err := NewParser().WithHeuristics(false).Parse(srv.URL)
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, test.title, res.Title, test.name)
+		assert.Equal(t, test.description, res.Description, test.name)
+		assert.Nil(t, res.Extras, test.name)
+
+		srv.Close()
+	}
+}
+
+func TestSection(t *testing.T) {
+	tests := []struct {
+		head string
+		want string
+	}{
+		{`<meta property="article:section" content="Sports">`, "Sports"},
+		{`<script type="application/ld+json">{"@type": "NewsArticle", "articleSection": ["Tech", "Gadgets"]}</script>`, "Tech"},
+		{`<meta property="article:section" content="Sports">
+			<script type="application/ld+json">{"@type": "NewsArticle", "articleSection": "Local"}</script>`, "Sports"},
+		{`<meta property="og:type" content="article">`, ""},
+	}
+
+	for _, test := range tests {
+		srv := newTestServer("text/html", `<html><head>`+test.head+`</head><body></body></html>`)
+
+		res, err := Parse(srv.URL)
+		assert.Nil(t, err)
+		assert.Equal(t, test.want, res.Section)
+
+		srv.Close()
+	}
+}
+
+func TestWordCount(t *testing.T) {
+	body := strings.Repeat("word ", 500)
+	srv := newTestServer("text/html", `<html><head><title>Not counted</title>
+		<style>body { color: red; }</style>
+	</head><body>
+		<script>var notCounted = "at all";</script>
+		<p>`+body+`</p>
+		<p>Five more words right here.</p>
+	</body></html>`)
+	defer srv.Close()
+
+	res, err := Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, res.WordCount)
+	assert.Equal(t, 0, res.ReadingTime)
+
+	res, err = NewParser().WithWordCount().Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, 505, res.WordCount)
+	assert.Equal(t, 3, res.ReadingTime)
+}
+
+func TestPartialResult(t *testing.T) {
+	srv := newTestServer("text/html", `<html><head>
+		<title>Partial</title>
+		<meta property="og:description" content="Still here">
+	</head><body><p>`+strings.Repeat("x", 4096)+`</p></body></html>`)
+	defer srv.Close()
+
+	res, err := NewParser().WithTokenMaxBuffer(1024).Parse(srv.URL)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Partial", res.Title)
+	assert.Equal(t, "Still here", res.Description)
+
+	res, err = NewParser().WithExtractors(ExtractorFunc(func(doc *Document, res *Result) error {
+		return errors.New("boom")
+	})).Parse(srv.URL)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Partial", res.Title)
+}
+
+func TestImageAnalysisOptions(t *testing.T) {
+	srv := newTestServer("text/html", `<html><head>
+		<meta property="og:image" content="`+obnoxiouslyLongDataURL+`">
+	</head><body>
+		<img src="`+obnoxiouslyLongDataURL+`" alt="one">
+		<img src="`+obnoxiouslyLongDataURL+`" alt="two">
+		<img src="`+obnoxiouslyLongDataURL+`" alt="three">
+	</body></html>`)
+	defer srv.Close()
+
+	res, err := NewParser().Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Len(t, res.Images, 4)
+
+	res, err = NewParser().WithMaxImages(2).Parse(srv.URL)
+	assert.Nil(t, err)
+	if assert.Len(t, res.Images, 2) {
+		assert.True(t, res.Images[0].Preferred)
+	}
+
+	res, err = NewParser().WithImageAnalysis(false).Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Empty(t, res.Images)
+}
+
+// newImageServer serves page at / and, at each path in sizes, a PNG of that width and height.
+func newImageServer(page string, sizes map[string][2]int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		size, ok := sizes[r.URL.Path]
+		if !ok {
+			w.Header().Set("Content-Type", "text/html")
+			w.Write([]byte(page))
+			return
+		}
+
+		buf := &bytes.Buffer{}
+		png.Encode(buf, image.NewGray(image.Rect(0, 0, size[0], size[1])))
+		w.Header().Set("Content-Type", "image/png")
+		w.Write(buf.Bytes())
+	}))
+}
+
+func TestOGImageOrder(t *testing.T) {
+	srv := newImageServer(`<html><head>
+		<meta property="og:image" content="/square.png">
+		<meta property="og:image" content="/wide.png">
+		<meta property="og:image" content="/tall.png">
+	</head><body><img src="/body.png"></body></html>`, map[string][2]int{
+		"/square.png": {100, 100},
+		"/wide.png":   {191, 100},
+		"/tall.png":   {100, 300},
+		"/body.png":   {191, 100},
+	})
+	defer srv.Close()
+
+	res, err := Parse(srv.URL)
+	assert.Nil(t, err)
+	if assert.Len(t, res.Images, 4) {
+		for i, want := range []string{"/square.png", "/wide.png", "/tall.png"} {
+			assert.Equal(t, srv.URL+want, res.Images[i].URL)
+			if assert.NotNil(t, res.Images[i].OGIndex) {
+				assert.Equal(t, i, *res.Images[i].OGIndex)
+			}
+		}
+
+		assert.Equal(t, srv.URL+"/body.png", res.Images[3].URL)
+		assert.Nil(t, res.Images[3].OGIndex)
+	}
+}
@@ -0,0 +1,50 @@
+package recon
+
+import "strings"
+
+// Facebook holds the page's fb: meta tags, which tie the page to a Facebook app and pages for insights and
+// moderation.
+type Facebook struct {
+	AppID string `json:"app_id,omitempty"`
+
+	// Pages are the IDs of the Facebook pages the page belongs to, from fb:pages.
+	Pages []string `json:"pages,omitempty"`
+
+	// Admins are the IDs of the Facebook users who administer the page, from fb:admins.
+	Admins []string `json:"admins,omitempty"`
+
+	// ProfileID is the Facebook ID of the page's subject, from fb:profile_id.
+	ProfileID string `json:"profile_id,omitempty"`
+}
+
+// splitIDs splits a list of IDs given in one tag, separated by commas, or across several tags.
+func splitIDs(values []string) []string {
+	var out []string
+	for _, v := range values {
+		for _, id := range strings.Split(v, ",") {
+			if id = strings.TrimSpace(id); id != "" {
+				out = append(out, id)
+			}
+		}
+	}
+
+	return out
+}
+
+// facebookExtractor collects the page's fb: meta tags into Result.Facebook.
+type facebookExtractor struct{}
+
+func (facebookExtractor) Extract(doc *Document, res *Result) error {
+	fb := Facebook{
+		AppID:     strings.TrimSpace(doc.MetaContent("fb:app_id")),
+		Pages:     splitIDs(doc.MetaContents("fb:pages")),
+		Admins:    splitIDs(doc.MetaContents("fb:admins")),
+		ProfileID: strings.TrimSpace(doc.MetaContent("fb:profile_id")),
+	}
+
+	if fb.AppID != "" || len(fb.Pages) > 0 || len(fb.Admins) > 0 || fb.ProfileID != "" {
+		res.Facebook = &fb
+	}
+
+	return nil
+}
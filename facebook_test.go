@@ -0,0 +1,28 @@
+package recon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFacebook(t *testing.T) {
+	srv := newTestServer("text/html", `<html><head>
+		<meta property="fb:app_id" content=" 1234567890 ">
+		<meta property="fb:pages" content="111, 222">
+		<meta property="fb:pages" content="333">
+		<meta property="fb:admins" content="999">
+	</head><body></body></html>`)
+	defer srv.Close()
+
+	res, err := Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, &Facebook{AppID: "1234567890", Pages: []string{"111", "222", "333"}, Admins: []string{"999"}}, res.Facebook)
+
+	srv2 := newTestServer("text/html", `<html><head><meta property="og:title" content="No fb tags"></head></html>`)
+	defer srv2.Close()
+
+	res, err = Parse(srv2.URL)
+	assert.Nil(t, err)
+	assert.Nil(t, res.Facebook)
+}
@@ -0,0 +1,64 @@
+package recon
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/html"
+)
+
+// WithDOMFallback makes the parser re-read pages that its streaming tokenizer fails on, e.g. because a huge inline
+// script overflows the buffer set via WithTokenMaxBuffer, or that it finds no metadata in. The whole page is parsed
+// into a tree with html.Parse, which repairs broken markup like unclosed tags the way a browser would, and the
+// repaired page is read again, without a buffer limit. Pages that stop being read early, e.g. via WithHeadOnly,
+// aren't re-read.
+func (p *Parser) WithDOMFallback() *Parser {
+	p.domFallback = true
+	return p
+}
+
+// sparse reports whether the document is suspiciously bare: it has no title, meta properties or JSON-LD.
+func (d *Document) sparse() bool {
+	for _, tag := range d.metaTags {
+		if tag.name != "" && tag.value != "" {
+			return false
+		}
+	}
+
+	return len(d.ld) == 0
+}
+
+// fallback reads the rest of the page, parses all of it with html.Parse and tokenizes the repaired page into a new
+// Document, which replaces the job's if it's read successfully.
+func (p *parseJob) fallback() error {
+	raw := p.doc.raw
+	if raw == nil {
+		return errors.New("page wasn't kept")
+	}
+
+	// the rest of the page is added to raw as it's read
+	if _, err := io.Copy(io.Discard, p.response.Body); err != nil {
+		return errors.Wrap(err, "read page")
+	}
+
+	root, err := html.Parse(bytes.NewReader(raw.Bytes()))
+	if err != nil {
+		return errors.Wrap(err, "parse page")
+	}
+
+	repaired := getBuffer()
+	defer putBuffer(repaired)
+	if err := html.Render(repaired, root); err != nil {
+		return errors.Wrap(err, "render page")
+	}
+
+	original := p.doc
+	p.doc = &Document{URL: original.URL, Response: original.Response, stats: original.stats, raw: raw}
+	if err := p.tokenizeFrom(repaired, 0); err != nil {
+		p.doc = original
+		return errors.Wrap(err, "tokenize repaired page")
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package recon
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDOMFallback(t *testing.T) {
+	// the inline script is a single token larger than the tokenizer's buffer
+	srv := newTestServer("text/html", `<html><head><script>`+strings.Repeat("var x = 1;", 1000)+`</script>
+		<title>Recovered</title>
+		<meta property="og:description" content="Found by the fallback">
+	</head><body><p>Text</p></body></html>`)
+	defer srv.Close()
+
+	_, err := NewParser().WithTokenMaxBuffer(1024).Parse(srv.URL)
+	assert.NotNil(t, err)
+
+	res, err := NewParser().WithTokenMaxBuffer(1024).WithDOMFallback().Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "Recovered", res.Title)
+	assert.Equal(t, "Found by the fallback", res.Description)
+	assert.Nil(t, res.Content)
+}
+
+func TestDocumentSparse(t *testing.T) {
+	tests := []struct {
+		doc  *Document
+		want bool
+	}{
+		{&Document{}, true},
+		{&Document{metaTags: []metaTag{{}, {name: "title"}}}, true},
+		{&Document{metaTags: []metaTag{{name: "title", value: "Title"}}}, false},
+		{&Document{ld: []ldObject{{"@type": "Article"}}}, false},
+	}
+
+	for i, test := range tests {
+		assert.Equal(t, test.want, test.doc.sparse(), "%d", i)
+	}
+}
@@ -0,0 +1,64 @@
+package recon
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+)
+
+// Flatten returns the Result as a single-level map with dotted keys (e.g. "title", "images.0.url"), which is
+// convenient for templating systems, CSV exports and key-value stores. Keys and values mirror the Result's JSON
+// encoding: nested objects and arrays are expanded into dotted paths, and fields omitted from the JSON are omitted
+// from the map.
+func (r Result) Flatten() map[string]string {
+	out := map[string]string{}
+
+	buf, err := json.Marshal(r)
+	if err != nil {
+		return out
+	}
+
+	var v interface{}
+	dec := json.NewDecoder(bytes.NewReader(buf))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return out
+	}
+
+	flattenInto(out, "", v)
+
+	return out
+}
+
+func flattenInto(out map[string]string, prefix string, v interface{}) {
+	join := func(key string) string {
+		if prefix == "" {
+			return key
+		}
+		return prefix + "." + key
+	}
+
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, child := range val {
+			flattenInto(out, join(k), child)
+		}
+
+	case []interface{}:
+		for i, child := range val {
+			flattenInto(out, join(strconv.Itoa(i)), child)
+		}
+
+	case json.Number:
+		out[prefix] = val.String()
+
+	case string:
+		out[prefix] = val
+
+	case bool:
+		out[prefix] = strconv.FormatBool(val)
+
+	case nil:
+		out[prefix] = ""
+	}
+}
@@ -0,0 +1,45 @@
+package recon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	res := Result{
+		URL:   "https://example.com/post",
+		Host:  "example.com",
+		Title: "Example",
+		Images: []Image{
+			{
+				URL:         "https://example.com/a.png",
+				Type:        "image/png",
+				Width:       200,
+				Height:      100,
+				AspectRatio: 2,
+				Preferred:   true,
+			},
+			{
+				URL: "https://example.com/b.png",
+			},
+		},
+		Scraped: time.Date(2016, 10, 1, 12, 0, 0, 0, time.UTC),
+	}
+
+	flat := res.Flatten()
+
+	assert.Equal(t, "https://example.com/post", flat["url"])
+	assert.Equal(t, "Example", flat["title"])
+	assert.Equal(t, "", flat["description"])
+	assert.Equal(t, "https://example.com/a.png", flat["images.0.url"])
+	assert.Equal(t, "200", flat["images.0.width"])
+	assert.Equal(t, "2", flat["images.0.aspectRatio"])
+	assert.Equal(t, "true", flat["images.0.preferred"])
+	assert.Equal(t, "https://example.com/b.png", flat["images.1.url"])
+	assert.Equal(t, "2016-10-01T12:00:00Z", flat["scraped"])
+
+	_, ok := flat["images.1.preferred"]
+	assert.False(t, ok, "omitted JSON fields should be omitted from the flattened map")
+}
@@ -0,0 +1,65 @@
+package recon
+
+import (
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ErrHostNotAllowed is returned when a page, a redirect or an image is on a host the parser isn't allowed to fetch
+// from (see WithAllowedHosts and WithBlockedHosts).
+var ErrHostNotAllowed = errors.New("host not allowed")
+
+// hostRules are the hosts the parser may fetch from, as patterns (see matchHost).
+type hostRules struct {
+	allowed []string
+	blocked []string
+}
+
+// WithAllowedHosts restricts the parser to fetching pages, redirects and images from hosts matching any of patterns.
+// A pattern is either a glob like "*.example.com", or a domain like "example.com" that matches itself and all of its
+// subdomains. Requests to other hosts fail with ErrHostNotAllowed, and images on them are left out of the Result.
+func (p *Parser) WithAllowedHosts(patterns ...string) *Parser {
+	p.hosts.allowed = append(append([]string{}, p.hosts.allowed...), patterns...)
+	return p
+}
+
+// WithBlockedHosts stops the parser from fetching pages, redirects and images from hosts matching any of patterns,
+// which are given as for WithAllowedHosts. Blocked hosts take precedence over allowed ones.
+func (p *Parser) WithBlockedHosts(patterns ...string) *Parser {
+	p.hosts.blocked = append(append([]string{}, p.hosts.blocked...), patterns...)
+	return p
+}
+
+// matchHost returns whether host matches any of patterns. Internationalized hosts and patterns are compared in their
+// ASCII form.
+func matchHost(patterns []string, host string) bool {
+	host = hostToASCII(strings.TrimSuffix(strings.ToLower(host), "."))
+	for _, pattern := range patterns {
+		pattern = hostToASCII(strings.TrimSuffix(strings.ToLower(pattern), "."))
+		if strings.ContainsAny(pattern, "*?[") {
+			if ok, _ := path.Match(pattern, host); ok {
+				return true
+			}
+			continue
+		}
+
+		if pattern = strings.TrimPrefix(pattern, "."); host == pattern || strings.HasSuffix(host, "."+pattern) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// check returns ErrHostNotAllowed if the rules don't allow fetching u.
+func (r hostRules) check(u *url.URL) error {
+	host := u.Hostname()
+	if matchHost(r.blocked, host) || (len(r.allowed) > 0 && !matchHost(r.allowed, host)) {
+		return errors.Wrapf(ErrHostNotAllowed, "%s", host)
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package recon
+
+import (
+	"image"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchHost(t *testing.T) {
+	tests := []struct {
+		patterns []string
+		host     string
+		want     bool
+	}{
+		{[]string{"example.com"}, "example.com", true},
+		{[]string{"example.com"}, "news.Example.com", true},
+		{[]string{"example.com"}, "badexample.com", false},
+		{[]string{".example.com"}, "cdn.example.com", true},
+		{[]string{"*.example.com"}, "example.com", false},
+		{[]string{"*.example.com"}, "cdn.example.com", true},
+		{[]string{"img?.example.com"}, "img2.example.com", true},
+		{[]string{"bücher.example"}, "xn--bcher-kva.example", true},
+		{[]string{"other.com", "example.com"}, "example.com.", true},
+		{nil, "example.com", false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.want, matchHost(test.patterns, test.host), "%v %s", test.patterns, test.host)
+	}
+}
+
+func TestAllowedHosts(t *testing.T) {
+	srv := newImageServer(`<html><head><title>Allowed</title></head></html>`, nil)
+	defer srv.Close()
+
+	res, err := NewParser().WithAllowedHosts("127.0.0.1").Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "Allowed", res.Title)
+
+	_, err = NewParser().WithAllowedHosts("example.com").Parse(srv.URL)
+	assert.True(t, errors.Is(err, ErrHostNotAllowed))
+
+	_, err = NewParser().WithAllowedHosts("127.0.0.1").WithBlockedHosts("127.0.0.*").Parse(srv.URL)
+	assert.True(t, errors.Is(err, ErrHostNotAllowed))
+}
+
+func TestBlockedHostRedirect(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/away" {
+			// send the request to the same server under another name
+			http.Redirect(w, r, "http://localhost:"+r.Host[strings.LastIndex(r.Host, ":")+1:]+"/", http.StatusFound)
+			return
+		}
+
+		w.Write([]byte(`<html><head><title>Landed</title></head></html>`))
+	}))
+	defer srv.Close()
+
+	res, err := NewParser().Parse(srv.URL + "/away")
+	assert.Nil(t, err)
+	assert.Equal(t, "Landed", res.Title)
+
+	_, err = NewParser().WithBlockedHosts("localhost").Parse(srv.URL + "/away")
+	assert.True(t, errors.Is(err, ErrHostNotAllowed))
+}
+
+func TestBlockedHostImages(t *testing.T) {
+	var page string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/" {
+			w.Write([]byte(page))
+			return
+		}
+
+		w.Header().Set("Content-Type", "image/png")
+		png.Encode(w, image.NewGray(image.Rect(0, 0, 100, 100)))
+	}))
+	defer srv.Close()
+
+	// a.png is on the same server under another name
+	page = `<html><head>
+		<meta property="og:image" content="http://localhost:` + srv.URL[strings.LastIndex(srv.URL, ":")+1:] + `/a.png">
+	</head><body><img src="/b.png"></body></html>`
+
+	res, err := NewParser().Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Len(t, res.Images, 2)
+
+	res, err = NewParser().WithBlockedHosts("localhost").Parse(srv.URL)
+	assert.Nil(t, err)
+	if assert.Len(t, res.Images, 1) {
+		assert.Equal(t, srv.URL+"/b.png", res.Images[0].URL)
+	}
+}
@@ -0,0 +1,206 @@
+package recon
+
+import (
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ErrTooManyRedirects is returned when a page or image redirects more times than the parser allows.
+var ErrTooManyRedirects = errors.New("too many redirects")
+
+// DefaultMaxRedirects is the number of redirects a parser follows by default.
+const DefaultMaxRedirects = 10
+
+// WithMaxRedirects sets the maximum number of redirects the parser follows for a single page or image request.
+// Requests that redirect more often fail with ErrTooManyRedirects; a limit of 0 disables redirects entirely.
+func (p *Parser) WithMaxRedirects(n int) *Parser {
+	p.maxRedirects = n
+	return p
+}
+
+// ErrNotHTML is returned when a page's Content-Type isn't one the parser is willing to tokenize.
+var ErrNotHTML = errors.New("content type is not HTML")
+
+// htmlContentTypes are the media types a parser tokenizes by default.
+var htmlContentTypes = []string{"text/html", "application/xhtml+xml"}
+
+// WithContentTypes adds media types (e.g. "text/plain") that the parser should tokenize as HTML in addition to
+// text/html and application/xhtml+xml. Wildcards like "text/*" are allowed, and "*/*" forces the parser to tokenize
+// any response. Responses with other content types fail with ErrNotHTML.
+func (p *Parser) WithContentTypes(types ...string) *Parser {
+	p.contentTypes = append(append([]string{}, p.contentTypes...), types...)
+	return p
+}
+
+// checkContentType returns ErrNotHTML if the response's Content-Type isn't allowed. Responses without a
+// Content-Type are allowed.
+func (p *Parser) checkContentType(resp *http.Response) error {
+	header := resp.Header.Get("Content-Type")
+	if header == "" {
+		return nil
+	}
+
+	mediaType, _, err := mime.ParseMediaType(header)
+	if err != nil {
+		return errors.Wrapf(ErrNotHTML, "malformed content type %q", header)
+	}
+
+	if !matchMediaType(mediaType, p.contentTypes) {
+		return errors.Wrapf(ErrNotHTML, "content type %q", mediaType)
+	}
+
+	return nil
+}
+
+// isImageResponse reports whether the response is an image rather than a page.
+func isImageResponse(resp *http.Response) bool {
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	return strings.HasPrefix(strings.ToLower(mediaType), "image/")
+}
+
+// matchMediaType reports whether mediaType matches any of the patterns, which may contain wildcards like "text/*"
+// or "*/*".
+func matchMediaType(mediaType string, patterns []string) bool {
+	mediaType = strings.ToLower(mediaType)
+	for _, pattern := range patterns {
+		pattern = strings.ToLower(pattern)
+		if pattern == "*/*" || pattern == mediaType {
+			return true
+		}
+
+		if strings.HasSuffix(pattern, "/*") && strings.HasPrefix(mediaType, strings.TrimSuffix(pattern, "*")) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// OnRequest registers a hook that's called with the request for the page and every image, just before it's sent.
+// Hooks may modify the request, e.g. to add credentials, and are called in the order they were registered.
+func (p *Parser) OnRequest(fn func(*http.Request)) *Parser {
+	p.onRequest = append(append([]func(*http.Request){}, p.onRequest...), fn)
+	return p
+}
+
+// OnResponse registers a hook that's called with the response for the page and every image, before its body is read.
+// Hooks are called in the order they were registered, and shouldn't consume the response's body.
+func (p *Parser) OnResponse(fn func(*http.Response)) *Parser {
+	p.onResponse = append(append([]func(*http.Response){}, p.onResponse...), fn)
+	return p
+}
+
+// do sends an HTTP request using the parser's client, reporting each redirect that's followed along the way. Requests
+// and redirects to hosts the parser isn't allowed to fetch from fail with ErrHostNotAllowed.
+func (p *Parser) do(req *http.Request) (*http.Response, error) {
+	if err := p.hosts.check(req.URL); err != nil {
+		return nil, err
+	}
+
+	emit(p.events, Event{Type: EventFetchStarted, URL: req.URL.String()})
+
+	client := *p.client
+	checkRedirect := client.CheckRedirect
+	client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if len(via) > p.maxRedirects {
+			return errors.Wrapf(ErrTooManyRedirects, "stopped after %d redirects", p.maxRedirects)
+		}
+
+		if err := p.hosts.check(req.URL); err != nil {
+			return err
+		}
+
+		if checkRedirect != nil {
+			if err := checkRedirect(req, via); err != nil {
+				return err
+			}
+		}
+
+		emit(p.events, Event{
+			Type: EventRedirectFollowed,
+			URL:  req.URL.String(),
+			From: via[len(via)-1].URL.String(),
+		})
+
+		return nil
+	}
+
+	for _, fn := range p.onRequest {
+		fn(req)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fn := range p.onResponse {
+		fn(resp)
+	}
+
+	return resp, nil
+}
+
+// DefaultFallbackUserAgents are the User-Agents WithUserAgentFallback retries with by default: Facebook's crawler,
+// which publishers tend to let through so their links unfurl, and then a desktop browser.
+var DefaultFallbackUserAgents = []string{
+	"facebookexternalhit/1.1 (+http://www.facebook.com/externalhit_uatext.php)",
+	"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36",
+}
+
+// blockedStatuses are the status codes that suggest a site is turning away the parser's User-Agent.
+var blockedStatuses = map[int]bool{
+	http.StatusUnauthorized:    true,
+	http.StatusForbidden:       true,
+	http.StatusTooManyRequests: true,
+}
+
+// WithUserAgentFallback makes the parser retry a page with each of the given User-Agents in turn (or those in
+// DefaultFallbackUserAgents, if none are given) when it's answered with a 401, 403 or 429, as many sites turn away
+// unfamiliar crawlers but let through well-known ones and browsers. As with WithUserAgent, "{default}" is replaced
+// with DefaultUserAgent. The retries replace any User-Agent set via WithHeaders, and only apply to the page itself,
+// not its images.
+func (p *Parser) WithUserAgentFallback(userAgents ...string) *Parser {
+	if len(userAgents) == 0 {
+		userAgents = DefaultFallbackUserAgents
+	}
+
+	p.fallbackAgents = make([]string, len(userAgents))
+	for i, ua := range userAgents {
+		p.fallbackAgents[i] = strings.ReplaceAll(ua, "{default}", DefaultUserAgent)
+	}
+
+	return p
+}
+
+// doWithFallback sends a page request like do, retrying it with the parser's fallback User-Agents while the site
+// turns it away. It returns the request that was last sent along with its response.
+func (p *Parser) doWithFallback(req *http.Request) (*http.Request, *http.Response, error) {
+	resp, err := p.do(req)
+
+	for _, ua := range p.fallbackAgents {
+		if err != nil || !blockedStatuses[resp.StatusCode] {
+			break
+		}
+		resp.Body.Close()
+
+		req = req.Clone(req.Context())
+		req.Header.Set("User-Agent", ua)
+		resp, err = p.do(req)
+	}
+
+	return req, resp, err
+}
+
+// redirectChain returns the URLs that redirected on the way to resp, oldest first.
+func redirectChain(resp *http.Response) []string {
+	var chain []string
+	for req := resp.Request; req != nil && req.Response != nil; req = req.Response.Request {
+		chain = append([]string{req.Response.Request.URL.String()}, chain...)
+	}
+
+	return chain
+}
@@ -0,0 +1,593 @@
+package recon
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func newRedirectServer() *httptest.Server {
+	// /hops/n redirects n more times before serving a page
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n, _ := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/hops/"))
+		if n > 0 {
+			http.Redirect(w, r, fmt.Sprintf("/hops/%d", n-1), http.StatusFound)
+			return
+		}
+
+		w.Write([]byte(`<html><head><title>Landed</title></head></html>`))
+	}))
+}
+
+func TestMaxRedirects(t *testing.T) {
+	srv := newRedirectServer()
+	defer srv.Close()
+
+	res, err := NewParser().WithMaxRedirects(3).Parse(srv.URL + "/hops/3")
+	assert.Nil(t, err)
+	assert.Equal(t, "Landed", res.Title)
+	assert.Len(t, res.Redirects, 3)
+
+	_, err = NewParser().WithMaxRedirects(3).Parse(srv.URL + "/hops/4")
+	assert.True(t, errors.Is(err, ErrTooManyRedirects), "expected ErrTooManyRedirects, got %v", err)
+
+	_, err = NewParser().WithMaxRedirects(0).Parse(srv.URL + "/hops/1")
+	assert.True(t, errors.Is(err, ErrTooManyRedirects), "expected ErrTooManyRedirects, got %v", err)
+
+	_, err = Parse(srv.URL + "/hops/11")
+	assert.True(t, errors.Is(err, ErrTooManyRedirects), "expected ErrTooManyRedirects, got %v", err)
+}
+
+func TestContentTypes(t *testing.T) {
+	page := `<html><head><title>Typed</title></head></html>`
+
+	tests := []struct {
+		contentType string
+		allow       []string
+		ok          bool
+	}{
+		{contentType: "text/html; charset=utf-8", ok: true},
+		{contentType: "application/xhtml+xml", ok: true},
+		{contentType: "", ok: true},
+		{contentType: "application/octet-stream", ok: false},
+		{contentType: "text/plain", ok: false},
+		{contentType: "text/plain", allow: []string{"text/plain"}, ok: true},
+		{contentType: "text/plain", allow: []string{"text/*"}, ok: true},
+		{contentType: "application/octet-stream", allow: []string{"*/*"}, ok: true},
+		{contentType: "not a content type", ok: false},
+	}
+
+	for _, test := range tests {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header()["Content-Type"] = []string{test.contentType}
+			w.Write([]byte(page))
+		}))
+
+		res, err := NewParser().WithContentTypes(test.allow...).Parse(srv.URL)
+		if test.ok {
+			assert.Nil(t, err, test.contentType)
+			assert.Equal(t, "Typed", res.Title, test.contentType)
+		} else {
+			assert.True(t, errors.Is(err, ErrNotHTML), "%s: expected ErrNotHTML, got %v", test.contentType, err)
+		}
+
+		srv.Close()
+	}
+}
+
+func TestParseImageURL(t *testing.T) {
+	img, err := parseImgFromData(imgTag{url: obnoxiouslyLongDataURL})
+	assert.Nil(t, err)
+	data, err := io.ReadAll(img.data)
+	assert.Nil(t, err)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/gif")
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.Write(data)
+	}))
+	defer srv.Close()
+
+	res, err := Parse(srv.URL + "/idiots.gif")
+	assert.Nil(t, err)
+	assert.Equal(t, "image", res.Type)
+	assert.Equal(t, srv.URL+"/idiots.gif", res.URL)
+	assert.Equal(t, []Image{
+		{
+			URL:         srv.URL + "/idiots.gif",
+			Type:        "image/gif",
+			Width:       500,
+			Height:      242,
+			AspectRatio: 500.0 / 242.0,
+			Preferred:   true,
+			Size:        int64(len(data)),
+		},
+	}, res.Images)
+}
+
+type stringRenderer string
+
+func (s stringRenderer) Render(ctx context.Context, url string) (io.ReadCloser, error) {
+	return io.NopCloser(strings.NewReader(string(s))), nil
+}
+
+func TestRenderer(t *testing.T) {
+	requested := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = true
+		w.Write([]byte(`<html><head><title>Loading...</title></head></html>`))
+	}))
+	defer srv.Close()
+
+	p := NewParser().WithRenderer(stringRenderer(`<html><head><meta property="og:title" content="Rendered"></head></html>`))
+
+	res, err := p.Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "Rendered", res.Title)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.False(t, requested)
+}
+
+// reportingRenderer renders page, reporting that it was loaded as described by info.
+type reportingRenderer struct {
+	page string
+	info RenderedPage
+
+	// checked is the result of CheckURL for blocked, if set.
+	blocked string
+	checked error
+}
+
+type reportedBody struct {
+	io.ReadCloser
+	info RenderedPage
+}
+
+func (b reportedBody) RenderedPage() RenderedPage {
+	return b.info
+}
+
+func (r *reportingRenderer) Render(ctx context.Context, url string) (io.ReadCloser, error) {
+	if r.blocked != "" {
+		r.checked = CheckURL(ctx, r.blocked)
+	}
+
+	return reportedBody{ReadCloser: io.NopCloser(strings.NewReader(r.page)), info: r.info}, nil
+}
+
+func TestRendererChecks(t *testing.T) {
+	page := `<html><head><meta property="og:title" content="Rendered"></head></html>`
+
+	tests := []struct {
+		name   string
+		parser *Parser
+		info   RenderedPage
+		err    error
+		status string
+		final  string
+		redirs []string
+	}{
+		{
+			name:   "redirected",
+			parser: NewParser(),
+			info: RenderedPage{
+				URL:        "https://example.com/final",
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": {"text/html"}},
+				Redirects:  []string{"https://example.com/", "https://example.com/middle"},
+			},
+			final:  "https://example.com/final",
+			redirs: []string{"https://example.com/", "https://example.com/middle"},
+		},
+		{
+			name:   "too many redirects",
+			parser: NewParser().WithMaxRedirects(1),
+			info: RenderedPage{
+				URL:       "https://example.com/final",
+				Redirects: []string{"https://example.com/", "https://example.com/middle"},
+			},
+			err: ErrTooManyRedirects,
+		},
+		{
+			name:   "redirect to a blocked host",
+			parser: NewParser().WithBlockedHosts("internal.example"),
+			info: RenderedPage{
+				URL:       "http://internal.example/admin",
+				Redirects: []string{"https://example.com/"},
+			},
+			err: ErrHostNotAllowed,
+		},
+		{
+			name:   "not html",
+			parser: NewParser(),
+			info: RenderedPage{
+				URL:        "https://example.com/",
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": {"application/pdf"}},
+			},
+			err: ErrNotHTML,
+		},
+		{
+			name:   "error status",
+			parser: NewParser(),
+			info: RenderedPage{
+				URL:        "https://example.com/",
+				StatusCode: http.StatusNotFound,
+				Header:     http.Header{"Content-Type": {"text/html"}},
+			},
+			status: "404 Not Found",
+		},
+	}
+
+	for _, test := range tests {
+		r := &reportingRenderer{page: page, info: test.info}
+		res, err := test.parser.WithRenderer(r).Parse("https://example.com/")
+
+		switch {
+		case test.err != nil:
+			assert.True(t, errors.Is(err, test.err), "%s: %v", test.name, err)
+		case test.status != "":
+			assert.ErrorContains(t, err, test.status, test.name)
+		default:
+			assert.Nil(t, err, test.name)
+			assert.Equal(t, "Rendered", res.Title, test.name)
+			assert.Equal(t, http.StatusOK, res.StatusCode, test.name)
+			assert.Equal(t, test.final, res.FinalURL, test.name)
+			assert.Equal(t, test.redirs, res.Redirects, test.name)
+		}
+	}
+
+	r := &reportingRenderer{
+		page: `<html><head><meta charset="iso-8859-1"><title>Café</title></head></html>`,
+		info: RenderedPage{
+			URL:        "https://example.com/",
+			StatusCode: http.StatusOK,
+			Header: http.Header{
+				"Content-Type":     {"text/html; charset=iso-8859-1"},
+				"Content-Encoding": {"gzip"},
+				"Content-Length":   {"1234"},
+			},
+		},
+	}
+	res, err := NewParser().WithRenderer(r).Parse("https://example.com/")
+	if assert.Nil(t, err, "a compressed page is decoded by the browser") {
+		assert.Equal(t, "Café", res.Title, "the rendered DOM is UTF-8 whatever the original charset")
+	}
+	assert.Equal(t, "gzip", r.info.Header.Get("Content-Encoding"), "the reported header shouldn't be modified")
+
+	r = &reportingRenderer{page: page, blocked: "http://169.254.169.254/latest/meta-data"}
+	_, err = NewParser().WithBlockedHosts("169.254.169.254").WithRenderer(r).Parse("https://example.com/")
+	assert.Nil(t, err)
+	assert.True(t, errors.Is(r.checked, ErrHostNotAllowed))
+
+	assert.Nil(t, CheckURL(context.Background(), "http://169.254.169.254/"))
+}
+
+func TestUserAgent(t *testing.T) {
+	var ua string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ua = r.UserAgent()
+		w.Write([]byte(`<html></html>`))
+	}))
+	defer srv.Close()
+
+	tests := []struct {
+		parser *Parser
+		want   string
+	}{
+		{NewParser(), DefaultUserAgent},
+		{NewParser().WithUserAgent("MyBot/1.0"), "MyBot/1.0"},
+		{NewParser().WithUserAgent("MyBot/1.0 {default}"), "MyBot/1.0 " + DefaultUserAgent},
+		{NewParser().AppendUserAgent("MyApp/2.0"), DefaultUserAgent + " MyApp/2.0"},
+		{NewParser().WithUserAgent("MyBot/1.0").WithHeaders(http.Header{"User-Agent": {"Header/1.0"}}), "Header/1.0"},
+	}
+
+	for _, test := range tests {
+		_, err := test.parser.Parse(srv.URL)
+		assert.Nil(t, err)
+		assert.Equal(t, test.want, ua)
+	}
+}
+
+func TestRequestResponseHooks(t *testing.T) {
+	img, err := parseImgFromData(imgTag{url: obnoxiouslyLongDataURL})
+	assert.Nil(t, err)
+	data, err := io.ReadAll(img.data)
+	assert.Nil(t, err)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if r.URL.Path == "/image.gif" {
+			w.Header().Set("Content-Type", "image/gif")
+			w.Write(data)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<html><head><meta property="og:image" content="/image.gif"></head></html>`))
+	}))
+	defer srv.Close()
+
+	var requested, responded []string
+	res, err := NewParser().
+		OnRequest(func(req *http.Request) {
+			req.Header.Set("Authorization", "Bearer token")
+		}).
+		OnRequest(func(req *http.Request) {
+			requested = append(requested, req.URL.Path)
+		}).
+		OnResponse(func(resp *http.Response) {
+			responded = append(responded, resp.Request.URL.Path+" "+strconv.Itoa(resp.StatusCode))
+		}).
+		Parse(srv.URL + "/")
+	assert.Nil(t, err)
+	assert.Len(t, res.Images, 1)
+	assert.Equal(t, []string{"/", "/image.gif"}, requested)
+	assert.Equal(t, []string{"/ 200", "/image.gif 200"}, responded)
+}
+
+func TestResultURLs(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.Handle("/short", http.RedirectHandler("/story?utm_source=feed", http.StatusMovedPermanently))
+	mux.HandleFunc("/story", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<html><head><meta property="og:url" content="https://example.com/story"></head></html>`))
+	})
+	mux.HandleFunc("/plain", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<html><head><title>Plain</title></head></html>`))
+	})
+
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	res, err := Parse(srv.URL + "/short")
+	assert.Nil(t, err)
+	assert.Equal(t, "https://example.com/story", res.URL)
+	assert.Equal(t, srv.URL+"/short", res.RequestedURL)
+	assert.Equal(t, srv.URL+"/story?utm_source=feed", res.FinalURL)
+	assert.Equal(t, "https://example.com/story", res.CanonicalURL)
+
+	res, err = Parse(srv.URL + "/plain")
+	assert.Nil(t, err)
+	assert.Equal(t, srv.URL+"/plain", res.URL)
+	assert.Equal(t, srv.URL+"/plain", res.RequestedURL)
+	assert.Equal(t, srv.URL+"/plain", res.FinalURL)
+	assert.Equal(t, "", res.CanonicalURL)
+}
+
+func TestTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/slow":
+			// the head arrives quickly, but the rest of the page doesn't
+			w.Write([]byte(`<html><head><title>Slow</title>`))
+			w.(http.Flusher).Flush()
+			time.Sleep(500 * time.Millisecond)
+			w.Write([]byte(`</head></html>`))
+
+		case "/slow.png":
+			time.Sleep(500 * time.Millisecond)
+
+		default:
+			w.Write([]byte(`<html><head><title>Fast</title><meta property="og:image" content="/slow.png"></head></html>`))
+		}
+	}))
+	defer srv.Close()
+
+	start := time.Now()
+	_, err := NewParser().WithTimeout(100 * time.Millisecond).Parse(srv.URL + "/slow")
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), "%v", err)
+	assert.Less(t, time.Since(start), 400*time.Millisecond)
+
+	// running out of time while analyzing images still returns the page
+	res, err := NewParser().WithTimeout(100 * time.Millisecond).Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "Fast", res.Title)
+	assert.Empty(t, res.Images)
+
+	res, err = NewParser().Parse(srv.URL + "/slow")
+	assert.Nil(t, err)
+	assert.Equal(t, "Slow", res.Title)
+}
+
+func TestHeadOnly(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/unclosed":
+			w.Write([]byte(`<html><title>Unclosed</title><meta name="description" content="In the head">` +
+				`<div><h1>Heading</h1><meta name="author" content="In the body"></div></html>`))
+			return
+		}
+
+		w.Write([]byte(`<html><head><title>Long</title><meta property="og:image" content="/a.png"></head><body>`))
+		w.(http.Flusher).Flush()
+
+		// the rest of the page is slow to arrive, and only read without WithHeadOnly
+		time.Sleep(300 * time.Millisecond)
+		w.Write([]byte(`<h1>Heading</h1><img src="/b.png"></body></html>`))
+	}))
+	defer srv.Close()
+
+	start := time.Now()
+	res, err := NewParser().WithImageAnalysis(false).WithHeadOnly().Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "Long", res.Title)
+	assert.Less(t, time.Since(start), 250*time.Millisecond)
+
+	res, err = NewParser().WithImageAnalysis(false).WithHeadOnly().Parse(srv.URL + "/unclosed")
+	assert.Nil(t, err)
+	assert.Equal(t, "Unclosed", res.Title)
+	assert.Equal(t, "In the head", res.Description)
+	assert.Equal(t, "", res.Author)
+
+	res, err = NewParser().WithImageAnalysis(false).Parse(srv.URL + "/unclosed")
+	assert.Nil(t, err)
+	assert.Equal(t, "In the body", res.Author)
+}
+
+func TestStopWhenComplete(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<html><head>
+			<meta property="og:title" content="Complete">
+			<meta property="og:description" content="Described">
+			<meta property="og:image" content="/a.png">
+			<meta property="og:image:width" content="1200">
+			<title>Page title</title>
+		</head><body>`))
+		w.(http.Flusher).Flush()
+
+		time.Sleep(300 * time.Millisecond)
+		w.Write([]byte(`<meta name="author" content="Late"></body></html>`))
+	}))
+	defer srv.Close()
+
+	start := time.Now()
+	res, err := NewParser().WithImageAnalysis(false).
+		WithProperties(map[string]float64{"og:image:width": 1}).
+		WithStopWhenComplete("og:title", "og:description", "og:image").
+		Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "Complete", res.Title)
+	assert.Equal(t, "Described", res.Description)
+	assert.Equal(t, "1200", res.Extras["og:image:width"])
+	assert.Equal(t, "", res.Author)
+	assert.Less(t, time.Since(start), 250*time.Millisecond)
+
+	// the page never has an og:url, so it's read in full
+	res, err = NewParser().WithImageAnalysis(false).WithStopWhenComplete().Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "Late", res.Author)
+}
+
+func TestUserAgentFallback(t *testing.T) {
+	var agents []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		agents = append(agents, r.UserAgent())
+
+		switch {
+		case strings.HasPrefix(r.UserAgent(), "facebookexternalhit"):
+			w.Header().Set("Content-Type", "text/html")
+			w.Write([]byte(`<html><head><title>Let through</title></head></html>`))
+		case r.UserAgent() == "Limited/1.0":
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	defer srv.Close()
+
+	tests := []struct {
+		parser *Parser
+		agents []string
+		title  string
+		err    bool
+	}{
+		{
+			parser: NewParser(),
+			agents: []string{DefaultUserAgent},
+			err:    true,
+		},
+		{
+			parser: NewParser().WithUserAgentFallback(),
+			agents: []string{DefaultUserAgent, DefaultFallbackUserAgents[0]},
+			title:  "Let through",
+		},
+		{
+			parser: NewParser().WithUserAgentFallback("Limited/1.0", "Other/1.0 {default}"),
+			agents: []string{DefaultUserAgent, "Limited/1.0", "Other/1.0 " + DefaultUserAgent},
+			err:    true,
+		},
+		{
+			parser: NewParser().WithUserAgent(DefaultFallbackUserAgents[0]).WithUserAgentFallback("Other/1.0"),
+			agents: []string{DefaultFallbackUserAgents[0]},
+			title:  "Let through",
+		},
+	}
+
+	for i, test := range tests {
+		agents = nil
+
+		res, err := test.parser.Parse(srv.URL)
+		assert.Equal(t, test.err, err != nil, "test %d: %v", i, err)
+		assert.Equal(t, test.title, res.Title, "test %d", i)
+		assert.Equal(t, test.agents, agents, "test %d", i)
+	}
+}
+
+func TestResultHeaders(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Add("Cache-Control", "public")
+		w.Header().Add("Cache-Control", "max-age=300")
+		w.Header().Set("Last-Modified", "Mon, 05 Oct 2026 12:00:00 GMT")
+		w.Header().Set("Server", "nginx")
+		w.Header().Set("Set-Cookie", "session=secret")
+		w.WriteHeader(http.StatusNonAuthoritativeInfo)
+		w.Write([]byte(`<html><head><title>Headers</title></head></html>`))
+	}))
+	defer srv.Close()
+
+	res, err := Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNonAuthoritativeInfo, res.StatusCode)
+	assert.Equal(t, map[string]string{
+		"content-type":  "text/html; charset=utf-8",
+		"cache-control": "public, max-age=300",
+		"last-modified": "Mon, 05 Oct 2026 12:00:00 GMT",
+		"server":        "nginx",
+	}, res.Headers)
+}
+
+func TestKeepHTML(t *testing.T) {
+	page := `<html><head><title>Kept</title></head><body><p>` + strings.Repeat("Some text. ", 10000) + `</p></body></html>`
+	srv := newTestServer("text/html", page)
+	defer srv.Close()
+
+	res, err := NewParser().Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "", res.HTML)
+
+	res, err = NewParser().WithKeepHTML().Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, page, res.HTML)
+	assert.Equal(t, "Kept", res.Title)
+
+	// the Result's copy outlives the pooled buffer the page was read into
+	other, err := NewParser().WithKeepHTML().WithTextExtraction().Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, page, other.HTML)
+	assert.Equal(t, page, res.HTML)
+
+	res, err = NewParser().WithKeepHTML().WithHeadOnly().Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(page, res.HTML))
+	assert.Contains(t, res.HTML, "</head>")
+	assert.True(t, len(res.HTML) < len(page))
+
+	res, err = NewParser().WithKeepHTML().WithMaxBodySize(int64(len(page))).Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, page, res.HTML)
+}
+
+func TestKeepHTMLTooLarge(t *testing.T) {
+	page := `<html><head><title>Huge</title></head><body><p>` + strings.Repeat("Some text. ", DefaultMaxHTMLSize/10) + `</p></body></html>`
+	srv := newTestServer("text/html", page)
+	defer srv.Close()
+
+	res, err := NewParser().WithKeepHTML().Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "", res.HTML)
+	assert.Equal(t, "Huge", res.Title)
+}
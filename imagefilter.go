@@ -0,0 +1,87 @@
+package recon
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// ImageVerdict is an ImageFilter's decision about an image.
+type ImageVerdict string
+
+// Image verdicts.
+const (
+	// ImageAllow keeps the image as is.
+	ImageAllow ImageVerdict = "allow"
+
+	// ImageFlag keeps the image, with Image.Flagged set.
+	ImageFlag ImageVerdict = "flag"
+
+	// ImageDrop removes the image from the Result.
+	ImageDrop ImageVerdict = "drop"
+)
+
+// errImageDropped is returned by filterImage for images its filter dropped.
+var errImageDropped = errors.New("image dropped by filter")
+
+// ImageFilter checks the images the parser downloads before they're added to the Result, for example with an NSFW
+// or malware classifier. img describes the image as recon analyzed it, and data is the image's full contents.
+type ImageFilter interface {
+	FilterImage(ctx context.Context, img Image, data []byte) (ImageVerdict, error)
+}
+
+// ImageFilterFunc adapts an ordinary function to the ImageFilter interface.
+type ImageFilterFunc func(ctx context.Context, img Image, data []byte) (ImageVerdict, error)
+
+// FilterImage calls f(ctx, img, data).
+func (f ImageFilterFunc) FilterImage(ctx context.Context, img Image, data []byte) (ImageVerdict, error) {
+	return f(ctx, img, data)
+}
+
+// WithImageFilter sets an ImageFilter the parser checks each image with, including the image itself when the URL
+// being parsed is one. Images are downloaded in full rather than only as far as their headers when a filter is set.
+// Images the filter fails on are dropped, as are those whose contents couldn't be read.
+func (p *Parser) WithImageFilter(f ImageFilter) *Parser {
+	p.imageFilter = f
+	return p
+}
+
+// filterImage reads the image's contents and checks them with the parser's ImageFilter, if it has one. The returned
+// image's data is rewound to the start, and errImageDropped is returned if the filter dropped it.
+func (p *Parser) filterImage(ctx context.Context, in parsedImage) (parsedImage, error) {
+	if p.imageFilter == nil || in.data == nil {
+		return in, nil
+	}
+
+	data, err := io.ReadAll(in.data)
+	if c, ok := in.data.(io.Closer); ok {
+		c.Close()
+	}
+	if err != nil {
+		return in, errors.Wrap(err, "filter image: read")
+	}
+
+	if in.size < 0 {
+		in.size = int64(len(data))
+	}
+
+	in.data = bytes.NewReader(data)
+	img, _ := in.export()
+	in.data = bytes.NewReader(data)
+
+	verdict, err := p.imageFilter.FilterImage(ctx, img, data)
+	if err != nil {
+		return in, errors.Wrap(err, "filter image")
+	}
+
+	switch verdict {
+	case ImageDrop:
+		return in, errImageDropped
+	case ImageFlag:
+		in.flagged = true
+	}
+
+	return in, nil
+}
@@ -0,0 +1,58 @@
+package recon
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageFilter(t *testing.T) {
+	srv := newImageServer(`<html><body><img src="/ok.png"><img src="/flag.png"><img src="/drop.png">
+		<img src="/fail.png"></body></html>`, map[string][2]int{
+		"/ok.png":   {100, 100},
+		"/flag.png": {100, 100},
+		"/drop.png": {100, 100},
+		"/fail.png": {100, 100},
+	})
+	defer srv.Close()
+
+	filter := ImageFilterFunc(func(ctx context.Context, img Image, data []byte) (ImageVerdict, error) {
+		assert.Equal(t, 100, img.Width)
+		assert.Equal(t, img.Size, int64(len(data)))
+
+		switch img.URL[len(srv.URL):] {
+		case "/flag.png":
+			return ImageFlag, nil
+		case "/drop.png":
+			return ImageDrop, nil
+		case "/fail.png":
+			return ImageAllow, errors.New("classifier unavailable")
+		}
+		return ImageAllow, nil
+	})
+
+	res, err := NewParser().WithImageFilter(filter).Parse(srv.URL)
+	assert.Nil(t, err)
+	if assert.Len(t, res.Images, 2) {
+		assert.Equal(t, srv.URL+"/ok.png", res.Images[0].URL)
+		assert.False(t, res.Images[0].Flagged)
+		assert.Equal(t, 100, res.Images[0].Width)
+
+		assert.Equal(t, srv.URL+"/flag.png", res.Images[1].URL)
+		assert.True(t, res.Images[1].Flagged)
+	}
+
+	res, err = NewParser().WithImageFilter(filter).Parse(srv.URL + "/flag.png")
+	assert.Nil(t, err)
+	if assert.Len(t, res.Images, 1) {
+		assert.True(t, res.Images[0].Flagged)
+		assert.Equal(t, 100, res.Images[0].Height)
+	}
+
+	res, err = NewParser().WithImageFilter(filter).Parse(srv.URL + "/drop.png")
+	assert.Nil(t, err)
+	assert.Equal(t, "image", res.Type)
+	assert.Empty(t, res.Images)
+}
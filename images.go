@@ -0,0 +1,231 @@
+package recon
+
+import (
+	"context"
+	"image/color"
+	"io"
+	"math"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// WithImageScorer sets the function the parser ranks a page's images with, in place of how close their aspect ratios
+// are to the parser's optimal aspect ratio. Images with higher scores rank first, though images declared via og:image
+// (or twitter:image, if there's no og:image) still rank ahead of the rest, in the order they're declared, followed by
+// other preferred images and then schema.org image properties. The scorer is called once per image.
+func (p *Parser) WithImageScorer(scorer func(Image) float64) *Parser {
+	p.imageScorer = scorer
+	return p
+}
+
+// WithOptimalAspectRatio sets the aspect ratio (width / height) the parser favors when ranking images, e.g. 1 for
+// square thumbnails. It defaults to DefaultOptimalAspectRatio, or OptimalAspectRatio if that's been changed.
+func (p *Parser) WithOptimalAspectRatio(ratio float64) *Parser {
+	p.optimalAspectRatio = ratio
+	return p
+}
+
+// aspectRatioScore returns the default image score: the closer an image's aspect ratio is to ratio, the higher its
+// score.
+func aspectRatioScore(ratio float64) func(Image) float64 {
+	return func(img Image) float64 {
+		return -math.Abs(img.AspectRatio - ratio)
+	}
+}
+
+// rankImages sorts images, which are in document order, into the order they're returned in, given the tier of each.
+// The order is deterministic: images in higher tiers come first, og:image images in the order they're declared, and
+// images within each other tier are ranked by score, with ties broken by document order and then by URL.
+func (p *Parser) rankImages(images []Image, tiers []imageTier) {
+	score := p.imageScorer
+	if score == nil {
+		ratio := p.optimalAspectRatio
+		if ratio <= 0 {
+			ratio = OptimalAspectRatio
+		}
+		score = aspectRatioScore(ratio)
+	}
+
+	type scored struct {
+		img   Image
+		tier  imageTier
+		score float64
+		index int
+	}
+
+	ranked := make([]scored, len(images))
+	for i, img := range images {
+		ranked[i] = scored{img: img, tier: tiers[i], score: score(img), index: i}
+	}
+
+	sort.Slice(ranked, func(a, b int) bool {
+		x, y := ranked[a], ranked[b]
+		if x.tier != y.tier {
+			return x.tier > y.tier
+		}
+
+		// og:image tags are declared in order of preference
+		if x.img.OGIndex != nil && y.img.OGIndex != nil && *x.img.OGIndex != *y.img.OGIndex {
+			return *x.img.OGIndex < *y.img.OGIndex
+		}
+
+		if x.score != y.score {
+			return x.score > y.score
+		}
+
+		if x.index != y.index {
+			return x.index < y.index
+		}
+
+		return x.img.URL < y.img.URL
+	})
+
+	for i := range ranked {
+		images[i] = ranked[i].img
+	}
+}
+
+// imageCandidates returns the page's candidate images, in document order. If the page has no og:image, its
+// twitter:image stands in for one. Hints and schema.org image properties often repeat the page's og:image or each
+// other, so those that repeat an og:image or an earlier hint or property are left out.
+func (d *Document) imageCandidates() []imgTag {
+	seen := map[string]bool{}
+	for _, tag := range d.imgTags {
+		if tag.tier == tierOG {
+			seen[d.resolve(tag.url)] = true
+		}
+	}
+
+	tags := make([]imgTag, 0, len(d.imgTags)+1)
+	twitter := firstNonEmpty(d.MetaContent("twitter:image"), d.MetaContent("twitter:image:src"))
+	if len(seen) == 0 && twitter != "" {
+		seen[d.resolve(twitter)] = true
+		tags = append(tags, imgTag{
+			url:       twitter,
+			alt:       d.MetaContent("twitter:image:alt"),
+			preferred: true,
+			tier:      tierTwitter,
+		})
+	}
+
+	for _, tag := range d.imgTags {
+		if tag.tier == tierHint || tag.tier == tierItemprop {
+			u := d.resolve(tag.url)
+			if seen[u] {
+				continue
+			}
+			seen[u] = true
+		}
+
+		tags = append(tags, tag)
+	}
+
+	return tags
+}
+
+// WithImageProxy sets a function that rewrites the URL of each returned image, e.g. to point it at a camo-style
+// proxy, so that clients displaying the images don't contact their origins directly. recon still downloads images
+// from their origins to analyze them, though a placeholder (see WithPlaceholder) is generated via the proxy. Data
+// URLs aren't rewritten.
+func (p *Parser) WithImageProxy(proxy func(rawURL string) string) *Parser {
+	p.imageProxy = proxy
+	return p
+}
+
+// proxyImages rewrites the images' URLs with the parser's image proxy, if it has one.
+func (p *Parser) proxyImages(images []Image) {
+	if p.imageProxy == nil {
+		return
+	}
+
+	for i := range images {
+		if !strings.HasPrefix(images[i].URL, "data:") {
+			images[i].URL = p.imageProxy(images[i].URL)
+		}
+	}
+}
+
+// ImageUpgrade is a policy for upgrading images' http:// URLs to https://, so they can be embedded in HTTPS pages
+// without mixed content warnings. It's set via WithImageUpgrade.
+type ImageUpgrade string
+
+// Image upgrade policies.
+const (
+	// ImageUpgradeNever returns images' URLs as the page declares them. It's the default.
+	ImageUpgradeNever ImageUpgrade = "never"
+
+	// ImageUpgradeSecureURL uses an og:image's og:image:secure_url in place of its og:image URL, falling back to the
+	// og:image URL if the secure URL can't be downloaded.
+	ImageUpgradeSecureURL ImageUpgrade = "secure_url"
+
+	// ImageUpgradeAlways uses og:image:secure_url like ImageUpgradeSecureURL, and also tries the https:// variant of
+	// any other http:// image URL first, falling back to the http:// URL if the https:// one can't be downloaded.
+	ImageUpgradeAlways ImageUpgrade = "always"
+)
+
+// WithImageUpgrade sets the parser's policy for upgrading images' http:// URLs to https://. An image's URL in the
+// Result is the one it was downloaded from.
+func (p *Parser) WithImageUpgrade(policy ImageUpgrade) *Parser {
+	p.imageUpgrade = policy
+	return p
+}
+
+// imageURLs returns the URLs to try downloading the image from, in order, according to the parser's upgrade policy.
+func (p *Parser) imageURLs(baseURL, u *url.URL, tag imgTag) []*url.URL {
+	if p.imageUpgrade != ImageUpgradeSecureURL && p.imageUpgrade != ImageUpgradeAlways {
+		return []*url.URL{u}
+	}
+
+	if tag.secureURL != "" {
+		if secure, err := url.Parse(tag.secureURL); err == nil {
+			if secure = baseURL.ResolveReference(secure); secure.String() != u.String() {
+				return []*url.URL{secure, u}
+			}
+		}
+	}
+
+	if p.imageUpgrade == ImageUpgradeAlways && u.Scheme == "http" {
+		secure := *u
+		secure.Scheme = "https"
+		return []*url.URL{&secure, u}
+	}
+
+	return []*url.URL{u}
+}
+
+// fetchImage downloads the image from the first of its URLs (see imageURLs) that responds successfully, or from the
+// last one otherwise.
+func (p *Parser) fetchImage(ctx context.Context, baseURL, u *url.URL, tag imgTag, rec *statsRecorder) (parsedImage, error) {
+	urls := p.imageURLs(baseURL, u, tag)
+	for _, candidate := range urls[:len(urls)-1] {
+		img, err := p.parseImage(ctx, candidate, tag, rec)
+		if err == nil && img.status >= 200 && img.status < 300 {
+			return img, nil
+		}
+		if c, ok := img.data.(io.Closer); ok {
+			c.Close()
+		}
+	}
+
+	return p.parseImage(ctx, urls[len(urls)-1], tag, rec)
+}
+
+// hasAlpha reports whether images in the color model can be transparent: whether it has an alpha channel, or, for a
+// palette, whether any of its colors are transparent.
+func hasAlpha(m color.Model) bool {
+	switch m {
+	case color.RGBAModel, color.RGBA64Model, color.NRGBAModel, color.NRGBA64Model, color.AlphaModel, color.Alpha16Model:
+		return true
+	}
+
+	if palette, ok := m.(color.Palette); ok {
+		for _, c := range palette {
+			if _, _, _, a := c.RGBA(); a < 0xffff {
+				return true
+			}
+		}
+	}
+
+	return false
+}
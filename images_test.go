@@ -0,0 +1,468 @@
+package recon
+
+import (
+	"bytes"
+	"encoding/base64"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageScorer(t *testing.T) {
+	srv := newImageServer(`<html><head><meta property="og:image" content="/og.png"></head><body>
+		<img src="/small.png"><img src="/large.png"><img src="/medium.png">
+	</body></html>`, map[string][2]int{
+		"/og.png":     {10, 10},
+		"/small.png":  {191, 100},
+		"/large.png":  {400, 400},
+		"/medium.png": {200, 150},
+	})
+	defer srv.Close()
+
+	urls := func(images []Image) []string {
+		var out []string
+		for _, img := range images {
+			out = append(out, img.URL[len(srv.URL):])
+		}
+		return out
+	}
+
+	res, err := Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"/og.png", "/small.png", "/medium.png", "/large.png"}, urls(res.Images))
+
+	area := func(img Image) float64 {
+		return float64(img.Width * img.Height)
+	}
+
+	res, err = NewParser().WithImageScorer(area).Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"/og.png", "/large.png", "/medium.png", "/small.png"}, urls(res.Images))
+}
+
+func TestOptimalAspectRatio(t *testing.T) {
+	srv := newImageServer(`<html><body><img src="/wide.png"><img src="/square.png"></body></html>`, map[string][2]int{
+		"/wide.png":   {191, 100},
+		"/square.png": {100, 100},
+	})
+	defer srv.Close()
+
+	res, err := Parse(srv.URL)
+	assert.Nil(t, err)
+	if assert.Len(t, res.Images, 2) {
+		assert.Equal(t, srv.URL+"/wide.png", res.Images[0].URL)
+	}
+
+	res, err = NewParser().WithOptimalAspectRatio(1).Parse(srv.URL)
+	assert.Nil(t, err)
+	if assert.Len(t, res.Images, 2) {
+		assert.Equal(t, srv.URL+"/square.png", res.Images[0].URL)
+	}
+}
+
+func TestImageOrderDeterministic(t *testing.T) {
+	page := `<html><body>`
+	sizes := map[string][2]int{}
+	want := []string{}
+	for _, name := range []string{"/e.png", "/b.png", "/d.png", "/a.png", "/c.png"} {
+		page += `<img src="` + name + `">`
+		sizes[name] = [2]int{100, 100}
+		want = append(want, name)
+	}
+	srv := newImageServer(page+`</body></html>`, sizes)
+	defer srv.Close()
+
+	for i := 0; i < 10; i++ {
+		res, err := Parse(srv.URL)
+		assert.Nil(t, err)
+
+		var got []string
+		for _, img := range res.Images {
+			got = append(got, img.URL[len(srv.URL):])
+		}
+		assert.Equal(t, want, got, "ties are broken by document order")
+	}
+}
+
+func TestImageSize(t *testing.T) {
+	srv := newImageServer(`<html><body><img src="/image.png"></body></html>`, map[string][2]int{
+		"/image.png": {50, 50},
+	})
+	defer srv.Close()
+
+	res, err := Parse(srv.URL)
+	assert.Nil(t, err)
+	if assert.Len(t, res.Images, 1) {
+		assert.Greater(t, res.Images[0].Size, int64(0))
+	}
+
+	img, err := parseImgFromData(imgTag{url: obnoxiouslyLongDataURL})
+	assert.Nil(t, err)
+	size := int64(img.data.(*pooledReader).Len())
+	exported, err := img.export()
+	assert.Nil(t, err)
+	assert.Equal(t, size, exported.Size)
+}
+
+func TestImageTransparent(t *testing.T) {
+	encode := func(img image.Image) *bytes.Buffer {
+		buf := &bytes.Buffer{}
+		png.Encode(buf, img)
+		return buf
+	}
+
+	palette := color.Palette{color.Black, color.Transparent}
+
+	// a lossless 2x3 WebP with its alpha flag set, and an extended 4x5 WebP without it
+	lossless := []byte("RIFF\x00\x00\x00\x00WEBPVP8L\x00\x00\x00\x00\x2f\x01\x80\x00\x10\x00\x00\x00\x00\x00")
+	extended := []byte("RIFF\x00\x00\x00\x00WEBPVP8X\x0a\x00\x00\x00\x00\x00\x00\x00\x03\x00\x00\x04\x00\x00")
+
+	tests := []struct {
+		name        string
+		contentType string
+		data        *bytes.Buffer
+		width       int
+		height      int
+		transparent bool
+	}{
+		{"png rgba", "image/png", encode(image.NewNRGBA(image.Rect(0, 0, 2, 2))), 2, 2, true},
+		{"png gray", "image/png", encode(image.NewGray(image.Rect(0, 0, 2, 2))), 2, 2, false},
+		{"png palette", "image/png", encode(image.NewPaletted(image.Rect(0, 0, 2, 2), palette)), 2, 2, true},
+		{"webp lossless", "image/webp", bytes.NewBuffer(lossless), 2, 3, true},
+		{"webp extended", "image/webp", bytes.NewBuffer(extended), 4, 5, false},
+	}
+
+	for _, test := range tests {
+		img, err := parsedImage{contentType: test.contentType, data: test.data, size: -1}.export()
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, test.width, img.Width, test.name)
+		assert.Equal(t, test.height, img.Height, test.name)
+		assert.Equal(t, test.transparent, img.Transparent, test.name)
+	}
+}
+
+func TestPlaceholder(t *testing.T) {
+	srv := newImageServer(`<html><body><img src="/wide.png"></body></html>`, map[string][2]int{
+		"/wide.png": {200, 100},
+	})
+	defer srv.Close()
+
+	res, err := Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "", res.Placeholder)
+
+	res, err = NewParser().WithPlaceholder(32).Parse(srv.URL)
+	assert.Nil(t, err)
+
+	const prefix = "data:image/jpeg;base64,"
+	if assert.True(t, strings.HasPrefix(res.Placeholder, prefix), res.Placeholder) {
+		data, err := base64.StdEncoding.DecodeString(res.Placeholder[len(prefix):])
+		assert.Nil(t, err)
+
+		cfg, err := jpeg.DecodeConfig(bytes.NewReader(data))
+		assert.Nil(t, err)
+		assert.Equal(t, 32, cfg.Width)
+		assert.Equal(t, 16, cfg.Height)
+	}
+}
+
+func TestScaleDown(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 4, 8))
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 4; x++ {
+			src.Set(x, y, color.NRGBA{R: 255, A: 255})
+		}
+	}
+	src.Set(0, 0, color.Transparent)
+
+	dst := scaleDown(src, 4)
+	assert.Equal(t, image.Rect(0, 0, 2, 4), dst.Bounds())
+	assert.Equal(t, color.RGBA{R: 255, G: 63, B: 63, A: 255}, dst.RGBAAt(0, 0))
+	assert.Equal(t, color.RGBA{R: 255, A: 255}, dst.RGBAAt(1, 3))
+}
+
+func TestImageProxy(t *testing.T) {
+	const dataURL = "data:image/gif;base64,R0lGODlhAQABAAAAACw="
+	srv := newImageServer(`<html><body><img src="/a.png"><img src="`+dataURL+`"></body></html>`, map[string][2]int{
+		"/a.png": {100, 100},
+	})
+	defer srv.Close()
+
+	proxy := func(rawURL string) string {
+		return "https://camo.example.com/?url=" + url.QueryEscape(rawURL)
+	}
+
+	res, err := NewParser().WithImageProxy(proxy).Parse(srv.URL)
+	assert.Nil(t, err)
+	if assert.Len(t, res.Images, 2) {
+		assert.Equal(t, proxy(srv.URL+"/a.png"), res.Images[0].URL)
+		assert.Equal(t, dataURL, res.Images[1].URL)
+	}
+
+	res, err = NewParser().WithImageProxy(proxy).Parse(srv.URL + "/a.png")
+	assert.Nil(t, err)
+	if assert.Len(t, res.Images, 1) {
+		assert.Equal(t, proxy(srv.URL+"/a.png"), res.Images[0].URL)
+	}
+}
+
+func TestImageUpgrade(t *testing.T) {
+	secure := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/secure.png" {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", "image/png")
+		png.Encode(w, image.NewGray(image.Rect(0, 0, 100, 100)))
+	}))
+	defer secure.Close()
+
+	srv := newImageServer(`<html><head>
+		<meta property="og:image" content="/a.png">
+		<meta property="og:image:secure_url" content="`+secure.URL+`/secure.png">
+		<meta property="og:image" content="/b.png">
+		<meta property="og:image:secure_url" content="`+secure.URL+`/missing.png">
+	</head><body><img src="/c.png"></body></html>`, map[string][2]int{
+		"/a.png": {100, 100},
+		"/b.png": {100, 100},
+		"/c.png": {100, 100},
+	})
+	defer srv.Close()
+
+	parse := func(policy ImageUpgrade) []string {
+		res, err := NewParser().WithClient(secure.Client()).WithImageUpgrade(policy).Parse(srv.URL)
+		assert.Nil(t, err)
+
+		var out []string
+		for _, img := range res.Images {
+			out = append(out, img.URL)
+		}
+		return out
+	}
+
+	assert.Equal(t, []string{srv.URL + "/a.png", srv.URL + "/b.png", srv.URL + "/c.png"}, parse(ImageUpgradeNever))
+
+	// /missing.png 404s, so /b.png falls back to its og:image URL
+	assert.Equal(t, []string{secure.URL + "/secure.png", srv.URL + "/b.png", srv.URL + "/c.png"},
+		parse(ImageUpgradeSecureURL))
+
+	// srv doesn't speak TLS, so /c.png falls back to its http:// URL
+	assert.Equal(t, []string{secure.URL + "/secure.png", srv.URL + "/b.png", srv.URL + "/c.png"},
+		parse(ImageUpgradeAlways))
+
+	base, _ := url.Parse("http://example.com/")
+	u, _ := url.Parse("http://cdn.example.com/a.png")
+	var urls []string
+	for _, candidate := range NewParser().WithImageUpgrade(ImageUpgradeAlways).imageURLs(base, u, imgTag{}) {
+		urls = append(urls, candidate.String())
+	}
+	assert.Equal(t, []string{"https://cdn.example.com/a.png", "http://cdn.example.com/a.png"}, urls)
+}
+
+func TestFigcaptionAlt(t *testing.T) {
+	srv := newImageServer(`<html><body>
+		<figure><img src="/before.png"><figcaption> A <em>caption</em> </figcaption></figure>
+		<figure><figcaption>Another caption</figcaption><img src="/after.png"><img src="/own.png" alt="Own alt"></figure>
+		<img src="/outside.png">
+	</body></html>`, map[string][2]int{
+		"/before.png":  {100, 100},
+		"/after.png":   {100, 100},
+		"/own.png":     {100, 100},
+		"/outside.png": {100, 100},
+	})
+	defer srv.Close()
+
+	res, err := Parse(srv.URL)
+	assert.Nil(t, err)
+
+	alts := map[string]string{}
+	for _, img := range res.Images {
+		alts[img.URL[len(srv.URL):]] = img.Alt
+	}
+	assert.Equal(t, map[string]string{
+		"/before.png":  "A caption",
+		"/after.png":   "Another caption",
+		"/own.png":     "Own alt",
+		"/outside.png": "",
+	}, alts)
+}
+
+func TestImageSrc(t *testing.T) {
+	srv := newImageServer(`<html><head>
+		<link rel="image_src" href="/og.png">
+		<meta property="og:image" content="/og.png">
+		<link rel="image_src" href="/link.png">
+	</head><body><img src="/body.png"></body></html>`, map[string][2]int{
+		"/og.png":   {10, 10},
+		"/link.png": {10, 10},
+		"/body.png": {191, 100},
+	})
+	defer srv.Close()
+
+	res, err := Parse(srv.URL)
+	assert.Nil(t, err)
+
+	var urls []string
+	var preferred []bool
+	for _, img := range res.Images {
+		urls = append(urls, img.URL[len(srv.URL):])
+		preferred = append(preferred, img.Preferred)
+	}
+	assert.Equal(t, []string{"/og.png", "/link.png", "/body.png"}, urls)
+	assert.Equal(t, []bool{true, true, false}, preferred)
+}
+
+func TestItempropImage(t *testing.T) {
+	srv := newImageServer(`<html><head>
+		<meta property="og:image" content="/og.png">
+		<meta itemprop="image" content="/og.png">
+		<meta itemprop="image" content="/meta.png">
+	</head><body>
+		<img src="/body.png">
+		<div itemscope itemtype="https://schema.org/Product"><img itemprop="image" src="/product.png"></div>
+	</body></html>`, map[string][2]int{
+		"/og.png":      {10, 10},
+		"/meta.png":    {10, 10},
+		"/product.png": {150, 100},
+		"/body.png":    {191, 100},
+	})
+	defer srv.Close()
+
+	res, err := Parse(srv.URL)
+	assert.Nil(t, err)
+
+	var urls []string
+	for _, img := range res.Images {
+		urls = append(urls, img.URL[len(srv.URL):])
+		assert.Equal(t, img.URL == srv.URL+"/og.png", img.Preferred, img.URL)
+	}
+	assert.Equal(t, []string{"/og.png", "/product.png", "/meta.png", "/body.png"}, urls)
+}
+
+func TestTwitterImage(t *testing.T) {
+	sizes := map[string][2]int{
+		"/og.png":      {10, 10},
+		"/twitter.png": {10, 10},
+		"/link.png":    {10, 10},
+		"/body.png":    {191, 100},
+	}
+
+	tests := []struct {
+		name string
+		head string
+		want []string
+		alt  string
+	}{
+		{
+			name: "no og:image",
+			head: `<link rel="image_src" href="/link.png"><meta name="twitter:image" content="/twitter.png">
+				<meta name="twitter:image:alt" content="A bird">`,
+			want: []string{"/twitter.png", "/link.png", "/body.png"},
+			alt:  "A bird",
+		},
+		{
+			name: "twitter:image:src",
+			head: `<meta name="twitter:image:src" content="/twitter.png"><link rel="image_src" href="/twitter.png">`,
+			want: []string{"/twitter.png", "/body.png"},
+		},
+		{
+			name: "og:image",
+			head: `<meta name="twitter:image" content="/twitter.png"><meta property="og:image" content="/og.png">`,
+			want: []string{"/og.png", "/body.png"},
+		},
+	}
+
+	for _, test := range tests {
+		srv := newImageServer(`<html><head>`+test.head+`</head><body><img src="/body.png"></body></html>`, sizes)
+
+		res, err := Parse(srv.URL)
+		assert.Nil(t, err, test.name)
+
+		var urls []string
+		for _, img := range res.Images {
+			urls = append(urls, img.URL[len(srv.URL):])
+		}
+		assert.Equal(t, test.want, urls, test.name)
+		if len(res.Images) > 0 {
+			assert.True(t, res.Images[0].Preferred, test.name)
+			assert.Equal(t, test.alt, res.Images[0].Alt, test.name)
+		}
+
+		srv.Close()
+	}
+}
+
+func TestBaseHref(t *testing.T) {
+	cdn := newImageServer("", map[string][2]int{"/assets/a.png": {100, 100}, "/assets/b.png": {100, 100}})
+	defer cdn.Close()
+
+	srv := newTestServer("text/html", `<html><head>
+		<base href="`+cdn.URL+`/assets/">
+		<base href="/ignored/">
+		<meta property="og:image" content="a.png">
+	</head><body><img src="b.png"></body></html>`)
+	defer srv.Close()
+
+	res, err := NewParser().Parse(srv.URL + "/articles/1")
+	assert.Nil(t, err)
+	if assert.Len(t, res.Images, 2) {
+		assert.Equal(t, cdn.URL+"/assets/a.png", res.Images[0].URL)
+		assert.Equal(t, cdn.URL+"/assets/b.png", res.Images[1].URL)
+		assert.Equal(t, 100, res.Images[1].Width)
+	}
+
+	// a relative base is resolved against the page's URL
+	page, _ := url.Parse("https://example.com/articles/1")
+	base, _ := url.Parse("/static/")
+	doc := &Document{URL: page, base: base}
+	assert.Equal(t, "https://example.com/static/img/a.png", doc.resolve("img/a.png"))
+	assert.Equal(t, "https://other.example/a.png", doc.resolve("https://other.example/a.png"))
+}
+
+func TestNoscriptImages(t *testing.T) {
+	pixel := "data:image/gif;base64,R0lGODlhAQABAAAAACH5BAEKAAEALAAAAAABAAEAAAICTAEAOw=="
+	srv := newImageServer(`<html><head>
+		<noscript><link rel="stylesheet" href="/no-js.css"></noscript>
+	</head><body>
+		<img src="`+pixel+`" data-src="/lazy.png" alt="Lazy">
+		<noscript><img src="/lazy.png"></noscript>
+		<figure>
+			<img src="`+pixel+`" data-src="/figure.png"><noscript><img src="/figure.png" alt="Own alt"></noscript>
+			<figcaption>A caption</figcaption>
+		</figure>
+		<img src="/plain.png">
+		<noscript><img src="/extra.png" alt="Extra &amp; more"></noscript>
+		<noscript></noscript>
+		<noscript><img height="1" width="1" src="/pixel.png"></noscript>
+	</body></html>`, map[string][2]int{
+		"/lazy.png":   {100, 100},
+		"/figure.png": {100, 100},
+		"/plain.png":  {100, 100},
+		"/extra.png":  {100, 100},
+		"/pixel.png":  {1, 1},
+	})
+	defer srv.Close()
+
+	res, err := Parse(srv.URL)
+	assert.Nil(t, err)
+
+	alts := map[string]string{}
+	for _, img := range res.Images {
+		alts[strings.TrimPrefix(img.URL, srv.URL)] = img.Alt
+	}
+	assert.Equal(t, map[string]string{
+		"/lazy.png":   "Lazy",
+		"/figure.png": "Own alt",
+		"/plain.png":  "",
+		"/extra.png":  "Extra & more",
+	}, alts)
+}
@@ -0,0 +1,64 @@
+// Package lru implements a fixed-size least-recently-used cache.
+package lru
+
+import "container/list"
+
+// Cache is a fixed-size cache that evicts the least recently used entry when it's full. It isn't safe for
+// concurrent use.
+type Cache[K comparable, V any] struct {
+	size  int
+	ll    *list.List
+	items map[K]*list.Element
+}
+
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// New returns a Cache that holds up to size entries. A size of 0 or less means the cache is unbounded.
+func New[K comparable, V any](size int) *Cache[K, V] {
+	return &Cache[K, V]{
+		size:  size,
+		ll:    list.New(),
+		items: map[K]*list.Element{},
+	}
+}
+
+// Get returns the value stored under key and marks it as recently used.
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	if el, ok := c.items[key]; ok {
+		c.ll.MoveToFront(el)
+		return el.Value.(*entry[K, V]).value, true
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Add stores value under key, evicting the least recently used entry if the cache is full.
+func (c *Cache[K, V]) Add(key K, value V) {
+	if el, ok := c.items[key]; ok {
+		c.ll.MoveToFront(el)
+		el.Value.(*entry[K, V]).value = value
+		return
+	}
+
+	c.items[key] = c.ll.PushFront(&entry[K, V]{key: key, value: value})
+	if c.size > 0 && c.ll.Len() > c.size {
+		c.Remove(c.ll.Back().Value.(*entry[K, V]).key)
+	}
+}
+
+// Remove deletes the entry stored under key, if there is one.
+func (c *Cache[K, V]) Remove(key K) {
+	if el, ok := c.items[key]; ok {
+		c.ll.Remove(el)
+		delete(c.items, key)
+	}
+}
+
+// Len returns the number of entries in the cache.
+func (c *Cache[K, V]) Len() int {
+	return c.ll.Len()
+}
@@ -0,0 +1,161 @@
+package recon
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// ldObject is a JSON-LD node, as found in a page's <script type="application/ld+json"> blocks, or a microdata item.
+type ldObject map[string]interface{}
+
+// parseJSONLD returns every node in a JSON-LD block, including those nested in arrays, @graph lists and
+// mainEntity properties. Malformed blocks yield nothing.
+func parseJSONLD(text string) []ldObject {
+	var v interface{}
+	if err := json.Unmarshal([]byte(strings.TrimSpace(text)), &v); err != nil {
+		return nil
+	}
+
+	out := []ldObject{}
+	collectJSONLD(v, &out)
+	return out
+}
+
+func collectJSONLD(v interface{}, out *[]ldObject) {
+	switch val := v.(type) {
+	case []interface{}:
+		for _, child := range val {
+			collectJSONLD(child, out)
+		}
+
+	case map[string]interface{}:
+		*out = append(*out, ldObject(val))
+		for _, key := range []string{"@graph", "mainEntity"} {
+			if child, ok := val[key]; ok {
+				collectJSONLD(child, out)
+			}
+		}
+	}
+}
+
+// is reports whether the node has any of the given schema.org types.
+func (o ldObject) is(types ...string) bool {
+	var have []string
+	switch t := o["@type"].(type) {
+	case string:
+		have = []string{t}
+	case []interface{}:
+		for _, v := range t {
+			if s, ok := v.(string); ok {
+				have = append(have, s)
+			}
+		}
+	}
+
+	for _, h := range have {
+		h = h[strings.LastIndex(h, "/")+1:]
+		for _, t := range types {
+			if strings.EqualFold(h, t) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// str returns the property as a string. Numbers are formatted, the first element of an array is used, and for an
+// object its name is used.
+func (o ldObject) str(key string) string {
+	return ldString(o[key])
+}
+
+func ldString(v interface{}) string {
+	switch val := v.(type) {
+	case string:
+		return strings.TrimSpace(val)
+	case float64:
+		b, _ := json.Marshal(val)
+		return string(b)
+	case bool:
+		if val {
+			return "true"
+		}
+		return "false"
+	case []interface{}:
+		if len(val) > 0 {
+			return ldString(val[0])
+		}
+	case map[string]interface{}:
+		return ldString(val["name"])
+	}
+
+	return ""
+}
+
+// obj returns the property as a node, using the first element if it's an array.
+func (o ldObject) obj(key string) ldObject {
+	if objs := ldObjects(o[key]); len(objs) > 0 {
+		return objs[0]
+	}
+
+	return nil
+}
+
+// objs returns the property as a list of nodes.
+func (o ldObject) objs(key string) []ldObject {
+	return ldObjects(o[key])
+}
+
+// strs returns the property as a list of strings.
+func (o ldObject) strs(key string) []string {
+	out := []string{}
+	switch val := o[key].(type) {
+	case []interface{}:
+		for _, v := range val {
+			if s := ldString(v); s != "" {
+				out = append(out, s)
+			}
+		}
+	default:
+		if s := ldString(val); s != "" {
+			out = append(out, s)
+		}
+	}
+
+	return out
+}
+
+func ldObjects(v interface{}) []ldObject {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		return []ldObject{ldObject(val)}
+	case []interface{}:
+		out := []ldObject{}
+		for _, child := range val {
+			if m, ok := child.(map[string]interface{}); ok {
+				out = append(out, ldObject(m))
+			}
+		}
+		return out
+	}
+
+	return nil
+}
+
+// jsonLD returns the page's JSON-LD nodes and top-level microdata items that have any of the given types, or all of
+// them if no types are given.
+func (d *Document) jsonLD(types ...string) []ldObject {
+	if len(types) == 0 {
+		return d.ld
+	}
+
+	out := []ldObject{}
+	for _, o := range d.ld {
+		if o.is(types...) {
+			out = append(out, o)
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,107 @@
+package recon
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// alternate is a translation of the page, declared via <link rel="alternate" hreflang="...">.
+type alternate struct {
+	lang string
+	href string
+}
+
+// WithAcceptLanguage sets the languages (e.g. "fr-CA", "fr", "en") the parser prefers, most preferred first. They're
+// sent in the Accept-Language header, and if the page isn't in the most preferred language it's available in, the
+// parser follows the page's matching <link rel="alternate" hreflang> translation, or failing that requests the
+// page's matching og:locale:alternate via the fb_locale query parameter.
+func (p *Parser) WithAcceptLanguage(langs ...string) *Parser {
+	p.acceptLanguage = langs
+	return p
+}
+
+// acceptLanguageHeader formats langs as an Accept-Language header value with decreasing quality values.
+func acceptLanguageHeader(langs []string) string {
+	parts := make([]string, len(langs))
+	for i, lang := range langs {
+		if i == 0 {
+			parts[i] = lang
+			continue
+		}
+
+		q := math.Max(1-float64(i)*0.1, 0.1)
+		parts[i] = fmt.Sprintf("%s;q=%.1f", lang, q)
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// normalizeLocale lowercases a language tag or locale and uses hyphens as its separator, so that "en_US" and
+// "en-us" compare equal.
+func normalizeLocale(s string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(s), "_", "-"))
+}
+
+// matchLocale reports whether the locale satisfies the preferred language: either exactly, or by primary language
+// if the preferred language doesn't name a region (so "fr" matches "fr_CA", but "fr-CA" doesn't match "fr_FR").
+func matchLocale(pref, locale string) bool {
+	pref, locale = normalizeLocale(pref), normalizeLocale(locale)
+	if pref == "" || locale == "" {
+		return false
+	}
+
+	if pref == locale {
+		return true
+	}
+
+	primary, _, _ := strings.Cut(locale, "-")
+	return pref == primary
+}
+
+// locale returns the page's locale, as defined via og:locale or the <html lang> attribute.
+func (d *Document) locale() string {
+	if locale := d.MetaContent("og:locale"); locale != "" {
+		return locale
+	}
+
+	return d.lang
+}
+
+// preferredAlternate returns the URL of the translation of the page in the most preferred of langs that the page is
+// available in, or an empty string if the page is already in that language or has no suitable translation.
+func (d *Document) preferredAlternate(langs []string) string {
+	locale := d.locale()
+
+	for _, pref := range langs {
+		if matchLocale(pref, locale) {
+			return ""
+		}
+
+		for _, alt := range d.alternates {
+			if matchLocale(pref, alt.lang) {
+				return d.resolve(alt.href)
+			}
+		}
+
+		for _, m := range d.Meta {
+			if m.Name == "og:locale:alternate" && matchLocale(pref, m.Content) {
+				u := *d.URL
+				q := u.Query()
+				q.Set("fb_locale", m.Content)
+				u.RawQuery = q.Encode()
+				return u.String()
+			}
+		}
+	}
+
+	return ""
+}
+
+// localeExtractor reports the page's locale.
+type localeExtractor struct{}
+
+func (localeExtractor) Extract(doc *Document, res *Result) error {
+	res.Locale = doc.locale()
+	return nil
+}
@@ -0,0 +1,61 @@
+package recon
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const multilingualPage = `<html lang="en"><head>
+	<title>Hello</title>
+	<meta property="og:locale:alternate" content="de_DE">
+	<link rel="alternate" hreflang="en" href="/">
+	<link rel="alternate" hreflang="fr" href="/fr/">
+</head></html>`
+
+func newMultilingualServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+
+		switch {
+		case r.URL.Path == "/fr/":
+			w.Write([]byte(`<html lang="fr"><head><title>Bonjour</title></head></html>`))
+
+		case r.URL.Query().Get("fb_locale") == "de_DE":
+			w.Write([]byte(`<html><head><meta property="og:locale" content="de_DE"><title>Hallo</title></head></html>`))
+
+		default:
+			w.Write([]byte(multilingualPage))
+		}
+	}))
+}
+
+func TestAcceptLanguage(t *testing.T) {
+	srv := newMultilingualServer()
+	defer srv.Close()
+
+	tests := []struct {
+		langs  []string
+		title  string
+		locale string
+	}{
+		{nil, "Hello", "en"},
+		{[]string{"fr-CA", "fr", "en"}, "Bonjour", "fr"},
+		{[]string{"en-US", "en", "fr"}, "Hello", "en"},
+		{[]string{"de", "fr"}, "Hallo", "de_DE"},
+		{[]string{"es"}, "Hello", "en"},
+	}
+
+	for _, test := range tests {
+		res, err := NewParser().WithAcceptLanguage(test.langs...).Parse(srv.URL + "/")
+		assert.Nil(t, err)
+		assert.Equal(t, test.title, res.Title, "langs: %v", test.langs)
+		assert.Equal(t, test.locale, res.Locale, "langs: %v", test.langs)
+	}
+}
+
+func TestAcceptLanguageHeader(t *testing.T) {
+	assert.Equal(t, "fr-CA, fr;q=0.9, en;q=0.8", acceptLanguageHeader([]string{"fr-CA", "fr", "en"}))
+}
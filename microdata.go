@@ -0,0 +1,136 @@
+package recon
+
+import (
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// itemScope is a microdata item (an element with an itemscope attribute) whose properties are still being read.
+type itemScope struct {
+	tag   string
+	depth int
+	obj   ldObject
+}
+
+// microdata reads schema.org microdata into the same form as JSON-LD nodes, so extractors can treat both alike:
+// each item becomes a node with its itemtype as its @type, and each itemprop becomes a property of the item it's in.
+type microdata struct {
+	scopes []*itemScope
+}
+
+// voidElements are the elements that never have an end tag.
+var voidElements = map[string]bool{
+	"area": true, "base": true, "br": true, "col": true, "embed": true, "hr": true, "img": true, "input": true,
+	"link": true, "meta": true, "source": true, "track": true, "wbr": true,
+}
+
+// start handles a start tag, returning the new item if the tag starts a top-level one. Properties whose value is the
+// element's text are read via captures, so start must be called after captures.enter.
+func (m *microdata) start(t html.Token, selfClosing bool, captures *textCaptures) ldObject {
+	void := selfClosing || voidElements[t.Data]
+	if !void {
+		for _, s := range m.scopes {
+			if s.tag == t.Data {
+				s.depth++
+			}
+		}
+	}
+
+	var parent ldObject
+	if len(m.scopes) > 0 {
+		parent = m.scopes[len(m.scopes)-1].obj
+	}
+
+	props := strings.Fields(getAttr(t, "itemprop"))
+
+	if hasAttr(t, "itemscope") {
+		obj := ldObject{}
+		if types := strings.Fields(getAttr(t, "itemtype")); len(types) > 0 {
+			obj["@type"] = types[0]
+		}
+
+		if !void {
+			m.scopes = append(m.scopes, &itemScope{tag: t.Data, depth: 1, obj: obj})
+		}
+
+		if parent != nil && len(props) > 0 {
+			for _, prop := range props {
+				parent.add(prop, map[string]interface{}(obj))
+			}
+			return nil
+		}
+
+		return obj
+	}
+
+	if parent == nil || len(props) == 0 {
+		return nil
+	}
+
+	set := func(v string) {
+		for _, prop := range props {
+			parent.add(prop, strings.TrimSpace(v))
+		}
+	}
+
+	switch {
+	case hasAttr(t, "content"):
+		set(getAttr(t, "content"))
+	case t.Data == "a" || t.Data == "link" || t.Data == "area":
+		set(getAttr(t, "href"))
+	case t.Data == "img" || t.Data == "audio" || t.Data == "video" || t.Data == "source" || t.Data == "iframe" ||
+		t.Data == "embed" || t.Data == "track":
+		set(getAttr(t, "src"))
+	case t.Data == "object":
+		set(getAttr(t, "data"))
+	case t.Data == "data" || t.Data == "meter":
+		set(getAttr(t, "value"))
+	case t.Data == "time" && hasAttr(t, "datetime"):
+		set(getAttr(t, "datetime"))
+	case !void:
+		captures.start(t.Data, func(text string) {
+			set(collapseWhitespace(text))
+		})
+	}
+
+	return nil
+}
+
+// end handles an end tag, closing the item whose element it was.
+func (m *microdata) end(tag string) {
+	for i := len(m.scopes) - 1; i >= 0; i-- {
+		s := m.scopes[i]
+		if s.tag != tag {
+			continue
+		}
+
+		s.depth--
+		if s.depth == 0 {
+			m.scopes = append(m.scopes[:i], m.scopes[i+1:]...)
+		}
+	}
+}
+
+// hasItemprop returns whether the element has the given microdata property.
+func hasItemprop(t html.Token, prop string) bool {
+	for _, p := range strings.Fields(getAttr(t, "itemprop")) {
+		if p == prop {
+			return true
+		}
+	}
+
+	return false
+}
+
+// add adds a value to the property, turning it into a list if it already has one.
+func (o ldObject) add(key string, v interface{}) {
+	switch existing := o[key].(type) {
+	case nil:
+		o[key] = v
+	case []interface{}:
+		o[key] = append(existing, v)
+	default:
+		o[key] = []interface{}{existing, v}
+	}
+}
@@ -0,0 +1,191 @@
+package recon
+
+import (
+	"html"
+	"regexp"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// entityPattern matches a character reference terminated by a semicolon. Unlike html.UnescapeString, legacy
+// references without one, like "&copy", are left alone, since in text that's already been decoded once they're far
+// more likely to be literal text, e.g. in a URL's query string.
+var entityPattern = regexp.MustCompile(`&(?:#[0-9]{1,7}|#[xX][0-9a-fA-F]{1,6}|[a-zA-Z][a-zA-Z0-9]{1,31});`)
+
+// decodeEntities decodes the character references left in s, e.g. by a page that escapes its metadata twice, or by
+// JSON-LD or oEmbed data escaped for HTML.
+func decodeEntities(s string) string {
+	if !strings.Contains(s, "&") {
+		return s
+	}
+
+	return entityPattern.ReplaceAllStringFunc(s, func(ref string) string {
+		// html.UnescapeString decodes a legacy reference at the start of an unknown one, e.g. "&not" in
+		// "&notanentity;", leaving the rest, semicolon and all
+		decoded := html.UnescapeString(ref)
+		if ref[1] != '#' && ref != "&semi;" && strings.HasSuffix(decoded, ";") {
+			return ref
+		}
+
+		return decoded
+	})
+}
+
+// normalizeText decodes the character references left in s and puts it in Unicode's NFC form, so that text that
+// looks the same compares the same, e.g. "e" followed by a combining acute accent becomes "é", as do the decomposed
+// strings that some systems (notably macOS) produce.
+func normalizeText(s string) string {
+	return norm.NFC.String(decodeEntities(s))
+}
+
+// textFields returns pointers to the Result's human-readable text fields, as opposed to URLs, identifiers and the
+// like, so they can be normalized together. Names that are usually profile URLs, like an Article's Authors, are
+// included, since pages often give plain names instead. Extras are normalized separately, as they're in a map; Videos, Embed
+// URLs, Apps, Facebook and Book.ISBN are left out as they only hold URLs and identifiers.
+func (r *Result) textFields() []*string {
+	fields := []*string{&r.Site, &r.Title, &r.Description, &r.Author, &r.Publisher, &r.Section, &r.Summary}
+	if r.Byline != nil {
+		fields = append(fields, &r.Byline.Name)
+	}
+	if r.Article != nil {
+		fields = append(fields, &r.Article.Section)
+		fields = appendFields(fields, r.Article.Authors)
+		fields = appendFields(fields, r.Article.Tags)
+	}
+	if r.Profile != nil {
+		fields = append(fields, &r.Profile.FirstName, &r.Profile.LastName, &r.Profile.Username, &r.Profile.Gender)
+	}
+	if r.Book != nil {
+		fields = appendFields(fields, r.Book.Authors)
+		fields = appendFields(fields, r.Book.Tags)
+	}
+	if r.Video != nil {
+		for i := range r.Video.Actors {
+			fields = append(fields, &r.Video.Actors[i].Profile, &r.Video.Actors[i].Role)
+		}
+		fields = appendFields(fields, r.Video.Directors)
+		fields = appendFields(fields, r.Video.Writers)
+		fields = appendFields(fields, r.Video.Tags)
+	}
+	if r.Music != nil {
+		fields = appendFields(fields, r.Music.Musicians)
+		fields = appendFields(fields, r.Music.Creators)
+	}
+	for i := range r.Embeds {
+		fields = append(fields, &r.Embeds[i].Title)
+	}
+	for i := range r.Images {
+		fields = append(fields, &r.Images[i].Alt)
+	}
+	for i := range r.Audio {
+		fields = append(fields, &r.Audio[i].Title)
+	}
+	if r.OEmbed != nil {
+		fields = append(fields, &r.OEmbed.Title, &r.OEmbed.AuthorName, &r.OEmbed.ProviderName)
+	}
+	if r.Product != nil {
+		fields = append(fields, &r.Product.Name, &r.Product.Brand)
+	}
+	if r.Recipe != nil {
+		fields = append(fields, &r.Recipe.Name, &r.Recipe.Yield)
+		fields = appendFields(fields, r.Recipe.Ingredients)
+		fields = appendFields(fields, r.Recipe.Instructions)
+	}
+	if r.Event != nil {
+		fields = append(fields, &r.Event.Name)
+		if r.Event.Venue != nil {
+			fields = append(fields, &r.Event.Venue.Name, &r.Event.Venue.Address)
+		}
+	}
+
+	return fields
+}
+
+// appendFields appends pointers to each of values to fields.
+func appendFields(fields []*string, values []string) []*string {
+	for i := range values {
+		fields = append(fields, &values[i])
+	}
+
+	return fields
+}
+
+// titleSeparators are the separators sites put between a page's title and their name.
+var titleSeparators = []string{" | ", " - ", " – ", " — ", " · ", " • ", " :: ", " / ", " » "}
+
+// cleanTitle strips site's name from the end of title, along with the separator before it, e.g. "Article | Site"
+// becomes "Article". Titles that end with anything else, or are only the site's name, are returned as they are.
+func cleanTitle(title, site string) string {
+	site = strings.TrimSpace(site)
+	if site == "" {
+		return title
+	}
+
+	for _, sep := range titleSeparators {
+		i := strings.LastIndex(title, sep)
+		if i <= 0 || !strings.EqualFold(collapseWhitespace(title[i+len(sep):]), collapseWhitespace(site)) {
+			continue
+		}
+
+		if cleaned := strings.TrimSpace(title[:i]); cleaned != "" {
+			return cleaned
+		}
+	}
+
+	return title
+}
+
+// textField returns a pointer to the Result's top-level text field with the given JSON name, or nil if it doesn't
+// have one.
+func (r *Result) textField(name string) *string {
+	switch name {
+	case "site_name":
+		return &r.Site
+	case "title":
+		return &r.Title
+	case "description":
+		return &r.Description
+	case "author":
+		return &r.Author
+	case "publisher":
+		return &r.Publisher
+	case "section":
+		return &r.Section
+	case "summary":
+		return &r.Summary
+	}
+
+	return nil
+}
+
+// truncate truncates the Result's text fields to the lengths given, keyed by their JSON names (see truncateWords).
+func (r *Result) truncate(lengths map[string]int) {
+	for name, n := range lengths {
+		if field := r.textField(name); field != nil {
+			*field = truncateWords(*field, n)
+		}
+	}
+}
+
+// normalize decodes the character references left in the Result's text fields and Extras and puts them in NFC form
+// (see normalizeText), and collapses the whitespace in its Title, Description and image Alt text unless
+// keepWhitespace is set.
+func (r *Result) normalize(keepWhitespace bool) {
+	for _, field := range r.textFields() {
+		*field = normalizeText(*field)
+	}
+	for k, v := range r.Extras {
+		r.Extras[k] = normalizeText(v)
+	}
+
+	if keepWhitespace {
+		return
+	}
+
+	r.Title = collapseWhitespace(r.Title)
+	r.Description = collapseWhitespace(r.Description)
+	for i := range r.Images {
+		r.Images[i].Alt = collapseWhitespace(r.Images[i].Alt)
+	}
+}
@@ -0,0 +1,148 @@
+package recon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeEntities(t *testing.T) {
+	tests := map[string]string{
+		"Tom &amp; Jerry":                 "Tom & Jerry",
+		"It&#8217;s &#x201C;fine&#x201d;": "It’s “fine”",
+		"A&nbsp;B":                        "A B",
+		"R&D and Q&A":                     "R&D and Q&A",
+		"?a=1&copy=2":                     "?a=1&copy=2",
+		"&notanentity;":                   "&notanentity;",
+	}
+
+	for in, want := range tests {
+		assert.Equal(t, want, decodeEntities(in), in)
+	}
+}
+
+func TestNormalizeText(t *testing.T) {
+	tests := map[string]string{
+		"plain ascii": "plain ascii",
+		"Café":       "Café",
+		"Café":        "Café",
+		"Việt Nam":  "Việt Nam",
+		"ố":         "ố",
+		"한":         "한",
+		"x́":          "x́",
+		"̈́ dangling": "̈́ dangling",
+	}
+
+	for in, want := range tests {
+		assert.Equal(t, want, normalizeText(in), "%+q", in)
+	}
+}
+
+func TestNormalizeResult(t *testing.T) {
+	srv := newTestServer("text/html", `<html><head>
+		<meta property="og:title" content="Tom &amp;amp; Jerry&amp;#8217;s Cafe&#x301;">
+		<meta property="og:url" content="https://example.com/?a=1&amp;b=2">
+		<meta property="og:type" content="article">
+		<meta property="article:tag" content="Cafe&#x301; &amp;amp; Bar">
+		<script type="application/ld+json">{"@type": "Recipe", "name": "Mac &amp; Cheese",
+			"recipeIngredient": ["2 cups cr&#232;me fra&#238;che"]}</script>
+	</head><body><iframe src="/map" title="Mapa de Bogota&#x301;"></iframe></body></html>`)
+	defer srv.Close()
+
+	res, err := NewParser().Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "Tom & Jerry’s Café", res.Title)
+	if assert.NotNil(t, res.Article) {
+		assert.Equal(t, []string{"Café & Bar"}, res.Article.Tags)
+	}
+	if assert.Len(t, res.Embeds, 1) {
+		assert.Equal(t, "Mapa de Bogotá", res.Embeds[0].Title)
+	}
+	assert.Equal(t, "https://example.com/?a=1&b=2", res.URL)
+	if assert.NotNil(t, res.Recipe) {
+		assert.Equal(t, "Mac & Cheese", res.Recipe.Name)
+		assert.Equal(t, []string{"2 cups crème fraîche"}, res.Recipe.Ingredients)
+	}
+}
+
+func TestWhitespaceNormalization(t *testing.T) {
+	srv := newImageServer(`<html><head>
+		<title>
+			A title
+			over	two lines
+		</title>
+		<meta name="description" content="Spaced   out&#10;description">
+	</head><body><img src="/a.png" alt=" An
+		image "></body></html>`, map[string][2]int{"/a.png": {100, 100}})
+	defer srv.Close()
+
+	res, err := NewParser().Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "A title over two lines", res.Title)
+	assert.Equal(t, "Spaced out description", res.Description)
+	if assert.Len(t, res.Images, 1) {
+		assert.Equal(t, "An image", res.Images[0].Alt)
+	}
+
+	res, err = NewParser().WithWhitespaceNormalization(false).Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "\n\t\t\tA title\n\t\t\tover\ttwo lines\n\t\t", res.Title)
+	assert.Equal(t, "Spaced   out\ndescription", res.Description)
+	if assert.Len(t, res.Images, 1) {
+		assert.Equal(t, " An\n\t\timage ", res.Images[0].Alt)
+	}
+}
+
+func TestMaxFieldLength(t *testing.T) {
+	srv := newTestServer("text/html", `<html><head>
+		<title>A fairly long title for a page</title>
+		<meta name="description" content="A description that goes on, and on, and on for quite a while.">
+		<meta property="og:site_name" content="Example">
+	</head></html>`)
+	defer srv.Close()
+
+	res, err := NewParser().
+		WithMaxFieldLength("title", 16).
+		WithMaxFieldLength("description", 40).
+		WithMaxFieldLength("site_name", 3).
+		WithMaxFieldLength("site_name", 0).
+		WithMaxFieldLength("url", 5).
+		Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "A fairly long…", res.Title)
+	assert.Equal(t, "A description that goes on, and on…", res.Description)
+	assert.Equal(t, "Example", res.Site)
+	assert.Equal(t, srv.URL, res.URL)
+}
+
+func TestCleanTitle(t *testing.T) {
+	tests := []struct {
+		title, site, want string
+	}{
+		{"Article | Example", "Example", "Article"},
+		{"Article – The  Example Times", "the example times", "Article"},
+		{"Part 1 - Part 2 - Example", "Example", "Part 1 - Part 2"},
+		{"Article | Another Site", "Example", "Article | Another Site"},
+		{"Example", "Example", "Example"},
+		{" | Example", "Example", " | Example"},
+		{"Article | Example", "", "Article | Example"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.want, cleanTitle(test.title, test.site), test.title)
+	}
+
+	srv := newTestServer("text/html", `<html><head>
+		<title>Breaking news &mdash; Example</title>
+		<meta property="og:site_name" content="Example">
+	</head></html>`)
+	defer srv.Close()
+
+	res, err := NewParser().Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "Breaking news — Example", res.Title)
+
+	res, err = NewParser().WithTitleCleanup().Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "Breaking news", res.Title)
+}
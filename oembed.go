@@ -0,0 +1,267 @@
+package recon
+
+import (
+	"encoding/json"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// OEmbed is a page's oEmbed data (see https://oembed.com), which describes how to embed the page's content. Its
+// dimensions are 0 if the provider leaves them out or sends something other than a number.
+type OEmbed struct {
+	Type            string `json:"type"`
+	Version         string `json:"version,omitempty"`
+	Title           string `json:"title,omitempty"`
+	AuthorName      string `json:"author_name,omitempty"`
+	AuthorURL       string `json:"author_url,omitempty"`
+	ProviderName    string `json:"provider_name,omitempty"`
+	ProviderURL     string `json:"provider_url,omitempty"`
+	ThumbnailURL    string `json:"thumbnail_url,omitempty"`
+	ThumbnailWidth  int    `json:"thumbnail_width,omitempty"`
+	ThumbnailHeight int    `json:"thumbnail_height,omitempty"`
+	URL             string `json:"url,omitempty"`
+	HTML            string `json:"html,omitempty"`
+	Width           int    `json:"width,omitempty"`
+	Height          int    `json:"height,omitempty"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting dimensions given as numbers, as strings holding numbers (as
+// some providers send them) or as null.
+func (o *OEmbed) UnmarshalJSON(b []byte) error {
+	type plain OEmbed
+	aux := struct {
+		*plain
+		ThumbnailWidth  lenientInt `json:"thumbnail_width"`
+		ThumbnailHeight lenientInt `json:"thumbnail_height"`
+		Width           lenientInt `json:"width"`
+		Height          lenientInt `json:"height"`
+	}{plain: (*plain)(o)}
+
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+
+	o.ThumbnailWidth = int(aux.ThumbnailWidth)
+	o.ThumbnailHeight = int(aux.ThumbnailHeight)
+	o.Width = int(aux.Width)
+	o.Height = int(aux.Height)
+
+	return nil
+}
+
+// lenientInt is an integer that decodes from a JSON number or a string holding one. Anything else, including null,
+// decodes as 0.
+type lenientInt int
+
+func (n *lenientInt) UnmarshalJSON(b []byte) error {
+	s := string(b)
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = strings.TrimSpace(unquoted)
+	}
+
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		*n = 0
+		return nil
+	}
+
+	*n = lenientInt(f)
+	return nil
+}
+
+// OEmbedProvider is an oEmbed endpoint and the URLs it can describe.
+type OEmbedProvider struct {
+	Name string
+
+	// Schemes are the URL patterns the provider handles, where * matches any run of characters, e.g.
+	// "https://*.youtube.com/watch*". http and https are interchangeable, and a "*." at the start of the host also
+	// matches the bare domain.
+	Schemes []string
+
+	// Endpoint is the provider's oEmbed endpoint, which is called with the page's URL in its url query parameter.
+	Endpoint string
+}
+
+// DefaultOEmbedProviders are the oEmbed providers recon knows about out of the box, from the standard list at
+// https://oembed.com/providers.json.
+var DefaultOEmbedProviders = []OEmbedProvider{
+	{
+		Name: "YouTube",
+		Schemes: []string{
+			"https://*.youtube.com/watch*",
+			"https://*.youtube.com/v/*",
+			"https://*.youtube.com/shorts/*",
+			"https://*.youtube.com/playlist?list=*",
+			"https://youtu.be/*",
+		},
+		Endpoint: "https://www.youtube.com/oembed",
+	},
+	{
+		Name: "Vimeo",
+		Schemes: []string{
+			"https://vimeo.com/*",
+			"https://player.vimeo.com/video/*",
+		},
+		Endpoint: "https://vimeo.com/api/oembed.json",
+	},
+	{
+		Name: "Twitter",
+		Schemes: []string{
+			"https://*.twitter.com/*/status/*",
+			"https://*.x.com/*/status/*",
+		},
+		Endpoint: "https://publish.twitter.com/oembed",
+	},
+	{
+		Name: "SoundCloud",
+		Schemes: []string{
+			"https://soundcloud.com/*",
+			"https://on.soundcloud.com/*",
+		},
+		Endpoint: "https://soundcloud.com/oembed",
+	},
+	{
+		Name: "Flickr",
+		Schemes: []string{
+			"https://*.flickr.com/photos/*",
+			"https://flic.kr/p/*",
+		},
+		Endpoint: "https://www.flickr.com/services/oembed/",
+	},
+}
+
+// maxOEmbedSize is the largest oEmbed response recon reads.
+const maxOEmbedSize = 1 << 20
+
+// WithOEmbed enables fetching the page's oEmbed data into Result.OEmbed, using the endpoint the page advertises with a
+// <link type="application/json+oembed"> tag or, if it doesn't, the first of the parser's providers that handles the
+// page's URL. This costs an extra request per page.
+func (p *Parser) WithOEmbed() *Parser {
+	p.oembed = true
+	return p
+}
+
+// WithOEmbedProviders adds oEmbed providers that are consulted, in order, before DefaultOEmbedProviders. It doesn't
+// enable oEmbed lookups by itself; see WithOEmbed.
+func (p *Parser) WithOEmbedProviders(providers ...OEmbedProvider) *Parser {
+	p.oembedProviders = append(append([]OEmbedProvider{}, p.oembedProviders...), providers...)
+	return p
+}
+
+// oembedEndpoint returns the URL of the oEmbed data for the page at pageURL, using the first provider that handles it.
+func oembedEndpoint(providers []OEmbedProvider, pageURL string) string {
+	for _, provider := range providers {
+		for _, scheme := range provider.Schemes {
+			if oembedScheme(scheme).MatchString(pageURL) {
+				u, err := url.Parse(provider.Endpoint)
+				if err != nil {
+					continue
+				}
+
+				q := u.Query()
+				q.Set("url", pageURL)
+				q.Set("format", "json")
+				u.RawQuery = q.Encode()
+				return u.String()
+			}
+		}
+	}
+
+	return ""
+}
+
+// oembedSchemes caches the compiled OEmbedProvider schemes, keyed by scheme.
+var oembedSchemes sync.Map
+
+// oembedScheme returns an OEmbedProvider scheme as a regular expression, compiling it the first time it's seen.
+func oembedScheme(scheme string) *regexp.Regexp {
+	if re, ok := oembedSchemes.Load(scheme); ok {
+		return re.(*regexp.Regexp)
+	}
+
+	expr := regexp.QuoteMeta(strings.TrimPrefix(strings.TrimPrefix(scheme, "http://"), "https://"))
+	if strings.HasPrefix(expr, `\*\.`) {
+		expr = `([^/]*\.)?` + strings.TrimPrefix(expr, `\*\.`)
+	}
+	expr = strings.ReplaceAll(expr, `\*`, `.*`)
+
+	re, _ := oembedSchemes.LoadOrStore(scheme, regexp.MustCompile(`^(?i:https?)://`+expr+`$`))
+	return re.(*regexp.Regexp)
+}
+
+// fetchOEmbed sends req to an oEmbed endpoint and decodes the response.
+func (p *Parser) fetchOEmbed(req *http.Request) (*OEmbed, error) {
+	resp, err := p.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, errors.New(resp.Status)
+	}
+
+	if err := p.decodeBody(resp); err != nil {
+		return nil, err
+	}
+
+	var o OEmbed
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxOEmbedSize)).Decode(&o); err != nil {
+		return nil, errors.Wrap(err, "decode oembed")
+	}
+
+	return &o, nil
+}
+
+// oembedExtractor fetches the page's oEmbed data, if enabled, and uses it to fill in the title and author if they're
+// missing.
+type oembedExtractor struct {
+	parser *Parser
+}
+
+func (e oembedExtractor) Extract(doc *Document, res *Result) error {
+	if !e.parser.oembed {
+		return nil
+	}
+
+	endpoint := ""
+	if doc.oembed != "" {
+		endpoint = doc.resolve(doc.oembed)
+	} else {
+		providers := append(append([]OEmbedProvider{}, e.parser.oembedProviders...), DefaultOEmbedProviders...)
+		endpoint = oembedEndpoint(providers, doc.URL.String())
+	}
+
+	if endpoint == "" {
+		return nil
+	}
+
+	req, err := e.parser.newReq(doc.context(), endpoint)
+	if err != nil {
+		return nil
+	}
+
+	// a page without oEmbed data is still worth a preview, so failures are ignored
+	o, err := e.parser.fetchOEmbed(req)
+	if err != nil {
+		return nil
+	}
+
+	res.OEmbed = o
+	if res.Title == "" {
+		res.Title = o.Title
+	}
+	if res.Author == "" {
+		res.Author = o.AuthorName
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package recon
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOEmbedEndpoint(t *testing.T) {
+	tests := map[string]string{
+		"https://www.youtube.com/watch?v=dQw4w9WgXcQ": "https://www.youtube.com/oembed",
+		"https://youtube.com/watch?v=dQw4w9WgXcQ":     "https://www.youtube.com/oembed",
+		"http://youtu.be/dQw4w9WgXcQ":                 "https://www.youtube.com/oembed",
+		"https://vimeo.com/76979871":                  "https://vimeo.com/api/oembed.json",
+		"https://x.com/golang/status/123":             "https://publish.twitter.com/oembed",
+		"https://soundcloud.com/artist/track":         "https://soundcloud.com/oembed",
+		"https://www.flickr.com/photos/someone/1234":  "https://www.flickr.com/services/oembed/",
+		"https://example.com/watch?v=1":               "",
+		"https://notyoutube.com/watch?v=1":            "",
+	}
+
+	for page, want := range tests {
+		endpoint := oembedEndpoint(DefaultOEmbedProviders, page)
+		if want == "" {
+			assert.Equal(t, "", endpoint, page)
+			continue
+		}
+
+		u, err := url.Parse(endpoint)
+		if assert.Nil(t, err, page) {
+			assert.Equal(t, want, u.Scheme+"://"+u.Host+u.Path, page)
+			assert.Equal(t, page, u.Query().Get("url"), page)
+		}
+	}
+}
+
+func TestOEmbedScheme(t *testing.T) {
+	scheme := "https://*.youtube.com/watch*"
+	assert.Same(t, oembedScheme(scheme), oembedScheme(scheme))
+}
+
+func TestOEmbedDimensions(t *testing.T) {
+	var o OEmbed
+	err := json.Unmarshal([]byte(`{"type":"video","title":"Clip","width":"480","height":270.0,
+		"thumbnail_width":null,"thumbnail_height":"auto"}`), &o)
+	assert.Nil(t, err)
+	assert.Equal(t, OEmbed{Type: "video", Title: "Clip", Width: 480, Height: 270}, o)
+
+	var photo OEmbed
+	err = json.Unmarshal([]byte(`{"type":"photo","width":640,"thumbnail_width":" 120 "}`), &photo)
+	assert.Nil(t, err)
+	assert.Equal(t, OEmbed{Type: "photo", Width: 640, ThumbnailWidth: 120}, photo)
+}
+
+func TestOEmbed(t *testing.T) {
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oembed":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"type": "video", "version": "1.0", "title": "A Video", "author_name": "Jane Doe", "html": "<iframe></iframe>", "width": 640, "height": 360}`))
+
+		case "/discovered":
+			w.Header().Set("Content-Type", "text/html")
+			w.Write([]byte(`<html><head>
+				<link rel="alternate" type="application/json+oembed" href="/oembed?url=x">
+			</head></html>`))
+
+		default:
+			w.Header().Set("Content-Type", "text/html")
+			w.Write([]byte(`<html><head></head></html>`))
+		}
+	}))
+	defer srv.Close()
+
+	res, err := NewParser().Parse(srv.URL + "/discovered")
+	assert.Nil(t, err)
+	assert.Nil(t, res.OEmbed, "oEmbed lookups should be opt-in")
+
+	res, err = NewParser().WithOEmbed().Parse(srv.URL + "/discovered")
+	assert.Nil(t, err)
+	if assert.NotNil(t, res.OEmbed) {
+		assert.Equal(t, "video", res.OEmbed.Type)
+		assert.Equal(t, "<iframe></iframe>", res.OEmbed.HTML)
+		assert.Equal(t, 640, res.OEmbed.Width)
+	}
+	assert.Equal(t, "A Video", res.Title)
+	assert.Equal(t, "Jane Doe", res.Author)
+
+	p := NewParser().WithOEmbed().WithOEmbedProviders(OEmbedProvider{
+		Name:     "Test",
+		Schemes:  []string{srv.URL + "/videos/*"},
+		Endpoint: srv.URL + "/oembed",
+	})
+
+	res, err = p.Parse(srv.URL + "/videos/1")
+	assert.Nil(t, err)
+	assert.NotNil(t, res.OEmbed)
+
+	res, err = p.Parse(srv.URL + "/other")
+	assert.Nil(t, err)
+	assert.Nil(t, res.OEmbed)
+}
@@ -0,0 +1,283 @@
+package recon
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Article is the article namespace of an og:type=article page (see https://ogp.me/#type_article).
+type Article struct {
+	PublishedTime  *time.Time `json:"published_time,omitempty"`
+	ModifiedTime   *time.Time `json:"modified_time,omitempty"`
+	ExpirationTime *time.Time `json:"expiration_time,omitempty"`
+
+	// Authors are the article's authors, usually as profile URLs.
+	Authors []string `json:"authors,omitempty"`
+
+	Section string   `json:"section,omitempty"`
+	Tags    []string `json:"tags,omitempty"`
+}
+
+// Profile is the profile namespace of an og:type=profile page (see https://ogp.me/#type_profile), or the person a
+// schema.org ProfilePage is about. A schema.org Person fills in what the tags leave out.
+type Profile struct {
+	FirstName string `json:"first_name,omitempty"`
+	LastName  string `json:"last_name,omitempty"`
+	Username  string `json:"username,omitempty"`
+
+	// Gender is "male" or "female", as defined via profile:gender, or the page's own value otherwise.
+	Gender string `json:"gender,omitempty"`
+}
+
+// extractProfile reads the page's profile namespace properties and the schema.org Person it's about.
+func extractProfile(doc *Document) *Profile {
+	p := &Profile{
+		FirstName: doc.MetaContent("profile:first_name"),
+		LastName:  doc.MetaContent("profile:last_name"),
+		Username:  doc.MetaContent("profile:username"),
+		Gender:    doc.MetaContent("profile:gender"),
+	}
+
+	var person ldObject
+	for _, page := range doc.jsonLD("ProfilePage") {
+		if person = page.obj("mainEntity"); person != nil {
+			break
+		}
+	}
+	if people := doc.jsonLD("Person"); person == nil && len(people) > 0 {
+		person = people[0]
+	}
+
+	if person != nil {
+		p.FirstName = firstNonEmpty(p.FirstName, person.str("givenName"))
+		p.LastName = firstNonEmpty(p.LastName, person.str("familyName"))
+		p.Username = firstNonEmpty(p.Username, person.str("alternateName"))
+		p.Gender = firstNonEmpty(p.Gender, person.str("gender"))
+	}
+
+	// handles are often written with their @, and schema.org genders as URLs like https://schema.org/Female
+	p.Username = strings.TrimPrefix(p.Username, "@")
+	if g := strings.ToLower(p.Gender[strings.LastIndex(p.Gender, "/")+1:]); g == "male" || g == "female" {
+		p.Gender = g
+	}
+
+	return p
+}
+
+// Book is the book namespace of an og:type=book page (see https://ogp.me/#type_book), or of an og:type=books.book
+// page using Facebook's older books namespace, as used by Goodreads. A schema.org Book fills in what the tags leave
+// out.
+type Book struct {
+	// Authors are the book's authors, usually as profile URLs.
+	Authors []string `json:"authors,omitempty"`
+
+	ISBN        string     `json:"isbn,omitempty"`
+	ReleaseDate *time.Time `json:"release_date,omitempty"`
+	Tags        []string   `json:"tags,omitempty"`
+	PageCount   int        `json:"page_count,omitempty"`
+}
+
+// extractBook reads the page's book namespace properties, in either the book: or books: namespace, and its
+// schema.org Book.
+func extractBook(doc *Document) *Book {
+	b := &Book{
+		Authors:     append(doc.MetaContents("book:author"), doc.MetaContents("books:author")...),
+		ISBN:        firstNonEmpty(doc.MetaContent("book:isbn"), doc.MetaContent("books:isbn")),
+		ReleaseDate: doc.metaTime("book:release_date"),
+		Tags:        append(doc.MetaContents("book:tag"), doc.MetaContents("books:tag")...),
+	}
+	if b.ReleaseDate == nil {
+		b.ReleaseDate = doc.metaTime("books:release_date")
+	}
+	b.PageCount, _ = strconv.Atoi(doc.MetaContent("books:page_count"))
+
+	if books := doc.jsonLD("Book"); len(books) > 0 {
+		o := books[0]
+
+		// an edition's details are often on the work's workExample
+		editions := append([]ldObject{o}, o.objs("workExample")...)
+		for _, edition := range editions {
+			b.ISBN = firstNonEmpty(b.ISBN, edition.str("isbn"))
+			if b.PageCount == 0 {
+				b.PageCount, _ = strconv.Atoi(edition.str("numberOfPages"))
+			}
+			if b.ReleaseDate == nil {
+				if t, ok := parseDate(edition.str("datePublished")); ok {
+					b.ReleaseDate = &t
+				}
+			}
+		}
+
+		if len(b.Authors) == 0 {
+			b.Authors = o.strs("author")
+		}
+	}
+
+	if len(b.Authors) == 0 {
+		b.Authors = nil
+	}
+	if len(b.Tags) == 0 {
+		b.Tags = nil
+	}
+
+	return b
+}
+
+// VideoDetails is the video namespace of an og:type=video.movie, video.episode, video.tv_show or video.other page
+// (see https://ogp.me/#type_video).
+type VideoDetails struct {
+	Actors    []Actor  `json:"actors,omitempty"`
+	Directors []string `json:"directors,omitempty"`
+	Writers   []string `json:"writers,omitempty"`
+
+	// Duration is the video's length in seconds.
+	Duration int `json:"duration,omitempty"`
+
+	ReleaseDate *time.Time `json:"release_date,omitempty"`
+	Tags        []string   `json:"tags,omitempty"`
+
+	// Series is the show an episode belongs to, usually as a URL. It's only set for video.episode pages.
+	Series string `json:"series,omitempty"`
+}
+
+// Music is the music namespace of an og:type=music.song, music.album, music.playlist or music.radio_station page (see
+// https://ogp.me/#type_music).
+type Music struct {
+	// Duration is a song's length in seconds.
+	Duration int `json:"duration,omitempty"`
+
+	// Albums are the albums a song is on, and its position on each.
+	Albums []MusicTrack `json:"albums,omitempty"`
+
+	// Musicians are the artists of a song or album, usually as profile URLs.
+	Musicians []string `json:"musicians,omitempty"`
+
+	// Songs are the songs on an album or playlist, and their positions.
+	Songs []MusicTrack `json:"songs,omitempty"`
+
+	// ReleaseDate is when an album was released.
+	ReleaseDate *time.Time `json:"release_date,omitempty"`
+
+	// Creators are the creators of a playlist or radio station, usually as profile URLs.
+	Creators []string `json:"creators,omitempty"`
+}
+
+// MusicTrack is a reference from a song to an album, or from an album or playlist to a song, with the song's
+// position.
+type MusicTrack struct {
+	// URL is the album's or song's page.
+	URL   string `json:"url"`
+	Disc  int    `json:"disc,omitempty"`
+	Track int    `json:"track,omitempty"`
+}
+
+// Actor is an actor in a video and the role they played.
+type Actor struct {
+	// Profile is the actor, usually as a profile URL.
+	Profile string `json:"profile"`
+	Role    string `json:"role,omitempty"`
+}
+
+// metaTime parses the content of the first meta tag with the given name as a date.
+func (d *Document) metaTime(name string) *time.Time {
+	if t, ok := parseDate(d.MetaContent(name)); ok {
+		return &t
+	}
+
+	return nil
+}
+
+// setPosition sets the disc or track number of the last of tracks, from a music:album:* or music:song:* tag.
+func setPosition(tracks []MusicTrack, field, value string) {
+	if len(tracks) == 0 {
+		return
+	}
+
+	n, _ := strconv.Atoi(value)
+	if t := &tracks[len(tracks)-1]; field == "disc" {
+		t.Disc = n
+	} else {
+		t.Track = n
+	}
+}
+
+// ogTypeExtractor fills in the typed struct for the page's og:type, if it has one, from the type's namespace.
+type ogTypeExtractor struct{}
+
+func (ogTypeExtractor) Extract(doc *Document, res *Result) error {
+	ogType := strings.ToLower(strings.TrimSpace(doc.MetaContent("og:type")))
+
+	switch {
+	case ogType == "article":
+		res.Article = &Article{
+			PublishedTime:  doc.metaTime("article:published_time"),
+			ModifiedTime:   doc.metaTime("article:modified_time"),
+			ExpirationTime: doc.metaTime("article:expiration_time"),
+			Authors:        doc.MetaContents("article:author"),
+			Section:        doc.MetaContent("article:section"),
+			Tags:           doc.MetaContents("article:tag"),
+		}
+
+	case ogType == "profile":
+		res.Profile = extractProfile(doc)
+
+	case ogType == "book" || ogType == "books.book":
+		res.Book = extractBook(doc)
+
+	case strings.HasPrefix(ogType, "video."):
+		v := &VideoDetails{
+			Directors:   doc.MetaContents("video:director"),
+			Writers:     doc.MetaContents("video:writer"),
+			Duration:    durationSeconds(doc.MetaContent("video:duration")),
+			ReleaseDate: doc.metaTime("video:release_date"),
+			Tags:        doc.MetaContents("video:tag"),
+		}
+		if ogType == "video.episode" {
+			v.Series = doc.MetaContent("video:series")
+		}
+
+		// video:actor:role describes the video:actor before it
+		for _, m := range doc.Meta {
+			switch m.Name {
+			case "video:actor", "video:actor:id":
+				v.Actors = append(v.Actors, Actor{Profile: m.Content})
+			case "video:actor:role":
+				if len(v.Actors) > 0 {
+					v.Actors[len(v.Actors)-1].Role = m.Content
+				}
+			}
+		}
+
+		res.Video = v
+
+	case strings.HasPrefix(ogType, "music."):
+		m := &Music{
+			Duration:    durationSeconds(doc.MetaContent("music:duration")),
+			Musicians:   doc.MetaContents("music:musician"),
+			ReleaseDate: doc.metaTime("music:release_date"),
+			Creators:    doc.MetaContents("music:creator"),
+		}
+
+		// music:album:disc, music:song:track, etc. describe the album or song before them
+		for _, meta := range doc.Meta {
+			switch meta.Name {
+			case "music:album", "music:album:url":
+				m.Albums = append(m.Albums, MusicTrack{URL: meta.Content})
+			case "music:song", "music:song:url":
+				m.Songs = append(m.Songs, MusicTrack{URL: meta.Content})
+			case "music:album:disc", "music:album:track":
+				setPosition(m.Albums, strings.TrimPrefix(meta.Name, "music:album:"), meta.Content)
+			case "music:song:disc", "music:song:track":
+				setPosition(m.Songs, strings.TrimPrefix(meta.Name, "music:song:"), meta.Content)
+			}
+		}
+
+		res.Music = m
+
+	case len(doc.jsonLD("ProfilePage")) > 0:
+		res.Profile = extractProfile(doc)
+	}
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package recon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOGTypes(t *testing.T) {
+	published := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	released := time.Date(2019, 5, 24, 0, 0, 0, 0, time.UTC)
+
+	srv := newTestServer("text/html", `<html><head>
+		<meta property="og:type" content="article">
+		<meta property="article:published_time" content="2024-03-01T12:00:00Z">
+		<meta property="article:author" content="https://example.com/jane">
+		<meta property="article:author" content="https://example.com/john">
+		<meta property="article:section" content="Science">
+		<meta property="article:tag" content="space">
+		<meta property="article:tag" content="rockets">
+	</head><body></body></html>`)
+	res, err := Parse(srv.URL)
+	srv.Close()
+	assert.Nil(t, err)
+	if assert.NotNil(t, res.Article) {
+		assert.True(t, published.Equal(*res.Article.PublishedTime))
+		assert.Nil(t, res.Article.ModifiedTime)
+		assert.Equal(t, []string{"https://example.com/jane", "https://example.com/john"}, res.Article.Authors)
+		assert.Equal(t, "Science", res.Article.Section)
+		assert.Equal(t, []string{"space", "rockets"}, res.Article.Tags)
+	}
+	assert.Nil(t, res.Profile)
+	assert.Nil(t, res.Book)
+	assert.Nil(t, res.Video)
+
+	srv = newTestServer("text/html", `<html><head>
+		<meta property="og:type" content="profile">
+		<meta property="profile:first_name" content="Jane">
+		<meta property="profile:last_name" content="Doe">
+		<meta property="profile:username" content="jdoe">
+	</head><body></body></html>`)
+	res, err = Parse(srv.URL)
+	srv.Close()
+	assert.Nil(t, err)
+	assert.Equal(t, &Profile{FirstName: "Jane", LastName: "Doe", Username: "jdoe"}, res.Profile)
+	assert.Nil(t, res.Article)
+
+	srv = newTestServer("text/html", `<html><head>
+		<meta property="og:type" content="book">
+		<meta property="book:author" content="https://example.com/author">
+		<meta property="book:isbn" content="978-3-16-148410-0">
+		<meta property="book:release_date" content="2019-05-24">
+	</head><body></body></html>`)
+	res, err = Parse(srv.URL)
+	srv.Close()
+	assert.Nil(t, err)
+	if assert.NotNil(t, res.Book) {
+		assert.Equal(t, []string{"https://example.com/author"}, res.Book.Authors)
+		assert.Equal(t, "978-3-16-148410-0", res.Book.ISBN)
+		assert.True(t, released.Equal(*res.Book.ReleaseDate))
+	}
+
+	srv = newTestServer("text/html", `<html><head>
+		<meta property="og:type" content="video.episode">
+		<meta property="video:actor" content="https://example.com/a">
+		<meta property="video:actor:role" content="Captain">
+		<meta property="video:actor" content="https://example.com/b">
+		<meta property="video:director" content="https://example.com/d">
+		<meta property="video:duration" content="1500">
+		<meta property="video:series" content="https://example.com/show">
+	</head><body></body></html>`)
+	res, err = Parse(srv.URL)
+	srv.Close()
+	assert.Nil(t, err)
+	assert.Equal(t, &VideoDetails{
+		Actors:    []Actor{{Profile: "https://example.com/a", Role: "Captain"}, {Profile: "https://example.com/b"}},
+		Directors: []string{"https://example.com/d"},
+		Duration:  1500,
+		Series:    "https://example.com/show",
+	}, res.Video)
+}
+
+func TestOGMusic(t *testing.T) {
+	srv := newTestServer("text/html", `<html><head>
+		<meta property="og:type" content="music.song">
+		<meta property="music:duration" content="245">
+		<meta property="music:album" content="https://example.com/album">
+		<meta property="music:album:disc" content="1">
+		<meta property="music:album:track" content="7">
+		<meta property="music:musician" content="https://example.com/band">
+	</head><body></body></html>`)
+	res, err := Parse(srv.URL)
+	srv.Close()
+	assert.Nil(t, err)
+	assert.Equal(t, &Music{
+		Duration:  245,
+		Albums:    []MusicTrack{{URL: "https://example.com/album", Disc: 1, Track: 7}},
+		Musicians: []string{"https://example.com/band"},
+	}, res.Music)
+
+	srv = newTestServer("text/html", `<html><head>
+		<meta property="og:type" content="music.album">
+		<meta property="music:song" content="https://example.com/one">
+		<meta property="music:song:track" content="1">
+		<meta property="music:song" content="https://example.com/two">
+		<meta property="music:song:track" content="2">
+		<meta property="music:release_date" content="2020-02-02">
+	</head><body></body></html>`)
+	res, err = Parse(srv.URL)
+	srv.Close()
+	assert.Nil(t, err)
+	if assert.NotNil(t, res.Music) {
+		assert.Equal(t, []MusicTrack{{URL: "https://example.com/one", Track: 1}, {URL: "https://example.com/two", Track: 2}}, res.Music.Songs)
+		assert.True(t, time.Date(2020, 2, 2, 0, 0, 0, 0, time.UTC).Equal(*res.Music.ReleaseDate))
+	}
+	assert.Nil(t, res.Article)
+}
+
+func TestOGBooks(t *testing.T) {
+	srv := newTestServer("text/html", `<html><head>
+		<meta property="og:type" content="books.book">
+		<meta property="books:isbn" content="9780141439518">
+		<meta property="books:author" content="https://www.goodreads.com/author/show/1265">
+		<meta property="books:page_count" content="279">
+		<script type="application/ld+json">{"@type": "Book", "name": "Pride and Prejudice",
+			"author": {"@type": "Person", "name": "Jane Austen"},
+			"workExample": {"@type": "Book", "isbn": "0000000000", "datePublished": "2002-12-31"}}</script>
+	</head><body></body></html>`)
+	res, err := Parse(srv.URL)
+	srv.Close()
+	assert.Nil(t, err)
+	if assert.NotNil(t, res.Book) {
+		assert.Equal(t, "9780141439518", res.Book.ISBN)
+		assert.Equal(t, []string{"https://www.goodreads.com/author/show/1265"}, res.Book.Authors)
+		assert.Equal(t, 279, res.Book.PageCount)
+		assert.True(t, time.Date(2002, 12, 31, 0, 0, 0, 0, time.UTC).Equal(*res.Book.ReleaseDate))
+		assert.Nil(t, res.Book.Tags)
+	}
+
+	srv = newTestServer("text/html", `<html><head>
+		<meta property="og:type" content="book">
+		<script type="application/ld+json">{"@type": "Book", "isbn": "9780000000001", "numberOfPages": 100,
+			"author": [{"@type": "Person", "name": "A. Writer"}, {"@type": "Person", "name": "B. Writer"}]}</script>
+	</head><body></body></html>`)
+	res, err = Parse(srv.URL)
+	srv.Close()
+	assert.Nil(t, err)
+	assert.Equal(t, &Book{ISBN: "9780000000001", Authors: []string{"A. Writer", "B. Writer"}, PageCount: 100}, res.Book)
+}
+
+func TestOGProfile(t *testing.T) {
+	srv := newTestServer("text/html", `<html><head>
+		<meta property="og:type" content="profile">
+		<meta property="profile:username" content="@jdoe">
+		<meta property="profile:gender" content="Female">
+		<script type="application/ld+json">{"@type": "ProfilePage", "mainEntity": {"@type": "Person",
+			"givenName": "Jane", "familyName": "Doe", "alternateName": "janedoe"}}</script>
+	</head><body></body></html>`)
+	res, err := Parse(srv.URL)
+	srv.Close()
+	assert.Nil(t, err)
+	assert.Equal(t, &Profile{FirstName: "Jane", LastName: "Doe", Username: "jdoe", Gender: "female"}, res.Profile)
+
+	srv = newTestServer("text/html", `<html><head>
+		<meta property="og:type" content="website">
+		<script type="application/ld+json">{"@type": "ProfilePage", "mainEntity": {"@type": "Person",
+			"name": "Sam Smith", "alternateName": "@sam", "gender": "https://schema.org/Male"}}</script>
+	</head><body></body></html>`)
+	res, err = Parse(srv.URL)
+	srv.Close()
+	assert.Nil(t, err)
+	assert.Equal(t, &Profile{Username: "sam", Gender: "male"}, res.Profile)
+}
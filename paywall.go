@@ -0,0 +1,117 @@
+package recon
+
+import (
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// paywallClasses are class names and IDs of the elements paywall vendors and publishers put in front of, or in place
+// of, an article's content. They're matched against whole class names, so "no-paywall" isn't a marker.
+var paywallClasses = []string{
+	"paywall", "regwall", "tp-modal", "tp-container-inner", "piano-offer", "subscription-wall", "subscriber-only",
+	"subscribers-only", "meter-wall",
+}
+
+// paywallPhrases are the calls to action that appear where a paywalled article's content is cut off.
+var paywallPhrases = regexp.MustCompile(`(?i)` +
+	`(subscribe|sign in|log in|register) (now )?to (continue|keep) reading` +
+	`|this (article|story|content) is (only available|exclusive) (to|for) (paid )?subscribers` +
+	`|already a subscriber\?`)
+
+// maxPaywallPhraseText is the longest run of text that's checked for paywallPhrases. Calls to action are short, and
+// checking every long run of article text would be slow.
+const maxPaywallPhraseText = 500
+
+// isPaywallMarker reports whether the element looks like part of a paywall.
+func isPaywallMarker(t html.Token) bool {
+	if hasAttr(t, "data-paywall") {
+		return true
+	}
+
+	markers := append(strings.Fields(getAttr(t, "class")), getAttr(t, "id"))
+	for _, m := range markers {
+		m = strings.ToLower(m)
+		if m == "" {
+			continue
+		}
+
+		for _, c := range paywallClasses {
+			if m == c {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// isLocked reports whether a JSON-LD isAccessibleForFree value says the content isn't free.
+func isLocked(v interface{}) bool {
+	switch val := v.(type) {
+	case bool:
+		return !val
+	case string:
+		return strings.EqualFold(val, "false")
+	}
+
+	return false
+}
+
+// articleTypes are the schema.org types of articles and pages, whose isAccessibleForFree says whether they're behind a
+// paywall. Other things, like events and courses, use it to say whether they cost anything.
+var articleTypes = []string{
+	"Article", "NewsArticle", "AnalysisNewsArticle", "OpinionNewsArticle", "ReportageNewsArticle",
+	"ReviewNewsArticle", "BlogPosting", "LiveBlogPosting", "Report", "ScholarlyArticle", "TechArticle", "WebPage",
+	"CreativeWork",
+}
+
+// lockedByLD reports whether a JSON-LD object declares paywalled content. That's either an article or page that
+// isn't free, or, for any type, the structure publishers use to mark the locked section of a page: an object that
+// isn't free with a hasPart element naming the section by cssSelector, or such an element that isn't free itself.
+func lockedByLD(o ldObject) bool {
+	locked := isLocked(o["isAccessibleForFree"])
+	article := o.is(articleTypes...)
+
+	for _, part := range o.objs("hasPart") {
+		free, declared := part["isAccessibleForFree"]
+		partLocked := isLocked(free)
+
+		if part.str("cssSelector") != "" && (partLocked || (locked && !declared)) {
+			return true
+		}
+		if article && partLocked {
+			return true
+		}
+	}
+
+	return article && locked
+}
+
+// paywallExtractor decides whether the page is paywalled, using the page's declared JSON-LD accessibility and
+// content tier and, if heuristics are enabled, paywall markup and calls to action in its text.
+type paywallExtractor struct {
+	parser *Parser
+}
+
+func (e paywallExtractor) Extract(doc *Document, res *Result) error {
+	for _, o := range doc.jsonLD() {
+		if lockedByLD(o) {
+			res.Paywalled = true
+			return nil
+		}
+	}
+
+	switch strings.ToLower(doc.MetaContent("article:content_tier")) {
+	case "locked", "metered":
+		res.Paywalled = true
+		return nil
+	}
+
+	if e.parser.heuristics && doc.paywallMarkers > 0 {
+		res.Paywalled = true
+	}
+
+	return nil
+}
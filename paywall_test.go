@@ -0,0 +1,92 @@
+package recon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaywalled(t *testing.T) {
+	tests := []struct {
+		name       string
+		page       string
+		heuristics bool
+		want       bool
+	}{
+		{
+			name: "json-ld",
+			page: `<script type="application/ld+json">{"@type": "NewsArticle", "isAccessibleForFree": "False",
+				"hasPart": {"@type": "WebPageElement", "isAccessibleForFree": "False", "cssSelector": ".paywall"}}</script>`,
+			want: true,
+		},
+		{
+			name: "json-ld part",
+			page: `<script type="application/ld+json">{"@type": "NewsArticle", "isAccessibleForFree": true,
+				"hasPart": [{"@type": "WebPageElement", "isAccessibleForFree": false}]}</script>`,
+			want: true,
+		},
+		{
+			name: "json-ld locked section",
+			page: `<script type="application/ld+json">{"@type": "Course", "isAccessibleForFree": false,
+				"hasPart": {"@type": "WebPageElement", "cssSelector": ".members-only"}}</script>`,
+			want: true,
+		},
+		{
+			name: "json-ld free section",
+			page: `<script type="application/ld+json">{"@type": "NewsArticle", "isAccessibleForFree": true,
+				"hasPart": {"@type": "WebPageElement", "isAccessibleForFree": true, "cssSelector": ".story"}}</script>`,
+			want: false,
+		},
+		{
+			name: "json-ld paid event",
+			page: `<script type="application/ld+json">{"@type": "Event", "name": "A concert",
+				"isAccessibleForFree": false}</script>`,
+			want: false,
+		},
+		{
+			name: "content tier",
+			page: `<meta property="article:content_tier" content="metered">`,
+			want: true,
+		},
+		{
+			name:       "markup",
+			page:       `<p>An introduction.</p><div class="overlay paywall"></div>`,
+			heuristics: true,
+			want:       true,
+		},
+		{
+			name:       "markup without heuristics",
+			page:       `<p>An introduction.</p><div class="overlay paywall"></div>`,
+			heuristics: false,
+			want:       false,
+		},
+		{
+			name:       "markup with paywall in a class name",
+			page:       `<div class="no-paywall"><p>An introduction.</p></div><div class="paywall-disabled"></div>`,
+			heuristics: true,
+			want:       false,
+		},
+		{
+			name:       "call to action",
+			page:       `<p>An introduction.</p><p>Subscribe to continue reading.</p>`,
+			heuristics: true,
+			want:       true,
+		},
+		{
+			name:       "free",
+			page:       `<meta property="article:content_tier" content="free"><p>Subscribe to our newsletter!</p>`,
+			heuristics: true,
+			want:       false,
+		},
+	}
+
+	for _, test := range tests {
+		srv := newTestServer("text/html", `<html><head></head><body>`+test.page+`</body></html>`)
+
+		res, err := NewParser().WithHeuristics(test.heuristics).Parse(srv.URL)
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, test.want, res.Paywalled, test.name)
+
+		srv.Close()
+	}
+}
@@ -0,0 +1,63 @@
+package recon
+
+import "net/http"
+
+// Persona is a well-known crawler whose requests the parser can imitate via WithPersona. Many sites serve different
+// metadata to different crawlers, so imitating one shows what that service will see when it unfurls a link.
+type Persona string
+
+// Personas the parser can imitate.
+const (
+	PersonaFacebook Persona = "facebook"
+	PersonaTwitter  Persona = "twitter"
+	PersonaSlack    Persona = "slack"
+	PersonaGoogle   Persona = "google"
+)
+
+// personaProfile is the User-Agent and other headers a crawler sends.
+type personaProfile struct {
+	userAgent string
+	header    http.Header
+}
+
+var personas = map[Persona]personaProfile{
+	PersonaFacebook: {
+		userAgent: "facebookexternalhit/1.1 (+http://www.facebook.com/externalhit_uatext.php)",
+		header: http.Header{
+			"Accept": {"*/*"},
+		},
+	},
+	PersonaTwitter: {
+		userAgent: "Twitterbot/1.0",
+		header: http.Header{
+			"Accept": {"*/*"},
+		},
+	},
+	PersonaSlack: {
+		userAgent: "Slackbot-LinkExpanding 1.0 (+https://api.slack.com/robots)",
+		header: http.Header{
+			"Accept": {"text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8"},
+		},
+	},
+	PersonaGoogle: {
+		userAgent: "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)",
+		header: http.Header{
+			"Accept": {"text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8"},
+			"From":   {"googlebot(at)googlebot.com"},
+		},
+	},
+}
+
+// WithPersona makes the parser's requests look like those of a well-known crawler, setting its User-Agent, Accept
+// and other headers in one call. Unknown personas are ignored. Headers set via WithHeaders take precedence, and a
+// later WithUserAgent overrides the persona's User-Agent.
+func (p *Parser) WithPersona(persona Persona) *Parser {
+	profile, ok := personas[persona]
+	if !ok {
+		return p
+	}
+
+	p.userAgent = profile.userAgent
+	p.personaHeader = profile.header
+	return p
+}
@@ -0,0 +1,38 @@
+package recon
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPersona(t *testing.T) {
+	var got http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+		w.Write([]byte(`<html></html>`))
+	}))
+	defer srv.Close()
+
+	_, err := NewParser().WithPersona(PersonaGoogle).Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Contains(t, got.Get("User-Agent"), "Googlebot/2.1")
+	assert.Equal(t, "googlebot(at)googlebot.com", got.Get("From"))
+	assert.Contains(t, got.Get("Accept"), "text/html")
+
+	_, err = NewParser().WithPersona(PersonaSlack).AppendUserAgent("MyApp/1.0").Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "Slackbot-LinkExpanding 1.0 (+https://api.slack.com/robots) MyApp/1.0", got.Get("User-Agent"))
+	assert.Empty(t, got.Get("From"))
+
+	_, err = NewParser().WithPersona(PersonaTwitter).WithHeaders(http.Header{"Accept": {"text/html"}}).Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "Twitterbot/1.0", got.Get("User-Agent"))
+	assert.Equal(t, "text/html", got.Get("Accept"))
+
+	_, err = NewParser().WithPersona("unknown").Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, DefaultUserAgent, got.Get("User-Agent"))
+}
@@ -0,0 +1,86 @@
+package recon
+
+import "strings"
+
+// Pinterest describes how the page appears on Pinterest: whether it can be pinned, and whether it's ready to be a
+// Rich Pin (see https://developers.pinterest.com/docs/web-features/rich-pins-overview/).
+type Pinterest struct {
+	// NoPin is true if the page opts out of being pinned, via <meta name="pinterest" content="nopin">.
+	NoPin bool `json:"nopin,omitempty"`
+
+	// RichPins is false if the page opts out of Rich Pins, via <meta name="pinterest-rich-pin" content="false">.
+	RichPins bool `json:"rich_pins"`
+
+	// Type is the kind of Rich Pin the page would make: RichPinArticle, RichPinProduct or RichPinRecipe, or empty if
+	// it doesn't declare one.
+	Type string `json:"type,omitempty"`
+
+	// Missing are the tags the page is missing to be a Rich Pin of its Type, e.g. "og:description".
+	Missing []string `json:"missing,omitempty"`
+
+	// Description is the description to use for pins, via pin:description.
+	Description string `json:"description,omitempty"`
+
+	// DomainVerify is the page's Pinterest domain verification code, via p:domain_verify.
+	DomainVerify string `json:"domain_verify,omitempty"`
+}
+
+// Kinds of Rich Pin.
+const (
+	RichPinArticle = "article"
+	RichPinProduct = "product"
+	RichPinRecipe  = "recipe"
+)
+
+// pinterestExtractor checks the page's Pinterest tags and Rich Pin readiness into Result.Pinterest. It must run after
+// the product and recipe extractors.
+type pinterestExtractor struct{}
+
+func (pinterestExtractor) Extract(doc *Document, res *Result) error {
+	pin := Pinterest{
+		NoPin:        strings.EqualFold(strings.TrimSpace(doc.MetaContent("pinterest")), "nopin"),
+		RichPins:     !strings.EqualFold(strings.TrimSpace(doc.MetaContent("pinterest-rich-pin")), "false"),
+		Description:  doc.MetaContent("pin:description"),
+		DomainVerify: doc.MetaContent("p:domain_verify"),
+	}
+
+	// missing records the first of the tags that the page has none of
+	missing := func(tags ...string) {
+		for _, tag := range tags {
+			if doc.MetaContent(tag) != "" {
+				return
+			}
+		}
+		pin.Missing = append(pin.Missing, tags[0])
+	}
+
+	switch strings.ToLower(strings.TrimSpace(doc.MetaContent("og:type"))) {
+	case "article":
+		pin.Type = RichPinArticle
+		missing("og:title")
+		missing("og:description")
+
+	case "product", "og:product", "product.item":
+		pin.Type = RichPinProduct
+		missing("og:title")
+		missing("product:price:amount", "og:price:amount")
+		missing("product:price:currency", "og:price:currency")
+
+	default:
+		if res.Recipe != nil {
+			pin.Type = RichPinRecipe
+			if res.Recipe.Name == "" {
+				pin.Missing = append(pin.Missing, "name")
+			}
+			if len(res.Recipe.Ingredients) == 0 {
+				pin.Missing = append(pin.Missing, "recipeIngredient")
+			}
+		}
+	}
+
+	if pin.NoPin || !pin.RichPins || pin.Type != "" || pin.Description != "" || pin.DomainVerify != "" {
+		res.Pinterest = &pin
+	}
+
+	return nil
+}
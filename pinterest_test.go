@@ -0,0 +1,54 @@
+package recon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPinterest(t *testing.T) {
+	tests := []struct {
+		name string
+		head string
+		want *Pinterest
+	}{
+		{
+			name: "article",
+			head: `<meta property="og:type" content="article"><meta property="og:title" content="Title">
+				<meta property="og:description" content="Description">`,
+			want: &Pinterest{RichPins: true, Type: RichPinArticle},
+		},
+		{
+			name: "product missing currency",
+			head: `<meta property="og:type" content="product"><meta property="og:title" content="Widget">
+				<meta property="og:price:amount" content="5.00">`,
+			want: &Pinterest{RichPins: true, Type: RichPinProduct, Missing: []string{"product:price:currency"}},
+		},
+		{
+			name: "recipe",
+			head: `<script type="application/ld+json">{"@type": "Recipe", "name": "Toast"}</script>`,
+			want: &Pinterest{RichPins: true, Type: RichPinRecipe, Missing: []string{"recipeIngredient"}},
+		},
+		{
+			name: "opted out",
+			head: `<meta name="pinterest" content="nopin"><meta name="pinterest-rich-pin" content="false">
+				<meta name="p:domain_verify" content="abc123">`,
+			want: &Pinterest{NoPin: true, DomainVerify: "abc123"},
+		},
+		{
+			name: "none",
+			head: `<meta property="og:type" content="website">`,
+			want: nil,
+		},
+	}
+
+	for _, test := range tests {
+		srv := newTestServer("text/html", `<html><head>`+test.head+`</head><body></body></html>`)
+
+		res, err := Parse(srv.URL)
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, test.want, res.Pinterest, test.name)
+
+		srv.Close()
+	}
+}
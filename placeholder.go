@@ -0,0 +1,142 @@
+package recon
+
+import (
+	"bytes"
+	"encoding/base64"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// maxPlaceholderPixels is the largest image, in pixels, that's decoded to generate a placeholder. Larger images are
+// skipped, since decoding one takes memory in proportion to its size.
+const maxPlaceholderPixels = 40000000
+
+// WithPlaceholder makes the parser generate a placeholder for the page's best image: a copy scaled down to at most
+// size pixels on its longer side, e.g. 32, as a base64 JPEG data URL in Result.Placeholder. Clients can show it,
+// stretched and blurred, while the real image loads. The best image is downloaded a second time to generate it. A
+// size of 0 disables placeholders, which is the default.
+func (p *Parser) WithPlaceholder(size int) *Parser {
+	p.placeholderSize = size
+	return p
+}
+
+// placeholderExtractor generates Result.Placeholder from the first of Result.Images, if the parser is set to.
+type placeholderExtractor struct {
+	parser *Parser
+}
+
+func (e placeholderExtractor) Extract(doc *Document, res *Result) error {
+	if e.parser.placeholderSize <= 0 || len(res.Images) == 0 {
+		return nil
+	}
+
+	best := res.Images[0]
+	if best.Width*best.Height > maxPlaceholderPixels {
+		return nil
+	}
+
+	// like the images themselves, the placeholder is best-effort, so errors leave it empty
+	placeholder, err := e.parser.placeholder(doc, best.URL)
+	if err == nil {
+		res.Placeholder = placeholder
+	}
+
+	return nil
+}
+
+// placeholder downloads and decodes the image at rawURL and returns a scaled-down copy as a JPEG data URL.
+func (p *Parser) placeholder(doc *Document, rawURL string) (string, error) {
+	var in parsedImage
+	if strings.HasPrefix(rawURL, "data:") {
+		img, err := parseImgFromData(imgTag{url: rawURL})
+		if err != nil {
+			return "", errors.Wrap(err, "placeholder")
+		}
+		in = img
+	} else {
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return "", errors.Wrap(err, "placeholder")
+		}
+
+		img, err := p.parseImage(doc.context(), u, imgTag{url: rawURL}, doc.stats)
+		if err != nil {
+			return "", errors.Wrap(err, "placeholder")
+		}
+		if c, ok := img.data.(io.Closer); ok {
+			defer c.Close()
+		}
+		in = img
+	}
+
+	src, _, err := image.Decode(in.data)
+	if err != nil {
+		return "", errors.Wrap(err, "placeholder: decode")
+	}
+	if src.Bounds().Empty() {
+		return "", errors.New("placeholder: empty image")
+	}
+
+	buf := &bytes.Buffer{}
+	if err := jpeg.Encode(buf, scaleDown(src, p.placeholderSize), &jpeg.Options{Quality: 70}); err != nil {
+		return "", errors.Wrap(err, "placeholder: encode")
+	}
+
+	return "data:image/jpeg;base64," + base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// scaleDown scales src to fit within a size by size square, keeping its aspect ratio, by averaging the pixels each
+// new pixel covers. Transparent pixels are blended onto white, since JPEGs have no alpha channel. Images that already
+// fit are only flattened.
+func scaleDown(src image.Image, size int) *image.RGBA {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w > size || h > size {
+		if w >= h {
+			w, h = size, h*size/b.Dx()
+		} else {
+			w, h = w*size/b.Dy(), size
+		}
+	}
+	if w < 1 {
+		w = 1
+	}
+	if h < 1 {
+		h = 1
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		y0, y1 := b.Min.Y+y*b.Dy()/h, b.Min.Y+(y+1)*b.Dy()/h
+		for x := 0; x < w; x++ {
+			x0, x1 := b.Min.X+x*b.Dx()/w, b.Min.X+(x+1)*b.Dx()/w
+
+			var r, g, bl, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					// colors are alpha-premultiplied, so adding the missing alpha as white blends onto white
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					r += uint64(cr + 0xffff - ca)
+					g += uint64(cg + 0xffff - ca)
+					bl += uint64(cb + 0xffff - ca)
+					n++
+				}
+			}
+
+			dst.SetRGBA(x, y, color.RGBA{
+				R: uint8(r / n >> 8),
+				G: uint8(g / n >> 8),
+				B: uint8(bl / n >> 8),
+				A: 0xff,
+			})
+		}
+	}
+
+	return dst
+}
@@ -0,0 +1,83 @@
+package recon
+
+import "strings"
+
+// Platforms detected by Result.Platform.
+const (
+	PlatformWordPress = "wordpress"
+	PlatformGhost     = "ghost"
+	PlatformShopify   = "shopify"
+)
+
+type platformSignature struct {
+	platform string
+
+	// generator is a prefix of the lowercased <meta name="generator"> content.
+	generator string
+
+	// headers are response headers only this platform sends.
+	headers []string
+
+	// assets are substrings of script, stylesheet and image URLs that only this platform uses.
+	assets []string
+}
+
+var platformSignatures = []platformSignature{
+	{
+		platform:  PlatformWordPress,
+		generator: "wordpress",
+		headers:   []string{"X-Pingback"},
+		assets:    []string{"/wp-content/", "/wp-includes/"},
+	},
+	{
+		platform:  PlatformGhost,
+		generator: "ghost",
+		headers:   []string{"X-Ghost-Cache-Status"},
+		assets:    []string{"/ghost/", "/content/images/"},
+	},
+	{
+		platform:  PlatformShopify,
+		generator: "shopify",
+		headers:   []string{"X-ShopId", "X-Shopify-Stage"},
+		assets:    []string{"cdn.shopify.com/", "/cdn/shop/"},
+	},
+}
+
+// platformExtractor reports the page's generator and fingerprints the platform it was built with.
+type platformExtractor struct{}
+
+func (platformExtractor) Extract(doc *Document, res *Result) error {
+	res.Generator = doc.MetaContent("generator")
+
+	generator := strings.ToLower(res.Generator)
+	for _, sig := range platformSignatures {
+		if generator != "" && strings.HasPrefix(generator, sig.generator) {
+			res.Platform = sig.platform
+			return nil
+		}
+	}
+
+	if doc.Response != nil {
+		for _, sig := range platformSignatures {
+			for _, h := range sig.headers {
+				if doc.Response.Header.Get(h) != "" {
+					res.Platform = sig.platform
+					return nil
+				}
+			}
+		}
+	}
+
+	for _, sig := range platformSignatures {
+		for _, asset := range doc.assets {
+			for _, marker := range sig.assets {
+				if strings.Contains(asset, marker) {
+					res.Platform = sig.platform
+					return nil
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package recon
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlatform(t *testing.T) {
+	tests := []struct {
+		name      string
+		header    string
+		html      string
+		generator string
+		platform  string
+	}{
+		{
+			name:      "generator",
+			html:      `<meta name="generator" content="WordPress 6.4.2">`,
+			generator: "WordPress 6.4.2",
+			platform:  PlatformWordPress,
+		},
+		{
+			name:      "unknown generator",
+			html:      `<meta name="generator" content="Hugo 0.120.0">`,
+			generator: "Hugo 0.120.0",
+		},
+		{
+			name:     "header",
+			header:   "X-ShopId",
+			platform: PlatformShopify,
+		},
+		{
+			name:     "assets",
+			html:     `<link rel="stylesheet" href="/assets/built/screen.css"><script src="https://example.com/ghost/api/content/"></script>`,
+			platform: PlatformGhost,
+		},
+	}
+
+	for _, test := range tests {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if test.header != "" {
+				w.Header().Set(test.header, "1")
+			}
+			w.Write([]byte(`<html><head><title>Platform</title>` + test.html + `</head></html>`))
+		}))
+
+		res, err := Parse(srv.URL)
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, test.generator, res.Generator, test.name)
+		assert.Equal(t, test.platform, res.Platform, test.name)
+
+		srv.Close()
+	}
+}
@@ -0,0 +1,100 @@
+package recon
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"sync"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// maxPooledBuffer is the largest buffer returned to the pool. Larger ones, from unusually big pages or images, are
+// left for the garbage collector so the pool doesn't pin their memory.
+const maxPooledBuffer = 4 << 20
+
+var bufferPool = sync.Pool{
+	New: func() interface{} { return &bytes.Buffer{} },
+}
+
+// getBuffer returns an empty buffer from the pool.
+func getBuffer() *bytes.Buffer {
+	return bufferPool.Get().(*bytes.Buffer)
+}
+
+// putBuffer returns buf to the pool. It mustn't be used afterwards.
+func putBuffer(buf *bytes.Buffer) {
+	if buf == nil || buf.Cap() > maxPooledBuffer {
+		return
+	}
+
+	buf.Reset()
+	bufferPool.Put(buf)
+}
+
+// pooledReader reads a pooled buffer, returning it to the pool when it's closed.
+type pooledReader struct {
+	*bytes.Reader
+	buf *bytes.Buffer
+}
+
+func newPooledReader(buf *bytes.Buffer) *pooledReader {
+	return &pooledReader{Reader: bytes.NewReader(buf.Bytes()), buf: buf}
+}
+
+func (r *pooledReader) Close() error {
+	putBuffer(r.buf)
+	r.buf = nil
+	r.Reader = bytes.NewReader(nil)
+	return nil
+}
+
+var bufReaderPool = sync.Pool{
+	New: func() interface{} { return bufio.NewReader(nil) },
+}
+
+// getBufReader returns a pooled bufio.Reader reading from r.
+func getBufReader(r io.Reader) *bufio.Reader {
+	br := bufReaderPool.Get().(*bufio.Reader)
+	br.Reset(r)
+	return br
+}
+
+// putBufReader returns br to the pool. It mustn't be used afterwards.
+func putBufReader(br *bufio.Reader) {
+	br.Reset(nil)
+	bufReaderPool.Put(br)
+}
+
+// tokenBuffer builds tokens like html.Tokenizer's Token method, but reuses the same attribute slice for every tag
+// rather than allocating one per tag. A token's attributes are only valid until the next call to tag, so they must
+// not be kept.
+type tokenBuffer struct {
+	attrs []html.Attribute
+}
+
+var tokenBufferPool = sync.Pool{
+	New: func() interface{} { return &tokenBuffer{} },
+}
+
+// tag returns the start, end or self-closing tag token the tokenizer is at.
+func (b *tokenBuffer) tag(z *html.Tokenizer, tt html.TokenType) html.Token {
+	name, more := z.TagName()
+
+	b.attrs = b.attrs[:0]
+	for more {
+		var key, val []byte
+		key, val, more = z.TagAttr()
+		b.attrs = append(b.attrs, html.Attribute{Key: atom.String(key), Val: string(val)})
+	}
+
+	t := html.Token{Type: tt, DataAtom: atom.Lookup(name), Attr: b.attrs}
+	if t.DataAtom != 0 {
+		t.Data = t.DataAtom.String()
+	} else {
+		t.Data = string(name)
+	}
+
+	return t
+}
@@ -0,0 +1,94 @@
+package recon
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/html"
+)
+
+func TestTokenBuffer(t *testing.T) {
+	page := `<html lang="en"><head><META Property="og:title" content="A &amp; B"><custom-tag x=1 y></custom-tag>` +
+		`</head><body><img src="/a.png" alt=""/><p>text</p></body></html>`
+
+	want := html.NewTokenizer(strings.NewReader(page))
+	got := html.NewTokenizer(strings.NewReader(page))
+	tokens := &tokenBuffer{}
+
+	for {
+		tt := want.Next()
+		assert.Equal(t, tt, got.Next())
+		if tt == html.ErrorToken {
+			break
+		}
+		if tt != html.StartTagToken && tt != html.EndTagToken && tt != html.SelfClosingTagToken {
+			continue
+		}
+
+		expected, actual := want.Token(), tokens.tag(got, tt)
+		assert.Equal(t, expected.Data, actual.Data)
+		assert.Equal(t, expected.DataAtom, actual.DataAtom)
+		assert.Equal(t, len(expected.Attr), len(actual.Attr))
+		for i := range expected.Attr {
+			assert.Equal(t, expected.Attr[i], actual.Attr[i])
+		}
+	}
+}
+
+func TestPooledReader(t *testing.T) {
+	buf := getBuffer()
+	buf.WriteString("pooled")
+
+	r := newPooledReader(buf)
+	data, err := io.ReadAll(r)
+	assert.Nil(t, err)
+	assert.Equal(t, "pooled", string(data))
+
+	// closing twice mustn't return the buffer to the pool twice
+	assert.Nil(t, r.Close())
+	assert.Nil(t, r.Close())
+
+	n, err := r.Read(make([]byte, 1))
+	assert.Equal(t, 0, n)
+	assert.Equal(t, io.EOF, err)
+}
+
+// benchmarkParse parses the page in file with p, served from a local server, b.N times.
+func benchmarkParse(b *testing.B, p *Parser, file string) {
+	page, err := ioutil.ReadFile(file)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write(page)
+	}))
+	defer srv.Close()
+
+	b.ReportAllocs()
+	b.SetBytes(int64(len(page)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := p.Parse(srv.URL); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParse(b *testing.B) {
+	benchmarkParse(b, NewParser().WithImageAnalysis(false), "test-html/nyt-game-of-thrones.html")
+}
+
+func BenchmarkParseWithContent(b *testing.B) {
+	benchmarkParse(b, NewParser().WithImageAnalysis(false).WithTextExtraction(), "test-html/nyt-game-of-thrones.html")
+}
+
+func BenchmarkParseDataURLImages(b *testing.B) {
+	benchmarkParse(b, NewParser(), "test-html/gif-img-base64-test.html")
+}
@@ -0,0 +1,175 @@
+package recon
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Product describes the product a page sells, as declared via product: and og: meta tags or a schema.org Product or
+// Offer.
+type Product struct {
+	Name  string `json:"name,omitempty"`
+	Brand string `json:"brand,omitempty"`
+	SKU   string `json:"sku,omitempty"`
+
+	// Price is the product's price as the page writes it, e.g. "19.99". For several offers, it's the lowest price in
+	// the currency of the first, and Currency and Availability are those of the same offer.
+	Price string `json:"price,omitempty"`
+
+	// Currency is the price's ISO 4217 currency code, e.g. "USD".
+	Currency string `json:"currency,omitempty"`
+
+	// Availability is one of the Availability* constants.
+	Availability string `json:"availability,omitempty"`
+}
+
+// Product availabilities.
+const (
+	AvailabilityInStock      = "in_stock"
+	AvailabilityOutOfStock   = "out_of_stock"
+	AvailabilityPreOrder     = "preorder"
+	AvailabilityBackOrder    = "backorder"
+	AvailabilityDiscontinued = "discontinued"
+)
+
+// availabilities maps the availability values used by og:availability and schema.org's ItemAvailability, lowercased
+// and without spaces, dashes or underscores, to the Availability* constants.
+var availabilities = map[string]string{
+	"instock":             AvailabilityInStock,
+	"available":           AvailabilityInStock,
+	"availablefororder":   AvailabilityInStock,
+	"onlineonly":          AvailabilityInStock,
+	"instoreonly":         AvailabilityInStock,
+	"limitedavailability": AvailabilityInStock,
+	"outofstock":          AvailabilityOutOfStock,
+	"oos":                 AvailabilityOutOfStock,
+	"soldout":             AvailabilityOutOfStock,
+	"preorder":            AvailabilityPreOrder,
+	"presale":             AvailabilityPreOrder,
+	"pending":             AvailabilityPreOrder,
+	"backorder":           AvailabilityBackOrder,
+	"discontinued":        AvailabilityDiscontinued,
+}
+
+// normalizeAvailability maps an availability value, either a word like "instock" or a schema.org URL like
+// "https://schema.org/InStock", to one of the Availability* constants. Unknown values are returned as-is.
+func normalizeAvailability(s string) string {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return ""
+	}
+
+	key := strings.ToLower(s[strings.LastIndex(s, "/")+1:])
+	key = strings.NewReplacer(" ", "", "-", "", "_", "").Replace(key)
+	if a, ok := availabilities[key]; ok {
+		return a
+	}
+
+	return s
+}
+
+// offer is one of the prices a product is offered at.
+type offer struct {
+	price        string
+	currency     string
+	availability string
+}
+
+// ldOffer reads an offer from a schema.org Offer or AggregateOffer, using its lowest price if it has a range.
+func ldOffer(o ldObject) offer {
+	of := offer{
+		price:        firstNonEmpty(o.str("price"), o.str("lowPrice")),
+		currency:     o.str("priceCurrency"),
+		availability: o.str("availability"),
+	}
+	if spec := o.obj("priceSpecification"); spec != nil {
+		of.price = firstNonEmpty(of.price, spec.str("price"))
+		of.currency = firstNonEmpty(of.currency, spec.str("priceCurrency"))
+	}
+
+	return of
+}
+
+// lowestOffer returns the lowest priced of offers, among those in the currency of the first one with a price, or the
+// first offer with anything in it if none has a price. Prices that aren't plain numbers are only used if the first
+// offer's price is one of them.
+func lowestOffer(offers []offer) offer {
+	var best offer
+	for _, of := range offers {
+		if of.price != "" {
+			best = of
+			break
+		}
+	}
+
+	if best.price == "" {
+		for _, of := range offers {
+			if of != (offer{}) {
+				return of
+			}
+		}
+		return offer{}
+	}
+
+	low, err := strconv.ParseFloat(best.price, 64)
+	if err != nil {
+		return best
+	}
+
+	for _, of := range offers {
+		if !strings.EqualFold(of.currency, best.currency) {
+			continue
+		}
+		if price, err := strconv.ParseFloat(of.price, 64); err == nil && price < low {
+			best, low = of, price
+		}
+	}
+
+	return best
+}
+
+// productExtractor collects the page's product details from meta tags and JSON-LD into Result.Product. The price,
+// currency and availability all come from the same offer: the one declared by meta tags if they have a price, or the
+// lowest priced one in JSON-LD otherwise.
+type productExtractor struct{}
+
+func (productExtractor) Extract(doc *Document, res *Result) error {
+	p := Product{
+		Brand: doc.MetaContent("product:brand"),
+		SKU:   doc.MetaContent("product:retailer_item_id"),
+	}
+
+	meta := offer{
+		price:        firstNonEmpty(doc.MetaContent("product:price:amount"), doc.MetaContent("og:price:amount")),
+		currency:     firstNonEmpty(doc.MetaContent("product:price:currency"), doc.MetaContent("og:price:currency")),
+		availability: firstNonEmpty(doc.MetaContent("og:availability"), doc.MetaContent("product:availability")),
+	}
+
+	ld := doc.jsonLD("Offer", "AggregateOffer")
+	if products := doc.jsonLD("Product"); len(products) > 0 {
+		o := products[0]
+		p.Name = o.str("name")
+		p.Brand = firstNonEmpty(p.Brand, o.str("brand"))
+		p.SKU = firstNonEmpty(p.SKU, o.str("sku"))
+		ld = append(o.objs("offers"), ld...)
+	}
+
+	best := meta
+	if meta.price == "" {
+		offers := []offer{meta}
+		for _, o := range ld {
+			offers = append(offers, ldOffer(o))
+		}
+		best = lowestOffer(offers)
+	}
+
+	p.Price = best.price
+	p.Currency = strings.ToUpper(best.currency)
+	p.Availability = normalizeAvailability(best.availability)
+
+	if p != (Product{}) {
+		res.Product = &p
+	}
+
+	return nil
+}
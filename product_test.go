@@ -0,0 +1,88 @@
+package recon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProduct(t *testing.T) {
+	tests := []struct {
+		name string
+		page string
+		want *Product
+	}{
+		{
+			name: "meta tags",
+			page: `<meta property="og:type" content="product">
+				<meta property="product:price:amount" content="19.99">
+				<meta property="product:price:currency" content="usd">
+				<meta property="og:availability" content="instock">`,
+			want: &Product{Price: "19.99", Currency: "USD", Availability: AvailabilityInStock},
+		},
+		{
+			name: "json-ld",
+			page: `<script type="application/ld+json">{"@type": "Product", "name": "Widget", "sku": "W-1",
+				"brand": {"@type": "Brand", "name": "Acme"},
+				"offers": {"@type": "Offer", "price": 5, "priceCurrency": "EUR",
+					"availability": "https://schema.org/OutOfStock"}}</script>`,
+			want: &Product{
+				Name:         "Widget",
+				Brand:        "Acme",
+				SKU:          "W-1",
+				Price:        "5",
+				Currency:     "EUR",
+				Availability: AvailabilityOutOfStock,
+			},
+		},
+		{
+			name: "aggregate offer",
+			page: `<script type="application/ld+json">{"@type": "Product", "name": "Widget",
+				"offers": {"@type": "AggregateOffer", "lowPrice": "4.50", "highPrice": "9.00", "priceCurrency": "GBP"}}
+				</script>`,
+			want: &Product{Name: "Widget", Price: "4.50", Currency: "GBP"},
+		},
+		{
+			name: "meta tags over json-ld",
+			page: `<meta property="product:price:amount" content="12.00">
+				<meta property="product:price:currency" content="CAD">
+				<script type="application/ld+json">{"@type": "Product", "name": "Widget",
+					"offers": {"@type": "Offer", "price": "10.00", "priceCurrency": "USD", "availability": "PreOrder"}}
+				</script>`,
+			want: &Product{Name: "Widget", Price: "12.00", Currency: "CAD"},
+		},
+		{
+			name: "lowest offer",
+			page: `<script type="application/ld+json">{"@type": "Product", "name": "Widget",
+				"offers": [
+					{"@type": "Offer", "price": "20.00", "priceCurrency": "USD", "availability": "InStock"},
+					{"@type": "Offer", "price": "15.00", "priceCurrency": "USD", "availability": "BackOrder"},
+					{"@type": "Offer", "price": "9.00", "priceCurrency": "EUR", "availability": "InStock"}
+				]}</script>`,
+			want: &Product{Name: "Widget", Price: "15.00", Currency: "USD", Availability: AvailabilityBackOrder},
+		},
+		{
+			name: "availability without a price",
+			page: `<meta property="og:availability" content="oos">
+				<script type="application/ld+json">{"@type": "Product", "name": "Widget",
+					"offers": {"@type": "Offer", "price": "10.00", "priceCurrency": "USD", "availability": "InStock"}}
+				</script>`,
+			want: &Product{Name: "Widget", Price: "10.00", Currency: "USD", Availability: AvailabilityInStock},
+		},
+		{
+			name: "none",
+			page: `<meta property="og:type" content="article">`,
+			want: nil,
+		},
+	}
+
+	for _, test := range tests {
+		srv := newTestServer("text/html", `<html><head>`+test.page+`</head><body></body></html>`)
+
+		res, err := NewParser().Parse(srv.URL)
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, test.want, res.Product, test.name)
+
+		srv.Close()
+	}
+}
@@ -0,0 +1,63 @@
+package recon
+
+import "strconv"
+
+// Rating is a rating on a scale, such as the average of a product's reviews or a single review's score.
+type Rating struct {
+	Value float64 `json:"value"`
+
+	// Count is the number of ratings, or of reviews if the page only declares that.
+	Count int `json:"count,omitempty"`
+
+	// Best and Worst are the ends of the rating scale, if the page declares them; schema.org assumes 5 and 1.
+	Best  float64 `json:"best,omitempty"`
+	Worst float64 `json:"worst,omitempty"`
+}
+
+// ldRating reads a schema.org AggregateRating or Rating, returning nil if it doesn't have a value.
+func ldRating(o ldObject) *Rating {
+	if o == nil {
+		return nil
+	}
+
+	value, err := strconv.ParseFloat(o.str("ratingValue"), 64)
+	if err != nil {
+		return nil
+	}
+
+	r := &Rating{Value: value}
+	r.Count, _ = strconv.Atoi(firstNonEmpty(o.str("ratingCount"), o.str("reviewCount")))
+	r.Best, _ = strconv.ParseFloat(o.str("bestRating"), 64)
+	r.Worst, _ = strconv.ParseFloat(o.str("worstRating"), 64)
+
+	return r
+}
+
+// ratingExtractor collects the rating of the thing the page is about into Result.Rating: a schema.org AggregateRating
+// from JSON-LD or microdata, or for a page that's a single review, the review's own rating.
+type ratingExtractor struct{}
+
+func (ratingExtractor) Extract(doc *Document, res *Result) error {
+	for _, o := range doc.jsonLD() {
+		if o.is("AggregateRating") {
+			if r := ldRating(o); r != nil {
+				res.Rating = r
+				return nil
+			}
+		}
+
+		if r := ldRating(o.obj("aggregateRating")); r != nil {
+			res.Rating = r
+			return nil
+		}
+	}
+
+	for _, o := range doc.jsonLD("Review", "CriticReview", "UserReview") {
+		if r := ldRating(o.obj("reviewRating")); r != nil {
+			res.Rating = r
+			return nil
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package recon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRating(t *testing.T) {
+	tests := []struct {
+		name string
+		page string
+		want *Rating
+	}{
+		{
+			name: "product",
+			page: `<script type="application/ld+json">{"@type": "Product", "name": "Widget",
+				"aggregateRating": {"@type": "AggregateRating", "ratingValue": 4.4, "reviewCount": "89",
+					"bestRating": "5", "worstRating": "1"}}</script>`,
+			want: &Rating{Value: 4.4, Count: 89, Best: 5, Worst: 1},
+		},
+		{
+			name: "standalone",
+			page: `<script type="application/ld+json">{"@type": "AggregateRating", "ratingValue": "3.5",
+				"ratingCount": 10, "itemReviewed": {"@type": "Book", "name": "A Book"}}</script>`,
+			want: &Rating{Value: 3.5, Count: 10},
+		},
+		{
+			name: "microdata",
+			page: `<div itemscope itemtype="https://schema.org/Product"><span itemprop="name">Widget</span>
+				<div itemprop="aggregateRating" itemscope itemtype="https://schema.org/AggregateRating">
+					Rated <span itemprop="ratingValue">4</span>/<span itemprop="bestRating">5</span>
+					based on <span itemprop="ratingCount">12</span> ratings</div></div>`,
+			want: &Rating{Value: 4, Count: 12, Best: 5},
+		},
+		{
+			name: "review",
+			page: `<script type="application/ld+json">{"@type": "Review", "itemReviewed": {"@type": "Movie"},
+				"reviewRating": {"@type": "Rating", "ratingValue": "8", "bestRating": "10"}}</script>`,
+			want: &Rating{Value: 8, Best: 10},
+		},
+		{
+			name: "none",
+			page: `<script type="application/ld+json">{"@type": "Product", "name": "Widget",
+				"aggregateRating": {"@type": "AggregateRating", "ratingValue": "n/a"}}</script>`,
+			want: nil,
+		},
+	}
+
+	for _, test := range tests {
+		srv := newTestServer("text/html", `<html><head></head><body>`+test.page+`</body></html>`)
+
+		res, err := NewParser().Parse(srv.URL)
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, test.want, res.Rating, test.name)
+
+		srv.Close()
+	}
+}
@@ -0,0 +1,101 @@
+package recon
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Recipe is a recipe declared on the page via schema.org Recipe JSON-LD or microdata.
+type Recipe struct {
+	Name         string   `json:"name,omitempty"`
+	Ingredients  []string `json:"ingredients,omitempty"`
+	Instructions []string `json:"instructions,omitempty"`
+
+	// PrepTime, CookTime and TotalTime are in seconds.
+	PrepTime  int `json:"prep_time,omitempty"`
+	CookTime  int `json:"cook_time,omitempty"`
+	TotalTime int `json:"total_time,omitempty"`
+
+	// Yield is how much the recipe makes, as the page writes it, e.g. "4 servings".
+	Yield string `json:"yield,omitempty"`
+
+	Rating *Rating `json:"rating,omitempty"`
+}
+
+// ldInstructions reads a recipe's recipeInstructions, which may be plain text, a list of text, HowToSteps or
+// HowToSections of HowToSteps.
+func ldInstructions(v interface{}) []string {
+	var out []string
+	switch val := v.(type) {
+	case string:
+		for _, line := range strings.Split(val, "\n") {
+			if line = collapseWhitespace(line); line != "" {
+				out = append(out, line)
+			}
+		}
+
+	case []interface{}:
+		for _, child := range val {
+			out = append(out, ldInstructions(child)...)
+		}
+
+	case map[string]interface{}:
+		o := ldObject(val)
+		if o.is("HowToSection") {
+			return ldInstructions(o["itemListElement"])
+		}
+		if text := collapseWhitespace(firstNonEmpty(o.str("text"), o.str("name"))); text != "" {
+			out = append(out, text)
+		}
+	}
+
+	return out
+}
+
+// recipeYield picks the most descriptive of a recipe's recipeYield values, which are often given as both a number
+// and a phrase, e.g. ["4", "4 servings"].
+func recipeYield(values []string) string {
+	for _, v := range values {
+		if _, err := strconv.ParseFloat(v, 64); err != nil {
+			return v
+		}
+	}
+
+	if len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+// recipeExtractor collects the page's schema.org Recipe into Result.Recipe.
+type recipeExtractor struct{}
+
+func (recipeExtractor) Extract(doc *Document, res *Result) error {
+	recipes := doc.jsonLD("Recipe")
+	if len(recipes) == 0 {
+		return nil
+	}
+
+	o := recipes[0]
+	r := &Recipe{
+		Name:         o.str("name"),
+		Instructions: ldInstructions(o["recipeInstructions"]),
+		PrepTime:     durationSeconds(o.str("prepTime")),
+		CookTime:     durationSeconds(o.str("cookTime")),
+		TotalTime:    durationSeconds(o.str("totalTime")),
+		Yield:        recipeYield(o.strs("recipeYield")),
+		Rating:       ldRating(o.obj("aggregateRating")),
+	}
+
+	// ingredients is the property's older name
+	for _, ingredient := range append(o.strs("recipeIngredient"), o.strs("ingredients")...) {
+		if ingredient = collapseWhitespace(ingredient); ingredient != "" {
+			r.Ingredients = append(r.Ingredients, ingredient)
+		}
+	}
+
+	res.Recipe = r
+
+	return nil
+}
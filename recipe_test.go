@@ -0,0 +1,82 @@
+package recon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecipe(t *testing.T) {
+	tests := []struct {
+		name string
+		page string
+		want *Recipe
+	}{
+		{
+			name: "json-ld",
+			page: `<script type="application/ld+json">{"@context": "https://schema.org", "@type": "Recipe",
+				"name": "Pancakes", "recipeIngredient": ["2 cups flour", " 2  eggs "], "recipeYield": ["4", "4 servings"],
+				"prepTime": "PT10M", "cookTime": "PT20M", "totalTime": "PT30M",
+				"recipeInstructions": [
+					{"@type": "HowToSection", "name": "Batter", "itemListElement": [
+						{"@type": "HowToStep", "text": "Mix everything."}
+					]},
+					{"@type": "HowToStep", "text": "Cook on a griddle."}
+				],
+				"aggregateRating": {"@type": "AggregateRating", "ratingValue": "4.7", "ratingCount": 312}}</script>`,
+			want: &Recipe{
+				Name:         "Pancakes",
+				Ingredients:  []string{"2 cups flour", "2 eggs"},
+				Instructions: []string{"Mix everything.", "Cook on a griddle."},
+				PrepTime:     600,
+				CookTime:     1200,
+				TotalTime:    1800,
+				Yield:        "4 servings",
+				Rating:       &Rating{Value: 4.7, Count: 312},
+			},
+		},
+		{
+			name: "microdata",
+			page: `<div itemscope itemtype="https://schema.org/Recipe">
+				<h1 itemprop="name">Toast</h1>
+				<meta itemprop="cookTime" content="PT3M">
+				<span itemprop="recipeYield">1 slice</span>
+				<ul>
+					<li itemprop="recipeIngredient">1 slice <b>bread</b></li>
+					<li itemprop="recipeIngredient">Butter</li>
+				</ul>
+				<div itemprop="aggregateRating" itemscope itemtype="https://schema.org/AggregateRating">
+					<span itemprop="ratingValue">3</span> out of <span itemprop="bestRating">10</span>
+					(<span itemprop="reviewCount">8</span> reviews)
+				</div>
+				<ol>
+					<li itemprop="recipeInstructions">Toast the bread.</li>
+					<li itemprop="recipeInstructions">Butter it.</li>
+				</ol>
+			</div>`,
+			want: &Recipe{
+				Name:         "Toast",
+				Ingredients:  []string{"1 slice bread", "Butter"},
+				Instructions: []string{"Toast the bread.", "Butter it."},
+				CookTime:     180,
+				Yield:        "1 slice",
+				Rating:       &Rating{Value: 3, Count: 8, Best: 10},
+			},
+		},
+		{
+			name: "none",
+			page: `<div itemscope itemtype="https://schema.org/Person"><span itemprop="name">Jane</span></div>`,
+			want: nil,
+		},
+	}
+
+	for _, test := range tests {
+		srv := newTestServer("text/html", `<html><head></head><body>`+test.page+`</body></html>`)
+
+		res, err := NewParser().Parse(srv.URL)
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, test.want, res.Recipe, test.name)
+
+		srv.Close()
+	}
+}
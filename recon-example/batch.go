@@ -0,0 +1,82 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/jimmysawczuk/recon"
+)
+
+// batch parses the URLs listed in the named file (or standard input, if name is "-") and writes each result with
+// enc. It returns the process's exit status.
+func batch(p *recon.Parser, name string, opts recon.BatchOptions, enc encoder) int {
+	in := io.Reader(os.Stdin)
+	if name != "-" {
+		f, err := os.Open(name)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error opening %s: %s\n", name, err)
+			return 1
+		}
+		defer f.Close()
+		in = f
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	urls := make(chan string)
+	readErr := make(chan error, 1)
+	go func() {
+		defer close(urls)
+		readErr <- readURLs(ctx, in, urls)
+	}()
+
+	status := 0
+	for res := range p.Stream(ctx, urls, opts) {
+		out := output{Requested: res.URL, Result: res.Result}
+		if res.Err != nil {
+			out.Error = res.Err.Error()
+			status = 1
+		}
+
+		if err := enc.Encode(out); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing result: %s\n", err)
+			return 1
+		}
+	}
+
+	if err := enc.Flush(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing result: %s\n", err)
+		return 1
+	}
+
+	if err := <-readErr; err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading URLs: %s\n", err)
+		return 1
+	}
+
+	return status
+}
+
+// readURLs sends each URL listed in r to urls, skipping blank lines and comments.
+func readURLs(ctx context.Context, r io.Reader, urls chan<- string) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		select {
+		case urls <- line:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return scanner.Err()
+}
@@ -1,27 +1,120 @@
 package main
 
 import (
-	"encoding/json"
+	"context"
+	"flag"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/jimmysawczuk/recon"
 )
 
+func usage() {
+	name := filepath.Base(os.Args[0])
+	fmt.Fprintf(flag.CommandLine.Output(), `Usage: %s [flags] <url>
+       %s [flags] -f <file>
+       <command> | %s [flags]
+
+Fetches the page at url and prints the OpenGraph information found on it.
+
+In batch mode, URLs are read one per line from a file (or standard input, with "-f -" or when no url is given and
+standard input isn't a terminal) and parsed concurrently, printing one JSON object per URL per line (or a YAML
+document or CSV row, with -o yaml or -o csv) as each completes. Blank lines and lines starting with # are skipped.
+The exit status is 1 if any URL fails to parse.
+
+Run "%s serve -h" for serving results over HTTP.
+
+Flags:
+`, name, name, name, name)
+	flag.PrintDefaults()
+}
+
 func main() {
-	if len(os.Args) < 2 {
-		fmt.Fprintf(os.Stderr, "Must specify a URL\n")
+	if len(os.Args) > 1 && os.Args[1] == "serve" {
+		serve(os.Args[2:])
+		return
+	}
+
+	timeout := flag.Duration("timeout", 30*time.Second, "maximum time to spend parsing the page, including its images")
+	format := flag.String("format", "", "output `format`: json, pretty, yaml or csv (default pretty, or json in batch mode)")
+	flag.StringVar(format, "o", "", "shorthand for -format")
+	ua := flag.String("ua", "", "User-Agent to send instead of recon's default; {default} is replaced with the default")
+	noImages := flag.Bool("no-images", false, "don't download and analyze the page's images")
+	maxImages := flag.Int("max-images", 0, "maximum number of images to analyze (0 for no limit)")
+	preview := flag.String("preview", "", "also render the result as Facebook, Twitter and Slack cards to the HTML `file`")
+	file := flag.String("f", "", "read URLs to parse from `file`, one per line (- for standard input)")
+	concurrency := flag.Int("concurrency", recon.DefaultBatchConcurrency, "number of URLs to parse at once in batch mode")
+	flag.Usage = usage
+	flag.Parse()
+
+	if *file == "" && flag.NArg() == 0 && !isTerminal(os.Stdin) {
+		*file = "-"
+	}
+
+	if (*file == "" && flag.NArg() != 1) || (*file != "" && (flag.NArg() != 0 || *preview != "")) {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if *format == "" {
+		*format = "pretty"
+		if *file != "" {
+			*format = "json"
+		}
+	}
+
+	enc, err := newEncoder(os.Stdout, *format)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unknown format %q; must be one of %s\n", *format, strings.Join(formats, ", "))
+		os.Exit(2)
+	}
+
+	p := recon.NewParser().WithImageAnalysis(!*noImages).WithMaxImages(*maxImages)
+	if *ua != "" {
+		p.WithUserAgent(*ua)
+	}
+
+	if *file != "" {
+		os.Exit(batch(p, *file, recon.BatchOptions{Concurrency: *concurrency, Timeout: *timeout}, enc))
 	}
 
-	url := os.Args[1]
+	url := flag.Arg(0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
 
-	res, err := recon.Parse(url)
+	res, err := p.ParseContext(ctx, url)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error parsing %s: %s\n", url, err)
+		os.Exit(1)
+	}
+
+	if err := encode(enc, output{Result: res}); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing result: %s\n", err)
+		os.Exit(1)
+	}
+
+	if *preview != "" {
+		if err := savePreview(*preview, res); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing preview: %s\n", err)
+			os.Exit(1)
+		}
+	}
+}
+
+// isTerminal reports whether f is a terminal rather than a file or pipe.
+func isTerminal(f *os.File) bool {
+	fi, err := f.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
+
+func encode(enc encoder, out output) error {
+	if err := enc.Encode(out); err != nil {
+		return err
 	}
 
-	jres, _ := json.MarshalIndent(res, "", "   ")
-	fmt.Println("Recon parse results for:\n" + url)
-	fmt.Println("------------------------------------------------")
-	fmt.Println(string(jres))
+	return enc.Flush()
 }
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/jimmysawczuk/recon"
+	"gopkg.in/yaml.v3"
+)
+
+// formats are the output formats the CLI supports.
+var formats = []string{"json", "pretty", "yaml", "csv"}
+
+// csvColumns are the columns written by the csv output format.
+var csvColumns = []string{"url", "site_name", "title", "type", "description", "author", "image", "status", "error"}
+
+// output is the result of parsing a single URL, as written by an encoder.
+type output struct {
+	// Requested is the URL as read from the input in batch mode, which may differ from the Result's URL.
+	Requested string `json:"requested_url,omitempty"`
+
+	recon.Result
+
+	Error string `json:"error,omitempty"`
+}
+
+// encoder writes outputs in one of the supported formats.
+type encoder interface {
+	Encode(output) error
+	Flush() error
+}
+
+func newEncoder(w io.Writer, format string) (encoder, error) {
+	switch format {
+	case "json", "pretty":
+		enc := json.NewEncoder(w)
+		if format == "pretty" {
+			enc.SetIndent("", "   ")
+		}
+		return jsonEncoder{enc}, nil
+
+	case "yaml":
+		return yamlEncoder{yaml.NewEncoder(w)}, nil
+
+	case "csv":
+		return csvEncoder{recon.NewCSVEncoder(w, csvColumns...)}, nil
+	}
+
+	return nil, fmt.Errorf("unknown format %q", format)
+}
+
+type jsonEncoder struct {
+	enc *json.Encoder
+}
+
+func (e jsonEncoder) Encode(out output) error {
+	return e.enc.Encode(out)
+}
+
+func (e jsonEncoder) Flush() error {
+	return nil
+}
+
+// yamlEncoder writes outputs as a stream of YAML documents. Outputs are converted via their JSON encoding so that
+// the YAML keys and field order match the JSON output.
+type yamlEncoder struct {
+	enc *yaml.Encoder
+}
+
+func (e yamlEncoder) Encode(out output) error {
+	buf, err := json.Marshal(out)
+	if err != nil {
+		return err
+	}
+
+	// JSON is YAML, so decoding it into a node keeps the keys in order
+	var node yaml.Node
+	if err := yaml.Unmarshal(buf, &node); err != nil {
+		return err
+	}
+	clearStyle(&node)
+
+	return e.enc.Encode(&node)
+}
+
+func (e yamlEncoder) Flush() error {
+	return e.enc.Close()
+}
+
+// clearStyle resets the flow and quoting styles the node picked up from its JSON source, so it's written as block
+// YAML.
+func clearStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		clearStyle(child)
+	}
+}
+
+type csvEncoder struct {
+	enc *recon.CSVEncoder
+}
+
+func (e csvEncoder) Encode(out output) error {
+	if out.URL == "" {
+		out.URL = out.Requested
+	}
+
+	var err error
+	if out.Error != "" {
+		err = errors.New(out.Error)
+	}
+
+	return e.enc.Encode(out.Result, err)
+}
+
+func (e csvEncoder) Flush() error {
+	return e.enc.Flush()
+}
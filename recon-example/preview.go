@@ -0,0 +1,123 @@
+package main
+
+import (
+	"html/template"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/jimmysawczuk/recon"
+)
+
+// previewTemplate renders a Result as it might unfurl on Facebook, Twitter and Slack. It's self-contained apart from
+// the page's image, which is loaded from its original URL.
+var previewTemplate = template.Must(template.New("preview").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Preview: {{.Title}}</title>
+<style>
+	body { background: #f0f2f5; color: #1c1e21; font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Helvetica, Arial, sans-serif; margin: 0; padding: 24px; }
+	h2 { color: #65676b; font-size: 13px; font-weight: 600; letter-spacing: .05em; margin: 32px 0 8px; text-transform: uppercase; }
+	.card { background: #fff; max-width: 500px; overflow: hidden; }
+	.card img { display: block; object-fit: cover; width: 100%; }
+	.clamp { display: -webkit-box; -webkit-box-orient: vertical; overflow: hidden; }
+
+	.facebook { border: 1px solid #dadde1; }
+	.facebook img { aspect-ratio: 1.91; border-bottom: 1px solid #dadde1; }
+	.facebook .body { background: #f2f3f5; padding: 10px 12px; }
+	.facebook .host { color: #606770; font-size: 12px; text-transform: uppercase; }
+	.facebook .title { font-size: 16px; font-weight: 600; margin: 3px 0; -webkit-line-clamp: 2; }
+	.facebook .description { color: #606770; font-size: 14px; -webkit-line-clamp: 1; }
+
+	.twitter { border: 1px solid #cfd9de; border-radius: 16px; }
+	.twitter img { aspect-ratio: 2; }
+	.twitter .body { padding: 12px; font-size: 15px; }
+	.twitter .host { color: #536471; }
+	.twitter .title { margin: 2px 0; -webkit-line-clamp: 1; }
+	.twitter .description { color: #536471; -webkit-line-clamp: 2; }
+
+	.slack { background: transparent; border-left: 4px solid #dddddd; font-size: 15px; padding: 0 0 0 12px; }
+	.slack .site { font-weight: 700; }
+	.slack .title { color: #1264a3; font-weight: 700; margin: 2px 0; }
+	.slack .description { margin-bottom: 8px; }
+	.slack img { border-radius: 8px; max-height: 300px; max-width: 360px; width: auto; }
+</style>
+</head>
+<body>
+	<h2>Facebook</h2>
+	<div class="card facebook">
+		{{with .Image}}<img src="{{.URL}}" alt="{{.Alt}}">{{end}}
+		<div class="body">
+			<div class="host">{{.Host}}</div>
+			<div class="title clamp">{{.Title}}</div>
+			<div class="description clamp">{{.Description}}</div>
+		</div>
+	</div>
+
+	<h2>Twitter</h2>
+	<div class="card twitter">
+		{{with .Image}}<img src="{{.URL}}" alt="{{.Alt}}">{{end}}
+		<div class="body">
+			<div class="host">{{.Host}}</div>
+			<div class="title clamp">{{.Title}}</div>
+			<div class="description clamp">{{.Description}}</div>
+		</div>
+	</div>
+
+	<h2>Slack</h2>
+	<div class="card slack">
+		<div class="site">{{.Site}}</div>
+		<div class="title"><a href="{{.URL}}">{{.Title}}</a></div>
+		<div class="description">{{.Description}}</div>
+		{{with .Image}}<img src="{{.URL}}" alt="{{.Alt}}">{{end}}
+	</div>
+</body>
+</html>
+`))
+
+// previewData is what previewTemplate renders.
+type previewData struct {
+	URL         string
+	Host        string
+	Site        string
+	Title       string
+	Description string
+	Image       *recon.Image
+}
+
+// savePreview renders res as a set of link preview cards to the named file.
+func savePreview(name string, res recon.Result) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+
+	if err := writePreview(f, res); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// writePreview renders res as a set of link preview cards to w.
+func writePreview(w io.Writer, res recon.Result) error {
+	data := previewData{
+		URL:         res.URL,
+		Host:        strings.TrimPrefix(res.Host, "www."),
+		Site:        res.Site,
+		Title:       res.Title,
+		Description: res.Description,
+	}
+
+	if data.Site == "" {
+		data.Site = data.Host
+	}
+
+	if len(res.Images) > 0 {
+		data.Image = &res.Images[0]
+	}
+
+	return previewTemplate.Execute(w, data)
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/jimmysawczuk/recon"
+	"github.com/jimmysawczuk/recon/reconhttp"
+)
+
+// serve runs the serve subcommand, which exposes the parser over HTTP.
+func serve(args []string) {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	addr := fs.String("addr", ":8080", "`address` to listen on")
+	timeout := fs.Duration("timeout", reconhttp.DefaultTimeout, "maximum time to spend parsing a page, including its images")
+	cacheTTL := fs.Duration("cache-ttl", 10*time.Minute, "how long to cache results for (0 to disable caching)")
+	cacheSize := fs.Int("cache-size", 1000, "maximum number of results to cache")
+	allowPrivate := fs.Bool("allow-private", false, "allow parsing URLs on loopback and private network addresses")
+	ua := fs.String("ua", "", "User-Agent to send instead of recon's default; {default} is replaced with the default")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), `Usage: %s serve [flags]
+
+Serves parse results over HTTP: GET /parse?url=<url> responds with the Result for url as JSON.
+
+Flags:
+`, filepath.Base(os.Args[0]))
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	p := recon.NewParser()
+	if *ua != "" {
+		p.WithUserAgent(*ua)
+	}
+	if *cacheTTL > 0 {
+		p.WithResultCache(*cacheTTL, *cacheSize)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/parse", reconhttp.NewHandler(p, reconhttp.Options{
+		Timeout:      *timeout,
+		MaxAge:       *cacheTTL,
+		AllowPrivate: *allowPrivate,
+	}))
+
+	srv := &http.Server{
+		Addr:              *addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+		WriteTimeout:      *timeout + 10*time.Second,
+	}
+
+	log.Printf("listening on %s", *addr)
+	log.Fatal(srv.ListenAndServe())
+}
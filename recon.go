@@ -2,18 +2,19 @@
 package recon
 
 import (
-	"bytes"
+	"context"
 	"encoding/base64"
 	"fmt"
+	"image"
 	"image/gif"
 	"image/jpeg"
 	"image/png"
 	"io"
-	"math"
+	"mime"
 	"net/http"
 	"net/http/cookiejar"
 	"net/url"
-	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -21,55 +22,267 @@ import (
 	"golang.org/x/net/html"
 )
 
-// Parser is the client object and holds the relevant information needed when parsing a URL
+// Parser is the client object and holds the relevant information needed when parsing a URL.
+//
+// A Parser is safe for concurrent use by multiple goroutines once it's configured, so a service can share one across
+// all of its requests, along with its HTTP client's connection pool. Everything about a single parse is kept in that
+// parse's own state; the parser's With* options must not be called while it's parsing, and any extractors, hooks,
+// caches and other callbacks it's given must themselves be safe for concurrent use.
 type Parser struct {
-	customClient       func() *http.Client
-	imageLookupTimeout time.Duration
-	tokenMaxBuffer     int
-	client             *http.Client
-	headers            http.Header
+	customClient        func() *http.Client
+	imageLookupTimeout  time.Duration
+	timeout             time.Duration
+	tokenMaxBuffer      int
+	client              *http.Client
+	headers             http.Header
+	properties          map[string]float64
+	extractors          []Extractor
+	maxCompressionRatio float64
+	heuristics          bool
+	descriptionFallback bool
+	wordCount           bool
+	events              func(Event)
+	maxRedirects        int
+	maxBodySize         int64
+	contentTypes        []string
+	cache               Cache
+	renderer            Renderer
+	transport           *http.Transport
+	transportOptions    []func(*http.Transport)
+	userAgent           string
+	acceptLanguage      []string
+	decoders            map[string]ContentDecoder
+	encodings           []string
+	onRequest           []func(*http.Request)
+	onResponse          []func(*http.Response)
+	stats               Stats
+	images              bool
+	maxImages           int
+	results             *resultCache
+	oembed              bool
+	oembedProviders     []OEmbedProvider
+	textExtraction      bool
+	summarizer          Summarizer
+	personaHeader       http.Header
+	imageScorer         func(Image) float64
+	optimalAspectRatio  float64
+	placeholderSize     int
+	imageProxy          func(string) string
+	imageUpgrade        ImageUpgrade
+	imageFilter         ImageFilter
+	query               queryRules
+	hosts               hostRules
+	headOnly            bool
+	stopWhen            []string
+	domFallback         bool
+	keepWhitespace      bool
+	maxFieldLengths     map[string]int
+	titleCleanup        bool
+	dialer              Dialer
+	resolver            Resolver
+	duplicates          DuplicateStrategy
+	fallbackAgents      []string
+	keepHTML            bool
 }
 
 type parseJob struct {
 	request        *http.Request
 	requestURL     *url.URL
 	response       *http.Response
-	metaTags       []metaTag
-	imgTags        []imgTag
+	doc            *Document
 	tokenMaxBuffer int
+	properties     map[string]float64
+	extractors     []Extractor
+	events         func(Event)
+	wordCount      bool
+	image          bool
+	notModified    bool
+	headOnly       bool
+	stopWhen       []string
+	domFallback    bool
+	keepWhitespace bool
+	titleCleanup   bool
+
+	// fieldLengths are the lengths text fields are truncated to, keyed by their JSON names.
+	fieldLengths map[string]int
+
+	// maxHTML is the size of the largest page that's kept in Result.HTML, or 0 if pages aren't kept.
+	maxHTML int64
+
+	// stopped is set if tokenizing stopped early, e.g. via WithHeadOnly.
+	stopped bool
+
+	// query are the rules for stripping query parameters from the Result's URL.
+	query queryRules
 }
 
 // Result is what comes back from a Parse
 type Result struct {
-	// URL is either the URL as-passed or the defined URL (via og:url) if present
+	// URL is either the URL as-passed or the defined URL (via og:url) if present. RequestedURL, FinalURL and
+	// CanonicalURL hold each of these separately.
 	URL string `json:"url"`
 
-	// Host is the domain of the URL as-passed or the defined URL if present
+	// RequestedURL is the URL that was requested, before any redirects.
+	RequestedURL string `json:"requested_url,omitempty"`
+
+	// FinalURL is the URL of the page that was parsed, after any redirects.
+	FinalURL string `json:"final_url,omitempty"`
+
+	// CanonicalURL is the URL the page declares as its own via og:url, if it has one.
+	CanonicalURL string `json:"canonical_url,omitempty"`
+
+	// Host is the domain of the URL as-passed or the defined URL if present. Internationalized domains are given in
+	// their ASCII (punycode) form.
 	Host string `json:"host"`
 
+	// HostUnicode is the Unicode form of an internationalized Host, e.g. "bücher.example" for
+	// "xn--bcher-kva.example". It's empty if Host isn't internationalized.
+	HostUnicode string `json:"host_unicode,omitempty"`
+
 	// Site is the name of the site as defined via og:site_name or site_name
 	Site string `json:"site_name"`
 
-	// Title is the title of the page as defined via og:title or title
+	// Title is the title of the page as defined via og:title, title or <meta itemprop="name">, or the page's first <h1>
+	// if none of these are present
 	Title string `json:"title"`
 
 	// Type is the type of the page (article, video, etc.) as defined via og:type or type.
 	Type string `json:"type"`
 
-	// Description is the description of the page as defined via og:description or description.
+	// Description is the description of the page as defined via og:description, description or
+	// <meta itemprop="description">.
 	Description string `json:"description"`
 
-	// Author is the author of the page as defined via og:author or author.
+	// Author is the author of the page as defined via og:author or author, or the name from Byline if neither is
+	// present.
 	Author string `json:"author"`
 
+	// Byline is the structured author of the page, found via JSON-LD author data, rel="author" links or common byline
+	// markup, falling back to Author.
+	Byline *Author `json:"byline,omitempty"`
+
 	// Publisher is the publisher of the page as defined via og:publisher or publisher.
 	Publisher string `json:"publisher"`
 
+	// Section is the section or category of the site the page is in, e.g. "Sports", as defined via article:section or
+	// JSON-LD articleSection.
+	Section string `json:"section,omitempty"`
+
 	// Images is the collection of images parsed from the page using either og:image meta tags or <img> tags.
 	Images []Image `json:"images"`
 
+	// Placeholder is a tiny copy of the first of Images, as a base64 JPEG data URL. It's only set if placeholders are
+	// enabled via WithPlaceholder.
+	Placeholder string `json:"placeholder,omitempty"`
+
+	// StatusCode is the HTTP status code of the final response.
+	StatusCode int `json:"status_code,omitempty"`
+
+	// Headers are the final response's Content-Type, Cache-Control, Last-Modified and Server headers, keyed by their
+	// lower-cased names, for deciding how long to cache the Result and whether to retry. Headers sent more than once
+	// are joined with commas.
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// Redirects is every URL that redirected on the way to the final page, in order, starting with the URL as-passed.
+	// It's empty if the page was fetched without any redirects.
+	Redirects []string `json:"redirects,omitempty"`
+
 	// Scraped is the time when the page was scraped (or the time Parse was run).
 	Scraped time.Time `json:"scraped"`
+
+	// Generator is the software that generated the page, as defined via <meta name="generator">.
+	Generator string `json:"generator,omitempty"`
+
+	// Platform is the publishing platform the page appears to be built with (PlatformWordPress, PlatformGhost or
+	// PlatformShopify), detected from its generator, response headers and asset URLs.
+	Platform string `json:"platform,omitempty"`
+
+	// Published is the time the page was published, as defined via article:published_time or found by other means;
+	// see PublishedSource.
+	Published *time.Time `json:"published,omitempty"`
+
+	// PublishedSource is where Published came from: PublishedSourceMeta, PublishedSourceJSONLD, PublishedSourceTime
+	// (a <time datetime> element) or PublishedSourceURL (a date in the page's URL).
+	PublishedSource string `json:"published_source,omitempty"`
+
+	// WordCount is the number of words of visible text on the page. It's only set if enabled via WithWordCount.
+	WordCount int `json:"word_count,omitempty"`
+
+	// ReadingTime is the estimated time to read the page, in minutes, based on WordCount.
+	ReadingTime int `json:"reading_time,omitempty"`
+
+	// Locale is the language the page is in, as defined via og:locale or the <html lang> attribute.
+	Locale string `json:"locale,omitempty"`
+
+	// Paywalled is true if the page's content is behind a paywall, as declared via JSON-LD isAccessibleForFree or
+	// article:content_tier or, if heuristics are enabled, detected from paywall markup and calls to action.
+	Paywalled bool `json:"paywalled,omitempty"`
+
+	// Article, Profile, Book, Video and Music are the page's og:type namespace properties, for article, profile, book,
+	// video.* and music.* pages respectively. Only the one matching the page's og:type is set, except that Profile is
+	// also set for a page with a schema.org ProfilePage. Video describes the page's video as a work (its cast, release
+	// date, etc.); see Videos for the videos themselves.
+	Article *Article      `json:"article,omitempty"`
+	Profile *Profile      `json:"profile,omitempty"`
+	Book    *Book         `json:"book,omitempty"`
+	Video   *VideoDetails `json:"video,omitempty"`
+	Music   *Music        `json:"music,omitempty"`
+
+	// Facebook holds the page's fb: meta tags, such as fb:app_id and fb:pages.
+	Facebook *Facebook `json:"facebook,omitempty"`
+
+	// AppLinks describes how to open the page in native apps, as declared via App Links (al:) meta tags.
+	AppLinks *AppLinks `json:"app_links,omitempty"`
+
+	// Apps are the App Store and Google Play apps the page links to or promotes.
+	Apps []AppReference `json:"apps,omitempty"`
+
+	// Product is the product the page sells, as declared via product: meta tags or a schema.org Product or Offer.
+	Product *Product `json:"product,omitempty"`
+
+	// Rating is the aggregate rating of the thing the page is about, such as a product's or a recipe's, as declared via
+	// schema.org AggregateRating JSON-LD or microdata, or the rating of the review the page is.
+	Rating *Rating `json:"rating,omitempty"`
+
+	// Recipe is the recipe on the page, as declared via schema.org Recipe JSON-LD or microdata.
+	Recipe *Recipe `json:"recipe,omitempty"`
+
+	// Event is the event the page is about, as declared via schema.org Event JSON-LD or microdata.
+	Event *EventDetails `json:"event,omitempty"`
+
+	// Pinterest describes whether the page can be pinned and whether it's ready to be a Rich Pin.
+	Pinterest *Pinterest `json:"pinterest,omitempty"`
+
+	// Audio is the page's audio, such as a podcast episode, declared via og:audio or JSON-LD, embedded in an <audio>
+	// player or linked with rel="enclosure".
+	Audio []Audio `json:"audio,omitempty"`
+
+	// Videos are the page's videos, declared via og:video or embedded from known video hosts like YouTube and Vimeo.
+	Videos []Video `json:"videos,omitempty"`
+
+	// Embeds are the page's iframes, such as maps, video players and social media widgets, including the players
+	// that are also in Videos.
+	Embeds []Embed `json:"embeds,omitempty"`
+
+	// OEmbed is the page's oEmbed data. It's only set if enabled via WithOEmbed.
+	OEmbed *OEmbed `json:"oembed,omitempty"`
+
+	// Content is the page's main content with boilerplate removed. It's only set if enabled via WithTextExtraction.
+	Content *Content `json:"content,omitempty"`
+
+	// Summary is a summary of the page's main content. It's only set if a Summarizer is set via WithSummarizer.
+	Summary string `json:"summary,omitempty"`
+
+	// HTML is the page's HTML, as it was read. It's only set if enabled via WithKeepHTML.
+	HTML string `json:"html,omitempty"`
+
+	// Extras contains the values of any additional properties registered via WithProperties, keyed by property name.
+	Extras map[string]string `json:"extras,omitempty"`
+
+	// AllValues contains every value of the meta properties that were found, in document order, keyed by the JSON name
+	// of the field they fill in (e.g. "title") or, for properties registered via WithProperties, by property name.
+	// It's only set if enabled via WithDuplicateStrategy(DuplicateAll).
+	AllValues map[string][]string `json:"all_values,omitempty"`
 }
 
 // Image contains information about parsed images on the page
@@ -81,6 +294,20 @@ type Image struct {
 	Alt         string  `json:"alt"`
 	AspectRatio float64 `json:"aspectRatio"`
 	Preferred   bool    `json:"preferred,omitempty"`
+
+	// OGIndex is the image's position among the page's og:image tags, starting at 0, if it came from one.
+	OGIndex *int `json:"og_index,omitempty"`
+
+	// Size is the image's size in bytes, from its Content-Length. If the server didn't send one, it's the number of
+	// bytes recon read to analyze the image, which may be less than the whole file.
+	Size int64 `json:"size,omitempty"`
+
+	// Transparent is true if the image is a PNG or WebP with an alpha channel, or a PNG whose palette has transparent
+	// colors.
+	Transparent bool `json:"transparent,omitempty"`
+
+	// Flagged is true if the parser's ImageFilter flagged the image (see WithImageFilter).
+	Flagged bool `json:"flagged,omitempty"`
 }
 
 type metaTag struct {
@@ -93,6 +320,55 @@ type imgTag struct {
 	url       string
 	alt       string
 	preferred bool
+
+	// ogIndex is the tag's position among the page's og:image tags, starting at 1, or 0 if it isn't one.
+	ogIndex int
+
+	// secureURL is the og:image:secure_url given for an og:image tag.
+	secureURL string
+
+	tier imageTier
+}
+
+// imageTier is how strongly a page declares a candidate image to be its image. Candidates in higher tiers rank first.
+type imageTier int
+
+// Image tiers, lowest first.
+const (
+	// tierImg is a plain <img>.
+	tierImg imageTier = iota
+
+	// tierItemprop is a schema.org image property, e.g. <meta itemprop="image">.
+	tierItemprop
+
+	// tierHint is a secondary hint at the page's image, like <link rel="image_src">. Hints are preferred images.
+	tierHint
+
+	// tierTwitter is a twitter:image, which is only a candidate if the page has no og:image.
+	tierTwitter
+
+	// tierOG is an og:image.
+	tierOG
+)
+
+// figure is an open <figure> element. Its <figcaption> is the alt text of the images in it that lack their own.
+type figure struct {
+	caption string
+
+	// images are the indexes of the images in the figure that lack alt text, among the document's imgTags.
+	images []int
+}
+
+// figureStack is the open <figure> elements, innermost last.
+type figureStack []*figure
+
+// current returns the innermost open figure, or nil if there isn't one.
+func (f figureStack) current() *figure {
+	if len(f) == 0 {
+		return nil
+	}
+
+	return f[len(f)-1]
 }
 
 type parsedImage struct {
@@ -101,6 +377,15 @@ type parsedImage struct {
 	alt         string
 	contentType string
 	preferred   bool
+	ogIndex     int
+
+	// size is the image's Content-Length, or -1 if it's unknown, in which case the bytes read from data are counted.
+	size int64
+
+	// status is the HTTP status code of the image's response, or 0 if it wasn't downloaded.
+	status int
+
+	flagged bool
 }
 
 var targetedProperties = map[string]float64{
@@ -119,20 +404,33 @@ var targetedProperties = map[string]float64{
 	"description": 0.5,
 	"author":      0.5,
 	"publisher":   0.5,
+
+	"itemprop:name":        0.25,
+	"itemprop:description": 0.25,
 }
 
 var propertyMap = map[string][]string{
 	"URL":         {"og:url"},
 	"Site":        {"og:site_name", "site_name"},
-	"Title":       {"og:title", "title"},
+	"Title":       {"og:title", "title", "itemprop:name"},
 	"Type":        {"og:type", "type"},
-	"Description": {"og:description", "description"},
+	"Description": {"og:description", "description", "itemprop:description"},
 	"Author":      {"og:author", "author"},
 	"Publisher":   {"og:publisher", "publisher"},
 }
 
-// OptimalAspectRatio is the target aspect ratio that recon favors when looking at images
-var OptimalAspectRatio = 1.91
+// DefaultUserAgent is the User-Agent header recon sends unless told otherwise.
+const DefaultUserAgent = "recon (github.com/jimmysawczuk/recon; similar to Facebot, facebookexternalhit/1.1)"
+
+// DefaultOptimalAspectRatio is the aspect ratio recon favors when ranking images unless told otherwise: the 1.91:1 of
+// a link preview card.
+const DefaultOptimalAspectRatio = 1.91
+
+// OptimalAspectRatio is the target aspect ratio that recon favors when looking at images, for parsers that don't set
+// their own.
+//
+// Deprecated: Changing a global is racy and affects every parser; use WithOptimalAspectRatio instead.
+var OptimalAspectRatio = DefaultOptimalAspectRatio
 
 // DefaultImageLookupTimeout is the maximum amount of time recon will spend downloading and analyzing images
 var DefaultImageLookupTimeout = 10 * time.Second
@@ -146,16 +444,69 @@ func Parse(url string) (Result, error) {
 // NewParser returns a new Parser object
 func NewParser() *Parser {
 	p := &Parser{
-		client:             getDefaultParserClient(),
-		imageLookupTimeout: DefaultImageLookupTimeout,
+		client:              getDefaultParserClient(),
+		imageLookupTimeout:  DefaultImageLookupTimeout,
+		properties:          targetedProperties,
+		maxCompressionRatio: DefaultMaxCompressionRatio,
+		heuristics:          true,
+		images:              true,
+		maxRedirects:        DefaultMaxRedirects,
+		contentTypes:        htmlContentTypes,
+		userAgent:           DefaultUserAgent,
+	}
+	p.extractors = []Extractor{
+		metaExtractor{parser: p},
+		oembedExtractor{parser: p},
+		heuristicExtractor{parser: p},
+		dateExtractor{parser: p},
+		authorExtractor{parser: p},
+		platformExtractor{},
+		localeExtractor{},
+		videoExtractor{},
+		embedExtractor{},
+		audioExtractor{},
+		paywallExtractor{parser: p},
+		ogTypeExtractor{},
+		facebookExtractor{},
+		appLinksExtractor{},
+		appStoreExtractor{},
+		productExtractor{},
+		ratingExtractor{},
+		recipeExtractor{},
+		eventExtractor{},
+		pinterestExtractor{},
+		textExtractor{},
+		contentExtractor{parser: p},
+		summaryExtractor{parser: p},
+		imageExtractor{parser: p},
+		placeholderExtractor{parser: p},
 	}
 
 	return p
 }
 
-// WithClient allows the user to specify a custom HTTP client that the parser will use.
+// WithClient allows the user to specify a custom HTTP client that the parser will use. Transport options set before
+// it, like WithProxy and WithDialer, are applied to a copy of the client, as they would be if they were set after it.
 func (p *Parser) WithClient(client *http.Client) *Parser {
 	p.client = client
+	p.transport = nil
+	if len(p.transportOptions) > 0 {
+		if t := p.ownTransport(); t != nil {
+			for _, fn := range p.transportOptions {
+				fn(t)
+			}
+		}
+	}
+
+	return p
+}
+
+// WithCookieJar sets the cookie jar the parser uses. The jar is set on a copy of the parser's client, so a client
+// passed to WithClient isn't modified. A nil jar disables cookies.
+func (p *Parser) WithCookieJar(jar http.CookieJar) *Parser {
+	client := *p.client
+	client.Jar = jar
+	p.client = &client
 	return p
 }
 
@@ -165,42 +516,318 @@ func (p *Parser) WithImageLookupTimeout(t time.Duration) *Parser {
 	return p
 }
 
+// WithTimeout bounds the total time the parser spends on each parse: fetching the page, tokenizing it and analyzing
+// its images combined. Parses that run out of time fail with an error wrapping context.DeadlineExceeded, unless
+// only the image analysis is cut short, in which case the images found so far are returned. Unlike
+// WithImageLookupTimeout, it also bounds a slow page response. A timeout of 0, the default, means no limit beyond
+// the context's.
+func (p *Parser) WithTimeout(total time.Duration) *Parser {
+	p.timeout = total
+	return p
+}
+
+// withTimeout bounds ctx by the parser's total timeout, if it has one.
+func (p *Parser) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if p.timeout <= 0 {
+		return ctx, func() {}
+	}
+
+	return context.WithTimeout(ctx, p.timeout)
+}
+
 // WithTokenMaxBuffer limits the amount of memory used by the HTML tokenizer.
 func (p *Parser) WithTokenMaxBuffer(s int) *Parser {
 	p.tokenMaxBuffer = s
 	return p
 }
 
+// WithUserAgent sets the User-Agent header the parser sends. Any "{default}" in ua is replaced with
+// DefaultUserAgent, e.g. "MyApp/1.0 {default}". A User-Agent set via WithHeaders takes precedence.
+func (p *Parser) WithUserAgent(ua string) *Parser {
+	p.userAgent = strings.ReplaceAll(ua, "{default}", DefaultUserAgent)
+	return p
+}
+
+// AppendUserAgent adds s to the end of the parser's current User-Agent, e.g. to identify your application while
+// still identifying as recon.
+func (p *Parser) AppendUserAgent(s string) *Parser {
+	p.userAgent = strings.TrimSpace(p.userAgent + " " + s)
+	return p
+}
+
 // WithHeaders allows the user to set the HTTP request headers
 func (p *Parser) WithHeaders(h http.Header) *Parser {
 	p.headers = h
 	return p
 }
 
+// WithProperties registers additional meta properties for the parser to extract, matched against a meta tag's
+// property or name attribute or, prefixed with "itemprop:", its itemprop attribute, along with their priorities.
+// Values of properties that recon doesn't otherwise use are returned in Result.Extras. Registering a built-in
+// property overrides its default priority, and a priority of 0 disables it.
+func (p *Parser) WithProperties(props map[string]float64) *Parser {
+	merged := make(map[string]float64, len(p.properties)+len(props))
+	for k, v := range p.properties {
+		merged[k] = v
+	}
+	for k, v := range props {
+		merged[k] = v
+	}
+
+	p.properties = merged
+	return p
+}
+
+// WithHeuristics enables or disables recon's content heuristics, which fill in fields the page's metadata doesn't
+// provide (for example, using the first <h1> as the title when there's no og:title or <title>). Heuristics are
+// enabled by default; strict consumers that only want declared metadata can disable them.
+func (p *Parser) WithHeuristics(enabled bool) *Parser {
+	p.heuristics = enabled
+	return p
+}
+
+// WithWhitespaceNormalization enables or disables collapsing the runs of spaces, tabs and newlines in the Result's
+// Title, Description and image Alt text into single spaces, as hand-written meta tags and alt attributes often
+// spread their values over several lines. It's enabled by default; callers who want the values as the page wrote
+// them can disable it.
+func (p *Parser) WithWhitespaceNormalization(enabled bool) *Parser {
+	p.keepWhitespace = !enabled
+	return p
+}
+
+// WithMaxFieldLength truncates a text field of the Result, given by its JSON name, to at most n characters, cutting at
+// a word boundary and ending with an ellipsis, e.g. to match the limits of the platform a link preview is shown on.
+// The fields that can be truncated are site_name, title, description, author, publisher, section and summary; others
+// are ignored. A length of 0 removes the field's limit.
+func (p *Parser) WithMaxFieldLength(field string, n int) *Parser {
+	lengths := make(map[string]int, len(p.maxFieldLengths)+1)
+	for k, v := range p.maxFieldLengths {
+		lengths[k] = v
+	}
+	if n > 0 {
+		lengths[field] = n
+	} else {
+		delete(lengths, field)
+	}
+
+	p.maxFieldLengths = lengths
+	return p
+}
+
+// WithTitleCleanup makes the parser strip the site's name from the end of each page's title, as in
+// "Article | Site Name" or "Article - Site Name", for cleaner titles on link previews that show the site's name
+// separately. The suffix is only stripped if it matches Result.Site.
+func (p *Parser) WithTitleCleanup() *Parser {
+	p.titleCleanup = true
+	return p
+}
+
+// WithDescriptionFallback enables a heuristic that, when a page declares no description, uses the first meaningful
+// paragraph of its body text (truncated to a reasonable length) as the description, much like Facebook's crawler
+// does. It has no effect if heuristics have been disabled with WithHeuristics.
+func (p *Parser) WithDescriptionFallback() *Parser {
+	p.descriptionFallback = true
+	return p
+}
+
+// WithWordCount enables counting the words of visible text on the page, which populates Result.WordCount and
+// Result.ReadingTime.
+func (p *Parser) WithWordCount() *Parser {
+	p.wordCount = true
+	return p
+}
+
+// WithImageAnalysis enables or disables downloading and analyzing the page's images to fill in Result.Images. It's
+// enabled by default; disabling it saves a request per image when only the page's text metadata is needed.
+func (p *Parser) WithImageAnalysis(enabled bool) *Parser {
+	p.images = enabled
+	return p
+}
+
+// WithHeadOnly makes the parser stop reading each page at the end of its <head>, or at the first element that
+// belongs in its body if the page doesn't close its <head>, and close the connection. Most metadata is in the
+// <head>, so this saves reading the rest of long pages, at the cost of what's only found in the body: its images,
+// headings, bylines, links, text and content.
+func (p *Parser) WithHeadOnly() *Parser {
+	p.headOnly = true
+	return p
+}
+
+// DefaultMaxHTMLSize is the largest page, in (decoded) bytes, that WithKeepHTML keeps if no limit is set via
+// WithMaxBodySize.
+const DefaultMaxHTMLSize = 5 << 20
+
+// WithKeepHTML makes the parser keep each page's HTML, as it was read from the response after decompression, in
+// Result.HTML, so that it can be stored along with the metadata that came from it. Only as much of the page as was
+// read is kept, e.g. up to the end of its <head> with WithHeadOnly, and pages larger than the limit set via
+// WithMaxBodySize, or DefaultMaxHTMLSize if there isn't one, aren't kept at all.
+func (p *Parser) WithKeepHTML() *Parser {
+	p.keepHTML = true
+	return p
+}
+
+// DefaultCompleteProperties are the meta properties WithStopWhenComplete waits for by default.
+var DefaultCompleteProperties = []string{"og:title", "og:description", "og:image", "og:url", "og:site_name", "og:type"}
+
+// WithStopWhenComplete makes the parser stop reading each page once it has found all of the given meta properties
+// (or those in DefaultCompleteProperties, if none are given) with non-empty values, and close the connection. Reading
+// stops at the next element that isn't a <meta> tag, so properties describing the last one found, like
+// og:image:width, are still read. As with WithHeadOnly, whatever comes after that point isn't parsed; pages missing
+// any of the properties are read in full.
+func (p *Parser) WithStopWhenComplete(properties ...string) *Parser {
+	if len(properties) == 0 {
+		properties = DefaultCompleteProperties
+	}
+
+	p.stopWhen = append([]string{}, properties...)
+	return p
+}
+
+// WithMaxImages limits the number of candidate images the parser analyzes per page, the images the page declares as
+// its own, like og:image, first and then in document order. A limit of 0 means no limit.
+func (p *Parser) WithMaxImages(n int) *Parser {
+	p.maxImages = n
+	return p
+}
+
+// WithExtractors appends extractors to the parser's pipeline. They run after recon's built-in extractors, in the
+// order given, and may read or override anything set by the extractors before them.
+func (p *Parser) WithExtractors(e ...Extractor) *Parser {
+	p.extractors = append(p.extractors, e...)
+	return p
+}
+
 // Parse takes a url and attempts to parse it.
 func (p *Parser) Parse(url string) (Result, error) {
-	job, err := p.getHTML(url)
+	return p.ParseContext(context.Background(), url)
+}
+
+// ParseContext takes a url and attempts to parse it. The provided context bounds the page request and any image
+// requests made while parsing.
+//
+// If the page was fetched but couldn't be parsed completely (e.g. the tokenizer's buffer limit was exceeded, or an
+// extractor failed), the returned Result holds whatever was extracted before the failure alongside the error.
+func (p *Parser) ParseContext(ctx context.Context, url string) (Result, error) {
+	if res, ok := p.results.get(p.cacheKey(url)); ok {
+		if p.stats != nil {
+			p.stats.ObserveParse(ParseStats{URL: url, CacheHit: true})
+		}
+		return res, nil
+	}
+
+	res, _, err := p.parse(ctx, url)
+	if err == nil {
+		p.results.set(p.cacheKey(url), res)
+	}
+
+	return res, err
+}
+
+func (p *Parser) parse(ctx context.Context, url string) (res Result, doc *Document, err error) {
+	ctx, cancel := p.withTimeout(ctx)
+	defer cancel()
+
+	// a followed translation is part of the same parse, so both fetches are reported together
+	rec := &statsRecorder{stats: ParseStats{URL: url}}
+	defer func() { rec.report(p.stats, err) }()
+
+	cached, hasCached := p.cachedEntry(ctx, url)
+
+	var cond validators
+	if hasCached {
+		cond = validators{etag: cached.ETag, lastModified: cached.LastModified}
+	}
+
+	res, job, err := p.parseConditional(ctx, url, cond, rec)
 	if err != nil {
-		return Result{}, errors.Wrap(err, "get html")
+		if job != nil {
+			return res, job.doc, err
+		}
+		return res, nil, err
 	}
 
-	if err := job.tokenize(); err != nil {
-		return Result{}, errors.Wrap(err, "tokenize")
+	if job.notModified {
+		return cached.Result, job.doc, nil
 	}
 
-	imgs := p.analyzeImages(job.requestURL, job.imgTags)
-	res := job.buildResult(imgs)
+	if alt := job.doc.preferredAlternate(p.acceptLanguage); alt != "" && alt != job.doc.URL.String() {
+		// the translation is fetched unconditionally and isn't checked for further translations, so a pair of pages
+		// pointing at each other can't send the parser back and forth
+		if altRes, altJob, altErr := p.parseConditional(ctx, alt, validators{}, rec); altErr == nil {
+			// the translation is cached under its own URL, since the page at url isn't what's returned
+			p.storeEntry(ctx, alt, altRes, altJob.response)
+			altRes.RequestedURL = res.RequestedURL
+			return altRes, altJob.doc, nil
+		}
+	}
 
-	return res, nil
+	p.storeEntry(ctx, url, res, job.response)
+
+	return res, job.doc, nil
+}
+
+// parseConditional fetches and parses url, making a conditional request if cond isn't empty. If the server
+// responds 304 Not Modified, the returned job is marked as such and the Result only describes the response. If the
+// page was fetched but failed to tokenize or extract, the partial Result and the job are returned with the error.
+// Storing the Result in the parser's Cache and reporting rec's measurements are left to the caller.
+func (p *Parser) parseConditional(ctx context.Context, url string, cond validators, rec *statsRecorder) (res Result, job *parseJob, err error) {
+	job, err = p.getHTML(ctx, url, cond, rec)
+	if err != nil {
+		return Result{}, nil, errors.Wrap(err, "get html")
+	}
+	defer job.response.Body.Close()
+	defer job.doc.release()
+
+	if job.notModified {
+		rec.stats.CacheHit = true
+		return job.baseResult(), job, nil
+	}
+
+	if job.image {
+		res := job.buildImageResult(p.filterImage)
+		p.proxyImages(res.Images)
+		return res, job, nil
+	}
+
+	start := time.Now()
+	err = job.tokenize()
+	if job.domFallback && !job.stopped && (err != nil || job.doc.sparse()) {
+		if fallbackErr := job.fallback(); fallbackErr == nil {
+			err = nil
+		}
+	}
+	rec.stats.Tokenize += time.Since(start)
+	if job.doc.challenged() {
+		return job.baseResult(), job, errors.Wrap(ErrBotChallenge, "tokenize")
+	}
+	if err != nil {
+		// extract what we can from the part of the page that was tokenized
+		res, _ := job.buildResult()
+		return res, job, errors.Wrap(err, "tokenize")
+	}
+
+	res, err = job.buildResult()
+	if err != nil {
+		return res, job, errors.Wrap(err, "extract")
+	}
+
+	return res, job, nil
 }
 
-func (p *Parser) newReq(url string) (*http.Request, error) {
-	req, err := http.NewRequest("GET", url, nil)
+func (p *Parser) newReq(ctx context.Context, url string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", asciiURL(url), nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %s, url: %s", err, url)
 	}
 
-	req.Header.Add("User-Agent", "recon (github.com/jimmysawczuk/recon; similar to Facebot, facebookexternalhit/1.1)")
+	req.Header.Add("User-Agent", p.userAgent)
+	req.Header.Add("Accept-Encoding", p.acceptEncoding())
+	if len(p.acceptLanguage) > 0 {
+		req.Header.Add("Accept-Language", acceptLanguageHeader(p.acceptLanguage))
+	}
+	for k, vv := range p.personaHeader {
+		req.Header[k] = vv
+	}
 	for k, vv := range p.headers {
 		req.Header[k] = vv
 	}
@@ -208,35 +835,154 @@ func (p *Parser) newReq(url string) (*http.Request, error) {
 	return req, nil
 }
 
-func (p *Parser) getHTML(url string) (*parseJob, error) {
-	req, err := p.newReq(url)
+func (p *Parser) getHTML(ctx context.Context, url string, cond validators, rec *statsRecorder) (*parseJob, error) {
+	req, err := p.newReq(ctx, url)
 	if err != nil {
 		return nil, err
 	}
-
-	resp, err := p.client.Do(req)
+	cond.apply(req)
+
+	start := time.Now()
+	var resp *http.Response
+	if p.renderer != nil {
+		resp, err = p.render(req)
+	} else {
+		req, resp, err = p.doWithFallback(req)
+	}
+	rec.stats.Fetch += time.Since(start)
+	if err == nil && resp.StatusCode == http.StatusNotModified && !cond.empty() {
+		return &parseJob{
+			request:     req,
+			requestURL:  req.URL,
+			response:    resp,
+			doc:         &Document{URL: req.URL, Response: resp, stats: rec},
+			notModified: true,
+			query:       p.query,
+		}, nil
+	}
 	if err == nil && (resp.StatusCode < 200 || resp.StatusCode >= 300) {
 		err = errors.New(resp.Status)
+		if p.decodeBody(resp) == nil && isChallengeResponse(resp) {
+			err = errors.Wrap(ErrBotChallenge, resp.Status)
+		}
+		resp.Body.Close()
 	}
 	if err != nil {
-		return nil, fmt.Errorf("http error: %s, url: %s", err, url)
+		return nil, fmt.Errorf("http error: %w, url: %s", err, url)
+	}
+
+	image := isImageResponse(resp)
+	if !image {
+		if err := p.checkContentType(resp); err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+	}
+
+	resp.Body = rec.countBody(resp.Body)
+	if err := p.decodeBody(resp); err != nil {
+		resp.Body.Close()
+		return nil, errors.Wrap(err, "decode body")
+	}
+
+	if p.maxBodySize > 0 {
+		limitBody(resp, p.maxBodySize)
+	}
+
+	var maxHTML int64
+	if p.keepHTML {
+		maxHTML = DefaultMaxHTMLSize
+		if p.maxBodySize > 0 {
+			maxHTML = p.maxBodySize
+		}
+	}
+
+	doc := &Document{URL: req.URL, Response: resp, stats: rec}
+	if needDOM := p.textExtraction || p.summarizer != nil || p.domFallback; (needDOM || p.keepHTML) && !image {
+		// content extraction and the DOM fallback need the whole DOM, so the page is kept as it's tokenized
+		doc.raw = getBuffer()
+
+		var w io.Writer = doc.raw
+		if !needDOM {
+			// one byte past the limit is enough to tell that the page is too large to keep
+			w = &limitedWriter{w: doc.raw, n: maxHTML + 1}
+		}
+		resp.Body = &wrappedBody{Reader: io.TeeReader(resp.Body, w), closer: resp.Body}
 	}
 
 	result := &parseJob{
 		request:        req,
 		requestURL:     req.URL,
 		response:       resp,
-		metaTags:       []metaTag{},
-		imgTags:        []imgTag{},
+		doc:            doc,
 		tokenMaxBuffer: p.tokenMaxBuffer,
+		properties:     p.properties,
+		extractors:     p.extractors,
+		events:         p.events,
+		wordCount:      p.wordCount,
+		image:          image,
+		headOnly:       p.headOnly,
+		stopWhen:       p.stopWhen,
+		domFallback:    p.domFallback,
+		keepWhitespace: p.keepWhitespace,
+		titleCleanup:   p.titleCleanup,
+		fieldLengths:   p.maxFieldLengths,
+		query:          p.query,
+		maxHTML:        maxHTML,
 	}
 
 	return result, nil
 }
 
+// headElements are the elements that belong in a page's <head>, along with the <html> and <head> elements themselves.
+// Any other element starts the page's body.
+var headElements = map[string]bool{
+	"html": true, "head": true, "title": true, "base": true, "link": true, "meta": true, "style": true, "script": true,
+	"noscript": true, "template": true,
+}
+
+// stop ends tokenization early, closing the page's body so the rest of it isn't downloaded.
+func (p *parseJob) stop() error {
+	p.stopped = true
+	p.response.Body.Close()
+	return nil
+}
+
 func (p *parseJob) tokenize() error {
-	decoder := html.NewTokenizer(p.response.Body)
-	decoder.SetMaxBuf(p.tokenMaxBuffer)
+	return p.tokenizeFrom(p.response.Body, p.tokenMaxBuffer)
+}
+
+// tokenizeFrom reads the page's tags from r into the job's Document, limiting the tokenizer's buffer to maxBuf
+// bytes, or not at all if it's 0.
+func (p *parseJob) tokenizeFrom(r io.Reader, maxBuf int) error {
+	decoder := html.NewTokenizer(r)
+	decoder.SetMaxBuf(maxBuf)
+
+	tokens := tokenBufferPool.Get().(*tokenBuffer)
+	defer tokenBufferPool.Put(tokens)
+
+	properties := p.properties
+	if properties == nil {
+		properties = targetedProperties
+	}
+
+	// remaining are the properties still to be found before tokenizing can stop early
+	remaining := make(map[string]bool, len(p.stopWhen))
+	for _, name := range p.stopWhen {
+		remaining[name] = true
+	}
+
+	headingDepth := 0
+	hiddenDepth := 0
+	inHead := false
+	capturingByline := false
+	audioDepth := 0
+	captures := textCaptures{}
+	items := microdata{}
+	ogImages := 0
+	lastOGImage := -1
+	lastImg := -1
+	figures := figureStack{}
 
 	for {
 		tt := decoder.Next()
@@ -248,24 +994,327 @@ func (p *parseJob) tokenize() error {
 			}
 			return err
 
+		case html.TextToken:
+			if hiddenDepth > 0 {
+				continue
+			}
+
+			text := decoder.Token().Data
+			captures.write(text)
+
+			if p.wordCount && !inHead {
+				p.doc.wordCount += len(strings.Fields(text))
+			}
+
+			if !inHead && len(text) <= maxPaywallPhraseText && paywallPhrases.MatchString(text) {
+				p.doc.paywallMarkers++
+			}
+
+		case html.EndTagToken:
+			t := tokens.tag(decoder, tt)
+			captures.end(t.Data)
+			items.end(t.Data)
+
+			switch t.Data {
+			case "head":
+				inHead = false
+				if p.headOnly {
+					return p.stop()
+				}
+
+			case "script", "style", "noscript", "template":
+				if hiddenDepth > 0 {
+					hiddenDepth--
+				}
+
+			case "h1", "h2", "h3":
+				if headingDepth > 0 {
+					headingDepth--
+				}
+
+			case "audio":
+				if audioDepth > 0 {
+					audioDepth--
+				}
+
+			case "figure":
+				if len(figures) > 0 {
+					figures = figures[:len(figures)-1]
+				}
+			}
+
 		case html.SelfClosingTagToken, html.StartTagToken:
-			t := decoder.Token()
+			t := tokens.tag(decoder, tt)
+			if p.headOnly && !headElements[t.Data] {
+				return p.stop()
+			}
+			if len(p.stopWhen) > 0 && len(remaining) == 0 && t.Data != "meta" {
+				return p.stop()
+			}
+
+			if tt == html.StartTagToken {
+				if t.Data == "p" {
+					// a new paragraph implicitly closes an open one
+					captures.end("p")
+					items.end("p")
+				}
+				captures.enter(t.Data)
+
+				if isPaywallMarker(t) {
+					p.doc.paywallMarkers++
+				}
+
+				if p.doc.byline == "" && !capturingByline && isByline(t) {
+					capturingByline = true
+					captures.start(t.Data, func(text string) {
+						p.doc.byline = cleanByline(text)
+						capturingByline = false
+					})
+				}
+			}
+
+			if item := items.start(t, tt == html.SelfClosingTagToken, &captures); item != nil {
+				p.doc.ld = append(p.doc.ld, item)
+			}
+
 			switch t.Data {
+			case "html":
+				if lang := getAttr(t, "lang"); lang != "" && tt == html.StartTagToken {
+					p.doc.lang = lang
+				}
+
+			case "head":
+				inHead = tt == html.StartTagToken
+
+			case "base":
+				// only the first <base> with an href counts
+				if href := getAttr(t, "href"); href != "" && p.doc.base == nil {
+					if u, err := url.Parse(href); err == nil {
+						p.doc.base = u
+					}
+				}
+
+			case "body":
+				inHead = false
+
+			case "script", "style", "noscript", "template":
+				if tt == html.StartTagToken {
+					hiddenDepth++
+				}
+
+				if src := getAttr(t, "src"); t.Data == "script" && src != "" {
+					p.doc.assets = append(p.doc.assets, src)
+				}
+
+				if t.Data == "script" && strings.EqualFold(getAttr(t, "type"), "application/ld+json") && tt == html.StartTagToken {
+					if decoder.Next() == html.TextToken {
+						p.doc.ld = append(p.doc.ld, parseJSONLD(decoder.Token().Data)...)
+					} else {
+						// the script was empty and its end tag has already been consumed
+						hiddenDepth--
+					}
+				}
+
+				// lazy-loading pages put the real <img> in a <noscript>, after a placeholder that's swapped out by script
+				if t.Data == "noscript" && tt == html.StartTagToken {
+					var images []imgTag
+					if decoder.Next() == html.TextToken {
+						images = noscriptImages(decoder.Token().Data)
+					} else {
+						// the noscript was empty and its end tag has already been consumed
+						hiddenDepth--
+					}
+
+					for _, img := range images {
+						if lastImg >= 0 && lastImg == len(p.doc.imgTags)-1 && strings.HasPrefix(p.doc.imgTags[lastImg].url, "data:") {
+							if strings.TrimSpace(img.alt) == "" {
+								img.alt = p.doc.imgTags[lastImg].alt
+							} else if fig := figures.current(); fig != nil && len(fig.images) > 0 && fig.images[len(fig.images)-1] == lastImg {
+								// the placeholder was waiting on the figure's caption, but the real image has its own alt
+								fig.images = fig.images[:len(fig.images)-1]
+							}
+							p.doc.imgTags[lastImg] = img
+							continue
+						}
+
+						if fig := figures.current(); fig != nil && strings.TrimSpace(img.alt) == "" {
+							img.alt = fig.caption
+							fig.images = append(fig.images, len(p.doc.imgTags))
+						}
+						p.doc.imgTags = append(p.doc.imgTags, img)
+					}
+				}
+
+			case "time":
+				if datetime := getAttr(t, "datetime"); datetime != "" {
+					p.doc.times = append(p.doc.times, timeTag{
+						datetime:  datetime,
+						published: getAttr(t, "itemprop") == "datePublished" || hasAttr(t, "pubdate"),
+					})
+				}
+
+			case "h1", "h2", "h3":
+				if tt == html.StartTagToken {
+					headingDepth++
+				}
+
+				if t.Data == "h1" && tt == html.StartTagToken && p.doc.h1 == "" && !captures.active("h1") {
+					captures.start("h1", func(text string) {
+						if p.doc.h1 == "" {
+							p.doc.h1 = collapseWhitespace(text)
+						}
+					})
+				}
+
+			case "p":
+				if tt == html.StartTagToken && p.doc.paragraph == "" && !captures.active("p") {
+					captures.start("p", func(text string) {
+						text = collapseWhitespace(text)
+						if p.doc.paragraph == "" && len(text) >= minParagraphLength {
+							p.doc.paragraph = text
+						}
+					})
+				}
+
+			case "a":
+				href := getAttr(t, "href")
+				if href != "" {
+					p.doc.links = append(p.doc.links, link{
+						href:      href,
+						prominent: headingDepth > 0,
+					})
+				}
+
+				if hasRel(t, "enclosure") && isAudioLink(href, getAttr(t, "type")) {
+					p.doc.audio = append(p.doc.audio, Audio{URL: href, Type: getAttr(t, "type")})
+				}
+
+				if hasRel(t, "author") && tt == html.StartTagToken {
+					captures.start("a", func(text string) {
+						p.doc.authorLinks = append(p.doc.authorLinks, Author{Name: cleanByline(text), URL: href})
+					})
+				}
+
+			case "link":
+				if hasRel(t, "stylesheet") {
+					p.doc.assets = append(p.doc.assets, getAttr(t, "href"))
+				}
+
+				if hreflang, href := getAttr(t, "hreflang"), getAttr(t, "href"); hasRel(t, "alternate") && hreflang != "" && href != "" {
+					p.doc.alternates = append(p.doc.alternates, alternate{lang: hreflang, href: href})
+				}
+
+				if href := getAttr(t, "href"); hasRel(t, "alternate") && strings.EqualFold(getAttr(t, "type"), "application/json+oembed") && p.doc.oembed == "" {
+					p.doc.oembed = href
+				}
+
+				if href, mediaType := getAttr(t, "href"), getAttr(t, "type"); hasRel(t, "enclosure") && isAudioLink(href, mediaType) {
+					p.doc.audio = append(p.doc.audio, Audio{URL: href, Type: mediaType})
+				}
+
+				if href := getAttr(t, "href"); hasRel(t, "image_src") && href != "" {
+					p.doc.imgTags = append(p.doc.imgTags, imgTag{url: href, preferred: true, tier: tierHint})
+				} else if hasItemprop(t, "image") && href != "" {
+					p.doc.imgTags = append(p.doc.imgTags, imgTag{url: href, tier: tierItemprop})
+				}
+
+				if hasRel(t, "author") {
+					if href := getAttr(t, "href"); href != "" {
+						p.doc.authorLinks = append(p.doc.authorLinks, Author{URL: href})
+					}
+				}
+
 			case "meta":
-				res := parseMeta(t)
-				p.metaTags = append(p.metaTags, res)
+				raw := parseRawMeta(t)
+				if raw.Name != "" {
+					p.doc.Meta = append(p.doc.Meta, raw)
+				}
+
+				if raw.Content != "" {
+					delete(remaining, raw.Name)
+				}
+
+				res := parseMeta(t, properties)
+				p.doc.metaTags = append(p.doc.metaTags, res)
+				if res.name != "" {
+					emit(p.events, Event{Type: EventTagExtracted, URL: p.requestURL.String(), Name: res.name, Value: res.value})
+				}
 
 				if res.name == "og:image" {
-					p.imgTags = append(p.imgTags, imgTag{
+					ogImages++
+					lastOGImage = len(p.doc.imgTags)
+					p.doc.imgTags = append(p.doc.imgTags, imgTag{
 						url:       res.value,
 						preferred: true,
+						ogIndex:   ogImages,
+						tier:      tierOG,
 					})
 				}
 
+				if content := getAttr(t, "content"); hasItemprop(t, "image") && content != "" {
+					p.doc.imgTags = append(p.doc.imgTags, imgTag{url: content, tier: tierItemprop})
+				}
+
+				// og:image:secure_url describes the og:image before it
+				if raw.Name == "og:image:secure_url" && lastOGImage >= 0 {
+					p.doc.imgTags[lastOGImage].secureURL = raw.Content
+				}
+
+			case "audio":
+				if tt == html.StartTagToken {
+					audioDepth++
+				}
+
+				if a := audioTag(t); a.URL != "" {
+					p.doc.audio = append(p.doc.audio, a)
+				}
+
+			case "source":
+				if a := audioTag(t); audioDepth > 0 && a.URL != "" {
+					p.doc.audio = append(p.doc.audio, a)
+				}
+
+			case "iframe":
+				src := getAttr(t, "src")
+				if src == "" || strings.HasPrefix(src, "about:") {
+					src = getAttr(t, "data-src")
+				}
+
+				if src != "" && tt == html.StartTagToken {
+					width, _ := strconv.Atoi(getAttr(t, "width"))
+					height, _ := strconv.Atoi(getAttr(t, "height"))
+					p.doc.iframes = append(p.doc.iframes, iframe{src: src, title: getAttr(t, "title"), width: width, height: height})
+				}
+
 			case "img":
 				res := parseImg(t)
+				if hasItemprop(t, "image") {
+					res.tier = tierItemprop
+				}
 				if res.url != "" {
-					p.imgTags = append(p.imgTags, res)
+					if fig := figures.current(); fig != nil && strings.TrimSpace(res.alt) == "" {
+						res.alt = fig.caption
+						fig.images = append(fig.images, len(p.doc.imgTags))
+					}
+
+					lastImg = len(p.doc.imgTags)
+					p.doc.imgTags = append(p.doc.imgTags, res)
+					p.doc.assets = append(p.doc.assets, res.url)
+				}
+
+			case "figure":
+				if tt == html.StartTagToken {
+					figures = append(figures, &figure{})
+				}
+
+			case "figcaption":
+				if fig := figures.current(); fig != nil && tt == html.StartTagToken && !captures.active("figcaption") {
+					captures.start("figcaption", func(text string) {
+						fig.caption = collapseWhitespace(text)
+						for _, i := range fig.images {
+							p.doc.imgTags[i].alt = fig.caption
+						}
+					})
 				}
 
 			case "title":
@@ -273,83 +1322,175 @@ func (p *parseJob) tokenize() error {
 				if textNode == html.TextToken {
 					content := decoder.Token()
 					res := parseTitle(content)
-					p.metaTags = append(p.metaTags, res)
+					p.doc.metaTags = append(p.doc.metaTags, res)
+					if strings.TrimSpace(res.value) != "" {
+						delete(remaining, "title")
+					}
+					emit(p.events, Event{Type: EventTagExtracted, URL: p.requestURL.String(), Name: res.name, Value: res.value})
 				}
 			}
 		}
 	}
 }
 
-func (p *Parser) parseImage(u *url.URL, tag imgTag) (parsedImage, error) {
-	req, _ := p.newReq(u.String())
-	resp, err := p.client.Do(req)
+func (p *Parser) parseImage(ctx context.Context, u *url.URL, tag imgTag, rec *statsRecorder) (parsedImage, error) {
+	req, _ := p.newReq(ctx, u.String())
+	resp, err := p.do(req)
 	if err != nil {
 		return parsedImage{}, errors.Wrap(err, "parseImage")
 	}
 
+	resp.Body = rec.countBody(resp.Body)
+	if err := p.decodeBody(resp); err != nil {
+		resp.Body.Close()
+		return parsedImage{}, errors.Wrap(err, "parseImage")
+	}
+
 	return parsedImage{
 		url:         u.String(),
 		contentType: resp.Header.Get("Content-Type"),
 		data:        resp.Body,
 		alt:         tag.alt,
 		preferred:   tag.preferred,
+		ogIndex:     tag.ogIndex,
+		size:        resp.ContentLength,
+		status:      resp.StatusCode,
 	}, nil
 }
 
-func (p *parseJob) buildResult(imgs []Image) Result {
-	res := Result{}
+// resultHeaders are the response headers copied into Result.Headers.
+var resultHeaders = []string{"content-type", "cache-control", "last-modified", "server"}
+
+func (p *parseJob) baseResult() Result {
+	res := Result{
+		URL:          p.query.clean(p.requestURL.String()),
+		RequestedURL: p.requestURL.String(),
+		FinalURL:     p.requestURL.String(),
+		StatusCode:   p.response.StatusCode,
+		Redirects:    redirectChain(p.response),
+		Scraped:      time.Now(),
+	}
+	if p.response.Request != nil && p.response.Request.URL != nil {
+		res.FinalURL = p.response.Request.URL.String()
+	}
+	res.setHost(p.requestURL.Host)
 
-	res.URL = p.requestURL.String()
-	res.Host = p.requestURL.Host
-	if canonicalURLStr := p.getMaxProperty("URL"); canonicalURLStr != "" {
-		canonicalURL, err := url.Parse(canonicalURLStr)
-		if err == nil {
-			res.URL = canonicalURL.String()
-			res.Host = canonicalURL.Host
+	for _, name := range resultHeaders {
+		if values := p.response.Header.Values(name); len(values) > 0 {
+			if res.Headers == nil {
+				res.Headers = map[string]string{}
+			}
+			res.Headers[name] = strings.Join(values, ", ")
 		}
 	}
 
-	res.Site = p.getMaxProperty("Site")
-	res.Title = p.getMaxProperty("Title")
-	res.Type = p.getMaxProperty("Type")
-	res.Description = p.getMaxProperty("Description")
-	res.Author = p.getMaxProperty("Author")
-	res.Publisher = p.getMaxProperty("Publisher")
-	res.Images = imgs
-	res.Scraped = time.Now()
-
 	return res
 }
 
-func (p *parseJob) getMaxProperty(key string) (val string) {
-	maxWeight := 0.0
+func (p *parseJob) buildResult() (Result, error) {
+	res := p.baseResult()
+	if raw := p.doc.raw; p.maxHTML > 0 && raw != nil && int64(raw.Len()) <= p.maxHTML {
+		// the buffer goes back to the pool once the page is parsed, so the Result gets its own copy
+		res.HTML = raw.String()
+	}
 
-	for _, searchTag := range propertyMap[key] {
-		for _, tag := range p.metaTags {
-			if tag.name == searchTag && tag.priority > maxWeight {
-				val = tag.value
-				maxWeight = tag.priority
-			}
+	for _, e := range p.extractors {
+		if err := e.Extract(p.doc, &res); err != nil {
+			p.finish(&res)
+			return res, err
 		}
 	}
 
-	return
+	p.finish(&res)
+
+	return res, nil
+}
+
+// finish cleans up the Result's fields once the extractors are done with it.
+func (p *parseJob) finish(res *Result) {
+	// extractors may have replaced the URL, e.g. with og:url
+	res.URL = p.query.clean(res.URL)
+	res.normalize(p.keepWhitespace)
+	if p.titleCleanup {
+		res.Title = cleanTitle(res.Title, res.Site)
+	}
+	res.truncate(p.fieldLengths)
+}
+
+// buildImageResult builds a Result for a URL that points directly at an image: the image itself is the only Image,
+// unless filter drops it, and Type is "image".
+func (p *parseJob) buildImageResult(filter func(context.Context, parsedImage) (parsedImage, error)) Result {
+	res := p.baseResult()
+	res.Type = "image"
+
+	mediaType, _, _ := mime.ParseMediaType(p.response.Header.Get("Content-Type"))
+	in, err := filter(p.doc.context(), parsedImage{
+		url:         p.requestURL.String(),
+		contentType: mediaType,
+		data:        p.response.Body,
+		preferred:   true,
+		size:        p.response.ContentLength,
+	})
+	if err != nil {
+		res.Images = []Image{}
+		return res
+	}
+
+	img, _ := in.export()
+	res.Images = []Image{img}
+
+	return res
 }
 
+// getDefaultParserClient returns a new client with a cookie jar of its own, so that parsers don't share cookies with
+// each other or with the rest of the process.
 func getDefaultParserClient() *http.Client {
-	client := http.DefaultClient
-	client.Jar, _ = cookiejar.New(nil)
-	return client
+	jar, _ := cookiejar.New(nil)
+	return &http.Client{Jar: jar}
 }
 
-func parseMeta(t html.Token) metaTag {
+func getAttr(t html.Token, key string) string {
+	for _, v := range t.Attr {
+		if v.Key == key {
+			return strings.TrimSpace(v.Val)
+		}
+	}
+
+	return ""
+}
+
+func hasAttr(t html.Token, key string) bool {
+	for _, v := range t.Attr {
+		if v.Key == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parseRawMeta(t html.Token) Meta {
+	var m Meta
+
+	for _, v := range t.Attr {
+		if (v.Key == "property" || v.Key == "name") && m.Name == "" {
+			m.Name = strings.TrimSpace(v.Val)
+		} else if v.Key == "content" {
+			m.Content = strings.TrimSpace(v.Val)
+		}
+	}
+
+	return m
+}
+
+func parseMeta(t html.Token, properties map[string]float64) metaTag {
 	var content string
 	var tag string
 	var priority float64
 
 	for _, v := range t.Attr {
 		if v.Key == "property" || v.Key == "name" {
-			if _priority, exists := targetedProperties[v.Val]; exists {
+			if _priority, exists := properties[v.Val]; exists {
 				tag = strings.TrimSpace(v.Val)
 				priority = _priority
 			}
@@ -358,6 +1499,18 @@ func parseMeta(t html.Token) metaTag {
 		}
 	}
 
+	// microdata properties, as used by pages written for Google rather than OpenGraph, are matched with an itemprop:
+	// prefix so they can't be confused with meta names
+	if priority == 0 {
+		for _, prop := range strings.Fields(getAttr(t, "itemprop")) {
+			if _priority, exists := properties["itemprop:"+prop]; exists {
+				tag = "itemprop:" + prop
+				priority = _priority
+				break
+			}
+		}
+	}
+
 	if priority > 0 {
 		return metaTag{name: tag, value: content, priority: priority}
 	}
@@ -381,6 +1534,32 @@ func parseImg(t html.Token) (i imgTag) {
 	return imgTag{}
 }
 
+// noscriptImages returns the images in the raw HTML of a <noscript> element, leaving out tracking pixels.
+func noscriptImages(raw string) []imgTag {
+	var out []imgTag
+
+	decoder := html.NewTokenizer(strings.NewReader(raw))
+	for {
+		switch decoder.Next() {
+		case html.ErrorToken:
+			return out
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			t := decoder.Token()
+			if t.Data != "img" || getAttr(t, "width") == "1" || getAttr(t, "height") == "1" {
+				continue
+			}
+
+			if img := parseImg(t); img.url != "" {
+				if hasItemprop(t, "image") {
+					img.tier = tierItemprop
+				}
+				out = append(out, img)
+			}
+		}
+	}
+}
+
 func parseImgFromData(i imgTag) (parsedImage, error) {
 	// get the image data from the url, decode it
 	parts := strings.SplitN(i.url, ";", 2)
@@ -389,9 +1568,16 @@ func parseImgFromData(i imgTag) (parsedImage, error) {
 	}
 
 	header, body := parts[0], parts[1]
-	data := strings.Replace(body, "base64,", "", 1)
-	full, err := base64.StdEncoding.DecodeString(data)
-	if err != nil {
+	data := body
+	if i := strings.Index(body, "base64,"); i >= 0 {
+		// slicing rather than replacing avoids copying the data in the usual case, where it starts the body
+		data = body[:i] + body[i+len("base64,"):]
+	}
+
+	// data URLs can be large, so they're decoded into a pooled buffer that's released when the image is closed
+	full := getBuffer()
+	if _, err := full.ReadFrom(base64.NewDecoder(base64.StdEncoding, strings.NewReader(data))); err != nil {
+		putBuffer(full)
 		return parsedImage{}, err
 	}
 
@@ -400,10 +1586,12 @@ func parseImgFromData(i imgTag) (parsedImage, error) {
 
 	return parsedImage{
 		contentType: contentType,
-		data:        bytes.NewBuffer(full),
+		data:        newPooledReader(full),
 		url:         i.url,
 		alt:         i.alt,
 		preferred:   i.preferred,
+		ogIndex:     i.ogIndex,
+		size:        int64(full.Len()),
 	}, nil
 }
 
@@ -411,17 +1599,26 @@ func parseTitle(t html.Token) metaTag {
 	return metaTag{name: "title", value: t.Data, priority: 0.5}
 }
 
-func (p *Parser) analyzeImages(baseURL *url.URL, tags []imgTag) []Image {
-	ch := make(chan parsedImage)
+func (p *Parser) analyzeImages(ctx context.Context, baseURL *url.URL, tags []imgTag, rec *statsRecorder) []Image {
+	type indexedImage struct {
+		index int
+		img   parsedImage
+
+		// dropped is set for images the parser's ImageFilter dropped or failed on, and those on hosts it isn't
+		// allowed to fetch from.
+		dropped bool
+	}
+
+	ch := make(chan indexedImage, len(tags))
 	returned := []Image{}
 	numFound := 0
 
-	for _, tag := range tags {
-		go func(tag imgTag, ch chan parsedImage) {
+	for i, tag := range tags {
+		go func(i int, tag imgTag, ch chan indexedImage) {
 			u, err := url.Parse(tag.url)
 			if err != nil {
 				// malformed image src
-				ch <- parsedImage{}
+				ch <- indexedImage{index: i}
 				return
 			}
 			u = baseURL.ResolveReference(u)
@@ -429,21 +1626,35 @@ func (p *Parser) analyzeImages(baseURL *url.URL, tags []imgTag) []Image {
 			if strings.HasPrefix(u.String(), "data:") {
 				img, err := parseImgFromData(tag)
 				if err != nil {
-					ch <- parsedImage{}
+					ch <- indexedImage{index: i}
+					return
+				}
+				if img, err = p.filterImage(ctx, img); err != nil {
+					ch <- indexedImage{index: i, dropped: true}
+					return
 				}
 
-				ch <- img
+				ch <- indexedImage{index: i, img: img}
+				return
+			}
+
+			if p.hosts.check(u) != nil {
+				ch <- indexedImage{index: i, dropped: true}
 				return
 			}
 
-			img, err := p.parseImage(u, tag)
+			img, err := p.fetchImage(ctx, baseURL, u, tag, rec)
 			if err != nil {
-				ch <- parsedImage{}
+				ch <- indexedImage{index: i}
+				return
+			}
+			if img, err = p.filterImage(ctx, img); err != nil {
+				ch <- indexedImage{index: i, dropped: true}
 				return
 			}
 
-			ch <- img
-		}(tag, ch)
+			ch <- indexedImage{index: i, img: img}
+		}(i, tag, ch)
 
 		numFound++
 	}
@@ -452,73 +1663,113 @@ func (p *Parser) analyzeImages(baseURL *url.URL, tags []imgTag) []Image {
 		return returned
 	}
 
+	// images are collected in the order they finish, so they're put back in document order before they're ranked
+	collected := make([]*Image, len(tags))
 	timeOutCh := time.After(p.imageLookupTimeout)
-	for {
+	received := 0
+collect:
+	for received < numFound {
 		select {
 		case <-timeOutCh:
-			break
+			break collect
+
+		case <-ctx.Done():
+			break collect
 
 		case incoming := <-ch:
-			returned = append(returned, incoming.export())
-		}
+			received++
+			if incoming.dropped {
+				continue
+			}
 
-		if len(returned) >= numFound {
-			break
+			img, err := incoming.img.export()
+			if errors.Is(err, ErrCompressionBomb) {
+				continue
+			}
+
+			collected[incoming.index] = &img
 		}
 	}
 
-	sort.Slice(returned, func(a, b int) bool {
-		if returned[a].Preferred && !returned[b].Preferred {
-			return true
+	var tiers []imageTier
+	for i, img := range collected {
+		if img != nil {
+			returned = append(returned, *img)
+			tiers = append(tiers, tags[i].tier)
 		}
+	}
 
-		if !returned[a].Preferred && returned[b].Preferred {
-			return false
-		}
+	p.rankImages(returned, tiers)
 
-		return math.Abs(float64(returned[a].AspectRatio)-OptimalAspectRatio) < math.Abs(float64(returned[b].AspectRatio)-OptimalAspectRatio)
-	})
+	for i := range returned {
+		img := returned[i]
+		emit(p.events, Event{Type: EventImageRanked, URL: img.URL, Image: &img, Rank: i})
+	}
 
 	return returned
 }
 
-func (in parsedImage) export() Image {
+// export reads the image's dimensions from its header, without decoding the full image, and returns the result.
+// An error is returned if the image data couldn't be read.
+func (in parsedImage) export() (Image, error) {
 	out := Image{
 		URL:       in.url,
 		Alt:       in.alt,
 		Preferred: in.preferred,
 		Type:      in.contentType,
+		Flagged:   in.flagged,
+	}
+	if in.ogIndex > 0 {
+		i := in.ogIndex - 1
+		out.OGIndex = &i
+	}
+
+	if c, ok := in.data.(io.Closer); ok {
+		defer c.Close()
+	}
+
+	var counter *countingReader
+	if in.size < 0 && in.data != nil {
+		counter = &countingReader{r: in.data}
+		in.data = counter
+	}
+
+	var cfg image.Config
+	var err error
+
+	// the JPEG and GIF decoders buffer readers that can't read a byte at a time, so a pooled buffer is lent to them
+	data := in.data
+	if _, ok := data.(io.ByteReader); !ok && data != nil && (in.contentType == "image/jpeg" || in.contentType == "image/gif") {
+		br := getBufReader(data)
+		defer putBufReader(br)
+		data = br
 	}
 
 	switch in.contentType {
 	case "image/jpeg":
-		img, _ := jpeg.Decode(in.data)
-		if img != nil {
-			bounds := img.Bounds()
-			out.Width = bounds.Max.X
-			out.Height = bounds.Max.Y
-		}
+		cfg, err = jpeg.DecodeConfig(data)
 
 	case "image/gif":
-		img, _ := gif.Decode(in.data)
-		if img != nil {
-			bounds := img.Bounds()
-			out.Width = bounds.Max.X
-			out.Height = bounds.Max.Y
-		}
+		cfg, err = gif.DecodeConfig(data)
 
 	case "image/png":
-		img, _ := png.Decode(in.data)
-		if img != nil {
-			bounds := img.Bounds()
-			out.Width = bounds.Max.X
-			out.Height = bounds.Max.Y
-		}
+		cfg, err = png.DecodeConfig(in.data)
+		out.Transparent = hasAlpha(cfg.ColorModel)
+
+	case "image/webp":
+		cfg, out.Transparent, err = webpConfig(in.data)
 	}
 
+	out.Width = cfg.Width
+	out.Height = cfg.Height
 	if out.Height > 0 {
 		out.AspectRatio = float64(out.Width) / float64(out.Height)
 	}
 
-	return out
+	out.Size = in.size
+	if counter != nil {
+		out.Size = counter.n
+	}
+
+	return out, err
 }
@@ -1,9 +1,12 @@
 package recon
 
 import (
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -30,8 +33,12 @@ func testParse(t *testing.T, url string, local string, parseImages bool, expecte
 		request:    req,
 		requestURL: req.URL,
 		response:   testResponse.Result(),
-		metaTags:   []metaTag{},
-		imgTags:    []imgTag{},
+		doc:        &Document{URL: req.URL},
+		extractors: []Extractor{metaExtractor{}},
+	}
+
+	if parseImages {
+		intRes.extractors = append(intRes.extractors, imageExtractor{parser: NewParser()})
 	}
 
 	err = intRes.tokenize()
@@ -40,15 +47,12 @@ func testParse(t *testing.T, url string, local string, parseImages bool, expecte
 		return
 	}
 
-	var imgs []Image
-	if parseImages {
-		imgs = NewParser().analyzeImages(intRes.requestURL, intRes.imgTags)
-	} else {
-		imgs = []Image{}
+	res, err := intRes.buildResult()
+	if err != nil {
+		t.Errorf("Error building result: %s", err)
+		return
 	}
 
-	res := intRes.buildResult(imgs)
-
 	assert.Equal(t, expected.Title, res.Title, "Titles should match")
 	assert.Equal(t, expected.Author, res.Author, "Authors should match")
 	assert.Equal(t, expected.Site, res.Site, "Sites should match")
@@ -79,8 +83,7 @@ func TestParseMalformedHtml(t *testing.T) {
 		request:        req,
 		requestURL:     req.URL,
 		response:       testResponse.Result(),
-		metaTags:       []metaTag{},
-		imgTags:        []imgTag{},
+		doc:            &Document{URL: req.URL},
 		tokenMaxBuffer: 5,
 	}
 
@@ -192,6 +195,7 @@ func TestBase64GifImage(t *testing.T) {
 					Height:      242,
 					AspectRatio: 500.0 / 242.0,
 					Preferred:   false,
+					Size:        495999,
 				},
 			},
 		},
@@ -258,3 +262,125 @@ func TestBase64GifFaultyImage(t *testing.T) {
 }
 
 var obnoxiouslyLongDataURL = `data:image/gif;base64,R0lGODlh9AHyAPf/AGfei43uSZHvBtL/SZlmRAWtSwACDwBxK5TZh9/6o1ijDke7TzK7abH4d19fXF5PB2MgJu7/SgBMKnTuS/7+/qaPUE7YIovVdI7uiimrMO7/LHTuLAEjDgSxMG/dLphURXbudK6vrS/NTEe6aHHvBKvbkiOZSk3bBKtwSyzQK5OMHi3VBAFxRQAwHJRTMgJLCdT/dAe0a+//CLLzkG3bBjDMbQNtBvH/b4ndTSSZMAfNK5YKFbnurtPV0TArAgbNSgS1B24uO7rdpapuMgrNBwSMBDBuATFLATaOBNiXq9LuwXWySTGyBbFxalDuTqpTSH5xAi4sK+RNY1PuAlLub4C4at0uSfn+wY7XAYjdLgbOdSMMAajKfm6PPlPvK7P62cpxii/uTqpVMkBGPY4vOn12ck+JMwCZaQEREn1PFd+vvzCZW4KMgNb/5z1wKb6NZ9IaOa3aACroaJnXmq5TbCzuLDT1ApBGWJHuttbYAQH1AJzBV+zQyEUFFQTuaVGZWqzdLc3Li5lmM6p2INNDP7OyBMz/AN3/AACZRDO7VQCIM8z/MzSqWGbdVcz/IgCIRN3/InfdeiKqVyKqRGbdRFXMVTW7P0TMVQCZM4jud1XdVd3/M8z/ETSqRBGZRGbdZlXdQLv/AHfdRFXMZkTMQETMZnfdVZl4N4juM7v/M3fdZmbdd6ruAIjuZlXMQFXdZrv/Irv/EaruIpnuM6ruMxGZM5nuIojuIpnud93/EQCZVbv/W7v/RBKHRKf/AACIIiC7QFXMeEPMervuACG7W6ruRKruW6ruEbvuMxGZVRKIM2bMZlXde7vuEbvuIplmIZnuXar/M7vuRKr/Iqr/RELdPqr/XLvuW5l3IQCZIGbMVYdmPqr/EUDdVYRmHoV4OQCIVXjLfGbMeRGZIUHdZoDMZmbMPxOIIBOFVpn/O3x9F0HdgX//Opn/XX3MVZn/GYH/XYX/epn/gYL/GX7GOczuAMzuEefuARHhVc3tPczuIu7dAObuJujdZgAAAP///yH+A0c0NAAh/wtORVRTQ0FQRTIuMAMBAAAAIf8LWE1QIERhdGFYTVA8P3hwYWNrZXQgYmVnaW49Iu+7vyIgaWQ9Ilc1TTBNcENlaGlIenJlU3pOVGN6a2M5ZCI/PiA8eDp4bXBtZXRhIHhtbG5zOng9ImFkb2JlOm5zOm1ldGEvIiB4OnhtcHRrPSJBZG9iZSBYTVAgQ29yZSA1LjAtYzA2MSA2NC4xNDA5NDksIDIwMTAvMTIvMDctMTA6NTc6MDEgICAgICAgICI+IDxyZGY6UkRGIHhtbG5zOnJkZj0iaHR0cDovL3d3dy53My5vcmcvMTk5OS8wMi8yMi1yZGYtc3ludGF4LW5zIyI+IDxyZGY6RGVzY3JpcHRpb24gcmRmOmFib3V0PSIiIHhtbG5zOnhtcD0iaHR0cDovL25zLmFkb2JlLmNvbS94YXAvMS4wLyIgeG1sbnM6eG1wTU09Imh0dHA6Ly9ucy5hZG9iZS5jb20veGFwLzEuMC9tbS8iIHhtbG5zOnN0UmVmPSJodHRwOi8vbnMuYWRvYmUuY29tL3hhcC8xLjAvc1R5cGUvUmVzb3VyY2VSZWYjIiB4bXA6Q3JlYXRvclRvb2w9IkFkb2JlIFBob3Rvc2hvcCBDUzUuMSBXaW5kb3dzIiB4bXBNTTpJbnN0YW5jZUlEPSJ4bXAuaWlkOjk1MkM2RkJENTdEQTExRTFBODI1Q0Y3RTg1RTE1NkE2IiB4bXBNTTpEb2N1bWVudElEPSJ4bXAuZGlkOjk1MkM2RkJFNTdEQTExRTFBODI1Q0Y3RTg1RTE1NkE2Ij4gPHhtcE1NOkRlcml2ZWRGcm9tIHN0UmVmOmluc3RhbmNlSUQ9InhtcC5paWQ6OTUyQzZGQkI1N0RBMTFFMUE4MjVDRjdFODVFMTU2QTYiIHN0UmVmOmRvY3VtZW50SUQ9InhtcC5kaWQ6OTUyQzZGQkM1N0RBMTFFMUE4MjVDRjdFODVFMTU2QTYiLz4gPC9yZGY6RGVzY3JpcHRpb24+IDwvcmRmOlJERj4gPC94OnhtcG1ldGE+IDw/eHBhY2tldCBlbmQ9InIiPz4B//79/Pv6+fj39vX08/Lx8O/u7ezr6uno5+bl5OPi4eDf3t3c29rZ2NfW1dTT0tHQz87NzMvKycjHxsXEw8LBwL++vby7urm4t7a1tLOysbCvrq2sq6qpqKempaSjoqGgn56dnJuamZiXlpWUk5KRkI+OjYyLiomIh4aFhIOCgYB/fn18e3p5eHd2dXRzcnFwb25tbGtqaWhnZmVkY2JhYF9eXVxbWllYV1ZVVFNSUVBPTk1MS0pJSEdGRURDQkFAPz49PDs6OTg3NjU0MzIxMC8uLSwrKikoJyYlJCMiISAfHh0cGxoZGBcWFRQTEhEQDw4NDAsKCQgHBgUEAwIBAAAh+QQFDQD/ACwAAAAA9AHyAAAI/wAR/bJhoKDBgwgTKlzIsKHDhxAjSoSIxoaiAwcsPkKkDBEiTx8fJWPxqKOyHL08/QI5yZOJWgU8eUInE9GjXjeV9VLUq+cjmSY8rTERdM2aZJ4mrWFkNBlSSUldJuuU6FLVBZWwLtiqbevWTj8KMMphYmmnBSOyjljQqZaJTn/Oyl3wJ9EfRozYLrC0gNHaEXI7Meok2MQkdEMl4V389+yfvmknBKB2bcA1XI0uMbhUqlRaJpdIZSXltTTpUa4qudJWSbU5V+YqaYNdyVxa2q1zu9qtOqvvrZJiTGLgV3BeRokSjUhOVdKCRCIWxPZ96VLu1suWfWok2ViAW6ZALf8gReqSpUult2YgPBYYI0uJtiaC3wmY4KHociSlmmFsp0mdGIaJTYgowpF+njyioIKKsNDggorINEkOE0KV4CMkKfKIhhv9IkELEzm0hQEjlmiQiSiSeKKKKbbI4oojJuSiiSEyFOOLW6DxywGK/OKjCQN+5JFMj2Dyk0y9BJVNL0p5okgBBXSgSAe1INKLkQVuuJOGikSICUgZuEShJzFNQpQJBaDp0ZqIJAMMMMlthZ4IoPE1DiYUQrmSMsp0MNheeynw3zidZDBJBpYQpmgiZ03CqCX0sWXJJIcWamZLJtCki6GSTEJMXnL9mUg1JLwjSzoTTHDJD5JUh1opfIX/dok21nm1VlfWtUbJKKyB0po5lMhWCSWujDJsJcsge11rqTF7yQiuKEcMMcq955dyVWEb5wLVvQaKdeDqWtsoo1yiybATzIJLA9AEIEol5VW1G7TznZVoB/GREh9f7ynKiAmSGGYco4wolkMGAHu00U+GgaSIMgqSlKFHSF0K8IQ/LaggJhoiYsMLHNTIkD8F3YgQyQWhbIDKJLcs8sswT1TRRRf98tOAMhGJyc7KeKIMJr1UaQJZyiiCSQHKDO3JgDbvXNJNB7DAAkhA6oLIhC0hvIYnjEwScKdVEpjUe3xZQtpe5lmSwThFIKoDR13+QtgCwKitqKKG2t1Jookg/7r3e5I8+l8OhSY6oVMm9ISOLi69RVhyhPc7nwXVeOBrIm8+25t8qbGmTSlZjYJVWmkt+5pq58Z2uivJLrPabhaA4solqdWelSt8cRtDIsgxwkAnDKAV33OdEGOJb+UtILqxrJlTija8tsYrt65M0Mo10hRDQyXVcXveeVXBNxgTcUI6H6OC3RfgfIk+3omFMnGYc007bfggOiV9lGanAU9Si5MY4lCXSPILkKmoZAcUUQJdtBAGKiRFB7kRBGG0IgROMGYmI5GOunSAHe0sKDVR2EdM0DOgTKIDSzMBCzyin5d0AGID6shGjESSjSCiA4johCc6QaEedqoTBbBYCP//hJbzVKc6iNpLDphgDlAAoxY94lEtcicXtalNUMBQgCsycDaqwAk+zGFL1/7Tt0l9LSjgCArjvAYptsBlK5VQTg2s8iz0hCZZqtFXazRhjkZIbxmduUQwRnGu7LwieqwJ1rmC5atk8eoVlTiXHzXhCtm1hjXwsgpaGKAcvjBgBNdKzrOWw72soCc5lTBWakihiVEEKzW+Qk9rQIEDa1xjF8ZAhQV26QEamMJydcsL+7Iip/hYKxFm2dteCkUwvAjIJp7Qz/9AojGJESiHXuvUGgJmgpBYSUhdwsSH0JBBhaCsnCmL2crUyc6FqOxlKJvZAXrRwY0ooxYm2VCBuqT/jJUgIigcewSPDNSRL2moZzwpUpE42CUTGKiHGaBQLSYxlWRMKJprwoRi5gMos1miFAvIABcVYAkF0AAYGSiCGzBygIMxgQl2O1gOeMeEcbCtCEUYBxO2mAFg7OVN7esUgPpjpmQEbA1JOhLj5qao3FnCFZTAgVUS4YolkKZZriBNbsyRLNaQqzrcI5exRrGMc6lmNr9aTSUgmRttLMMc55qNJZmlmjo+hxjPeY5dQMk7Bmy0FPEhnR1HwDysXKJcvUkLt7TiylkU4xrGwAEOZnGLCWRBE5YgxiSAETjwPcd8vMvAXZjCCAUwCn1U8ZpRt3kll3yESDhhUA2tFE1G/yTFav/EhNUU5DOfNchjLQARO2eEIwqisyE0OueLRKbcC0oERWjoICZ+YZOdGclIsn1QlwzkFihixAY38UgBarEhkCRoZwVyKCZquDRMBeWfUWkJmzxCKVGOZzzn2UsGUnAJC5CCBkyIqDJYeg5lEMaKCggwKcbxsRagwR9oeMELbFAESFnYwphTwGDudjdJdKInQSGKm/i1Fty5IgvQmIApzBaa3Yimdq9JTSO0kcja4EY2tNHEMvzoq9hoQhuLpHElQFFWbRBLdo0YRWyWIWTQcY80xCFNfIiDysFIgpP6op0dSzGKUgQjN10OK5e5RTrPPM8UosBBAHjhnWpUAv8+C2AAtepWNuXkNX1d48tylmOJ3nXiao6ShH4QFlH9CCm2RrIaSy4GEkTo4tG6UGikF4QICUgADe3MtKY33U4ID7RLOYgQQzUktag5yEEGghtLD7AxoxkIE1B0kkeCghKOFCjUnkiGCTaCjgLVZCORjtAkILUWUpTN2HvZTTVixwQqIYKlFyFLoUSqNibYoAX+yPYLjPBSBYwDCFXsBCn2hqj1GIeHHt7wUHR9JhNkQMNrOasocAGNRriYe1ndzW5eQwltvEIbSZbdu2bTuei9whyq0EaMa+PWSArLxw4f5LJw/LlSriU5fKGW7xKRldPuLRFazdUoRsBlcnEPdOX/quMI6IgW0sGSBq0gVn/gk9nhXaJ4nQVMchiwTeTMJy+Gak7XCpapFkZFYQuD5ms/koMxJeMRukgG42zSMS49O7jkVCdxJ+jch9DIuFovLjqdiyIO/CJuoTZaB7Jhs31GrUHb1ad3MRLODdnQvAP60lsQkQN0JCMHSj8vOKpegCCdd4d786ixzTMJ0FTCA0xIAe4KAIxsnKODSSOcGd5dUia8INtoaEERmECaJXw0PsmJDzCIsTeSOsqZTO3Lv3QYoD+TdAmL1YYp6O0B2ZDCv/muXbD6PeN+f2LIleDxsIw8Cg/ofhkJB4WRdQ9wQi4fep/QxPEpyatluDL5Ewe5//gXEDhGyFJ4rQkNrdgqVnJ9YhSvOCzoaqXyOo7ZKk+lQvz9snqMLz45cFIaVHEW2DJVjvI+jEAMnYIIUAEVhzIJa7JoBYAIE+gROOQSOGQTUQdNHUMgymBpocdpmvZO6sQyJWgQJuhOE9YjodZBHfCCZ8cjq7ZqRqMgGSEB28UhgRchiFALmHAmvUAg8sMgAeRqThJOQSJS9rEX48Y3C+AKClALSGAJwCACwNABNtAL59ALymAGTYcEC8AERcABoNcCiIJScAYfj3Fhb0Jug/GGaxB7lJIDkrAGWJMBgCIdohAAWWAKsZEaT/iEpMAbaiUK0zdJx2IOfSQs/UZjjv8IZMmnDcfnOc0yCqzkcKnEPGS1SuYQiJVAFahXMAxgCfFGZoDlZLNyWONROmG2ctWxctyyLVeBHnxmCaDQCKDQNRmgWcOGbJYwisMDPJ0wAnwRH6CIWsQBFYhDJgCzgBIyJoiAMzGRIFnyJUkXMTaBPxhhacLldQjxdRIkdhX0jQmEQBG0QArkQA1UjhDxdQbxAj3CMYqQDT/wghzDUjtCd6d2ERjCAp+2XXwnE+N1TdGEEu91jVSHalgyIEbjgz1oApBCGnZEVd2zRRSSAqSQAgWABElyDgGyNgqgACBDhhVhNgUAGuPxUkhgAUxgAQuAe8vRF322GIKxFWVjCZ3/ghydMBTxUQkjYA7zhgOioG/mMIgL0Dw2xhqmAHAApwkz9gpQBXCVsJT9ZgrDQgnEQgnLQHxOCXDLMJSKNH3QE31AZmRqhR33JUvEAErPAVav2GWd0RrM0BoigB2QGGap1D0jtxzmgR4g9SzzkXxONBhxsnJmczYjkJh5YZOusAAgpS+J4CiTYlEAYxiKIQmTEiBeMwldUwu1YFGUYl4JAhLg8BFVolAaeAASwAISIIKu+ZqweTIWMRAD0gFRcnY2oJo0yCMOwpus+XZnt129ZTQ9CGuKYALg4AlJ4hFAoyAQw5sbspCm2TGegIe5ExqjIRolRThAAAqkAAw80TOE/3MWOYBtW5BtNuAKTOBTpGADNsABDsYB8tkCFMYEQGBFhJFMRbQv/xE4/UEhw7gEriAK0JBiT7ga4zGIpOA5OGaIxDd9wWIBwMJwppAda8Uro+Ar/zZjTMZjUGUONFYODteIzEdJmOR7oUNI6CFMa3FE0JJKy4JHh2Usy4IepEByjol6n5QIDPCX8aEAhIRZkGIeLyYaoSEfgAFH9+UKcMKjeOESJgQwOwQghgEwqUUpWOoJjOMJVZJqU0d1CvIR26iaEZFB4Ah2Z2oAaIBp5kiO5xiOyCWOcaqOD8SOaCABmNBBN8R2uRk1MogR/qhd9uOPDlIksbUhQAJNWzokiv+QDF8CTqSmXRzTgyAxgTtDKWjDLa3xYvDiCoeykqrgBWHSC003CSSFBD6QbehpAR5VBJ+nqrCqqhH2AkiQAUwQGIv5HI3JFoDxO0xxoKAADbhQDPRgAcOCGk/1K/1GLKJACYZoCpRgCpogrZQwoaJAD6rQCJ+gHe7QShU6Y6uwY42gCjume1hZrp9gZJrQb6qAlUbGiDZGCdxBSReHFs/SmKihla+wDOEKSUlGLkkmPc9zCcuQFldRCsGDLXHkl9BCCp/ADPpnDpaQAlTVG9yzFnHGlwsQHcJQHuexHO8jFJX5L4xQAGREKcO4NwDSKEMSQjaEFGAqdYygmhLwAuP/xKaxCREkmLMyIoI6cgDnMCWKYAMSQLQz+KcyeBEzGJz6JGo7gQi9ZhMacwBFYyRwl7QDlQ3cJSWYkA1891J7ASmmdF/6YgmjdwkT8J1Bcw7jgAQ1hW2qegRQuGA2EKt2G6sc8AJFUKt52FHzoVlutAb9sRuzUKCUYAGw8YRPdaCYtBpNNCzAYpYz5iu+wkd+hIjB4khDJpUH1whNBCx91HxGBgrbMQGa4CvXIX1O+S5vhrHI4ZijIzp7BKPLMCvKch3L4JiwqFfG1JPK8XHz8VavAABUsA6VMAl/QIqjY0fCJALh8xeJ+XOM4GjJUABnYL1QkSYKUAAexmHF8x+z/6ZDwzkgPmg/j0AWRWtp3IhpOPuaY4eObRq/7DgRx6VpI+IDNpNqQ5sRGPEL+4iPrLYh0GY0HWE0SxNNNVF4MqELQaggWwJ3vQl31HlDtYBD/yFS6NGYuYIVs6O4e9Gu1RAgSbJE42Ce54kGCqCI13a3LKyqHIBTpGdsxtaYImAJNcx6biRuHmAq6YADHlBJsVEeg8g95iCh1RosuLisoJt9flStHlBW5doIyzCXrZQdpvAK6UoJn7AdyyqJ2ievJJAO15AOvuIKHoCVaGwKtKNYtMgttksuNJauxsJWskGjxiIOyMJlySMCm6EcioXDw6MJguwKmgAAIGC8VEGKF/+rDYm5FSs3i96zAGvpNRRoVJwpFiibmQcIGDj5hsMGvlhDKSYwvQkyFJOgmkSbvvLJs6zcyhLRAmdXJFqrCBKgmv5Id6uGg39ayx30Cz2zM1zqg07iMyT0TzhRNHaXtBHcJWEajS/YCR2QKHzRmHM7HqmxuN+ZAqCAWZIAMUs0hrCKBkWQwq/awuYMYXq7U/dVbF/RPmtgAViQDsfADlkwlLwxiE/lCsAHG7RBLI3grl0sO1q5rMiSoZg0iaNwuZC7xf0mCv9crpFAAs5wDdNAA5cADLuxAZTgAaLQe1YxGmghH/DiVWuFLObwCoNEsJnYGoOEGiPnk1bxF1PGV6//e2RXMQoQyww3BylZ9WalsAR7hrHWXDyOkhxBxICSEDAFoGue0L14qJMhZR+caSmVaRj/wk1QOhi1nL402wJ90L6Z1nXLBb/jKL8WlI4h4o42YgAv4MvUZTRjOsA0A20xSHfhtDQT2HTT+AgHGWkXIjENIjXM3Jsc8yUdUF/TrBXUzC0K6pKqYQlMUA2akAI5MBZIgATlfJ4vsBrlfM7Zdp7hnCMTVgQWwBpHuRsh/TukgAPpMAvpkAU/bLGvERr+hbiyI9B9pAnNKgqg0KzV6tDGV1aj8AlYLInDvVZbqcXOqtsbzdGi8EvpggobkLbcU0egkCoTkH5j26KuKD3D/60d02csvkJW5JIs7xcbhyS7jbwcVTEKgawJryAaxIAe5LAKVKB/I3CjtFJKsUgKpUAaZ5McgaMcSZ3Um3VCH2HJXrEvoGABHmBsKsuZPwQgilEAiSBUM7vV6luza+rKEpGC9evhEAGP+Zun/MvLuJyPW81SvByoDJk4HtETEKOc9ROpUcPLEOy//MSDHwEn5qHBiptHX3mgW6TNTgDZOVAEKxyrbgu3nh2rEWYDSKBTOOWeeguIyFYtygMCnzABlFANorFTgIg7dDI7/qUJlyA7NDZjokADhmiIP+a5omAOdjxWtBuJcV6WDe0OaNbmE+ABmmBYuEMes3K4byaRIf+tFlcBZrwyl3ikVt4How9ncsEQPIopSvNROrOjL3l1PCLwCuvADPFCTOfBAJy+zr2THPrCABlQAIYyCRX4EZgAIIyyF7vE29XQAYIhCf3xpAA6yuTWKf64misOgh2+1ueY7HL6XGj9Mu9r1vMLv/6AgzuyXd+1ajrup0prAyyQm6vpIOdAqQzCIRoTTYdaagO8jwYCJEvzC6N8VfnFOtpgCUDwbTrVkpDSkiuQAjjlqvL5YP7AAUdQBAD/5KpKnwrgX4eVAifweycghqkhsS4JKImwHZ9gLvpMHslDGiJACqAAClbpnXwECsDirKJw8iff5xutxZFkcig9x68ACs7/upXuoApoBn2qkHBoZgr/3Aj29hqnwyyUQAqdSIvHo1j/jRqs4X2ThKHJkmTe935+9H6pVLueEUf5bQ7BsBU0GouO7BXP8grMAALdIwxPOALOa0dPGDzBs1hWYWWEISQQSCkZJx2a4AGWECWcKUbvASBDxRYACCAsTrM2W7Mifvge7g8tsL9He+29mY8zaLQHsF5aixIQjCE9sROjecwC9ZvfPteKUCUgsTNTZDaK25hi2NkB3wIS9jGubwNHwAEPtgU+cARGYPCgnW2i15It+XstWcT79hpWlR6d0EefIMS0k1Uy/D3GOvT+9S247dDN+ksOXaFLeS6jEAzJIg7t/2dkHrCUPv/cpjD+48+U028O6cpkxkoPRtobWG4JpucbpXMdVdzyNAZJ2ZEskmR9saEN8cIVAOHq0oJKIy4BW5CQEYNEIxItMJio0joq2lxZumSQkUMGGBdYYpSQFMSEnYhJYmSiFiJPHTxhmiSJGKNEwDRZ6vQIU7IFnRY8nGTpYadOIRXglDTpwNIDEg7YaMpCQgs0HNAYMLAFa9atXb1+BRsWq9avWsmCNctV7NqtZ7u6Zfv1hY1fNlgc+MU0ryK+fPEeYMFC0V2mjx71UoYI3CPBBxQZfgzO0yMzjxAZBpx58CO/fBEhwoSoQK2iHytZclWpyAsO/ly/ho0G9v9sNEdm38btD40NYK5SrLCgILgCJkSYnLBgTvkncwsuWRqB+lOjSyJEVHIFilQlUs9TiOjeiJQrV5RIaapEyQOlRqZEuTdFyZR7SqK0UdKmaVSjSq8qfdKmEUpamU49Sg507z1RPlGlERw8MCXA/O4zx6JP/isvtQVGGuiSS8xxRZtRKiFxFPzwE/GTUdCjpBL+KNGkkQDNoWQUbUosxcMFREikxxEYGCihETYyaAFtDPoEA/90/Cm6EZxbgAEgnStygUkS6WQS0TyRBJEOJintpxQS8cSTMzqx5KePPhJKzaGICYopqCSwQQI766Tqqrj25LNPP/8ElM8W6pJzqbv/AluqL0MHU4QpRg8wTBnBJu3lsMMUUUYZwxg71NBHHGvssVpCy8ESoTBSYAFXmHghN1dfhXWL1zhggh5SmAACiSJs4LXXXo04ZxwmzHFnQyZS0+QSUiwhpbvURLjkO0vAq248VxRolkVtTDEHvvneW+++CflbppJytdmWkk800aQ8BrUBUMZGGBRFlPYqASW/StDTpsJKLMJupEQUwPJJ7gRKDURNJOTvxoXzfSVAV/izD7tKLikooUSSisnHEf5IZCOaGkpowxoiMaWSn0KC6CGMEsmow596zKCTz5KRZCVEJOnkIQZGGHINE7LEyZKjevap55CKKoqRvwhzyqm7/6aqKlA/+7A6a62tfuEuqBydlNG+Gt3MUcc4U6QWvh7RtFJ0NN007k8Jc8yxx/qyrANMJEmEFAsm0A41G2AlvHDXZJXNBnpcGQeJF/LkoDU0ZNMt8hZemKuIi5bRRhRNQGE2Be4uVrY667ohz2/yLAAFlBMTrPe9BO9TrxFV4B3lk/IC1MQUD9hlz5RlIoZRXfdwEEWV+MwRsBFzFu5XExrJUxa6hojxUFmBQNmXeXiXaWRF9uC9D/wY9VUZ4yCxLAAYmdxs+ckmW3bulQY/KvISiQzKsZSCOkyEJTIgiWSMpgChmQQwaEKQBTBiEmtKk6mYoCafuExNIUnUUuwStf+m2KAFVNlaCEWoNbgEqgVPecpdHlW2T5Gtbk1hSqgEY5m7GcYTilnbI37xGBhmJoObsQwmyhTACWTBAp1IQREMt8TCtUABRWgBrDhghCIwgQk0sIAFcAWES6hiBiDwgAiAYQkLjKc70drRtMZziTKOJ1+goBGNZIc85MluQgdSEb/SIy7xoGs+6FkYfuYzyEY0Yj318YAHvCeKZbyxWQnJQI8CaDGCuKJd/MGkuOQjLxrxsRIgGsUENwSSDPyEGGkimUOe1KMmNa1H/2mE/GJ2iVEQBGMjWNZDsNQJE0jCE5NoCZeI0omLJKIAARwJBHuyph4tLWQhs1teYCgBqTD/ZSocaIEBruIWPZFQLGcpoQGwppWrlJMt4YQLOifHlW6OBSwcoIujxvYYwdCzL6Gq2w490wuWIGJtn7nMYxDxC84w5oeauRtoDliATnQDFBa4kiUGx0SK4mYLRmiNayg3qyPwClcnOAENQEoDD4hiAgJoAAwa0AjwUO+MpoPWdkhRjUuAIjuuo4coEJkFUfAUFaqgY3w0MR37NGIZn/jEfGTknnbhoEGwxE8jZDfITzxIFCXFahYokS9FWqRD04LZhzBmyfKtxwOfWI9OdZrWQrYVPY2g3iiUJQmZaaN6+sNrQxrCsiGNoBP7icQySAEz5+RoXxejCdCGYiUHhswS/2sgSsmE0jeCjKASrPzJZZvkMgb4RBG/OMdS7MRBOtnpce3sCmpHaDVzllO1ITxLa7fSTT1xAIWZ+ZSnWniAbEyKKWBrVKZ6MU/ODFdsm6HbbxEKGl1gIgee6NsJ3JeIbEy0otc93KsmZ4OOYg5z8LRBEapIAxgoIQENmAITBuKK7gCJGMRIhAic4zf62pQSEzOHKHKa057G7qr1iqq98CWfcW3LkvMJUH8OC59uwee//q3PgZj3yTQlpE3kuRF2uEciRYqCRu2JsCjcYYoRT8giqTkYMFKAkRGUYgRyLYhABgI0hzTwY4xYA45p+YlgfIQUT7JEQUohyWUNhBGdyP9ADhhxZAfmwEl6LVnJemQJhFQQfiFhxC8ypVw5zWkqeepTOMMSzm16JZ1imRwHtKkndG4Fa3G5CtbWqU06o7YFX/uFPslmmV/4Mxs0/Gyj8uLPQMsNbY+JWw15SLexTQYRK7lhAonJ3qDsCruXho2sCEe5yV3uCJgDAg0E0ArQgeIS7K0WeERgalNb4BJvtYAh7VOvLHhgAqLAAQ54ijxVqEcUG2ieUi05r0bW53sqipftpHofUyDv1lfdQE+l2tP8otgVDsHIJTyAr915YEIeaAQcj+cBHCg1QVJdxrZF8clLmAda/iGRsi6mjRwtQBgjkO8lRMYALL9YFeWynof/VDZYETDLQ0FeWQMTAaaHKIQUDIDIeqcMPys3JE29yEEORDvNpoz2ch+c7WrdKXKSo1lPrlXtbmI4Np3YDTT+TC41FeHPgmpKy5dajDIwhba5+RAw9fREpbbkiQyAicoLyEDRgZBRTDd9iRtFwwc/OBcmUCIF2sGYJpplCa2TQ6auJo8HLEAJGtDAHfXCAQ3oSGueuoenohiFfdCTL00c6D770cYrlrGMVye4EeczRRYSBCEP1LrWifQwDVzRnGQuoEKKpES35HOg+uRXQfHZdUkNyTzl3EcTHmDvGjMiXwyTKE1FYqVQFiKJBazhgREbrCugNFiHXORiF5kx05Bs/2FJVsInk4VIDZ4UkgwseZlL7gQSzKDzzBwqalKRwAu+jIYtTM761zcna9dM2zq3BSzZzwr1RZiW6l8fK9hvJzzzspeEcoYFlwk62pSLCSEKETT0rwWkJzNzRRjX/T1XIUVIBvvzhF4KCqHIAEsAppZAAqdzwMIxgEw7giMwAgrkFRqwOocqpLRSj/PIDktKD/XAKnDrqSzAgQA4wSxAhSxoBVFABRyYgE0SHqMyhVeYD/z4hAPZj0qwkRzkj6TyNuWJj1vjqQ0ovCy4BWq7L+44MQuol/h4wk+INpKKHVO4NVNwkJ7yACzQhKvyAFBwHgvgO4LYl4PrjhMhj39JDf/CGhIbWzJJgA4VwbpKsjbsQA9T4REsaaCEuIROAAaYabifuIRSqAGII5mQqTGFGAFPGAdl2LioGS3TepwPUrPWmhzd6D6U8z4+4b6SAxTZ6kTzmy1e+S1G0TNIUQZ0aL6vEQxPqAVPeIzEmDkTmAQmmIT/04nOQJRqUgRXfC5MqIWiS4QELLqV0AlPYIIHVMbr2oLuUgBVUICtah4YIQVqaRbV2TZKoIeSUiu2w4EV5KksQEITpLwIkRH84MFAGpFKCIZRKBdAwpdzQZDCuyqSSqSSIika0AYLuJaLSY7YwaoD2baSarsnrI8LgBB3+IS/eQ/yUKOCkxHsODVT45z/EjEX2dOYEUCJhXhDYvirLnSFUrAli0mN5+gRkCm+niGso/MJgkgEYgCGGpCkBjIaLAmZogCT59Kh5nvEOom+x3mBOTMAq0C/olyn2KKzbEIt2ZqtMosztUCLoWzKToTKt5jK86OtqKsK69sKp/iaRMEbvHgEtQlAReGMl7ghTFCGZDABYACPyTgbmuMLFtitRkGEUnKgX4ooKrMEE8AEgcoAVlnGwXyNS5SNjYINDniBIkgBYzgBTeiGaiAFU2sW0nGFakgP11kPGhDHWpuAFISGXMOBW1DBFESeQeKddgGFo+qPUfAP/zCqEzmsuksrUyBNXRNHLODMq9IGxQOR/36pj3JDngBAKxqBEJOqF1k7TfrYgA2YgPMYLOhEl3PUFwsIEAtADxLRzouBCGLYQ5EZAWLInQnolw/EDm2wBPgaiXyrCY+osRGQvSAhBn5Tk49gmeMjCkZ4LkVwCmuKxEj8uFDESvSjswG1xLUQswLFyu47vzUDFLgQyu0r0K3MPn+gkx5qFC6bub90IQ6duUfwyxzQshwwgQ7ohg5wxEZhG0VhlMAQDEQAhqLwhBzAhMhaAPf5y+EqgElIgVYhzB+NjdzYDSbAge/QgYJrljLCDvYSiPsCBc6khFp7EF37TNEURxyYhSxAQVR4j3eRkbdykVdTsB+ckGSRHrUSEP9zCMdaU7vCS1MQMYdPMgeCNAUBEBALELtEqqOSYg+TkqpGEAASmIJrZC90yRcAwQ+bUhb0eMd/IZHUWKWQWbIlSwSQsYRSWAZueQUyNIcRUKCNSSU1CTKMQKWH0B9i6KWi+IM9dBOiMIEjywEzyFDnqyZIlEStvL5sqkQKxVXrs4ryI1AHbVCuzMpdJcqjNEoG3Qo1A9Y5s0SjhFZteoFSbAxDwQsWEKLmGgzQUJta0AmdGK4cmIRSAYYC6Ixs2D/3Q7R6Yrgs8YSe6ZAMcDQhkgSEsAFNA9J8pQ1/AK8VWIUjFYEa8JtTOyzteChKsIBwRM4imgUcKAYcgIYssAX/LD1BLEUFLjWFLjyqedmWGNGECSgpeBmfTwAFLKQEoMK19kBO0jQ8LfTCxSsPFWRBnmqEW1sP95iAQgLDv5kA0kQFARCAFMgi16G8kwXD3gGFdvmXc2QXE0kY8+gQ+UlEh+DB2yEP/lCZUvCIi+EQ9SEdVOJDX8oxpmnDCIosmsgAnXu+//zPjztWaDU/uMU+A41QtohbutUmTCTWTFRQr5BbrmxQrCDFr2mUanIUmPgMvviFUcEEffKnlVCJSZiEDJgnT9AUF8UES4HFX8KJDFgDSYikNMkAv/QnE8CZDLiEIrANfdUufBVSH4BdH3gACEiDB0iDNICC232AB/CB/yOgiiKIhFFgFvZyNe0kVBqhAS/UwrQzwdBcwQAYR1TAglmAEAnRhGWIqs6xQgG4hekYFxcp2f/CgfyKMKxKXs6kBxqYHoEohc7JtRIkO4HE0+lphGooo9ZpTgEoo2MhBW/bJOYhK0finrk7LHRZBleAMZLgtx7pCH4LEbiyCAt41PRhlgoTxAUQyefgQ0v4DF4yAVq8EpcZRibrBFfcRcAgregbre96W+xr4bltYaKsM6F0rdRCAzmjPl6dW660CqsYVlHkW5RzYV/VymGNp9/Khrq5ix26S3MtqEfQEp2jvwFEBBKtBZzIXIECjbnkjFREhDV4V1NpTzBJIy15Of9E6AS+SQRzsAEfmA2mY11/QAKooz7etV1v+IZT+AYUOIUK6OM/9mMUQAE/3gZv8AEOyIBISI7I+0Kbapfx2KqrugVu7EwtxdIsaFgUnFhw1DwstJdPeI+a3YApmJfz0SkBUQVy+zX26NP/4sZbOBA8dR0Ui1JUIE0P2ACeckIaEJCt8jZWm0w4spbUWI8saAQjnLW687wTqbv+wN7/WM3D0h8XuwSIi59EWAIGUcMRQZeL4dq+0aUnkZlaYoQb4hJEKCDgW6ZJMD4TkJT+5LhIlL4A/VsX3r5kXTN/8Fvw21t+vme6JcpdvQoZblBymuGTM8pdFVy/AMsM7U++eAn/mrOMc/BLtcEEcKA/EyBRT7CSv4S/uQQHusQ4dGBLo/kILGkToaiFArCMmEATy1KNKIpjIZ3jyTmCB1AHAhBkFCCAnj6FD8AGQSCAbSAAQfgGohYEQSDqni4DQfAHJlCFWEvUD1SW5EgrrJo1SkCeFbQFmeXSFxSAbWmPkjKF21EPVSAdErEPSnAHWbO8W+BlCXEPyLsqu2uEExAFGtgwNcUC5tXSWhs7CXtSRdKOMlodV+BHbxMQsvbk2OmcpNIksmoRdLmRGxkFDTkNIdEYc7mQ0ckPd7QR6MA2LJEvVuqRSUgGLpkEE/jiB0KahSsKnMgBR+yUWp2mFWYNCs2T/17929324Xre2+o7C6Wc4WNt1haQAJSTYXJap7cNvzTTYexTShtgUUjxCxXqs170BF2AjL+UVUzRBZbABI0G4SquBS2Bxb/0SxMowKFBE1RyiGYZxi7xhGNiFlIYhUTQBCSwLtdoAU1DzNfYAjhuOg5QAChQhz4mABUQhFMQg1NocAI4BQnvaQlv8AgvaghvcBXwBxzQhkOyJHN4qNbZKuQxPPXoQtucXlRo2IYlzVm4BSMUPJzFwm2pBHG4hHfsHflQkHIjTVPIwU3yQlAoZsrzgORFl1FwjwDAglub2FtWj+QgclBYK712Bb1+qDytWVNAhflQnqn60/jwwQAREP942U4eTA8o8RBVSoT70J0bwQ93SI94U9qZQbqTmAkGoEWUMF1dYOclYBnH6hkTyK14vm07uZzTgtvdBu6ilNB1anQC5WdGT1aqzMpmvVus+KGx+UoJYBtP+AX2VgTqPge+yIG06UVgrAX2Vgn2Xm0TAAeaQ4RWJ1G+qSBkiiAFLJMju0ZmKQcgGAfERAM3zg3fHUw0UIBBwAYX+IYH34ZvEOptEIQPIIAP+ABBCOqkHmpoPwVv6GlvGIQHsIEZqIRtk8xRYB1zyCIBSV4Psw8oFIWuztIAEAXonXccIAGt1tKTvbtRGAVHBsF28Z1uBHIPKAcgF7FD6s2xe56Sep7/f3eFEiy8b+QpDyABDJye9fCC+ngov4kjXwvHwVOriO+cMs/ehQGQOAUR/1GZ+BTtKUuEUXCHE0uNmieRE7MsjGAElJAESWCAnj8DPV+DZCD6NumZn3jXTsg4nkyujUPueY6cTuNVqqD6raSKHiZi6K76Y7UKSodbrL/65y7Krs/6oYRWsr/nsc8KCXBcvcnQ+cMLRcgBtDEBuWdtgtJujR5XjS7hybAhy6DFAmTnlvwIjIwgYMiA6OCQ7jCHE9gNH9UoYr+NT7sNDuhdya8oDlCBU9DjCc/wC6/wCk9qbMCGahcDpNbjb1AANLDC+5hMSyJyPN2pBLmFLsQ1is/S/ywthgB42Angcizo6vlAhU94R0vanYVJ5QfRqmZbkKuaD2MWBdeRfZs6pKI9wuEEBFTAZQMpWi+wNZMiKVdYj3xfwcDrcger60Kqj16+Khn5nRahhEuA83XkDpmxsU4gBW044N8kD4IYkUQACFeXKi24tGAEoxiSiClMxmhNsjWMGCWq2GlEpz+dFuR4pOgAyJAiJZCU8MLGixZoOKBRqRINzJYxZ9KsafOmgZsxDbCEmTPnTp9ogPbUafRo0Be/Piry1OEXC5EhFSn7qOxAL2XKenm6egATJk85TOSYpKzWI0+PPCJ6ZALTJE8m5DJasHEBXryuElmi2MnVAlJ8Sf+BauEPjQI0/hb7Y8mYsQ8bj/21MHKyxZEjkzdzZsxBRZlvBAhs2yboNIEPqQkIOnUKBbYKp5q4frbaRYUHRVqB0gbKHCVXlDx4oCTKQxbkxik1EuVcQJYA0bPMmg5NFA4ctkyJooFKlKZKwYP3flXJlDbnWYAv92DKg3Pn5mgIN+fBAiXxmt57AC5KAA0eYIFKFhNQQkIW5O13oAcbiJJFd6SYAwqE2U1gShbNOUeJKRo2MhyH2lDimzmfiKiNJto0UskopVTiSiWkLJDIjAtMlMEoMFZSySXauGIOjKMQFOMoI1wyAgOSFCCJJLowKckakiBCUSJ1dWLJRgrkYAb/C1F9FBVIvxxgA0knSWBDCyy5FNOaKqm5EpxvrqmmAS/ZNKdORSH1Jp9CxVRUn22yOSgaEijiEQsZTJLNAYo4OlVTIS2lFSZVNRqWJ52UlUMtOSCCiS6KICKqCYiYMYkkJpjA14yX0GjJJbBaUhEprtiagiszvMDYEUYcdtgWHEyGhq+MXdYZssk2poBoghAgxminOCsIbad4A0UaaTywLRTeyBatIM8cYQxxoPDXyHvHcbeBe9x90oooppiCCg7SzRLALDjcK10xFbILSiWNaDKiB5WkiG5yqDBHiXHc3VKgex7cAp8FxxU3cIfsegAKJVlAd4sAxQiAygQKawLK/wQQPyzdLce5QhwqWDwMoSrOeWDgcQZmyJ0o6DLss4kmajLKitrEOEIllpiTCJIGVSKiwSeuWPSOO7aYSJJJxhBD1kteRGNBNVaSwQFgSnDA2WfbcMALJpmEkpt+FuUmUEjBpOdPBugNp919+62nUTzBGXdM/pz9ESa1kL22o4qc3eiYNnwkppjK/FJVLXKJJVYOOWAy6qi1iOoJlJMg0skkWC5gyeqkWCIjMJ3USp8FKfBQxGKK+YOEsLn3zuuujSGBZprKGj8ss9+otjwBT6iwrQ8+9CE9BNNvsQUEKjj7wROCvHDchMARR8NxWaRHySfmiGLccehilwWB0klni/++A3qAAw3LVLKMKykC+fTBMKQi59CjO/iDkCg4FiCGEQdEjaCBKYxjAXOIKEA0kAUrbhEgC2wMP/CxV72yQAIaUOIENKABCR6UBVVwCDvx6tiG0rU+9TXHHI0AjisABgodVcJIltDGQSpSlxH4yFZDM5rRhATEUgxkVgxgwNYKkBBGLGkSE0nElfIyo7Gw4Etl+6KY0EamMxGPb0BJk6BcwgHCxaklPZmbn4YiR8ENZQtHAVxNAPUnopiRJXBEo0wIJwExKeJzmFDEUhrHlI+srVFVYYHlevGIT32qU5Po3CTm4glweAIRajGBJCaxhjWYIIt4YZ0l9lIJVWiDFCD/aEUrLDADDyhmC55Bgu7Q4APdHQYKi7GBAtShAgWoQB3qMIIRNHM8ZbXAmMqL1inUET0f7AACO+jDNatXPQ74oALbIMAzKvCC5DTQFBsoECW+U5wHNqIREEKFKQJQLxwQKH5ZoN8sZmELAVRHAJVI346GNrSGOSeCjdgAFnDQoCw8rGcMm0AD40MJiJpCZsQRDw0EIIBQZBAUNLDACdlFAg8EgJ9ZOAb8GEocD6CCBudMGQ5E8Z0JOKcRqrjZ/VxYUO6ISBOiEJE5hvYKGBFxIGFD0ghGsCJNwMgVRRMSi0oxioEwoAYMYAiTkkSMJ1KEdVrEi6p6ERIWPO4XaztT/9vOlpI23o0DfpTJW+9mxpn0pG5524nefuK3mtTNbnjME992YrilfEUZmGiUqBq1lMeFSRG9OMdSevGLRyiDLJ6AS6fGoipE9AIsTTHBJDoQF9StrnWBwcsyfhEOJWAABCjDxa54+UvJ+I4xRhAWElRgmydIiwCnKGYw1XGE4C1zMxywgTAFUQEoPMAHELBmH7TZh+lWrw+69MYpuHeKI9AjACsVBRawIAqaxvSGDHOOg5JzoZi+837TqY4t7pmcS4TnFZcQUnh6Zr5GpAc7yIHPg0jYwOLcxwMn8AANkpPACc7HFqyIRTOmcAJNnAAUoCDBchBanXzZAhC2CC8lSP/YMnbFdBbqiWCCNCqA7EQMB+o7kUODWomhjkIbOSKIYC7xtQUYrKkAE2rVpjrVGmAtBsQ4AxShSIyJUAkvScVLJr0SFS81Km1kilwLsgyTKLCpJ1yWUyDDbKcxx0mvcB0US+x4EzDb6a1ubiOe5Aq4lwDuBWApAFh+sZQOeOIjo6LcAZZySESUhSuIWEMOOuGJNWCClIlmhCc6KWlPhJYRouxERUaQCMAABhhzSMkRkMADHkwAF0WQ7WOMYBg0BGsxx31Bbr8xhA80gQDfcNY2sPENFfDaCDY4Am2L+5gjQOF5z60msrGp7GpO17oPOMWsK+ADD+DCCxLDwTkfRi//VHjgE+gzBU0DwL6UqaIRE2iEQr8jT1uw28HgIZo5aBy14hDM3AVyoQDgM5wGecALJzywBSbmgUYYuBokoAYrWIGKDZAQFMTZAAkYnjKQsbsYs7C4iSPoHOlAtGUToGcWNvAJVEgjFCRoWQBiyLCVf2hElAiqiJahv2WMwBVGOsgCtLGMcjwNRSrSn8FGIaRgjEAYT3wi14iBEAZ01SB40cbrLmk2tIHEbCS58gteIJOZ2OkmXd8r2MMudrC/xACD/JQkxoEJGyQOEV9Zy6POcYCrHKpzOVBGVzKXg82RpROljDQiKlkLTWXyD5OoyOos8bplZAANTfgGFNBggwsw/+F3nMGlP+xoyxbYAAnYeMKzPrC87A5he6zBhmUqYxhh8yqZ0X3u66+ZzehO93o++KYgnvAAGzRApi5lKHhDngUFDzBD64OQe376oZw1yBSzAMQxAOEB4fSPRfclxSsmVIneaMgDIxSAc0ioDfgkiDjd2RAHX8bBjyrAAgPLIX5Q6FL3xgwH08mC/YVzUBVOgHzxWZ8TiAIJnBwEPYj6yAtQ/VRvBEdA8dAClMIILAHYOJk2INHTbF+NDcTTjIAIjIBVJZkkMB1GTARCVElpWUImdQlISMCUlU3aUN3aZBkHqNkanZlLyCAgld2bwMTXxc2byRUQBpYe8eCfDIoPtv+RHr1REL6RW8XEC4hKAViR6PxCB3xOLUzC5yhDDlAFF2JCoZlALZCFqpBFBpRSJ1iaJ+hC4HnCFZoAKXUCptXFpsHKAsxBC3zTB6BABaQBsBUOZ6ABEriaD/hDqGnPaNgaa5zCN32Tb7UGNqCAOgjLCxTLMl3P5tnAA1iTJiab7NFeHxiAD3hDauQGB1xAMYTQw5zTBmCbzGCI8OHABUyALaScKWjCh3DHx9FUvLCbLIiXTdnijggHw6hIt9kbdMQXDmABgFkM8g0fDUwAx3AQcZDCCVzCJRDGJVQDKVjA+p0TgdwCKsQX/TzMLCiHc8SMx8WUC0EUKDCBBWyAg8T/FHeoAs+AB8N0iMstg4kAjP60CEGUAo8VxDJUYHiAwih8Aovsz46MQDBcwgdulSQs3UZUBNMkQoy4wh9YSkiw4BdR3Zms4NfJmZjZ4NiVpEkaxZoQIUqigVJ4xCTExUc8wiQ8Qi0og0d4igkYFlVoBd/Z3VxkgCV1Qgakiickg1x4UickgyhhSSIowIy4AhN8wgW0AAo0zxO4wCAowKpl3rDkzqmxmj8AkzqsxgfcGmkIwgdgg2oIgmhkVzT5CrEMIusdhg2kwXPBXvXMXnVdjz+kgWgQwAP4gwKUQMywgocx1PCpFIQEAIakE/zYH7qoCCUoVMqtz/3Elzyd24js/9DT5AclWADBFYcy3oLMYEF8nR/B0YAGDR9EEdjLgELtuI4IWEIKWELtWMAJdAxxaNAEmOZhBgAWsIIHTAFypJR3IVB8NEIKpIACGAeEIGe89MeGaAMrjYh5UMIohEcPbSfYJJXO2Zg5iIf1aYMGFsklCMMlcE0IMsIIzIpXacOmhacrdEItqGCXUNmYbGRUEE8NuskaaVmaqMkaDWgPrkQNqiRJ1gSZbVmXEWGbbR1NxE2wsNlLxI2guFWasGRMJgMKYsJSXOEjHFIv1EKn5CRneQ4YcgpZpIqmmEAGoCCWMIIJWFomTcIZWtEknNKmWQAJNAANvEATfMAQ8BY2SP+GLSGLYhyBOlBGWCqAW7bGaHyAIIjiB1SAaoxGa0DbIASiP0TPXB4GMm0idM1es9nSA1QAbgziC8xAyMAADBSDMaBCdRAIu2SmKMgTnsqLKLBQygRAACAUTXkjPDKMO4zIJ2jCJwTDKwTDvkkMP92CtsGPzIgCu5DmypDMeFWCBXjUpqaABbiCCKSANp5AClgbxOUbxJXULPCTAMiCNLTUKuJLdTxMymwAhzAMKRTHOYFfy/xfgpgCKKjCwDBHidgi1PhjKQCkBJaCK5jCMgzUjAXDEQWMK5hHD2ENUuGc0xSECLhCdnaCMkzdSIjRR6KNhQ4OurLZSbZAX50kUvz/xF/tIKEYIZwczqUsSi3AxWXB3d3VQi9sYVjcHWXtHadcUk2y6OGBkkaAlo2W0iSMQOKlwAmAoyMogA8QwBCgAB3smrIcaTedQjJholsyj2ngmjcEwSmwxjZ8Ax4KghioA6rNZec5F3RJV5kaQF/umjQdBg0UwzXAAIDIFPwIIKViRwvZX8rIC3p8gkw1bQrlzDCGRyO4wie4gswZZDv1B3zMAhbMKhYgFHSkkAaNbYB9x23m0DaSgtqGKjdWgwm5VIAs1PwAAisggy3QgDI6yKzSgP1BCHMkUM+4U9/+X3o0R6EC1cE8qzaYB9Dd180dxI7I531FVdVYjdGUAgNo/5qmASTEuooIFATrMEJHdNEXtWB+vqC5qpHq9ieE5iBbFaEepSQSulHsousRyhUbxe6BilkT5mB/1iAHPGGjTFIHAEMUhhKnVJajVFak/WsYukUyZEBZwOHeZcNLYgkcjuDhwSGmwUqpCuAszMM10EA3HYEDPIAKBI8tHalsbcHHCkIxGcFYZlcijoYYpKxruAAEpIFr2No2uEZrROKXMgZLqsMDJJvNTpc/GIA3oMAgQIF17U4/0AIWnMAJrFh8kSZ0xFTN2N93cHAjfMInTByEmAKtngzBvUIIqwL59NQqHCQlOMjCtQyBTJwAxBe3TczHFIgAiNtnBpTapoAIiP8AKaRAHfwGj0KqKNzCBqwqLRSDNCBDdYwQw6ECIBTDLWzY8DkHPU1AygzfOSkUDmgId5Tx+qzcz3xIdlZCMIwC0C3AMmhgK9mY0AndK9CxQe7IQIzCA97ckRTJKJBCJVSECTzKRh7ymTDWWqXRSM4J67Lr3dBRnUCoTfCRHzUhHHFdmOWRmfWJJhPhH7nVGhnACyCWIlhvBghlXCBCNnioIpyD6PSZTebk3skFi6KOWICSJKCOjcLhAmTAQ3QCMFwCKKACCaACK0wDPzGBDwQBTNyWYugNsnTT8gyBzj5BWpoe6AkCNjjLE6CAtqSBC2wDCpiGrX3Dkg7wLRmBdFX/TxRsQR8c6TeUwfPIJRocAxasABOcgL4g5i2YAgmgW3ygAj3u6TK004dsMRdDI7Nyn4Z8R4JoQjcgZLysjCjYggBiQW8+jAYDX3bcAg5cB2zCJg3A5usQcQqAQkpz4wmQgCmAHy2wwjW4qj6hUGmmUD2xFKV6x38Yc/Il4xYbxzJ+Anf0hinYkIr4HELqj9HgWAQuwI/4yMy5SI0t7tNkoK3w8SUkq4tcYA9BnQmIlelCDgzmZ+pmGVqjNYEG0n+iEfC2BIB2GVqHmby6dYA2shvdoEp8WesaaJsQaFynEYGyhPCyReApCqFhQhViAmX9Qi/s5DjIxVgkmhVdCepI/0IiVLZdMMIZMtlEIAfITIMzwEADtIIFo8EfVIESuMFh4CzOcsDwwMRhnGn/ViW4pEbKssYhfkBvEQAKgDMEbEsZvMYpYIMxqfOvSJ4RQAHNTpcP8KUPnIJsPIBsC+Y1IFjX5lM+hUx04MDH2emFRJApMK1M3UwjBMAEDJUmDIxNHZT9fYLAvIIIs1TJBMDCrdiswg+k3rAAPMwsGkMWjDQo+JsXwGYKqG01bCqnDqdGxQI/RMAADIPHYAEJYJsApEx/A+csbIClooJ9zwMJnNt3j5dOk9dO3SqwAqvAfMjByFjlxog+isJ5aAMzPGslrAKiiogFvMhU8YhUsciK7P8IEhXylDHWCnpkSCzyI+M1nlxoYP21UVxylBvoD/ZVXQUKSYZkI5Py2ixFWnxOiJ7yKf+CCZyDMpi5Y+HdvyaaCeDcleAolDCCJHC23/kyMWiCx2SBNVzDLuDCBLhCJLQAI8yBEEjAYsw1OggBFwjBHywGBHgTaZAlWToLaXyDKC6i6BGAC6gGCnhDc2ViGnhD/KozrL0A9gBb9NTekY5BynqDHdkWFzzfh63q/AQADrPUBcCid8E3fMeLKJgD/hhIIwjyJXCIiaSLcehjKYDCQ6dUzJhYMraMtGPBDvfLDllAroKCKKSt2n6qgRUYKEwBDUhDPwyAMdCCSqWQAAz/CEP9XrZllGqW0ApsTHEkY/8NX0z1bXMYR3poQ/qMCH9hZ4poZ+UuQI4UtIq4sY2x8T8Fo9U8oEUKCY8ZldF0Tul25LiCBJkAKA52vOrCtZrE9UrYNYCutaC4Ee0WoWDLroIKzluhUWDXYBQooV3zIEg4Chuy8qd46KTcnTKMymODYaT98ihMwi+E7ggAQyhxNhYpPaokQq4eBylMQACAABVogghEwkkIQRVwwQvkABcsuhAQOheEQzkIQfSgAKUfYms4C7RsT24fIttj6TehQBNsg102lzq8gTJ9KQcstw8cwQMYgfTU3mF8Q2wcgVxm3gtkty3MQhbz9+PTj7oF/wA0yMtwfEIWfMIGNIc5nbEH0BeltlM66RslrDeHgJz8REfJIKZGZRB0yAI4BjgHrd9wTgA3ksIlvAwlWNvGeAF10MI0rCoNBACkUju7RQc49vctIAh0nJMHEAERpDRzSIzwJWaBpNQGRJCIvIIH+DsaM0xQmcMyCEmNjQAFtcKHLIN5mIc5jEL7+1zjJtVUhWfVeGshf4RUHPlZJTkjuy5AtODQAg1BgQYLJkS4UGFDDmgeInz4EE1FiAkhEqxI0QCajhYjFhzoMCGHiRQLtmDYwoYiRZiUeeqFSVEtm7+UKVPUC9GjHIp+msgxyZM4G/78JeqUKMMkp5MsTWI0qf+TCUaWqmmqlKgSOXwiavwQseRFFS7hypUoEY5LlQshwrENV6KFtzIEPhDY9k0QX7x/nxAQpJfwB2x5n+T9sO3vtwqnvPnwoQBpZcuXMVd+gcRHny2et/hD4y9NGWyRQ1fmwORaFlsCbLm+VQxQMRwkKG3AAc2UqN6NcDQyJfwTKlGiKJnS5uoSJQ+UNB3PQmNCI1HmrM/KsoFGliwBRAXAMguLANhxYrMqn8WDK1AWPNCgMcW5hxPVUliAL58GCQ8kshAgQCxuyQIVA7GwZTwCz5sgCxJEmcCDWyhJAYgUXNFEk+QcjC2LCCPMwpTeVDGlnN+0oSRFba5rRBtQKqn/ZIQYK1lglFdaEUWbSl4ZRZMbK1lGG6121OaSUUZgYIFKSkGyElJi7KUXFlg4oEpFDsgyy1+ybMkGCVqIYqAxD1KJzJTKPIhMMs1U082RCDJJoIjgVAhOlVS6KM6GDMrTIJNEUimKNgciVKCCDlAEEUxqUUSZRT1xqpNaTOjFExMmMWGNHDi1pBNxHEDKjHAs+WOEERboRNVEJMmUkaUWsMSSS0RYBx98fvgBmGVeECKcOfYoRy4uQpjj13Au4OKIb/wiQLBTCDhFDGefpdZawp6A1oUPytimW722OaUCdR7wIbNzz+WAsz5AC20LQbCpoFzMkDDGlgBes+WWWQIo/0ZfEozjdwNTcpxAFRA+oeQTEHAwzmBKSNHEFONuue2744QzBd/YChQwtllsOUYAWgRgRYBZbrEFh1tA8cCC9zYQZQNKvKDBA1Lec84LDyQkoeSTs7jllg1cs8VkkF9jxZbXZkHlFlFQwcKDDoDoQEMvyEkugKBpMM64rVsheETffIvuN1WS06aRSkZh+5JKhAFFlUwa+WRDH5f5ZJRPKtFE7RtLqWGEUmiMUZsdTbAhyyq1bLzLA2yw4YWV+KyccoZCOqjyjfwsyXONLApd9Io+2hP0PzPnXKHOI5JAUUxYeOQRTDzJoJNJmjLBE2UorYoqqv6oYjR/3IDciBeMOP8H1U4yaHWSHFRlJJEFFhBBBFx/KICBTl4I4awL4jKrirgQ4AIBs1rgawjFsHF2MHgVE2QwxrapX7BtPkjijQ/u+CAv+hsDBXQNsDKjQQNnQmMApKThGyrwRh84cJmC2KIY5ImNgPxlC+oEbWK+AY4pPKCNT1ziOROAmihwQANNWGADWPjQLbrmAeQcBwffMVDXoOaaLJjsGHGQRYLWIwCb2ewE8iFBf/rjimpkyDk2g48obBEHjxkoC7PAgXlYAQhbAII86iHPLI4jiixYIAXZ6IB7LgEKSkzAhR7IAiVwIEYZmkIVxwGhb9SmjeHoyBSUaIQ5aDSCto1iAa+4QCv/dlSJRhAuSJdwReFilAgGXOISM7JEJVyxjEqgA0uMc9wnIWcDPLmpTX4qZZoKlaZA4SkifoLTK1EXKItIRCRyAhToBkVLze0SlrJUSaIwgYieIKIWmVJVJ3LQgXEgohe4w93tPtUCf0SQA3NQSwlGIEpMUG8SifDEqxYwvQW4ghTVwFUMfjACBRxBCGbZA7GEwIUSyDMcVaiCEqrgAxWkwVqMcd8p3ucsgA50MNQ6BRmSoIY3uGAbg/nAKQLjAgKoABvmIuC50HAUf6gjgkiBQAUc84Dh+SM1EKFBA8wjAJHNgqUCuAXQ+DULSqjiE6ZYhYv62JsDbS0AHoja0woU/4BbGCdmHwrAyi6GCi3OghZMtYUsfiYyARCNEjAEUIFIAMT40GCGE1jjd6JqC1qwghaykAU0zApVWlBwX7aYBSDA88abWUIEmgCFKEDhoAO90TsFqmGOfNMb36hiRSVqhCoa8QpRBAmSldBGKyArQm14QJExIoUFhlQJUJRCGMIgnCZ39KNPoMNKWrqSaR8HucnV6XKWc+0u7fSnOLGWT2fSCG1hi5CNYMRydaITQ15wgNkhAii1yEExs8EpTwzlFztpyiQQIRVGiEaBbJhDFcphlnhK7hzBkMSrLJEIV8zKEvChQq6AUQokpOENZTDDKb4BhRccwQaAQsoYlsCBCv9AAAWMcUEFfMDPZyFGMOoLKAARqoYkEKB/hSkDvE5xCoteFDNoMMJojGCuB1SAAAAeKWZYA5stspQ8BYrNa2yDgxSNyDotruEE9qXFoKFQFLcYjy2M0xsQzkIAR0XFhLDAClkUAz1PvYXJ1KOg+MQnZkIU0BGn4wFNTAcHPBOFiLEgi2OQjBbHoKAzyAoIWgRAOwqKDQi9ejNQuMIDHihqFqrc5hp6J45xDCNyhiTZTwhnGTp6JCAfWYlLCAkHFyCsJvK2jLXFiBJ9i1EpSsGVxlZCdlQqLZa41Ekt/SJyLQDTKEEdalGPmpWlTqWhTq0mMf2pIrtEtWxj60vcphr/1Ip4BDOJQpQMYKIDyoAeUWrxCKEwAhGe8AQDziGaCP5hDlzggrCeLYQ5IOEXI2AE9VyxJCjxDBQ/IEYignGONJwCBRVIwwvcsIQqdPQyFRiDN07BGEGg4AEbDmheohWuaBWUMINxQULVQAeJ9lcw1IrXhCmcGn/4QB1H8IcN1FGBeJXrw5cxwjUEIAu3nkyorpmFkItRjFaogqatwEGJJmCKT+DA5BWUhVBltq99Ac04vgkAmaFRDFQcdTbFkMVafw5VJL9miP4hQcp85lIsSIiNG5jQ0XGgtJNJQ8iscIYxkFEMacgi62vVF1QdxjPngMI6KSfaUbPQiIqpzMdZ/zBhzFJuCnN8YhnDWYWibTpZSjQ6Ro0YhR/D1seb3t1uKqI7jGrQpLxV4hMibFsvQLm40h5AAlqa3ChdWyg0vTbWuiQJrPfU6oukTvS6jbUtS8/51AV3dsvVna4xFSkTFMATxNRd7WvRCWkOr1fhu8AI2mkEN7zAEqkq/nhJcYINoEITHSAGMIJhA0GQwQXeOMIeSiCEZA9EAmhgg/DckIZxh+u/PngACj4wGDF8gwDfENeD5deX9vMlL3dIwv3BUAYXoKDDTWDwN8ogDSjsXI4AefzhCBQAGx6A3c7lBYyBFgLEaLhGABKEqSoojljkzmhMjKCBigQAOTyAAo9KO//Eo6c+5Bi4qF/i4Gt8qKy0qGS4aKyGyGXk46VSyju6w82ujAa4QwSr6OesLg6ETBZ8iBVo48TKY2VooBFa5gRAwRQ+5EN+yjsowTusEM6wAAdMIY4+RDkQbW20Agwlq21iRJNe4bBGpG8OZw1fgUeYZHCCpBQSiUZ6QdOyRBFYAEvwEEvuUJRI7Q9L7ZQCZU3wZBALkZcEQkx+K7buBPQaEU1sq3NIrSBcR1GKaSiGgiokoRPW4HZMoNhoR5gY4RfKwp5GwAyMoC3aQgjYAA3GAALQQAEuIVYugRRskQYEYAI0QVcYYBRsABueAAUmowSW4NnqSQikbQ4QQAgkowL/BGEIBOEUmgAC0qAJpgUvUEAAtwACvKECKqDc3O8bPoD9GIMOFEwNwCAJqBECQqMP0mAbmuABBrDCjuIc1MUIBqikBADjUMFfUGEWpGEDYAMZaAEZZqEYVG6NVMHH4mghcYBf/KNAnOYYYooWaMFftAMVTgYQzIqsngYVQqasjKbLRsZkeNDNQvBkWCEdlMZnqihozCNAToaCZiGtOnKsZMEIjZDjXkPjfozpZAYVDkSpFKRpDsSKrIjtWA7OxGimRKER+Aw6VMQCoGRvRmEZRgGncCATMHDPXKQRNIHuugHS+mYUdoRvNOkTlGHyEqUte8EN1vIOsWSUUglNRm3z//rE1dwELznvTUwHIkYic3wLI2wLtzqPtl6gJmohA5qi2KLLBDKAmDyhFoTpEWpPmCThBXqALZyNC66rLUqgC/whCEgTAg5gFCyBFBbAFkkBFyegGzogEbjiBablAdipGMMhBIolLrynCpjxAR5sL+6gCfwBoLBhMJ7hFEKlMiTjAcatDFTgFMqgDJgFAkLg/kJADYKAOYPAWT5gAedR4Q4wgmzACDTqohTgGhLENfYRGZAh4y6yXz7EOigBheAuPExhAnBgawSABAIAF5mqNmjBGTryGLrDNTjy57BAFLomQMhKrJbmZ4ToiATEZIpB6ryIgk7GpaoIB7YINnyuI/9rQ8gAgRVSJg6QRouWhgeDZjy24yXN7Ba2BhX2MwtaAc4uQAvTDjlyajgqwfCgxLEY648Oh+RA4AJcpPH4hu70phTexrEWb0eWYRlcQkv0MA97ISd64Rd+AhEoTwI+zdNELUwBMdTYJC9DzRBTibXo0i/P9NXalBDLVAIQYSiYxymEKVKMbTIfQRcQIRmSYRKyQQFsYAl8hS3KQVjO53zQIAgeAAKCAAKMYBlEYAFmsRZvwT+7QQcSoVKPYAhQgAO44ALmwHuOxViMxdk4wP2iMRrLYDRR4H0AqgLQxV22QOG2gA/uLwn4IA184A6CoH7QLwARbh59AB/9YTOKFTP/QuPiTMatKmgX8mEAdkEaRsYYwMNB9EoUIMuKjsoLGiZAdq5faMEYQs4WpME9h8w8xGoWpkEWeCxTX+MYzMoWnKFkTObofIYElGYaWKEZrM5oKEgWCHStDsTLrKgYsG5Am4FAWYEVhuEY/KUYyspkVCoADkRGbcEDTIFA2mppSKxDj8o2ZlRERkREyIaOCItt+OYsV8Q3WiETAgAqNYGmXiFFguEVSuEVzMFvmHRv9iwuHadKlKEXHsFRFIW4XgBMyNRMD3F1QE1OFqIun3ZqW8vzYC0ktLbzRu+WZg0hWgJ3LAFTTCDYPIFRFOXWMMEELFMRssEEuicExCcuhKUc/0KgBXwgUiMVAl5gGcjrEpjAFcaDErrh+RjgF8YABSLDurALWcYnN8eHC/7AH1CAWeTnFD7AH/hLMARhMU5BAAcwNIC1D/Q2CPqADO6gc9sPpL7hCB5iHv3BCFLDB5CAAc+lBWgBF5ZGZaZjGq5hAPQBGX4uOALL5E6OP3PRj0CkOwRAzEBmPGRBGo7haL7j437uNRKkZHIyJ1nhGKa3PNaDBARWyIZhQH/Oh8wqDo6hitADCwIgDi7SGRqWI7FgGEi0y4hwXoPMQ6sIC+SjhkysRk9sPx/yJWnIjZLDN9wBp7ThFcSBb0bBFbThKiVL5VrhAijBHECBRZbhFWqAHP8gbRTEYRm0wm+EREgeoUquJA9/oQ5/QnYQQRcKAEvAFEzFtGlH6fLK1JTIFA0+bU1l65TkdCUIEYgbsYhRKRDjxAaIqVWqwhNuzTKN7VEiJRn+1LjOwQ18JVXRIhzEoQTcIG8h4BUj9QiWxFIttRL6cwO6QQSAAdnGLQ02TAWcLVUf9wKqoARe4Pz24jj3axsrIC/WTy+IcwtcwAfYkV1uFQ36AJHTIAjoAALY0R/6QJL5h/+c5RvkTXEhwPx84CHEEzOOVTWQoOIsIzTQgAZgwKz6kwexABYGAAakYRp07hPqaESMAwAaIQByTqh2g6Vq7BbglamG7EItUmlA5kL/6XWtqo6smtnqWCFeS0asLrQjpZdAh2EYkGF6heypToYWrtnqjmHrCJRAh8ysioFeNW56D/Kpdu4hkaoYRLZDoYHMajQ4TMjOSE4Ubvk4aEqE2ObvRiQ/We5sDkuRgAQr96zxGqHu9CiFJW8PHUUnHOURCkAXFKGGNTpMbQlNRc0wk9iXuBb0XEm2bKtytBaWCHGkM4p3iqkTGOFoZeccMOERjLbYbk8ZJMEGFuAswseniWULInUHJBkCyMAHlkQExMsVfIY75KAAEmEUwk9eoGAxzGAPqqDZSiAEthqP0WAv/IkAXhWRgwD9CGMbUOAO8pY02ToIxoCtgRUCmBNS//sgocTgfwjgGRaDAFwgwr7hk7dAMgYIDeSxMo4AWQdIAWYAqoTIZiiQYnnBPcPo5PpIFfImPPYzAG5Unp/GAsuKYCm2Xv0lmikSZGFDpUqGm71srCqUJo3BPPAFGZqhYZ0hYn8IBVkKXp1BtlmBIGUBEJyBFuyXQIshAJqKNi60GETsrbSjuWngY+CZRremA62ozsKIPUikjtCQEhbYHLAyGFIEOGYKBzDAg5RDFcKyEoJhFO6uoc9bj7ShSqlEduj7EcyguXpBF86gACjvBSLnS8V0lMIUh3e4THX4o6M2t+ySlExNlRBx1FLJBkygA6JrUoipJ0wgdoyWBZIhUv9qQRk6wQZGAAHkwljAhxkhVW9THKmRzxUmQADmgQS8oFITYQSOYBCGIA2goAK2IYyFzzzNwAyyegF8oAnwLd6IMw0gYBuGAL70AqDQD1IlOQiigKjZenYh4A7IgAzqWsHwDS+gxVn8ywEKoQsc7qKOwKKaFV1sEw1eAAYuFDYIpMYEIBryIRWkASH1M2xEQRPqRj+ZW8RgqB+jdxiKgRouUshy2yJDLoMGdnqhCsycwcvMI+m81+cslmC1+ZhJRmmO4eagAcx8QRa0edTNahoGtCBTPR8KMuOGjII2TjfiNQuKu55HjMyyoANpNEfi6EDsjMagUAsJi7DoLhIkxhT/NqARIKsVZsqPRsSPRiESFOawoDI5bPkRTIsFslRLKbpPY0AXJAdMvyTcA7zAF7zAG1ESRTovZ6kv+UTdM+K12gQNDgATdm0SimkcCuBoq1Siz9YEfO0Fsiu75FZu58AMOGBvpxxSOcAcFIB6KkEUZuHoLOAHojoHHiDC6s38kOAPkKAKuqAK/mANsoQDjOBb+LoJttNRyxp/xAAbGMMBCEDKqZytYZGk2nrKyeAN7u8J7uAvAOhy06APoEAF1CGU6XEea5cDAOEaHhZ8bYzrpDfrBiblWkE4GiESuJXWR+YgsQBAOtLnjgHMQrQma4OlHnLIoDnotJex+4NAsleI/5TG54ahGbYZGR4WELbMGHBA7YuhtvMhm8vXFsqXIIch6MCMFjjyJd/KOHDAQx+yx76jNrRoP1XhxbQQheAI2Pl5bHDAHUShz/QoGPYOKn0DGkhugZPjRLShvdWmHBBLORpaJxJFw3MiB6REGfq0AGIAEWqY8r7kAJb205p2cgj8DyXg1I5fiEmN1hr8lNI0t0jt8gbiABZTEorJBDqhA2iCJnYC2xXhE3UnBwr1LMw/LkpgDKKgdIc6CCZDG8aJEsiMBJzAAoAB3EwgDSr3CI5gVMtHCQBiDhczbv78WcLjgYsyBAh8Y+Ovzx0IaU41JIDt1IeGEyEE+QhhSx8fH//HQHiQhgwBFHfeJFFD56LMhoJWYvO35YE6H/56+vzpzwYanECL+uNgxB8NaaxksRJASwArZItYDYCRYAaMEg0utDJlCgeOC7OKFZMly5YtAcPMsnKGDFmzaHFlOUNrdtYsW8WODaPljJbgpsUE3KJBwsMttayOGcZyrNiwYc6kSaPcFK0sZMWQsfo8zVk9ZNKaOWPV1hiyu6xpFduLalYWtQEA7V1rK0sAHK+LZZl1a3crsVkmZDmOA6xyU6JwqMJhThXYRo1USRdV/VMmsZREfRJFyVQjU8tMfackXdUnVcpYsDjA4pEiRcrq13+UTFcMXTZeSJBgw38A+tdCgQb/GvjCgQdy0AKDChqIxoMSFhihgy1EWCCDGF7IYYQeKqhhgxei8aGGDm5oYYoHYNLBOCaMM8k4jOSAiHzK1OLeL4h4UkAtk7xggxDiVBEOkeFwQVIUEHjEZBA+MLHAApZksYEANGiSggiJiHPEQg8cUQIX5VQxxxFGvVBFHyhsRMA2/gSxJASn1LTRB6ds09BHPPXk0UdBuDATnnQkkUQTG9VEEwF1fnNKBUP544MPjxplAwc9bWEUUEa8YMQ1Un0GCFrDsJKAAEjYgCoTChgTCVhi7RZAALPgYMssTTmDa67SODOqM6a5xkoxfMki2DBO+fUZLbZgQQIWAmDxFCvO/05bjK/B4nrMaU65lo80cjUzjFz1ZCsLU80UIw0txh6D1iyy2DZbFrf85mwcssGKw6yoZAENDhOIkoUou73qAXPJuSqKKh6Ioo0p5ZiizXiNaEPJMuNtB9YnYDWscXnLqKKNdaos88gjLCgSHzryIdKLMr14gkgMMSDyH3wSHHAzgP+9kOCBCUrQAtAPCh100T1PqKDQFiLNNNIWbgh10xS2cIAJmEziiSc5MJLBJBmYkEMGtShygDI71gJ2Ni2UMwcCVXARzhxLRBHEDn3CqWcOFjChCQnzkDBBN/hYIswoPgxxCgRLcAE3F3OM+OgRNhzhQxoE3PnGFluk4QJFFv/ZieflDbkQxaVB3BH6KU1809AQGH3wxBtqNDHTRSiccsoz22BTwZ4cYJrpC2ZmahQSL0BFSyis0CLLMNckQENRHLxwywy7vTYLKsXIGsAtmw1jSDOwIAMLXKxwQ4s+zkRDLC3I2KXuqHY15pRaUtkSrQD6C2DLMaxcYwu4UGZXf4kLXfLRDEc0AxnDSIU0QuGMWMiifKlYjWlkcYxjSOM1ASgGLfYiC730LzimENZuNpCF7c0iAP/ajWy2hwoPZOETrZCOKbIQnu6YwoYaMwUlKEYxEODiYCDYoSiYs8OvmCc9JntPyVqmDPrQ5xFnOEMMFCEBIAkoQDjLItGkxiH/Cb1gQxNi0PSiNrUDkbFDYWzjh6ZWIg4tTUJmPEAtOlCADpjNBJaYRCcy0Ikc5GA+ivCEjzyRjRy84ABwCwGRuFCFLcDJbnZbkpOQ4IpqiIIEU9hAN7oBjESMwgw+eMMDHlAFRxYpHEWqwh/cMIdACGGWavoAS/xhEkxBYE2KatM36vQBn7iBDrx0wRDw9IEPCGJO2EgDGJJABxcIoiZ0csFHXICCCtzpFMAj3lFe4E3p2QALM/jMaaTRD2kUoShbeNQJZrC9YsBrhbMIVbay1YxrEGsqqMmMZtjFvM9opjG0AESz0pIZZmFhWaywVRwEAAhpAKIZdjHNMeICl3zU/8U0+gDXMZrhrYsCJoO0aIb/cACr2QDCMQUNgAcmgIMsoJQGYpENIBbWHCyggqYAExnEjqgKd0yMYdYBz8Qa4QpNVIIKkWhFwnbYCEq4QzzLsc4Oe0E2+vSiFzuq0XwQoQtJxOARizwAF22As7QSCGhsLRoYi/bFpiXoaG8Fo4PoWtcCvSBEB8hBByxhAk88IgMZsIRhE9EJP+YgsZJoLIwmp44QhCluVSAJGZhESSeNwwOUCMAGNhCGddRABMAQhxug8IwtdAFuczhSOBDgOC4IoRxwc8cefIA7NmhuT/64g0Ua8g1s0AR3PTllF7yhKBSIYSZsckETYAK7IdCpTf/bSEMagpCGbVRAmyQiXjttQJRw+mMoPjBCPWDAi37cAAZMsFRR0NCfoRQBnh3EATQ66JZoTKUeoWBgM2IBl9Wgxi6nCdZbMmPOY0SFeU5pBnCk8ilW9C8OfUEGYN4CGGTkwxn5GIb6UkGZYYwPLpzYoIKnIg0HpquhjMGfLfSJClG0gl8C0EsAsoCKW8gGhcaRzbx2g4qw3FDG4TniES9wMEo0wh3aqIQmXgGCTEDjOzbEzg6lA4L0XKAX8InPyx7hCUX0Ij5UlFkX05ozNB+gBXNlc17fGiI1imhCFXrz09oI5wZxgAN9BUYGOoAJTIDZE50wgde8BgxJTCIHkzD/QQfQ9gIVuEAFIWjcER4QJ7x5xAdIyAQJnEACwHVDBJYQwQheoACKfKMCcyDS27ThyDGxshwhUMcDUECAO/gEJN5Y0xOo2cuGQKoCW9iuN57hgm0Y0wUf2IYg6tSEJIDBBb/8hrNX4gKLlAEbmIZCF1Qg3uGJtydDQQMHaHANLCQlnC8oAjiLMAsP2BelWUCLZ+TiK18BOC6LuIY9AKMu1EBlGM0IVWP8N5WAHuOhOs3CMdQCiKc4xYPpMlZgvBUXcOljNbnilT2aoQ8MSgUwzlAfKwChFnkew12A8I0omsMXLMgGpjfWl25QivODNScsRwwLJX5oHRyELGLm0IY5/16hiiLioDyNePnEVLFz9YAFq2QuWcnu8whEnIEBBcAizliQs/f8ggW/6KIX1wpXt6a9rWx/s9vf7nY0vOAXHSis1TyBia6+CGuTmIQlGF0LMyRDEow4wBGGkAZWO24JJGkSkyAwXy+gItRekEMiRFAKMzwgB6X0xjYcV4VyhCMERyrHBVwrBNxu4w098cFEMI1rhmykDDV5wilQEKk3+MAMSgjTEqztDW/URCOAckAg2PCMyy2T2tsoRAWgAAV1qAMKPoDCG9ZNPOyPGyhDaUE4h8IBLJwADUVoACp400FplC8w3CDfMKJxDV4Ugxv6gMEAFoGMCkLQGcfghiyacf8+m5FwxsINo7I/B9gUkzEqsdAMJlU+DMgNcOEMsKAP+OcI9QALzbAIzZAKuMIXxWAIVCE+vBIuZQEV1bJyMoQKxCIssiAr+aIX+QINvrE9/ZIJYEEJE5AeSgQe5eEvzdEdSVUJ6zEcONAcVmVDqqCDmfAJIIAO7sECUYQOUjRmJWNFMSAgZpdWmGACZLMzXgR3SNMza5RGIhJnc4aGaIRnEMIBZEhHC9ICyuAJhNUBilALtVAjXIg1YNNohpQDhMZof3AElpMGREJ6jQcBdoM3fXAOuIAKE0ACJ1ANP8B1wXAEguAPKAAo26AOrzV6rBR64gBJc0BKqwYB/kARFOH/egTwbNPUJspEANjkAxBQBmXgD0LgOCVQApWmAt7AORtxCi2Ra21CAMglCOoAAZTzKOa2BT6gALxlFOK2fT5BIkPRTZnSfUJwDS9ABELgQrRQGqfhGoGRDrQQDa6BAxhkLA0gDdZAGcfSFMjALpFxUfmQD33xFFhwCwvlLGxRUuDoPgyULXFxUchgD8jQUQh0kJ7BK4ExDAIQGHHhGeDiK9IQAMcACLQQGfFiC6EyCwVlC7QiFvQ2K9DwGyj1chbwUy+3cxDzQ5FwRB4wMZXgCqBQCY2AA5mQHj2nHAmDA+hhCr1gMmBWIzXyCL2gMrqgC1qgC1+XhTejCGswSAeA/zMBIiBodyBt9TNrhyAG8kX/4VYooldcWSBx5TNqJzVEY5bTowheIzYmcEdZU0gm0AmdkAh9t1h2mVgZsACdcAS30wUIEA4l4AaPd1lwAgE20ACfNQVTIAJaQAyX4JeeIwi212ynUAVv41pzIHol4AMqsA0oEBHbQAaAAgGhoyga0RCqSQBp4AMV4AIP0AJKUA4YgABzMAczECZdUAFpsExNQAeIEpqCgGkPUF5GgQZGMClFIY3TeCnLSTzdZQNK0AArMAszGADXwEACQCzwJ3/OYAu+EBskcAsCYBbU0Bnv+D4SuRkKhgwwkA+p4D/6o48C4Bi2MgwFOSoV1AwB6f8tvOAMoaCB+qAPw4B/DZiB76MsAMkKvEAZDHQWZSFSsgCDK+dBwgIcJTlvhSFh/WKE4pEFMtQc+WKEruAKR/ihy/AJlUAJNAAC0LAdShQWVjWSn4ADKLNVgtWFQml14CAzj5Az/8FFB/AIOQA2ZAN2VTkgbVWWEkI0dOWGYSilTepGEGIgS5MNMFIL40BoeIgIteAJJpABf9AJllCmnbAAidCXiZABrvAC3nAK3mAkXCBJjhcEUfAC0DABGwAK5IAPMlMDpPQAg6A7ivJLxPkHoHgBmVkCR/ANLpA5/tAQdpIGtyZNyYQoiiIIvhUEW8AS39ACXaAEcYObkXCbM4D/i2bgDb9UJ5ejEN/QetD4E7L6E+DknLfaesPzTtdgDHzBGc6gPwFUDLwQQgKwLwIwBTE2AfpYLZbRFAP2F4EBUgNwDQ5JnwIQB9HSj6PSK1NxFixYUuGiPgy0C+FSLeUCLoBgDB/lPuGSLJ7xFwUlLPIUQsUQB2khT7MyKze2LCs3C1hwY0YYMNDRHAErCjYpCkhmDqKwDAyjDa7QCPQgRAGwQ0KmCtUBFgBjCvMBZiZgBlyoCFb3CPoxVlQJdkl6APMBNmYgH1QJH2gVpGDJVmjXdjULVwTiVmaZdhPSdlTalWr5Zi+QDV0DDB1QlzHChTlQC5YADFJyCZbQl1Ii/yWGNTdNoF2MIwQmUTeXRQYf0QLtAImSWAPEEAPMgAYPMCe/NScNgQ1cIJhwEw5C4AZRsF1p4A+0Q10+wEuH8my9lHy2ZDmC4Hn+UAVtUAJtgwAIgAuRgANCwANVAAWnUAaLggLg5hMPYI3TaKu4eqtf4g8tkADVii7uM38naAi+cAs5tgETkIMbcAsodQuskA8DwCsBNyoKFAtUsQupgBqjYitS4RT7kyys4Avg4n4m5SuwAD4pRhVyQQuwcBassCsR6QyLYBloYQzRYLyp4EG1UhaVURYB4L0pxD3Kgj21ggP7AlOvuwE4JxyaoA3u0ApNh7EMQx5HBA2tMAGmsP+/zlFDRlhDrfAIZbNVBdweIiszXpdmLiuHOSCUZUeVaRakODulb6WzYPRGb4VXbPgCd2gJhLVYXeMjhnamC6AAUNuXZmoJJ1wFbnAHxQhJJWAGloRZEMAB6esFXkBaHRAMByAn3yAIz2An3qBMLrBa4XB6VVACXdACbFAGLiCpsQi4aZBMG3GpsSgIgMJsgMI52eWajDQHpjoHAJC4CHABPLAHRrAQt7dqPKE5/tCc3tROkEKrnBtu3qcAMOAaHtkXZpEKsiAAE8C6GyAKG+ABhCwAWUAC3ImQ6sKdzbMZ5SIXbzE/n+EYJ/cUCGef2ToVpKEu2eIM9hAuJbcZgtH/cnyRLa4BjgYpUhbWPHEhGMUAsC23UjKlU7wBCLdgGzigLJgsU80hCuc3FiOJUsxBMUIGMdNhDo3wCY2QBbiAZDN6MBVrHWJmhy/TMk8klCT7HlSJVgdQdvQBNoFFNi7rst0MszbLpG7WID1Llmq3zlkJz0bjlfUcV+4szw1iAz6Cph3gCZOwAHxZlwI9AihsWFGLpkxgDgvgA6zYBUJwJFFgEofpEWiQvyAACjqQAeJwDnq7msu0DWIgCNugAiUQN0UiBEvAARXgDRXgD4KgXJdzCmj7bM3WS8j0iqhzBy4wBh+QBndwB0HgD3GbuCWQuBeQuAkQCJWaBksyixwg/ynmpn3ehAY8cQTXuH0V4l7blxRFAAPBEkLRoEHJ4wvTgAqBvL+fJQAbgAquu8hSwYG08BmT8T5OMdescC7yCJ7MI9cM9BmN0YCOQBm/4i3lU6D1MAwf5wzoIgsUt3K2sBm8oGGkURmmIb2d8UG3IBj7imO/0dj985H4pRezMrA4h0IxNTCqIFXS8aHdoQ0MIwoeEAkBMETDERZi8RU1lAlTmAM34jJHKbL60ZQLXM4sAKae0Asm0M1pFcFmZXZAa8H07LMbXMHVzWa1wJcg7EeGtcIHHSWWQAplCrUn3AmuwAT0wAHbsA3f8DZccASZlogQ0AcWMAEWUA2WYAYtEP8FtMcmd9JLbgA3Y1IFKY0GLM1NlgM7Q9zQd4CprboRQJ1s2XU6fvIRbuADPDAHRp24PGDURWADRvAAQfAMpxCNWc0Tm7t9LfDhSIAECjA5Wx1OQvE/8uM+5UILviAAARDIL0UDhjEvQYYKzhIAcoEM3Ika2RJhflHjxlJQ5oQrf/0ZFkZwpnEavjKuBDYMH1kMt+IZtlEWkG0ZDWgZcMHYvcE8+fIbNdZyweIuZWEWH3QcR0TM/oIFWSAdx2F0DZOxWfDar73MqoALuJDayWGEzuEcymAGWYPNJVOUPhoDZ2DOEjAf8FGkYBOmKGPOmf7Nz02z81yWWWTPXKnOP+v/6aKOlvXcpO5sAzmAwsBADF0DtQBtCZVgCSOwAJeQpm26AK5gCRZgWJ9QBBAAm3vABTIc4kvCtX2wAhhgAUhAOdhEAMslE8vHOK21B0KgDiu9DU2QBs+AAoLAOoJQAQ8QfMrGbKyoKE1Q09vgANuw02MQ1F2LXf7wB0qAAEJg76diA65QAl8QCC39AJSyfVtgJnGMnEdhBAqgAipwCl2gDirwJd3FTjjRfagGA8MwDdI7DKFAC7kbGjouyAdoC4t81gtVDOMzDa5xDL5g13c9DYzNf/0DF97iC85Q85TRkKiRf83QX+NjUqHQFnzxFlTxFmbBF7NQGe9D1wRXcrhi/xaNXRbt4xkb9EFmkS690XI4MGOtICuAgAXmgC9Cd0S5LRbV0XTo0Qq4kL9ar/WmUEP72wot8zKeoAyPIIdWpwtWBA5qRqTuoQiDRPcmYDI309yFj2bufMFOat3V/UUYslccYAN/dNCEpQCVkAgHrQCkECUKILXgDbXgTQqqoAAc8MTf0EgcoGmIeQRIwAHkTjsf8MLTVROFeCQjUOxG0AIMEYvSNU29/w0P4MJ34ABa7O6w32wfUJoTTuEfgSkcMEvu0B8LgAAcjgDLIAQq8A3A8yi7hdWZ4gOWAuPe9AJIAJpDAEwNbynQGTwK0A/9GSwTOeZpESskUG8LZQv7mP/Iz8JhsXDZngHlowIQyGghQyaNFq04rGQNY3hsmLNmcYYdoyWL1jFkspwhq+dMlixWCm3ZwhFAlrSCBG1BC1AMWb6XA5vZa6YPmceQ0o45oyXN2TBWyAAVY1UMkECExXAoDZAFR5ZZJel5yNLUKQ5tonDgUMVVlAVTjUzRU5WpQRZTW0WZSptW1SMTOeKa6OWpF6JHiMDFOKPrAIsDfx89UsTiETpPnhApBvzXsaLANgJPllBZQovLljFXxtw5c+cWnkV/Jh169OnQpU2b/ry6NYdfGSxluLTAkiVSCyotcMWbtyVXlhYsMOdqnaZVn1qB4PWiCQFvVbiUOAIhCAT/7A+CPDCCAsUQb0OeDNl2ChsBMSgcSC9XTkkVDg+abPtAgEB5+4JOndrmY1uaO9LYxoVttnnChQNdIBDAMe4IwsEgrvNnC3+EWOYFd5QQQogSZhCCByESgMIfEkv0xwcftkDDRBYp9OeFLbbwgUUaVzxCBRWGOEW//Ag4pQIOaKQxSA6QgMEZX2RJpRmIFkFmEVmasWUWVGwRQIAprRTglluOCcCRAUJhqCIBQhqGlok8EsiYY1gRwCJnWBlJFgEqOsYWihiK8yd7KrIIywCmlAUZTpDhpSJUZtmpmWmGyagefWBpxhB9nDFGmmaQGaaemYYphpaNpHGpIFqKcakY/2NKwmGWLJRydSscJqBEFFFagbWVLC5YRhVaTZkAl1RxwcHWVkxRRZVWeunFhLiU8USZHMDRS5czYmChsb8UgUyRvDCxy4RHJhN33AMkKLdczVQ77bMXQHP3stRaaBfe0NCgV93V8tWXNX5NOwAY4XDjrZMFFOhtuN6C6+0SVzzQZAIqQABhggYUCIKAD7oIpwQzoohiB+tCVuGJDz7YkbxTnsFGEALSqGCOcC7gwggOjJhPEP3qE4Q+jE9JQ7sxHnzwjg/S+OCObe64gwwHyYjQugf7KHGJEoRQ4gIE3NEmhxzOQcIIH1pAYwsOUETjBSHTtuHFtFl8QYHzCGB5bv/7thFEhRbaLhFtG/ohSNOJ9KGlGVnqCekWaLBUFAstabgljjcX0cdwhtwMqZmQQNpJlmKwYGWokqqCypZiOGelVD0zdUaaN0mHBhVAMLUHIoKKicrUVF4aRp9mMLdn05ukkWbTYfLJFBBZdsJoo54OMhXWraABZBZoogpg2CzWojV6t1TJypRPVMEFl1m0uqBYHEyJRJVlc+gkBzMQyaEuRJLRha9HDHMML20XQwQTZiWDBZAhVwEdYxnOSOAFmUngZkrzQM8scF3v4tcDG5gaCarrMjkgRQc96MFKdNA3wTHHAixQiQmkEATwWCEVrMGENNxnG1yYgzZ8MIaQOe3/Ad4gAB0GtKNT1Acb2PBGCLgQDiEEwgdoeEAF8nMylvmIPxV4wBgc0KAHGe0OLkiDC7bINKFBKAgOCAKJVqQAJSjABmssAhIKtgx3IGABHBgbGlDkgyMcAUUccBGLDOAPDhyBbXrzx41UcAq5DUE/PCLAeE6hjiC1aEYUChsaBHANXwyPFrB4UjNCUY8BDCMaUxLAnazkJio5o0wD2MQiBpCPWOiEFUBpE1CAUgxTFsMWqKjKLJzhS1oYwhmc0EcshuGLYTRjGLDwSEVONyhpUAMZA5DGLqSRCmMUIwAYGYAjcmGPjPgkFMgwRD0W4QxDPAkZzAwKpq7BpIK4pFSz/yhG9aZHT1SRjnQBgAYOUKGVYQVUFevzgChmBYIZGKOfAbhAP4mFDmXMxRO1wAQmHqGYApzhDNqajLa0pQxFeIuiijEgC8wVGHNJwAYphWBp5lXBzrQrNDLdF03ptS8K4lRf95KXGSwAHFKkYAEddIXBeDOC3QSnEpSYGAg2sAEqQGwCtjgBBE7hAgewYQ4hwOF1QEaGPpRHEN/A2X2+YTc3HJELQlhCfFqQBhS4wBuCEMN95Maf8jxgC2mIUF8d9Feh8bWvg5VQiZDAA3PgQAge2hCHhIAAJUTSRJOMkQ84cFka2SBvaEvbilqgDhVsg6wf2IZZ61PaO+znAUIyQv+MTrQ2JsCgTMrTxzDsIY1F5GMAtLAFFgTg2ysxDhWyAAQtWDEAm+RjESFRCHPjlLmEIC+617tJpeoxjEVkSpkacYZHGAISTV0DIy7RyEkulQXYhSQU+hATROrBEdtCiiO66x1KrsEKaQzKJcUFHfSMYhRarErA0NAeDgAaiXKYYhmmyIo2QBAAYyCAK8QCaPs6wSxl/A8vurjfGfK3LW4pQhl58YQJvGUCXVxrMoAxKWAg0xjJHOAXCJygAtNVYxy3ZjQZRE2ORYMZ4FTCFdrgjSsqcYndCPnI5rBAQVGBitelI4VRnUA6sPAAFBDAAV2goRmsA7KnBcGJQBQENhz/8I0uzGGtVTjCFh7wgCOgIA0+2NE37POBMhAAG984hT/4SsYIkdFBXHRBEArNVzFG6A6uJVEJEODoTGCNC7hAwBwQEAkhLMAfKyrREThdIrLNiERkQwIaOJA3If0RDaCNon1c/eo7E0Adop6sESaEBiRwwAb3ne00kCEmWEjDER65ki2YW6Y6DS4orPBId83U3FKFBCcaYS4rZhGUcS5iGOOUVKE4acuHOAq7juoJMlAVClEJwFazMHZQnOEkTthjEfWIhT3KGQpnxMKcw3CE8IriEmdcI9rGKNVSoKHQaxgjm6bS5ixspZVamQIa3SuoOWiFC3kEK6DEUpYJoKWM/1rkReTVOsMBCGjyR4DUE9IyQS0Q0Qm8oNSA51LpSdF1mpfyVMctlVdMJ2jTnvNUp/miqQ2Y4AoRuIIUIawEUkWY5EqUJB0C8EUA2HGlp0Z1JD5AwR0I4IYqzKEKfSBDyLDjg29AwRvq8MY21LGHsBtxDIF8AATyWIE+qKA+9SFAlgngggr4YA99iFDQIiRYwvY1CtcJwuKlZqJyOBoBk6f8ox1dghKs6NOcFRKFbGRrsbUNDUbQ+1m/cVqMpb609SFDBUrNotGXqAhF8AcWKkKCoCjTHkQpk5bk1O5ZyCIOyJjlMIRPXKC4qXIJaVMcPgISoHzE+PhurgC6S5NmXP9DHyEpU1AGdZJh6MQnuXVGFm6B3iywIg60mMXg9KEPYdrD3vLniDRs4iRw/o0gxygdLQDBilYJHW2qJ6OQhZYAhOsRBW3gimM5FhxwB1UQC3PwlQaQhlloqIBChQtYlrjAhPdBhMXQhYyKAbxggV8wOW4BB0/olomaBEmQBMLoKMCQgGthsY5yDHRpoHThMZ4jDR0cjQQKwh6sIB3kjBawgRMwMldQOm24BAswMlLoDU1oBFSgOgGYOl+wwnmwOgF4B2mIsycgADSDGaiBgLILgj74hkBYgiXggrUKBCGoAjNogcVLAwiYM3WAgG3IMzH8ERfADhXwhi4QAggwvML/G6MgCBoHCRrtuA5DJBEfcAMSuQYEoDRHo7TJ4w0QUYK12TxCIhE4G6QaeZFCgDVTPEUCUAFBmhDDiqQiwIIiuYE3YQVYYAhRcgiQmEVamEXjMy5bZDZpY4jeAYotCYk7ST7mkj9DeAiQKJ1i4KRU0C08AUZpw4gnER5pICZYYL9ZuAUSuIU3oQjwyy1HsAdH0IdyhDd9QK5FsAdvc4ZUWB1kEDhUmYUACABAyKZrKIZ9HMAAYBVeSp9W6IpYEYVIEAVomBVK2ABj2AVjcLh+goZWgAbC8AS54JoSAwf7iQFdQASTCwzDMIwMy7AcmKiMCpcCMhfAkIwYo4whjJd4/9GxmPwxmpzJmxo6nIyXXzChItMGg1lC3lCAKQyULPzHKznKK0GFdxAAHjgCbyADuTGDsPMB7MAOMIvEECiBENiDLjCDMQgbHMIOHPrKBxiEArGzb/CBB/gGFaiAFyiBJfCBxJvLxLOONHARNxACQBICNZM8yiuBcMiANuqBEmCRVRQ9f0CCT+QAFTiPukK90iotF7CrvbMPdQA1EjkCtEGDFkAGGyiCg+AuaZilhWiIYxCAYui+iUg+iWAI5wsKR9GUOPEtGhCAhIiDY2iT4rNFzgEEWxgKaaAmhbBN9buSNrGFhRgeZNiFayC+3poFBEy/Omm3jcgHZ6gHe4AJ4//hnewCJ5iYne4iCJQQlVS5hXxkuP+iJ1UJAFHgFVWAQIRsT1NYCwOjFWhoAGvAQIfCgV6YBLkwgQxwHwCtlhiIAXDwqAG6i4vyn0kosQKAQWwJsccoIJREIBpLlx9sqQzNsQzd0AfisRfIAScchSWshOIoDk3QhOWYyCyEB2hoB1aYByvckg24hXmYASSAK/34hnCYAzcImSDYget4ADy6IxRpRDO0Du3Qo1NAgf1gSz16MwjgDgo4B74yRLrsK6iJEFFrAQqQRA64GgzAGsrDhQuomhLQBhswgyDRPM5rG137RChoTFSs0x7Chi5YrVHzBxuwNc6cAQUoAus8p1D/aAZq2Al0qodouBNgtMXKYTbZ9CRzsrdhMAQ3oQESwNQyGYZYcIZPEiZfKAqR4EcYOM0sEIAsOE0roZPTzIlmkJzsSs0AKBNUuIVZOoZmABVkmJx4KydYxa5mKJR25IUn2TZ1isdpaD8IOxVTMYiF86cJQIWAgh72nABVyAKquIoGaICIhIaI7AWu+U8FyIECSIYRPAOPdDEW+Ja8WAy5qIVJ8DAVA0mUagwaFJcB8lAgHEJ+pcmbHDqdy6kWyIAlLNhlMAdNQFim+pVWiId7nIBWmNWjtAUbJYEA2IAZMIIHKINnEMMlEIIfDVIIADMgZbyuMjvsiAIfgIIKmCtv/4ACPbIsU2OitvIH7bDDxtNSwirDxisRIegBQcrLSECAxmosPHC06eAB6ogkGfnEF3hTITkCdYibvRuCD9gZu4pMufm7++APVINExfSHFlC/XXOE3RmGVNATbtid1UHObUsmJkk+ZcJOQ7CHyZm33xmG4KIBvqXFeqiHfFhGZLwI/8OlWfAAvkVV4+Q+dqMTZGgGmCCcbmQVK6klT1mIX+OIeiinfMgHSaGJWGCSZuCFjdjVZ9QtUaEnaHDG/jOKVGHPfwrI9HGHrQiAtNgKY3GK+3xIHIjIDTSDi/yDThDeC5OEAgWH/EnedrUoi4Q5EyjXA53QGpxQcYnBmrNQ7P/NXu210AXy0CL0wX4tDRtQgEqwBBrwABIQBadygoiZgKZKIYiF2FlYyi1sh1mYhwlghxlgAghoAhcgjyUIhD/wAZw1w6cxPCCtyiE9AiJ9szczAjSI4AhWEQ5YgkqwtQmJgkLrqix9kCiYGg0hEcKstCVwBYIpGAXAgQvgEHdYgi7QoxPpo87zBwyGU9Cyj7oigDLgMx85RUQiswpYG1Y8AiTImxYQABiwgeKpW0qBBVZY27oVk2iQhU9iJjHRE081p82VNy0221AICb6lgVAI3M29h2XkhmOYhe5DBmNDhQ0QhfO7EjPJRVZQJk/KB04olZaYBXtUCDFxEt4RVvj/M0d9gNXNVS50msdrnEcnMbdsMjeecAZTmSdj+EcBmwBfaU8ceN3zuRVTAAFr2IWJ7F1owIVeUAS6iItOWOVOkIQ1kARdgKgPC7FHuAtU7kCORMm/mNeTMqlxUQRPyEEHulAj9DF+9d5/1anLeKl5sQELoAEvoAFUmICLTaENSCEnmIBGoIJXmIBPmABQiFiWwIWJDIBW8AJcUIAHqI//NQOxo8qqVOCUbTzsoOcppcogUMtHWoJVjOC9UYJAUIIWERrGWzzrWLyehcQPsTUkUIJIwAMFUAYz6AQkyAEkQAIbOAeaKZEJZjQ4hVoaOQIFQCTU46HTiiKtRb2yyjWO/y4CtOEAVoABJogDGLBbZLBbhrBbMamu+XLUZNKUvw3qTdncv92USxWAoXaEoNaH5MESz6ljWbAF9MICcGQF4KKF9EMTR7E/iGC/f4QK5NmUjTDq91LqfDAnZwAn3vHOjYBc8dwtPv6vnigG8iwuVMkCaLAFrFbhCXAHUWiErbjAB1RhWDEFhbMVaGioDeSa9wHeVWYERjDeAqhlwlAEcMiwdrXISTCBSbCf/HmMa7lXbKHXvFCE7T1tBkJtIeTQGwtf0UACGnATGZXRq5uHafYCiKEC9k2hbXYCcoaHTPDWdpgYDLAELLsDq/0GGnqrBGa8JGW867ihKb1nbDgFFf+gIyNYAumoAgVAg48lWh4woxKJAiztKweAABZxDwQApKuZgUjAgA9ZLKspgTa4gBmhIzr6xL0BaRNhTHVwNZNxNUTqWlgjs61FASHeNIwGJFZIAAUQgAEwBOUq5Hr41HuIRyaGv8oxPob4JMm5t3rgBC2+N1uat3nLhc1tE3YTADUGClbBVZBonE1FJ/bi1FfdLlr46imhHUeZiVc1p0KOFHmbHHTMB3yrN0cwFHOjJ/5LCZQwN2jgP9V9Vla5BYPs3aVQi37agJJohQbABW3yVlOOKPhxnzXohElggGQgwRUsjJTDixX8n7lAhALQhXAZ7XotIMPYKNSOINf+cx//g0kcw4wTOFUBIIFvJIFDnwDcfgUqoARZgd9uyGZ4aAUMAAGJxIVGwIBxwLLUc4AQmAMbqEqSdW4IiAII6AOxhBoh7QNZS8zpcMNw2AMhaAFanzyrgUQ9JRGENjwZ9occUILC9Acl4ILKQ4ALSHYEcI9O0O+2SfC0QQJ1EATIzFprT+nUq0wILpE+NbXsA4pQ0gflOttPEnFnOIZP/Qmf/om/Naa/LSd4J2pK4R18O2sJj4WQ8D1WOIZTHQiQ8K1TZZzLoYn8QgaMeCasvgUaSGPjGs3H5ZTJUerfKRTeSYVryAdx15186IneRBWhEB6Bo8fpObjV/UdTsBUD013c/yVsfzp5jFMFibyeu0CENTCBVV6ATohsSYhsFRwMRbDz/1EME/AE/5wERNiowriWD3MMG7yWwkAEXcCEv1BtBOpeG6P61A5Ck0rtYc56C7KMDLIBGtgAGa1VdpiyCcjmqLLYKUh7Kpj0TGiFCcCAVlgOKmCGHMAyndmGChACMzgCIX3uQnyaeD7DwbdDCNYGIeCCcnCHcpCZcmgBHni0SvsQErEaWvMHle0jFwlTIXgBNFCCSsO8C8CFEsAABJiBC1CCZSiRBeABHkiDHXg8QtqCw2yRQhppWDu9AX8i1NOZrWUZvgf9TbMBxXwB7NItLW7HWDByfiOngSAnezjyt/81BE+yB3cnaqU+BDMm6kIxBI2oh0OoB1hQrv07NjXe95+w6uprE18oJo+g67HevmK4BVm4NlnwBXhK21AwR6IeAICwZ6ieI32L7HFq5uiaI2S0ZkmTVazYNWnSaFW0qBFZsYezigUIIKoVjgA4SJqcpQoHjmInTUHDBe1kK2gmEHnKYSZHp04LTHSSJAnRo0fKWCDqRbQoIkwmTGTwJAmcogMsrLKoemAr162PsiIqcFQC2bJmzb4420LC2rZs36Z1u5Zs3Ldk5b7Fqzdv3hY2TtAQINhLqwkTNE2gQqXRBA9eRISR86qaE01ULDd6leLSC28fBD374OIPlyo+gkD/QL0jNWvUEF7Ddh3kCJoSXNxVKVelNBcFNgKVKyGkBII5Sl74M3IlhA9/zp9D91dizpwLPLiUAIDggpALF7hsJ+68hZI2PdpIsSIFTp/o7n20cO8PzXwkpz4QyL+NwP7+/P9vI4gg2OBXIAEfPPGNGfT58wISaBwBQz76NFPPMIboUw8y+hzDSjMcSeMMhfY0o88ww+hjzzD12GPiifXAWM9Ahghkzz0qdshKi/bI0mI9rNiShQBYsFIkK7IMQ6KRS7JCIS20IONMPdJYOAwrzrBiZTNXIimLhSbqsyGM0qQizTUbNuPMh8g4VAwgbk50TTHIAMKmMbOwKc2bAUAD/4gtLF3A0kwstYLFBdqwZFIAFzSAQSuqQHPBUzx50tMfnTDSCQMxJANOL0X1ogsiRBGVUyc5TGKCLl8d8IgiX1XFgqxbvQoOOI/oUkAyspbFQq9nARussMPaNaxbZs2llgRp3SXBXyTMI0AAhlEBAhWGTeAECF540U1k3XQjR7jVyPFDAZIc4cIQgpxyYBfUHQEBGazNi5q9raUWBGpjoMEFF+WEw1s45fSGhBLDLbBEOEIgoMQyHPizgBJVyOecETxQRxwuxSGwhDvCTYcADxD7owQFPLShRHpwgIFCxc8Z8XJyKjxxH3/3fZOfzju32+5/ghAgiBhiEKDOEc69wP9EC0XAUNBAjtyzSD6LnDhMKM2EgsxBNA60Iiz6hCKjPbFYLWM9h9xziNkz2hPKMLEg40g9nNRDNTKy2IKFLccM48wwyBiSDycqVp1l2HCHYs8i+nCiZpFbWu13M74443bYXMuoTyyKNwOLiCXqAwsy0hTTTDHSzELL6adLM/qZF01UDDSzADLTTCDNpGigI/EJiDHQNMDnSSbkUIsniJhwaaaMxCDJqp8+klRTmCiCUw45FJCDCbdi1b2vV1311aijOt+LBLJ+fz6wx/KV7F7JIstXs3Wx735Z9t+1lgUk0DIttiA4IVuX2YAc5FANfIigGyIQwQ/MVYAC1OIFKHD/wX8+sI0qzIENUcDXvV5zr9XYiwNLKAHAABaO3VShBDnYzQteYINOCGc7PBBCOZDDBSV0wTkMck4JMICAH15gDjMsARJs4MJOlIMPMTuCCvGQMjyIQT1S2ILMOHC0inFAHeo4hSDWFTQCHQhAPzvQE4C2DWwMiADf+IAKYtYgI3CgCAmoRyzEZo+53RFr0jARhgZCt3pULkZ0oxDZUkS3GZlNbVzLh4VgoSGHSEQAtqBBjlghjWbYAxn5aEYz+MYKC90REvYYQN0+dIxOykIWzlBT37JktQrpQ24tyhqFOJSPS2IyT7ALAC1k0SYzjU4jrdOTMbIAjSwoCgezuBMg/0yCgwuYAge4uIBJcHGN37XCFJ64iQky5ZNJLEASjHDeI0yADkxgYlQmSOcjPLFNT6RKF1nJiiIUwSvwteoX0SvAGQrQPHQ4ICtY6cU9iWXQgyJUWPAz6FxOQAl2oMIw7DBMAOUQBnJcVA4LBIYlJiEUTGXvCO0yI39CMAfm1IteKkVNSoMQhSPQ8F8BC0EIUigEI/ilHHgQghLc4YoSGCOIxmEYAhKghOYw6AhKAIAPjSGEBbRAASW4wnDKgQQHOYcCeGAAHkwmhDdIYQcyc84RkFMxKKhgCB/g4hC+wcWeqZEAPstPgdq1xqAJYj+nUAd9WlBEGiRgkVST2iLEVv8QTuiDRocwRGERaYh79JEgaTME2harNrT10REDyYVBHMIlbthCALcoUpSyRth6ZM0Qw6DbYiFRN32kwhmwaAY3mhELaVhOtlazWmLxWJDCNgQZzVhE53AbTGdMhBbcsMjprpERi2iNdAGYBQ4m0JJZLNMYd8KBMXCQBVPUBBqtMEYAGmCNdvBpDTrpZieUlwNGjBMRylAGOhThCftiohZNqYUJ3Km9+mIlVlaRwFau0otfIEIXZ1gDA3SxBlYdgMDn49VZmHW/YC2UWHhJC/3kV6z46aUFLWACKAqTLSfgA1wKROAPgEEMSfTEEkGZBCMSMQ4fDIKCQLMgBsMRr3v/uYY1IGQNBKKwBX/lBoVVWJgbXtAvIUAZAUZgwgW288Pq/LAEFyiBEqjIwzlcGTwIKIENOGADBECZy/7gwAha+AUn9iBeY33OA3YYHR+og0BcJMAzsOENbOzHjN/wBoDQOIT+CMJA29grxJI2DsCWMpMDWNGK6lYPUk7IsWsjCD82ISPIxpIfMxJ1Pe4RI1Pzox75cIYsAPEkWgBiFgI4hiqbsckwLY6RWVtlPXpLI31IYxHIiFGJosS3ZlgJlCjSB7NZRDW/2cNve/xbMJtBC9IhFxnGaB2dQqTtazzkJKLoUwBA0pJm4iBSOBCFoHjXAGPQwhjaq0VPJJGBBWCK/8ExWFV/a4FOp7QTEfydBCImcTxXoU9WAq6nUW6lixhAPAYQVl+E56m+hNrAoBlPKMcvblASW8sJcsDHD/BRDXD9YBLE4GgnElFjn1jiEjaAws7yc4clz+EIaeAgBMbQGn1B4AFB4MALhKANLgQMYEJ4mBF6oAAzHOwcL8CBEDZmm5AhwBhjLs5R/eEDJWjsh1k+WAb8coE2IKFkFFCCJXJwjjnMGTpWfJkRFOCzt6YxaHhH457xU/OaY0MdTm4BK4rAChgEbiCpxtBBFncIUucCsvlAmyP48XhOpM0Ri0jbPUSJWXugLReZG4gikWGlYqRSkgIokt9C4et6iN6PMv8S3Nns0ZDCws0QzrikIWjhi93Cwmptc4YftZYPsiGWE1O7RpiCGexmaEQWFrmlRM4Up4mchLva3b5L+OQSlsjOGLWzxgC0+xQT0LvGjJCxUPadDGV4ohcmaKcymiJwEyQDE/BER8IVvpXzHUA9LQUixIA/FYAilEWEKWAA8soYdNwDctxCZZiGvUAGWIAmWIYO/IAO1MDKSUIiJMICyJhHZUCmEEMi2MAY2EyBbMM3cMEcmMFp4IsH8dxs7EHABMxu3FQLhIAQzAESGIEQtIAlgN0zEYeWoZmVjRl2CIE/DIfYQaGVQdmDjAMaFEHKYEAIcIEOocEeBIJZvUwLXJH/e7SAOuQMfrALfiAau/THNwRaGKmhGOGHOtgAGtiANAxDPmAIjMwI49TDJsQS4wQi3RzSppFe2iTS6w2EI/mRjazIsCEDKwDCLcQBK8RBMdhClmQI5miaIwgEQTQDhqxatG0JsvlN3zjDMVxNtMWCrSkEJtkDLLSIQajaHrHJLd6NmwzTnCTXRcBOMcyCKchOMRgDMUrDnWQBdZnETPzO7eQDLxiDCXhUT+AbvgUFA5yBqCTFO6WTJyTDNj1FwenCfb2KrHzF9wTgqzwCJuBKMkRcVVCcVchjrFDYA/oKWUQYBOqjQpGFArgCKCxQBgADMMDXCCwAKSQCKVgCMXjU/yQkgkMeQIAEzUR+QAWYVDmcxgP8nL5wpLykBgfMgW2EwxxUgRCEwwucgxDIFALYYcOImdZpnXCMQw5cAw5oGcMoAQcoAA9EgthhQEhu3Q8lgBAUARoAAAC4AdKIgxB8gRkYDcTQh53BDMlAxxZwABQgQQXoDFv53d8RADbMlVfyDB36gw0MAwycliEwlj3kAmV13uxhyABM3iKQniFU3iZA1mRtXmvdwyY4gqflgqhN3tn84dzUTZHYQjPYwmKyggAkDtqQ0mPhZR/OiODE0txsCCvQgjOoSCxojm05Qx0NRGP5odxgiCPoYdwE0wCIiER0BJlIwwBIwy4M0y44RP8xTsSdTAQ0yAnp3EK5ASc0QANIyM4A7II1EM8kTEJPTEIGKACmCAUioAP8XU9+KYPxFE8toMo2FUX/gQ+BZUU7FYUiKNi+IaAR4SMA4hP67CMEJouFrQWHFUv9XFiznIMCeEIGdEAHeAIj0FgnWEIipECALidPKGcBvABdUSQFzcEICMEBbBCQpUYUfFAUjAHShcMJCUEOvEAKLVlJ+kYnKEFwAFEJLAwCtEAn8IA7KIENaMMRIoA/4EEU0qiJKoE/pJ1zREIP7NRwKEF8bAFSyQcaPEDFaNF9CAgaTaR/+AcaAprQHIhE9sc24Ic32ECDXMOkmYiPXKZpwgjj5EP/puWDlyKS3CjSpnFW4lmaa7FNjJhekRxD3giAKMiCjqCI2ZgaJ9qIPnCWI1TNiURiKmHJlvSSmpgII92R6Q1DiXxpmNrDMTiEnDSfRFzb3+iDbV6E8+HmRLyJduWmS6ACduEAKrSE7MiEMcjmU5hBT2SKjFnCB35j9EzKJORABtRCBswbwR3PqpxjwjFgUdQCOvBqWBSgPFYcgdVTACorVvwKWdxje0JregLLs5pFLdBqDnSAQE6CJXBUIoiAJSwAMCTCjE1CMnTCEfCHG1IkNmghF+yBD/hcS3EQapiBif6LELiQSuYGduAUcpwDBZQAcWBAOSiBKnAAwZZDAigA/xIwDHEowRHYwBVcGYyC2QyUQxuYFRpYVQuEgxJwgRDwwHw4R5BSpdxdqZc9h4OowAd8AxuGkVjmB9F0EbvQ7Fsp2ikYUQs4wgCojScGzgDYA5uKTR+NadRwXl1iXmVNFtpAAuc93kB4GiLFntlYCZD8yUf8DSEmEmTBnqWFgh5+YizQQisO6pYciSqGZiBJzeCIDmo24kFM2kVcm7AV491Iw9Ts0Zxcwy1Bo0YQ43Bu2zU0QLxx127+zjX9jjFcAwwQz6l4Uwaw6gMdTy/kBPEUT/H4VzguBQv0Kj3aE054QlEUxcPFAAIqIIEpqznO44BFa0JZWPy0pw2cQwfUQv/tToICKEAGyJjLjeu4wlgnKGcOoOt+vOwHeEMIZGgJHIEMDpmEBkEf/AECnNActMAILVk5aKEPcADKvgDYIQCLVkJt0JBKdm+W3VDaGcHBWFmVFUcVKME4OIc6KMFOPUzRCQFUVqUPNAd06K97oEHdsexaOWkcMikcBo0XzeyS/gcU2IARvMBZ1k2YDiItNsQwyILqUMkikJpjIaLSPpYicS0iNVap4amMnMgxwFoAoAKb9NpAMNLTbJojbAnxJQkrnSIrOMTq+U3wZcji9LCYDESv8cKG6MMAmI4tGEOUnM61adLd3mI+ZKlzmYmc4ELsIENFkJf4FUMrFMMFZNf/3+7CNcBTN/3nAqyfRx1c9EiCtVorqlgr5BJPGj8COHDuPNmTpyDPUuBKDGgBHf8frbxKIFfFILfKgFHrWRzysypy6yZgs6rPPdpADugfJhSACTABuAYoMDBAgAZojYmrWDwACixafgANAXiDh5aAaQBdS61yEPhAcfiLDYRk0pVACLwAFJDhULJdCwwHFzhV9x4hdQiBAujQEgjBUPJACcyAELTBlb7ADIkdlxWBDVxpxegv91Ilg7SACmADzHoz0NQsza5LWH5DBTxAC7zADfRDBPCD1KQp7LUzanFSL9nChljW0uLzPVuW5VHW0BKEYQ7E5fTaKskC1g5X3Fia/9PoQ+iNHuDUDTKkgukhg9j2TSw4QyrA1jAwoqXlQj50NGJx1iLwwiLswiYsgiOojkRcNHMtgjTwwjW0dCpE8QBkqUvHDjHuQjE0gCzoJjEC5zWFnzQwrieswRq0KnzNWKegwyNoT6p0wjYlQwdcT05cT696p8LpwlLPMecighbEACL8MeoWxVHY01VoBVccMiOrtUIdQA6Mwzj0VwY4pCV0VKbwbicw5EMSwwvcgYEUSKKpw4cKwRFsEGxMqGqkhg/MwcDkwAWcUIYGwhK0gDdUTG0oAC8TB5q9QDmoZGaj2R+I7JohwQKMgPS6A8R0LBJm2TIoQY5WkQ/QRzVHh/8NbFGBYIPMQmkB7wd+EE0cKto2ADdwn0IaFOni0iJiIWoolhLsvImOfOKZIhJ0mw2bXqapccIw4GGGxAilDUMc0MIxgAmj5kOlZYjcmFpBnPeJqAmtzcl3e8mKpEnfFFuvDY7cIJZcSg1qyuYiOMOTzMIxTN/oQAkwWcRLQ3EqUASUEGPsgJs0UFO61c4F4EJLyATwiPFTILVPdAIwfOAZSCfyOLVySmMBGByuEg+v1rE9qTgLYIIurAoigEOp8LEunC6tjCdVLPWKC1j6pI+zOnI+IrIjHxSQm0Vam8UvdIJcm0Ei0DW4ZkAiSIJCypiLLUAiAEMMoOsQxOxECsL/E7gA0oH5FsirhLqB9IYDAuwBhpbAErzAEowVBxxMlbWo+26Z2EWCEqjDWNlAnG8MFBIHfeivVOrQfOjv/s5HXyEBNrjV35VyfviMIHzDE9Qcu+zMgNBs0KDAAxDAA3DAFRDt4jQe7OlhPUS0ZkbDMBSEPrulqleW2UBNq6tWY5WNaoUCq9nCHiFSaiHEpynWp31eKiwqK2TNJVkOK3BD2YSN60UWJOihbEE0MoQCDtPCO1zb6fgSTI8OMtC0sKVCPtA0TVtEmZBOnJhJMU5X4ZJXbnrqLjQuewVFpgjFGYDD/PWXwa2BVEijJ/Dn+T0CQX0FrATYI3TKrRDFrfBx/wFImALWkzqK7nhC2MKvdcQLC0/0RJK76gIQw5M75CQAg09UOYm3AAr4dcw+wSmTZDiUgBvIoITChhvMwTLoRoYGjDa0AMUI+sjCNhqw6IaegxKIg2drmRIwiBEsQTlsGciUQA84tmf/UCTc1HPM3WujgZ29ALomWhiR1BuC86FVkBxivRt+QNgPAXE3wYglAIq0yHUz26ezyYekkjRg3gcbImHOSNSsDSLmKYugVpJgUt9kIoqs1h3tumiWGuiRcB8pzoY4wy2WCKUhwzHwjYWE6XXDCCnVA7LtUYos/mbSQjPA20TcokUMgD7w7d0G296+Duq5RHMd43CWBC6In/9wFmPiRuMaJA84gVPLMQI2bg9OKOdTC4UkVMpTuNOo3Eo5wgrnruPBkcoeN9gCWsVYo4MJvEovQE89ZcUvZEMv8B+P/4r3L7KPE3mQ+3j5D0v6/AIS/IG+V4oluAImd/xDPuQCLMAlJEIN/EIo60yjs4sL4NxIAkQVNEGCQChIsCAZCOrmcAk352G4Ei2q+LN4EeMWH/62WDyHwIYSALgQkMR1gUcni1yU4JmDBwEGBCUQ1CRps2YJY2jQXNzyYCNGjD62cODQ0R8aG0ZQfCPwFCpUMQTEnBJk9VRUrQQEPc3K1SqKNKdcqPBXD4YhfZz0LbLnqJ5aQ/bUOtLXTBb/rHqH7h0yxNcv37lwBff9a/gQ3b1x6zVrZqheKMaGhsFSbOgeZMiSA/fNXG/AMEj8HA1zdswXLV/OphUbJivUsFCPnQ0bFoufoXya29pbNEyaNEfIOElDJouXNFjSBuRbJG0RsubT88FQjuwatGLFdl27VkxaAGg4ihkrbwx9+muSPEmSxKhAok7y3RdAhy4ZohydPHUyMem//zzxBBNPEHlEkUcOeISFBhlE0BN0DvTkkV4ekSQGXQ6QQIIDFmThEU9MQFCRXkBURJEGDwDnl18QbFACFjickcYabbwRxxx1lMCGc3Lor5ZsMljAEiITmQQYJCfppBNLEgFmARuC/0DhAwKq3Oabrq6EiIsq5oACgjDFhCCKMH0wY44qwlmzihI4UECoOB+4qKekuBCihAtKiAQBLhDowaIZhLigJj9rOhQBPmsiFKUc4vSnhaDi5MkiNDo6wgYFPnhCDEGu/GYbAkINtStBTCXABVFVDbXKK63EBksC0kj1Gyg4SGCYAeqxpx596umVsl8hs8cZaWjxFS7NJnMGGducAZaxQyar5x7G6kHm13qG+ZWfxp6tZxFq4/KVsXCl9VUzTnLRB5ljhmFlGFqQocU2e+SVpRnb9O3Vrnx6RcbXVLZFhhdkjnNGXmnyQSaffHhxJp9reLmmH+muWThiaYqhhRbzNP8uBofxtjPmAvOuMeZkTxj5Y4T3GFkgkScZSSY/E0xgcpJJJDFBkiXXmMSTZEKs5cAHQQTxEUSUBkcXAxXRJQYtdJFxQ6QfWcPApB/ZmsQDFPEaRRZMlJHsG8uWkUaqZ9yw6hnRjtHtuDksm0dMmGSylkQWIGUBERhJUj5iOiHykg4SaeGUbb6qkgCrrPwmBIjSLMGHBwy6PCEfGOKinC5LcMANI4541KIjtkDKohY48MeGEpTQswRTlNjImDxLoAmn3GNCYI6ZEKgzTg4kJR2pF9RRYavkFRdkiK6Sl0qrrrapAAJsrKzAHySuGCaXcSHzSy5+FsHLlmGayeUzzDD/O4Sf2GCpbJi5lFU/fcZCUYwTxhxpRrJw5Vese9KSFlwW0Zd6bMIQhpDFuyRDmbksoja1OUYs4uIMTtBFH4bgXzOYVRpnyMIZrJCGM6JRjHxIIxXS0cd0mJOPAVxjETBsYXJ28bHtjEw7xpCGeaBxMh/qrBNrYMR8OkEMRhAjBiZABM2WNLOdAQhAnchBBpIBtAPpYmssSBHXWIAIcBwIHSF6RNR00aEOeQ0cJhDRI8CxtQSFjQUHYMEvUpQidMhxR3nUox7fJoEWHCAHTMhAJ4Y0uL3BrIg9m4+TgJGIHLzAKlqyElesJIY7dGlN4QiBOrZwkMvtIAg++INDEFAF/yGY4RtHcAUSLII6izwAeJUa3h94UIJyKMEG/riAEHCAKF8iag6ESpQQjJAU4g2PeEYwQuJE9Q1XjWpVonLcM1XVqmpmSRDfeIA3TlEldfgjDrjKX7IYkz9I4AUQrKDF/aD1vcxsqzbNsIxmsMWYasViWwMTlrgcYQ97rCVblKmWssipGX5wwhn6YIUsBACv+dljGMdoBis4GC57PKYenDDfMdTZGPOx4hiykAXHDGYc43DiGi9UqcRSioznIEMa11CYd7aDDGPgIAAB2KF5trPDkwmRSZJIxMsSoQUGMGINBfDEJJLBiPf8TBKdeI/OkoG1AiBCF1llI9e2diCl6f9CaSGKGiLYJsdH6AIdJuiFGR4htDY+aEVufGOCWOAAGKktbXB7217NyNez6RVucwssj36hgAxMIgOWMKQrLHEJJ81nEolgpAh+8AsfVCAqU2lcqZ7hpYhwIRA+KMgnIZAGHyxhDpILxxH20IILMMGYQ3GlRZDpjxfMQAnu8IcrlJCTQ5GEJsDdXXDnIAQO9GS2PqmtUI5gBBUwzipleF7yHPe8KklyGyiAQDcJ8AQVwLIfEcioPhzBD8AkMDcjdGlciKOYwMRFNrCxh2DyMRv/WSt+0yrotDRzDwQawryOQF/3IDO+EMKCFgnlxG5uU8H3xcYRu/GNYhaBLxg6gxr/rKAGCGnxDoMhIxrYaUYqXFhi6izCxAS7RndSEVMXGyMAxdBOTL3jHZTtzD+daGIiiMGAnSVDF7WQxM8CBCBGmACpa/iR0pQWxhM96KxdLRoiYhADRJjRQ200gS560QsvgoMF6EiQHLXYojEzKEVx7OMe2dxmDk1xErWQIpOcxLcFZODIS9LxkxjwAm80LlSpYpWVyNKFEIRDTW1yQxRGO1oI+KALpQxHIF7wBjfYABdMWF1Gkns6odRJAUrpAex+WeqaQIQHrCQdRtCwadLZAAlPgeaotkGqSUqyVVly3KwpeSXFPaArU/lAE45wK2hVK1nmdU4GbdMMhdpmn5C5/1ZtzPUr3wwDXdnS1ra3BV9ta3ug4pKWsoSlD3Pr41myqU284PVRbmtLYBfddjPwGcJiyKIYs0CGCBlGi+AYzBnNiM7CkvPCgUujYCc8oUw1BmORFeM7O9WhMZA8n6gyYgRHjMEIbIaInPXMqR8XohBtZoICAJlmXmRjgs6aH0QYyBOKoLIWyNohG3Q1Gf4xgTLAzFUFPcJFisCEzMOmiHOM7a6A5WvbOLQhwT5d6XIL7AtYkIHDIpaQTCLFCC4RMx2/rEiJEMHhTsHMzUZPENtwiEPCcQEubCENENgB5k7LBXEIwQ1dWMIRkGAKUbyAI0hZ7qrrlIMZ3Kl3vpSJ7v9oUtxcrrpSSXG1UDigDiOU4QMfuIpWsMK8pzyB0E/ZvPJA38wPoOABWTlF6U2LhQQAeH2G2IR5BxCB2juCE8gojWRis77M5GMT+dAL+tQSLnyOG6CaqQx/o8UYAk8GgPdAnwCZpe54yWIWtDgGN27jDPc1ozSpGFfuY+F94BQDGbPAC0yjYxwUR+f9Jk6pcyz2nBcmp8bbmYUxtFNj/68HySpuqIbKx9ijAHhmDaQqx34mZ9TIEw7QZpLhraDMq8JqQjyhyq6sag5AabBmDZLBE44GzVJkQVBkQeiIzBREzQbLzVoQR2xgzuRsPzoBGIhKsqTIqWogES7hEjKAA1D/QNZ0jZqspAvSJASqoEt8gLTCZAwOoASqoBwOIBCgwAiY4BM+AbYgTwuNyQyEwNQQQJjA0JfmoAS2UCg6YrZaQB2wAfQEAVacSVWYp1SwoVS4y1Wewpry0Eo+oAxSr5oI4A5QoAVY4fX04RDyIQLaooLYYhN8hVfga1sgAy6mAxl4Lx/yxy30IliyJTa6TVxCQR/CbVcgI9zIjfk+6BgaihVYAQtsQQCawV1koxkuqvxs44IWQR9ewzZgARmcQf9k4RhKCqYYxqUAxoWkQR+WrTkGToa844SMA8ZmQTvO42Nm4RpKIAHd44heRhJGgAEYgGf+Q2cmgRE+LmeCKhzX/8BmdEESssqtdAET8uOqLlBntAAcPMRDHiEdtwwd2gjMUATKUCRBDmSLUOQXMEGOyIxskg7qnC7q6OYho04ZfoGQfmRJEkESGCARGKATNHJJRmABKmEBPqEIHmAQQoUqOi8rnOIpviEiECAcuIADxgBzIMABtqATuGAO3GAO2GAQHsAVSqERUgDwkmLwttAdeiu4fIckesckhusCEuAPzHALj0ABvuJ5VPIqnCd5SoWSvLJUtMt6nEK6quIZWsAQYIB9Zi9c3q8t8gES4oI0Msp7gE98qkMf7qcz3MIQkMEQnEESy2kyMmgxyClZ0scz1mcxjAUZ7k2dbGEWBOAd+P9nGHKvL3+jNCion/pyGmQBn6ZBnqSBGpAhFpDBHowDGWDAOVbzxFYzAlbz/WAopURTY2LsPL7DPKzBGKxBHXsG5BhBI33sA21GEpJBke5mZ55IHZPBBJiTZ15uq5gsrChEjapMQ6qmFyLwPtChF+hqi7Soi8ATQQ5k6B6hFmqhCL6mamDEBc1mjwBpP3KAEWZQPmpQPuRDqmJGBEbBCNKASm4t7UAlmryhC9ikBySAJg+CIHyAA+agHKrA0LzhCSBAHKrBFVShKKmSdEogBA4lDIUp8Q4l8YhJQyEPCdRhD7HEDTWvVE7FVLDkz6wJmvQwD7tim6Kp1uhAEPzhBnL/ox5ioRnyYRii4x7awlcASlj2xR6aw3xw8VeSBdsErhh4BTIuCKJ65UrtQTLAzdr2CdkmDC7qgaNoAaL2rRhWUQAW6C6aBaLYpYGcRUy1RReDkYNkoR5GiFlU7IRQbGEGgGGW9GJWjGFWTGFc7BpwCgdgjKdu6KcYwamSYagWgBhahhjWYMiODOQgyxIY4UicKACTUxIKQGuwyB2TxkCMc6zwkQV0YcuYExze6mhYjquQpgINhAlyYOi+JkXcIOkg8q+gzq8G61cl4BcGScfuBmaGSgQWYAR4LGaA4RJE4BNsQLOmwg23kg4X5xS84QiFwFGOQEx8QFzdgAscohMC/0EFXIB6mOESNEEUgCDwShQjOMALS+K3DqXxcoImMIAMM1Re5xUJVMBawSLYvtIOp+spBrYrQG9gT+8BUHQrBKEMoKAFriAuhI9ghtR7CHN+nMM36oveLhFcJoM46mtk1WLcFqO/lCV/qjQuPoMv3CJ/NOoYjiEaLGgYfAEZUuE0yoeDNFYvWrYekkVLATO+hoE0pYM4YEoaumMXTOwYT+waHIE5ZvOFuuNkZGz/NManUOYaGmAN/qATCkABgbPHLNVSeaapeMZR7+ZuzoARHjAcGSA/olM6maw6reyMUCRC1qAX+rHntOiNoIxBJCRoRMQTzhNBPuRrlIFX21Pq1v8MR3IgB4hBkRx1AGGGU4uIqHjwCFxgCEjFeaAp7QatC4RgBFpAAdyAdfNOAfagBNiEDbrADcruCDShG0DBFFJg8v71BXjgC0+Nd4JpAfAAdv71USrPeq7LKgR0G6rkG+6ADuFQRuPw1ppnkqyJALaJmaBJDLahCR7ACLbHYO5iYeyiwHolg9a3L+0BGWABXoxUWPwF/IaBSrttLma2VwSTFO0pv8IlfxoIWyAqDkRqopgFL5xhFlhhFoAxFWZRvxSxL0OhEoEjwfztfZNxGO3BOaDDhQb1hSKmxQYA4a5BH6RhBiDuhvgPFwDBY1AmpozhPdyjE0ZgcBhAGISTho3/0z3eA0pehoicKjnJsRw9gVS/yuWwKucykqwS8uUQFz/eChwGd2swYQILIG1/DBFMYOhEMBtqoUHC2Ffj5q/KWFgBi1iHSAHnI2bauI2JYewuoRSAwQf+s7tUBSrkcJIapwvQoLh8p3Zoohwe4hsqQPMq4AHGgQpIwQsawQlyCSkeT0OXoF5xYil9iwcWQBu8UAiWAHnjxAgUgA4piQCGgADKgCs1C2HzmGAdBywRGUVZMmGpYtgeQHuG1B5yDxdZdmjXIoN2o4MRZhEWQTMSaGjBxTciYy8MMTH34vcAw5m/px6ez39II4NgUQBsgRX2DTTf5d7OtH8mw0c1Ix+k/yX3YMEZuCFfZKE51MJPeTH+lrHEUKw5YEGGVkqmrgE7RmY7fOgaYMA7LnUSFqAT/gA4N9KpVEZtAWRnhqgcmQSpkqyp1qBSvehAvgisvEoR1AhDmlgCFGHJwsgTXpUFek6u2OholEHIYoBJlMoTlAFX0ewXakEZUATpIBJya+RtihVo1KiJLCHjRsASgjMRhHodStKUM4+atuEJsEsQ2MAfThcJkXAPqsCqrboLPsAFFEcdOCASSAEUwloVsrCVJNkMlwB3grcmhAAJysELeaCYPhkjbAB5PCWaWPR7XyVGlbp6t8GuTcVUqmQqQuUUHiD1qEnzUAUFfAAJEmBXpP/BfX8FF9mlMfLBN+rJEEyYorBlN8hFMvwlF0M7Ga2FMboFLrJNWB5RH1SbLuuhNrAPC/ClGRJMFo7DFrTZTkWWN1ZbLpjtWih7tmHKhLED4VSIT82NpUJYYbCDpQDaOz7GhSFu4mpMGqJqEo4Kc7+xh92DAXRGiKJqSBy1G19miKJKZRLh5LDIqzIaETBhi3VGEhBBPVe1ObMTP3yOq4oGaYzToX+mFl46B85zppOmFjChQnrBrhZyjIE1Iv/qAM4hA2J6qXRMqB2rWfWzFC4hGEryP6+LKkQFVqyieQRBu8ZgD74hxP3aG7DBBVq81r4BBYygBYxBGyjBAiiBErz/gBL8tdiokgNmgCnvFScaYgZsQAjKIQeUQNXk2iKca/U+PCq2spuwUiqwwsNlLVuvgnG8whvSACqsh5XF4BvCVx1wZZfLyS8r+L7kB2nbDcDgIsLkh2Pz6b5SNmWlWYCYTy4iw2YXaptNgxWmAWkhsxhCTDfyZxMy6n+deThio2BmY5ujo2DkOfjomTVNrIUA2uBYSjeNwRp1iDuMoQGuwRqcaogGsCMz0j0stWeaioflw1EzjqCRKogc1ceExqK/KD96AR4pVxLO4B7jKERMgGnAoR9VDhwsZAKNhtdtZmXiNuZMoBYCvKarWBGywUUOkkHQmAX56EZ+wRNyYKP//yPnTEBvJJUBLEEESCFamcEGoOAJMi97bw1U6JAPwfUN0sBURpxGx/wBWgAXcBwUcFwUQKEVxiGW5sQMfUCtRfR2QmIJXkAJmJxOjKALpAdHrdcrSfcPrUmPca0lH+A/vXeSXGDYMGV7UjsfnKE0mYWCtSXCwuVdVhEU89elAAqiQuFZXGpb6MIequX55GeaycseumczT5ZXvK9Z7k2k2q0xaGGbWSGF0E0zK7idHMYZmCUfWAGBsz5XVGgZ20JhnGP+qPaEJkalaGwXtqOHIC44pFumXsagMa4TgLMAv/vIGACo5GMBMI5ZMU6IxJsBiIEYUo6951Fp+gNDwMxrlP9BieqWaZiTa0aEjS6aa0BwEkgOaMKd83/kETDhi937jc7zphvc9MM4sA5gRF4OI6Eosnawb0TAAirhEpbBCKBg3jf+K54AG1DAG3zgDZ4BKxQHJQntA04BBTBlBkxhAnKcEiZAFCbAFEyhBTDiCI4SI1qAHnIAePf1XmnidkrgBRJAAV5ACEaH4jkACcacAGQ5j6k8YrFC9HpNJecfLFLP82TNlEEPCJ+gCZQJIK7kC5XPEDJYzerJSlUw3yJ7iwYsYsXqGKthhiDqW6TPUUF7ofTBQmaooL6Mh+odumdIZb2WKWPeW2mInyOV+jjVu1kyFDJprLgNGxkL2bRmsK7/ccoHa9jDkjoNSa23SdouZIukDaOVylk0pw4dRgy7KN+AsAMkXis74NrZtm15Sbtm7JpVZHavXePFKAejTowCd2IgqbBhE33XrPkzyVKnBX8CS17TNzDhM4g8IdpsYvMjRLWSZZCkC9yB0708eXpkAh26z70QPQKnDNzn2SweKer1KJkJSSZMJFvTq7inWrWUIWKBSBkmRYgUKTqgSJkJRY8OsJCwXYL37+DDi//+67iiAgWITZLkqcMkYokuubpkqdKlVTa+ifkgSNCHU9sQIMg3ARJgIAF9oPANgQQSEGCAHwxxBwGn+DDODJRkmOEGlHjgIQJM+IOGPyRCQeKJ/yii+II5RZSAwBxzXBAjAjRygQAXQpTzghBIvFBCikAGGWQLSKgwoSAEfCCgg/01iOSDSUa5jSBiSBmlkv0pqeUpEJzigINRuhClfwS4QMcDHCRwzTDI2DNMM6w4I0s+0uTTplnOsCKAnhfpg0w++viZjz32NJNPQsjoU8+iHLV0Tz2PLpqRpJDy85Q9hgyjzzD1SFOPPvYg0wwtyBzjjFOLHjOMM4r6VE8zsWiq6Uv5cGJPPsPk44wziQ7zJi/65OOQPmflc40+0pQF6C5muVXnWsfOpZdexUhjrbXVFkMXI4n8kUgnjiXCCGEFSFIAZYWt11gn4n47wgiMREbZuP8xkBaDJ8mA44kum22WgwmWFKYbdY8UgIguJujCmied2abww49EDE4vySQjyRoXd7KGJ9AhkgEm0eXQSy2erSadbqBhwoIbLGznMncwvywzdy17d04OmGBiwgiJLMDtJIlIssAlmlxSSiUWMFMEFCgQgM2TT58iSNQfoPCAIFJTiCQB+n3zX3+nvKAAD5SgIsoGZ3uwgQe3iNJKJS+ISKIRRwiJIhpY2KDEHCVcIMQ5uNCIgIslFP5CAja8wIXdjANJJDZJlmFglUh+I3XUBx6I5OZZZ6611KcYGOAgD3iDwgcfPOE5kmKo/kETUHAwAAycpnJMKs2cuogzi9RTVij/FV2ElT6x+L5RR8IWk4rvmJqUC6b15PLS9C1two8hJ1Flz0qLNOMIViQhw6sjuzaTSk7IxNLMUWU1Ko1PLfW+CaYQDeS9NKlgRZZDvqPV7CJuqd8AkHEWtlwDBs7KSgP0YowGWosvnYhgBBfQCXNJomIWM8Ek1jAJE0zwMY9hBLz+IolxrSEGZ9CFb3TBL0ToizMRlARmtHMAzazGBI9AB8KSIZuI+TBiirANOMDhGxNQZg2d0AxrFLGa1WDCOdhhAW+m8wudBWc32qlZd8bjnS1u8Rc4w0QOLLGAoSViBMAARiJGcYlKHG2N44DCEPozBCVBaUAFCt02vmElKBXI/wdH4MEJAuABUajtkGvLAiWuESK5ccAIjSORAl5QBSEgwJIXKAIPZPSiwc1hRzZogQI44ININu4FRhgElLSUJCc5yUpb8qOA5jjLJxHoFGlIA9fSgAIxieEUSjKQIJ6AuidUwB9xSIAzIDIMe8giVrdaRK94NSo7baooN/mePaThjGYQyhH2qAf0etcSUGHvUQMQ50tyUg9OnKQjrGITLXgVi2MggxXN0NRBmtEMZPizJbCwx6ak2RJOIEOchpCGn3iRq2YAMB+++9Ow2LKRZglrAMG6xi7WYlFn0Skv2LqGtqTBLXGxi12JYEAMCmCxSXRQEusBlyXEBS94MYBblv9RKQPOAByW6sITk8gMIiYRwxg8hzrCwcQZ8IWOigU1GUxFRy/OIDGHIeJiI2TEJJThmug8xwRhjI7EfLgbsHZCjFzVYszWOrPtHOAXk8iZJ3o2ChFc4hIiAIYba3CJV9x1AQo4AgoqZ6AyVGkboQsdmDKHtQNJTRAoyKURoDGBLEyAbaK47GXR1rYGxE1u6uDAiBinDiQgQQmR6AQPhKCNsV2AcAiYwQuM8QIOIKGUpmQcIPnI2CVh7beg25piKwcgxX4tuMBkLC8BBKElRYiPVVpSE3ygggSkU5pFGcn3pDkSXrTTLDkZVkkKaI/leeQhubhHLvSREp04giX32AT/S6hyD0jU6njb3Yg/k/UTrjQjFN7riD1i4YxQMK937mxJLphij/k9hCNssccAz+uQBucjAmkxS0EIWFG2mKUfbSkLAgGYir1gixc/+ctfHKNVYsSAEUa0mMU8wYgMSObGgJmEVhlRwhjstGItREQBmHgwD44GMy07QA5l0xpd9GISqskMONDB1RyywDYRC47PIsiIZOjGE8pQxm4WBuZHsEA62BHzLxRhAtUooxa/6IVat8hF8JxDNcjxxLsqMYJSLKASlihFfBJhCUsAYwFHgNyUWOnHBrHySQL6wIOw4YIKHKEVhjxbI7KQWQ95wAtnw4EHWjBaNJxjtHY7whL8/yGEEiBhCZc8hztKUA4EXOCSL9hD3JCwhbrlVkg+8EEX+jO1VfZHQMfOUiv3uA1iXunZiy4TmLbxgKd5AwrfwMZ+tOQfOnzgG/3ZRhMeYIR+0I4jAtUHrxLlDGn8yZ9/WjdHPrU7RRkiIcx7FPYUdROEQop+CU5UMzYlPvH5yVqlckYxfFUPTr3En8MwMKOaiZGO1IMg9UAGL3g1jPxt00/6aEZb+JEPGGh4E24J1LOUlayM8uIa/fDoNZBhDGnURS8kpWC7MpDSmwrnghcDTmBquoARFB0wf+AyvS7o033pgjid6QCXSWPmR6gmGT/tDMMuyMN8rQEcJgBHaRDR1P9OvGsJIcThT70smxwwjGHYgc4jfqoL7FgdOmg1QS/Q0bI5sxVmb+1EDiaRjETE5xJstE8lBk1oUuj1BXLcmucElLUASV6Yp4CugSJkhAuYQm2iCIAp0Ia2CZwtCyTABRBaQCI0GMEGckM1irbwANcrQQg22CQPbFAOIVwAF1zgARLOEbcjBPvXQnokFFSwudWBDrG/1dzlKEcA6lvftwCy2ilC94AKBMj6ksMGYp3mgiYIAplX2ElFRyJNTvBOH7xohq2KkhF3HiIfxWtJRjbxEntIT8HUsxM4gT325QgOpW5Y4QzxBwv58D2OEHHIYFDx0xFY0X8GMRIZURbSUzz/S8FQixBxIpcPzIJR7cMPabEJA4BhZ8EsMABibqFhcNEsITYXI7VAvOAzI9AJwBBDLrYGwhFjFzMvkuEzWwYYFWRCMVAvB+N2meEbc+cJEQQMF3RmisAvsbEGFeMJF9NmN9QLrTFECpMa8bIAPRNBj9AL1fEaxYEObsceHKMMP2R1uYFm0lEL2ZABXrgyalVnEoAEYqSF3GIJlzACpBAfwGAJ8JEIpLAAIlAKo1QBEcJoDuIk2CCJiLVKSTJdotAhosBpOEAJhoQKakMDmwUNNjBaqCQispciW8AB/rAESgBYQjAHXLAjfcM35YAGdcNrsId8QIIGHAAF2KBtSOJs/5ZHeZZXiUkifmDCSs54IIy2R1DgDdwHBaewbc0oINjwDLPkDXRQARygAAkwcKLCTbJQKNKUTu40KAh2X2ehE/DITr1zD2FRD/eFUOGUD/zQTObTKyMRKEWBKz6BKyMhTgjmDO4FgnQSCqzCPJ8SCpoiDeeFFXahX4DiCMSSD5tQUZwgctLwcu1TJw7hLMWCDBtlc9MiDQ1AUjn4Ld9CDDCJMTIGHBdDVH9BU9wCGEMoCToFVVooVAZzMERlCePiZdhRMUQUHJ6ALhp0MD6UMGvwGeigYzUFGMFxG8mQGl6IDgzTCZmRGj7UHHEoHZiQAbWQATkgMnzHMiygHX93AP/jAGWdMAkLAB/AcAmJUAn2YQl21Q13NR+R8AKKNXmJhTWQc3nBZSDJdQpHoACtQIpuczahtwGieAsbcAseYAw2AEkiggSu2DiuiAZcgDg2Inw7wgORUAJCUFv+cARb8Fm+mCK6WAjPMAR81HwEMEegQyGTh1hYAo1l8Ju4SSAVcG1DUAFpQI1Z8x8Ggg3LKTnf8AxWYGlHoCasgAxd4QvJ0oAVtQkaqQ8OZoJkYQ+cMAzgRE7c+WAngSnZU1DDYFAEZRLt8xFZ8WC+wxLjxDypsJ2hABHQA48OtQglcV7SAAvHwxb8sAmL4J25EIEaRlHzGWIaBS1z0RbTolHwopP/7MKTF8Qww+Ebw4EYSKdznZB0SRcvKrVSBaAa+JIZ+5IZOdAJPJeEYYkIWekJqYEYweEbSiQbq6EvwVFjgkFUvTAxqxEdYMYwJlALImMC1hExYQYOcfcIYjYOb1ZFtaB3fMd3LzMGMCMBB5ADcZkBxJCTDGAJDHB4lUAK9FFopEAKqpADDwBMSGJHk+ibVNJHZNJc3/YASHABlGAKppcFNBAAnXg2U7ABNFAM9HAEKvAAc8OZjTMiHCAESmADCBAISOAPoqQE4aAECiA3/mAErBebKXIE2YY1z4glWWJ5qANLfZSNkogkc3oH2BAETzNtx6YlzLg5LoAC2HAE1zAD/7IgC8hAC9w0KPEXKA8BTtjTErcCKA+xKZsiKeEUKIqSEPZwD+iTcd3zKRkHKPdmJ/MmLImSPv13E1HBKhr3J+0UTgOnKcfSJoSyXyDnEIaiD/bFDxXFKhhFghshDTE4QHRiJ3ayC3PxLD/RM4xQRuLiYsBBeDT5G5ShkwtARkf3FzloGfWSQT2lQgcDVOBCLwojdlDVZm3GZb+BCG22GcnQVAhTsTj4F23GGlrpCWDnhf/yL6rhhZ3BG7qhHI9AMh/zHDmTlp3QGn3HAmMgM4PXGGrELn2mpqOgCXppCW+qCaQwA0YAOVwzeUsiBrzFnGGbXElyClBgBLjgAaY3Af84EABZ0DaVNQEkQAI8YARMow518wKeaUpb4A87cqmrNVqnFQhCIFokAkimKpvqsH2/JJyK2ViRW31gmzl7NDlgEn2n4A3YoAIEkAaWI3mWs3nOlTWC4AKZdwQ0AAMDcDvSEA0f2T/PShIbYQ8YyREJhj6x0BDwSJ4QQRLTExLulGDMc3/1OSww8LtZsX+LQE794xEZMQDXUw+w4DsgVisOEYFrUbsehoLS9BC7QBXiiYJnsQnXCxdusRfpO0ADcBe7EBjfQpQ61bHsgYVCZ5MUxAgK4DPfEi+SkYSFoYXBERxCBYWOAS8xoDC9MXdK2WaMwEEbg5S60FTDAVQmQEH/PtMXOIoOU5YaXKUMqvEvnaF3OApmrzFEPtQLSIszv6AbtQBUOOMGXNq03BGmGcAul0CGf3ZXeTkCOXxXQHwJoHABS3AEkEi5H1CJXpMkxugkdbQkD/IB3nAEJZBplGCoWQB6lYUKAYAFLVABYlBapFYEsUkPSmAESrBqJHIEliqpHACbi7vGFfAMyqglSywIc8QfDvKqdmqJzyZpdoo13pAGZbC5aSA1YgJ93zAhWKKMw5QlFQABSHANCcAmH+kMh/I9fpI/AskqBdZM0oA91gRR2QMq6FYQi2K74aR+BZUT9oBiwgJhnkILdoE950krENYR6/ms7BUWt/sS1xDL//boy8jgCHWSkeV7EvlgX28hsBYlsME8oXaxUYaXk4wADCpFDDLJCFrYUn+hw2ZXdIGRdIAhCSPAAIQhCYjQCTPWASzkF2SUUjHgZSqULwNswTzGHlCFdVAFdPPSLjC1GUPUC/xiG5kxwAgdHFuJCGCJCWJ3lhH0L6ARG56gAG6HDmuADg7Qd2CUARlQH4soDI1IDJUwND5cCqBACqBwCZ+gCY2AAILpOQVCec1X07tpXJYGCDhAqKgwepYlCpYZABsAA0WQBoMwNdigAGhgA72IfEiQAEaQAJtKIuGoBKjmBnGMIhygAlKjAhCCXHiMWNigm3hsmJV7jcn1W2RNAP9zug2R5bWsQ7mcwzqScyCVdgcQII4NwA3OsJ8D0HEa5wxNwZA+8YEj4U4cMUCNshHt5Mq5HJIZ6BDTuxQM4WEAxCa9MwD1wxGQMACOsAkRYAjeuRKHwA+hrdjGg4IlMZ/Qe2GWIg3k24ADgIK0nQ83kBbmJhHNYnIrCC0D8C2AMQLvkYSKEWM/yFMe9Bh/QKIqZoQ8xgAyqRoIYwKYwEPBYQn727EMjbNYp6QahDFtVjGfwbK+QRmIERjrUbO2AYc/5AmDxzD/UkQbzAJ6FzFgtsJimpbPkWU5kAFW6hotowgeXWgi0DPEAC/fQoY8MwJ86QqvUAmN4AqqgAS6BG3/viUIfJokx/YgBOLWjQkNNIA2hlR6IS4KqFACR2BHp3AHp/B6NoBbyMcBjboHUz0iR4AEqOhrWe0PD6ACKlAlz0hsr2RLsnRHmYtseIQkacDii3zIq7ps05aN20AHdOANPsAK/dAP18krxyANyCo+7iYryMCPgKIPMydQnKIomqLL/OA7hiJQmKzLHPE9+9Vu+cALxlotntIRCWEWtEJh/OdO/woq28kR3CrKaB4smv29MIjMgyKD6ytz7asXbYEXOckzORV0v/EbMqaxDQtCCL7c+TwCHctSbVYAJtABRTZBC6BSqC4c/LIxwbFB+UzePwUcwoFEQzeX67Eaj4AJ/7NxG8Ux62CV0DjEhV6owmPEBAtwwxnABDlgBjkz3mmZA9mw0UClAIbXMwwbNMTAk4Z3aEI8Cp+gDZ/QCFjgA03gAmCTR9FHJdT3W1FTbChgBEywtiTwiaJYWRtAmQFQDFugILmZJCpgBEWg47k1Wqx3BAiPBj5AqXAcx1s9jM3n7qY7XEtSud+X1rMkTL0kJv8BugSgOte3DZjjOV9DTE2AStdwBb7iDL7wE80QDaygFecJKwqIFRAhu42dgcSCX8/rO80Lng1RFPU5DNNAQBKZke4ECffgCPxwCKJ9DxFgKVQfX2jRYRpRFu5HED7vnRxhvmWxkf+aYW8BgwekYf8I1BYwAL+MQJSTgesIbd6BgcESBL/OXRj1skNFBDKqccGPAcAXhEMVg7LJ8MAd1DBNZd60Ps4wBhxOdoYRo4YgnKNr8C/FXcJeaHUq3AsRVGgy6tGClwO38RlpmQHKcA7KMAkf3Rg4VUKWQFTswi3E8MOVoAnmMMQpTkeQ9pt79DXNiOG96iCkYww0wGmbhVl0Cw2BhQLbMNPPoA6Js+OMI6k73uMLYvKPBiBRfoyb9wSYaKfbIGnQ9gRkgALcWDoZzx813SCNBiEq/w0+oA5qMgz2JAuhIAvOgKymwirNIA0A0czesHz6HOkruCmfoXoMFxnU9/ChI3uc9A3It8j/3oCKw+xlRIZMGi9k+aQZyvdxk76VCjfxg8RSH0OMjvLlcxarWcGHKB0h04dMYr4BF/VxInrx2oBrEYgi23XNpKOl1xZJvcZrab5d0hiNWDCCUSIGDCQxmiTJkyQTa0wwOvs10QJGlsYyotvprqQYxNYUSMYI0Zo1khB5MtGp04I/xMqaMJwsmVsTb9cwKrxGFyJdkiRNmgRXL17FbsHpeoQo9SN0qSvn6FT5LGHElQe/NtPJlaXdCjJYAt5hEiZMqcdlyMDkF5JJwDo5TySJQScGY6kTs5To0nZSoDSZMgcCiQsCQwic2kZAvSD1pwS5R0+A/fxTH9RjQ5GGxi0P/7dEkaDkFi8mEGUDaBQ4oon0xJCPAHWQeKEFf7bwp0IKK8QwQw031NAGDj8EsUIfFDhFPRPlKxG+E09k7zwCvklvxRVbPG+b9857AIX3sEFRvW1K/ObGE+Fjz5shmkjDhzgSgIGVVEKSZZYAhqEllGFSGYaTYTSSiKV6NqlnEX5yqeeQMO1ZpCF+DLHHEJncBNMiiaSxiaI0UdrEkQj42aRPSA6BaUx+ulzEyZGaeWiALyMK06hFiMrnpT73xEghjAZAJpqMBrjq0qowgoGoETpJhDQGYpCkME8m8cStVCVZDK8/FlCMEUb0Uuwt6Qo7o4BadPGkE3QOm8QEuxaQjv+twx5Jpja2MOskGRPAQSQZz2Ir4LLRGJHWE0QUeSQ1FsINtxfEznVrDXO7NaEXE3KA15IFXMmAFAUWkFexHDKopYMcxvGEiXF+OWeSDIDJIJFOiiVGEmCASeThRCZO4YQ6FgBFm0Y0cQcCFO7AJj37Rm6QvfREJuADQUxG8QEkoPGAEkoKFEUVTZywABomjCBgm5NTPgWJBzgIseiiXzA66QofCHI9Qew7Gcaep/4Z6qmt/hllqLFR+WkC0njgFG++YbDqlKX+eb6V63sGhVN8MAILGKRhhZVZsrDFlmKGcYYWfYqRRqiC9CGc8IfqQbPRhwpiU9GDkFqkpHwgQVz/mk4Jd6YegyKNlE9+DsmonpjsQSYUZIaRJXV9hmmmmXrOdCTMZgovvKhHPU2UKGl23/3SkqSSJp9Pr1mg1OLFqg5Wtp69zAS6np9rMb3+KBUtYmIw67M1rF0DEUSKtRXf6xnwJJlqJ1vrLb1MmAQdXUzIHi5b508kLU8K6AWR08AJVxd3EctBqhjRlsoU0ICK6YQCaKAAVyxgCQswxwJIkUAm4MsSv0HOL2ADHOAQw4MfnFh0EmEJUuCjDjqoRHc0AYoLPMBFJSIAg9jTNWwIyT70gdoQtoENH1yjPwXygAeqoYkVgmAFObohfMqgACMcAQ1Kg6KFPBRFEG1BBe95/wKRaogeHskoZV98T9TO8x4GrSeGXxRDGdoTtgb9yD4sCpmJ0iMkFz3hA2qEghFYAQMYCIAWsqDF7hAljV0IbiW5WESX3LSIe0AiH06xU5g6J6ZN2IMiL+nJAOSUj9jJqR+HGICkDKEQRyokF/qIxTC4YY9YlA4ZrXvUoxwiyUdNKiOcW0QoIzWAS0FqALz7JVM8NYC8fIURZmnYAAmzPcxMYg10UZglSKWXSSTiD5ixFV8kYS1umoCbqbKEAuxyPbYk4xG1UYa0BjiJyQTmMn9Y3hpqZZnheOIR4OAfuRBhrtoY0J/ueldiZrUAbSzAoEsYwQjEea+FStM346iFwf+YQKpEjCARxBhLdrKDnQXUoQMd2M4CqpGCSBghDSkj2Q2/SLIdLgiMKXvPAxQADUpsgBKguIQFRNANUEzAAkcwI8nUAQWiURGKUzQqh3xQgR3yaI5Sw5rUWGa2D6hsCCyrakpXeooHsFGrKbXj1Mjznvk0zT5P2MYRjACIBOBCAMcI3CtDUsuZZKRNYarHQWziJk40CiUaQcpKDHeRhkSEKNewRzP6apOJcIJNlTxl7Caij1Q4oyC/bMYwYCEQyyHjrwipB0ZiWZKL3PJ2kdoKU65xjJCYxB6f8goDondRsXxGfgWAywJCgxd54WV+v7XVCLDnGV0tj5uKwdcIypL/KhMQS1pvactk2nIZWxVmW79NRgfQ0RrU7FN/ukAHYoplgvRRxp+3Mqg56EGPJTSwoK6ooAQlyBsmKABgk7BENbVziUpcYhTaSUQpRkEKS2wHYfx1RSVAUQpXPOAN5aHPjkrWoBeZkazyQYE3FIALSjQCp5qoRjcsUAdR0OAFTXijifCjDiMkVWk2kJCLNwSFCnzjqldNUYpN9A0vmijCMFSPVluEgg9UwAdAds+EaWQiFREJyOppgjpeoAA+IsNJyGilRPjkCJdE6kuOMMQ9cjFKfhzEEJ7TCKd64oiHrKTNbRJcmgVFJpcY4iERyKXseJERyjrjUZxAU0U2R5EI/zDqIJGrVCj7xI8b3HIA/VgE4JARTF8ShS5iuVX2IAMZbkKLNAaVZifkmYEBYpMRftmmN9WyTcrAphN2OZUkcqCa7aULMdJlprYYkYPwbYt93nuEMljjLnc9ojIFgNdzi7WGW7crB23h9RLMwUAFPJBWF1RAQ4EDL9iAJgNhKUUlRqFcYlxiBJcAtSUgVtEFaOISpBjFEdxzh5U+rUVz7Nk3UvoBkW2xBbgABShIQYoUuOIS1bCAByzwAiJDTWUpw4YRfCDjonGgxRTPEAe+4Z61ZY1qH/f4yLqG1ZWiMUhFgkB90LOyqpY8a2iTKtU+QG8kjagfMKAFMpxRWX3Ug/9MZj6TnAxSWIYQzk2x22tDvsQPQGsOI/fYkmFjV5DY8eMaki3TmWKBEGnUQyCsAwrhgIIMzQ2uJGQ/e5ohdVrRAu8agBDJVfQhDYzcxJjPO6ZosHkZbYnFopgG7m+jc6pSZ2Z5kMGLQZfLlgIgIlqthhVabnUrhQXXmNFppifA4QnwessEyjCgJAB6+IACdBII1IYrXMHAsAAHgeGUJr4UkIO05BdipfDvKEoB4FFs56LEEMElSkFCV1CiEpGwATbKKCMYColGLaphkE9hBHNEghSaqMG7vUADD4BiFgxXqYk+cAp1IBXjG0ID0s6PISjAED4qHX+MvJjiMuJ4ZEn//hF7yrYero5xi0xWj+UbIy36ERl5mlNAgSAwgjiAgWtIhaTYhHs4hHuIwFL6HArkhwEoMz6DBEFjk0cppXvAs76KADvbCMtJnCdZhC1ZCJ+TQEM4s5X4q4U4CsHpJBYcCi45itJ6tD1xCV7yJaHYnUnTnV4aALGoHlLJJmjRC3k6CyW0KGmavOqan11hrsJgl9qgFcVDFfIytudiC2+avCccgWuaplqRp7fwBE/oBf5pDU8APYCqDPOaQ/IitmSgvVfrBAuwhDLkoAxAIEAUxE7IgVpYFVKxhFI4txHQPRFIBBE4t4jJjkRwhREgsErQBkpYhgzIkbG6Kvm4qhoS/0WsGoIb+oZn2AYxeAJBsAFVIAVXEDhNSDgP2IASeIEga5AP4BoHKYL1+5Aj8EUM2biYY6mrOSN8E7JPhL9cLDlBKIM0SIOm+URB+ESPK6un0potWhlvQAECgBtn6AeEkCXHmok3sQdI2MDYsYdypImZEB2DgImF8LJcGgBW4AVpgAVnCIphAApnCIW8KpN7CLMzcwSBhAhFMRygwCufQJMdBMHScopHOaVhghSTYIq5u8dguoa8GxVoWkJbGQ2w8LuwCIvqQcK7QBYGaJ7CgAu2YCdiWIywKIvA8CZOgwuVtDy/wxXleh4EepUc0AVq6YXN8J46FCBdEbXxqoxegP+VP+gEsDCog4oeWlGMC9KXWjiM/doO4SOF4TO3S5gYS6iBAquESkiw4qOET2iwUxCD9zgFIpkwHSuDFkky/auAFxCFakC4ICKB/diAGTACb6ADHnuy9zAC8wtGf+AAYDy/J/KHpSKr5ouhuXy/HqMwuJyP9sjMzPyGCgibyKQj5gNN98Cx0aRGQdgGFCCqfEiAReIyO2OJRAqUQ1gEROqJFvzAdzwISDAEGBiAMLsBGDgBErgBP5u0zoIFZDiICMyFTQCUMXOEMTEEQKFNiUykL1kEm6CcR8Gz6NyERGqkRfhBSzmKXeqlq6CKYbIV4/E1+eE7Rrgm6hkoupg86oL/T+RhyXW6DGmxlltZjD9ggAJgNfaZjOa5FcCbvGuaH8aYH+iyJ/4xJ9RYw5pkygICyThUBnOZrk6Ylf9cgGxbDECEjX0xgQxAAkxgnwxAt0oohRYlhRAKIWBIAYgBhnczhwSrBOOLhBeoIZYThOizD/IgGfZwADDSt224gw94ApdpBAuQmSygxSwQgBkQmi/SGvkov8TUECcKRjTwhvjwmSDdBl1cGZYDua2ajw9wgRj5KpiqMG/wGG9AEXzLmpWZGpZRm8xMG0GggwrYAixQggEYBtshLO+UR5TIhzCZNFQ6k4foK0CriZsrAgMwAAmgARhohmtohpAYBo8YBs15/wh+qKvdVLpD2JzbXAnJYkeW4JPSUghKMqytEx5huonf+SXh6QrhgULfqrwlpA6QfB6SpE8FNZ6SrI4RkAR4ggsTQAfPOD2oXC5XEShseosF/Yqw8C0k/ANMW4NiidDTYJZVYZ9NK7XqSgZdWAN0YFZXeSaDGguD0kPfIMQMoL1/oT1GEIF20wZmCAZhKDAR2L2JKQVSuARJiJjtAAVzoIRMjIQceIAKmI9qVI+YYxAxSBH+Ow96E4MXwAEPyAICuQUSkAUBgAEaeIDBfCMea49vQALH1FJ/sAGXXT8fsEwCeAIUocYU+5mKtczlmyP5ww+mqQ/5iL6atcYkC8AK+/8AlRWEJoAAKLiCG/iSL7EzChwA0NkEGKyIMAmUNIkkizAERwhOGkADSrUBLbABOpk0V0qlf2QTijizh4CEe1gETphbrK2HvsoFurWHCHCEXPicTQDcCWQIQPHOVsUIPOGktUsty7GKpRALj8SovfsMUZvChOpJ/8QVy7MVs+jWThDQ5kof9PqKwiCgtig1yLBPvUiobf2KkATJATIBZjkMcGiWApIW+6TCthjK8HqXYHm1fJGmUGOfQqwFEzjeczCBAgCLURC3/grL7cjX/qoEEVC3g3lFSig+V1iFBZA3e0uPm6WqkoMaqCKAO0ABvMSBW0CFW8CbWTiGYiABH0D/ARcoRSs9Gyl72QqxOC2FAqn5uCFtozs9o6zC36ixmhPJIW9gGogLozP1uLSRWKt5I0FwATqAgiPohwQY1JngBDOplDKriNlhVZv4x56LHEMYgARgggoxgCeygQ7AhCK4BkNwBpJIzk5NE6SgCFU1szZxx9xkiOhER8B9CTJhiBnsMtuJFIRQiqKgipvgBcuiu438O7Iw2GzyDLOoji281pH0z09LKGPyDPo03baAFZFcPAuVJ8KYn5O8tISSY5H8rVaRDHQ41+cqoGuJFcwIQ3V9jWL5A9eLPb3AhEk4DETwF3/ZF70QNyISgbMgBty7hALjr0tohBCyhEpohExM/7BLKIcXUBHL3Mwn27jzuNlTgIJzmAEBmIVZKIZiiJJrEIAjoIMVkT9BUIGLu5D12wIKYcxgpF8CUKMVSaLQTOCaxV8vcg8jK8AwUlllHpKcrdlTcIEm+IYXiIMrqAengATx5JM8odu80ocxm9s00YiDuIEG6AQM6QMDaIEXiAHiQIRf+M2MkAbTYQiLkBSqbYlRWkh9oM0JzBN0PARDmFtIADOqBZQ8cQlXDSXD2oQBiAAgvBS6ywjGgCYFvZW4WIPqiN2ovJf5qZW7Czy+k4TOsJZqHQFLAGksdAvM2B7WVVCx4NDI/YpZGYu4mIReuF1iq8kCAp9ZKYx02i50yP8Bda2FQOyEDDAYRlgVb/GecfhdZUiLuQi3UpiEAiiAiqLkBQDLrrwEYAAOC8hRVxhYEQgHIyAPrUHNnhlTl2NTiGPTb2iB1QoAWigGWnhlY7AFoNIqkRkCKECConpZxFy/sMkaGwHSAzbGGUoZO/KardkGGIkjlHGBt0GP+CAZBinfsdmhlHprnT3GfAsCOjgFfygEbi4KN3Ftg7AHzXnb0AKtRbi5FvYHSn1hDuCAA5BhRCiAXyACGKgHfqyHyuJHpLMkRjk6gQbIIX7uu8rNO9EHfigzeHwIlZA6q3hiSNEHqwiefMDWJTCmEQgNYkDWsjjWxItKTKOV4MJW5QL/abhojNlghM6QBMwtC9MVIPsOLr9jN5GUY7wTi2QqIM8jDFGbw/CxFaE2l15YA1eTpvoBBm+bhEJMZF1Y6loYh0nADnSrn632IOEb2ErOPpwasP5aWHfrr0gwA8U2o+WLTBnabP1DkfErsiM4AWNwZVpgBVmABmOYhSO42QGUPl1GAmDs5QoJZhlbTC31AXVAWhO5WR2bkRhKMgbBhrrsGfcoWmZ2gYfFNxunS9OEIXwTwAaRoZTpU3/AgitopDG5zlfFq7r1TZS4AUc4gdxGAwrZAgPg7QM4gB/4KEzoAAkIBU6ABYPAsmFIYjThs8n6TpuYQFPd2zCrpNdmE0CZ/1si7tp8yAVd2oR+qJRcCp3cuR2/8635VslTIbwFbe/fesqvAIu8w8nf2s+4eErlah7Ufc/RWO/wcV2RjG+QnI3K4E9vMq/IuxXCqIzwyp9DZARSw6/mUQsML5/lfZeIwouWFlCDNViwFgGCFYEavYTdMEvt1YRKWIb+GoUFYDhBQCuXi8t6X9ofXRAVaIFioIG7eeVimIVrcCHItpoyZTGZ5d+Jw7gm98UHUIcALuAzTSmoWhshQ5vHxhFkJF/5WBmpungBvlJ6Yw96qwA0WO0yWdxUjQjDSpNcSIB8mKLeloDc5nMJkABFlmFMyAZwAAIYKJ3ZKZ1h2Lou6at1TP/iRkE6rgWTnjOEYfjuxfqSe4jOqSesulUIn2A6zhFVNuGHWwpwuUBCsmCAGsCeYyqVEaiE9i6eXU8obRiBJWAAbsUokPYMSVgABjAobSgL2eWWuODI8qZ1AZ9jiyoVzEsVzVDdw0e8A4WV3E0GdFCXyriVPwgNWAENVulWcS0WQoyoxQBLYsiAs5gYc0v3/ro+giWFT5AZTtaGVyCFV6iE5GPG8VuRuqyPpgHA9WiCI2ACaNiAW/AjWWAFwJyhYt6x91AHdYixjENsozoChU9MKFCBerdMfWM+Af4xMpLMFzmFNHAbM7Kw9qjZNWcRoHmP8WtaIzt5SeGTR4LBMon/gBtoBg+h1D5ogQN4hAOYEDRAg97m6o8CiF+IEGESsEmfI3uG6hnSt7DhIX6L9C2qd9CRvgH5DCmsN0DfsHqL7IUa9tDeIUOQ+G1ytIkly3ybFm3KF8HRIpgaD46cOGAEI6ALOiVixIARozUMYhxFymgB1KidFvwRGrQqVElOt64psEaS1qcLGDCQZGLNmU7JOq1Jyqjqn61O/yy4OgJqp06MOpkwa+Js37/J1qzJaymuiWSeTGBC1MuTp0k5/jDJu5eRCU85PPUqYEJy3lrjJnlKZGnBJEkFMli6lOjSgkqjNFWqZMkVKXPaRJlrZI72JRGqbAgiIKj4NgIflDNf/77t23IxBJJTb+7i24sSG2gIwGKrmLEjzKsnF3SquBEj/tD4a9/ehvv48ufPR3OEPv788zmc2nYe23IBKifIc8t9A6ByT7igHHSnCOjcccY119wpDnhjnHnkTTedIA9u+AE2xxX3wROCfPNcdQEK0gQESFwhUj4YPTTADdfQwEF7Bui4Xgs2IIKjjmhg8kMHHSCiyCOKnAFEP/UgE4s9nDikDydR2qORI8PkUw9I+jhkjz7IYORMSPXUMwyaITki0j25WJTPIQ5txM8ALCHEyUGc5DPAMPxwyaU+DIyQyF1FMUAMUIkwoEUMl4xw112uxLYApHcF9egC2jBSFCNgGf/VKVhhPRoMWUklw0Bba/zR1qWPuorpo1VQOmuijEwSmFlu9dWpCVHZmlhiiwWW1wLA5HWsJ5LkYNYkkxCbQy21dCJJIsQAoygxl1zySSXdkKLJJZWQ4oo2pmjTSCWguPJKJZRo8soFSKQxBAH12kvAKffiqy+/En5QwQs44CCAALLIwso1RqSBwgff3GueIPSqYMQL8/WoH8bu+cBexh27h4YRFUCcr4TFSVccxBL6Z6J09z5HQIjnEXDyzP5W8MA3TxjnYL80t2zvyPV+8F9xMKNs3DYAu3iPIYvcEMENA2BRsT9BrhekBD3GAKQ/wABTZAEDHRDDL47cw0k9ekL/wjQ/uTDNkEMipY2MIc1MZAg/hgwTCkN9NwTJJnhH5Ag/K9W0ySYR2JQ4S5tAIhNMm9hzkCGDFlVUXVolIsmiZB1FVVS0BvXUpUssYOhXWv0hySSdXtbJo0vFkNgZSUnyVVWj1+UqVpBSStejb63xl18mYGa7ZUOZ+hc4iKBjQi/OWtKJAn/8cSxfrDebqwm1KNKsJZsHlYgI2lbCbrigXEIKKZTsVpsr5lzyym+gtILEAzJvSN0TyX3QoSCk45zxMEeA6jBCAgRwDFZIAxkJ8MGFilYdlA1BEOp4AMc+ph6P5ccHOOJgxtjDAW9UsIIfIuAEmXOc5ZgnOvszDjaE/zYh5zwDAhV4RgVPhI1t8NBeHvLfcUwonfJM50TQac4HUPAAdSSgiXmIA9V0ZICqGYA9QeIAB9BwJAn4gwPEAIJpMNEBHRSABZigQQTihjZD3OMQbGtIPfKxCGToI23DsIdI7DERNDVkIXhsCOHWZgiZ4GkjkDtcP16yE5nsKR/80Ecu8kEoShHqU0AZAQOEIYxLzooUmRqBNmgFKW2MoAqP8tQlkaKVsCzAEtpQVKeSxaoR/IEYlGKEKQe1yVc9KpSDgpRRptUWsAzzeOPLnAk8YwJdJAMRmUHKUOqiPL1kADO3Q0onlJGa0/2SfK8pBTg1oY1PaKIRo3CfKUChCf8LfGIUq9AEJRrxCRy4ImRHO9kpHCaIMkhHDGV4mITE0B98fWAQW5BGA1gRDVbAwAgPQMF0/jmdU2AjX/Q6kAJa0J4tuAc+IMSPD/zB0Y/mhz0PUEEXTqG/ftXLf/VCgUrrNSIGsVCFJavXeb5xCiikYaAVTdkTziNU88i0ZjMdGlGFSoAT5VM523hDGtShjvhUEQ1oqCJWr6pVA7SAA48ogAQO8IMV6KADKTBrBxSBiA7kgo1rc2NEXhKRkbjxIXDixyE2wrRhVOQQ9eAbXO8RuJfkgib8iIAhNjGACEACcJBYRARYQpPJsYSym7hkJZvilKVogRifmxVU7rK7Vtn/ZSt6QcpXUrWX0DIlGX8Znq6OQsuiXFJQWAktVIKiF9USBleFMd6m9JKsxXgCEeAAlrP0MhXs5SUHmWFdJ3IALWCEb1MjKAUwPFsDz1YCXbV5xSUasQxKVEKc6PpEI0yhCVXQwwZi+IAAi1geAr3QpQJCzgeW8wAbKEEWyBjGDF5woeVcaL5HK446kGDV+ICMpPtxcEnbgwZ1fEMdJrLvdIawnGeooAwEwoY3vHEH86gUohwa4ADJs42GCQJnSFyhys4ToQCdqF4h2mGNnfMNh+X3G2/4BgQ+GJ8t7GiKV8Vq1arWghYoogMH8EQKUgAEYDBhBUDAhCIKcIBr5G1K/7mAZNzq0QwuNaRKC8nrQ8jUjIWcyUz38Fsb8coJvOWkJnHVx+HuUZGKaAQSV7LHowiVWQbQNpMMqIGgRlCJWZkOt66qZOxGhxSguG6YoTV0ruLilERAOni8BGUpMBtavUyCGHoxHqsY0DrY5ZYRyWAWIpr5zKdYJhGkHkonJlGAV0uiE0iYiiUQlYhRXOIoIkhEKUbBjFFwSxPLqIQ2VKGJT1jAFI2gxCdM8YlPXAAXL6jAgkz2jQyd4mf88tm9hnCHCnAABtfwhTRg4A+GwXBkEBtCcsSgggNmkD1HuA+E24PFgJeUA0vwj70GerRToCAND4DAwyP+gDRAIA1pcP/BhVjaL6FuwxvnYaHMIDaIiNmbXvbSGVJDBLSlVqACZQipfHREZJlLMUhYNQAHXtCCMygiW6QgQgpIsQKgFwkTBWDCDdrYRpXo+W16WsQ9ErKJerhxTRWJxTBgwRCTdMRtbjVELgrHEsEmznEuOawj8FaTSL5kETIBJqU5FxSlaEEYgqJV6EIHu6c8KndAOQqnUhcqrVCqLIQ5/DVbdUvZwmoESxCtaDVN6basRS6rrctXBqMYdMT6L5fR3Vu0opdeIwValjh2URw1gtQQKtm1eT0ztEHeeGpbFZQwBSXi+YkSGAEKPFvOvRqmoaNuCDkDOoURkJAAViRAAT5gmM7//FOeGh94pxjMIAcATnAcLZjg83lABTD8P0GE3xs+eID0T1GGJuyrP96YuA/uUNMBoqyHzrlZcijEwhsfraYuPbBM+UcTNEEajFTMGcDM1ZwCSpGStYAu6MK3dMAJnMAKMAERAENZFckvwAmczBncJMQggSDcVJ0emcne2M1flQkbrWA9BFLkzIki3QMk4FnbxEiMUIRE6MMCOAo3kc5YVIvd1cAOglKmTAopmU6lkBKmjE6icI5WFFMneE4MJAWqSMIfoEovMUCoDYrqKVopiMMILMPjwcrohIWmbUWi3FJSrEGyPCBx9dr0cFOg7cXmgMUCSIJmiMBpbMprVMsk/zDAAoCLbJDXK8jTMjibKKiCKIjCBGTBBDTCI84AEkDBvngYv+QLU0WMywxVvuQL+XFAvMFAC0BBE4AcynTivkiITnmDDWjUevjDA7giwXHUFhig93HUEXyDCuRfJ6rAKTzAA3jDN6BAGSBV/jkMyTQBREXQzgiNUt3LKbhABdhQvgxNUS0VAZTBy/QLUbkMCrwBATxAzFHRApajFG2BVmXNQGhCCpyABdDACXjAlBVJB/zAIwzDIbTESqRERORVTuRjHzUN0yzCQqBNRbjJQxgCXDFdSkQW4ihWBOSC4ajEABzCAChWTagdS0iTpMnFCGgBonHaoyDhAjze7lAKrP9ARe7URVOkVqi0BewwxfBoHmEkRaXIFqONwCiE4avcEi15ylZoWl3oRV1chmslizO92looFyDWBeZMC1j0RQ50QvjkxRpsDjEwwvSETylcwrONwig0wjiNkypYm1magijIUwngD32tmIBoCDNO0HEMVHU8ABMoARb4wxu8pXLsUH1tyI4dQUa5xxZ4lPdt1GHKBxSowweUh8eZnzeUwXn0UPBhSHzVy4KIQY0Z2F8SgAtAQDFOSLj9ZXWMmzoIzTYM4B3YImLmyCtaUZK5pgKuBxq8gFpdACi0IxNYgCWkQDYQQwcUQDYoAtIxBCc8zkn4yUiYyZr00ZfoTR2ZyUP/MMTbrCDTCFZGQM7jvMThyFE/PM6ZOIJM6GBoQVpQeI7d7VIRLloljAAY+s6nYZJ8jk5Z2I7xEAVZxIAknMpZXOEauAogtgqmtGcwVAEpLcOrwBIaOsUlEYUlWAWoZJ6nEIVPUpJThgUD5FoGHBtqEAO2mIa2hFolzA+zaQLufQIlqIKKmgJaPqK1zUDvjVvKFE2+xIzxAd/OQMwOjVwFoUALJMALLAzw6ZS91ai+5NM3uAESVIy/UU1iymJi+kAh7JMYtBzEwZRfOsxSuRDJlUzRSIeW2lg3Bh/DpYE30Ju+WCJLDY3DPAMBVEATBAFryuY5kqNImaNV6YgE/EIL/2ACA1gAEYDCBK4AKVhCkWQgImRDRcqgI8BVjIhE3LjJIShE1OGV3JgZSijdIUACXOVCYLkNYhkOJITdTLwEJKTdMHCCRAjWEtZKVv7SoV1XVLRnKKFkfLrK4llSqtwOWHRCVcjk4aVWXNQWZvUST7anK+ik442PraSG7bTFJIzA3gUaWMSFhCaDrSAFbV3PHnKKUyxADijA6fwhspmGJZSCJXTDiDIAKXRXJTDDMpiCOaSlKcQri1pbIzQC7xmBwpUQ/YGYX6bYEwTIijVHcUDBC+TicVCfCTUmitVMcuwYEiypCOlcYvoDlBIce/hAF3yDC8DUxIUf8FmYAOXYDP/N0MB+CM9oSHIMAc5swxBNSHwRiCBgg0BFiMtFgXtMkWzaaQZNEc+a446E1QsggjmswA8wAQ0QndEB5w8AgZbVg57hFV4hREbUA5isEXa+WUNwLdw8xHUGluCcjT64kUO20WKJnSJtREbwA+EQSqD9naBhkjDUgDCEyw4uAIJqwxIEwxI4SnvSqqKVkqd9SlncTn3CTn6iilucCmnJ5wg4CimBoRgi6Cjk7RIIymbJXa1c0qOpkut4AlEmghxK07QyKCNkQAYU21iMQqgJ2iiQAruM6CeYwyssQ7bdayOoQiO0gihEAiVMACU0QO954jPiC1GNm0RpHL8M48TNJfL/Hq++0CjwqQAUqAAS4MgLOKn32QfB8ewr8msFYMMDSCONtlQTnO/KnWLRMO8lkl8aQEEZaOmBSVTQxIwywtyO2KnMiVSSAe3/JhmRzRwVoQEHSIAEFAAWRBkpjFEHAEE9JkIB/AAiAIEiYEEE2ENkpcRIGELT6JFCVpadEWTaUGdKCI5CLl0/Ag5LqIQhMBZjaUAEbMIhHEIuvMRNRNYMQ8IvWcpeJBqy1d3dwQZUKOGnxcar+N1mdUpqgcoVksUTPusIsEEqEdqrkFIwgFJ8HkVLdsoIKIVdvApqmQWzTIKhnNblEEVwzQUj5IAr3IZOQgUDWIIkXMJslEIwBIM2/6QL7ooDvZ6LPOVrI4hC7vFeGiysiIzHNzzDjbHsyZLHNwRB/rSljHVIitXM0ASIGJjHYiJBC6CBDXAvhHGUkGmshN2MD3zDICzINvglEGXyzDjINtQsLRssKyvHyr7Q0HhcKovfh0TIM3zAEFTAau4s/8amxwhtFS3ZCwADDTCBoX5NAXxNB1hCAcSALnSALhRBPyzEADANRRjSRcRNHQFS3HitmfgVG9UV1VGtqbYR5Dik2h4WPR8OPxAKA6ieJQlKJtnt4AauFldCIoyh42kxGL5n8MgW5ygFTB5FDcgkF//npzFAFrfnTj7bMmR0JSDoo5RCR1qeaDFCKL0n6P+Kyi2N1qDsBaVhk7SexoiOaKgtgIcuQLJpQim8wijc7ioswyrsbllCoingACRCIiXgwiQ+DMlYYzNyI/P2xzQmR8yUB8m0UPHhVEtFbIU5X4+IcsAZZpQ+1FJV0FSTjECx1IiQjMYpHFrfizoIKVo31b4kB5y6AMcAMC36L/hO0V0PcJHhdRVlUVa1wAvYACVMID0WANh0gAiIgC5gMwUXQQJYZBpphJRE1kREwCJMxCZEHdX1DTsHFj+q8D3IAA2PNiRowCHIACToMGM1DneyxEUCKBfT56Fp0nVp8SjUxgJcbg8/CoLubSmJTkvyaqoxxRTy1qhoLifR6ihktDb/aPROjtZWMC7pxKcq7Sc02epUVHdwaRY2ZUBtlAJulIJtXEKymY8mgGUwfII2lFNZ3mtQs+ju4sAElIACHAG9ObIgDGz/6DIBPEGIfJhUA58g+EB/DJSK1LKH1N8xEkAX3Pf2Xqz3XuwYoMC4EZCO3VdzHNGNkmbxbeaGLNUveoPIGEcFdcg33MGbxmnQMqBr4keLLyD/ctWOXNWSYcIEngAwFABiT3NiY3PYFMAjcPNOUISR15GUULYePep0cgTY+hV3wnNgrUTYxVXk4NWeuG0+bCc/KBo+E5rn5HMmaZJX1kYY2nEcA/eBjkBFEy4YKjTiMsWlLEUN/GdQcK57/7I5A1QBA2hDc4+TRreTn4MhbVkSfVKS5GqxUFCLJEBFsiLoEgiaFW8KpU+aVrqCB5xPd+X0MrgueLELM3TXK6Aoi5blBLAoDgQADrTCquOAJFJi+7qvWSvV8fpHyI5pjprQ0USvvZQBNmCDOqiA9Q42VzsYB8BHBjkYGjwURekLLwLgPYW4rI8bKgINJraYjHWiIIwcOI4BYSIguG8UAiJzzdEiuN91jmSVAi7ZAVgCPFoAYtMjPerCD/D4NX+VLgBBAjjCAGjEIgzAnhFkC9dJ4ETA2yykX/EjS2wqP6i2DByCBuQCDEdARKB2RM7wQzqCDiMOpMCSbG1KPo+AMP+UQihdLimR0qLtZHs+ijhoQxarPBFeEqvcTgyoylOQxVeM8aVkMam8yjKIgziAZadHrrKWQiVxirX+HSPABuDSSq59K0Hf0uUEk3fvBRN4QLvedClQQk7bcSkIYnMrW7RFWyLeXlCvuimwuilgAP488i+TCF8CUc0WCIYPwSn4QBkMwS2DiIjk14QcDXWo3GJW2GBf7BHAXMCdFACt0A65MoJ4yCW3cor8skAV2P4MrImgAARQ+3EIYBn0QboDcF6TewC7OH0oMxq0gATYACnQgJX1eHBCbXD2eNjEwFqxgL6byURMBJRIydQlxJcJjmfnDV5dp9v843G682FtOdX/roTBcydCMNJLlIqrVDcg1m0pCENzk0ttNDeb86TljoI4OHenL0MVLNpKHwXrAF5QMApSCIokQK4SJpoej//4L8MeV0LQc7R5KornMj1AVBE3qtKCUgwYMGLEYATBBctGFBzBaESiiSM6JVooiVEGV+YoVfpUyVSjSK80jXpFUNurYCU/vRL1SZWmT6YC4GiFAxqOAB5mKDiCgkDRJ6eKCiqKbdspQdgEIS069QOBbQSqKq1a1QWKND62EZ2qtKmgIVGxOSWgNKrSb06fVC2D5IURf3fx5tW7Ny8aIxz4Bta7xZvYok5PbbW6WGnSqY+NrnUKFXLlxlgHYYVQwcUp/xQVPkTxZ8DAltKk/W3BS9rA6NGn0RiIbbp1bde0WedGw0GChA4WBCjQAawAph8diHUosJz58keKfhwAkiAUsgH5NukbUA8Sv03fHXWHNCDCvUOGDpkfkItf+kP8ZBx6vwnS+AiQImy6D2nTIf0DNrkngnwiGGC8QxJKhCGGEllgBAYSEUbCUrSpZJkFKhkBIldGCGaEKkYYYYEqlhlomVHEGWEJESfaSJI1GOlEIQiFiYGRPyZKqCKIQrxolGW0KVGcIIEcZZSGLFKQIkY0CnEUgxqKsqIWYUzkSScbtEihLWdcqBMFXNFEm0Yq0WaURgCIRBtNSqmkklfeXKYRbf8ouamRTxpRBQdRVOkJGlMmKEGBB5pwAavFrsrqm6sEWRTRRxX74JuqthmiMB/SKOOqR9kagtFDJb3qG8qqEsMMdZCwQbBV9ULDHw4eYDUwNL5BQRBKGXuU0bPWOusDXBPFiletQEX0m6eCxeqUB3x4g4AxWnMNNb6ilXba167dKzdpX2uhhQOYIOEEJgpABBMdgOgACOIQQUQH5cp95LkfwCmiH33suW4RfSDRZxN+csHOu3oW4QeSe3Ixz+B7AjSkPYYR9o5h7/iJwLsB3rN4wAE3IbCf7ihiyEGLRiglolLWESaYSsoJkkiIxBFGmxG0qSKYEpfJWecORzG5xYX/EFryQWEW7JGBS0ZIMUVhNCxHFXEiYWmZm38smeQpGWLE5DY/yTmiHh9UKMRKRgmmlEsSqSQRtCtqEqGEmABlzDGXoamRVz4ppZS6QZlJlU/w/PvvSHDSyfAARAnKCMOmKkMrpBCr7LFPlRJjLKTSgKAJQcqYCvK2Il+LgLSiGpUtAhzoQgUjVJV1VTRc9cEu1/HqA4XOHwN906nKQiwqAqSS3PfgK5Mq2N8fiCK33LZovtXSUjtNtbtka8006ld7LTZXVSONgxZsOKASAU5YroPj3i03huV0+OGM9XXBRBfoHgECBn3zGWCR/L77DhJH5vOw+fBHPrmQQS7kcx7x//SHPvrZhAb6gx/6kMeB34mARhKkpIlYRBg1EEYpVnaiCi3DQlGCyJE8dKITaghsC6HIHxSSCEm8LQY6WsODQmSyIz2IIOVwms5y5o6cLeFIC7ja26Z0iSMBKUVN0tICKGIhDIVoAVWcUoOYpJAcgAkUrgiJmChxiUq0qU2XGEkjlmEKbZiCEjDRxid0YgpT6MkUnyjBEh5wCkNFSnRX8aOuAEmWPvYRAoJE1rFuJYg/CvKPH2DKWraxjW+owwato92s/OEDS8rKBxWIjCFPEUmuHEoQpVyLKYElOkaGMle7a+SthpCGN0xvW4KZlm2qla1V1QY1pIGdBF7QARoIoP8DiFAEMJigA0R0QDntQsRxzqCLciFCXgf4AQuA0I965Esfi7BHLrqZD0eEcxH1CNDBHGEwdR4sQNkxRD4O5rCO9Y8fA6hYPzoGiX4sAjvw5EfIcMigHApjHaVYCTPEoQptLDRn2tibhqgGRBVOjWYOCtpFFCSJB9UAIQ7CUYeSFiJGeChIkfBhOJ4WiXB8wkxG2iHYwKa3gkRCHBARhoMWQjIziaNMI+CQNpK0gKyJKANx0wQlzBGTT/TsEqVQySVWUbc7AY6qVFVFAFQhRxzAhAeDKoOkEiM64F1mrFL53WGecpm2kOoUmwFdWtViuaSELndQQQodPoACKBThBa7/uqRgjjC716XBk5IDneUqJ5m3sjJ3ThlCVYryAdydVXKi88YbIDAa3FRLW9YyTfdSE1pZMS96W0BDC15wAEtkgQYdkNcjUgAMZjITEeY7TgGi6UxwQEcRRLjBIQCkj/wcAjuLEI+/sEMf79SHPPdRWHf8Y8H8QEIDEozAdQdwA/3kYxH6qViMdMSQrx1JQh/MmSpyViYLVQ0iDP0RzhrqtYsI7W0QepvQKALSUpAsGNqIxDJU8cOnoXdIKzRajhhxJJPx9EQSCdENafajhfa0CiObUhUT4pExgQKNlCDh2t6kxFEstWv/zeoFAAfgC6hixGlshCnm4NUPOGUbijkd/6Mc9cdFYiOVjVLksrzhqRzzKpWfauSxHDXjOxDACEhowV9XdYTW+VUvmGrCNxZT5CwXKyq7YmSuyHooHQNyU0O4Q15sQzvWYOuSuOylAbz1Aky4whYraBcmEJGu8y2TmeaLQbt0QU15gYMFMVBENv81AO30cxMfy1978vEe9rCHneZRWDsr5h3v/mtjFcsfefzlaWIEFIchLQVBa8CMYHStpjobRSSCMRBZ50wc4cBZTcsRa5xeRKQhugQDiIYQXosjGB7ykMrKkVBVrDilkWCpzoq9AA8dmGcDWSgzKpGimTkpZyqxUHoLcgkrakOojPhII9xRp7uNxKkr0cQyUv9iilcIThWtiIQq7tQnU6wioZ9oxSdmwIQ0EM93h/Hc75DiqLk2CngEwHLCw+KGgWOjrImFzMOTgruGD4EAXy0KCpCABCivyghHuMv08AKBUzShsbk7DGSpMrwuF6/LSLmK4yYruhl7blFssJ4vSYPyk+vFeqU1Ou0+i4buee8FvsGBAIhQ22ISIQUFOF8HutEBEfygtsv5MyLAAY5HULNcQNguf7S7CUNYjNMAMpAj6MM/6gIogt2p4HTxQx4KFig/bIc7A9Yg7IXEtIPFXgaJ3luhgRg7SmaaWjjeC5EMPSi8OFxSDR4UgxH8QUdf8xADFiAOcThNFe7oGoGB9G3/iEwbbBEi28paTELWn/BMlTCHK8JdMitWJCGMYIKbxPQKbazCFcEQo5uKbaFgsPhOOFCFsz+R0BWLZN4vRsCgwvoBUzLyVoh65CJZKckjb2obEEDBIksZ1jFr35E63oblGPUrVAl25Hs5wgv28gCiKCZR3efjmAFwy/xoUQbBUM7PBVDpUCzHGxgjr0RjNFyFs1hlzdKs/nDptL7FEmyhtRRhtmQLCNIHGICBFIqJOTChABRh0MSOBVCQCLjL0zpmQGCgnq7BEPThPQrmX+xOYCzG7uqJYrqD7QhEYPjJEQTmX96GaYQhEXQIhAgqGKIKvVhtFFYBRX5kvVoNwISk/9VYCKZKBiEkoby8MCJQ5AuFAcCgRhXQi6b+69lQ5GakpEdSKBhyjaUITBuMzUgAbI6AJGfOJiIcxMI6wRVGISVAIU5W4hVKAds+IRg0YdXwRhzsjXCc7d4mIBLvrRVMISigoLHaYioQK3TOaq1I8XKAxxseoAKUQuYIQAyIp7KKYlPsigDw6hRSpf4CwwZM7i7QAAoqgOIqgzISiy2QAhhHhxQnYxSRQhB8EQX64AUYQa5acRobTiqeoAyoJ+hYQ+gGA1t66TW4MTBwIzZ+7rReoBY24BhOYJlka7aOowPMIQVI4Tj+7AeW4wTH7hF0QRfGLgZ+ARhgYBP0LgICkv+CNoETOI1h1s5gIME/+o4fICg/NCAXAiQiyaMgLchg9s6+FsRkSGoUJIQZqNC/imQkiG0gCKJEbM3Waqol5QumkKa+JKQGNE+8QIRHekT0PqEcTEHAEgpIFgqkWO9BQMTYIgrAggRF+vD0IgEHRsEcWKiIHGQELoERFoBOSgEUQAiEqBCEqCZvXkFOoFBPmEGOBGcZVkINVWEVImEOkMAIBkErhDFXPuAJPtHGGMNRBKn9RMcHVAkq7EoMniAAte/LPmAb0ooAnoAOtkEFRA4X+cIHTg4bUOAb4I9TvuEJimXGxM8qEFMvFwUw+a8pwEAy/UELfsEENDNYDvNxHKD/CbKRzXbpzXCx6IIuG9HgBVogBU6AFYCgXNBFXdoRExoBGFIgXZZDF0qwXcaOmozpEaKDBn7rnjQGn+4BEhitniaGPf6lna7zgS6mnYZwQA6hH4hrQAJmQMTrQYxPHLZSQtYhGEwqCsuhawCACtFSJERvGSIhTcQhqtiwREAqh4QyRCSEATgKbG7GbDyybsoBxbaKpvgNvYikIU5tBJSwQ4LBSFbhE/7LFBKq1XayQ+/N+VisQlwqIioBaRJhtTzgEBuhFAaiEY2EGQyqFP5L1QDn3zIhq9gScCqBGX4UBD4BAzIACpxisTKucUqlGGfMxyTnjzpuWbqiMiTLGK1U/5WKwjKponPEABsUYJMgMy+OoALEQuN4R/0OQwwcB60iZ3i2IjGQ4jMc4C5eIAayoVy6wDIcrgzE4A2OYHm20TWIThsNNXt0aTWihRy5xbRagAM6EBVIgAhOIAVWIF3eRQcsIQWyrhLexR7Xp1wKQJrAYR/38RfOIDpYoe8E0oLwoz74Q7k2obscqLoY6BAiIBfuo0Dszn8KxIIYyIJK7diUqGROTRiIrWv4rWsaoaZ+RClzJhygZlptjaZOZBQYZGs6RLyIJtjEy0OMZNqU8gLKAQe0oRx0psAeomqYRls3NIRabE5OT4WW6hXCAQe0sMGMBEr2ayLMwQPktanKpv/Yis2puHJDVwIAaOL5TOI//7NuqgoABuWxTqkz+VJKyYwpJgVSsuwUfACVNJYs/E8ACWCPxIwxtwwr1GFQxjQvtiANmkD7IovMOAUrNraUGGlRFEmVtuEJ3iAIUE4RlqldFAExT+EOdnaPTqFOBXUCX+PkZINbYpNarEUCd4MDsoEYmIAIVuAETmAFOkAHiABdCmAeMeE4DW2adGF9OmAfMaFUjaldYkACBCACgEtjMq07vQM7Qo3TKCYC+gFvOcbTdnBjjhCf8gFDQYppNnTxnpDf2FANE+pZRVL07k0VwkGl2PAnw6G/9AtDVQZDTw3YvhVDy+YSzGYVMtcdImH/FXAgc0MUSCh0QUHSKDf0REpsGURhWmdUGxAK3y4goYKEQp41Q35tAcxBFSihERrhEZmhburmFWzUJQ5qFISUcFAMcFQBANiSRFcBvTBAHaBAM99qLidHLWYsfWOOsirjAdJALNriWLQUsSCDfh0O5hJFDJpABXzgHFrWH9DAL2ExS12OcypO5qTCBZqgCe7Ar1RDAgogG4YWE1gABXaOAJ4hKSoAMGpptG7DAPogeshReqAHzU4D6GzjalugAFKABphgBVoYhkFQtlJAFJQDGHTgBJkD7EK1AGJAXk5wmbJBC14gFHaVf/rHbjmmfwRSInNhPFp1ADTgujZBBvQD/z8okjxilX/Iw0AVl3ELNhiEAQDEIfrQC3Ojr2uWSvTW0Gn8U3NZMkV+bWw0BIRA11uDgWSWRkPJOMCYzWkSar3K4VlXT3FBcqKiyg6RsqYGDMB4El2BhELchAktAhjMoRE8YEzipKqWSmV+xHk99AK2KgrZMnO1dxVWQRMiQQEY8MYGaRs0FjFPaTFiUUs/sZbzygcY0CriIhabYit+JZiDeTO3rCpO4QiOoB/CdEy34AHKIKwmJ5Bq9hglA8d2BTFR4Ger5YE7IAU+ARgmwXwc4AnuQAwYZRsqYAwItc2+kZcElQJNuGlbA3Y4AA18gwZogGuB4ASIoJv1TARcof8dC+BTu05UY+DPpknqiikGXuC6HJJhtovtwklvuxNA1Mli2EMDIJI8CAQGM21j+qFAja9xgyFl1kFI2ZL0NHdao48SI+GlI+ECXhoBAGAOxAEApqZsyqREBnZqAEBC7pgBiA2FhPo/zbIVVkEUyoGmSMwK8XBDIU9vpgZAnw/A7o0/1ZA/7w1wllqmy4QK3cRqvCgLKKFO8GQVlrqqVGIUauIVXgEEcKBH7w0E5kCuuxe98qSrvOEJ7NJNG8fhGk7hlAXmClisnjkNCsPh2ILhBrjjAPsxkKJzPqAJ/OIGYOAGimAXR44wiOJ02AJNXe4xQrExjuUUTFsrKqAJtmH/ekyjntHgDCwACyYAGI7jBzABE8zCLHbuFJaHNkJLAldDNYQ7WkoDNzbrWqrnnU3LB1oADQ4gFIZhBbqWa2FYB1Kgm13BAmj4B2h7fYghodfntqTO6jrgfWwgAiKy76h4uioGgeiDuiAhPoC17/ADvSsIBvguViPSZ0KXaY4EJFMGADJXCwOspcsYaviTPzM3c5c6HBAg2cRBJG/XSGqqQ2xmdBm3R4g6RGrq3i43X3UGD1Go2pwq1iRKobomZ9DIFPjTaZxGphmKpzJkvxaAFERBG0SBedESwGgijXiapd4tT3aiFZ6v3mT6pfHE36ABF2YACbzhV4wlAKV8MRiw/5FsdgAhYJdZs5iLZcut3CqaYAuQ4ApYYRhmYAAedeR84BSwjFi2gsdoFmMRcy+tQhAY8DMgwK+gx68kIAWwwAKKABiyQQSNKQc+gFe8oQJG+FpAa+SS+zYDgzViw1o4wLVP4B5804W/NgWSiQk6IG60GwhoAASJQW67geu6rgO+bmiXswBegD3kaTz3Np8o5l80GjvniTwq5uy+o58Al54EMkSKrWw65BKmBj7lM02W+vnS5KUR3KUR4AKiXaVomqYEHABiLdZiV9vFWIyJzXaLDVmp8AIyQZQvgMjXEHq/nWBPpNhED0ATinCmlQ3T5PlEWRWMIatClCDIBG20If8LTCF5S8KqiXwVhPQTonAZOlRPoKEV7C0TTCETXloVMAAajOEaGgAXKMEIKDZOP3F3yuox0rdzoPlQVlMqTgEK3rcpisJysIywGxsyFDiwrkAAUuFeEkAAoEyXK+BYKI5+7TfmLEdSpqKXcxtymiKb7yCzeBF2fGk3fsACNuAELAAUYisFEGF+JsUpUCAIDBV6bDPSuZE2HpUDDpWzttHpZ6M0Kt0fOgA9YLhrU2AKiAMEO4AU5FEHqoEJSLAAgCEGfuATrK5cwpY48rSZ+kzVJWDt6oNVf9W7YrV/NFogI/KKpzg/ZIB/WNWK+c5kMpRxjQRlCCqNlv0CCBx21dD/w5kdptMkTW7NWWuqCm9mao7tDEumQ/obQ7F62kN0qZYVRUSvbKxwIMIyZ0wMapZaGwaM2WRapgsc3PRGRFwBFe6mEjwMAFJ/FYIUb+4kT6Dm3FuhR+X63C+gAa4BBoTAGCghAAbFgtMKzv9PzLIMyXAWzhNlUXIMBR6gM3TOKrRvMgCCAIFtAwt+IHCQ4EGETYy0SCDLmbRF1+oloIHGn8aNHDeieXCqwocnCREW3IbSILaDglYSEEPAxSlBpz5820azybYxBjgaMIDmJ1B/HTp4SEHKGBZXTIB1eIRI0aAPH1QIvfpTY9aOXDlu4eCPBZqMPX9u6VgWaFB/P8ei/+GQjYYhViuYnBB1AkgHJjo66GBiwVIHSyKYpOhQoMAPTD86INLVAdNfp44TR05c4ACrCIYGROCXi98mTpv4Qcr3+fOmXKcjbIqAeoAM0Z5pR+gHSbRu2MKC9e49QtioVcGKrwv26lOk5RciKVcVaZXz5RiWR0JgPXskcdtViVu1LFK4SOHDARCGHkDxUeKCMfvNYMQnVa0uZGquCnr07czEvSq+jHrBcCfdePPhF0l+jUSyIHSq4HABLqqYIo4q2lCiTTCljGKJKFm08ooqq6jyyX2RBAPAKwlGEqIqjaiCSyuZyNjKBK1ccw0MMNwAQzEeiHKNOg9UIIhARh6JZP+SBNCkpJFMzoTNKd6kgQJLSwo00xBPNlmkkQSpgwYM+khzzSL5mGnIFUx0xREaPgiCAjZNzknAN3TORAA2FTThQh9ptWXAWUGBJYEIKaSgSTaoiFJUY4g8io4gg7gxllBbBIqVUGixtVFZ/rTwQgtYXbqVRqIC2hMHHERhQAGkrDDMCSuskIIAthABhAjApKBDNbQC4asFwCT2AzA/JIaIZR00ZhlmjmISwwE0RBABJDfcs8kmh2QLSQSebaIBuOK6Fi613lKbrbkDlAtDuDcIE9wIwYxQirziLFMKesws8wl3zEVSTiTafBKwfgiUgwAA2pkXXnjihLOMOBVG/PD/KL5pKI688moYDAMMCGfKBReoIjJ5/YIH3jIRDwigytuRV7I4F0ysyjI1j3yBKQhc0IoqCExYSSWjlLLACR60Ioopq1BIMgjLgPdJJdEtPSE0E5oCDc835gNDPwNcYwwOWTdwBBRLFimIICUd5MJJbm+DDUFFlqRQ3AN9480pD8j0AUxWtoT2lQQpZBJBCH3QhD/H9JNPPsgg0zgn0uSTgA1s+uODGxXMtM1Nb3+uUNouHfRE2oKIgcIgZURxFluZ9pQRB2i0EIMOFtB6CSZOHfZIYpgoQgAK/gT1J6eeXt6VARzoYDmnpJYKlKaufzqWIkB4IAATRABGgwApAKMX/xN1iQCE7SsAQwQipSDSWAzJIlJUAe8XgAli8iPSO/u/AMHPDabhhhrR9CM1/FBNAL2lgQPG5jOQ6EdpIDGATTgwH8UpRXEy1p5gVKI46vnEJ5oTCRBmQjshHOFy5oAA7FRnDstRmMLEAQBxMOMT+/KOOIRxnmBYbAQWY8aA0COMGbYiEvXBwIjCE52A+QeGEhuQw1akCgw4KISqCNgFsFMfCNWnFdgZWMRGsQBXzAIarZjPcqQDnec0Yj7zoY+MYAQNY+RoADwagDGMEYA7NqAfL4ACCgiAJzpxSU6CZJJApoQCmRRJDDU5xTbw1CWEnCJJNHFBE3yAhQSEYgAwsP/HAPJhCNHYAwaVAwtHfACFbxBpJnhaSEEE8kiB0IQlkxTEEwhAkuBVoAJjeAEHXoeVoLjlAKSwxAkscALEDAsxiHBfATogiVNd5Sxpoebw0NITn7wADcv6RfSuUqnhEe9SQdnCWzjQAjQoggjVoMEJtHeCW3gAFB1IgSVSYBhepeAERNABKRrzgxgcKwbPtF9Bm8nMDujiUbrARAF0oZkbHOJcA8jFPSDRrddEoFyv6ahr0KVRim4igq/xjDBKUQmNdUyHPVxHf2qWHxJGQmFWxM59dlYd7Ihnp08LxyfcE6CVBaMGF2RPbwa0wwFZBz81W4U4+qWKcIRjOE08z77/AGAzKy5VPFC9AIRw8CCeefUCjSgHhcAIChzMAgefsNknnAoCET31RVfrWSYyAY1iwICOnLyGNKCR1zviKAEtUMcdCiIIzxGObm7725JqAjpJPuCwA0lb4XBypVvmSW5nCxwUjNCGYXAtH590BCjzYVodGcGUPnjANyYpN0jajXA0QdtN7IRZWH4DBRUIQhQ0QjyyAHN4smvBXk6QAgt0QBQ0AEIMUlADZDUTHNLUlKfacrlsauVTctAEJkAFzKsMT7xjaYGqiMkEGmgvvVh4JxBIkQIiWEAH2wMFrTogAlIQqwNyQIxDOxAD/9JPFw116GOg8ghM6IIFRbDoJlDD/4ncjJSADy4NbFwjwQnH5sHnQs1r+hGcfFmQAUNrj3oAoDAkRgcDIGQhAlZxn0wgQMYjrI6Nr6Ow7DAxht5RzzpwyDKncrA4wQhhgrhYRg+yaD/fWQUARhHDGI4oYVJcMRGvCB374KA+WWsFDnCBg0iYYkKqqIQFRFEMMlIiEltexQdBAMK49qwV0MAFNKwxRx7BAGxxDACOcNS1I9hJkIWcJKGNJCUqda62r9SSoWXJylnWsgkc2FE+NrEIR+gjW6U5xCIioI8bJAAJHHAtGwhQhkfWlpV1AiSkCeBoQIqhDE8Qw0Ka8IExZMR5/ngeUGRnzrF8BSyIIMKrLMCr7v8BQwCiOFZRLFAACbzFUpi6FDXR0LpOGa8jHaCBDiRgAwlMUy1AEVRWhHLOdBbBAu6c1QmMhoVh0IoG7eSVBZB5mA4EJqAFqMGxjrVf+mHmMb3ABCYeg79HxOAMEmCFRDldrY+OCxIaoJZnuvWtCZtr4zeIwA0uwYB6dawUJB7BMtwTxGDUTDvN4Q4ARKZCAKQwhBcAwBxkfp0LfGI8/Hoqisrxn4sJIxwqJzKRe7OMF4XwZyZL8cm4w8RlhCOqzCHQy67YHPvk5wJWA2t9RlZWU0RsAWjuWX7qowqFjWw5DlKFKOzcgDl+Lc3GKEYx6l4CwV6jAS1wSecGZ5DCCf7/IB+wG+DUJnjAP3KyA+EsTAgH+MJ3jvBDQAkKHqCAKwzDTPW4hj6QMYB6DEAfhqhHBBKQACNA4RTfIHzpCHDYIi3WJKbznEA+0LYmEMABpgTucFtgXrcAShG7OkEjgJACIJCABqCwgDN1xwREfMp110XepjTSOi3Y4JfAB+c3ibdt5clu/B04ASXqsoIT0AALtxvGe/dJg+0dkwh++QF8QRGDGGghwEXhP8IfSj+e8BiKgHCIUD8xIAH8EwH1sAgRJBoHBC4FpBu5EEAUCBvpcmnrMkAUVC/owTGlIA6l8B8+tB8Gw3YkkwnSEQkYMGORQGMydUYJMh/lEFX9QRzn/6EeTvVTTuVDxeFUOyNjQ3SC+zEebvYKKLYcOqUcADBCSnhkbLZlYiOFmbBl0NEw5pAF0BAAPQMCpjBCIABjR5YJ9BEJcNcAe2VHd6SGfoUjckQm18ABdvIBkWYkVgJLL7Ek2CAIWqKHkeZoLPEBq3YKaUAlpsNqkjQ3dRhprdQEYXINn/RgZmImpbFJV9APCuAPUFABKzEEdwg4ZZAkMIE2k+RI3wAnTbA6u5ZN4SVs5mVeqjIWNlANQMAE3kMExvQ9HvADyYIYTPADEiA7ZhEovcZrbFJ9G3EAHcApvpQp5RRequKKL2A7JEAErnACU6B+iHEoK/AD6ccEXnCN8/9VDcrVDTTgBTGAD9WQAslyP/JzBs30UO/zKI+iYI/xA+CgCJtxD4cwABAULoeQCx91cefCGh01Uh1HLjCAYfPSgR+jUhYTRN+hRCzXL0kIACt0AdVxUySkMNLBRJ8wCv2Bg8JAHPfiVBnEHt+xDPVhQlq1HFDHg9KBRJmgHKvAdC44RdCxZTyDALgQIV4VUynjCrYQAGTUM2A1hmwXIWFmCkQ0AzmCI3d3d7igR3LUhoCGBupAB4hVeYG3NrSnJUviOXsYOJQ3SygAAd4gS2Ipe1cie63nJFqCe5eUSaFAWpd2l4swDPqQAAOABP7wAG9we5AlN0XiSF5pEro1hyj/gAJB0Ac+EV5ZUV6zEyq+NBYdAAxMcAvNdQnFtCvAwI5FsQK6kE5gcTzWt12nqREHIG6mcips4RbE8zrjpyocIAG1Mit2ISsrUAA6kEz4RASz4k4pgI0kkALF4gS38APLaSy6sBjwo1AAmCwJNo/ViT8K9QtFYAj90BmnsQkYdWnZghrUIoEEdBvnki0FREEZAy8eOALhUArMAGVFph0aeYKrYJ8aiQAYMAc3BoMztQqa4FQAwAwn9h0wNEMH2h6roCKmMERj6GKqwEIwqJ8pCAIrmJFsJ0VjyJI9CVgIYAwPkh8TsgweMAvGAFgX6oUyYh+tYGdjmAkgMAMNMAO4/2B3dYeiVAk2O/pnuwADLQAFukeHt3clR7JIWfIktTUEjPQ3eIICaeANKECWgVQGjEQTnYNqSJIlH/AQIwUDXBNBpDcACTADCoAGQVoGd0AVgoMkC5FqmrWW3zAEvHUHv4V9rmNtrNgWwCcBEhAqaCAB+AAEsyALRHAohvpewyICz9RPiiA7srltZ2FNWjFcyChuQXEAstkWv0Qq4/UTUYBOwAd8L/AKJIBM77YCRHAC7GOo3OibK0ACJKADK7CZoJAC7VQMNPADIiACy8l/CkVgMaAL8nM/1elQj6ALj9B/L+BwE6UBFAcu6PJR0tour4Fx5rIJCDlyI3AJI1ADGv+zIfI5IODBZCTCdMqRHS7EgusKQgBjZMsxHxMjHjeIQ091oEKGHMGwCmhkHdyRRkw2Uy8zcwGrMFHUHG2kU6pwH1n0k6bgsCTDIq6QV9CwZZ9QH7hQAjBqH82BCw2AC/IAArhAlXWHAIBlDCWwo1YpDcYAAxygDneoeGxaJ4HDWTGbiISXNjWBlt9QOjAhBojHEjeBeC7wAQRhJy3RBFuQScPwNaN3D/UgavqwWj5QAWXgDS5ABzdrEiVRJG0zhwnBmBVwB2PQe5E5KuQFjS/wAn6KX0BQDCdgCRaQT/i0jguFKzGwTedWKqh5jBuBTmUhTf7wS2MRjK/zFuYVKhL/UIt2kX6zsgKYqQO5Uk8rQAM0QAKWsALIBgTp5wE0MAs/0Ag6oAMFIAJFoWDDirrxaGD4w7qIAA5Q0UwtMAz3cA8SCAngokAQ1BrqmRoelkAPZkEa054Wo0PfMRzM4Bxtpx8yxUJ4sJ8lsK77GQlzkKE6tYLLkaAn1pEv8wpXRVUBi5/Lq4IzhR3Wex3Ucb3VER1JyRwyhgF0FiEzwjMO0gra4AGyYAwxYh8vmr+4gAHve1c0OgMYkAlUWWd3ZwwrCzYNIA0NYAy7QCaVYwRN8GhzuGqjiCR9aKRxE5aBU1sf8KRq6TcfQAe4JknfcLRDUAEEQAeD40hlgAZXsAhf/2oI9uAICXADcQAFW5AGTcAnckJIR/JIMAFLgwBpIMxbDjAGl7IR5IQp3iebvvZraXsALVAAMHAIntu4KTAr8QWaiOAUBUBdeDpcksop2yWp50aMv/RrloJts6Mq1fZr5YVOfSoBzHUC7XSN71ZPxHAo3Ui5rEADFgAEAeAXFuAFnts9x2QJjdEXzJS6Z+BQA3ZgxYoI74gIWvACnBABEwUJuRAuGuAZ6FJxz7px2HoD1yoMHxMf9EIvFmNBwdAv/WEy2oEA6Eq+Fylz0ruu2ZGRGdmCmRAO5IoiBwoA43EBTkUcPgh1++GR+4FiVzQeObaRN3dCL5mECmsfPYkAF//LdTzTRuJAA2qIAHAmsudcAjEiI/HQAPLgv62AovEcRwh8lX7VALQwaj5AB4bGWVd6EoollpHnlnlolgzxANhwWAdBB1OyW1IqCChRAd7gA1D6RzFBlwlgCKSVAF2DBRzQB97QBHTgAorElinBWIB0lijQBGkAAWxiTsN1XejmFuPXAn7KAhzgC5AgA0RgX4eSF8AgGEWRDQsmbajCKZoqXql5xlxRKeYl0+fExq4zfrNzThLQAjYQALIiK+50O32hA4fiFytgBzTwuMZGBLvCuRYgCzRACn3xA+VzupDxjg+VrAj3CK+LCeDguoqwUNDyApuALa+RgRcm2LerAQX/FJAFRJ6pMQDB4RsMMC/BcAnCURw2GCAz9Qn5yXbXgQEXuYL7+b/SiwfX676hjQsy5kJzUKAFuh8ntK+v7cytbWUrhgAsFL3/y5/VcaEzRSIm5IL7GyN3dc4zZoV5hKKtEAAYcM7X0LEdmwk12g7Kfc7nPAtplldxhyO78GfWkABYgAab4yWOtmqu5iWHiGizlCdu2cF2UhNiUAFHcATq4AYP4A0qcAoq8AZG8ABLgARG4APb8AyMeQQ4nABXkA9YYAQv8ABNwJiE1wRTCkhzSMQ1YSd6yOAV4AB22sTGA0zYRm1mIdXpBqgvcAAHAA7ZMAWGsAkdIAArQAnwZRf9/4QJZ8AC4vaaUjxuTKzGeapdGjG4cfwTrgh8Hk5Ob7EF6WReNnAAL5ACsUAE6+dOWr0sP8DFs6p+JCAAQKAX8nVMIuABpQAKpOABFlAszpYsZyA/C3c/CwUOB1edBdA7UAEtNhABoCyt0roJMvAaFZdRHKUBCpmtpZAIJCfohE4vLOWD4WGF/bodq9CuzTEeOEa9E3odBnsfLLYzAQtDTkago2Ad+ypDKDJ1w8FGAEAfObZ0vIyhLIgLqD4yWQaEYnWxYeWTC+uwWJC/W1RneUeVIvvcH0vAARAAvZ5mYFZ311AC0lAMM4CjDbDs/dACk5TQXglZROo3Wys6LUF4b/9TtGozJSHhD2YgBFzABQ/wABCA7kcgAUIQDnMgBHtwBOfuA+qQAPoQB77UB2lAB4n0AXfQSIk4ebRnikXCmAQQBD6QXUjN4dhlXZLZa+f0FWv7ApBRAPzDCqBABAXAFKSgFwpV439bfcWzFdKzbcYIm+hG02RhPOgEizV9BmfwtupXubMit4eSfO52AstnCIaBfHahPbsKX65QGJELDKs7P3a9UAjWurpAgFFRADZgerTxYIegG6Vx2OVSQBG0QPwQLvSSCCfVnq7sgcvADK8dU9nxHCR0YxoJzDi1n6CNC9dLsDMFAPtqc1PTQjLnZDW5HDWWvvs5ByxZwBcwA2X/+Nm9XKH2Ib8+yfguuBymcHfXUGfQ4LE12gCXf4YzAAP/2+v5W3cia7JquAvFADbaPQP4rHovsRD8fCWPh4eL1PpDqiRGq+CE6ANIIARVEA5csAfeUAEowQHsHg66zwVVAIou8ABv4Q8QQAYoUDoezErbHjiyxJiNifDG+MTZb7avY061GRR+2jtAYAFEMAWOMMndcALA0BcxAA7AWLjT1GvZ36nCmKfZpl2Zcimv2H3jJuTAxwJoDhAWTpyYMjCFDh0pDuoQQWTFihMkphgiAWRFihMWdJD68aMbKAspVjDsUABRDF0mC6RkqQvRS5gwC2QroKhAkUMRImyKkHOT/waeOoUOJTq0lDBhI0odZTBC6YhgwZaNWrUq0rJX7iKpitRVnKpwXbsiAIDgQqSyFzB0taoWAQYEmd5GmoMWwN1I4VQBQEuWbF20VQGIi2SVa6RMdCNhmINgMQJcbuXiQrxYbKsLrbZeyHQhMuZWqjBwbuQhgDFouHAZU41rRgMYsWfAaJAJROrUqIsFgAYNtTFj0oJfI36t2LV+/dC8cfFtGwHo0D9g+0BAEAExBKpv127d+/Ntzgk8b17GyBsUp7y9EFKFi3su6lygeOA+XDgu90NsE0TnVIVTULCuuie6e+46BMcjoMDqhmjCAR/8kXDCCQ3wxwALL7wQww0x9P/QADQ8RMOfEUfcwgAOOBgRwxYkKICJWVYgQiMmTEohEyCAMUmCFlQM0cIPgxRSSA0ppNDDC0NEQ0UOSARRwyVTTFGClDoQYCAmLHgIiAI66OAHHRxi4oQVaKDhkHpWYOIiGoBJgYhumKgmIyZSKECHkh4pQCVEXFrJJXBqUkQXlxApCZM+gcgHEn424SeCATRodBMZ+AEqgkd5ykcoGHaKIJ+lQhXmkqWQGiUqqj5Bq7CuEtuK1csWA2AtuOBKLLFaIcMAFwzWmsOqWWd9rFbL1nLVKstwzaTWVnApAYNlM+EVWlyklbbYykKDhrNWMMOl2cUuWEWUYqC5BpcGrkn/NwEYbmhXthm+Vc2MMaJQZwnWUMMFmgakoUZdgK2RppgEFHigiegSju4bhbc5hYBTEPzmOoUZtu4b+pYQIo03OCghnHJADmeOKrwh4IESQs4vvxIq2EYMbJ7jTpCHCUjjAQgeSKNmApog8I0y3AjRyC02PBHDE4sGcsgfQfTwaANOlPJHDiQ4AxEvmFAHia11yKaTHHLUoQAbqlYxSKiHRFttIy1M2oAWfjyx6aM5aOHuFl5wUQcBBhAAIogQ+rIAMHUAHCIRHJHBkFtWEEhLTVKoBhQziSDmhxh+2BOTPfdE5AyXHgG0gEceQcSkDlLKRhcgNMjlBp6CigASnYC6/0GDnW6gvV2dgrphlBEuYaCUp4gPRphgSgmGKsL4WiaSRiIpR6yzvBqrKwDmKCutSN66wLFat8cre/K7MjbctVhdBQBk0bL1e+87Gw0XyDx7a65MALhVlUxUwSEzBICGM2dpRDGKARziwGAAEehHAgYAg9k0oAsSkMARzBAFIy0BGjhQjTFKEJyBXcMYIiSOMWbAASjQ4Q4f4M4HTuECBXEHPN0RhHjCc0PnYGMIUDiCEAD0ACTMoRxVCAcRwxECdTzADB8bYhGHGAIzCEKG3yFA0SiUhui4QBCDOIUEmmQkMBYpjBmCkoaCRKKhkVFCaEDDGR4BihYYyYsTakEBDv/AgRewkUNA6pAZO4ShNHKAj2GcUNKW1DQoeYhJaLgbCzqQAgHk4yFMoMEJUvAlLt0JCEDACEQQAYphHIJOJyCCQjJCgzoNbk8xOF0r+9SBlxAKHLNEBDheUgBEIQocQLgHAzexwH4wSlI7qdSlNhWpR3WqH7grxSWUspRE1IABSPmE8kYBgGB8QlVd4Ur7xGK+yrhvLsaCTFw4Y5lv2kVYcJlWr3o1h/St7y6rwFUkqpUr+skrWvSDRq+KNRfMZKIVndlWZCjTP1tAgxfXUCCk3OUudtFmDGDcQghCUAZ/dGGE6AKONIiTrl0UZxfHSAAW/OGz6lwnOwpjqXfEEDH/bNBMEDGNmHVUsAUh4OcUL+CCykamHxX4QAiduI9P8eMyhbnwOmnwRxTK8FR/PAA6p2jCN9wgSH9YMasWcipUAfkkBzx1omb8EBqgljQKFmkMY7AiC87QAXOQ6KkYldBaMehFCrJRkEMiK9KcxsYtoEECcouaGKEWohSFSGlRc5qK8NYicDzEFgIgAhFoQAISWOJNP/jSIzvgkBXEoAMx0MEwBjKQhwyEBhvwQAdEcDlgaM4kKPHT6fbEEtPFhHSIQBQQDrHATdxgKIcAigZwJ7uiaAB2ESBeIhIhDAYwIBFOId5Skrc8vHClm1f5pmPIsqrtJcYxkaFVXPAnK/dl/69798PArOaCPWChRTPkLUFnxmu/zhg0fmLpX2JAED9VfGaArTAFcRw1gAH8Mja0acAGmxqCHoQAg2OgAAVC4I8jAKcB6CrOR0dInGI0QABXKMIDUOACBmlnCNWRWcNoqKAZPscbFfDBEuawDC4YwQYlcE+Ph8gFBdhACG5YwgWIaMQ5vMEb2WkhdCZahgpfeAtDOMU2MEhIDkHZwhJCkj96UGGM8tFCKxpS3DIUggr3gERnKAANkOAPCldYQmje8pJ6pMehEdJJTANRHD8ERhHFrQVDOpGgGXm3AxSACKxwxi2AQCYSYAEiKQBTByh3AlA4ZCUFAIIvTkADgWQJcP8eoDQTMGcSYpSkT63c9OdwOyhEwFoRL+Hlbz3Vu03kAlNCyRSmcAcpoQyAKdR9ZvKEcRTlBYMZyOrMqw7j7LU4pp7gZGc5mUUreM5qDniA51v8CZcZABR7XQHBYloBrXjdClzVSgy7M2GMXp0XVwEc6LkzM8AJIGMRjtjEIhY8m9egqwv+cECFKSAhLWP0G2b4xh66YIYudAEHS3j4vfJRwl3QIgFogEIaxpCzI2jRAWPAmQMI4ICcjWEbd8DZA1wOAQfcbAwef4APhBqOP3ShBQ5wAxvsswQ3OOAPRjBDCCTQcy78ged/+AO9dCYIh508ZxGiMxui+o0rh3Fuc67/MBi2eiIJGXwMajtakaLWgq0b/MItUATRjeAPLavZy1Em0d3YVgYIO+BCW2/aXs16tkFqSGqH7MOSRARIx/aIA1FoAToQQQIBXGkKKyAFRK7UAXxYYE4D8QBEurQnC0yhkqAoU0EoLQIRdONLmSsAK1WyJzbrIgawL1QrdWETzuHkJz/RCe0goYGcFKUoviuFcxlwiURIF7pNqa41X/G8s6hCFcu4wDan/yqx/KUsYpkDZ+ISrvw5Rnt90TaxbqW/7a3vep3Jbwkiw/4Bxz8yr9IfYlQRUO3Cv3+taIQtjuEIZBgAIYCBa4iXayiBc9G7uEO4CgsCPaOoPRghY0AG/wkKo6x7QAyckDH4IgrhgAsykgt8wC3YhiDQKgn5MgrQuww0EhRUQT6KAoNbQSPZKwkJqzLAoDiiEDaoMKsjuKcakQykM7rSs0HDkL36s8UCJDZawiMEETuzs7iRAHDoACy4khMYk5DIkjrRgWoghSnQkkoKrSrZADP5NCLAAknTAc5KgVJovT1JNZMglJVAiVhKCd7qE86JiZeoB0yBBJ7oNX7ww08xpp0YJmCCFKWYLgYghmhKhKUYBVJJNnEIhlV4hYD6BOlzjG9Kn2GRlcSYA/55FsjwRMsgC7jAg14pgXDDgGeJN7hYFatYBRAYKMRwDV6RllHMBF3URc+olv9/WovWgJb7iwTN0AxjMYUJOIaQSoAZSIAG2LANMwYMorML8wc6q6un2oK50ruuKoOJioKQCikVkJBuLIMm0Uao8gcOECuEEyuxQoOncgAb/KJyHKumYkc4eyoMgscyGLm5Khor6sY4owB7HIO5ukEKEUixSxIOKbgKs8EyKJG50hCDRMhDkiuoiiN0fCohVMeJ1MCDHKt1VDuE9MGJxDM28qK5KStDKkLGUpIoiYIUaREV2RtWuJIVIL2MEAhQ0AFL+gEPGAiBOIGSEK0pKAgSICUaEAAssKSQ6Ig94axWO53Z+4FWioHYgwk7NAlE+IVh0Ald270I0DXaEUvc+TX/4bsEYpAutlzL4imFUbCmVRiF6pO+SFCVrxALrkiMVvg+VwmLASovdlonvmAvazPMTGgM9pGnseAfxMCAcMunABoggxowsRgNb/EMzrg/yVALAGiFT2gFWXhGAoRG9zOGJkFBumKDEOhBOjO4KINNCiiaJSAOHJCQHYRNjBpIBqQAuZPNFBxIgzOr1zS4HszNauxI4YRNIHRI4JyQ3ITNK3NO2awQDdEy2ewBNBjIsVJNEkkROIvBpkJB2Zwo3iQR8jROuAPOiExPgvzOHmmRF+ijrwKRJlQkH5GSu7EbNGABXTgBLCCBw6kTCzATGgCFDcACA/20i2ACPNGBMsQC/yCgASyYBRqQkRM4iT3xElUjnc7RhatxCdczlFiCpZcAByxYrkzRgEV5FEuJgGHSiQWKgBt4FBltpuhyrthihOiCS2Y4lVH4hFF4hW0CTb3cy/urH7mINr7onsdYUl2pFXj6J175FneCTFe0i1bJltbo0nsaKLXQRdXQxWKxFoE6U2uplm9pBRzAAFMIMdqYAXVpgHgxhrB7yDACTj2lAIxSB4YaOOfEOz5dzy2zRh4Mz/LEzhgkz+LEIO/0B4NTwD3FKBjUU7kDgzQrAxS8sEpNM4OTuy4rTt2EVDx1zhLJwQWcO081OAzSsmpEwQhjTk1Vux6IAjrrAUHdxx55gf8WOAAJEJIlVBsUSSyZxBs8shtwiAFIm4IUGMpmPQEBwCzII4FbiAjUGggvsCwamIJMW0qJSAEvOAHNwRzNOZ3aKwA2uy10ra1Wc4kOUAQsmJ2d4InfE0vhK4rbiQDgiS4G4FEeXctEcKZRGNi4HIXpMwVMRFjCeB5VuIBnUwXA4Au+CAuugJ/RSMxIuADtGT+3yBX2i4vGAK8mzVgolYv8MqfQ+Nj+4gy+GCD24x/NzC+H1S5REIURagBjaAD3a5Y/QFQKwKAucIMocIOmMk40eE2MMjiMMgMC/AZCrUboPFRDHVTnDIHtnFo+RYMocE4MCgKDc8AWvNOf9Yfc7IH/MUADpSXbNDOAKNjUoq0wDHLICytbrXXbDZkQFFSDKDCAtFWDQ/VONiIRtaUAq+PaihLP3CxcVvXZJ6M7rhtUCokbu7kbGxBWtcEzKcncmXwEJhgGVjCTFXi0MQG1E2AF072FShKASqokIKAFLPCAoTwBIPCAy6ITTRgbwpk9rbSlQkkd0JFDmTAdd0UEGriBfnCUTcgHRxnEn+gHYHuUBdoUnlimTTieEZCmUhie5zq+4IHLYBCHUiBSTFSFT0DYu/wEcdCMjA0NYgEvWIFSYulLKa2VXekVz8hSBFiF9GlSuZgBaPGMZoEMexNT/gHTeaMWyEiN+1W3YsyM8gWE/3RJlxlIDVwwA0I9OH9ojSXwWdx0XO9UAYayYOzEVbzFU1J9z1R93B5U1RUWO7StsKI5z4484YmK1BMEswuGMFg9YRV8VKQR27FKW2qMsx5okjgCwo5EwR501RseVLul4cG9MAshzx5QwZk8NL1JG8sVkUOaSbuZXBZAhFlYBFio3cdZARLYVhK4SdFT3WiVPMjLgqSECCKAPBrwAlJbgXG1BBGQrVXznC7BSj9pvSpptdRZgZ3wQ564h6AYAOHSiUe+VxpNHmmqAWBAvhFwxOwdnhE4trhEX1UQB1NYhk8gZW0whYp9FZZdlXBYBWZYFQDCHovdHsG8n1XsNrFQFf+rwETEcFnPsCeUhdlMQGVhNieZPVm+zAyY/R8MUIUsUJcS0NkDNAaiLdumahZoEGHH7cgXHts9IMA9yKr0/M0K+0aDK5qOjBAoFlsVHMgtOE8mhuJu3s5zbmK9E1XjdGd79iMOBuIcxmEpyZCFtOGp7cGBLue6WtwZflv1nBA2ypsDaJogCdYPWaT4jJKZdCQBMARWSOPV/bQA/TQBuMnIEwBaYErIE72kvFDS+9wV8ABKUMMvAQYLsIQfaInO+VCUWAnQ2VBD4RyaKAIZWKBGid5f+hRgA65P2QSecGQYHYCoQIrhOT5HPDbUG55SWAVxaIRXwAFTmNlGGN9hbDb/xNDEdHLS86EVbMGD++EV/52BGUDFe/o+9tlSuAiofZKWZukWxMCBzkhZe0rTZrmWXWG3zKg3XXTmCM5Z4MCFiVLiqGqNI5har4Pi8wwpGLBHByDPtRJP59xnptJnfyZUNUtV5PTZCYvB8+zmaYyytXrtfmbtvcsq0q7BxV1OChgRLxqagcSghVRVSh3OdcZOfwZCeIRNMBKsFxCkpnloimaSL+6RvbWzmdQFJliEeggFOy4IkV5KVgiFYeDoUDBdAZiCyAvQW0hjkTBvysJjUEgBUqA0C2gt2ZK9DV2lrWy9kvg8WCqJbOidW9OATpHRR3bq3KFRBI+AYFAKBqiB/+SbrqcAHmdKnud7hVPmCm14vkZA5bNYBunDjHSS2FVJJ02k5bQYjVxhDBR/r6pYhb08iwtwWP2zP/gLqHuz8Y89qF7GDBAQZs4QhVn4IAR0P98YEe80A8+AhggBXOFeQB+4hl3Y4O3kquHs7YG8MNl2wPMUW0r1zrg7bhyGZ7rjTjQgTwnxW8KdkChI7XIuc/Hksg5+yLatMDWYENnEqKoprH5W2qNdSIck50Ht1OBG6KYaKyYegy/D8hfIIyFJLKdhkcQyswvRXAlQhBMYgHpwY9X9tDSGvEVghWEI9VAf6ctSUBq4hZu8UGilgaAMCQtIhA4ABg8AAtHqnBiodf/bQgTRYbVW2m+asIFcCKZIEXYWBbZjYiBgc95Ogd6oUB6kOLYIF4ZKwOpTKeVGuL9VaARS5nC7HMatcJVv+sz648RNHJZnUUXIfJZlOTf0ikVXUQt7Cmz6oRbPMFNspox8CuAqDezzwb/OEAV+AZjfKIesWsgl2JcG8OfzPO0jaFpCjc4Lw+0wF88HeFoS8e1P/dQRUVTYbNxCrTqGzs44980eSPSQh00186smBs6so+KiuSMQkQCLP+HnrG1VtbBPjeHyRLMI884d9gcbMLP6PDTm7jPFq89itZsDIF4ZsAfvvpKlLGlfyO5hAO/xDoVopYHPjTxfKO8VMG8yIQX/UnjKDlgBTfgBTKjKzLn1kigJQsnDOdzvlfCSA6iHG3Dk2LkU30nwAY+AvteJUhkepFCeaRqeGmi+UagEIlWFRmj8T6iEsFaF6dGuV4G+jN2K9jrrcq+L8poBeMIAtu4VyoinT+ALmMWMi6XMezPZ+olxcJ8fJd3MTMCBgDKF/sGB+zMHXIDmc0mNDdZnDjKGDe7UjU+zx1W4EdJmlLeiccbTBdyCPihodTRn2bRacqRi4Qb5qV1NPe3BLXDPFBx52OzBQQL/8NeQJLDhFhmRR2gS7afOT1Xh669+rUpPrK2wJHjcCztWiY4Sw2NCgGiBBo2Bggb8cUjIAQ0HRR0E/2xidWIDFls0LpIQQALLsHqchoWqx2oklo0bjwmgAURHFgEpiIggJUJEhwKWLCDqoKtAhx8FfnTokFMXIkQFjCI9WoBogQIsBNyIwC/CgAj5ImCtOuAGDK43tFL1OiBYsBqlRggTFkztqGCjhNUYVUruq1GrTLVqZOqTqkqqVEW6gCES4cCZCiMmDCLSYgwIBkOOhGHOZAyWMczAjGvGZsuJD2NodThTq1YXcOHCcIE0rky4RCPIJDuS69a2XbfCpar0aNG0ceDA1WC4Mc4NEPhDM2a5vyObjRnzF2V5FH/SqVtfPgaNP3fWiu2xHqUM+erNpTsoP31M9fXtH/jTzv/dxxwfycmXz35EOv4t67Mvx118AVrnT3plOKAce8lZdyCC5jVIXoLYbVGQPwdpNx55Ah7kDxsUgJgcCy/4cwAm5wy4XYEalqHgGOJhd1AUDprXIRr4cRBhfgCq2AIHBhBkEBot+IjGFkMq1IIBFQopkI8SKFIHMocIcEtGJGxwwkYpCYCMIcN09KUArGjEpTSsrPBDCl4IAEoB1ZCCDylCiQAMUD9gosNPOgB1VFBNKXVGDIIatZNQTEQVwSaJYtVoVF9p4GgEj2IVTCk1jHCWWsJk2lampQTDwCiXfLKMKY2ogqomjWhjCmAXRPLXKrFGIg6sCIgDAACR7HoBAoT/7QoAAgDMsatkpyEww2PLXkYsAKvMemwmsO52QSsItKJKJnidtpq3pmVSgmPQWLuaKjis9kms4O6mlyjGlHANLteUYMxm+63ojr3GQPNigS3gKx6EzUkDjTGz0NNCgda14MYsAS8csXVbECDIFhEfwUUVA4vnQDhscFygfxKTXLI/EghossodqnyhdWiASIED/rTAAkO6MDGDfQW+oIDCLQMdtMpovGCQ0QZw4GRyBTFE0IUGKTSkBIjQUE89XAqwQQor0MAlFqxYXc8wrIzNJQledM0KCUCI0DUTHawAChMp1AREATE0RXdQe2MilA5G1VToUmfsNJQiU13VD1UR/yheleJd9ZOo4lg9ThYDcFm6VlxyjZKpMJVUookmnzSCg6urUEL6J9muay1thIlz2K9zSBvJr5HMCoBjg42GAGoziIsBHiW0NtiwhIEWSSvKn0abtdqq0tphr+EiGQbVV0/utNMu3wq3mWDrqigbCFd+A8XNcFwVS+xhzPnynm8M++5cA90SVexx/h7sA9LANe0UI4DRWII6zKCOJeyCFgGkQRfMgAYofCANadgGASIowYqdggBp8AYELnSEEoRjDmxYw4UcwIZwoDAEH3OAG9zwBzYsgQ1VyJHJBnKQgxDpYjQbCJOeRpAhBckfFztIhSrEQxtyh4hL6lAPQNQD6/+AQwIciEE26hELZDABCUxgwkVowAQjGMEGClDAOMxQJCY5jYguGyKQBuKygvhISUdLyAtsEKQgAYkDW0jaQphGECYxLY58ZEEBBHCNRdAgI6zwQApOMIWMCGBMIQnFMHwhgFBEkgQkSOQlT2CBE0xAAFPoAA0sAApgpMBuTemADvgEhL3tjSd040lTBhUDXZyhJpj4wQGw8qhNNCqYkxompYppqVIkYgQMCAZa5nKJYIgjVDUgVSU+8QnTqcKay/jEXlRxAVipKzCwWgatgkUYBAAGWITBgLAksyvfzQEXj5EM8ZoXmHO+bnvl4t4+mzctXAhmNQhAlz71aZpsHXT/N6oQRXCu0YB4leChM3BfCThTP3nxy3/1O18DcAEde13DoSG9hjWgU4xoBLAY/FLpSgMgACFIgwNQQMEdCEAACn6gYhUbgguaAIGD2GAOKAxhcoRajqFqbA5IWEAJSvAHGpLMhhZa2A0ZcjSj4fGqWU2jhW7YVeuEIKwvksABDhCDp0CiGCdgwgm4RoMpnKCtRCDlCixgAx/10UhGo9lUv2odFhxAFzs5ygsEkiQbvCCJQvphkRKSVaYlJyF75ABZgSGAAYyEJCTQEg1O0DUBjM0QZCMTmd6aSBqMZLPVoIEtiEAKD4CCFDrIRgfspIs+BYW2mECEImgJDB3UpCln/xhuDMCxSt7G4QbArAoMGlWVrPjyuRFoblbIUgO4CONy14VmMJgRzVEwYxnZDEy2spnN1MVqMLFaTCTUBRhVFOtXhylMtCCjO8lQBjP6tQxnUqNeyQAYFyAYjWwKbGDpqQIauZGNb/6prdWIJjelYV73QGEvjl4jfR0dzgwy7FCPzst9DhWx/zhqjF1cA8UwMEZJeSGNaEAjHcWQMY0DkA5asKIBCTjCA95AQTrkVBBDEEQGBYECMljnCEIIYTiEQDQhcKEcR50DF5bhVDPMkIg8ZKMRLcSdPy5Jr0cy2pGUFAUgKgmNF+tyEY/WZpZZhwNvO8MPDCGDaNyCi24VAP8W4roCIKxgBRMgRWEX8iNAnvlHHXKzBAR1N5784AdaKIAiUIYGCdhAAlddrI/O/EM8/pCPLXjB1FYwpk3cA5Os6OwJBODIRA7DEKH4ki8qGclWk2AKwxDACupQDRKIIAWi8AAlLMDKDtSAFE0BCnCPogNV+kRPyw4u3vAGlA6AA1HFlBSlfDmpG4D7UZbiVA0ut8wRZG4UbXHLKoLBK2xSIpurGAXpVpFO+qpCHJ/QFTkjMYdZrQIAuerVOoMVmX/6LuGq+Xe0tJVwgCZ0XaYgDOtiU65YcU9bkmHo83CwG1eBPAs4gNeGI0rREkd0XsRrBbyuUYyLQidewjHGNaT/0QBolPQ714BGMQw2C56ndBbQQcY1EvCCIwzCAXc4RU638Q2nuwAFHWwOF4YagiOgQQhVCIE7QlAFLsxBCG5YSBKHZCE2wvmNXn7aQQZi2D5u+o3J4apXb+iylKGhtkggQS5k4IwAsJWtWLgFDbAQaCAQYQUnMMUvCru0qk7VOgUZYnLOoIUYKEIgQ2rBASRQAJrYoPOd9yogC5IQzR8tiQgRdQtKjZJ6OAIL0qCBI1xtgc5ukmyU9MVIxlYSz9LAGXG1APE7kAJSLIASJ6AbUGiyysB1AAjAyEYBgLGCuVW/Jtq/JaCo38upRK4qVxF/oyZ3A8Ul4PxREUc01+J+/1AJgxnBeIU4VlH/YMwqW5ngi71Bbs30FkYrzEo4JUbufMIqgM/tJAbFLRhqyFP1zFdiABQErobyaFy24AIOgEuBvUYmKNRBkYYGmstB4cWpeMCFCYdIDYf/zEALlg+/eBR0rOBHyeBI7YLNScMuGAMvFAM1zNgPAtB3UMM1DOEuFEMCnAMHqEATuIBNnQKREdkp3MGFtIBQCVUJuFA5IEA4SJkQzMEfFAh3GFEaFcjkCdHS9BCQTB7SIMnpdZVVbZoNld6mbYFAHMQLWMAvAAMvHIIhwEBdtdVbaclmrUAK6EBcAQOp3ZEarqEaLRoLxIAWYMLOnOHEcMAv0IAmCP8FpqGMGgJSG26alzHE6ZEVMbDCAKxALESSLMSALGCSIDqSANTal0wSmYhSaU1BKZ1ANXRANRBDCoBCKU0BENxJUwDOtYnAD6BSXEUaMEAaoFTbnxxAPdxApAyTtyVKNhITuE1KeL3CMZVCOIIKqJBF/embuynUX9QK7qjCMtgbeakCOkVLwPFF/eWO/fHKAhbGJxzGtUwg8fhOYiAArHQLQG3PX2jcPX1LJMjTtPyF/uFFbqTjB6rCqWSBzHWUvQBP+nRYR+EC8YCYDIbYzYVU/BSDNahgzRlD0PWcMUiDNcxCAFEDLUiDMVCDACTACTxQE9SUIGCDTf3YxaBBCFT/XThwgRGoUBVcwBzMgQRIjEGUzKKxnY0MCc0I0aexoZYRiY+YYduxndpdlcIMhCIwgQRYAizUQy40AzDEVSK1lZb4WaBRQqbZYQ9l1YUMSR0xgSbowOXlCFQqVqi1gBHJkVYZ1mNh1UI4CZQwwTAgAxZgQdeQgC6UAiUMQ2edFgmwQq2FwiKIVmrRQD5oiUZcRCG6Fik0Eg1wTU/8lvY1BXABAysxgQgAwUuIwPPVxGApBQvQQAIIE3RNynN9xaN0BTfmCjO8gjCQQym8QikwQ/x1VyOsgjsyA+4sBrtYp0ItxrRggCpAy3fa2yv8X+7wyr2BxjwRhgM6IGf0F2FE/8v0uEYmGENpBMduyAZC8gZD4gY04AC5lIZpvIZpYAAOkEYmgEC2mEIWXNhHreA1wED6lA9qmNhwoGT8pGADnGQDwICDjhg1AF062BgtWIM0UMMsyJhMSgM3QMU1AMwb3BQB0IFNfUAFmEc5MKU7YKFSCgECuIHkIVHbSeXELIk/9IFeeZWSkJ2irSEgVQjZmV0oQmmYtdELvAAmpMAB2MAxHAIMeEGrweUUrABcnUDircAtAEOmLcSY7ZUEZAPXjElGzMMUdMMB2F2dLhEgxRkH+MCTLpGTKOnjQQ2SEAkLIMIJyAKf5R4pDJcOpMRoRhIlURItjkQmTYExjMkUgP9CNehACqSABazJCoiAB9TBtSmF9qVAMhIjn3RqMvaE8fnET6xSAfxCt10jN2JjuP3SDdQFWZTFOILKKNSF/NXFJ4hDJPSbx8XKX4zGq6hGJEwcX5jCKpSD/X2CXUTL7cCKPo6L7yDLR1bPrOAKxV0AucALNFQLQX2gq3jLtPintZgCumQLQV3cN7mKNgTHvqBGvWSYEKCc/3xU+YxUvUDDhrncvPQDDEhDSFkDNMjCLKACKsRk0M3CLARAAMwCTcoCNbCCNMwAE/gDFJSBC9RUThHAixxBFxRlOIyREDil0JThosWhHRKEHMGhVimWwtTpGULlziYN0mTaC5ACEyD/QgtEwyaoxEW0pZcSARG4GhF4wCNQqdMkRxEUwQoogCkJACPpQAzwEokAjZBUIpGMopLCEdx91ZcNBEOM2gEUgAdcZi7y2Q+AwxmkgABYwCaRwNh0RKxV0q5Fktp8zZh8UiHOjSucagdcwgR0gLEJRVHsDTDQRPQ9Wyv9AOCkUrMBDk8cwDDcQD5whVUsTuQ0V/ip36TcQwIwATPI33OSA3QyA7Cao/wRK60cCwioAu9IRmxAj0MChrrMCmB8J2Kol8b9ivEoD2r4jnpiQCaQ56zghgNqYLW0a4E+GEApGICWRrkARytooAZS2AVQAg5oVPnMwMHOAAysYIepb4YN/4cKuo8xoO+GoiQM0C+H6iCI9lxJrWRKBZBNXoOL7YIscEMCwAASqMMSEgAKlAEKVMADnII6lEA5cEEJVIEbtEAUXLCRoEEUbEGRSkAGn1kIb/AIizAGl3Af9IF/tF4UnFmRhvAFw3AJkzAJG4ALk/AFH4AR5LAl/AALgAIMcAINEN4nwdUGkEAK0EAs5EMs0EApKAMLXLAEIMEJxEIzcMIxREMMoAMLsMAHh9mRFOmRiLEGg3HajvEHY/AHlzEaa/CZjfEemXAUSIAEJEMKoASisgIoxADewNXtZcQ7HEMs1IMhEHIlsUIzyKIA8MIwxEJGnIAHbOoPeOqp3l6kNR+b9vmECEjbnfTEKgEFp0oboGDbCmjjtpkyMSkAGgQEACH5BAUNAP8ALGsAAABkAfIAAAj/AP8JHEiwoMGDCBMqXMiwYUETAjs5nKhQ4kFGCxgN/PNvAcVk/9Z05EixpMmTKFOqXMmypUuBEF8qNOFpUkyZCDMMtChQ5z+JlhgFNVkr2aQ1GnEqXcq0qdOnAxEVTNYL6j9PBLEOTNQ0A6NOX/9lkNjJokauSSdCnGS1rdu3cOMKxOQWHUGuKy3901uw06REnfRaKjupk9e0fBv28mTCBDpJciNLnkz5oNSsipZiNZFD60kmCXP8AzbQZ1kFZTMMXjCidaJEIwRaWpC4wCS2BEHa/dcYJO7KwIMLx3m5oDKrNwe6KljpoLl/HTyXHt1pQdmf1SV9XWKJyTgg/4oY/zl3bsGC1wskAc6guiCjSZCvJm+q7V/94fjz64cLiiAiugSZkUMGHfz0Rw6GrdYJEza80AIH/kToDwctvLDEP4Dhhd1kz+3n4YcgytShCAX9YtA4TDChQAYK5EDMbEXY0IKEEbZgAxPmLHBJJ4kQA5h1gyHEU4hEFmnkU9EZdMlsCpAiWIEFHUAQT4YxgmAG59gAIY02ZuCRAj8BFhRgfg0pEJjx8ebWfUe26WZkABJEijaWLEmKK3z59M8kyth1HIIIliUUEjagEaGhE9rwz5KoWWJJIo/y5dGGDP325qWYZqrQAqMs00gln1r3hyWNiMCnMr3UggSYjLCoABIz0v/oDxocZHPJAn9BymMnxGQwCSMafWXmXpN2ZFCxmiarrId6kiLQJ5bEaIMNMf5SRAqfTJCIJGYosMZsCzBBKAcCSQghGntVkggwtDEB2wijXFKDCNoswIAlsf20BFeWNPdPsPmmaQ4uMvn7T4fLJqywQ5YoMIJHlbhig6wSokErBy8c0aANRxzBAbkDyYoxKbc+Wp2O4jbYwhEt/KPoOHot1zApCngE1kaTEPOPszhRsvDPQCc0VietLaANE7FSrDTF/0TYNI0vjOPKJbdqEzETRbSAbkEVv2CDESouE/E/zWkoFlONBK322sZOuowCS0towKxLE0QjGjaMY14w2rj/woQRIAu0NdeH+oBxESu4Q6dAC+jJ9uOQv5SvNi/EbbnTgv/DgQ8PKGCKNnQCQ2jLB/lD+N2GooGEKvXta3bksDd13KXOXjLCMkVcbnlBm3dsRMcvvOCQ7hb7I/w/f3ClgMGxN+88Qs4u4EolE+u++9OYmz780i0ggcQ/CpxwAg1M8CzRAmnv/Pz67HcUbtKn7y5r0xRJuAWNHBTBhCjavDIKKOYIYAAdJZZl/OMV7Utg86imjSIgSiGYM4iETBKh+90vQkdgwiuWYYoAusICrghhJUYovYM1ghLOqgFcRvEPFirwhVYRgSWclbtyDWQLS9Ee9rjEBFdo404LMIcr/3LkijuVwl86eU4lSHQJGDoxaJ0QBf0KsoXBucR0WLQfGl7ABE1coBHLUIU4VBEOU3SqEqM44vRIIY5/TKAb3RAIieCyClA98Y5KmYQlJDbFgfjgHzhUCo00Fzx1eA8JKUpkDxUgRFO84lOk8AA0WkEFUqTgH1MTCM/wyMk2nccVDqRiy7YQyJdUcAtHIGX8DtW1cyCBBte4QQLaQYNqWMIC/4jjmjrJS5aUBVJMsKJASCdIgQTObkqzIP6OgAQSzKAB/xABKfw1x15aUyCPEEhmUlIclngEGJMIBhN8UEpAkkuVTNEhDktpMRvuUFaqM8WnyEYRc1DCZwfZwD9wcP/Nx7HgJNmsikr8YhBJdEIBRzDIH4U5GR0K5I8vOBcQxIbJAzrkOaLIAps88A9T9PNS22TIPyVDGoPUIgPASIQNyEmQFqwTOA71hw9s5DV1MAgIomjOJ/DpEA+IQhQeHQgqPvq4A0hJICZSiE4EKpDZucQE7CnFOAHpx4aUMzIcsIECVMEMC3QoqAvBpzmkSJAsCMSsRAURQyfyi3+OdCKZyeZbWdKBBVSCBhwg5da2cMyDsBQqTsPiQdB1gguIwgKN6I9D8OlRKW4ArRT5RFrdlM1sCkQ0CMnmUYnzDyDUC5V/JMhVCwIByejQAHZDAg4ssMmGIOwfUqSBQALwD9r/TvZnmECEJyzbzYX0diG1YFxitimVSaTAEi34Y0IJEtqDPGCtJzHdaBPiUKri0GmZsAAwSjEQUJBCsQRpDnj/cQvy/qMY/5hFKxwC1tu+RQIG2exMEPFbpuCFLXTxBGSYUIkHCQSVA7HYdPNKEDRswQcITi6Cp3s6NDTXIBbLKwcQtTRD2WAZIiBGNavxj/EeTCD08KkofEqDLKA1ABdAiG3/IVn3ViakDKEJZpniq0Rski6Y6AAwLGGOF/hgVg8lF2o191+qfuwfFvPBERBMKwM7GbqE01xCD/yPB3jDG9gwwze2oYJvEMAb20hDGiDwgB/Lir8iuMScTkiJ5RAE/5cc/UcWyPpTh4iCpy4+0oybUqwOdIAuqgHGJYqwJR0mVHs+EOZCO1bmMic6uk7jQBewUYEyVOAU/yjDEIbwD0GcggAoGAIKToECAmDjGQ54wAOq2IJOiCMF9qSEJsyhiYJ0iKNSbIQHXqsQtLY4z72MDmkukYhCl1LJmHuwg30AhW9c+tkqqICq97o9fxx4D53+xzcEIRACCITb3BYDAQjg5VE3oQIV8MYD/MGEVVCiEb8uiM/izE8p3rMhG8CBFPkJbBDJty0lhQi7glG5+T10RkX2IwS8vGUCfIAA28CGIC6tDpYyWIISeoEKTsFtb2PjH97utrgf/gFwfwAbLv8gNTbQcAJV7LQ/rR2IB7ThAQ+YouYUMQVk+70fEyjjF5Z9SmL2xJZELEAcTKDbIAeyXHMakwPeGLfUpe5pQXyD1Gl4MENodIQKCEIFAtkGuZ8ghn98oNMEELcYOM52tA/BB61QhTwbkgWdQ7a8CyFrQprDa57HxalNscSFnOSjFxG7EQUPbAT/4aAI/zcNHH+4uMUecbFffRunKHP9DnUEsDt83AMJtyBIjo0PnELsVheEp9NgA1yAwhRuVojeKaENj5Z3vQaxLVp3LhBzpM/vj+OKXd9mHm3MIHcTLBcW0bBkR//jj97g+NSl/mkvn+LqV4fCo6vtjwdU4BvdBr//6Tu+8U+j4OGYD/k3VHCEVsgazweZgBQl24gJCETvTYkz8PUTXIbAxjzigGA+AAE2AiH3406R5gOX5gKGozVQgAJiRwB3AHHjRnkUeHLfgAIPAAFQhnER4n0D8QGmRwDXhw1X5g2C4AKXNggu4HDYsA1DcAQ44AEeZmtz9jkmYQss0Xf7xxT9lw0vsQAioCPLgAQKWAYooAJGYD995DTeN26ktoH/gHmf5gL+0AdvMH1T9w0mWAGcs3kfWAH/MASW9g1QoGoDmIb/QGYOUAFUdwpGoADQcAKyB1Q+BVs9CDSKUBV7thDzcRA18w/L0AIpiHIVZz/V1X0o4Glc6Gll/5AGz0CCKAABEeIDbxCBlPcNH/ANz3AKp9AFXzgRStd5gwAFzzeACXFgZfBwT0AAD6BaNIBnt7B7HUUJ+JeH+6EIMEYRx/GHCVFfckIbt3IEn0YAFaAOW2AAhjJkzOWGTRCBDocCpUYASPhj9wMBbECF23B+rViBp6ACoSiKTtgHpTUQpQUB5dgHNwR+4+YNSGAMoGB/AlFeqIAF8igZQ4WLDtF/KbEYauIS/aIALfAGz1AGY2AACOkPzIhkgCQIbECJFeACT4B+2yB2LoACfUAjd9AEUfcNLjAESeACImh13/AN4VhtLRAE6igQ5LiGA6GOOIQGD3B+BOACRlACHv9gYvpnYlgwGbeojw2xiwyRAzkAhCbAFpZyEhBjCZlAD0cwCFtAiYMUAlzABYUyhcZ4P1j4cNP3DyhgjdbmD2mAAt1GBkkQkd04BKeHYA0xQRN0jizpki4Jk6ZDAJymOTiAA/Q4EBPQkwIhW0CVc0D5Fkk1EcrAj7wxCYhJEYlBMydAAg0QPIHgBhNSBiHwB1XABeFQBTxgBj5gad7wNGP5cIJAANy2DU1gQWbmD9SIeS7wBm/QglE3BOT2AJDmD01nji9ZYB9AB0+gOUDQAKJAW7bACvTYk3rHUaqgTwXhUQjzkwTBbwzBUbw3mC7xH7q1JwNxGwJRUhXRRFzRRBv/wAqhIAstUAIl8AJVUAJCwAVzwAVUGQ7hwAU+cG6UaEHf141S95X+AAF3EAUSQoKn8AFNkARPkH5QmHnUVm0+EAQHsQNU5A2ldgr5MwOokAWsMAAD8A/pMBABgAOoIAq3IAr5WJ1g1QrQEKIK8XsHIQrMaZ0yEVyZEScEkZQKoTMDYQG2AA3RIAQvoABCsAQlwAXuIJ9GKp8XsATEiGlNcz/RJ4Jn92UVcI0QEASrCYH/cAdqIAVp4G3odwoVhxKYs5ICAaEDYQA45AAgF5ocYAzQYAvHMACsIGfRuU8DEZj3dxCmUF5mVZ3/4DPpwyYw2hWeIBWbYRA0uikEQQkC/1AMvvAPqMAB/VAFc5CZ4YAA4fCemBoCVNl1d/CB92OJnVia3gaO/jAGZBYEF+QPTXB+SRACd1By0Uhu3rBQFCRTabCSELqr/yWWnugNhpIFxtCoA0EC6VVb5vUPv+cBAeCnkCoQqpCPBFFrbqR/g2oVtWACvYBZImESM0StePcP0HSTVTkHexACmVkF5WCpQoAE3pcG1hYEUkluDwdyBJqR8hoE8kojTUAHSZAETdCCkSh2nhgFfSWKB2abZvoPfbCSOIR5YohDTFACAvAPJwAEJfYPfglbWeAz7SV3AyFZn4B/ehdzbjQQjcBd18oUosEZ/4IhCoAXFvE6B4EDFf87ENYwAatQBHvABf9QBRVAle0pBHMgBOgaDi/QBV54hfJKiWNpdiBnjBBgAHdQpfIaBatqBf+qBlNYjNR4ChUAARdHXU/DAQ/QBzuAtgzbsFSlbYBkPDOQBcUgW9YqELOQECCAf6bQWAKBeysqWcxgnQerFE4VTsAgERkiEWwxLAqhCpXwCjYABdvwAWlwdepwBEbgBhM2QUdQBhHStFZan3ewDSB3kZSor1UaBRCQBqsKBv8KBi5QkZS3Dd+gDmQqjqZjYBDAtmuLQwr4D9jQXMIKCHTqrAWhCq1wi6pwEvdBrfrYgS6RrQT0AmiQDSPwFzcDGWdRGGkhEE0kEBP/0AiX8EMvIKEqGLxusARcICtuYAYtAAXxirpB8ABlAHL2WwbbsAVVm69W2wdzAwZq8K9kcIHUF5q3ipu905K9CnmnkAYEgQXXEADE+w+2cLN5p6wEcY8OYVYsCpTEtBTZhBU6gS5oIA4jcCsZ0QkmUBaWYAJpkhB71C8vcGqnAAVoIKRCYCgvIAFoIAE9UAJ7UIlkUKVV6gOY93FmdwousAWga7UQQAYZqQYBDLAuoIkiWIEaKKa4uWQ+0LCq5A+YVwbrxnQzAAjGQLw6mBLKK3MHIa08t1aDuxSTMlJugABZMi01ExtB8hMLUQM2tgBHIHEqgAZ7UAJV8A9mEAKB/yAE/xAOVBnE/uAAqIuqDwB5nhZ2U6qv+rqB+RoEd0AHAKwGdFAGmqifJKgCthldHHAEG7i7/zA3Cnh6DCk4WdAAtiALt7yybgG9MpEvBuADNpAD5VAO9IAEXlMK1iEREDF0ZsMus9ECBDAID3CTF/IPc9DIlBoC8gnJqLu78voAZ0e63DaJfZCvfcDJRLy7d9ADTeAPdNAEZ9eKW+aJWteW5/IPuUpV/uBpTcCBBUEDxTALAmALxqvLR2IRkjACIjE4QmCpgcAFCvACReAR3UsQuoQhloDMLyAIg+ADVQAAmskFlGrN1hwOFxAOEzIGV1ulYyYQp3d6FaCqmoyO/P8bBDugkmVAB58LBmXgbYIwBNtQAcFbErPyR0qWjE3zAJgWpgVhBDBgC1CNEzgADQVt0EtRICpcBZUjEA4QpD67mf9QlX9gAzyBLANBDDHwxwuggC5wBFywDFUgnyK9mZtZDgiwAP3Zzaorr8+Qpdv2AdtQBuVMxE2LjoatkvJqQf8Az9KnDtdXzwthbcqFRX2QbtI2ywEmDbYQB7YQri0B1XeLEhZs1Q1hBp1QII0xAgjnMkIgn9f8swjABUsQ0UuCELUGDGlGCoD8D1AAASEwpPNZDuGwB3OQqeLABZ0gk6D7D1GwgaRMB4LwmxJYBkSMqvm6A0PcyZJ8QRfpaRX/+H25SbblkkqoJFOXtogOVUokcA2gjRO2gF6kzRQQ4QnokANH+Q8FgA6MsNr+4MgDsZ7hwAHkuAU8IhDMEwCvUAAC8RqW0HlpAAXeoAKZGQLvKdJz8J6BMAY+MMQ0vdJpUAaliXoSaNiETeIzHSEGIK+QN4WTW5NlUAYOMLYQdgQOptQgVwYXhwQNgAWzsLEv4dkOMdWAAOTxbRCToAvZ6QlKDh8cIBpVsARusADhINxzEAJRSQZk8ADn4EIGcdGJUAqd4H1QwMDY4AZGEDwvcA4RxQFmsAVDvIEqLa9DbMnjhn4ooMl4rq8qvbsRQo53UGVTJ26kSm6eOWFtuTLM/yfUX6kQNnANFJyskmFbsjAQRF7k//AIWoEJWIEIY60MPVAFmFoCUz7lXIAGpRUFKmkEy6AX+VIQL4IvGQAB0qYO0uYDcVgOsu0xCilTTcy/VbqIWyYI2yDsLkDiDUtO9lPO+urnqwhxZmeaEDeCZMZgOmQoRuCAXrgQaMAKASAAqFDpkSELsmXpv4gIBUBfnrDfrz2fluoOQjAGUUAGA0EGL7DqB1ENP0AawDAKSJAGg6AOZWYGYc0DS3Auymg68b66VjsG/zDnn/YP0pd+D2Cl9IPwPnAHQUAG8wsBLkAGFeAAXquFtLsHBJAGm6MQhrIFCmC229dHReYPNNAAAv8Q2mm8CBQx1S5hC85A7g2hDJ6gCCbgBjZQ3AJxyHHNBV2ALlFgphDwAjQ7D1lADhgiFJVQBJB3zlwAxFxAmf6gNT1cBSHwDSXeyRuIadFekVtWpeSCtqjezYYNwPD8efWKlag3ZlBQAVCQXGN7qsn1QAoxDtcwC4I/p/9grHYmEHq5ErNACwNxDDw/EExFE4gg+WvwAp1QAgPhs8KNACBz06VFBj6wBP7CPAJBDAsADMFwBJxmA4wM6j87Ahd+zT2Q9ak2BhqP51U7k2ZvaqWpxGJ7qg5wBw7wBJ/sAi5wB1oaAmowkcVYdqQatdJsOl3QBVWEThJELj62eKLVNC//AAPFYAzNwPgCUbHRYBDps7yh7RKTPhDI8Pgm9Q9U8fNr0AKH3Mg/28gl4AYNWo5raKX8dRAAQe7fvwWWRBw55a8KgCpc/oULMSccl3AIHnK542MMhCAcg/zbsuVBhYEfCGwTtI3AkzT+/PlQufKDIDqCUNxJkvODC0HYtv378IQAgYFiBP2D4vIISTQDnQ7c8s8fGg5ojqBx+VTrVBrXoBVzikUUiSwDU9GS9s/Uvwlr1Q50+w+VVrr/htUdRkvWv2N1/f4FHFjwYMKFDR9G/M+ECU+Icpjx9OLfnH8OKZfjIvkpGY4QzlWy9I/SPwEbnFr6cWnBg38+hIijGDtc/7mJ4eaI67ExyJ0gvbf429IH26lTQgmcUvkNRRCXUEZ6O2VS0ClBBOioSQLmGfWB374RHVjm3zYU2H5zUMH6rz8O/1qw9rfe34l+x2gNPIYjC5aBsKTt+icAUVpZqxFTRhsogAC+umWWAP7xQIDBkPmHQvwO2ysxDTfksMO/ainsEV08SeYfT5RJhoMqZnsoHBer2CKIKP6JYod/IMDxiEr+WcYpEpxaoINKzoHChy5CcDHJJLmoQpwqlgnhgSM2SgMCqaxkjYDqPhjvG5OGAu4NHwJZwoHqBPkgOpzUaGImbI7a5hundhqKgG+wwcofI3xYryo0opJqPRsGuOWYWf8EiOOfOASgQQBneJHlGllIEAUHuETp8SlAirHlH1Gy6NQpWlhBRhZn7qOrrMNC9bBVV1/9pz2nWhiIVsQ8+QfEf3KIbATKBkLANiQ6g4CMfzrqyIZRSBmI1Q5GWEAEbcyA4IEuJLqgNtpko20PHx6AwIE+QOrtRhT+GWIlAobAZqgyEvKhggfcUIILb1ww6YMP7gAjJxeoG2KbU/6hbuCbTpHzG3Xy3POvLfgczJ8XhimGlX8sZoUVWwQQYIBmQmHlGmMawMUYU3AY0JQJLB2omGJmmeUfsJxKC1abb8ZZsBaaeopnrZrSFbAcBlLkH0Q88cSEXkx4YQEuaCtnxRL/3PDhIysHwhGCF3a8ZYJbPBjoh39EsEScI3zwhoAKJIraxbZnq6KEIyA4QtyQ0ghimyBceOIpArg0yRuS0iDPhyqEKKECb0riG7tnCHhzvH/g/AA64rAZDr6X1gM0PsA48IeGfjJ2Ko5hBEBmmGGugeEaaTzIAgceSmjFgwBU/cdSQOYaSJpm6jrmrg4Tzbl442sNzFZcCesFkwz+mQQRE3L4RQIhbBsobiOOsNqpHXKE0DTT/ukmhRpGEGEJH454Q4wPKijhn0Bsg5vJqDl44I4+toDgDjLKuAME5FSn6AAlOlpKAwpc4ILWKAEBeCiHN9LQBEE8AQyBSJNJfuIU/zpsIw0+gEACT5E5qQDHLy/x2ec48AIYsEIWrLjLMPoiABi0EAk7G4hVsNAAGNAgZv/IAjRkNhBaNMMZnLAQXeyBGAkdz4nGa4rPouiUFE6iA4QJmglq0QtltGAODmnRBbrQh+595Htp2MIKcOAFL/zoHz/oAAMuMYJO+AMbd3iCGOK0hCaE4CFViJo2SmADHAGHI/8ggBj6IAgxPMUkA3kGUAhwhzvQaQxIUEI4hMAFdTwABWRoQhL0paWTSE4MxyEABHzQByh8owKnuBrnZKWzFmBBGgKQBjKQ4YxhSCMByCgCFf+BlRwqIAGA+EfMWOYUWTSDFrtsFRaa+ERqVv/TPRyaBCP+gQlPLK0FVXgK3I5wo38YKwjfC0IfiMCOCUzADnLQQgqIUQpLMIOQgxiKUMTgBkG4wW3hqEwVfPAbjqTBBdsYgg9cUAYuMbA6TvmGC/5GgIMukABHqAIPLjADISwhgR8IBArs5L6hIBKRQ3nlA14ykm9ArC4u4QDo/mIAp/ijBTaAwS5g2AxO/CMBQJglVB72DxuAzgYzGAgy/eIMR7xqZtaEas5sZSvDTOIfyTAB9HCVgwPQagn/QFLcJPAU9XCkD0zAxQZuYZpLdCAFlriEON7TppNM1AVmAChAlyGE9/QmCGm4w78ekECJInImXJoc4NC0DZ4E9gP/HBACHi5QgjkA415veMMdtIQcbCBSJYJIiXK84RIfdDIwaHCpYKaChDgkYAato0EK6cIeLJTgBS94Kl5S5ypnyAJVUQVuzg4wCasahldrYIQnkMsBb2ynIVyYg0CNZax/7KAjECjCDDYwvjdaghSVMAcaVPABFEwnJtuogkQcUoI/+CAN4NLsZgnmjZmQ8kuS/MA29KUvB2yjkml4gRA0WoIZ7AEKp5AJwggmUpG+sgKurIAKpIIGdRzBc3XZQlAFExU0IAELRWjPhZ8SlahMBQdKOAEOjBGAH2plGjjzhcWCO+NW2UpXiCAM0nJg1U7kgBFI4MAQnuGCr04ktTfK/9oLoDEBD5zgH4mIAQMSMYcWpEHCH/BOSkDrEBdVpgUuMKi+BOGCQXykkpVk4GHRNJAx+9cFlNxNnDnAhRIgAAFC2MMDhvCBd0l4zGlIgzdA+A8pPeABKvBBcwD1UqoaJiuN9kuJ/eEBHkADB7YQBatovGlOn9YpnZiEJ4ZGmAX8oxOdIEYHlnEEPStwRRI51kcGgqwWQMMLYYsBMYARDDPES78DQfAQFgfGcgjBDWmoQJ22gZJTPMAFDgjKHQZCFDGzOb/+nSRvHBDnI/SAsncOhETf+61qsWYLWKHKU47wGzSoB8OQHkwUsxKY+GClBdcIgMs6vW9+/wUTIBJ1Yf8swYjQLKATicjAAjhQgZl4AwGH28iNZoSjIHDAAhM4QQ3eCIxEBIMDpxyIShDpApI8ZBklMAP7JIqSDW5jCw4IbCUHgq+ZsHnMk/xAnHkjI/0tQAhzQEAJFMCBPhhaEN8QTwUSjQZiBmorGq6pE2UqlSIc8xq2qNlfNN1vrh9PApiw6iRGPRgFPGUBibDEMnr9hmUvYQ4h8NZHZF0sHygAGnUoxT+I8YNwvAACFZjOSUM+GbA+beFpG8odqFMBHDngzftKCbUXiOad+JeSvCFDELY9r3rV2ed7QPY3UCKvqXTuyEI9oROnyJ4GDIAV1shQ12UP3BbU3jGJIK5hyj7/kE4swPfeIoOW1LGiEIwTAlGQO45sgAsmXOIfjCiFDZbCk+osmyglBygX+OQN0VNUkt445F81b9A7bJuS+xqPC8jAG7z5NQpVesEc4vePEpRACAw/F0kSXcJhLpre8bGpJ1o6f2CCfqAFmJm9BCyesXoKWpGAphiHfwiNwiCFRCCIgbAE7/oHc7CEFyiDJxAEFZiD6EI+G7kRcDmCRiACICgFYZC+QpCokhoKF+iCQPiHcig2DoCCoTi67RiKZvOGMXAAvLkDgzKoBQIKaUNC/9G2IMg8cXGJC6C/cpiBOQgELhinwVocH6CKkEAhETuhEoO61IMK4DAhqAiMI6iK/xfghd9SwDe0GQaElU4wO++yBFGwgQcoA2xQB4qYAwmwkuNDJw5YACJwBSCbvn84JS45CTkpB8oQghfggJhQtqHwBiMAJ8zTuTgDs39IAy6RNrroDZfwhwXoh2Kzgdt6ATe4kff6Ex/gAB+QxRdQw4GSrafIis0pDFLkRVIkDNDBAhgYIq2QscMoRjhERqeQgDGkQKdwhQV4K1dwBWiwgTIQmGuJLh9wgH/YCDfgDDdQgBaIgjuogJ8giqMgr58AqMM5myMQGARjKORoAh8oBwcYA83jRM0rv8BKg2PZtm2Tu4HQH1I0ByH4BwTAAQHrgTboggo4l4EQMR9ogQyTxf+KlC0T2gJ4m60S6sVeJLGt4L8tsAE0YAIY8AWtgAVngIUD5LRjTEZX0UgPWYBLqARNMAUSuAYB8AEF2oYKsI1xmrWskUU52TNg0y+iUI+o4asWAKGIOhPR+wcUGJOq8auqjDMiDIIxOBatCESOVAAhsLM5iIRI+CIuMAJEg0iIZA8NO7eQ8JmsCImIIUU0uKkjOIIXsJV5C4zbcj1aQIuniL0Z+52XdJWxksNZ8ZBX+IdPYIsJaAVUeAcYsAEHoChsiAg2oEqOQD4roQ4xQJgss5M0iAjYsK1x8oEDKy8G+gdsUIE0MAMlIDSrzLydu8qAfIr+CRSsWAKwtDMEwID/oCuBJTAtkHQ6rSgxtaw3wABAUjyCszyFVwrHF4hL//sZqaCHF8gH4dGKVBhMGgtMwoTJf9AMWKGCTJiAeSgGGFCAMZiJNGiIOUit3kA+oqCOvzEsBwiBEKgCG2yBs3kAHxiEQUgDBxiCJxA2qRzBF8i88LPKqtRKGaGRG3GAt/QHBUCqg0SAC5iDC9gkb7CwjpTEeGu6/5sKKOiCUxADOsAjOqiAD32pnvkHdSiCfADPGqWL8YQqEHAKEGinVngHapiCnfyXb5C/Naiazriu8jqlnli2GrSNEuiELXCDcjOCCnAAH2gCNhsIIxACDPgTzfOI3rjHbcMbMrhHbpwT/4gxQ3/AgTpDAGPoTQywPyR4gY6MD9DRS1yUii0wAF2UD/ZQAQQTCjwihL5pUZf4yIFQ06pwCRgwhlToOje0UcKIyYGYJagTgYEoNcJop3/gURCgggBABQG4Bnf8GzOoAC4ovrlBUvciDm+AAgcwgy5AgBAQgipYt3vkCJgggC14sFMQD1jaT74CDkCxkoC0Tay5kYyYCkJzA5dw094MOgC4My5oVlzMsI6cLRKjzqgjRfSgg78ZoH/4Di9RAQuri0Rjj0Cx0IEglWFIok3rzkm1GRwdiB05DBDQ0VZgi1uYBwHYBSN4Bs0SvbjpNaxBFghQAW9QBwV4GsTBQg6oG/8ZAUrzMLSLdUUVqL8SYDc0SL6dg4AH3cplhYBvhU1/sAGNwgWLiIQMNUiLQILZOjJfVEsv5IBuDZTfsCkV2KCBGZjI+QahQLT4oE5AqVN/QIILbRXt9Ata6AvBSMl/mFd6NR5XSIx2ugUS+FcBaAAseICd6II0WALbQL5joTgfcIMrRJx/YIOiOiQQ8oEoiAL/HKwHwIb/3AIjsIElQAAeWAKXwIoZuYMZEUVc9BwlgA/6G4jJ4gEhUAIFUALHhc2nu8VAAUAvPL2aAlwFQLCS8AnJEUhEUgHQ8b8MGwi8ZI980Bj++Adn+Ad9kNTBeNqnCJWnzZDYfYrfOYbvpFr/4yEFcxCMmNk6fyWBdjgBIwBB0esCLhCCn+AM69qIKKiAJUCC/4yCkMURcmKNQkO2f9gDdXiYLegCBzKGC1ACI9BZfzAAbdzKZHWAkvWc19xSHsjQoHMHG8DfFniBIniBTpCMC0Ohyq0pPrnZFyVFdSCYDxgCR3oKOvgG4liY4xwICzOA2psKVnCEWXDJwuDdp4BXvviHUNlgrYjX3qWmr5rAp0AFsAiVeRiICTjJ0iCBVqABIR2KWZ0DNgjE6/WIkPhPj3AKq8ERKekDFZgOQZNEf3BTLshQJcDTuXQKwqWRgZw3JYjZf+gBBACAgSgBc1iAKnCHzDCDSjCCTrAK/1k8Ny5cj1hJ1JdyiQcojlDUCsT6B2nbhifYBnfznAJugSLACiy4BlaghQ8+jNkd4Qr5BxpIjLIoYRO+GW0AhbrAhX9wQ18QgAkYCAGYBxKYgA2wYQJQh7EVgrmJNYrzqyoJxO7pjA9Kg4FJj5vVEyHABTctAfP9kxb4BlIMDsTSvD5wCZr6hyVog6awPwSgjEnGACGYgfhRgl+Q3HG6WVjkEwGWClvMXLX0AQmjA6c4CqIAj/DgEit4AgVwqfi4ioGoB9BRABhg2mgwjL2YWq0IBcKIZ8I4ZEemC+d7CgtAEL9ohU5xYbkQgK45z3ZyAlxoAQJ4HA2CLlZE2OyF6P8gNtusqZZ/UIGy2wIk6AIbMAKN2lAEgC5b9oclUIIW0OUx0EqdBWYjuAIk8IccyKT5y9ALcFkhWDU9WV6izQpqBglolg8KOxdGNKw5IepKEoMKSIoRgxhAUAA9McnBXIT7kAVYCAxkqGet0AcMweebcQVmSYyTfIp3+IdWmAAehQdceIEnMI6UOJw/iALqkjU0jbUg7o1i2VUUkJMj+CIlcANBsjOOMmY4bYNEcwMKqIIn5kVA4YA2WAaUvYJwwAD6MwcEUILGVYILQOzNKdYz/AuJpOY+RVkJC1aT+ma/Idd1IQCpBAlL3T8BIAGJgYF6oAuqfhXZHgx33uqbmYL/DhEisAgASpgAKgABcsAAG3iC4AsKZIsuMgriq5k7G0lYHPmeeUGDr9wDeggHG3AIIRCCEciAEuCClg0HJTADl1CCHjACbR0IdzC1c8gkoAs6G7ipHCiHpubF1facPGU0nC3Dl+BZEATdadsgpxjwDxiO0RqIPt0/Y0Laa8gHZFiip8hqaloiGo2ZFsttTpvkSW4FEHAC4XaCSLCBOiGEU3IAzJDiWXMKzkCW9q1rKVERg2RvIXjNc8DfIvjK3sQDyhYCWMQAK6bZCb7sEhhy+gPL9UYAmHKJP0hvM0zLwNiCceLvA45j8HgowfMbOwEPbGA8p3iY+DiBBDgCG0gA/3tYhKyOcLA2HhrNh0X4B06QoXzLcH7LhH/IhEzAgHbqhvIpBcmoEzF4gm/4gz9kDRM02xY3I2KhODT4g44qh/qj0xd4HoO8HvgOOsal6QvogQUAw6CT1qALh04oAR7Yg/MlxVUIBwVQBzG4Zodp9X/o07OEAkAHXTqmiwF3ilN4Vqf4UCZIAAVAgtiO1wiPcCfqKacQgK2b8+Ph178IgEygggnoBhEAhgKQBBv4B+NQiW1wOzOoLrPVSnNa8faNtShwg476uXN4ASSAXCEwBi7ggcl4U2N+U7KUP7FUAm0YpqZgxUj4TYtY2X9AAvwFcpfggTZAeBSAAzJA1IgZKP8DZg/O7awrN21vJm2n8OZTIKYtkM5/wIIEEAAFuIGB0AdO0AdIdaJ5rosl6uBlD65XIAdqRxpEWAAJqKA4+RtBcIA5cGtyMvSf9x4k9SI6o9McuFVt4AIO3RUb8DnoojNL781I6AFlyKFMwoCWtTMpHAiwbHJ/UAIKwIMvwIM3sAIpANzfoLcxXE6clmO/AI+6+olGCjmiKFmnUOeLiYN++Id64IQlSourdpV6aHmXH4jDPJ7d9gt8IAdikAQTkIRkKIAFOAAX2DMEwwYDtw179Qvn3lUzuFUbUID6IwiAKgH+zYH4mSz5s7PIQgBciARcALp4D2ZgsQjKsIg644L/HoApN0hZChD7IQADX56KteRvkGh10qqAf/mS0i4JFNUSjM/yZrswk2aFAbD+UDDzf2gqwuf+wiCGgUgGXWh8SciARLAByqeob6iOO2CDOaCap4hrFTdBoeQCBbCBEkCSgAqHoWn0lz3IWgaIEjy0LSlxocScEgiU/PknpMS/iAgQzEHwbw4XJf/8+etBIZiNRG2SiOnD8aS/f1t8oInYMqK/LX0i0qR5EtuHUx++bRMk6N82mj+B0iQQlOYQQR8IfHv5j4M/ZwP+1fvHyZk+fYaq1uzq9SvYsGLHki1r9izatGrDJspAbBKjSYkkWZLUKdGBNCgEEeC7bVsXIX8g/wT5VzgsGcIQHhypokBIlYhz/nX6504IDwRcEECEqOTCCxsG/5XgsIRHQn+4FM7BxRkBhjkXlJzzx6FNmy948CBp4eYkGo5dffjgQNOAPx9bwHJ8cIoAAZ00txH4V90sgQ9lvvlI6eMFmgGLOHF19M/82vTq17Nv7149sAz/JgH7R0wSMUuJ5Npw0VfQE0u50EU44fiQhmFf7WAYBDsQ1ocbIUQUQmThoOFGOBBlSJFFAhXxQjg8XCCEO/4soUSGQiTXRkUX/HMBAuEslIE/aAjRRQsbocQBB8G1lFJNaBD3UnLMxdTFX/8sRd0HSjZpVFlifNBkd/+0wIEN/dSTT/899ujzHphhijkmmWJN8o8kiRADzF0M0GVEGjqdwhd032RoA02HRQRBRIkFAQEZQWxRxWQIVMHDH/5cdoEN5XzGmhBztKAAD0Isk4A2/syW4Rw8vOBPC50qgZkQ//SgAEop1XiEMmbMwYFyLoGFxhHdCamSVxylUQF1dc55yj9DHGXWN0/8880DwqFBwxX1LKLPIv9EWya11Vp7bU0L1BQXMf/s18kkkiQDzAvePOdXdi5kmIMPeoKlGARbFPhPFUIY8UIJJSzBRQkvVCajEAq8gECpji7TkhDl/DPRP91x9IIRCihghsPJ/bHEiJVWqkQLwTUM1UZgcbDFC0ZyUIH/A9ssWVN130Q0FFkfbHNKsrYdAYM+nCCjjz3ocYUt0EELjZYl2tJUWQGUAWNCBmcUkMEL3ySVXV+n3BHCHAb+w2dXhfkJAdhHYFSFEkbYECmhhPKAhBEJKPHCOQkodEEClfhTwgyk/WOMQKmi5IMNBX3xDx4JwYZAAi2kusURIQNJU0tC+oArTCdB0UVE0EEX0a+C/HrdWE1i/uM/CRjCzz/DDFDVz0O7/npaEoRpNLgmnPkPJohgknstOfIlhk6CiLENG+HMYcTkXkUBNvNgm1FCvTYYAf0/XFRhvRFH+GMEEiNoZBAP5twtxIYlhMPxSS/scRmpSkAE42acnb+E/98hb/F4TRwZx1L+yZ3yzcqG5RUBisUop1DHcvzBigjYIx9UGQbqYCfBCZJFdu1JhLcwWBP5/KMDmPBgB5ThnF55DjouWILxutCumpBhT18jAwesN4MXmIFE5bAevxRXI9sQrASRwEMJ7oYLfsGmHF+oDQdG9IUeYOAiMILRaxSCgEiUQAjBuZ+qYAIrp9hkZCnhUQI5goRTbOMJPxkW6CLSJLJURxDYqM7I0GCIRRjCKl+iIB5hVzILjgkY9emWJb6CCU/UAhGIOMADUMCk7ADQc1krRwvGEIWvKCYIfTgCv16QA8hU4QLhKIcQ8BSc4nDECJUSQgvc8Sj58aBER/9wn2YWVj2JTKSWtfzHp1ASMpsspysxMY5LgsMRH5wCGzQRA5Ta47ltsKQF+pgKJ/6Bumi2p455vGZ7WECmEXRCWwUogCQQQYxyXacvZeCLCyrAhWWUwA3JewCgGPSnIPzJCG87RyDEYbxPCkEdLtHejgy1gARcwDUzmE05/PGCNlwAA69xjUIgigCJVjFTuvwKGkgHk+TZ7yTb+AY2imUdAqbHc4JAwQtekCN+QOI80OKKIewxlVhgs6Y2Tc8CCtCBZOgULiXLnH+mJCUC4LAKvWyQYSZJmCiQoQ9L6MQLOFmgZYTACC95gDBT1YJSSXEPSqDfC1BUgvjZ8kUTqYj/bCBixYvibzjK6mX/IFAd//hEje2pzjkfsAUkJMARDtRHBP+hD2nkQ6ZrsaZZmnHTxbpHg96KyCQQUYBJrKkTPlDkP5D5O+hgoxxzEEcJovCAr8zTeWdzxx7mcL0S/KGXo+2fLjukhAXUSET5cqhrbDkR1sBmNkjYoXBO8hXvcORWWNTRFrxRgZ+YFFgsy9zmSPqV6tzhFBDABhpuUA9HbPeOnNCHeRZhD2y1jlqK/QdNGVutymRAEoz4BwM6WABPAKMDiTgHBAKkxuwM1RtcKJD1tjCGPCV1nn3wQQnKUaBPVmEJseoDXP2GkiMgoBMPE4JuM1xLtJKGC1l9nHBn/5XR+/WvBSoA4BOA5ROcECUoTMosdloGATr4wAhXGMYi6pGKfHA3WuG9I3u04pV7qLfI6gHXXWiSiAIAYxKTsAQiUeCComhODNgIwX+5wIUXPCAxLSzMPIMQBS3f0HibcYNxthDhLOooJn5r20NrmTeL4IImeXOIK+pXubYGqXI28QcUUFAd5+YkIkHRnHWGcJZvXKcCcq2AP7BwAxyX9x+5sAc1jaxpCr43W/WZbAYsYd8cuUDRHxCEywjwDAIEYQ/hsF47V0iYrTXPB1z4RziqkGsuVKBxGc2fTfbstwUIAYoUuYhFIuIiZUfEUxf1JZ9h0svg2qYMfUljTT5grP+urNEsP4GAN+igDn9cw4Gw8EqlN61uPgrNsSIAxg+AYYlJiKATR1BxdqyDjZnJ7A1ZyzIXHbSDIAx8kgi44Q258I00w7Z/bUbJZV4zkTpPBiJeqaLijstn4WBUoxw5wnLn9BUxKGk9zvVBdprAmASEIh/WdKBNM61uxt7OPm95mn5yAAEU2JUvnkOmCypUoOP54MuKEbgPdJ3rAlUBq9B++p9Pkq9byvIiNJlMoYRAD+DuMlfR9mVwoECAAD3hKC5jT7dpAoGlkKECaChEAuqhFWgBeeZ2txYpwmK0f/ygAOD6wQJGYQRv7IUvmbP2qQXBBlxnbQ5L2AI9GTRwekL/YAQxanwXRusUjgMb2goVgjESsreu1FkyEVHFDAoBXDYHW0dj+fg3ynAs6rwnOrZXUgUeAAVgfQDS9RgAJ+hYj03YIxeBvTvyh9at+qbJEpWwge15AhSjUJ8MmFs6O/vAJzGXNggtqILSea0Ojroe6q33BxJmsAcpynIyNXH/HLRhrxC//uuVe0AFzPUPFlvH0EQhOVq4mJIMhXM8yTZk1+9xRbQgVvI14JGlBTAkgiWIQCK4wgtUAPC4UQllBxkRgH9JSIGUgAqpXWlhkoK9WhmoA8jAxHAxxz+YA4ZRncX9Q+n9Q2yUgAIkQC6xXgtynudRh3PBjHVoDpQI4VhE/4fnLMU/VMA/IAh0bAMduIEN3FgE3MMh8MMmjMW0OCAXgoUJlEUgJYIE1kAiVMI5JFJdmdT0LdI/hEA4UAgCjGBEOIhivEDWFMgSDEjn5UpY+MNjJNv7SQRNWIQQFEG/+GBZ8KCO+IDLKGEyZU5RFIUADlCLEQUBvNHWoECTPMEz0FgODt89QEI0wRweMWAXjoU2WYsIXIIYioAIjEJ/DAHoNJeheeASUISZOVhElFayVEEkYNkeuEEFpMHkHJdaIAHoKQRptAZNzKBnvAA95BILpgcWHYEK3EET/MOcyB5ZAItz1USK3d51ONcDjFBEmFETRJp2bYI1mWJEHEJa1P+RIaBHWpCimNgCY7HbWVRGp40FKSTCJQADBY7CC5yCN1THf6BaLbqAIPjXG9bLEYCNg4gZcezLrnkDqkFB43QdWtDKQ1QCRFDErd3awrjIZIQSEtQMR65Fc3wDHTTJUTwiWHyUdAngUewbNjwBFBBTt50aCvjDMFDFIuTDIiDDP5xXkJVFup2iTdUAKyYCMJSCAjhH/22OUFjHknSBakEPB4gWBESBDzyAGfBLgQhBBbiAykmMn6UFyfzDDNhA3sxgRNSgQ/GADdgAQNmfWdwPB+zeSx5a/53dV3iONn6FNyJk/y3TZdFEk/AFHfgDP9yAtOhDKoBJNMFjTckCU67/xbsJ5DjESZOEJiT+wxl54DdUQQiUxgscQRQYgRug5hwsQzkQyh+YS2/ggg6Qjl5+BVQogBLYALGVgCuEQ00k20QIARNwAEC1xzCpwDOQEWOehREamvTZZERgw+Q05jl+QBN4gz8MQAT8Ay84QyzsDLRI0BYuZXrQwmaiRSKUgitawjq8wDMUJpUh5kglxTeUgBnUywXwCx4ggCdpBheYAaOpABpYgCpMQMkQCVpsARq8wBegARMIwQwsASnM4BzE5UIk5xHA1Xo0hwoMQWPOyXVI18uQ5jd2BWK26CWOFomWXFCkExSgQQLk2OrUET2+znj9gzWpZ3uuRSf8QWV4/4UIwNd7LgApXIIIjEALAEhEACCUBAVfLIVPEAAUKIBqueFIXk8FdIEb8EUX+KGLWMTm7WZNbAEHDAwHtMBYhdKFWh0tGQQaPMBMoGn9gRxRfIMbGVrasag21iT1+Z8aVYAPqEOKpR0BkAEKpAEHwMAiNIMDyR3syBy6BWl7rEF8GOk/kMIIlAIDMOkl1IAl+ECMvky+7d+xQMcyGWoTktGcrJELPIMLnMIpVMAWKAAuVEMjTIAo+BKIioU/HAEabJk//MEMFKINKEERXANpRJEqtECtuIeuqFjJiYkxsQwKPIBeLNN2RISUVYAbtEACwMA/EB9NCBnsHMI9GIK6Yv9qe3hCWFxCRFyCvVqCMGTAEUgZ6Fhpy1AHqqXTEaSBLA4FzBhFuKKBApRANfwDJVACLhQBsCbi/UyMQvVAwPjhPyxrmY7VDLRAC5BfehRXIk3ZdIpJUBRaGvxHRPApUWCjC7xAP0hmKEREUCKl68CUj9aDPMJrWtQCWXTDP1RCDYyCK5LqZU3ZfpUoU7BqEyZST3yj7GlOOvmAAswAKIiCE/yDKGQCDfAITZAYWZyEEWydP7iCEtBDcOAgEnyBRSCAMQjBEsAKc9qGP42dtaxRdUABGsIqYRrFENwBCqgDGsTBP7CCeZzbIgzDMLDCuZnFAPRoe+TCWWyhz57FCNT/RClcAikQgzCM0yAohZIAC5+eHXXQHgToxKmh6qGdggu8QY2VQNZOwD9kAdeWgA2sYMNwkZFk1B7oz0N0jD8IwQvcIsGsnxCsYLCixTBBgYxWi/RFhDc4R13RJE1Ul7b9pAJcASvI3TAcgzM4gwTpA5GRRTMgg+RuJruZgCHV3FcI7SWUgia4QjWM6g90w2UtBTINiwYmhU5sTgYiWtUQHgS8wAx4AA74akRsAMQ2QptOm6z04Q4ZARTUiI1ZlW3wQQssABLQgxJwQcB4TIgmhwpEh2CWiQbKyWgloTfmxCUmIQHgqhHcgDS03FHyAgUdQvqCRSzUHaaeA018Yd6B/wW9zu8lTCAx/AAzlIsmPu/L8GlfZOtMzskQEKMNQENYNAAQoAGJ2QYwCauPqAME1MgeqMgoBcILXIACIIEx8EEJdAx7DJM6KBpRVMtQEEAZPMAbsaxfAAVg/sO2PqqWXEMqOAPrwE7O+LBXOIM0XK4EFAH7IkK9gsWRDu0/QiUFgkBK7sWT/MMTVM0LO1dQkJwgWJsBIaEgDMELQAPtci0q0EQAuGXu2o/29OFGZNSwdgdxBEKyjBIXiIYrKKwSBAKQ8O5ZNKc2njCZqOESPkB9nkKczAxCPodOyN4zNEEa+ENV2DCO4ay1pO93Ue5YNIP4+qwyAC1ayFsGRGANvP+CAnCAlCWFflmHN7SY8MhoG0HHUsjMTrwALlDCrzLwr5LAP/hqK3xxkegltV2RkKhAcdWIAjSGClhJFfjTeowSo1nlmKzRvj2xCzxAGWxDGsDwX6waujwBWj5DpCVAUHLCMNSDURrltdQDNVWFIl8uWJwDI8jrWTzlAmjCJXRDObxAdWjHT2gONpQQaS615vzKEHbBJxPAEeCAKYhCK3sATWDBBvzDCaRZTDSOIgobR9xPRvnAEUCFMBHrERiB4hyB9hizWegPNqxotWQr9Y0WNHtDGThXOVkHMvFEDG9PAkxFutbsN1vuWVSmz+ZAWtDrP9qrOYhAJJATY4KOy/7/RGiCjrBkDpOgAAe4gyp4AFbXhAegAg1MQRPZxOQgosNJ2IdKmEoM0xerRXER3icrIbVsgzEZ0Gsx0z/4R8q2rHUcNQGIAeyiQSOzQiGXs7XUkT38zJd4c1mkV00pgFooAwehRd65giZUwiuoQkpWQGMi5DRrI3NpzswEi+31xcwcgTn8gwdMgO3abkSQwAaYtg8470YQxzT2oJtJWKq0WazAdVzvnjlZC7A0NVCUwbYS3k4UdRkoIR2kMp96wxtU8PbKQlDKtLWQxz/0KOW6I1mAF06LBRNERCV4Nyl8ggLwZJP4B1AcJGGWHHCT5k8kOHXcwSAcARBYBFZnwQbg/8At0EBEGAPbDELyRGgiPpxw1Q+QxASBH7MPqID1UguhGWQTpgF1pAFV6q1N8ilfuK6gKVQCCAEtIAN7pmtX3HR7NAPPYCpPkwm9VoI5NII2vEIlRIIPJPUns2hfDCFNPMdSD2FxR4cRFEEDeMAsiMIrv/JWb4AxHAs2QIFxDCsLLsfyOo6ebbpy4GkfbgEUnJhMiskdqzfNaCIK7Fx9QtcyAUtScM5S5N5KV+Yu0ERlOoI+ZCGY5ExN7PBYTMUuMPdNYcI/MDaZLECKi0IlNMIyaAIA2ECcVKVP0J6S5FuVx+g+8xcUiIbttjKRi8It/AMqJO228qXIju2zpbulx/9xGqgDdFJLX9DxghOFdSVSk+xvqhrTu8/VXvhDlhxuI1ODZkoDLAwDh6PFm5+Fpd5RI//DADgrMiCDZi6WMvzDI5SJJkQEKFCCNohCNWQCnJwCMk3H5uBnRNBxKpfQ/17iERgDFvwDDWxAFjT6P8jDCTxANhJAufuD04XF8ga4sKl2pjOvD1SAP33AqwcLmChz/3mDC3xDMT2AChieyZOcC9fEzLyB9jCLNOzCNfDCNThCMyyCMaTCwYvJ2UuLj/7D40pLI0uDdJd4TQA1x4NCJVDCJ7jCZc0oPhNqV/jE3+q2ypyCD8Q8TaBCfP+DFzTAOOzcT9QzViUPXGU6/gD/vapwXHIMPfO6gcgFxTd8PtODCXsXhnL5gANkB0lhW00IAlpig0LBgBIwbj6QsywgQzFERMOHyZfsTFc8SwOi85i4QiXMLyhsvDZ4wCvcm40TN3mT1IqalFH0Gg3ggAD8wwaU9j8EwBQkgA14Q064zDfkHn93hebv0uU3nG2cO207fjH5E3PB+zc0aqGN33JV5TEhWle4jBjQQaP6YbkeJUAg+zeQ4DWCBxEmVIhQ3798ufJtGshvYUWLFzFm1LjxYqVL/yr906SpUqsW6rAJEjRE0L9tAwls+zZw5T8Cgm66xPbvGwGfD5AYo5TlnwAaqERNgPbilJgPLl2e8uED/83ALQSvavRX0Z8Pjly/DcH24ZSgsl/RfiDgwsEpske8qXhqcy5Mhadm3nyCgiqWK/9YsZJ2bGAztBbzDVxUb5E9gg0PR5Y8ebIFV5QaNRoZ6VyaCmZ3EoDpU7TZg6R5fsBJYIg3G7i8mJpAYsO/2bhe/GvZUvQ3vl7/Vf23dSDxyP4eZJ3s70gFsrttUj7YEiHeCmnKfKjg5sEgn24HlhVdEfUzMM84HDEYaqAzZ7TQLroon1O9XAPtyZe+H6MC/hurEaGSSijRRhNXGlHgiFOw+WYngl56aTdBxHAppn9UU2sbAsjiwJhXJvjnk0Yo+YcSHGx44omnniLNCOX+gf+RMn+26GM/NNS5iTrT/ktItAix2WYQH8a6kKBvIlRIJm96u+ODJtLwB4l+EhBIFlsQakYggQiqR6P88nEEsn/yQ2iAhLjs0SITDlOgk3+WWEAyRg4KI4WPLLiEkkpI0uQF51iKjiefxFBJpX8qRG21m3zzgZ5WMAPlH1A0oWQC4GASg4BvTlEHq+L4oxHT5R5QISc1yfvnCQ5nMuKBCryBaZtTZLqjDJoIImug5wR55h863ngADSRg+MegYmZxZiBkYLmGF4IGyMcQXjjBryJ9okVLIlQXYhMhNv/oxJV/3FlCISYGkjMyERJBSF1SXKnEFVIusMGNIQgIcqAHceX/aVeCxotpCBekOkGUf1yRVNJ/LsgNQ90CNWtUNZnjD8fSAqUsJiRPW2g3HwRJKSXd5jL0QRZztVC3O5pAQVgVDJqFFfiKsUdZaRTLZxF9HIHWInvEPOhMhBxbaBhuD5rknzX+UXohc0ZAyBKCpuZvEhFIqYQUkighZZUcjBgkNJhO+adsglp6qWPSCDgljSImIOWfj6rxwgJjBDXbLLNOgYIDjAw4zB8OHJbuiPDMlk608XblLSG8mnjgVbUTH2iIyqm76INtUKjggRb+SSAfVv7hxbCDEjMkF34i+E+/hMZEmqAcCEJnoE5MUBekg+jcb5Sk/7GEFOEr0UbeS5Y4/wIFXzfdZqyHMdpwQ7XIeIGZf+T+xwLtTfnHhY0PtdAN2f1B4/D9ZFRcerTLOhvCZ055BoU+GtTNLvtVQhmjmFxooowH/gGIBOAMZ9RAhj30YYh/GCJMsjOEl/6jLLSYgSDmOsibEuIfylxiAb/DWiMMdAkOHmEnaqHJ5TRCKw5lBwUcaATWBgIKStDAGHAhSPv2RgBhccsfzBGOdACYuZScojRlYNxCXlKas5XmGxWqy0E01TYIwOpsLdFUv+yHEer0L0ZQSABgzsSJxPwDEhPBSM8ywo/X/aMhY5TdRTzxJg3C6Y0DqRoH/2EOULhiXst4gTc09ZQNRQeFFVGhWv8+8AG+MEETKZDUFEThhX+4CkJIuglLoEC+f/yNP5nMHFRecoeLpA0qPJEQxyyimlM8IA0oyJwJI8OhmKiGDUE4AhYS4AyBDIBn/zjEP+QztIPETiNrJMgirhGLOlaETr2z40DGlRDdHQYYHwmJBUJyEG10YxU2eAAKougTfLnPIuDBiSKNUART0OAftSHBQJAABZ9Qp33/GMI2AGgR43ylfD7Y52SU55O1ue9UCmHceIg4k4p8AEmnkAoRTzEEWEZGLWxT5D988IIbwCAUA3AERXKxicQ4YhP32BYZ0aiRGywTLd6q4ByDpyYRBG9ql1hGHrUBilFoghSvyMEDbkX/lqeEzBufNGj+KMShU7TAGKDYADsPdo0T+AAF09FNS1b5wx6hoSvD2Y8/XFDVsl1oQ83D0FoQQkqcqC0mRlpIWz+ggn9A4CyieWJCjJqQoRKkQmWI0jASUI+G1IMiA2HgP+rhiGqVjiBunAwxWRpZgsz0IHKL5qQ0EYxK/IlgCDmiRXZDRALQwQceKAYlaoOlf2CBA00YpKb2pioCgA4h/zzOFvz5H4fqtSz8IqdFItS+z/pkLttwQAV8oAJOzWRkC83iQohoE1oJAgVd8EchEnAIe5xpAPc4BCcOsYhNFHZnxuRI4SSb3otgMCGgqEQpRvCCnrTkrhlRCUs41IQj/zABFwO5RVGuIQAOVCBvKcHXpoygENuipYdU+Y8RBIqQU1JOr/tDIkNxAgUfVEAm28irVetbEbVtQwxN2IINElCsgTjiHmzclrT0sYgDqpfGC6nFYbJnkVeUYg4veMlvNwId0iwVBreAxj/eIQtaSOMFKKAcHbDRPu3EyKsHWbBWtoKcKu/HLZ+tp2TM8tmBVGgb2WkbAB+Ul4rQ9ytzCZY/+rHS7p4UITqzB51rXOMMHAabMVUIBy8BACME4RQuiGUi16pIDuDAGLMohi1YIQAY+HggsWLzaspA29qm7ytoQMPEKAOBqioUYJJ5AnQ8Rh3OsbIM9E1Skgbi4Q/ztf8uLFKJ25oQqzjcYBgndURiGdPGWEAQafIxb54lk4hSZDObCBkFKbohgkh0Ip+SIUA4tbPfBgjAFrOA9AyQ8E2jsq1vXK3tlTdSPk2jL1aVw+JhynYWvMq7t8nllF0W99yMkPPaPBoIqetxUkjwrGgDKBrS2DOQMiJbIZjgyCWqZhGP1MAjLaiAoTVCYVmmjUPeeEEDsEAQATRAAWPQESrtUhZQ00g6XdHqZLLinxB/ZXGoTmu/VIMCDXMYlD0npEooPMqrbmNgp6iqBhX4j8UutsawYPg/kjEQ2gWvFBtZ2EHMMZADdeMSr9hsVWedMrPslS5j9QkKWiCKWaDC27T/KEYxHuDa8YjhbInyXEJoxEnJoAG36D5MlKOTb4Xc6q1mW1x0g565gPGFcm0bVELVVkgRe3Y0MTnFGya5wEUMoIwDKOzTn067qUsHFMu+RNYikRsx+yhWMnlKhTx7B+QqQAgkuIUABEANLPjALbU+qyoFsYV/vlxwXQ0Of7xyTiAHXiZ5bUmDSI0T+24KAvcLu0XcWup/eIPUvvocGxU4gHowXbLEBn3SiMGRZiuEUq94RSRs4A0nT/4fQxyzQsRgxG+8AAYkwBJhdu/ixsOKFucJjC6fskL4OID4+MkHOG0yWiksQowseuMgossmhmv1lGSu5M9fgo4jPpDyxMAb/yIHdIplAFrn/J5uHDqhE9plP0JCEz5hFDLhBdIA5e4HYDoMehBiLrTDB3AAZ6KBFWAACdKg/nwQZTSEAMrgcJSjwWakYkIFo75BrgSmwvyFVrAB42hikNBmX65KIZ6AJ6TClURjbDQwLQqKAEgQCtCAdAYAEFSQ4ZDABf9hARhgPw6GEmQQA6AAgMpscQjvIHRl+QCGQ1xGAWBAMHwBBoxAEOigLqAjh7ahCTLJKorDB/QuMtAAdFiu5bYAChxq9RJlX+KKAFDgFFSgAirA6MDufhov8KACG8Zjw7whO0otDbEPIVZFNMipAvzhEjciKxhwDiWDvfgjM0zhFTAACf8ggHEmCi2uDUOGgANgYMmQAQaoigtNiXEE4SUU6Qj2qXw4wO8wYnA2cTkGog+IaAxPod3Qhg6cZyYy6QG8QXIg4AGCIA1c4AMuJ8Ji7SKkIg0ISkNKSSMEwUE8BRzdqgwqQDRg5AEt4hEKwBgpIwf8YwFeUDpUITM+4RPwQAGoKomMKMJ8y9+UxCcIpgWwYBETAAu+aQyhSxBKkgDuLkbKZyuKEcs4SSL5ia7IzCV6QrqeoQKGQMOOwAfS4Agc4AHcIA0eYAweIB/tMQexL1+8YhphKV84QiaPqK3+oQkcQCGEDyNkpCItUjoWwBIYYCMlQxX+oRFeQRVMARcUpAz/1EaQFAplEjIEPYvovAEJlIAVlMAIUQDjGCdjDlEQVGBUGswcL2IrFPD4pGMLZkWUcqUC08ArZgUFUBEVb8Xolguf0uAIEyJCPquqqg0mSIkyCC8IfuiHIBNU0hJV3HI/GuETWiETaKDJhCre/E3evpDUpqPQKqAFUuEaEgBTWuRhPmxxtsHBssxGZkT4Buer/uGbLpAgBqExecIoLWR9ViJ/zu5HJC+JfKQSH6CK9I0yBGEMyHI/XmEjFq4234gSVEEV7PIIcPEJdtCQ9CchvkEQPoAOgCIBFOAB5PFfbMJQlOQdH2ABi6MrZvMcJfRG1EH6MMQ30mALCFJC/oXC/yQqZTDsiNbHoDhTc4jTIlAAHg8icAKHP7ZHIYDAPi/iNqWDEkxhN+lhw9Qm3uZClijHiurv5pBwL44gAYxgvkSLNCTPqsziDx0wy3yAOqUDDdJxMoyAw1hiCFyG95xMED5jNGwiNKGIDaPikwwxJ9rNL/8tDd1UIXbSRuk0IXDgHzzgH0ShLhVE3rwQxDAClWLCZZDACPByPKYnJXDwYQTpH7ijD7KMQr/qCOZUK2JEAeppKjBkG1jkGwTpJoRTIVKioGzCL+/tTwUUM0+TIKovI2IUaV6wA2KgTmWnI+NSFXizOT6QNBBJFxWiNd4ADVRAVcvKF/3FR1CRU7AyUv9XUzJ66HwEhyCMAwUaE4CC9BAPAoUKkQlvSCFW9CAOU9wyLiP2KUZfNSHOVTIirgMe4R9WilbVpBH+wRT4dEFUrZRigkII9CuwKg2CoEV3JVH90kh2Y1OOwDp9kiPybkYG4m+8YQteJVY0hQulh6zAcnHIwgVUwq1OdCFQAALcACwXolBaQibT8gURoQDO4BfgFVW65x9aoRVowAdcy7O+kl8xcJXcJ3OoQ6C8kX2kD1aGQ1IrlCteoCqybDIewAe24TNQI2+m5yD/wYjsZ0NQ8l/CrmmnSEOt8iDuIF1ZSgIkACNqQCM+r2U3whQaIQtaQRVawRx8YFEhRGQ3Ikj/kORj13NHLGl9gk5tFMpBmlY0BgfUBIcDHPMwqmJBwCMETfYrW1NqN+IDGsQbDuclfKVIeTAKxlEdIZM4EGFGpUOY0PYrYrYVcOAImuD1DCpvELJ9mtYHNgVUKwc1jgiVhItWUjFCXyC3ZsQHWiBpOQJGCExCBnF1fUQjdFElqgpVdUM06KBVrWzLWs4iWOA/8Gx0N2IC6nIPlGfmSjX7NAJJWiINpognWi88LwT2oKLdvqF9Z8UbsAEbkmOHWu5winYgtKoLLIRDrs97IIQ/zjDM6OobB6KqVu9+KSNLgSEjOuAfKPIikm501fYfXkFeEwIVcCATcAEHqGrmQjXI/4TLLB6A7gqpbdhGRwDSLgKpnpa2WY9DyxB4ONJABagjQBFiJ3rLnmwYtDRCkaDyM5rgH+CTIMCWyhLWRs+WVjVBI4xBFNoWA+jhmz4wTveHcl4CKhWzai2EOrCBZDNzqGjlFMoACnCLYUVFejPCGwbhGV4JcsljU7yXAEx2+pp2+8oAei8CRqP3/HRhIRwLe18WI1oBZjHAHZpDV0qNirGvnm4CuYbgAtnKSVkjcaJrnpSqbdQCBbAhKZcjywx3I6oCG1KREH22By2HlL0XJvBlIyKqaSsVIYpYYTNibNVEdOH1FbRhBuNSIQ5mIPZUPxOUVjxmMpbINESt1CqqH/95RG0ItGQQ1a7axgGp7DiGowVe2SocilNZL8SM9X8vYp4I4kHCjgtj2VKlFXstwvyeLpATom3vtBVmgAbEjV9QBS94Im8Xh25zmDQqZNbmyaGkeTKQ1sF2siuUS5EBOK2GSAMJDI03wgBieCMS4b/+44vQWSNU4RNwQBVmIEFJVU0sb1ZApjS69rnAsT1jzSc2BBuoM6KtDDgW7NMa2lf5Y9a+dSHKWZ8UIqc3QhHWbyP6GCHa9aI5Ij/dtgSCOZaQ9+z+oZV4wxfpyTQwtqQxEEMcqgKsFHGPbwuy4p8glsDKBhcnw/F20QIT50n5wwCK2B942iKUAy2J+iKyrkf/PuEfVOECTGEGbMDDZMcLVw07VC3f6DmlQ7AupMfDTiGr0eKHHAwh0OBVjiSVLYLUFMovSwghBhToJIxhOe2aKyJwslS9xiiCe4RPDiM/LSIS5rUZRVF9UcVnpWhM2YYmxkMSDRED85lvgOOIHZsyN8k4ukL+JFF2GGQmgnhpquNq+eMqnpCtnXWT0jKJ86wV5hMhxOEgTCE/9fqyy3pVgStgXCJvv5cuBpCy//M0pmcb1Psb8YK3K0I2M7FpETpVJq9tDgXzBuIHCBFi8ooNJqOtOdEA1i0j5EYHMqJ641ohRgEABmIV8hMESsAImPc0CnYh4lhQLI+uxmMQhat4/wPyR0ajKXQj3iIRBdQhtC+CuROCAwDIyWj6rcB3IBBzV8pmGwJnwJ9rKNUEwDlCOK75B3IMIxABaS4gvV4WLuuawQ8iozFgQVxbIYoLLayY6B4ANbmWrDZQ4zBTfAeCDggAxXt8OHyA+zhEMjr2IipAVVNJISjIPj37K4Yc9LqHEuo6Miy4Ij4hEjAAwjTHqiUbIxTpARSKKy0QxI83kltNuP4BBaIEC64BxxX204zOfl7coCoKIxzAvbH3KsCcIOAaI2Y1wRECAMRBtQ9iFQZCHDQDF5SHG9MKhw6DzTbEbQgyOk6tRWBtAwMyIJX3AfwhHxLgJZ2VxVvxkyp9xP+v9SJME38P4s4tYhDo9M034tMtss45wtT/Adv/gcHfbwZK4JuwDyee/DAsryov0MsruSJWVPrOkACgwB+KJRSG4QqKAGm/AgLUAVCeqNLJ4oowHSFAhwMqspf/Ic491iIMYAsSHm11YAUOItT/IajTsnuW+CvYOSEm4E55IAtoFtDHurhonQePJEI+zE0/oFD8QRaEgBWQYRGkIQF+F5SzswwOEyH8XYtg4ilc3VVfQAfYCSEa2AzGHV3/wVyLnsfPL08tYlbRso8dLs+yu0T24wIyYSD6AWZngAnyadbA48lVFyOu6G5VRvFSgmoN26xLTQwkChgFIAEEI1oMAQb//tgi0uMbvIPNEKXkjYRxysZTr+2jNcJgHD4hhvqhr0KPBwLpXVV20k8h0NLga/N6JgMHPAABkKB7RXaO0WYmBtSStEhtNGUIHIAgDY2tAkmppiOvGFL2/kEdBvMaYOCA8qEeYAALzBG3jvCemg8qWKTMBelyHmT35wKtD+Oy+GkrXtWl9+PpOSIFgJ6BGS7PUR0h4DIy8GYCKAHcHiCfSTqvgDPeNkKWLqrWb1bw9uaDdyV1m8AIXiABZGEgIGMRFiEUruAEiDghINY37IkgincSD3FWAALbNwKn/n37hzChwoUMGzp8iNCAP4gUK1I0ANFZwhUWF8boCDKkyEiq//59+vRv1b+SIhMG+NdKCBIIKD7820ZAzD+bDw8i9Olw2z9vCAl8EDTEwYNTNoUK/WB0582DggQh5Ilwm9A7p/xdg1FPX8JF+hzpq5fASEN/PtR9GySIgFWGLqTaxJpV0LZv2wr+m9sSITCIugIbbmkAI8MOoEh07JCQSMgOiA5bTsjyXySFKCFeqwgtAZI0/wiYJvD3n86FpwS1xua6o+mrFRykKRMX24dvYlCjLv3aqvCEpj98aMKBxo18Ef4ZGqvvUL5FVxQkRMP2wb9TvlHHLn017tzfpQXBLh11+78jlw+j+ae4fcj4CzkGToGwAEIdFPXLb6hKZwplAQ1C2v8stBlCAopUzD/G/FOMEApod1dUuzGElHB8NSSUQ6e58EBNv3X4G0F9CYfNP08s9AEd3hyRQD3/8LPJWP3kos8A+RiSABZoYPfANjUJ8oSFHSpEwBAJDQHYBwV9A9U/TaTxwn8KDQYRffKBAlJiCE1kmH0NXfLPRxVVZqVF0LzUyGEwVDRDMaJBAZ5cqTXUWp7qhXSKkP9AgQIBQvmGkF+tfVNQilKtdsodb/gDwwCRPvfPAP/os4ml/BwCwxX9cOCDkCgURShqrRH3l1+AZfUPChUIxUFCWlrWwS0dganYrGnCt8U/vSL0XksWnGABficoVA2WHZm560LM7CqNQw3/ChGhOkHsZKFUGFZ1ClF7BcXQN3f0lUaITd3E4l/YbFOVkgr1iZwCSvjymUJi5WNpjfYc4c8DKtiZkyB13bWQTkdWtSpC3rQQLHwOW3lsYGA2u9AW8aHRQmA0YCHmQvyJVBjFDZmyEsXXNDCLaCSaWhRwNomR52nkvUsANr4VFJUgKDzgzSkpWkVeiUyhd6R6T6gA6SL/ONKPWQPYs4g9z90Ag1raoYBTQqcUOVtCRTOkKEMTi0w2Yl/+d+zHEGFByT8iuO2QLv6JnNk/BZadUDQSXjvweaxaZVOGNwnKIbpOMSWXazz79c8QRdvc8lQKfbANHT4okMAwbyakTyj//7yZj1o+VDAIXoZ9jbfEVupKMQ3/WADEQ6Qg5IUAC829UMipI4RLQq0c9iCE/1yzS0Iw0JKAAkSdhiR4gBfEHfMMzXZzaU4KUsED2JziE+NIblNkX+qxXAYaV9STqaW5oP9mHDb0S0cF6sQFXmCjsrF7/hSN3VDDlym7ENtFTH8gqds/LoADZmQCeAkxRoMScg1pFGMGWPBBTRJyl7lUpTRHIhhDnGI437CLAGlwwan2ohXWIOUorOrLM96wBSzESCGW+kccOOAPCLSKAN7IyVE2yC69hMR/BJwP2RpGRIdkrCL4aQgTUuAKkYADIWhiiCLSdIxmBe8an2nANQaAjP8ESGMLFxwcesAWm5wQTmvbCRp6aqazpbimZoTai2t6s40mWE8qBKHDC67gCIQw53MKwOEzUDAqU+kEZq4RjvfSxL/DNLGIWQJJrBBCspZUAyYVYQIlL5C6ehkjglyEAQdUEDjdYGs45fEJVEzXwhAKRTzeEA5SsPGANKBmIAW5Awp6dooKJAQbKPBBHG4wjIQgAwkTM85cbEY4QWzoH2GjpDWv+Y8D3K0lscOmSFqxQMsETyEPHF4xlDChV/msOwmZC/SoN7Oi9AU14hMUd2LzDZ15wwcqsM4RoOCNQZShAhwAlQ8Q4qoPVOAIV0CIPf7BMAgIswkusBl3oFQGnMz/kkn1S4gevdmsSFKyBSKAhWE6oAMANkRtIE2TMRrwD2QMTxrXKAYMSoCG0oVnNRjs6UOqsi6rHOQJryQACoY2iAccoQT/KEEXQPSAPjxgC0goQQlG8Id/HJQAPshDPwrxDzT44AMoGMJRoEI/1eykmjzBCgrG0NKKnCGuILNMxxoyuyZWka4iCZ40ogXBme7iGFcwwjOMApUV8bRlhHrIa8hzFyX5QIcPMAJ7wlGOcjA1UNk7ghD+EY45lIALUIACBHQ4kQcMogJ1iRxCUuS9eApzd0kMDO74uru7JmSTA8TtZbjYwH9EqxjOmMEYKbqT1iTMcBYxz5J09gw0dKEC/x+AwiUvgNkQLEGXT/gVQqrwDy6o4BQoKNKo/tFai0AJXfH05gHm6tv4NoRLCvmBfEOCC2NscSG8uAY1rgGLBKgjDXqkg03c2EagNMSdWhODazgAym8Ukwsh4EI5uPCPcsxhtkb4LELm8I85gBcqRiHAE+hwRg59wwXnXY0D7ls271KyH//Z5EI8sJ8ywRgk22SINEZJiwj6AwV3wJNNvKXgh5DnkD4wQwmqgGGEhCMhXKhCOKqgAjS4gwtTLkdC5uATIiFEEA5+iHGmRF7U1HbHbG6WJx0ChLcxRKVtdsg4FwJTXiBEGrxgxRWQEKInrOhIv8mTeeZYEeixRxxzCP+ED8IR5fB+VyZH4MIfIp2QKhDFgxQJJgRMA1eR1jkhlxy1YQpw29dNciHAIMZ9MV2RegkXIfVCBgz++lfk+UMFfGlvaoYDmFmqcjgdgjKjzdDhhYQjHNqYgxm6UAU3MDXSkIYSLB+CAgi8eM2mXiJDWLerUudvrwixAEJ620T9QMbUDAHvQxoQvGg0IBoJIAEa0hCFf4zhayxUCGkQ8u+E6PBaY3gBiP9hBjS4YSH4QzgXOBBt9jRE4nRIK0Qkzu77ettKca5IbyuS6ox3pBg0VUC+48rthqRB3CL3bcqvY6UfEMECFnCdfJjVcpDAdAk570POfw4shWy8JXQGuv7/YAoIoyv95y/vCI7bs+6lS33qVK96y4unDqtrXeMKabpCPv4Qm2997GQ3OgfQwPKK6PYhay/7NU/udqkrJmO6WnMLODB0hiEk7QhZARb+MYV/iN0htnNi3A+PeJFJwJIJyRjGQOIYhEReJCcYYNsTj/nMU0QCd8+fzcGueRj7g++hh3GV6jx40Jd+V/5Y/OrdboChEz5/UQ/9wi+DhnLA/fVb30KpZb+BwtuK92UjfUvQ4G7iW30iLzf333WwauUr5EE4EIkZyGk3epgBCmbYQ7+2cYc7uACuPSuNGGxzh16hIdJZ9ccYeA5iC/M8VlX4A3hHIHvp0xXcDbm8/i3T/yANAg3FAA23YAwJ0AJHIExFphMV8gFDQAfqF1qN5w/icAFVphCB8AJcgACflX//ZxEfoTtHBILYBA0FMgvpUAzWIAsJYAMcUAFlwBUyw0EV0AfYEQJzgAAIMQb+UAUHlxBTpgDlIA5LFCwS8Q+iVoJLuGN3dhm0kBC0YA3FUAw0kABF4A9Q0ARkcEamYTM+IBF/AIRI0AIYBkoI4WXA4g8TIVJK2BEfWHWPsBA2gE2cMDz/oDQJYW6qx4QW4YTSYA20wAokkAA00C9vQGJRQQAVkG/+wAaRdgA9AGXhNWULMTGl5oZ9CFImpYkJwVRpAoUx9RmsIAsCAANC8AJooM8CKOACK4IaZfBi/uAGHvYP51AF5bAHCXF7XacQ/GclXkdJmdgSwigSxteJqUMNDiILvIAMsnAM08AK1JAAJ5CFenQHK9IENuEDC3dlc4AElyZpx7gQRZAQhQcR/ONzafIeaXcMrDB44jhr/1EgxSALEDINtkALAsAKSpAAR8ABSEAHd2AcqOEAt3cBVbAEOQBJsmIZxEhXU1AHdCVj8Kg/s4AQenaRKchnnVIMbuAGFRAobJAGFeANSzAHFwBicLUrwAh007AQu0cxMOl2AQEAIfkEBQ0A/wAsZwAAAGcB8gAACP8A/wkcSLCgwYMIExL0pLChw4cQEY74N5EiRUv/iCUqONFVRIEb/3X6h/Ego38nBXY688/Tn48wY8qcSbOmzZs4c+rcedAjSUvAKpW61CHbL0yYOvz7da5WjkRMfIqMuEBSopEGM4wENkmgJBO6wGEaOHJjSJoYpfJcy7at27cEEcG9aWnBP1ILiDERsXFckRctOAB+wcGGjSJFxo0jVfZfDgURLUn66OnRP3AEX1pitABrTEtp/42aS7q06dOocy4QMUkTEyAv/MmeTRsNmheHmVxaUNIzwk4YN/I22PXgGkSPdBFMOZD5R7uXBH5KTb269etrGRLkfelSERu0w4v/93fbBhMmlkZmUKlSQYbNjDoB65RIQchEjCZ10u/ZxD8TJoCj3EA5eBZcTNF55NNo2DXo4IM5McTQI4rcBMwC0VlSCRM2xDbeh7Oh0UIRTCiAVXruuZdeIsAAU9dGlpDCXnEESbKeJJ08gol/AvE40VkxVUKQT+ZAaOSRSCaEiDK1VFhTAVORtCGIVIaHhhHjINGJXZZo9d6XLYImZl2bFSQJQ1pNkhI6A5nhWEn/qCWTNkImaeedSHqyBiaI8KiTh1UG6g9uOWRg4gIKpGfJJJIlIiYpY4KWwVmTTAIjnphmqilOGXjiiTIDWQYTInKRxBt4gqYqWG7v1cUIMJPS//ePihQtkIENZ0mSnyVX9SjQGm5ps8ymxBYLVw7/lErQWBDVMlAlSACaaqBb+MNBYSQyYRdFIyxgzhJ1VZKNYc2dhNW2pGkjyj+NGOvuu2uJCpF2AyVyyTktTJtqQeS18AJi5zGBBBJ+HVbEtn+k5FkiQPq2U7v/aCMnvBRX7JC8AzkJ0UgbEkaevrNVW60/A23xzxZHgNiCv4YBscASncTHXEURs/cPkDtREvG6Fvfs80EsiOokCw4xKxAwTFTy3cgkb2GyQPsihMYRNrTgAweBvaC1DeNk8B4jOXzVydjAkbkAEwKlNxCwMBXpkM4/x42dxjctGZOnlWZwoSuwpf8M9T8cnPzPAweR7JBsaBR22DkKjMMEMK7UteWiGSyQCG+Ya7itR2U6J/fnoO9ENETrkWR6JbC1UFDg//hQ7T+Gw364lS0cMTA9rkAK6XydtApp5HiRosBudd48kp8yWRD68qdhTNABAo1ekyJ0O9QBMCldYkl0l9hwREE+CPQCGg2ZTPKHTGP9r7a8kcLw9pdUsoD8kecufyWkuKKAXSNIsgRBGxCIJhLitkoozwPMS6BbJBC9h1TvIyxgAfQc4h+kbAlRKYiRJb5DvoGobmr/6GBDQHSQayEhKryZVNJGoL/fKcAVTCAFE7RBChuUxHPKG4iMDFIJc7iCTgoMolv/HigQ6DHQJpiZYEQ8MZkFQGo3IrDEOcI3kPCJSCFP44DrEPI0gaChiz44wnlcUYlgXAIUpajEJey3AEpY4ARAsKGQSjKdmvmwIXTyiDbgJsQ+7kSJAzliTgCZEO0g4iR2+Z0ITGGE7w0EDeH7YEJ80EgqPpIgBrikCAWyqhNmwAIxGoXEYPgd83RmKpSooyv0pxCIucKHmnCbH2cZN1L9YzICWQAZT2CDaIWQfJAEHDA1iQYOhlCLLRBhFxFyvoGQ5wgvQAJiEKM1I/wrEtuTzieiMxBNTEwgrxDIDgVIy3LGRBFBE90/ekEThlxIIIkyB4eMAElHkix8rDMIGqKF/xt1GAEJRjACYrYYkfC4LkRhTJzONmQBbYgTIuM0p0Rr8gjpFSSCDxGaQSzqEOCk4B8bMAeigNDIgTwgfIjT4iYHYoQHKEAd2DjFG96ADXVwYGpHeMAWVkqQQF0LVWiwwDLoYYlyLEMUjQDFR874j+JN9KmD/AdHDYIORKRzJoiAEkESEccXFOQI+YLd1HhqBDeogwDeqIA3vBGID6DgFFRMXEIChYaPDcodCHAFAy4hihlkIk4PhUgOoUpYh1BPqjWh1z+ICJFEWEAT1ZAEE4qQstiR7wjfk80RLCkQI6hDBdgQRAUI948P8IEMgjiFEeY6G4GYbJmyI1kLmKAKbf+8EQes2AUMqOEFGX3TIN60wGMLS1yFHBYhE3zgVJ1HEMYSxAS6VN6tAGUQH3g1hEggCBKwQQBBEIAAQzCpGsBABmw04Xs8lV3rjpDe1r1AAe4whStAQQMsYKEeMEjANUjwj1IA9i4FKckqf1vcAi8WE79YCtAUogtlsBMz/6DQYmGCkUpUYoPSMhz5fOCDaF2WtEdQwSm864I3WHILakhCE55AgApYUSE2MEKMX+CD2hVBmiVqHElvbIQW2AAIJ8iEKkgBCqcKRAQyKh6R/6GzRnjAFAYurlx+cYCpfkQZGrNyRy9nCSRUtrWA88EDTqECdQA0cEjAmjq+sY3vEqD/DARBcRLecIfvngK2CFGfj7XG58CoTnz/UB3LgKCNj96lFAMUCCVcMQqfKDXKkP5HLZyVYJkYjU026UQHcmCE8WB2zBX4QBMIcAoFvMB2RhDxEL5bAQgI7mR8SIIa6CCGU1QgDTXpYgc7bAMFKOA1RRhFJeb7D6QKpF1K7Uako1xpgTCEkNTpQAYmgSorta4MpxADCtr8jDJDwQZQEAMBPjBuNhAEAhAAQxLmTAYCbKMCfYgJngWXONwwwRwekBgpKEEKC1DCyXBLwTgJrBMjLxtTzk0I8iKiZYJMggkdKAKI0ICNCmzjFC5oggtQ8IzuVkAQoT0FAZrgAMFtwQVB/+gDHVJMBxd84xt3hsgw20sQHzMhE67wACUoMcDhdmOHoOj3kg9OdIRUSLE6cZRiqPSAj58CBf+AwBtcMGICiNu7H9gGCnAtEAdEIQjoljUYyP1u0jqE5oULoQ0QcAF/g+KjxPiHoX3b76Lb/SD+Kd3F1okQxYqgExwST1390YcykLoC5ktDBWD+DWzAPLUfaLVAIBCEMQQB7H2QdRO8WwYVcJZfJo1rQ8hHsqnR4AIeAMUlRFCDf4jgH8qzAFPvTtxOZcwgDMkBcyFigt3n8h+T8MQ4YjN4f4zsH2kYxClOgeuRleEb3hBEarfhXUGUwdVbuHwfotAHykMgBGoIwv/LVRAE1g4Es0/7UEGAiYYVqMIVAnfi6+OUv/8KRBsekOVAcAATiDmU9qaRSe30D0YzCc6yNp4AIDDRC5iQDAehH3GSCMTQCb8weDclGybjA8r3DYhnOMb3caT2XRcnWhjoALMBHuhGB0GwBTT1bp/XU7RhA/50BFCAfjtVfCIjG17EBKZACimweqCAb6DgAQYnEAhUEFkAgLQEKgTobJ7ATkjXEIjQCwNiECFhKybgDwYgGxyAWSZlcWoVZ/4Ac+P2AR9wCm2mArJRfh3EBKGABpQnEC4wCNsAfaxFGy+gAhXQBRVwCv/gDSflAyETMrbBASdQCXyRP0pFCdrwaAT/YQE0QAOKxjNKCFUmkIAHGBGI4AnJ4DkDsQAjYAnBMHg+MAJzwAVCUAVXkwanUHEQcE8OUC1Sl1rfBXL/oIb+kAb+AAS+AATz4At68A9R4AIC0Yen8A2uxkzh8VkxtXx9uHjY8AA6hYExCAoi0A0eQQmi4AGJZhA0cAsIJAqmcIQPkYT/AGWVWCwMUWmr9QvaoQyXiCya6IAQmBDBQHx/EAIlEA7lUAVCoABbAFqkBju5SHkkUwbb8ATSJwi3eAr+4ANR4ANEIAAkQAJT0AIyIIyu1nQX50h3SB5d8HL/MGJspgIjh4YnFR4toACksEbayGT/EB1FSIkweY4OwX8K/1EJ/5eORyJhyuCOBKEMGSCPEbFwBsEbo+APLSAEczAH/BgOFxAOVbAFFWAGtzZ5lxcEZOAPEFABbjaS/4ACXOkPV1AEevAOdvACcXAFGekA5IMCKCAIKoB2BKlZJilyeEkAA1EGfSgI0ygbPEgKmmAKTmYOPUcQ+ocQE2COCoGTBNEIO8mTbUGXF3MOkiYQCfYInkCUNuFEJLEMCuAPQhAO4hAOIfCUXPAHefgNbFB+2YduZBAE4dMEbVZrdXhnUeAPCXADRjBZhpAAMpAA/2AAxNgHTfANZWB2yjgbTceQ1CcQ5CYQ3vV0gpAGEHBTrlACHkAD40gJidkQjUCTDf+Bk+IZMXwkmUbCFL/AhALRCznAmZ3ZkqTgD6cYDuEwBxfABVWAilxwBIuHeFF3eWTQB0Ggi10paqkllxAQBQbAlldwA1cAnBB6D/6glRCAAiOnDvPGL7LRYSqgAnXoZt+FDUMgCKumfBUwCFCAG4GgCpQwAZKYEOI4EKYAZa0gEOiIELPwDzuaEMOCnjihOoEjgDPBAufAAkQUhTOxALzxCWiwByFQBftZBaa5j2wgARzgBtgAdf8AdndAHtrnA3H5AaX1ZhBgAEdwBRoQATLAD2t6A/2QACSDbkFAAHcwWg9xgtv1De5mhgRAdVfnbt/wD9aHAtY1B6Kwc0X4DyT/sJPfWY5AWixEM0FmUCFGo6QfoUGr8ALqkIpzUAL7yQVc0AWxMwYtgGs+YKGzEQR3AAFlwF1n6F13sAUPoKY3EAG3iqsRcAWFMKdkYIZPBxGI80+n4FbfgALY9mbkdoaktg1/2AQ+UAQYoHPF1hA8w5gPEQCROheBk085kQMH4GADkYm8h0sEESMj8AJCwAUhsAddEAgl4E/qFQ49UAUCAXZBcIJc+QBU5111WAZpsAU+kABvGgEGewMacAMU2qUQ4AJ08GbKmXYE2QLbNQjeQABQsA3eYJLICqLdxWb/4AAqwAG4YAGmUJ4F8ajZigXb6hbJ5K07wYme0BUdsJkO/6EdlUIQePEPy1AESzCq3+AGKGAGRlACKIUGaxAIouo6aQB2LTAPejAPRdAHZHAHy0dqgrBtrcOWt9q1uiqnFQp2IocCLrCh62ct0fQCJxWRHPYAECCN2PChF3ebR6AA0ECOa5EFskATAdSyNZENzmY0EfEIpCIXm4kseocQUOIZI3A5/fUCtyYIG+cG6ppdHOAAQiAE5cAF5QCHlxdCU0CRQOAPUTBmC/kNLkAAfaCbBhsB/dC6/RCcD8CV6MaKWveCUqOU3uNqySgQ8da2WtdmxAgBaCMKOHALfpsk7XVTD9Fso5KAntApBQCfByEJUEIjIHEJ4WAGabBtQ7ANNv8gBCVwBH8gBIEQAuKAikuQi2MAAX1gCC8QR4ZAoRf3D4FaBmPwD157sBGgATJwBbgYBDtQpyIHoA9RV95Tfm7rvgSxA21brB8gCG5gBA3gAbOArQeRBTTpmAeBCsmbGtA2YTCRA8ohIZPgJPnRUQbBBI2ABUZAB9Z3BAugn0xpn3MgBCEwAuBheQagABSQsFcQoYT3DwRABx33Afj7D8C5prpqsMHZq7TbsGWQWhGrEAicU5O3A/+gxVrsvj6geGaYBkYgBCTAf1lwDAohCh6cBXj7wRCiCJ6wEcoywmtBAgGwCy9wCt6gDmZQAsvglFwQDvv4ZQaABiV3DwlwsBr/cAUUmnW1SGre8A8ykKu52r9PLBuUtwN1NgTLZ7YHMSJuO3lRF3VazH2EQwBP4AJGMANZsKN7q5g4ChHaehPI68YPYQMCAbg1YQKCaxMncAvFMA8zgATboAIPsAf2aa//MJpW4nX/gKuvm7CI7A9WK31sVgYmqAAIewP80L+u+7+y6H1BYJvP0LsHjBvoNhDmHG8CoZcu8ACAkAU4EAB7KwAwgcEPEU62vBYJNxDsdBB9gr004QEeQAI7KgDsQA8P0AUPwLn7eYpmwIUP2QNuEAX5+6Bem7CF4AMaV3Xv9oq7mbAJewP/mwf+QAYQEJvoNp0PAAW4m3Y+Fsq862rs/3wyhPME2/ACDYAFxQARlGiOGHyjN5oQ+rzPBgGzB9FevneZS/EI7BmUlzgJOfAHiRsTHiwQxqAN7tB0PtAFFxAIc1BWKcMBshECQnAyuWmrCNu/CusPCPkBIfcNY+APUMC1CPu/FOq+el2ny3prHilzuDwQNe27g/NWaYAE14AKrfwPMYoQNBkApsDBx1Zs+GzUpeEwAtHPlVMKpYNLusIIcQcTruAOy5CxD4AEo4oG56AEs+EGXDAH+sgBb3sFunqr/nsFD2B43UUAx6qL/nAPtH0DENqrlJdyTXAHhPDI7+YNSJ3UgPMPL2AEO2DOJYN8waoAMzALPd2jkmgLDv8x1AQBZY0wAdVq2TOBdminy/8wDsnyC0TkI79gA9EBNiksEp6oEFTwQ/ZhBM/gAybWAlWgBCkjAac4B1QaDgxaCAT7ugb7unJahuM2fbORB7t5D2SNr+kWAkmQBo5MbnL5AJTpRSljA8moxfziDcf6D7awB9AACBccAIDwDyybwQSBA/wHMTBJCUrVxuYNEYFhaQYBx30nElUAHmbACIkwAuLQLWOzHichCQJ9EKRwYUuwBEjwAIA4xggQDkvgj+EQyIE8B2NgAIXAtU18Ayb9Bswqgk3ANDrYBw5weemWYklwBwxZfd+rAlEQ4oE2PkjQBybeOn3wu8c6CFtAAzD/0MqzcNA76t0H0bf/AN4FgY61XBOSva3N7RCGi6nIYwIZMAIR/Q8ZIKVI0CHnoBWj0LjTFuUHwaR/sAQZcFJjvAz8GAKBbJ+4Hg5zTeEy0LokfQNcuWrL+l1troOsWmepq3VksG51Hlof610EoAKD3RBZ8wDd57t9kIF8OgQ+dg0CAAi2EAeO7iC3oMY9/hC9QLhGiRCLEmiZtAQjQLFLABkd8i85sACg7RCW4BEjMO+1o7nh8A9POQf/EMgCzwW5yQ8SWts3sAUOEJcjdoyC0ASv+JBl8LDboNuyum5g4A1xSQAk2l16+NIHYQNo4LbsPOjV8gDH6pAvgAXGYAu2/9DoAoG84w4T5H0T/FXplp3pmS0XxYHZKoEjUxE4/uAAL+CPeyCqJaCfgzEVQj8QrzcCjKANnYAGBy4QAc8FAm+aVTAHS3CmCu66v34FdXUKdPANcE12TZCv/vAAbzBmEV6GZAAGatAEz0CocF19L+diEUEY1v4P8RZvb68CZQAFAjEOrEwLMb7YPUoT2kresxwTPH7uNVEc8TECaGAAhfwPJbAE5SDw7iDIQoAE6vACOHMQ9hKTlWAGZoAH9loO5fDl/zD7XB/6ITDoZCkDtx0BPoAGF7sN5MZiIiob3vAGgfAAsLpq40YA40WmRJygJepi3/DXuQsYRuADg57txv/3VijwNC9gDLJACzO/o2hs6QKBsgfB8wMBjv9w6S3r8zHRFfRfKS/RQAMx+7MfQl8cOJajEADh6t8/S5VsVAlXLty/KgMVXvgXrkoJN//6oLl3JcGNQv7QmIly6h+BDySxCSJAwJ+/CkFUBDLjgqQgQXTIJEni4p+gDycJCPq2DYUPKA8GHkWK1J+NFja29PlnwF+ab6ce+PuHBs2JErYC/AP0T8CspGXN/jN1dIKoAFnOvoUbV+5cunXt3sWbF6+nHP8QdfjXidEINAb+vegxpxwXh0I4PAjygMOCf5XiUiY1wgYXLiGOhuMC+l/ocHNeBOHgb8u9jgaCvHnggMD/KZoosdGuoBpFhQdm+HxzcSrlBzEo1NwhMFDQzpRl0lQgCmXL2+n/OBR54WOLVB/CVRgdiIYJjFnFbJGVJdfY0UZoJxw1xfYf2be2/uHQm1//fv7980v6Z5KzcjDBkzX+8cSTSTppwbp/lBlhjn/KGcgIH4L4J4gojNBGAVIWoMwsEBWwpIU9EhoIoXAWWlGicFpIo4/tCvvnDhsh8OYfnT745wOeUnpgiwpcYMOHJYTYAwpvXEDpgyTouIO5gUryMQjoHvhGLn9esOEFqfzxoYJTskTKBh6KwcKWLOxLCoukaLEPh2XSwg8/pL6C6ytUzErvKA9u8S9QQQcl9CgA/xmBCxFEkikgmxFeaOEIJA54YYE5tLlgDw6CgAACTo9wR4QFLjlrARGAEaGTFwThYo45QKvi1RVDQ+APHx4YIwgyyEDplCa8SQMFHrc5iTZjCQiTpzd8KEEILpbwhjYnm+BxCB6RImCbB7ABUx0fkqruKDSMsAEN1bogoJBv/6nuBVaKOWaDY/5hZYOznBmIzUj+aQWHVtJCyxRU7IVrz2LMCoveQhdmuGE06kJHEgLLUuTBo3LIoRM3EPtHiFf/cYciH8iA4B9PW1hgBFIsOUsES2pgYIEWTrljiRA4Q+CNPaooByEuzPAhDZOD+OAD4dIw6g6ekAqqSSG3IeCOof8QEKKEJaCImo4konxmmykH2qaMb4KAwqMHwDMLqyO69KeqU0RK6ggFrhHglixYiUMApKRBKj1pcDDlE1M0+SeL9wZqRT65aPkH37IYZ9yDhien/K6HB2pwrgwGqgXAsybBZKBJcljjAGWEWHHCcsqZY9OjdtgBAiReqawsYgi6JJFKRjiCNgeqqmAbQR4ILbQSfIDa6CZSUkHoCngsSfjlBBFuiG98QAFqlL7hgAshjAnHmw+09nEks1TQ1oiVbj0LK3+QOEIdFbCp4IiyODjHmFlusY+VgWZJxz948ThbfOIforhPW5Cyp8LBhT5JYQUDkcEKW5CgchfEIOaQ8jD/DuClL34xS8UUhaAEdcIGyijBaAaiDS50YVMQiAKGOvWCZZCCAW8Z1QgqcYQhiOEn20DON7rQogtUgQMpOck2vvENbzygJcnREQGYBDUllgQCP+HJB+5wPXWUwGqC8MaTknKtf3zDAdtIQxpSgxXtXK4sL4CCOtQxCLRtsAUCMIYAaAGnf7DpLMgYSFpUEUiIZIGB+jnYQPqUQUY2Ei+hO4snEOEXROgCEwV61AgYo8JylAAKPhiDpzJ0MleQ4h8CMQspEiGCUbjBBUOYTUpo0gUuLKZWPmjCN35yCmx8xxsooWKPsjWSlBBADEVDpgNsVAYOHEkIXfjGM1CgEygm/4UAaTgF0layvrf4AAnqqMA3thCugUynBQpIgC2kIQtpIANfeiMBDY6CjGj8IwAGNMVaWqGKT1BCUP5z3F0S6UiCvsWNbrSLIh7xD3D0ohZJAYxfavEIRGBiDX94wR8kxAWEVIELLRjD6zwVBG8uoxJ6+4fk/rGAVQIjEcAQhwRSEpSmeaMLR5mDGx5QBpoERYlQSEPRpAg96PXoFMjMlgtsZKMgIIsHCCiBESrgwzKOBHooQUET0qACH7RgJe0biD+M8M11meU610AGIGxhi2scRZ6KHAgg/6EKU6hCFKpoxCDb87+4MA4ufsXLWAo62Lx00C4H+MdCKxYgE1ASE/+TqMWjJsKYV3HmQjv4h1FCSoYo2GAZkpsFCZxQAxGsMhGXAFEwXMnLY1VlIFwowRhkQwCeVsAIXMCSTLZRviYxpyQ8ccETdnuHDwTBAWlwgBHwUII9eOMJHxiEck6BglMo8WwQeIA3vrWS6ZATKS9AAge8mxR30W0WxvBfH49ii1tMwx6pmI8pTIEDPCEFB6jAAVmO8UCk0KKefGoGYAk7YMLWogOL/cVRWKAIT9RiEhVTRA4QUYs1vIANHOXorFrAKQ1hiMNbEMVbN5CCf9zOEomwxMpGIYE0PIEkBPCGFCsQAoVwYQu0IUBQvKEALhxhG89wwQdc4LUfBZlHMgn/8lLvEIQlM7kP4Viuc8WQo7d5AwI+wDIUtnEKb3zjAdv1iEGN4FW4oIEG5mWcAFjhJjdlYY/RGIY0ZoEDt8z1H42g3VFmEYf1HKXOipyXWWgRloQNKr0ERjReFjoQRHgCE4hQxCQbraol1HIPoZlDFS6EoaNw2Ac0sAag/tGNH4jgH6i9hCUsoVoIoKCYKRHDGzItBJ1WlwDY+IYR5rBDID7hDlDyEUmCDbUhb8MFZ2RyskP5CyFggA0yQcE/0oBdF6DAG2D6x9uwtK6VIFQpbIsLE65xjGMEFCnHKEYqhgELZxRDGsXIAjT81Z5B/mNPs0gPm2RBC74dxRnwRcos/2bBx0LJNdEHl0s2ztLoimHCEybI6EY9KhHXDYTTnuoDEmZAAi90QwSmHsE/RKViGwRrty/2xh4iwgUORAs521DHA3hwjgcs9QNpAKKQecQTngv5Dg5IdhCCEEo1Vo0L1LsVlspgrC14BAoq4A27wpqVszRFLi+QBSBkceij0EIWzgA7MobBClpcowH/kPd6RIEfUaBiT/WlhTubcRR9DGPufZuPoNx5lLsjvKCGrcskkYKIAjXaBL2YxAtGg+nSGJHTGcIQ0dHQgAk44R/4+PgCXJHiUY3iBU78LW2+sRCOcqACtxHEd/bAAySM4Q5BHfIdtiE84vZofDrR0c+NK//0oafhCOEogRK24Q9ihZO64dxD08EEhSXU0dsbNMJ4k4IGLPBCzXr8Rz3mxQq5S2MRrBgADKgBhC7ZAAjXcFMAioFS/voVvgA3y0Dj4sez4Nvvfs/cXi7G4F5cMuKjqYIQiJUuuBAY8rBOCYI+YAJcWAE5IAZi0DxLQK1SSARxeIGg+ImfMBoE6JgjeABbYyIk8KIXcINkW7KbU6qbqxEXIIObW7IlAzreC4KVyAEhOIfeGYRvgIKieADsupUG4YDHkI4Owoq3WIrnK4u5wRs1Q4b0cEJnkAZnYIUEuAYFQAMOGAcaAIT1Q4+1+jOzkAb6S4pAiwtAsKD7Q8OzALz/t0gEBTiKTigLRqgFE5AwwjsAG3AWziiNcBCCUJIhDvOUzsKFOqgBFEO1ERiFUgiGJegdbHCxlHiGm5mDPfABXUKJIYACBcADLjCC3XtBG3EAFxDFD/A1r1GqDFmyXGEyH1iJI+CBJVCCI/AB7NoWbFKBMSkb1eCAI/ikI+AAHxCv8doS+5G+InyBa2AFskOGZfyHULiGfBA7GEiAE+ggG7iGK5gBGLiGYuBGgRNDWaAGMlQkfdALVkgPWhDHstivo1ikNGSYNTwKeDSLWhgHAUkKAGmsi0kQRTCBFuiCiQsNhRCCKCiZf4iCofGULTCHVfgBYPgBkSuF3bkEccgB/+xRjqJhvk4Cmgp4hqCwij2IhDmQgFxJA95LI6ADutdTKpQcOt5TRdXwCCG4ABtAgjRQh1NQAQ98ACjAye/4qsxyg+rYgiDcAu0Qyn/IP7g4MwEoBkBohmPYhWUcBn1IAGRQvH+gSSN4gXEQNyFAhSwIALZwCywgu2E4hmYwuH/gBv1AN79qR3eEy7KYsA54qIf6oH/IgM1Bik5oNE8wA3/sAknkjBCYg6DhNDIQuhkCAcBYpRG4BFKoBEt5gWe4FmzwISj4hwu4Bg6AgiHAhpQwAyjYAwwoB0/JFRdItuMCOqVygTRoTRgMOgi4g3FqOg5IgAvggSRZDlY0FzB5AP9wOgKPUJurKItxehj7sR+5QAIYYIVjWEZeWLd8uAEYcMNyMpesaIFzsIETMAZjMCQcKAZRiwW7U8v+cIZFOoZhgAuuu5e45A8klAtP8ItskM9/uEuzeDgTKIBOCC9s6MgusJkVCYSD5DROScgiuARgGIhUu4RSUAVL4IBTEAMXUyIXWIKBsEJ1AKNTcIFcQ4A5+IM+ELpcUUXeW7KSbDKhy5AV1ZBt8gcOEAIEuAAhMAJs8Ablc1F/4MXexIoHuM6qewF5LIsrpIEZ2MZraIYBSIAEsEIjxEokYAImWI9ZiLfGGQb1lL9AGaBhQCn39FJGq8ujYJmk2BwmYIQFYQT/S2CEBXgBRwQWFWgVPjQZk4GAMYAd1KiERCAGCmzQSkCAIngAFDgJlJiNPTCGQNjFZ4AAMckmN0AAXDADT/GUaYvB3RO643pBFQ0pDMHRLVGCR0USdUgDF0WD6RjOsFKbIgQX6yiXcYoLLFMHLJCGfBgGLECCuViJB1AAGjiBGYCG9YjCgUC3QhmALyUoIbWLR/ggwfiHRMiAREAKvbSETkCxREgEmTECV0uDwOyMHkPIHRC6PngBbUgBS/iBS7iEUTAHNOBIFHDXMiCALtCGq9mCL4ujJbGtGSiBcoiCKPCIGJLBgOW9fxiDGBoIT/EHqTAXBYhRqOICBWDFbfqH/69CAywrK+tgxbNAAxugurpAg6Ts2KTQDlXdSmPAgmKYBUCaoKQwN2N12aOQgP7IAE+Aw4EQlX/4A6RIhE5YAAUAEUvIgHKAkSEQhG2gpdKYAxEdKZK5Ax8Yh0oQAS1INdPAhkHIpmn7siNogez4si+7sisDL0tQgk/aglL1gUqFzRGVwVFygRzFCgxAALidAQKU2J8MD+1Aiit7CyOAT4OiCzQ4ghe4Tg6wASZIAAGgBsjxhX/Yt5d12b4d0rKohfo8C70ciBMb04GAVoIYAVXz2QzggqAhgDMKzFhhgz4gGZIZKTRYAEtgKQAAGjLowZJszRmj0a/tDnX4TBcI3P/3EQJzwdGSEVgOW1GTGYM+yNEXUIJwQIDmFYIlcNGk4Kbi3FvIjVzrRYqzeoEteYETgIGT/QdjkIXxjQXHNdarjIuYHYiYBdm3qFlUMotrFYj5TTELmIEXMAKx8QaP8h43iAKS4b0Z+gRLAIYMCBoq+wYUEARaKgETSDqiOIUhGAgV2DEmVQKngMmmu5ASDdhRwpCvcoDgZNjmNYY5cIcXyI4Oyo5v2S681ZKsKJe7cJ+JPQpVNQsjuIJxwMoXKIa2+odiaIZimIZ/WNzBUk/zrQtk9Y+VeQuWAZFLWAImsARKaIVWsAYOGIMyuLkquDSWk1QD/ZQlyIAWcINow4b/D9gtbIiQKoiCW7myqhAa6lGHI/Ai3BTOceKukeqw4j2KpvsHH1CClZiDEmjeOegSIxCvIGSXoRyIX5xYu30LwpW6ycFhJuCAF2CCGRAAd8IXVljLQOlSukjHpOg7JIbZu2iB9iVTpKAM+CUIMTWlU7MAGpgHVpiHDYiHFoACV9sGzsg0gAXXkdoCM/iDIxgS4TgFOhCDf/hQN8AuWqyANHK12eCAC5gDqtmDDIZJ1dhUURoIXInYf1AC6LUBJbgAQkaAcFCCEujNkA2rinVbG566SLELeVYKGqZeDmCCK2ACG7CBTZarYgiFYYC//jjiuRC7umhZU/bbo2gB9S2L/81LCic+CgWA30owJQvYgAnwBVbo6A2gAjzgAHUQsm3YGSHI2U754jHwgT7g0H9Qolv7g1apCA7rg+fwgVsTnpxUAZmcUQXoNh5diYM8ioJ85H/wjevcKBmVyRLggB+VXmyr4ehNiq2V4XiWC3PCgivw5/GwD3Jb6LII5UJp2fQIa1OG6CQulVOyBAugC00AgYEIAGrwhYEOhVuYAioAAQ5Q4A8og9DogSPwQ0lFGgjYhnACppmmtaDBLk7Bhh4MnlNQkiOAgKopAS5QAi6AyUrj0aIEjzvw1yLkg2UYiB5ACg7smO3trqhOTuk1ixdobVyN57o1i+5CgxfoB1Z4gf9dYIUhHuIByoe3LAtRoxz2ZOjsfSPycui4IAUm+AcmCDmkMAdLcOX3mIC4TgeP1m4B2AAvAAH8QIFnIAAz4AJxcAxOiR0O4xRBsLZtMIM5oLUXgoxOESeg4CUPXAIOWJ0L+NAegN6V4AI+cIMc1Y6IxYoRUALr+FTUZubLVoLttedHbgHr/Sp6lu3ZjvCkIMIXUAAliFLmlMIJQoZ5GWW+Whj+EtbjNgtVjgurNgtScWu3HghzqAxTuoS4Lgt4CIB0EABfEIAfnwcQCAN5QAJpmr0y+NAQ2AJOAeCRgoC3qQAemAOWvgNJDSkogIDeUYHMOgILQWGarBqqQYBtWoL/NtDs2R6INoBDHiiBQeYCXDiK9fCqMItqFzZCtVkX6ZvqeObRqVPVr/JRsfpnummrQFO3f9CHRSjld/iHEt8PAdCTfxCwgThrFT+MuUhl+0ylt6jOt3CC/bG3H//xynMCZsi23RKEMgiBEDADpUXAkQIlLnAADlDa2PGUB/BAH1CBbziCK2y5Lu+BQOACGf1QJVAfseoBJehUP/YH1mmWQp5RqBqH8msBH0BC7lJiP/dFLXHbX1wjiU0bjPUIjv3hRh8IfXCGekAGR8CgY4gDMYSLtLR0ukjr/bjugWCgH/cFEuA4KqiGVXiBY1KibeBfyABE9YaAPujmgx8IHzCC/4ogY14Exi0QAqMrZAQwZ/VBgxKggOAEdx+QcgTIBFdpXigTAh4QghngAyR4UYttIxq2drjgro115xpGVRflxR2EAguhahvutrK59MYti1D4B0NgpK8biIKed72od/+I6/cQAAsSgHm4hQAggSmoBjkQBhsgA5NwATOYCAJMTAM8SElFSPTGLsEOmhb4A6MLhC5ogfBCApksZACoeATY3jlogwPotoH4g6eC2+b9hxIolxdwJhoF918ERmyXeWOMixwFXDGhruNTh6C8Tp/3CL59USQQAGkgbnbMoOZszoE46KXPj8xB37OohFL6B396C705HB8Xi3kQAB1/h8qrAf85+IU0IACizZa/pjVR0mMW1WMIIJkvm7addwMhCEAkMYNL9p64hSrpp5oEKAFcuIA2MIKseBgpD/xH5cCUp4wWMAMlEIJuC5dSlcWLTRtzyY646NQjuMWB4Ck6yLYKQIEvg2QaZhvCxYJdAIh/AgcKlEXwIMKEChf+01ePYTSGEidSrGjxIsYFAzVdtCXgnxcQIKAFQNWtVIcOLVAIEvShjLcl4Ur4CAJhBwQI/4JEEcjTZs6cD3Km+bdkTokqRziYWfKvxIU5AOZciDSHB5cFCKIKEafkhUAfQhBYRfAPwZx/F7gIGTvu3zl/cufS9efDBxqF/tDwtSGx7gMVgv7/fRD4ofC3bwQqjNmCUC4HJBz8gS12zCDGzJoFSvtXbDPo0KJBXwL1D8TmDf8mxMMFbQIVBh06HXlG4DaBJ2bQ/vFBZue/MTsE/ia+I4hNH/8guHkhJJwNNApKnOWwZAYCqG21GSmypC2GmeXacPjXSUmkEgiMCUSA4ewFBAiUCHHXwscWf//q7t9yV/lBaBzhzxEtMASYCrn9c8o2pwiS2BCCMFjBA/o5JpB+/yigAGU07NLMP8hQNMxoC4mo2TElqniRBJmBJZBGBJlj2mmgTQBbKyBM8IkwBSxwhAsEnPKBGN9sUwECVfQ03BjLDRTEQDkF0YdQaOxhBgfnlMAF/xdLlGCEDUJE9U8VLxSBgBBKlGBDC+Wop54/38k351lczHlnD0bYtUVeGQqUl0D+8YmhD5MdAWhCdR1RgUtPEDZQYQsKgs1gRxwk1z82xMHXCdeEQiJFD614EIisCMTKZ/9gNiqrrQ5kIEEHMFHJPx78s4GtK+oIAhVUvFIDMcQkYoMRFXwwJAFHzjGHbxA0GSUEUAoUrU1RPPDAES+cw1YV4YQTQjni+HNBDzzYYAMCCZSjhAJoZKAmWjgowcELSlyAy7JnZeceWmhGwoMChPqAKaIE3YXhP3wakSiG+RFYSG4vifHPYBVTnFsZ27zxp35yodECDDZwEPA/i4hKUP8sJw4EKqgrOvIPNwd9NswtrtrsagsvniCQragIhNpFuASQEBUgvEKFCMRIQkwBDNjwwBMEfJAsAepwEY6lBOnk05NRQOADBNZusQdU4XBRhTvhuMMFGiOUw4Erau6hhF8XKMGFelsJweFY8ul7J+BCOJWhoHkVTJB/lP1jRHmXYjiXDyrcQfGjhVn+zx0uCTTYNgN1/A8HyOwcsD325DMQiP/Ys8hCpo7GSUMC0UJQiiTO8o8tN+vOKhYlZjL0QTd+Qo4WwBQADCMFSJJBC8YSIMbz21QhhBtb/1PctD5FS+3X02ljdjlXdymEChy8pVYJ89qgZjjLyldCCTP4s2//v2dh0O/9c0QyQzl97vfPXRzDl0T54FB+OZznPKaCf9DhA09w4Ob+MTFB0EEQBBhMhC6lHzRggRXSgcFD7EGQUAhEH7BABuxC1KoBHOR2qRqILEz1kd3RMDQ0mABBZliiapTiBx2QxCQ6MQkgdiIDR4De1LbxgTt0YQ4OABDXCGKTKQZhC38wgxvOdrZwVKEc0+sCm4SwpRLwgAMZ4EN8tjSnSPxDCP5wAw+iArg5ZscdmDoIB6D4mBYcIVsI7NhcoDCkSFHOMN+I1DcEQgBsbAMFRfGcQHb2IoTo4x+++Ich7DEMVogKGfVoWWhA9bKB5A4hq6ohKi3SohYh5COq/8FIJlpxkElMogOMkMQIgAFES0ziHy3AzW2m5oA5sCEK0pLWQMiwvQckx1x/4JLZzMYWYgnhHAqYARnRoI27IQAX+/Lm/RJAICGkRT7eVM8515OdC0wGYQMR0IUuFR2wHOwgBvBHfo6wwNsQZBsEqMiF7kgDDU1yILG4Riz+kUJWOGMghtBH6jRjiImk6CDHMFVEU6nRi9SsZgyxlRMQ8gqCEKMWk9BFATqRPEZMogCeKAAjXpAGFPhTiU/Yhtn0mJBoec0H5wgHArzVLbWFAAHWEQIXFAAWHmhTCOX4B1XmALg5CAEN/lDCU+Rzgb/NyU5saed+/BQWvAToBS/gQP9e8qhBf3BAHUNwVGEGI5DOKYSu/yDAAzwHoATQwC8JcYYsQKm6eojqZBZ5iGENu9HFggZWmwmDQrqBEFouYGkZqGUBOnAJIzxgEFGTGgFcUIU5uIEh0ToOBB5ghjlUgQv/uFo5BIeG7HTrH2B5QdzKMYf3SAUPUnUP/ORyBAqU4z3vm5N6pJoJIQzIMYD0Exrw4zkOsOkgfQAQPuUCBclVkGJDuis/ETKxgVSAIAT6BxZgYIhxgHAA9UjhQHzxkIZWciCfAg2oHprQiR5Eh4z976smsjPXJSSkIU2IDhCiUiEWoACTQAQtJ7GAHLyAprjZhgu+UYIuQBGZUAIKBNL/sIVulSMc/wiHNgLxBzQIYQ+t9dYS/LEAMannLGlpCw94AACAQQ6r9KMjAGawBJ2G1U+F+odVzYpA6c6FA4Jx1EHo+k+JnKJChPoHDWBwjSIMwBAqU90wEsrY2f3DowDeKCsx4oF54FAhcqgIMP6xgEkkYhJMAyIwLLGAYaFADFO7zTb+MIdyEPk3ZABKcoDqrXAs4x9meEEPoGk25rL4LHnrwRwk0Jc/pMmqA2Nrju0k1fz1q44IfNwG77OfIxA5gfn5xiDAO+VT3NUiFUiDfu65H1bAAAYKYKFCWEeQXKSyoWc+tmMZIgEgZKYTAiHGQTQiCSA2GBEpyXMlfjm1/yRu4xvtk0BPnrScnDQrCBJgrVADkYMWCKEKF2htCMJxVn+oYyx2arE/+rCDHfShLnVZQg/iI9WB28kqafnfY+aCZLT6NVFzeQAKKHbTf/jTMBWhwz++MSh/tIADsoCBPhIwjNMdGyG9K/mx08yQDBzkzf/oRhhEIBBGEIQRxHA2Qeoc4ZbWMs+JGMERchPMU4hhGyEQQheMCaXhQElKEFCmG+awaCH8wQY8cPf05E2XFsTxTf7YARn2TYaw96HsYO+3fhSgBDq6BwB7yJpYzYspyrwg7gnci1st+I0y3EauFJ/IbQSD3RfYAAb5yEU9+IsQfvx3drMo5T8yivJRFf/0HyofTQH+AW2CSAIhJpjESz3B8w40LRGJsETQty21w5wtBL4hSE+2B5SoL1re7T7b3vwdJx78Aw/h+Pq+gy984Tv3CD14DgbspNW96cnuaz2rXuZyBBTEFRuFgZ7fKXIbbGC3BVk2mT3qexDGA1gAWLAFIAQi2MmzfyMC6QQxWHoQVwiklwOxBIQ90QFENLilQBRBIpTCAtiABQHTTUmTpSDTGJTb00HAbpRDCPyDGzxHvOUJXTiMP9hADyAAD/wB8A0fCIIdBDgMFyiBm1zA/WQHDizFHTGMXBiBVTkcPpVXE8gVbhBE9imEkZwCNljZ4ujDDegD4nGCCDlUydz/g0UYwiGwyiw04UewguS1n2ZcnkAkm0RQYSfkwEHMBkV0wEAgAhhiQmYZj+kZEQoEyQe4BAGkwdWUgMoFgQ8MBU4ERROpBVT9Q4mlhe7tRV6UAB50wgeG4PCRnVwggb0EzlI8jkTUnd3RBRRckOVAWQTlIKRM2aMIQhkMDJIpQBA6ROQtBMlNhOJtRiUVIUFkgZkZmxQuFhUKRAGYwT8wgUCwnCVcBCKIIf8dTwcQAzCUQg4I0jcUoGJID5cM2SN5zfaMQbT4wFGc2ImdTQmYwR6GFRKIkTb4AxwEX9iFndgN4g6IWAau3XEBDKIo3GNwgKWYI6pxAK2NF4McBCUO/wQwKVKDVIh+QAKwqc5ojBJoPBSx6eM/pAiBreIq5oBBasYkYIJAgOG0AUMHAAMwJEIw2EAaDEJNgVYaDBUX1MTWyF5QRIGgqUUVhEAVIEAXDAiq1QW7YYC4CKJLil2/oQEP/Na+DFACPcYLwEoL0sUDNMHlfEAiQQpDSM3fWY4K2OML9APrLII+CJtEfFkqnRJBsqJEmME42B9FJIJAkN5L5SIvJgIwLMACLEMLYIOQSMiFVQBrfUleLQcV4QQcVsFrmc1ocUFpyR0GyoU7YAAeBOI2gh1g/iU37kA26scXkBNaCIGBxJM6Ykgi/knCFBkHlNcpWCJCxKMi3SDFlf/BKUDBC/5DPUTAIrAOPxIE7IgImemOPkzUEk4lylUeQiDBP2ihLf6D8ngChF2bJVhCIlyCAhxBZVqQBU1NRnrLHHRBH7hlEMwhMkYBG6BbF4VAF7RAIyoKHjCXvr2kS5JBcv4DD6wTOTLM42TNWvnDA/Dd1CgEIUUZQRCSBUFBfigAyF2D6jhCKCaELMRBQNaD+NnMyfiXazIWCyyEs+HcRRzPP7TUGAKDCCwAKZTCb1bAMzjI89yGN7yB1M1BCBiT9tiEMk2Ju+Xht3gDWCUcCX6BG2yBN3YjiwYmYXanOKrHqSHcXlBnZKakk/Hd8zzKRYxXhSpSBQwMDUTA6pT/zH0iBCw0FCtQgyquCHwFKJSKRgckwz9kgCQkQ4OR3ggQAykswCpQpIMIJ2jdAYnNxBF0ZFCkKRucDWshABt4A5IdyAvyACMEonZ6Y3G0gBLMBBc0jgwKSMLJRWDcAWglRFAShCWOF2EQJZGcQt1hQT/wQuIhgz405UJoUjMM5IqYoimtn0QoVpSyihbmAFZahPJkQGaNITHs5iVUwgtUJjDhxjOMljTtAQN6KE+FaPvsgTq0GiQRxEx+3WB2o2ACZotyJ5nYSwKQp16Azq9alVxUwDfQ2gUhxAU5yN9h5j9VayINjA3cQD2YzESVJkK81z806YpM1D/2o0CA6lKG/+pG1eI/AMMkGE8BWBsmTEIGMAEw/MAonAPEeQNo+dPUkMEShABJtk/W3ATD5oRzvtu3LIE3zKhCGOIWZOeduuTWBBz5/AUaxFNKPsA//aQiXU57Vk5CbANdlcED3IUCiFwIoWtCkJ8snM4o3owjeOpCTBT5DQQkwOtGXUItgqUkgGUG7GIGQKQIkMInvMAziAGFxqoLPGc0CYEZ6MQUNSCIIoD4dIFdVYQPKIGeZKPw/eWxhqBjIAEPCNlfSNdAYOAWQAH1DcQESdC2xpUEUU7dWusFDYE3BIY/5AMMDAMngCpDOAMybEKrcCpo3AOx/SM//CzrNJSYSWEr7s4P/P9A/WmeQwJD0naACFjCJSzBq7bEwIKWC5SBUHHRRmYtiEHAUCHAHmADnFqEY5yNnWbs8MFBd6IFdUjEoCAM5FTAP1nQxKwnQXzD8aJsP1HcAz2B3z4AhbwAzHpiRTQDU4ZqfUEl0NYQGSaCCABDCiStJZDCJYjAJ/zCTPlTrF6QIDRR+4RD+FQPFWVths5BBagDFGAEgfCAsBYrsRqrNhLmvglEvSCAFSKEnwaKxwgSNjzBd+WgxVgQDnrXgkyN3lXmEEwOAbyBDyjAFQxDPWxCf2oUCXUvClPEqu5Z+FoCE4ClK5DCMrAjUG6DIFzktm3DHmxRFWwYRzZsH3SB/Lb/zzdQCP+yWAv0wbDqLsYi6z+MgBIwK0VADgpUK8la3EGAFsmSrPF+g3JojD/QQALUQz48qUREIUEw7pkZbgrfDCkMxCX8Q4OSAviaLym8wm+igFnCKjBZ0DaIT/tcwJDhKnJEQQhczRzsgQsY8UXIxRK4gbAKcAAPcHaWXTYKBAcowR/wr6BSpt8pahZnZrdNEK0hC/R0zm1UGcV8QAX4wzAkQCjYwxKacYmEEEHcrM3gchvfjLx2qSUIiwhcQm9WwiWogg14QzAaycX40w0D5RKITw8jZ1Aghw8IWmtxgRl8gzqA7BRz3B74Qxro7r71AR1IgTnvgIpeCA7IpUU4/6Kj+N1P0hrzPgooD4TFEGyQCmwS+cMidFk+GEI+HEI/5ONFKO5BiHB/ihkbb6pAZGr3Xq6rhG4lpAAALu0lXAI5qIIrTGaDCMlZwuqkSA/4hM82UAm1+IAZPAeX7MEdnAJZNfIL2gXZvqi+zTQckJ05E4IVEAIY8C46/8MR0Cc3PwaqRY5iIGqt3eAFbavFYHEW48YppMFM/cMT6LE/3MAmGAI/HMImREBCENvPJoQaE8Qh8MNEhXUJM4Q9EBsRaoYscENqDkTM7HKJ1KKzLcAlMMAlMChei8AFPA0BJDMzG4liuG8TDVUJbMEU4YUKJIW3cEEQoMADCFA7zwWfNP8xxmY2lYCBFXR2ZxOCFFhBH1zIRt7oXwgqS5xC7S6q5VCo5TAqjyYE9NzVsVwLg4QWHeDaDXQZJyhuWp+OPSChQox1KtjDKIoQ9yqELmOELBgWgB7bgDJEdI+KzA2tCJRCN4BvCpwvObiqYEzZP+no1IgBpVRAvPVwFdhFFByBOszEHphNCQCar8opH37svvEuGYCBOfPuxcKBFbwBHBCCgOt0Z0sBHAzEA8jmXxSZPzSIdw3GP2Gf3Q7Gn+UtZmarPHqDDzAKbTdBGqDBbgO0IyT3Qi+EsHGqI6wmRayrQLA4RcQQMmQULAQklEI0qxBDDYhA+IZuMAODD6AAMhP/Eg4ni996yz8EwgtIQBf8QQlIlbtxiRuoA4dpxnPJBQRkYx+Etn9bgRTsW2cH+E6H+ZZ3OSa35URATlI/CG1rsWWeLPLWlZFAgBoShgs0gWcmQD+EQj7YQ3KnklMqnnBnxuyAUjNIZcpplF7/g1bqeA38QCmIQI5/aV7Jc2bixhAAZbI0EdXpaQk8xxysND3Y8Df4ICdbtl1wY2iHthXAgRQQAmGGtk7HOiHEGxhgD1oBb8LIxYZD9bYWUrVeZiEthIRcsEtLSMWFlp2jwRUMQCj0p1MyVomHRlzTdUVo5f0dBDDUQG/+QDB3gza8wANs8N91ThJRjQ0TwIWWgz+U/wMbjEAFVEEZ/EMXfIMLCIJkD3VF7CF3wsGWe7a/73S/8zsb4IE5D8Stn7l2DRKE03bJ5q1ASPhrI0TnFAnF+NOoQ1z2uYAjgUw/hF8Rfln4aRQSRntmNJQA3M7NrIFmHMDuCIMcDwSwyMF1Y7QIrMILGMEpXPpAHDswFYY3VAAaCKwLqKw3fAAaEsAgvEAfOU6+K5yV78AbvIFnx/rUC3iYdzYbfAEegAEU4Tvi5DoayK2kPEpNCQSlK9JdDUaRREqsKdIERcgpVPUDpEHOjzdhPAMKfAMH9BpAM+W7/oM0nPA/EHRFkBwn9LnNTINBobGr1IIEHpvp/cMbW7ui5/94N2hBN9B8DSwDuA8BjxIs3F+ON0g2xLFvz29DE/gAE5yAjZpXvodVf+xbEogDCoA5gYd5rPu3f2f9F4RDEsA0PiUIYfgZyr65PUuEezIINsQ7BNCUQDDSIX1AFfsDMsBAs4vQIoQfMvCCNHRGKkhU+DkDLDD+qJC8FLL8zbiCBYgArZwvPtSAJowCMwRDjkfCCwhsr4MX9PDTP/lt80cKQBAQOHBbmhbFcBR54e9fw39o/jF0OJFiRIlbtsB586UKHSsfCYUUSchKSCtw2Hxp82WOm4ovG/qT+UDFKUH/PjQk8I/ATZg/J/b8J8jmqQ8oPjw4tXPiUoGnOAyDEcr/3gB9ixoiq/cvlT5HQH8u0hcLGVizZyeWRbuWbdsDbdm6+idXRKJSIrqN6napVI0a4o54I+CCoqCBAocSSHPqnxgC2x5H3oYCQhFVqFwV2eJwixGJZmVKlKmxB54nUuCATF0ydWsrFb7gadOjCmewoR9U0Ilz4G6YHwjk3NaYInAxDb9BFoTNxylvOLEJusnYMB1/WJQMQ5ZPKy1krP4dg+uwXj5n0obZG9/W2b/26+HHlw8W1D9N/0RocVVJ0yVyIsi5RJUjDNvpMIdyIiA6nso4zMEnUEgDCWhogAaIc2Rq6AgfIgLNIos0+uILceiQoiRCTJQCRSlYZDEJPGJr/wOAzczaIjQoGMupIccOGy4ohxzbcaigguStugfS+McBnra5SbomCXiDAwUSCGWYAbJKJavx6jEEmVC2m0/MMc1SRBEy50vknwKIEUGTUvr6T4RwbHjmH8iGE8hHhwT5xqHgJIvMBcqYaIUGGlopwgZ/IOLgiM+AYii0iHZ4Q7YelrCixR3IwMifPkCFgIwK2ij1CyVqcwgimGRCwxujghKOqZ++uWmnnD7ApiHgEoSOqDGSPIWwDxhL7IkPngDDByMS+OfKfLCy5xh9moFrkWbqcabal/RB09tvwT3LEksmwa+Rbl6poZsahBGBCiMEccwxyPzkycB/dHVQX+DSYP+CwlsCOOEfDhsyYtXbJJV0BylEnC0EOnYIDQ00PpMIDTMA+CIElxqikVV/fPhm1j97xMm3hiAzLrE/efLzG6PKcC4IfFfGxkeCmoCAg3xuuPIfTrh6759u0bpKH07qwWoioA1xSOmK1Av3n6+krpqtci3BD5RLuhHBaxGEMeI5HResyJsPhNsNUONQ8EEBHP7xYIoSmFioIQ48O9jDT5sQ4tQ2CJDJhz/mEIKHOUpIHAm9KWJ8ooR9UMfk43jCNTjoUF47OZvvrMhAy4FzQakPvHnmCd7+EdmhO5rY5roEWNFHn2zzsScfuLbadquJdq9n95eAfvosWWAKxerj46v/759RmOGvhgA/KeI5xJaDyTGigLvpegKeIOCUB/z14B9RJsik7g6N4OCfzTyO9J8+wJijDTwo/qcKJXgQAgMESpgDgxLyVw6OTYQD6osUyFTgveFoz0BBGhliHlOse72EANv7QAWOIBBBuEAoe9rVPwZ1Cn9QSR+GgFY9BmAPLaEJEg5pWkOo9g9D3COGcIkF8nC4ngU0xBXVcEUj+MOfS2iiEecgDJ5+YhgdfSNBkTEMUXxwAlOQ4B8b+AcuimCEFjTkBS9YjwtC8AeG+EAJQgBAJOaAgDSmsQQI4F/+lnAEDqBBfY6jiEyO4Kc94Yo3OnJIyqAzHEEWB0GXc8z3/zgoiAc8xo88Sd0HkjOECiwLBv0YRj540QyiCW0tL4RJCYnmkKg5ZBhoaZo0cphK+bhCE5oARSUosQwggmAcz6CDb0bGp55U0EgV3OUp0qCAADTEC6jAAQeggAT1cQAJkNqbQ/zxgCu00X9uRMD+2pi4EnChBEIooxJssDcfJJApoPtcLhNDlH9IECZCcRIBHuCAb1CmQH7Snk62YZNnNAEKHBAADIbhDF4MDRk3XI8nG5KLqMVQeP/YxHhW+I8WqpKiMMmaCBqytUoM0RWgoAQoRpGB0RkpXhNhooEWaBNs8Eo630iDFKk4hQnQwAdDMEIR1BfO8azqCEoIxxoBYP9NoQ4VARcQAhcgYseJUKw5gsiJGG7ZkGIBBZJEkY5PPPenBHnjAUNwjlLsJB1ITmQbteLgKdCgACVIgxXnKYbQRgkfToRyK8OYXXwi+pNt3a6iOXwlJVz5j49SQhumaAQ5FJCGnujIJoVBzAL95BTDNAmYCgABDeLWDiakAQWnUAcS/vGCRUGzQ2dZQBsugIFqphEXauSfNbNZOCF4UalLBVkFdnKcRr7kdA1xUmMp9xPKGeh7hvledPx0nFl9Q1ciu0kFHmCEfsCAFdOQBi+cQY1/8KKUZGqo1Y4Wyvj0oq9oIewrzNEIV3hgFJ8YRTAuYYkHIIVPnXPISpkihiH/vNM4kXmAFDdgig3MoAhpGMIHnqEALypzPIYrBzVdu0aicsGNiStHW0I2OTo4xgVDqIhhJpKrsqKzOJcDTj7TIIh8/uNVKjYZnxa4qzSwIQ1GwAIMBIpKZFzjPTWEi3gbAuSJNA1o5TXyeijREMKeaxWjeAXXRPCAW+ZEnY5cp73u9Zgy2OsUL/tAGlzRiiz8YwLGeAEKBDGEfKrDBm1m1E+c2RAkJA4Bxnjt/tJo59Zis85c4IFO1+IPb3ShN4W2VxmA40in3GpIwaWIgRBTK5eigADfyM1uM1dpovjJBWV4ghFUkICpOGMAzkiFNBaBDFhcA5Xy6VI9kFYRR3iF/x9oeuh3jzymV/CnIdoAhTaA2J+7XOIIjFkpkOx7p7U15pyG6fARLNCKYUIDBz4AlJpVYAQkFEFSpAULGrZZVKLO4QJEda0QttgWDlSgXiBc29pg9QHpxNtkmC5konlzh8Xk8wFeTvYHq5wTF2yDDlA4Ag1gMABWmKcsyGiGNJChFmOsp3aGiKtDOJnrn3hC4w7ZdUbN8Q9QPLlrIiiFEXzbkGM/EDKNmReWsbHL/+JiFqiAxgZ8gAKecE4dNzXg48DSAiHY2Y14fi1R7Vw4dzykLVvAEWSUqxMeGWVWxQrucbDqkFOcDt/EEkSEIIMCJOn8N7Uqyq4EQYe2GeEaCf8YRijykYpmTCMrsJAP0haxlYvL8B4s5N1DO24WYPwjEZJgy5viKwJgMANeT42Oruz1jwPDSoOMzJ6WfWABXPwjC9BQwCIFgmDPYoPNovE2TFrAA3Ov3o1p5AFt4fKAmKWZV5HHyYER9GITv0Teu33nYi7ogzL4aAi6PUVZqVzv4HzABW/wxpQSYElWSOMYyKDF7TI+nr3/wx5A8zGZ+CofE+TQ8P9gAFga8Y9PaOMfQryECEjxCSQQ5rc+YUoue8MTq36DDg9QAC5MARW8wAOOoAK2ISdOARuWIttWJc5gQgGEoI2MYQ7SyOhaSwKt6X/SDS62IIE8zMQgbWRuYk//nkgoWAYxHo1YTsEBBAIFoCA3QAyf2CnlbuIovKEJvqcF4iABFI4bkGEXaOEfiGcWtG8T8sEI52rI+C7wXoK8zGKHKqJc4EJNKoIUKgKWKgEUSEEEFiAFLIEZpKcnsO5OkmP3ZMWD1qYMfIAJMkEU/sELRCGDtuE4hkMMTuEOFIADKsYsluCoSqDchorcrCmN3EgIloDpxiM3UAZ1dIIwQuzF6m0tymqdvGF61KGmnOpHVO6RcEI6HuNOPoAOCMAHjgAQEuAakEEWkEE8KCL7gKJb9AFLiswhas0QhGxM9C4+OM4sLGGH2K8hFAAuGAEmLOAf6uAl1OsSSOH9SKEU/8aBs3aOJ4Yg5sYQq3ZiOIDDQdKgCFqBFEBBE3DhCP4kOpZiyzzDRs4CDRLgAlqL9YZqBhCgB1AOPkKGMZ5AXo5PJ2bl6hrNBPVxyyhiEk2Gg7ahAiDgG+yJMSrtcpDI/roHUDioCbyhM5xhBv5BAGQhCIPQaVrNLHKh1j7pEOxhoriiIXCtLbACJMNlCehhAUghGB1CTbJmPoABo3SgIupjP1xhAd4PDNNAFB2CDsjmToJrT3hkINLsAYqgEdwEFKABCZ5DkLZhKrFBAeYRLY5ACMbtAgTRjSiMf6ogHNBNPnCkypRtt7LHJ/hISHwrOjxoIigHbbwnDXygAjjIcv92BVC0zic253Q4CAXuoA9aAAuaRRYwshjy4eF2TC2A4qGaJhc+qYbKIh9m7SVcsa9m0gOg0CEUwBVmUkxE4CY3ExRcoRj7Ywsv4RIyAAKaAMQuR2000UmG4hSo7hlsAAQuQeT+ASrvT08aQh1qy9uY5cEQ4AKLkxCHSghyYJvkAw3G6RSGANJ4wtFmUzazDtnSjGWGBKuUSBCagMWozsocicR+5HIIoAJO4QiOAAsskhV8ARlOze7OAqHaQiXD7ycO4cjk4ltKoSEGb/AaYofkQi4soRIsgRSAoQZESt4QRLcoQgx8ZC3lzakIgAxs4BWq4QQEhglm5sQ+8Qm+AQn/xBEt3KEz2wgQxS0SxI1/uOkFhKATOoYeHyBWnMrDEEQ6hgJtIPHDIlQvC6lJhgMC5ksu/QgvdWkicJSJNmjrLvEIqOQamiHi/sE8akdMxEss6LMh8qqvcmAiQo4iPjMDxoQK/6E//+ESkuwfSKES1NQSRCC+ck45jIQ4fCMfGeiJovMZjmACLEAULIBPZc/2bqX00GIPiiADlCAStFKoKJB/FABxEEDorjI+joCz8oR6GHGdrKpYiCK32uk6U25W0KCxsoydbMVz/Cgun6AJ+s8HWAGgkEEssERM+GETxGsAFmEWTRImtqUh8lOVFGAEJgJGv0VNGEAELKEUfjE1/0nBTZPVEiCA7Procn7ryvxR/9SMDmzTFDzAA25BFLLgCL5zSP7EG4pgLQABCc5BCOZgGdqIqEqgCPoHB8DtBYITLbYADcqyc9ayciCLrJhI+RgU9z4IKOryOdryEe2te64TQhsCBehgCwoBBq5lVmXoYs8CGYLnH7DCEboPLABqoF4CGSzuJMMlA6AQCg+xIT7zM+fDHC5hPxvCHLaGFBLhTUUg50qQBnGC3nbCO/VRiSZpAkhAAEhgFgLABoYPnU5BUdbHLOgBCloAAYRgJ2fgAuAxtooAA3DhAvxhD0YUPhgCDVpgMQqEBkVmJxhjbdXJTmFzZeA2KAykAjjAG/8obTY/rJ3WCYKMEkcfwAb6IQHqwVYX4Xa+jyQpgmiU5ggpIgJQMh+w5FeRZw1eAib/IQMyYBJMwAR20SGk0CxSgPAcYhQuoRJgSeQ2yiWf1QeOpXJmxcWmEu1mhVj0bw6PwANQgQZuQQAaYJHuoF62wWaCw+AcsCIK4QjQ4Ai66RzetfX4xwYSRy5swF7FxAf8AQpisCEGruXuhHOyLmB9j1x/QkfE7gEGgU+yDlemSqr8aFj+qBOPwgU4AAsGAHKH4aF8bNYMQSUdwmLJpFe/RRI8ARFMwAmfsCE64A/+wfAywASyYRz+gVjBgietsBOIwSFC7pWW58lSMzW14Qj/uodUHcmq7sWPPtByJsOLWuEWbMEWZgAJFKtX7GUInsEz1qd9GucBCKYIlGAJmED1YKsEoBcPDtEfjCBs42OOYPDR8g8F74k76+soqVPr/qEM7mATd8ksh4RYxmp8EWTrdgIFyiAIfEB9biAXG6LW+EFywYJ/XS1ckuEfEIEtutQT7NiOHcITNnMtADSjSEETQu4SRgE/RGAUKgGEg1dHF2i/wOJSy4oO1tAYBGAW/gkq5U2QTopYjMCLcHipBoZDWgANtAGcINWaZsuo5MJRznU+yNZV8rEiuBcbI/E3TOon0EmJ/JGPQBWWXzf35ukB0GAYroBjMZaYmVA+kiGO/9FiJj1h/Ob4HzpXgmFij1+iEj4hN4OoPzRhFJYhTo9NJ9a2iqmKNwryAWigAVihGATgGhILKRpkIUXGs0rLYGd0Ylx0Bl5ACVKrEJFgCZRALiDiHH4uifW1WNotMgykDKpRfJF0SEKvIQASJnQOG2zUexq6Bjn1JeqwMWAln5rkH9DzH7BgmBdhVhGXd44ZLQz4H8YPLGqBImqBgDvgHEzgc1/iElzSLEp3Ad60FEq3dJfhCMQg0U4MFHf0vlouOlTsG54gDcYBBkhAPKjh82wCR59AlpMJziaCYCDCBnpAAWxACdzhD4UArJWAHv6BA7bgCLyIlSfG2Cjimz9siv/Vho+o7KpcbCKGIAiGlEFzj8qiw0ff8qqaSzv/clmuoITusyLsrtZM1kqPOQckIQfEVE2sECZS8ycu+xXO9BKEoRQC5L1WQRy9DsvI8742tVPpwBvO4RoEQACOoRiKwQeawBHJtSig4BzBgmCYzgiu4AUWQJ//cAZa4KxjgpnGpAWiSQX8aDhO5y0XESwaSG2p+H1pN+bEM1SljntgorGWw/60LjjA4AG2CAYMYRMOgWoQtx6+wn9TeiKSoaVbOj5MoEvxAz7uo9dcoS/ez5CDIRJA+ANk+S1JrE9iLGVQwAakwRaOYRZowRhyLif2q7cWUR22CIeLt7eVgAO4oB//wqGbXgAJlACabKCTx8MH6EgF/uGKHxEmSDAGdYXe/sQTKYhPYOWDGslH83aBII92P+cGoQANYAAGJsJiHYEyo4aNw+Vj46NcOmG+56MDXBYmLhssluEVlhEvLqERRgHA7eVUG/rDlkKqdOIobAAX0uEfbMG1t4C+OnGX1BYFGOyOGMLEu1oJXoAL1vGo/qEExNEA/EE9WfkfxNFSvezGTWobDiyXiSSdxJcOX8IgL62xnMt7otPRK4J2wzme8wRWrloi/eEQEqBpHgrwKgoyv68toFw+POGlx0Qb7mOQXWEZNOHKmeEByEDekqM64wWvi6WLQwxQXgAHNu8fGmB9/9AMlvNFEKyyIjLkJyDiCPjgBfzZHfAcue8GtDKcLawXG3RFICnCT/KFe5cDTyJDO+8LLLbAbh3DrpXPxT/xT3wkYOX0CQbFdeqhSr4FE/4BHM5Ccd8bTRpBE2KJsD7hE1pBqL2OHNOMuD6sbMqcACKEBlybFRoAs45a5ZZivxSstBIGKFYlD6lECJbOB2gEb8jEegvQqhh6O3mDKRhZUwOyLR5gkSan0VrecyDPU5nYoUPIH4ahWf7hBgK+6NdikP+hEYBNGyihET7hAo7gDujASTwssH0r5vnkG2y7cirrGlhBABJAbO5gVpiC/kyGANRBD+/oJTxmVSCC2vlAHP8hYjOmd9vXAg3WWh124qSw6gm8lzEiSzqO43p66xrPIickZwta7qHd3cWn8pW9+B9OB0JEKA7+AQbiAAvE5CYxYcorghMcgb2NXkw+YRkogRI8gBJEgRJUoRHCEffy5Mu9uyJswp0SYwi8wQioi+6mMwbbXWSGz8PKoOc+pGPsviEmBhhL4HGQ+/jPooD+QcXRHSe2TCEdlkIJYAiik9FOpp16AsQgAFZcU49e3kCuM/lkk0keK3Uqpwn2oDPGIwr+4Uwq4gfOAhIc4bEpqgCqxhSavukB4lOkC0dc/BP0L6FCQQgZIlR4UJCYfx8oJhR06ogQZNeuQTT4j0BFAv//tm0TZPKUmRYQ//nb0jKmTDRH0ChEU4SlzJ08baLxgQ3bqW0iT0EU843ih1OnUBA4ReAbym/flj79ZpTnv1NiCBBACOEBgW07yYoUCZFk1bPbvpFNaBYuyTJBWhow4E/rTb18F/H9CzgwRCQyR/zrVEOEYIiiTOFopEpVKw4oxGQlKujrqYcthTb8NzGzCxRHikn7RwNCQq+s1RLAhvlgGXUv/tmEySHv4i0cEtp8YcSf7sUxOfh4UMEoyZBkM2MsU2HbgyMP/lWH8iBNkDQJt98ZwlfMkIqnqu9sTaBryK5vD1ok2f7JQRT/ytglrvPfI+It9fH/H1gOCSUCEQP//xQAzD+XCGZKQo188o8ppoBAUEMnrcZZSIKAdxFnFRkkyBNlpKHAAAlAcQcdFX1Q1UgKVWVRQoTZZhsatRHXm00y5uYSgDpu4QMU0YVUkkjYeIOCN9xlRxU2WxFAH5EueBPEA0F4A9JC7lX0zzcuVJBQE+2l9QFaXFokSFIfnMmlWv+ACVNCwxnAE50x6QigQvZskudOZwDIyBr/kMJTIgT+55gorWwAzREqhOTVRAqh5ZVVkLaWVlfqwADDEedVFFpaVBmRo00++JbnCzb02Oc/HMBk1FtRhVQBGf+McUoFFTT0hJNEjqUQU97E9NVywd5RgQ/foPCNfBB9ltRRoP9949VW/3CYUBnm7fZPnDLp8o8mABqiTy6tQpQMX4btxMhOkySU4KGLqRIhDqiIEgAOL2RW5pkQkcVeSf+4kJ6xJUlEhw/XAHLEmmOq2GFmCUXrgAJI9KbQC3j+hwapwrWKRm8PdHHKSIKUgc1xBAxC0rBpSkrRVx1ik6FCK+4kSB8o+BvjwDJVlFl7SLLxTx992inTfv+Acm7TgbUrUyeD/rOAJC3Ju2Al4gLWSEIeTICDMS+oUBG1zm6WFUlqf9NVV5UaxTJZD2CBxQM7C5xQmZil555XSRGgjjo5urqqQt4CZpMNR/hzatMcqLPZE0l508c2YC71Dc2nOCkx3xf/bUZzS0Spt1MayFU05k5lwExSRU2AyRfSMhkA0+ExEeO002uYoBUj7Ur9j7wJWZKQ1QolYuAIo/xTyQiKQahVgw02IooT+Krywldr5h2ztQpNpHeRXZ3pnFy+thkbz72WiREStf12o49GmIpqnhy0oALNFZhXsrPArumVMfHMTBApmUzQAgGmkIVnO0EdaHIHwQj+BWotWRcF/7GumIwgGAUqxYKWlxBtbA0wlJgACETRggp8AAWfQYixWvgrLU3Kcg+AQrWI5EK1feYiq+mXCtynkHNgzHaxSwhwEoIxkKVwf3X5imVc6J7OrUZtRJkUkdLSwAp4wwdNsM9fSPIM/xRUoAmqMVxCZBcnNGxMgmzUS7qK165EEC8hf+ALKQ5VigERqBQcHMUy/rG8rf1RJqLQxPREAQpQtMIVRxiLQW4GtKxAEm8CYxM2PrANCMDuH09o0x0scjOKMCRmvVIHEkjlkiL0yR9H4EDHmiacB/igLicRROgmGZNQ9rBnS2FgkSxnHfukDiLPaMkHGtctObWRL4hY5iH0YrUFDOh4A7pgYBiwPGaA8I8QUsUyJNS1mNDrH1/DAQ18oMIhICRU7HvgNoxiwNgYq1qCQEEavLGcp7DmigmBSkvclhAVmDJHM+IPnV75D0+dKy8OaEIk81aUUBksnxRtSVb4EiSm7P+kZBWJDhGXCdI2EohABvqHNWVyiQUlglAJqcQoXpEQEP5jGZ8IZ0uoJ4qETEAU11jCEeggiEkK9Z9n4afaPjBKHlZxYg1R50FiFZJIfgAbSFBAC/yhqj754AX+mN8qf/KNCrggcmx6QoaGuRh1jslt6vBWhghwhw+8ri6Lkd1w/iG7PBGvmQn5UwTrwZdKKGQEGRTMJaAHkVF8QrEKUYU2+BIAEPwjC6hoABKO4NAoanaHELGP2i7VGoQ8ABtDuOg+n4qRrSCkTRBFCDZUwFUbIPM/R2jBCxTKH5swbllP+odnNlMSf8YEI+0xWGctw8OW3NB0FSgmZ5xEALrG5C7/syNOXhdTgH/EACKYCGkF2biMSgwypv94UCMaJBN64aAVNJiBEdLwSfDxsmfK/UAngTUpvTkJBURZ0XIaMsrVgnIsH3gGAcYKBRXYgAM2WCNxWnmxPJ0qK2nyL2ttJggHgJIicVHImM7ikNSRBCMPQNKwntGUZV73Px04UEv4Khhyefc/5hhhJDTxoPIiViFZCEBCstAKISAhDZUpClnGdNEH2lK1ToWohpKShtGss4eg28YQngJFojRnM38ThAqg8AJV9sm2BSWO3eLJmub8qmDGko9lijspHcpMIV48k2W6cgofwPMN/vroTvwM0g749S/8kEk+FMKJf2yCTzMO/8wyKFFeU+y4JbjAwQT+gQoczOAF3qCDe/hysxZiK1osikgajCIf1EWrwjHr10LUGdS12BIKSChcnlpghP+koQkZguKKojU+Y84Zb7CJll6MTRHgTucf3FnxTpDm7Ea3+D+MdporGFBYvUx6JwJRL3r1ggNcXOMFNgyqhvg53Kd+QJ1JVo9XhsJsieWwIWhLrVfKwC94YsOzUPrGM7ahgMIBWiu/KYKDefKS1WDxip+p1g0XkmQ3USsiXwTNgd9wqmjfKU8aZzGhd1JtR9zjEI4AjGA1mG29MO0feYyJCBUCoW2jl14eMEUrEHCNFtAMqZfBm7HfwpnUEksuYz2Vv//QouWkmJtgUS3JqmM2LG+M46p9MoIP8nLXmZindf8qyVvY1Or5ugAllDyIq9cdyguZxWBZ/0ucqOu0JAYmu3zhhH9aUu2mLWhBfwEFJXAcmHHuxBSq6BoOrkGQS0IRUqg9LV9a4zaMKImF+SQSz2qGs9cM4RtM4GoyifMSBaAB6zzZgjcqQADwwHuKD08L5A2GEKJ4BSMCZn21egk6oRi746VvNE9grBWlQaIlkCi00/44ipTLRFyPlckg/9g1SphCFJGwaUyoZ/NK63kpABPYNpzkFuPGxC2iixlDypDAMnm47Bvu+vpNEhU6IAGIEkZC2yHigzSUYRtmVT9EfJn/EMHmatzjQKX2TwbUdC9Ce8LFKnxxf773e3wxbRD4F5AGEcHwCiAUCdBzaRNALzUlEyDQIKowA/RwBKS1ZhDxN5WiFZ/1WcFVT8HVNw5HT5p1QAuIENSiDlb1H1h3BDbwgBCwDcxCEpvxXwvReqDlcKnDImqjGVMkRcaSFUaxSYGBNFvwgBRICtulFz+QEMNHgVoxXokFU/8gDjJhfQoBU47xDzfHBD6QJCJBfjM0Kd8wLDKxFkN1EAcGASwUT14nVCWTZM8SYCRBB7Qmd4KhG0YAhDEhFqfwSevhM5jXgiviKwdUJk1ART1UMHmzQFVoXWHIE4ohihJ0hgnxCuKw/wo7sYoy0QqiMAGZgANuYDdlgikKVyloURZqEypY8U6xBwGwJjBqU28ziDP1hjZPQABIUAQ8ooifd0oQgQZpQC1PkTfK8U5f9HrnMRZv4GkmpVykkxZkwXsbV4ot4Qr/kALnyEYclIHbxhddYwqZ4A5HgE//QAcT0R5HNjFwwX6+FYjX6A2nAAHkBx84FHa9lFQ9wxBdgREEsxTqYHD/EScK9RNQUAYG5CIWxlQ84X/K6Esm8Q9EY0yVpIsQ0QQ9OHrsqBAjxJJtxEGCASE2Fwna0AKmdUPtgVwlw4sFo2U2uH6a8QALaEUK55HFKHQAiQIqYASn9COBkXVoAAHYYP+NXvQsCOlL8qUXxuIAWTgpHvKSfQIMwECKWkF34AJ8uXOKxNEILec0hPdYkRAJCuADw7Yc/aUXFvYQugRRK/QATOePgrGXDkFFg4AEaGAPCcCIAJIXW/AAzfIQw5QUMOIiVwmAHpYZb8AdEFEAzTSBO4ECbhCW/NEBCSIYBaAICdFdoxlBrRgJDkKXm1QGjgczuFgsb8Z4fLOPU+QNSIIR/hcYmoNcC7GU/iANN5APEWAE6gAgPpBrT8GXCxE6sTeIgVEBQaAjdqILq5kQypAQTdZPrEkcHZACTPCZRBAT4JIQdCeeFthGNdUK/4BPZ2UzJEGVBPZ/KsiRarYiRuH/DeGHX4GxIdeyFJGpAv6ABQmADIvQDzdgBLgVGGjwAOrgFLD3aR6mkf/oM5REAAaWELaiENdVAGj5KwYongACDKRACh2gA+i5ntoFfF54oucSCfEJEa+5CqbAA1jAAV/hLCVZeU+YNnuIFL1FEqGSGUOAHUMifgUUZ0W4FWiXN2/AAQpwBawwAPlgCPmwCTaQa4jjA51GeemmWvAGNP1Um13yIlf2FvvXEr1BJyeQBS+aXYogBnRwBxRmlRDklctEni1hmnzFhazpAct0ARjwD2XWQD8TI1WEVDfDjxCVjQ8gTIIhfp/0AS7wBGlwBFcgAMjwD/mgD/UAA/VwRHzR/xv4RBJmZTPnU5I306QykZIPKAEpgAUQsY76IUofgldt1KdttHIK8QMs9aIyOqMAIngK0QDXMAO4wANHkAYvmBC+EimcWKas5StQ9EIFMwTegBxqFhNwZkVoIyuzkgDSgAz5sAjIwAlaCgOskFVacRwVECX/5FsPpFqXpCWXJxMOgEzOZgEbkBCUsAKWwFKYYF+L8asQoRsHlztzBBEW8A8WsAIikCBc2DXZNYE6wJqj0AinCALJ+g/w2BIi+w8dkQUTcAEckHS2BK6o5STQpRCXZBSXiISudmQEoCRzxjMCGDMww0BvgAZxIATOIA0D4Az6oA+OYA/60A8woACJiP8nEPAMvJaHeQldBRqzS1aNWHRwv5oBE/gLQ5Alf1GOxYE0C0scQACoOgCxEPGZCvG24vmaI8sMfUIJ95JQg7BuqQVPLwRcXTJRsYduRdJwUSEdgtikUXiMz1ABR6AA/8AKCjEAiqau6soPV/CgRtAFvmEcUEAfGCEfEgNqrOFUpIuvlKS2/+CeMYEJvdBoWwATeXW243msgdGKGNAIqyAO2lRe/xGfuPAPket0cDGHovRWdygSkdkS/BIjIjEip2YzP3MWF6aAgjAINtEP+QBYJ/sPR/sP5FIPiTk2ZRQkWBF0WvkX7PMVQfFAj8IfHKsXaeldtSsYxhoTLnq7i0H/soFxGrgAA3XzaRgBnU4KXROncDULlkoFFSVDJSpULMalfgWDFC/UJQ8RBwlwdy2xJ/bACfxgCAPAlD6wBdL7GsNpLLIiLZICFSFiFGdCFzKxui+ZmhAxcP+hAzK6Avv7lgBiDD/MMPVaQHHRn5z0cByyVP8XsyuSJNFKETb7f+OhHIUbEpv5DwPQvRCxCFxaaP2QAIVgHChmhP6CVBc6s/3yXybzD4NwndPFwzvRTBIgjfUjJw4rGPqrEKRIrFpBvzP6bfwBA8YAA4CQa76ElGayp0EzmzJBe8UyFER2MmravKwhFMk1KU2gIwNQci0RAQlhDxGQADdAGCrAEOoE/56WPCbg4YJGmZKiqRBeaTRv/A/xoxC9ESf+YBP2G0HZ1ce5M079yxcXkCfXIA0dURBpehGhAz7Vu4dJ1Ra5xBCXJBL0cWrbMFXrZzM8CZxUFgRGkAD1kA+V+w/+0Q//UA/3kA830A+id2bsoxxlLBc7gQ1kkIRloGFpqxf5IcszYRczvM8yIcwAYqMBTRzXYAwNkAB/KZIHtBWjoxlCl8qWATRk1xqnAAWnFmwNsciRapReMR5NwAHDcAP2sMUKoaWkmgBQK6GXcyYBhM08kSYs+GzO1nES22gLC6F70UZEMAWy3Iox8ce5Iw3FfAwJoAAP0CveE65htyGU+BlAA/8s/VUejnpgFvGyHvkE03EF9VC5fqEQXkzI04gCmSWOv+R+DSTEt/bP3pWra60XmSBBu3AN0QAD13AEp5AlwzQUvLgZ2AIRQyBfmeGEYzHVPPlCVCFDKlgf/9AE/hABMODV4fsPN5AAw9BKyLIzeLl4JbHIkIKA46EQg9AEGsYtfFGWEOjPbHQC/7DDqx0Y8ut7wBxSxCwNQmADD0AHP8ocZt11Tr2v5IdJGAEB//OCE7zbBrMW3pBr9nBo5pwACRAHR7AFy4LX7cTMECl+HsKAoLeSbt0ndqwXsP0XXjhtZ/nPkhUYuyANDSANu0ALCYAFaCBRZsx6vkXAf0Fc6BH/KdYcJVZBT5UhBmvHNk3gA8OQAFesDzcgudURViKxFFQ5up1YMJstif8ABopIJ3CnFfETt/Xbq2yEBvoMGG3t3TcKUsZwDe/NAWlgYLt5JkuVJmiVn5JsLX5jEvwVBLz1Fc77Jv9QGQCUkS/wzf8AA9BtBFsgVwfmQtvzs2aiiwGaEPcMlSHaq6ubdyVujgDCtgCinhIEDXyBqHoRD/8wAzxhDS3RAP+wC//AC3MN3xywK+GZLbNCRQ18lRMxTyhzCtRiizvXBEdwBFBwKo+ZK56GhTbyAEPqA3FwBZT9Dz7AAQ21JieTQ/vkcBPMIjZbRqCXJ14QEyQuy3iCBgew/xM2DRHyC+rY9aJ8UcNhaQx6UQwJYQynYdDIMANbQAYn8eQB+D0RgRI/6aiP1BIDaYQEkCz/IAT/sAclBgEQoCzOqQBVMD9bAAFpkCZHoASscDHTHRKA2RbNsdBaAq57+mzLVADGE7FAkOpO8zGC0RuJiOWjGet8sebeWwy8UNRYsAW5/Y3Nq2Ro84LUajATMRrM6QPV/gAtoARVEA5zsARytRWNMwfIzgXqkB3VkfAB+AYsmCHKUVQx0UVpEAV6cRveRQLNHYp4ARh4ocuuMlse1yem/mK3W+b/ILwQ8erC++Uy4REJUczS0OYwwAHDYl9QumFI1dTe15ELtBouUP8G3nAEIeAWi/MP4cAFDM8FVYERRzDxCsEFIdAFarEN6kA2q2Vc4vo/18JsI99GunwCO9zWOvCaHfAuepHafPFRuZwnHR7vPvwiOM8TxmANQA/01+AMAewPqEdhm2eb37l2Lwj5H1ABWzAHJbANb7AFVYAAVZ8Qc7AHXzK8JRATe+ANKAEVS3EKp3tAD6fb+4u/7Njy450QgwoRO/wXEqsDwOCFr9/3r94SHlHMPX+yAqAEdAkeLiQfkXqEOXswqSNa/zDxj2sEJTBe5QD9mcMBJbAHEMEFEKEiZ8rjMeEA9URX1VnuIQXvGQOoCSHHNOLhdtU0pnkuvM+dYSn8fbL/5rzwD8WADLyADDAAEDOMBPl359+Tf4L+EWDI8NS/IdsWNvwQkcA/jNjE/PPxLxyXLmj2XMBYMkSIKj7UhfuHoOS/chhdlJS4jeFLnBh9oHDwDw2anP5yDiVa1OhRpCWBJn0plGnJLU+l/tPxjwnRE8CQTslSkthUsGHFDjUm7V+Da/+uWStmDNm1YteOJaABxRuKhf9OfcibU6HCf98w8pX4Aa/HcCE6loD5LwTMKlzYcOBCtNwejHQCb8OmkO/QU1HKSBhb2vTRpUxTm14tdsrQbjl1PdVasvZp3Dj/5L4mLVqxVGmLDdjT5ciRjhiPYOxZlODLMf+iQ/DBmE1P/wdjlvyb87KLmRchllcw+rBM0uRio+Yuqi6sAbCtXxpYPxQNB/YYSSAt8K+DBYzqMKq//Ar8h7H8irHmn7K6aMHApJp7KT2m6oPwQgzBaoGD1OAzCj/TUsipGlJyKoDAnEigIUMWc/OBl39gbBFDC2cskEIbiWoBPg9xSq3G08wpqaoci2QxLRyMVHJJJjF8sMATCkSkySYFo/JKLLPEyIajgMTthBUF+EdMLctkqgEz01RzzbEAXLGkAIrCgoYoiwKFTTzzzM9LPceSj0UB6hyKzJKwIPQfLPA0pc+icGT0qB5Ne/LR+ZaU5R8P9iNKgEQpvRBNT5U0YNRQHz201P+jUEF1VVZZFTSpN1uV9U9KDXBKVlxzXZWD6HQtbVJfg0WVnhlb4MLRkpaTlU9hc9O0WTUfDKBXpmbCKbyjdoO2yEgxpJUpMUUcKpRtjzImLGKRoubcOP9xjx5491DAByiyu6MMAvaSKA2MWmCMi+3+MaAFlDB6jI1/jChpiT+qABGsbk2LlNmj9HnpWdy+zS/icmc0BhpQjcIFI7N4KQupc2eBkxZbaEFFACWw8AeKMvgCTJBTDOKICy6qwAg+B+YIhyWfuUOiHC4QVJPjpA4ZCuMCFSlQ4yVvwfVclF9CM2Sk0gmAGmj+oYWaWWYxhhUYBGjhgQo++OwUQZrYwgD/H4QIpzsu4PvDbpy4MGMOn299mCn4qFbvH4qJmgajV1/qgCjDbRw8qcY7znDkl4rByJqRNR/Kc4zCViuAWTawpRgBZoDBCH+8+eeDOz54qI+fPoqsCgc48PmyykoyA9ixmEZK+ALFJKHynDS+FSepXzqgSUOKes1yohrgmiljzoVhrbSKokYaaga9xexorkkAixdeeAYFMWZaj4uP/uHiBR7K6S6cmHxUinii5u4Ti06toFypoN5UrlcSpYnsgETRHOhkkZZo2MIWsyiGNK4xCxgogQn+eMA3KsCGPmCkCoC7QDhsAD93JI0o/ENK5ApEPHG9JFYsWt5paniUFUGtw4BJmcE/RHeUBWHEGN1LCtbSgRHg/KMYs/AFLdSyC3ssIgEJYF0f6BAdf2ynOyUwAhcwgxP/4SYqicNJ4eAzNzLmyIVDYWFRvpVGAehwh0/BxQJfco1zZS9sRBSLE48hAFkUQxb5QMYwnJGAZtDABkBRhxEA9xEk3M1GNyxJDQ3AAR7lCAh9mpzAPNTJf7xphnPsEwGV+I5/LEIaA7gGL5oBgwTAAAZHgIEZuICAx/zDDWUijU8wEsLS7JJ6wDQQuZgUEAAh+QQFDQD/ACxlAAAAawHyAAAI/wD/CRxIsKDBgwgTKvSkUGCyf70IIiL4qKFATyYsGlwg8NKISxwXVLp0qciRFhwknCsyLscfBQs4/rtkySCjgzcT/RmxYMRAmQcnoftnYo3GJRqTKl3KtKnTp1CjSm2YY2oMgQUETlT0r+I/ZQknXSxQ66HSmv/QLhDx7xWQIi38yZ2L5oUNljMHMlGa6CajRIkEBr5pcBJGdLWYzpv1L4BCoBunSp5MubLlywgxJa3I9REiRV4H1mKIyGzGpowsyXS1INElETZezJ09u8ULJOPSLsjQqeCfhJ1SixWYAWGHfzmGDl0KyiLgf5UwS59Ovbr1pp4K9EJUsRc4gZ4Vnv8mOknSQE+MGBaUaQkkqVGWYv8z8G9LwS2177rKAHn9cILPCVRTJwQCNZwJyfSi3j//DfQbVM01ctAoBGlz3YUYZqjhQZhMBN5Ej4D11SOPsDCQZhmZMElGOTAyHlEKWUKKagtcIp99BPlz31wvFJFBg4QJdFxvAk2ygCVoWVKeQIwU909xYq2BzniemCXYQEglBRJ0XBYU3T+lfLnhmGSWaRFXTHniCWhaKYLIm7VkhImJBlXFV2AziWRJOePItpRcHBhBpJP/dILWQJb0plZahTLYm1iTdBKpWL0oKJAZjQrkU1OVVGLBKJ9YJKaZpJaaIZpQaWaQmp5ERJCqDS3/KKBAM/Z0iQIvPOUPGjb0psA/CnTyq0F4drJAb4YyOMl/hElCpJUpRmWhhQItY+q12JKJ6j/bSoWICZ0gkpxlNS7DBBo6NkWfjokkm6hMxREZ0k8ETgJMpAUB8495BS04gjauGGROQtqMIuFSlGSr8MJR/TKdCRC7+lQH6gFjLI2W5DqVDUzwJ2wn7Q6XQaKJCnRsIpMwQqRBiUriyYoHWRLwPzgINPNBrlSyzCimDMzwz0AHbVBEsjYFKyk1LuAKEBz8o2O6BG2B40D2TS0QrwqMrJoliSDp9ZHHLsDIsZkitIBRAv2G6T8B0/APNK1U2JAoBZki9N14K0Wn0GDX/7g0Gk5DTVDTSaHB6zhIahNTTF0baqyhkgCTEb4N/sNRb4GtzLYFNBSDy+azGpRz3qSX3lS32IrVHimVLKBNEYALHrVShsvlgw02KICksTGp9njmYx80ak03nWaBBcX8w9gJl3hwCUGuULuUKfTYbfr1DO/9816uAHMkKeYGPpBcAwEOuEI4HhHbCxxw0MJdliiQCDDttqusJIxA+g9gMpEiEL8FuZlAouO/gkyrEnT7h/QUskDsOdBMB7DMm3LgidBMJQMgiYlHKgEEwE1NcFL7x/kswgGO0YAJwJCZamCCJ/uZTHRICQnZNIcQ1gzkZq7w2QN3uDBYXWYiHhLId/8sow0Z1UgENGhaushHNaVMrUeuGIElmICkY9UETxuRiUhemJbioE1hOuShGK81kWRMZDvgCKJBLNgQUpAiYKQgSSUawQQb1Kc+TKxPCEcou3/4QCBTq0snOiWjFlouEQso4ED8NzNXBEwmKBPgGCdZOjY25AB2ZAqJ/uEhSz4FjiKJiQJUMQ40wOVpTxNhfUaIkC00jZUDeYECtFGKBUxCSX2JiQ03whqkjQBP+jJIA6eiCUoaE1sRRJ1BwPEdEF2SW0lh5AJUUYSm2UVH+BHIH0VIOKcJZC4CeYAqv6nHwN2GCdELBgOC8ZEFjKISo5MJPOGJNKB84mAGUaRkGoH/z2P6c0yepAg6KmInhERwKTXhSCXcUc0tGA4J3nyaD/6IBqvNBpAt8Ob4mqgjNLyvCFTMGSgUQAoFMEFpHFkCPM1xxScZ7BVKSdhTZPrPmkpGewJRhDL/gQmJIcQzlvJhVKKzgIHFBV3+MIIN/IEf+3AgV2gg3EXx44+ljrOcGvGBQ/8BUnOI4BI5tIAHchgT6IiinwPpxj/YQhBKXEKfDVHFP6wnEFDAtEs2zStmAnoQvjZlRqx7HgfAKUIjxO6OmYwdbeTygrgMFpsNaZ9k3feCFbjCAjlUgCveCNZ/lHRmz8snowjC1qegVa+oPR14nKIL6YAiEZe1QdPsw8QW/xxBLjhinx9rR9W5FOEFUMAd+5iaEMOpzwjqWAETQHEJzI4VnmAdhSOVJgpTaEIT+kqBRkhhgX80Zyn3pMQwU0teqLAAp9T5T83ip9sc7eofNohLujhwhH+0wAe4pSoaoOAGFXwACic5gn1gaRD3GWEc5tCGKbQhCpSOZCSX8NQ/cGFduG73S8U0SBYKQjeZeqC8IC6dJSpBiRQYybAFQaVDH6tKI9QHv/7wAQfsg9wy3CEQBKhAfe+IkI5qFA1HMIIr3GGKZWTiE8uoxCsqcQIswOAf1pCrUqpxCVC44rsIoWuIt2yQnS7lEUVr2Ko8K6R/zPYgTGzfQOjrD9m02f+D6lCBAz4AhkCQoQIP6KN70wVLHdlgHExQgCa0kYV+wOAaMGhAJiwhgholZGbcfYqWuUxev652IHSCVZijMpEFGSWY2r1aigPnjxLG5nxo0KoP0GUEHcVZEBU4RQXU8AYX4FnP40OXrneF1MW+TwGySMA1AtCIL4WWzAi5RDElRNNJ42DDA5k0pUPsMILo9CAM8TJBfGoRRCjDh6obJBM0VpDaARm5SFAHRGUrl6juKldGUME2TrENH3wjCUlAwTYq0AeLLHY2TfVjfTlgShqYY7MID1gNBpICTVxCG8cuSCM8IIrqfVggCTRIzaYd4kewiafQLEgQC/rTfwzRKVz/qwkwMmDVFJfaBupQgAr+QYA7oOADCkCCDdDVPl4ZoQLYQEEF5qKGJLxBEATABlSgBjUjKLVHFtDEzKJOV7t9WBMWHsjFpf2PCdBtw9DmeHm3ZQL0julHmCil7J72AiOU4RuC+McHBPEBAoiBAOowrOHQgFwXPAMFvcV3EhwgiEGI809oJrUsxwHS7oIVy4tsCt02TgMccF3sIM6BxzekxrRYIgNMyKhGx8dUbwB9CHcgQNxV/w86nEIBdUGuOk5BAKdR9Q58SEIT1PGPU2yzIZDFNY91ZAEcWKARAdPGXf+R4YMgnyB0s9sENl4Q6mM+tSyQgEYC+kWpFK0DbyHw/zfRYPpvPEEMH6j7B57wAZr3HsBIQAK939BbwOE7CPpGQRqs1mMeJ6UFTNAKCpAw3TUQrmEzDYFPogBtF3B9DogQytB5A0FyTHEotSAWpIAnGTAOdsRHivUAR3cKdfcN9PYP2HAKqycI2KACMvcBKCAIc+EDDgAGSaAG/gABFWB6EGARujZYDWE1J5AJFkA3ilQDoQV5CTEBphB2NOMYjPGAW4YIk4AIqlJtibEqOfAiSoEIRLIsiPI84tJBwUd62JB6BNAEBLANdbcNafgNAhF3gvANBPABFQBjPnAHURAEOygXZUAAp6AC/Idmu/IAz2AEUPAAEBBCBHFYHKBgkv/EOnhVEOaQMKLwYXRzcRuXBQGABQWxYRkHhWJUba+yLJZ2HhSoFMFEHGlRCsDACAxgBgSHVB/kDw9QAQQgh//gAk1Ab3P4DyoYh0lHAARQBlTjAHkYBGQAAQbgD1HABthQAWmgEejyAioQa9Z4CmmwTVDTNDZwARbgP2zhP91lATT1XQFDCXSTcUzYGAhhfaDoT9nwD7UgVAmRA6eYFIQiIDVhCSNAbuaGI6Wnb2/Qb35EBzfHi0j3hgTgAm8QOGkQBHeAjBAQBH0gF9GoAt/wewohF0fQBNjwAUMgh7K2DQ+QTVfjD+PwCaSwcDNBYkg4EKBgDh6wdRT3jtdnArX/IIHTMTOqgUjCEBciZAZmUAVLIGAxWAHfUAG8B045tg2+SHvCKIwoQAD48QBBEAVS0wdkQAZjQFVAVwEOAEgJgUrqMAi++AFi0IcoIHQP8EfzZQ4kASak8AmgQFMF0RwegAML+A8eYJf/4Db/YAtT4Zc2CTSuUhVocg7cco+SkUJpIQxLhQY5UAICEQJzEAJaJRcPgIJs4Jbv9Q9B0AQfUAZrCHfbUAZp4A994AAV6Q8rkCt9EARBYDtv4A2+pxHthgJkgHROOYdziAJvp1UCUQSRAAwpIAIwJSGnxZcDU4kCMTDUQjeowIkW4Y6F+UCP4DAWZAKKwJhPgSSJUFop/2AJkRBjXPAP4cAFyzAHVVACOYAuPrCZsuY0PuAC/7CHd1ABcOiLg7ANDekPQZCag0UEJIAFR2AAsrmM5ecNVzWW/nAEFZB6NPcBtCeCQ0AAKEBvEIAGC2AKn/AJrjABqnCJBkFxn/gPdHMw1jkQjLEBdrOiSgGj12kdB6A9dKKYaIIJYMFtUrEoazVKPnAEQnABVRAO/0Ck57kH8GmbFQABTBQEoOk0KPAPbPgBH4kCOkIGadAHaCAASMAKehAHV+APCJpnaICUGZkUcgEFM6eGBHEK/zAEYkBzKOACD3AE5+AKuEAJ/JQQJ8ph/yAA7EgQqKA8duOJWeABjjGjO/9kJ3vzbZTBEYdyCcAQCbYhBOEQDnMgEEa6B0JQAuhSi7KGX/02kWSAjEyFhlHZBGkAAW/wkBVpB74wBf4gAzdAAT5gANvgpA/QBN/gBmr6oP/wDR/QBAJRe6uHgiiIodj4AEUwAyNKmAiRjklhC4s6EKhwMNLKqJchfvblFKI4EAcQrkuhbQPRCZ/QWEJQBVUQApn6DwjABULwD27wXv5FjAAqmxDQb1FwB7RYBkj3Dac5lRBABv4wDPHFATKQADegATegmq3qD66nAhq5kf7AoIJwB/TGi2gJkoIwBOnHhigAAS+ABabwp1o3EKIwXgiBBU9oQNy6IQQmfle4FGb/dxBroJMNcQmjsAWBwAVcMAdcEAIhUAJLsASEs3NdoALYSFwQ+Q87cAcT6QJ/1Ju+6IfJ6A83cAX3sLU3wLA3cA86ooeheQoMiptM1QWDoALqAHQneAprCYfCSHP7xgHnYAzdta2XsQoxmyGipxAOY65QMQmnuGleYgRVwJ5V0AVmEAhzkDtQIwSBcARQcAp5JhBk0G+qGZEQAAFpgALPoHrYMG966A8R4LD/EAE38A8JEAEJQKatWrAYWrEIgVQ+AAWdO1E+AAFH8ABpcApD8JEDQaEQgAQlIFaAOjdOgbJ9exndZBB/SxA1ex2GSxDLUATXgJTeYJtm0AI8cFsc/+AGJrCegYAGe7ArdwCacsE0oNm5DgCVcCqMUqu1AqG6AnEDqhsHtBgEO7ADacCq/hY4qbYDEEDABtwHUeADvzulwginaYAExiAQgCkQE6AR1gOjhdq8QhMkBaEI9PgUOnsQrTEKNmCbLiAGQ+ANLYCp/qAMgVC07oqZZaCaoNmaJzAP9aGHm6l+gnCaV7kFCfAPq1u/ERAB/PC6aKCHslsGgWgQqLSvArEDA7GDfdAHPmCbAiEGlvsrWSAKGwB9SVEzJ3sQGyajGlwZzysZjzC94qEU1etZlsAMwBWSZuu9czCUPZCeVVAO/zAHPrAN+WqwUzAF82AHNuADfTAGD/9AgshKey4QBVo7xKlbxA2bAHmGiLL5ubwXwJoJtZ4cxfcpEGlQAWxIBw/8OYJJEBuwjp14ARsACAIhZdN6xmUCOGmcEODgCROhDG9MFBITJ1fYG4yQhQPRWgkhReZQBA9QpxXQAiVQpFyAAIlbBX08B3+wu2NbkYR8Av5wD2PaucRKc2mIjU66uhEwEEUcAbYqtrE5kRAwjDvIyVsQjf/QB/E8EP3WBy4wpShwBCcADdCQBRXMCoL6D7fAygORMBunlwrRxbNQ0Kbgl9ZCy5jhrQcxHh8scgURJ05SEwCUFAFAAw/wBHW4BHOQqUbKnuu6BuezBX0QkQZQDzpnBPf/IMR5QItu6IZPQHvbMJuSLMSqa6vqLBf66r9NIAhNLIh/RJAGsYN/BLcvQAPGgAOoYMYqOxBWPRCtICGo4BgTcFrNR9GUccsKwcYNgQkMISs0tBSFCgMcYLYPoJ4DEQICISL/EAUOsAVRMAZOyrr/cAVX0A+qq5rEqIY9jHqXe87nPMlhewWQvAVWSbaCMLLBCjhSfBBVs8hP4AOAgAW38A8ZPBCfLRAIvRQbAHnMMEADMdFizRTiF72XoSYQ4yQmMBEfvRTF8A4wYAQEkAZGYLRAi6klAKwGADhH0AVNQwYV1bo3IANCfQM3XQF1x35QCQX+kAeTrLpFLAMUoA43/wilBOwCGJoGaYq2AhHPfXDZA2EAEICGR2AMtgAIs2ALtGARy1kQIFAQt+COE315BDHarV0QGUXWBNECFv0UvZCFvEE23/IPHKwU0dAYRhCND8AGJaAGVWAb+OVNgeAGCTyRBqAArZu6+PsPMqAB/hC/dHuL3xBjCbCwp7uwV4AEOnIH9gwB6/ebaXB4uNk0nRvKBuEDg/AELzDVFpHBeksQn5AFXdxWYHVx2ArlAS40JuAJnTACsiUpk/AHRCIJWBSjReAADwAFFQCLLfAHSlBfaCABv6EECWyV130F9gvUN3ADW6Cx7eeHgnAKGUm/Qry1MkCmQeACfUAH6Qu8SP+Hod5Au6OWSlN8EL2aBkxwDbNwrQhRqP5tEKZQqBU8EFWGjnMl2vfUEADeEC8LirAtEATOFFeomENTFcUhAeRTCp0gCTzRG87y4AkhVwBgA0cAAYVQz0tQAiHwB/7wB+FQApgaAl0wz05aDwnAD5Lc3FcABUFAb04Zh4JAsXKh2Pdg3bFJkWVAa+krEHWnxVTpbzrSAod3zwSRBmXgAwpwDTgQAKUtEIJ66jCajgMzwTbzYcs3EBTiXU1x79wKSweOEIqgDHaNbUiCFMuIAOuDOzmwAMGgLJKiEK8QMKVQBLfrA+ogBOzJBYlbAggwEHNQBu5cqyW+2Op8BYUQBfr/9g9PoHp+yOMGkPMv/ZAQQAc9gG/U7Yd+uA1TyegpdltFcN75fEdb4A1N0AfPaq0FgQqz8NmCaQvzfeoHUV2qXBBJbhFfPOXQKxUkBxYM0fDIQQrEbQAtoAA/WwULsD6Po4UE8QP/oC+MhgROWgVCsAxF+g8kLw5HWgXnyQaQ3QcGUOJEbOI30AJ3QHdzWneTDcgxFqCu5w02TwZvgG93oHRD8IbKepsN8UctAKyj9g8oIAZHwAHXAAjFQJ0DAQgFHXZZcAuVhxBfXw0KEdFd3+SkLfbVEWaKMgLE7Q9rsK7pyQUlUALLgDudwAANwRYccS4mjdL/wMfnef0CIQRm/+ADFOkDV2AQtioDW+AAtWfzk00AKoBbUymC7icGT0AHgZAEc4gNe37/KFiNPJ4Q7ONi9SyWAPHvH4pTPv7R6DdL4MJ/swQIlPVPlsJ/ATwwxLgQREaOHRne8hhS5EiSJU2eRJmSYQuTGSZ1WpgjYw5GGS6x9OcvRJVwO8MtCVdFSDkbGQQmYviD4SUF/5hwECJuYbmp/0L8C8dlTJQxfV4IvBEhggZ+CRJs8fePwLZ/gj4QeOsiZxAfFbxhiyvmwxMwatg8IyDo36ltggQR+ObNWxqSLBluEWikwgM0/2wICQCI4sN/tjAKECUQVWhToTtO+IfqHw6VrV2/hh1bdv/rNZMk/fO0EBzHSZYWvEjrTyiXf1yyLvln5h8SGwssYQyTsVKGFwiyhsNKvFy5EFSrzHGzI0iUfzc0hL2R4EYctFFOtcU2+JvaCjlRpHlg95SYtv9cvFEjCRfeI2CwwQQr44EHoPAorX++6uOfLbaozKB/HnAQmWJmoUikLP7ZIACRTBHoQ4FM62iDhVrJQrXR/gFkNhlnpLFGkRTxJLdajHqEI2ByKOUFNHLiYASsquBiBCHc4KAPH1q45LmMlBLIkkpsMMK4IwUqJ5ygsCtuDAgY+2efsP65RwV/0DAgiroE2+Ybtg47JSdBUHjAGxS2gSuwD+5Q440PThEsvsD/1iqjDCgeUAEyj4r4SsKFvDkFGwf9QQIGLIqhEYcsJgBpFs9aAykiG09FNdWUJlHEKJgw6UUgRhaaZJIMRrDhn3P+WMINmOao4p9efCBDzK9cyQiUhYwspYg0tAQToxDmKI6DMSEQyJ8tDNi2jyCCgOCIMt47pcDBtqmgjy1Q+KYCCL6B658n1IGrLz7xMhcuwk7xBgofvrGQI5bO4cCAtB74ZzIHH+ynAY445RQjiEP6hLV/Iu6QIc4WCi1jVT8GOWSGJmFIpoVMOPkfRP7JoIMRDnhBCONKAJOLPyr7Jwq6FlhAIFIEQo0hK21IowJgsQJTnKvKQdIMHyCAIAqo/6P+JwgH/vFmQR9QEMyFAgWh4wMUfPCngm0Iq2uIOOF1oQk1nvgAG0HkbmstAhALAmEFcc7IhiJaSOsIQtXhW6BZZrDlw1EbWpyhiEW6aCSKsmhFFFFMUYgWkTfnnAPOV17IE106MKGTEYzIQQgkkeySC4N2+AcCupAYxcqOEkmkFBu2waaCEoj7Z45osQLPhzRklz2INLz+5hQXCnkgjVPeMveDf7a5Lw0UPhjUBQgqCIxQbAhQ4y1ByD3/sLu/+UbBf3xAAtuFBeKgCCP8YbR3DDFSIAEBbHnI/1DhsZKIyCSZa0gxsPAPkBjQGaYSyTE4N0EKemRlKMMIInokEP9M/MMEJqiFka5inAtUgSpmiFDsBOIDG4xCBD/rCDCCcYRvuGU+FdhDFULABi9hZw5HSEMQqnaHOxSIAAPyhg+UGCcXfOAw5vvGB6K4jTR8wwUoyFsFahiYJyThH3cgVFuGYBg+wSUNjPHc+xBWuAcZQR2nUEf75icQG0jDFoCwRRxQAZLXmKgkzpDIMWThARVB5GILXCBH/ldBRs7GMSeZRAc9QbKFKAJ0GUHEJMzwgjkAL3jhEIIPEDaGqgVhB0cQyCWQxRERaMMNWySAN8TgjW14wwHf+QcCJIQCdqGAAIQqF7ry5A0yOO+IdntCudh2PRcI4opnrIBhTnGHJDT/IW7oI5S+1rKo/a2wI0boAgrckJOFOAoNNLiGAAiIElEEoBWtMMnj/qG5zowKkAvhzDBStLFG9rMknmMjSVBWC4ZgAhPZ4EgtEGGCHBwAI+Eo4UKg9g/Y/cMVIuiZR0ZxhGbC5Qnwsp46tHOBJayLLW9RixPv5gNvCKalAnHBNlDalg94rZk2PQUEHvCebTigCU24g5zk1J9fSvEUeXsAB3Iyx4VwwAhIcANaOOKPImjKMwBsHMiQsZCsZkSCGGGFQEjgT7JiJKAkSWNHOogRguYgN56oRTJwgwhG9CJWDMlKF6om0YUo4BL/qARHnhMMI0jvMIJxizcqYJwqlICj/wQoQ4H45JY4deEBH5BpTf9hPesJBrMLecI2erqNO5QhCju9G2nb8h55bRYK7RoXCvyFSqYuBA2Q4oBUMZIWG1yjGLQA7lab8Y+xCgSQAwgJiWREi68yRIIQ9IgA6FlW6jZVRor4Ry0IuhBEYGKhazhAJ4CHHXGUwGlCVOEOoGYDcfyVIwtIxAzFgJi5IXYbb6AWF7hwhLv1926CkKk3QrmQm15vTmqhqUA4mwYHBKEMY/JGYP7BnwM/YRBKfMA38PMAJaa1Iy+wwZBCQoNDSkQasjGga5xBi7AKZJHVhbFIztoRD5ckNwzN4B8O8AculGMPVgmeOnRG0W99K1za8P/CP+axgST/Q0oi0J0YCHO3SSEpHHNYwveesdn+uqACwUPAZUkrUxe4ICOGgQuAN2vmD1jNB4EYiLwMMxAVONNfPqjMKVSgDjyvqbb/4IANgBOSTLEirPJcTWoe4gxenEggIPiEJjQRY0pXOjaP0IUnMOjBf+TABDaACUOEIgGdqZchZDiIaohbjX8AwykCGcULvLYWA4vhG91prA/Gtw0xiGGy2HgAG4SwBB8QkYjWc8EdBLNZtwhCL00s8xeDQIbxdFJ7ZBAMnh4wpvykQakL2gMq//HncQtaJHWUhS2OESOM2AILnknFcOmpin+Y4hMUZDdKmmvpftY4NyP5d0z/Ov3pTvzOS1YpgRvIQ+T0QqAIDeBMHRbiCkukYIa/TK28vGydfyjgBXuCrCBCK4jEzIEHZnhAEO5wNQdYb7PMPoyv+YRZBpMyCGMIAhJ4UAUyVIC1ZCKIYtOAli0wCqDkPoINHEVjLEijxRn533TnySkcBMBy/5iAckUkC6nTaN8nOTG/YbzWlNy4Fr+wQQlEPQc2+ABcGFFvCxqgoug4OaOWGMHWoGhmb+zBSyV4wU4xy5aUpiEEJZiDEVSucgYz5g7+MbMRnVjTNDy+yGM4Hh7mYBeDDAFe4/uGCtrljSH5wwfqWMifK7N0jtwWBnHoOkOGu5BjrJgWJWhACazu/ymBBCDFNhIkRhI5knuKnZFoiJRALpkSRHhCES9Q+0J0KYQjYKuiCxnDFmjQCi+wWilIyd0fUPuEApXrGcbhwh44UAEXiF4ghHqGOmaAgBJwgIoNdoHVmEnaP3EvtFs2s8drMJzzgXDggW/wh0IZhMHABmBbFCM4gi3wAQ44AiMQCX94AVRiPYxoAVvYqo7QnOJDhgGAAQ9YASY4ASzIjMVBtBkZBubiEIZ4OuOrtIDKAOwSie1aCEzwBA0ygRfQL+vADuOYgy0AF2yRKAiwgRlgCKT4hxEoBSTYqZkDsGfoAmAJJSiolMJYNgJ4FvrrBIMgJWr7lvy7mjb7oj+5mv/HY4hvIRtl4IEuUIFTwA9seRoIWAwNw4YJlBAOeAAJVCIKwYhAUwkJ0pxjaAZk2CoYgAEaUKrbKoITMIYJ4gzo4icaJKsaww2BCDgnC7WRICiDUoYj2IOmIA4TmoM/IBaMkJ0W8AAv0IIRAAYReI74OoJnOB/yGxRv8BIsQwNsOIUwCiYX6AIhCIcSUAAIMLO9Yrw7CAI2uwPu2QYyuBohcsZvuYOceAE8OLm00EIfmJAJWRAVYIOSWio0GBIO4AAlOgIlqgw0yBXVo72LuRh9KD5nQIZ8+AcBYIkWoIEE+IcEmIFZYLHfWwhWiIYWg4WFEMGT0KeGKIlLxMTY0ET/jwgokgm4HPmHT+yEjuyEZPhEE1AGdLArE/gFNHCe3jnFOSAljugDJAABItCBVLKEUViAI/CluNE4M8gKwNuawiA8w7iDxroA6oOAliyyfyCTxXOB5fmHqxEI9LKaPsgJNBCCf8iGQRCEChiDpfLKooOCCkCLS/FKcnIUGwiYkWCFiGCFWLgGaWiGYegHGCgCgWAJLFCAYqCBsMKCC1C1EhEIZGAFuGSIWMAIfZCNrppI6vqFjPAujVwImOiZSegFmZAEufqgHOiABUDLCogbFaCZf6iChfuHlvwHMuAATSACERCInlGFIqAU84mPb/gOZHQqXkqfKROwC6C/6qvG/1ISQP2DSjIhpQb7liAat5woASH4AnVQgWdQKgNIzqUatyPoAnLKCOpckxdIPo84B/+JCGmABWcIBYFIACxII+zUxn/AAh6YABzwjACgCGrAx2KYBo64z3/oh5NwhmJoBloovsX8mEeSDYJ6hIXKABO4JJkwGYHIgQzIgU7ogEmoghaAAK7xhi4oAaFwA4G4vthRQnL4B6T4GW3gABVwgShaC8NAPzRQEBRwgWfgk5YqmlxCgGXYAlRjw4UQoh5Vyh9diIVzw+REgxJAAATYAyjwhnKyLexcR+zsCNNrge6cKn8Yq2IIK2mwBxhgTwLlCBBTAN0TCItpBmdoBlkIu/9/iIaFWNN/YIX/vAaTCDtj+EAB/RgJmJEcGYdNg4lPnBUFMAons4REkEwOUAeu2QbkEIiFIyWEEaItWADWdLI5wJJsKpdYQhIhUJAjgAI8uRMj8jIM4E0heAEygIAUisqFcMaOEJOkTM4XUIILwIASsKzUI0vTcxTdys5x44AXaIGKZAh/aAEsuAYYiNM4wAIv9YgMPAcsaIBieBxWsAVTmcR5MhwXOQlDE4h+WMiSmD07TRXPUdaRUT4cC4lP9MQFyIAlyAB/SAOtTFSskBD0YohwqQSlEAFiGwT2wYaWIgwEmIM9KLoFYRA6xA+34AIhEIKiTEZt8QckZFRGLaX/rdgr2TGY5FSAhUUAIVABgPoHvvFKCym9BtkCG2iBDfSIFjgCX32M1luThWChfxACUZEGeppWzdmFiFA1EqCBCYicktCnRRCIFvSIrwPXGsFTgUjajiAoTVu+KOk4oamSKMkoM/AHeFmLKihKvTLNqiGDLTAKYFgFokGBZyAIs/kHIzADJDACUfqHRYkdvXmfI1CAJeCBElACdyQ6IeUIUsK5b3EAJPxYf6AHJtxYd2iQnABHSYHSjNiCIkADYJ0qk6CQS+GAFaA9aZCFWJCgYuCE1ADdv5yAoEGViICuo20Mk1BWRGhQgXgJTvOIQXWy2V2AprAEUqiFC0ACNKCD/8DYBuMQgqnZKxXigEtwji14g1OAUSFSEL8zSg47hQfoHReglKMyAwWwARtAAtX52MGFUvKgVx4FlyBwFDM4An9gwkwwwE5Agy1YxwnMLXRMi9wKVo8gRJKojOAwCTRQovllzxkwkWI4BmT4qltgDVHIun/wo48JK6NFXY9YWoGgUrusEZiwBClpwn9YAR4AApYIn0/igG8RCKgRoiPAhfU7IwWBgjJIGC4onjHxAbbwTIQ5DDxRAoWNBC5QgrQiuod9SsFN1di5A7JZEyXwh3PggUxAgBnoAuQDx3CsjHVcxxYY15ZdE6VD2Rl5AcjFQCBAHE45hhMzTG5AjUKioP8ADcwHdo0qfo1ZkRVSwGCB0IQAmIAGaFsEwwohOC8g1ikOgIIdWJ4yUBtB8Ibv+ANrEZMLeYAxwpYxGoIH0CswK4ESEDHvzQlUBeJv6cq04IFxmgMjNVIuQIKyJGVtyeLsRD6Q2QJjaAF4nIXfogVkiAha8IWNoQTOmUE1Vt0aoaSMyKiMCgkBmALLeIAm+KI8PgIxMVWJeoBnUAGCYJ/rWQIXhpqUyxsoYAz02o8KgAIzEII5oL8LSIALsK2xzAkDQJjY0WT9ywkjMGJYjQQjNQYlQNwZIyfJFQjIuOIJQRWHVYcIYAkBGIDBFAhagAVkmAZnCAAzbqSH1GXYIND/+5UOJwwJGKJojgiAauCBF0iDJ/gHkbqyEB7eEX4XkjMMQegCcQgEN3BbbOEwbPiGPmieN/KGXhUI60AAd1CCKkgjJOiB6SSnbL656ewBBfAHHtClI5VZow6JNZmx3UplVWlffwAEgCyCa+A6N/2HOoUxrn7olJhgjviZSliAVfKIYPiHVViITECF+/SFhSCBMJgBI6ADrxGIVLQQ03w7dhEMM5gDIVDF4+lRBakAMnEB5CA6I5VZhS2HBFACG8iJB6CAbSjLLUiebPEHBfgCNDCDn5a+f1A7ckuLpw7WkpWUU0kLqvScYWACGBCARhOIVPgHoaWu0/1qkfBSNo7j/3+oXd4GZoFwhab4B0p4BYxgaLh2AicgMcjjISEYAwsRYfLQKRXwBjNYTjPIUSSsZunxB0qZjCEZkmUYirVdgBJQhXJQAqNOiyvggvxtXHLigWWwgVgVCC6gvyVYAnxu3Ka2aVWBDLSoyxMw6GEYhtqTBTS+7QT3iLH+BxjqCCcg3Y6YB6Chggl4gLuRohDgghKYqB3AOYE4nmD7ZiZR59KsGghQAXBkEHWgEA5o36hVAG3AgUkGWCHggfvxB/S2wI8FWX/wZgxAagSY1RK4AFxYAHN4gffl52AlNyZNi7AuiSav33FDA2dwuoKW7X/41o6Ig3pSiFxOicaxbQVf4/+OcHDeLgl4yogACCCBmPB/8AIQQIImiqVCBpY7XIiK6gMF8YE+WGZmxBYoaF8o6NjRbnGFFYIb/gfePFIE4IEfwsCiHjdbXRj6m4MLcAcu4AEbJ1WzVCI+TM4oh3IZE8SRyF8hEdZ/SCeJ0Gqvhg0w5whWAATVkIatQvAt5wh9JPOVmJEAgAfUoAI1D5p3cLF/SG4nYII0KIPzKeSENYIxMDWGEBNr5ogxGbcWUIAj4AAkMAMjiF9XUIJOO4dywAPQFnL64wEe6ISN5YE0qlwkUIJIwIB/wIC/zoDszYBlUILzddicmBAlivIrlnKG4F8jsMAjSOeR8AdvBzRVb2D/WVhIQ6gHkVgnXE8JA+IEZOAFBM8Ii1fwr4jgjEiEwEqJdBCJS3wFZwkMw3CDEAgKI5yo4aXX8N0rUjKIdgwE/SqOyFiCFyjvhc0lcG50R0eASJiDfZ9OHgge0LbvSFDYEiiHIuiEXMlnrxQI/p3AwmEjf+hvkXAURhEIFPiy6+GwtLStcePOj+0tY2AFARjzjhCk2INIfOJ4jpCFYYgIZ2AFzVHMfSx2BY9gNsYIkmcIUkCWScuId7iqf1BzYmcIkzd5KmCGbNCe80GMU/hrN4AAUxWirqX5qAzxyTiCHnOHTOWCF9gCu/3mpV900EZqqpgBpI6ENrBaf3ADJZiD/zmY93o3hkYXAjzABRtYRyanTnT89O7FmeAIsZFwFMlgCJd7v4a/wKagBlbP8n+4fo54SL1fCFnw+JKwB+P6/l0HmUYICWK/BVQgdk55CHdKDdRYhyK48CfCVC6oAphnRo+gVx9wAy7YAiQACC5Vqgip8uKFkBcEL3D5h+ChkBIKxp1DoCQcEgQlwilp4S+EkAv/APxz6BCBw0hClvzj4MNHyZj//NGU+XJLSZr+OByR6fOnkQofhpR88k8MHZkP/P3D6fMgUxus/tGK6azZv2M/fTabOhXrsK1ix5asShYL2bRq17Jt69atK0r/AvyEltUnCXaoZNLF1arVPyc1xv8FQSHolKB/dyqEw+MGAoQgMclA2AoZQho0XMJtUcBljhAJL5ZE/NNCyT8hD1NnsKHA4gwbL1xdg2jDH+oSJmNq/Fci0owqNV1y8KmTaUk0Pnoy9WejRVqnPUtui1m9JIEhiaejkbmTRvEiyKYh+xe2fNpY/5zFDFsS61uZ7P/NKjnLbEwS8ffz779VQnyVxGRBWnTFxEp9/2wwF4MggBBTN3Jk8AABH3xDwB1DuLFHOOH0QcY/lUn2k2RBRFHSEVyUwwEXQvyBxjmBzFFFhy3MsREPJfzxwgKpKVGEP0zwMMMFJVygxBEtCDFHQyWhlFJMJeCAhk7/+NBCcTXlVGX/TD6gwUERbL2gglEE/HMddYIQNUR10G2VwDn+vNAPeiU5Ml9aztgjS0xY6RPKW/boU1JYsgDyjyz4yaSff4066haAjQLG14P/vCNTMb7EBBg8MU3wTynAJPICCgSUcWFJp8wBwANBlCiTq5VNVpIZJVRhxBwH9VYFF8skRJoSC7yQwT8NrWgDDyE9pBEPRpxW5D/G+KSbMRqV4KaWy3X3jwExcSknTm6mBRNR/zSRmExinPLPKdmdckpz3knjjD9ooMcJWazU6dNUMg36jyNtLfLPwNNsxQoqt3jwKMMNOyzTp/9MQMVPr/g0aStexNTIBJ+UlMg/wPxxxCliVFed/yDfVDFHFydCEIWIJ/4UGQRLVKEjBwqEFM4/4dAYTgUvKPGCDUIoUcU/TPyjgJNOPsTDC0aE5FCTT5bUUAkIFLdlTVduVVMLW6Dxgrg/dftPd0Z9MFZigrj9DwoPoO2TADP4wAF79Tz8j95rBVwSMnymJcDehRvuFiobULzV4u38M2lMlTYixz8/ACOJJAscMQQBnZ/5zwcrV+EDiCWNKFYQEFTBwwEv+Ib0P+WUVAUCgZDtjhIlLNNGERzgwYNPy/JwBBI8QFsSLiYl/xAuJbjuXU1bHLGtcS/4c4QP18Mklj/qnGJFmWKsLdMH2LD7j3YofEN9TDTAwHQ+AwD8r/9YhvTt1t9vFUPfP/0e6P/hAviwW4hlA05YXFqS95NuXIIY/5jEPyxRgE4kwgadW1t1zPSNOYTDB6lbCxmOkBCp8Wwrf+DAaIQQOyXIpgcIEMlPuKCELfxBNVZrkkyMJAQbfA05y4nJttBwm9uU5CU/wcnmjhKTD6wLG4LAxja+8QEzfY6J2+uWl2gwAyygQRr5CAV8xiI4AZaEcDIh4HrIqMa3RCqACozYJQpQkk4wYgGdcKCw2kXFmHgjHEJ4jIjEMoYgUGYJL9AZjeawBxgizQwcCAcCoFOOKxDNJBjYjUlKIAR/LAF4JblkSaSFkksiQAhG4B6V0DY96iXpH9b/Qw4H5DaTLsmkbf84zGHYha5/7PEf3kDOPx7AARrwQhovQIb88ieWYeRDLYB6GCuqMpVQuGeN1mxYpSymFnL8JAaI8AkEH7gAG6ShQgRIjAv+4YKV/eEnUThdSSATBDQsIQQDaVIVykEQ2fyDSTaowtCQ0IOS6AYlL/xHJBAwB5GUgCRkQckFeIAEslTpbjGZHg9nAsztJQcJpxgfL0vyjX+MtCTYAGlJ1vYNYE5PANKAQRGGcQ17VFMsyLCHNWeBA5kMQwBTud9a9nXNtpTtUQ+awKcqpRZ8VC4GyZhjBEviiU78o4LbwAYBxEBFAnijAjPy4A7IQsgtLCEQyyjh/z94RZAXnEOFBCmHEdwwUYdkrQQ3etIMIqGEiWrSav+4pG5A+Y/ksQSYP6kSB8J1BA7wcFtammUw1em5Wq5rXbeMCRUry9EkCUB+NBiG/BZhj4A5om+wCEsYyUK/RyVIPqHQh3qGKluZtPFhTP1BTOQYE6r+QxKTkEQtOsCIHJDsGRW6rAvYwAUueHAtPuhQz2LiMxV2QoUXEAISepKDHrijn5EoyarwcKN+qrAlQsADz/wK3qtd0rA9ZAoH0IBR9mlUJk4B3S1LKpNzkmUQMrEBBwSgjwQwgRXXCEU96jHaYQAVYDi1Jn5s4ZPVzrbCY6ltfIjRAUn8pBOWiCAdJ/9B1UTkwAh69JwgPtCFOfxjDLKCVTyD0Icd4OofMIwdF65FEG2sJHtM6YJvpJW1f/QAD7yagxJ6kKWSLCF3QvYJYJn0WO7Va248UYs6SKquXK6ll02QpT+cxYob5CMW+jDEgwcWk74p08JufvNPMKyWanDzJwWYBDAKAIyfZGASEPzzJDKgORQ843PVcUEZCCIByJDoH6mDQB/csJnY/Qwl7ghBi/5AEzR0Zwu6cRIXeoCEKjnlQ30oCQdYzGKZHPQhgdiaWmiCQg7AWiw90aoG+UMAddTklMysRz4WoeBmqDkmbYZzTKSB7GW3pRtioSCHQVYSSzDCE/9gxAM7IWL/zT2jZGUQA3a+odAueBACIBpRZGICATPMKCY5boE7ctySmnTaH0UyqBLc4A84lCSs/9gBGUC0A6cU7QtzQMmnB1sCbTyPqBYdyxaw8VFeIsaybzkFNoozNhskAM2GiMnHyfLxQ+gjF8w+eeHEVdSGYXvPJQFGOCHYCU9weBJM0NzbfEKAEpKuMv4uCcxiog4VubsFXACJEbTEviPk5kb+EMOL/+0TyuDEHzDpwQVQ8l2N7GHlZOlJfMkitzuAbhttK5dbDqNxGxQhAfXA1z3q8XFHPFgmdMdXSUK+FbyjvO9+j4m0ZWICzImYARkwwhA+avEzLbQEPkjDP0qXusmX/+QB7F5ZCZe7w02X5L4t0WQJjOyP0v38Jzs4/an9sQAlqOJJWUtA9trCw1qPZbIVf0tme+KDF5AgAZzgx8eFHTBIxMTkdsrHJs6sd4KBXIBC/fvD5CxARGACEd+UiZ8lkYhxHKEC3pj4NjQYAnEgwAgQCGtlGB0iR/fhD9Dl2WZKkHTD0r+tpUTCqeNJlrAGPHs2UMKNlYT1eB7EtcUUPYEg9JJaoEl1qEtPCFH86I17ANuExQT96INoEd8/3INMLB/0fSCceQIi6Nb1/UMHJEIBlIIRQEHnPAEVhZ83bAYCdEFkuIqjyZKjhUg4bIbspNUcLEF8uZdPcMAMIAAPdP9C/knd/kUeGaSBnKBGSXxXDgihWHAUEPmED6DAFP2D+exSSYiBFG2FFxbFP5wSGhTCDdgDvhhCTcUEP5DRIYCgGkmfm42gTxSAHBUAMdSADXyAILjg2ozPOjXJFqRBukEarESBPslOCBRLGU7Z11hCRIzAFvCbW5yeE3IAAMaEcFDhTyxWWrgBCqBMYijgZYkFmvyDUawLp4UFmnGCoNRDmy2CPtSDosghLn6gJ8SAtelCSUzCU0FQIlxC9xHAupgJFbkAppUDFzwGiJROi8WEGzDJZ8iOQHAAFQITTiALBszBFpAeCEXeP/DbAyhBQ+BBCXSHJ/rEfQkhTIzPxG3/RcmsxQe4YJa9QBwYm/38QzOVBD+o2VUgSkn046PUnVikVi4m5FYkQjj94jdZ28eUAhI8wCC8DbgJYgVcwM2YgQ8wWvo5WmQcQQhAVzns4FJUWVr4w2eU11sI3On9wznwQCS0iEeshesQoExAgX6pi3+UD/p8A62B1scNij7QDzLUomiVRzEcCivYg0H6Bxs+n0zEQWvxjUJeZUl0QExw2AM9UAwkgjCcQxoYximEX3UwkQtwCBcgQBV8Y9T12z+4AQJsxqpVwEmyxRHwwPD0gSW2Bf+RQVi1yHURkVpsDzBRD0wgRkyAm38Yhdpx3CEMzGgBDGXGRDOpYXnQQjS0/6GjcKZMHMMx1Ekc7odnYqVb5IB/FIBW3llMiIAlXEILVACuiRQXbkNyCcQORoEsvYxPHMGMdAhKAGW8xJoCKMEBxAc0AtwsRdSoxVrDJYdMuIE6+eHnpMU2oAlKycQpfANMAFs+hAWwqaGgIIPeOYI+IEMzIANo7k9/GB9b3GI9cKAHNgoAmebe4JlWxgQxAEMpnMMDjGLn7JGaKONyEYQbPEANykRlPICK7OAPesMWrONhCQ1n9KVY/Bw0ggghlMRErYSEFpEVYmFM7JIp6lxlsYsxns+FnFMZPMBS/AN56kPA4MvfcOA/GAIGjlY+NAMtOIKNNkqDkYXeBOlbpP/nGNnn4VjCJEiQT1xfB4zCC6SBdhzXdSRgcrHlDioAB5xbDo5IFCCAO/AMy/zSW/iDHyHHW5KFuUXe+RFUCZTDhzYFWXgDBpWoWDhRzqGLN6TU2hDFKfRBcbDCMDSDPpSmTODUNSBkw+iDovLD/RgqWbxhWdgnC5DRnmEbtk2CVoLMh/1DC5AlieKXCyzBDvYMF7wAzShoFIxBg6ZVF7yoWzhHG/hA6YXjMwJmGeYO7flEEP1Ed5BMu6QFuPXS7eHXT2yDXULBG1xJAvxDKHzRWjSDLCgqwwwKQRpbowzMfCJp4TiQtlWVy+FhTEwIYiQgSUURuiDajIzkH6UbzEj/hsysmj1hA6yyBVOggRIYQRKyhRSgQMBF3s/tkFrQFxD9JwbVnn6VhNtoxy050X5twxSlQTk1we4lwDD8qFqkJ92tEd/thz2wYUxIGLc+igPd4S9qZY8UgAhAEDD8gDgUgTeAqnVc1gewAXQVC3OdjovNTqmyiwrEXpkKxBa8JFyCCL+FFb+RgRRIgRUsrRREHk5wQBs0J8HGBAECkwpcSAKOIXYoUdcyUXZsVS3dEgrExCk0gXNcQVhc60GGhT3AggB1bKNI5cj6Ryd8q8l+zAKQArhegiWUAgeggAt8w9YqoBg4wGZAFxeMwTu1aRBswQOM5D80ogucAtDGKkIg/0fpKG3A7SsELK0VEELoNq2VxAQ9xKlYPABiGAUqVufM0izXlqWV/qcLGKM/KMANDMOx+cQm9A0y3NRVgifKVapa0GF89MhWiABXyoQIgAoxvMI4/CfhKib5gM43fMbsmBJkoN8OQEAIcFA/VYE3qMPlxqoQiMsOPAHTSgEhQF1MEAL7WoEV0AEhEAIcgEHpvEAgdNpboME3EEXnlOVWZNZ+tUuwJgaa/K8tncITgNsY+EM+2M8m/MNokoUzyE9sqQXGlkQF726xPUo1UWvdugUjMEItdGpJkELJ/kMKfMw/jMA/fIINeIM58RICpwrEEkDi9kw4yFhYuQoHiA4C2P9TVz1AKsWHP9yKU8CBFcCB6MovIUDAAzDt6Iqu/YJBE4DB2exBiLKFD3xUGGbnF44FEyVGIOIXSG3DKUDAR1UIxR5BAoQCPzjCG+ZDBIwFgj2lyPmEmumx4QzDMdRnLhJvw4AMb/lE4EVQDVzCP7iCKhyB4Aaouf4EAXxvP6WGuVWGbi7BRpRqGlQAR0JiW7iBAozeNoCBE1cx6dYv+9avTPQAGJQBUzzAKaGuTDjAICCjwuLemZwTf3lhdTzAhXwACnjDG/SBEVxBTCzCJkwwhfnEx23rVhhCBQvbwOBLBTuMNI/wWpjAIYMTIidyTNRAImiCAqiuH2bQDfNSdaT/QRVIbgmQWxREgRvQiK004hx8QwMfQU3YMhaWMgR8QAhIgRMzsfw2bSrbb0zAARsYnCyjTU36MwegChQZ634x5lak01mi8U9UAAR4Ax1UyDe4QBP8AxI0az2Y3INVE4X5cUn8i/wk2AfrDZ6cmT0QKX+o2ZFy81pgWzst5E8gr0+IQA2MAhKM5RShzJTucgJuAwctlxv4jB+FgOxcwPeygTc0gRFEaH/4wxbwWh9IAQBUwUFXsSuL7j9YQVrDQRW0gRJ4I4pUrZo2wXWgmE944eJ1bfhpVWJgdKpAwX+maFm+gT8ggTJj7MCYpwaqxTWc1msF3/3YwzUbDpuNRSrw//TxVhVZgIwDPe8LeMPWWhZI3bDJsEEIxHNb7WA+7eAOsoELDML8OYrc9AEh4AEeJEVMQPE/oLVMwEEIfEEbzMEYJEcXpwUNs274kYUtHetRHFcgJqB2nILElmWdfgAYoMFJ/8OgSMNl24lL/4Sa9Q394NQiNANOM0zdCXJJOIOiRANmr0FbJEIpiEUNdEMlvMAgDMLaLHeq8FLnJAbSIEQ4UCMzLsESEIALoACv+bN9yWnlRZ4U9EAbiAPT7vboMu2GPm1JwMEctMEX8IADeEdbcIA6qA/aDXBlSRwl+/fWXtZ/hxQEVIAglMH5pBhhY4HaXmYqyOhlyu1WXEPeGf/bHMfEAJw3w5SHM6h3SbAnZjdMDRB1KcDmIBjXE5nJwZLPE7hAF3x2F3wDHQxzGnjDHWwDCjgAlzQKTuyAFOBBG/QA68LvKm8o6TItcLc5G8jpETuXCpCdKqaiT4xPzrEudeDXRYKO25wUF7LxdVaHEyE42tKAxV7DeQqKv5CFF5kHn+jDJuTDaj2zWHx3SWwwqPtEediChN0isxGyw4izT/wAPizyfBNjqbQgf+2RgCI4RUpRhbQxAWzDEGBDC5wANjp4f3SHmuMBcAvBGzzt6W1BhB6HD/RBH3SCOCRCFdSkvc4SFOgRjWNHiaoxmphJKgboHj2Brn9AE0BA+BH/wBO0yxQRwDO8wRZggdsNSj08k7MiA9y2xT18sBq2xbZm81ggg5JPA6QaDkSeXPMywD807z/IQQ2AynyTAiRPkcWDDmIgI8qE3wMwkVni13VinD9oEZA4jBT8dhu0gUccRwu4gREs1nHEPHLI9VagwbYvkbh77V2TlPkYmnX0enV+gFb5IQE8wBgcVwKOj9mVNKSbh97gnTOkApAz36PgyaF6bJ9kcFpoRZOzxSUwMiP/Q8TX2T+QgyUYLFPj13KfQhncwSczUdfGhAv4wwnggihkgvVAD06uhT9IgRC0AQMcxxZUQQ8oAQ8YfgKEhALws8xz2q6KBQeowDEqpi6T/wWqhJS4k/vMro1WSWwZMPBJ+bIYPAG6+wC9S/CDOUIzHPxWPDPUf/A0b3BbcIKn+52qq5GACMhWzPc/XEIWplNKgU65mDF2kPkZk88p7MADUMIGiAIuAMFjibJb9EHMF+eSJNSyzMEcJMscaMOWB2FLcHVhqgCC31JebwXERtHJ+MT6n6WZuM0UJYbg3lKvr02FfMAp9wEcD0C/SKvV8wdA2FuE7N+/egURJixoSGFDhwnr6Xs4kWJFixcxZqRYKuGohqW6/ZNTysYQAgVP/vv24Z+gf2IICCJw8sMpAjYVfqjwgJIpVB6M2fCXEA0ajQiN+lPqzw0eJeHmzMEwB/8XghJWEVjF80+JEDwIfBStOPSfDxQuC4r5tk1hylMuBZ36d4plSoQfViKcufbfh5NyBdHBNvPfyZne/qVpUQ/Gv0WOCzo7mlBgPonS7GXMV5GfQnuOml2UPJl0wxykJZSm2CHhpYJaXh0ZwjYhW5a3/21LGRdt2weiNnjYEIBGi6FD0WwpXVTpkn9XMWSVPv3qHOkl5gjhkJDsxAc4EfaunRshW5nmExKgfdfwPxQQ/plsSbhwXwJ3mnjjUOjGP0fXHPlHItUElEYyfQZ8KLOLPkuIINUWJFAjZcxwQ8KMalAoEUkU4ugfcpixYbD22oOrpfpuumk92yrwwZQJNvj/hwRcmDDOqH+O6O4opebgAQGpIrEuuquIRMCYObAroQQlckDuHx0dQuOBs+4CT6+Z3ipjrrlKvOub9j5oQiaZTpryHxe2pEulvlD4AAU6fEDin3wMGcARZx4jLZ9N/MvMHk4cYgihewxKKE+KkHnwwkURUmSicxidaAHX/lkgIUsQ0qYUEf7J8JUXBHGJNvUIYKkglrYJta+HxCjDBwV+QoUGL3ApYruCWvDhycncUMK6qH6cTlgELkCAC+mEaAEhKB8y4pRvXPoAG5QQEoM8h9pbVaVtPhBkWoccKMglMUzta5tUCzq3CQg4gOGaYexBMN5/RqtHUYokIujeh/LU/0fQgg5a9N9IMXqE4H8crYgjThNaIBELCqrkk0u6ofgTG1ywayb1qj0xLvoacqEJH2hAZYMsJvDgn1aK8Ec5NI5IaleMFBBi2Oqs+ierqnbOSohylmW2ISkrGLMtvSba+CWPbRKkpifaQsEHuQpSE65ToE53mya2QYOVBAoaAJlF8onFGUAPRghtyjCC5ZqEfElbboxYQKjuiyxJxJIFGPgnEYYRMqUgSlXB2K50cyJPvBOpPcWHEwIQ7p8JRBHliJYLOkI50pQc1rqEENC5IOmUOGepmSmS8i20vl2c1d20xWYbaFVND69/vNEtjcKs5VbVmGbaBgUC/FFAQFYWkf8MmWaaqWc0jNbGF7JCEQKtoQDnfAgWh2KZuyJwvKeIlH8qcaUSSy+hBCFNzPlHE1Ai+SWNNpFOKNr6qE2IJZF98CCAk1FBggaM4xkKEMo/WnAEhGzuImjgQlWqMiQEzOAfc/gHBQtSlQoa4x8vON1FtnAEqn0Df1RDiAnbspaY2OcmNSmVQ1CQBrbshIQouUlc5NKeJ4zsBc1IgACk4RhZJCpthpBIPrBXkTxtRkGHCt9FDIaQRyjjH7VAzTj+kYGKUAoY/2CCQyoBin9QwhWRGMd3ymWfllgrLohDiwrP5RKpuaIVwCkIKqAghmcgwYMFMQoDMWIO63DBOqEjls7/LIiQCxQkKhdAw+mExp0tbEcuT1hPfLxBKlRxbDy8UwhbLqmQqdXwTOQRA10EcYdpnSJV3mgCGdCggB+yohjSOMY/kIEguT0PIfnYl2Q2E8SJhCJCTzyKJzJyt4L8AiMeqlSlNOGKhETzH41oxD8sMSW/WKswN6TL4W4yFxOd8h8tMkcrNrCBW2wAHkZ4grf4+A8jvIA09ODBA4NVAtHpM4MKmQES/PFI1E3EHxzwBxRyaBHCHA5bKWGoQ6gmCPkgLSXbyGHGHCfLdyGEIKkIGCQWxYl9UaQeufhH9P7RmIE4AhmcSJAxJ1MLK2pEixcRgTQ1wbBraqIg5gCFNhqx/4xuLOABeblLS0L1FlaGpyDTmtY7BeEqC9QRFaJABQ4e8IRTiGEI4wiLESJJEXdc5UcIMaRZF/mcf0RCCEIJa5RgpoL7neovusELqmqYtW+VC5TXesgzCpKGNBAAJuUpCLTWMq1vPCNMihFAAoaREFoUJBUC+sf2HMLLiiCDiQhx4j+M+Jh5PWgRl6nHZP8BUpiehqZHSQ2BeLqMRqiPp+77ByhE2EYUjqpcLHHJTLQKypF5oBUm+0cASACfbZRBEBVQAD2HAkiLLMFHoGMkLhCiTyVhoCAGfetDfKCCainVWmm8CFyAJxOUDKGNhVnPKeATH2ywFyFQU+8KnTY8f/9gIQGLYAUMEkUQfdgDGZUtCGZB24w7LaKYRxlQLvKRiwXBQh8G/scANrNRi0TgH3ySEGthqpqdtm8Zr/iHR0RQiRfM5Dw15O2qemNeNvmAjqK4hcpEkVWnxeQUSLDVZKpQyIeELiElcE4VPqiRg1ILPX3F1kNwgxtxkXJVNYTA/E5lWDTBpFypfAIK+nCEa8DgGInKR2RHI43QFKNQoYnMSzXCWYSoFiMjDfE/THDnjGhCG5qQWCUSEhul4kW91fpLOB3aF7rQZWQnKC4qbhEAVCi3MDY5hQFdlpEWLMBSiVRrPwvCTyGcQwgAje6OjqCCFip1LubtzUM7JhOYXA3/cXoRxDae8QAttRpF3eTdCrUqhia4AA1Y+MfbnBEKZHCjGKOJbEI0K6FDHMzDCOGwnvX8ij5rQ4zX9NuJjTBYNp7kS4zLFuL8sqbCQIAJ0KBBOgMgCnH5RTd3GIQRboSRFiCgCDNI6+gecoFrGEEJHvSHAb7rEA54YwiF4c3ioIW/i5gqJqRE1ZnQ5B6VuAWU2fJtS0goRwJA4Ai0gMEAhjEMZzgjUbSQxRChPZF6fLaXCqmHI+CM7WPqnCKAVl8jxFgQTv1gFUjIZKVRUga7mMh+Rw0TBE6QCUhvgATQQJFuZvIBBRhnoBN5QQmKIDqdEfnTCMEAF5DABe+qBqHe/2qJXFCoERO5rn4uYEnc8bdQ9cL9JNEiAAq6BoU4JAAGvmjGAP4RWVnQqxjdm0hnKUKokxYE8jzPCDIfgqkQp2Aitf0HKZaBhCllTDywTlx47vCAceCCEhMABQ0mgLEP2KYlH0ACWDWdLOxkBSFBEvvo9lCEPSil6xjxhwg/8IR013oyUabItEQll298qyF+8YuJ7vqlbeDnAVLCQj92IYDJzqIgAvhHmZth58kgPolpk0goLK+QBWh+UToAtEIqMb7PvyIHaaDDXyTqPFDI1XKiDCAACCIBFCyAEogLCTJmPljiDtQBCWTGIo5AO/5BCBDC0zgo1C6AB5AACRSA+P9UgwPcoAn+ARuegWrwwi64qSJm7SUII5Qe4gHSoAy+iURWqCa2pDCw4WmewQVQAAW67wgei81kYRZmARnY7LKugeYmY0/4waQUojMQYmDibyL+oFK0wTkmgv7+oRP+AYsuIhGwKSFIQZoaohIsQQRKIQOmxKJAyVSsr1So76hO5QmeIQ2KoBFS4H2coBUU4AYp7nbUgesu4gjowR/oQQiUZHTOqgSQYA7YygiMAApIUDUeIC3wbj3K64X0R1tQwq4oAgDZAgW8IWuYTyHyYhuw4X4+gCXWBQ2QIB/6oRiOgRVoIf2QwRmkQZgKwhHyAQor4k8cYhMC5h/6ASHczCH/bi7n5mYNJsNSCsJ4CqKmMoJS8OY7BgPu7GeF8qchYoIO+FAVqqEaPIC4FCAO0+Mf1EEopKsGC8EfXiABtOEC+Ck6uAsPxoG7ZuAIoCBHloU0tgMKvuEtjuZoDofpqAW/6geGEgPwes30YCch7Cs+HoADFOBtpGEYmmERmsHxFIJsqk1C9KHajAj+OMPyGOEhxLAiWtIitFEhwHBw/oFTLuEIUFE9vgExKA4hfrCibOcfnkAMXsAUROAEIIYSaCC+7EcQ1KH4HuIIRtAfyqHgeGCRItEGfkWBBFIq9c0HKuAZ7IItGOqFli/LxBEUxYUhHa4M0sAHBuEO+ooG8TBU//4CaT6gDFBgC2gRGWAgH1ihIBJlXvzjYDjhIDbjIIYhGbMQIfIsIdRQIbCxIF7yQjxC/xyC8x6AAF5RLu6wB7HPIU7pGYxAE7wAFNLRC+RNYwyDABTgVizCBx5AKV5ACYogB5QAH7kgOnjABqwOD47gBXwgHicDDXzAGxAyIVaiN37LPsaFAFRxY1boBWkjNHOjRbABJ1JCxmSw17DFaYjyHzjACK4hAQYzHzjhbf5h2iYDC6uwtDysHhDPIRrszjBPIbzwGrNIIUBsMjrBNc6nFBLh/mjyxI5gL9CjIXTD10LJ+p7hCCYAYhACu1DgDnLDDj9gCIzggCwCOQMKDf8sQQlawNMKQgiKoAoc0QdyRaAu5DsW5wmk8zbA8RWbqr3SAiXS0mPWEgoKwgH4orDeLhStTxTTJalcQCZGpngSoBerR24ChCECZACQ5zEVwhM4RCMUYBIYoTIzwgz/wTXMpxIM1LYuwRJSoBI609K45JMIo9DwpzeG4AgoYQNooCA2wBiMoEGz5Q5UwEMrAkTRgAM4IAEu4DZxIJGUwAhqpixyxSkJhFDZwoSopmkUYnGc09wUgu8aQhCkJlzcazdQ6L7y7lKfhShH5QiOAAYSQFAywxCI0QprDrSgMaUWwkoTYhIQAhH+IT+P4j9Jo4v+YRn+oX0QQprAdBQSdO//0MWG1MivwNNN+gcHNgALBIAE/gEJ/M+hnEYQvgGgMGILlgJm2uAFLOFndKYEqoADdrM4t8AHEg4jpgawqKVI0QRTq4ZIV8VUbi031oPKyqIM6BDW0PJZW20GvfVMhsAFhO0OOOD7YOAgavV65sQeVPLmMMLYsnANkoE0LCEDOkFX/2ESgFUjJAFM11ATSIEjLqEUziFjvqHhlnOrCMsbE0JNdgMFjuAEjIEEbCEAbokGHgAFGfS5CiITG+KDisIVjMEfHvEqtKMcEkBZcCSgLuT4EIoOlmZcAPYiXlBcRPEkzMMwDjJfQ9MTgac9uImwyGMmyitUwaAPfGAYrmAY/5DRpCDhHvhEGC2CGBXCBnD1wxBipiJlMv+hFCwlES4mY14RHHW0FBHnGaSGCXBBAAKAzawBC7TpodRBIMPqOEBXKdoKDZSAC/7hA23gNqELgXRFQvyBNoFrXyfjoa4mlGYHN07hAaIAbCcCN2iPRunNryhOZNTBH+IgbIYRYDbBCtvvieDzMS8zITwhBwg3UigF0ErhFYzABYZgvtprVHqw+vBnO9MACX4oHWjhFoqBFY5g2BzCRzPNOIPmSVwmoISAHg2VuxYxARTo+Igz3whEV7iVcS61IvDVWSvNLUJlECDgvkTFIcgExuajm5RuVV6QB1GgArbAGiGj/aatJP87jFEqzyIIRfJIw1dLY3r/gYoOJkMO9yZDxAXuQFVC5RMlzna+yS/ugAC84QX6QfwGkxVK4AhklF615RSMwKDklzuOln5fYPhsQAkqqAQ44AXkpCiSuHUJ5HUViDfso0ETp0jVsoDbqFQIy4xj8V6JED4Aq2DbYnZcsS2sxRtU6IZ1WBCaYAvM17M244g4ARhBy7OOogMOACHqITNioRkD9yLIkFHUkBwiphtWYXsZByHpLj1IhMsqoAWugRUGUxaOAQYeYAg2tC1OQR36yCK6Azn8AQKEIoqvcgR9oHVTV4EuZAsgwKJcAmrYqJvG5US+diFrtk1/rT4AtgL+YQX/bfSXN5U3lGZpPNOGCKCGCosOXOINfIADbmAADsEK+WR5FcKECYQfYqEeDKEeEMx7pPFCStYhRPYoKuESWvYSNOESIkH2lll2wXZxSPE2pJUGjEEA2EwAruEIBEFGPe4foEAdBCpem1gp3pUelQABAuGRbkRVbdl1aVMupCyM9dk+CtEhs0yM2daYtyChjJQ5Zwd/0sjFNOY25IItahNsEiJAHsNvNSKKCiKIhsFsuocTRphRovcfkqGdLQKFF+UT+oxSyKESNAEAisAFymC+xCkc+yLi8ie9cqMJHoAJGqAYZmHx2BeTFQIK3IqgHAJ0H8kfWsAYlMDUnqQ8XwCA/0ljKGZZHZRO79RtPiL4H5qg73j0Y06BajYmghdHZxWIAGbDcW8I6XYNsF+TW804gl9od0Yye5r32UpDp6nHM+iMUTq2Y3WhICIzphpZNShBG9TnH4Ih/8j0EyphktNghh0uQ+0Kje3iFQXha//hDsx6BrBgFkgABhQACp5AdhQCJjwT3xyaiZdCKThAG3pAXBGiQ6n2QjhAhEgoeFWiW80449jjIeyORkkRcWzDhO7DqleEL9Dj1hBDJRCDy1pnXxHjvQvCHkBYQni1IApAIfwFYBwBYx1CkVUDE57ImSpitRGiESqhEUwsErRVPWS2m8zYWgD7hMSxMB7AGFjBFv9SCgoO+mqWriUq4Kx35GifWwGGjyxsoMX/YYkzwh8eADEcgNww/GjYQrk3BpTKre+eeTxO4g5Q4B8gYMhBptd0izfYy2YP1pMu0nHLryA6ozEWWSRTuCCQGiM6wagRYgQuRNtawQbSgAenxYxpwy6nhZQbQoOvgRacweQSFE3O/FQW7Q62DmmT4yFW+WjFVSl8oI8KSp5gPMalJGa/QQxs4xW75YVWWkc/gCwLQkZzFHgZfWlOyAFQ4FZcB45zg8pwI2Fb4gf74p3muzCU78v+4U457HhVIwYmg+Zeqnn17CWxqCYjpSdeIROgIA3EBALpayIv+bAsiQhPAGxggBb/zGTpTmE7ZUJasIGhh8IA/sEojkA2x4IsSPBlXqAItNh1cWfIwXifJXxa6vCGoMWSedR7qWY9/A53bkU69QIhaeIulI65ltPXFnJV5OL//kHtMnoyDIaedMDWHwIS+OGb7xuXAhwh+OGzF5lgVKERcCHcuuVtU7BIxXgiaOMDjABSWKEfsMApbTcW7RAbWqY7jAJQlaw7ytMIImUoKgBkDuf6NjU3bII6cZt3T4+FjsAHmuAOAJaETE8MrHnm2ZKA/2HXCgKQmhshOgBwNaIe+IQhXIptHt4hXIMRRABlH8LEGkIUrskULgAEMCDcmKs6De3XMrUhuKkCCnWyBrEo/5uuMMR4ENQBrMjikRKorkM0c5DgugnEonfHTaylly1qQ5fdsjNasM4kLkwCeHT5kyo5XRauPtDi3u0yLsolBl+6IJBZIVA+0zJCEYCBFCj0IYCgCj3sHh4jQPiECsX5MamxILz8ZF94ImbrIQQn4nFBzE9CRpevtwx2wg2rW3SWBmBgGWORXIKH3EZlmp/h2XFvV/zBBuja+BSiCFze2+PaJTAULRb9HwaByBNDOQlArubDG1wgDZwmh9H9gcsFQ6XVLG4nU4N9R2XsFLQkDaydO6RdLADC37+BBAsa/GcOyMGBBQryK3ioniN9//QtOkhxoUaNyTZ6LOhqI4OBpP9CLtTWyJQpgxMa/fv0D5cNbwTEfPtX5oNBAgQE/RPU86dQn0B5CnoiyMg/ZAPvnOI5cNvTgkP+nfqnTp0RfwK5crDR9eNHH0g4iD37jytBnToJWP334J+3Ciig3iHQtsxVFz97+v0XVEwZtwN9AiYwiEPcw4QH1nx6dajQnQOvolhoAO1ZUhsxFdxk8NA/0f/u5bq40ZDmgWtWL1RwUNJASwQraVz2Spuqg5pUmWqJ64U3pNv+FT/I9l/y5coJuHDehMMMLP/uHOcLmGZBqcqffFMHVuDAIxzEuzZow8Z5jVz9pRns9oNUQUdwEnhmHOiTD0/EGN+mE2Af4IWXcgb/DuSfTv4Z9w0UaNAkyHFrFVRggAtdt955FmjUAUFEEPSQQfb8M0CGJnpEDCP/MLEAMImst8o/Kx1ESSMT7NbKTKf4FB9BEvrnkxhBFfaPkDUNuBA2hgG2Y2QfRPbMEHd8ZwQaYSFhXlrrcYAEGhz4cKJBpwwmximnpAHBKSiIYdiAFRoHGEFCAjZnkZMJ5d9xxR3hQxM+WVinkEEOaRAKTfxj1npqrZfChhuFGGY9aMlmIhIKJMJAQyNptIBHLu1GECX/pEQJLke4IMYTgEmo6p0HGpgcQd98IAgKL/yDAncFKXnThVAh0WVXLWx1opUvFOFPff9sEaYPPqhT61Vp/zThFgFPFLcgARI6VpyeUf3p2ECxKjcENhwc0cQzkRWkE3YLBRiEa5kdlOVCEmjUUIcENTTQIfcM5IhBGYVJsEGdEMTAKBrZxtlHmRDkxD9OrATNC7UWFWdBS2osGZHiDuTNP9gcJMi6O53SFjbYFGElGv/Y4EO9monnT1kvtJDhvMv+88ILewgCwU3PQUXZQQRM1aNbREm2cU9NPMDBtBZuVFXB9KLVQRYH6btQBP/+G7DVYh+UgiW2KbwR2gSJc5ApNYo6UCONiPrCkW5tO9DI70748TYE0NTHPy6YLPI2vYqJ5H50QIGlWsgSjAaWY/MZNArPfCDkNhEaNDWc3f9qC+fHGStokAtN+NACTZ1rfDdm8mrkcpj8GgTaP/mMvRAjKo5wokkb2WYbQas0sttKrQwE0z8TiDLBjDb4zVOeYkH1JIJ4/vPE0YJXTbLJJXdOxxBZteAVsRluYWUL6g3kcuwZouFGBTsehu1h1nv0pE4b9+pWncX9WYZ/1Edbp6hTQfxzKNx5RGe3EosW/jE7BX6kNef5Q6cKcolSVKJhl1hIMBYCKoL4phFZAAEOjteAIziHMBvbG8eYE6CrQEBWlNGbUKq1k6xUyR/qkZlrePgV87jvh1BAgSAEURXQGaZAB+nWhMCVoDixhUDJIYwD0PCAm/AESc25zD90ZhD/MK5GjGFklgQlqKKFvGgjvOPNKBpRCU2cJYQDacVKTBGAf7QCB/84oamGYC3lTEUjS4MeQYCytCXtIGNnOdrSDpO9LiDhBTUrj4mYhYb0NHCIZ1HLFlRgxAAWhSekBEwLq9WYn8xvaWUiZbUeOZCbGIED3hjCN7b4j2/QZQxnLIjMzKiZH2ykdr1czRoL8oeB0MAgyZsjJZr5jwsEYDc4AMFATGEMH6BAJ93CnGaIZqEAIfIfFfDGjgQhBrxpzHCCmFr+sAEeDiDLh655wVY4KRbxaNMvgvjGKfzmxIM8R53t/Ml+XrWNazVHSLFqCwGc5TdaESQKBaOnWGJ3L6z9/4NrHtEHJwjWxoJpI25q88grVPEKUbhEIzhAhSgGgoESHMGIhSEMAbABvZoQik7VWpCdDnkZdTVGi4ecH4XidJcPfKAMKlCBOl5wDotq5gU2aOB5HlABHg0Se3Kyk00FZRC88chzBflGkO5UMrccAQqxPAsZNfLWE3F0awR5RAw+QppinoUJC/kEHQ/iErghjyB8/Ac0/pHHf2DAGDNlTlQIM6DOLacxTByCYRYjKy5GRSife+w3sDGnfqrACGAhWHqkqhGsPoOFBtpWgZwY2bMARZ2OiW1NcQUFH7jhDvgME2o/sr6CIOIj/ALHMA0CCbHcbj226eBGFLAhwQ52I//QHMhf9Sijf2RhBjR4wGWeUECj+W2cBPlLKpeExBZGZTD8W4gjAYMNf/pEKuVCQmm1dB6BFIGSmtkCFLJqpiYZCGUGGWucdrqTWwZQiQUOimF2tKZ/RMFKEvRHXIF7ECDMbq4FOYNHIPWPCJQIuQUJmwQz8A8LUKISoBiFqBrhXIO88R+qGOlGRHEBPooCBxMoAQ60uY2Rga5oiGxTcz7GHKIQZkGzCt1H1FtTAkzyt51Eyxa8QVPN+YcvU4Tl//qi3jeV17XuEhCsuJezM/aWIMDg8EF0MZAiEEMRAxkRQQa2ENSEqVMjjbFBYDMQbXxiGZ8IHmBHpRkciMoUDej/7iBLtqRSkqxIpPwykF71jzSITpUec82TblqEI/iDWVReCJf8sWaC+KACTZBK9oh06ZIV5AlKahISD3JpQzbm0gZEpXMkfD69ikUHG+nAI3RhXI0s1yPJLdgFP/KKGJ3lpCsVy0oCUIITeLc44zowx8DFzTeRriCRGXJZxTK1atFKSOoQdZh69pEHwMctrRrXB5SE1n/+xLIutOlaIMu5AvXoRBcupkIW0pDhwnkgj6DzeUB8opH6biE1GsgrCFKK2lz8muf5BA+QkIanELW8jCzqfBnDNI2koS7a++dV5pfKjaDSP0hRAH/XA0yLelffSzqc6CxL4CdB+ipJZB1Q/7hVchxCBYf/AIOw5ToQYCz8H8QuCD4GQoyDPOIfWz/LQxzRbGIWrIMh1cgnbDyQUaj9H2jTRiTQkjxVXEAVQjjHe/SHb418GdzOoe0pfTAIdkpIW9+gbZLYhY1rIYF8zfLhypl4oCYTZEcAAtCF+oIcvKxLqcrZhvS4jTGr6QyYBWsBCxYwC4Z7qJgR+IfYC1YJQ2ukzxtZxj+WoQpm9JWlelTFDF7wz57Y+vL+AasrY24QQFrlAdxBM0FseB6eqEOApdbIFhY1kC08AwUEFmtjCLcrSNfalY5hLUGS6dM4AcWnTwggwQnCrIKvZwKpn/pBzPEPzkQwTHr+CNtWU/92GKQNJVUQtqcJHyRoBKgRb+cbqgACF7AquQRLFCJuP2UcyCcyuWQQadATS+JEUHZ4/PYT2+B5KkBJ1WcQNDMQAlEBn9VERiMUSmJDmtMrkKYthBFRH4BmD2QGqsJNFzg1bPBFBSN/6yEBlmABtPAPMeBmiEAJlpAC//AhGmF/Z9FsYmF7aBF7bDcCsvcPcpQ8H7QQ0rYa0qYKCBAJ2sQxyJFyiXQnBDAEUqE/BFEGULByhud+OGEVsgYn53VIA+EDA7BDK1hlC4FVPyF04BcuUEFg2XNWuOZVTmYQbnZLBkFRYQJMBlCE5zEB1OEoBgGF1fB0uCMMCyFH/yCGC1H/CVn4D29XEG9HhgRhe6uAAfRwBJuHaYd0RLACg5yDaW7xAEZ1Fj53HNhwb4YTHxXgD8OQAIbQAolyIv9lSO61i3AyXwDnEccRJRUQL3U1XAdxBx+Bghuxia4BDAPBVxtRdf/wA54xiiaigGOzCgBwEJGwCpmwBLjyD0nkgQTxBCJogaZkgXjHa/9AB99wiNiAgRojjNrzE3RQEN7wBmiABQnAjKxwLjtzHrdYAdM3EODFh5NnH4dhE2CVfhpRAdVxEPvHAgWWNwZBeuehiRr5jgYBDD/wjTW5HptyRqoATfSYf0qABDM0IR9gVv+mZPZ2ZH+4aYABBQ+wkAbVi23F/xZO8QFNcARGkAD10A/1kAAn8ALQ2F9QYCZ88Sc+UXgN9oH2w4vbUjVXEQT01Ewe5gaqkj01QU4UZUbluBHmQUbj6Boh0QHreFc6KTaroA2xSDCacFK4oAQzURMLJpBuWH4od0h/wZSxtA2D8A8JVI17qIjupS3bRAAo4A8J4AvLlgBIcAQx6RECUReRYWCQsSSkY1MYozSRSSQ+0QSaxj4H8VK0cVS0UhynIIQSxJcZcgL/EIUEwRnEVpiGWTAvtRsbRzCN8AkugQMNMAMz0AK/Ji5idnnHwURRSSE8gjdu4XPbIYkA4k1N4A9YAAOscA3/sAj1+TLudlFYxR+xhf8d+qYr/fhlPyghD7YQsbOJ/uEWIZNq75ecYaIQKUBsHnAeOSmdBmFjn/AKEEgwx/MPDdAA0IALOHAE6UYnaJUxlQYV6+dCgvQBKJAmyDd87LlZhWFWtfIAR3AFrDAAyBAK+mAI9SBip+URW/AA2LBNcZIt4mJrI3MKqiIY/rYTeDEyrXaJBGEWvTVxDAcg7sdLYmEA1ZcZUSCWQ2g1y7lRBbECBJECKcBhbnahHxE8kVBdJ9IPDfAPXhAJFjBT7vKD4TkgfvNYAUJF5mY9BfoTM6Q5+tYYrfIRRlkcLqCM13AN0vAPuyAN9mAPizAMMDAML6CfBCEQWwAB3lCJS7b/EI76E+HobZVRPa5Cjh6xTAfBmeS0iYD5DxQ2EA9KEBZKVwVhCR3QnAvhq+n4D8OFCPsnnacoQRTaCLhAD951dJZJckpXcgZKGZR1CmCihybyP6eDBP8gCwORD8QUAcNwA1hgA6K2KMnyAOpgJuGCFrf0J4STh72EDmLgRRdaapeQApZKECRwCxs1rAQBBMxaMBl1A68Xp+sBsIcVE3AReSVnZuxZPRX4gt2yDd6QRcqxi4a3EdUzBCrgD84AA5sKsLajD/ngCMwYB0ZAPgKBBkcQjOxJFIa3ZUq1kCBYk7gaqxnyCwUAN2v6D9RBdSvinAdHtFZzAA1rNcUwEMZA/xAgB0iyhnyCsnQkSWm4JJIHZlOnAAFEgzIEphlXUZpkcQME8VEEUQ8DcA+LUA83EAc2QD4cAAU3ARTpdmtw4lNKI0VMJ5IZFaeu6Rq/YDUFWxAUuo5rirgGEZ0GUQBwGqesODZQew3FIAQKwIEuYFk21GQWAlCGCnnsUluUJQhZ5Deh+RFJ9AGD4A8w4Hr8cBEjVq6S8i/1cAXD8JJkRRQmw22OkU4SwkUg20sNwQEu47NiYVUpyKsGMasFIUxi4avKShDumGw6uRuZUKcZkrL/ALXm4F1uQTiSVn5Lp2vIt0QUcgpY5TfruRrP8AZbUJGLsAn9BzD/wA+kYXNGUP8B1TIV21AVJmNTPHEckidwudi8NjkQ62iYgnsQCTwQJKARwgmKThsmkeCKYQK1UvsPUssDEEAHtfSZzcEWCuVECUWo/8E3BFJeHEtD6+E3UGAWoXAN/7IU+Gs7hhAiWPAlBJCSDDJfJPgNEDKC3HaD40Zyq8EBHADBNenABQFGmRGmWCoWz2sQRLC0BGEBjZusEPQP7vgR4OCr75jBViMNUKtt33VAknE4bkJAO8Gi9jN4fGFEQId85qlqRgsai3A7+gAahgAD6foll5FKcxgur8oupPSqx+kaDUoQK3COFiACDKyTOPOzGxE7jWsQmlwQaPqrGjHGhklHD2g19Wn/qTAQB51Ha6S7OhdIW0q5E2kQchGSlhLyuxvBbae5XJtAEfUQC87wD6j8JRC5aWwBFIeDYGshBlWRQFeDFsu7EA3zDyKAYu8YXE/nyetRhWexzXrloeuBCxvBC//AC9cAAy9QATohZJlZm+R7nslcGCjgmyXjTyS3YK1cJv/ABvKbAKEQzP/gCPfQejAAG9pUBpb3bYcBuoVntpuFAilJlL3EBJxxjh3wiaNoA028GsJEyf8gwQYhAvhyFmBck9AUzmJx0ob1EZZ6DYFgBJdDK42BXuvSK5H6mY6VSngxOD7hAidHEKwqOP/gAGuBDXfwBOrgMhdxsoaQDzfQDwqA/wZjUAZNwCZ3wYZE4gK98g1VIQice68a/RFKaMFhwkAHYawE0dEFsSFnTRBMcI4Gcb1iEddjvRDGgJ8LYc5Qqw7SKnRJ7ComUyY5dVY+7RjP4BNDcJVTXV58iEoSBRjYoQBXUA98PBD1sHgQ8AangA180RMnuRDzg7cD8QatDFdWs0wX/XRZMmoShKaaTLQCcBCukM3/IIpSGIWh/I7X9c0ShJ/S0A9QWwF3QAedQ7yvMllNmR8l4wJs8AA+AAE9vY97gQLY8AD40b93c5WvG8wJAAM0cCsrdxk6sSN649mc00LdGEZoQHrJ+w9NG2L/AASzKs0X6sgmMtsLQR2SO/8Qtd0Q0UvXAwGxUsvBCyEPEFsMxjDgA7ELBTHgDWCp0/Ay3tAErIpKL7cN7rdrQjEgShaeC6ECLvAGPmADe6AA9QEBUCAVTQAFyXK8tCS+b1C05ArVPnAHFUAcgzS2IItIKLCvNbk+UzAQCsEEmoDa/40W97K8NHACjcvJB8EZ3WDkq4GnB4EB0ACxAX4BVq4RDVAM1yC1xTAAqXwoQ5J+T2DYTRORrnwTQtYtB+0CaRDiRyAE4TAHVUCCQfAAJ84BCBACczACCvAlPpAGD9AC+oAFogYB4c0u7TQrLCyJGjgQQXClsCM2LnMv99IBj0tspMDWhvlWllwwWfwRgBb/5Wcx5f9gDQbxMAQhoh3cwSktzpaqhC1gjMKnXpCBFkShkGPrDTSbBkfwAnMeDv9QAl1QGU2ABlwwB8M+ByUgBOrgDYOeq7HjJ5tzVAjiYAZBF2cRf2baS0DeAQtnAZ3u6QUB6ri6tP49Nt0cpw1Qn3f9igOBCyUAtYYl4ANR7x6BDDCABf4ABsKdwtn4grJyFO10CmbhwxzABeVQBQQRAtFeBhCgFLZ3AeEw7EtgGHSgAvKjOUeCZJy3JKoq1Nx6Fu5DuGIz2yDwIQXQdTX56bl6Igf3D2+NO+wunQ2QthqBC60+EB55EAmuEdKQAKmTE0kqry4po4g8JD6wBHMg/wYPgARC0PAEUQLlNAg+wAVc8A9z8A8hMBBc4Hm5JB+DcDRl0jnPYZQfkJJiIAbNbH2Y/BGcBNZsijvAdPLwRxAmT4jn0eRW0/Kl7hpSiwu40L1ROxAASwswAAj+kFWCinkFxrMbEey5tAV1rvX/MOz/UAVikAY2IPVaP+zlMOzGDrr7EYcLEQR4UQGl2q35tB46w94INzZooN7yZwDMQnpiFPu4E72+avOAbxC4QKkHMQCWagzSIA27IABXYARH8Aav9ohUcfQe4RZoUALLUALPsuwGwfV7gAb0sADLXudc3/VywTlHuRBj4BMQYDgHYElhpEBWMvcNK0ZSDJMSpP/fB0Hzbwb8YcLBUw4QxaQZ+1fsij9sBD48ESSIwD9B/yROlLjtn8VtBCJG/PDtX4V/SxD8C4eGiziS/7iknKPinxAbXML9CyGxyr8qFimegijoAcWJFR6kGQPU6FGkSZUulZiD6T8OT6WikToxalWjw5iCwmq0A0ViXcWOJfu03z9p0qhJlJbqnzp/DiBULHr0g8Siaf4dmTg3yD8fR+bgfNHiiAM2M4G+QFIFjZk/FLuU/Ue1q+WymClv5txZ4iyJJ/6dmALUwr/TZKt99dzaNdNraF93NWM0Sters3Xv5n1UM9bUqJNynRjjn/Gnl3ovZ97c+WYDz6Uvf6FU9MT/4P8oNdc13Siu3oC8jyf7m/x5si2Onsg+XOwK9GIbxKdf3/59peop6vlH41+df+BLyj/8ClRqFwMTVHDBo0qjKAWjUJkIC/8IfEoTA01hcEPecuOwN/MkCpGiXWLxAKgTSLAQKFY+fEqV6aRp7TYXa7xPMw+fOtEoEiizEBgIbZwNQSGLlCg6Izkb4B9ulvIlyQJjg3LKJP3J8Slbjlqxs7D+IY5KMPHzJ0zdRgSKhYn0QapH14L8RwSjCiATKXWc42tOrCTAMzo0rjQKjUck4gc1Np1DBM+n3HFusKTGqAtKJI1SBFGmcnukGxn/kVCiDf4RgCJ9QqF0vEyTuvMo/xonOkUnoPZAygc3uGBj1Oe2qNGLAK/TjbUi56uq1GL+MSYdiYxZq06JrAmWIjf+UYALJvwxAoUg9LroH4/uGGNMiqrgy58xqlBMoiX+eeFRNoKhtTlbnfMTKTfXla8BYwhCSkp7JUJQSqOgkSaAgoyaBRVbaIDhmhZaAEkiAr45xRsUyBhTvQvCCaGKMUMIh4ubFCuBgwVKkEhPrCJ10UHRgADKzKrklPcol208WCprhL0GQSIlig0Zo2oGahZuiimGhFQSQMIfdZrYxgGJBInYnz65COECcf8xgAsuyqHInRJsqELd6LidyGQOu3uKiKTeLTDV+pQrUmSk4JbIV/+CrrkGGrsnKiZf0JABDbTPBJhglltYSYAVf7aAAoU7nnABhTT8iW6JwUbyx5+ZwnGnJoo4MIBsIV32ABKlsBBwIhyMZJktirhy5eWqZlCK33/W0pn1BqzJtJ1iJUrHGJ8pssWWT4uZBgZn/9lCrwpWTfyfLrhg9IgxRhB5JaP47BMz0JfrHqmroqvm9qRIKHSiRCRS28Cr9EuqgGVP2JIsYGCfKIB6yZ/7KVomokVGGQUgS/+gRf/6MQwj+KMPLqADt5AgkZqcQwg4+Qej/iQ2sbnmcyI6ygYpkwo1/aN+EiGQrmz0vRw1oxlH8UCL2mO/pPiKIrT7Bw33N5GcHWXVWWsJVs6aVJAB1AMWMLiBPrBgAxtIDg110tgcbFCOcuDhTxIZ0/eQsrqkUGV9T2mXUWSBlU6JRQDnM0oX88PBp0SKAxxwH1N+0CJPleWFNpLhDI0BnnxN5I7/kFtXjNG/fwAMcPeqByuQAYMEXMM//bjK1FSCqDEd4B/qGeE/SIeif8ARbVUZHxnJYsWnQA2NFDnDP5xRlhXCECl1fA2xlOIWfSEjFdKIRQJqCIUqzGEmI4CKfdhGltuwoFlGcQRTSkWRUyWoXVgESjKM8qR/rNAQ8QkIACH5BAUNAP8ALGsAAABkAfIAAAj/AP8JHEiwoMGDCBMqXMiw4T9JAic5HCGwUqJ/lf4hMRLFxz8fPmxIODcuQ6d/IzL+O+nw34KWBNc8/MeIEcybOHPq3Mmzp8+fQIMqzPHP0yRPZgQSTXjpX6lEpC4h4YDGH8Et//z542CjyDgLAjtd/FeL4EtLLH160mUCotC3cOPKnUu3LswCBJN1MjFQ0UEFpJhYYGKjxT80h6taJaiVw4siTNBaKmhT4GShfO1q3sy5s+fNkmQOJCaw18Gzqsa9+FekBYctiBELtIo1q78WQDpYkrhwbFwFn4MLH07ccy1JyRIqK/iSyacij434OAIy4eKsaIzUSss9w0uhyRF+/9+pUmGw4ujT90SkvuBehBCJfSc1uYgNJEeyDqy9UKuRDCud9F0njHjHSCKXAXXReKT4pJI2BrkCYXsUVmhhS5golBZGlgDR2kCytaTVagUONAmBC4iFIFBJZQAcXBMSVMkyBcV44Y3qnYOjQ5dYQgkTHPiDFX8O1ebPagItoMBFiXSygCXjbWZjS5qYU5CV/3iw45bplWWQX5yBKdByYjKUSAYNjlJEkD5pZcM/kwmYwW52YZlFRT5hyeWefHYGzj+P3NRjJaS8oNVhiAoU26GM6adoVRwAAWUnljCRpEsbCuQbTG4NZM6dOJjiqULmNPKPKKf+YyollVRJSap9xv+K3i+fZfiPIm6AwwILN5FSShH+PZBoQdc1VMScK6HVpLIa2pSpQZ3+s0SW0CTpyqU4VQIWQZ/I6u23Pv3ZEoALREXKVqwhGqKwjjZarG1GKGBBIilYcu2TlhVEWmU0MXSSRN9VW8w/YFFSCk6o/qOnnuA27LBCgd46EK8MATOZJdq8gEYR6iC0hRH6vWvQYjYoEJklKKO8UooL2GRTDoxMkuJKDhE4kDGp3itQgwa9OtCEmqj68NBDY+KJQxS3RIorQHBQhAKGhlyQEVpV9Q9WaBBJ0DkKLJCIKwu8FDacLtG0wIknMdkQgApcS5DPPGnTLdF0f2saQgfwNII5Nrz/QMM4Vif0pkEeER4voYkg6LXXSY7FiJMuhW0JzxoKFM1AcEdY9+acC+SJaRErVGZDl1Rygg1MuGKoVawT65h+tVHVqBH2tV2JK1AyPlDLK40wgitLtzTtPwPfedDSrpizLcMFBd3585rlrZMnoQfVozZdaQOsViKH/IJhBMk+m1VIoIEGBy0YwcQCvo9gSeIDXUbKCKREaZD9BZX+okCXLHAJcJewAOWgR0DhDM4h7HnEI5ZztIXwKmkNjNACSkEKG5xgBWxi1HUW4w805Cd8HBiIVY5wQNs8xgJhA0YigLEASYzga7+LyqYcErRt/aMpArHAtSjRiEqAQiEeMAUl/xI2kLkV8IgOVIj0FhK6R9xNIRI4iNHEc5Zx4KAF3OMesThInfDJ5lBGCKFB0NCCcaQOSuvz3wIs4hLKVeIl5SnIJ5oSlWu5QgRmkdArMIJDhYgKiYBsyeh2go5/oKMXEWxI0sqSyIFcixQ40NjVDtNBqR2qaiXkQAi3gJUWICFRiwmRDUq2ALBdghSVKJ0rVgk2bdwueQNZQinndkc8aQuHLyHFK4L3D1f8kBI/NEgQCwKKKcUxkAQ8wAEGeZOIKaJ6OGGmQJbQDSxucYOOGqEY/9ECxBxqTbNRCBmLgDwmLA0sGXlj2da4ThqpJCPAc9t3cDjAVRYTIUREpj7pAv8OZWRmILZaokJqUZZoEesgGhyfCY+wGI+Yzx860mL3xnek9VlAG6KoBDx7iRFUXkIbS7CRvXj2wzhGBWEM+YQrMrdP6NGKJ70opGnAlLekNaQDeCEII05gzZHZJja2CSrVOshQ9NnACF05xwtssIWJivBI91EADlTxiow0CJ6VWILbcGCqyVzrjcCjXB+vxFKC5LOlaP3S9P5hAlsh5IEw6YTqaINQRunHAEE9QlOPQJX0GUEdClCAOmxwBOmEqD+OOYclRPEJVWjjWo+1gAB28Y90mKKPOBTBAAmyWeYNZAJ/LAjcaJTWltp0INCU2CMKqRAWSOC0z1QIE6K2EDT/+CBEh8IKQ/1hgyM9BgqnOAUBhnAKh7bksBwwAhLMoQpVVGICAghFPwZwjQncUKz/SIQIgME/R8LEeKUNr0EECjHqHeS0/+BVoNhTEJlVxgKDc2pWQHKEF3gki0LawnQcc59nDAEbBBAEAQYBhf5YUkgFOcI4TFGMGwyAFwKhRIPseIlu/KMbKeijJiYsEOf57Kz/wAFCrPsP0oqXgLQib0ueSJADUAy9B+lEBrhbQRts86nevE0LjlBY2oVQgxrrWxHI8I07qKMLDnjDG05RYJxgpXWNKQIMruEBinT3EtUY4KtAwTMb/gOjrxoiiIF44tLWwhNuLUgTDQLjg1iM/wGTIEY2oIOQqqUPCepQhwpOoQLA2kAxh1mqDdQBhTIQgA56/gAd1NAEFKTBIVkTp1aYQAMB/pDLmrAAJTYdIS8PRBQ0EMiYy7xPL41XEeNAhKkLYin2HoS8FOOLq03UiVrYxzr+6DEK/vGNfzjgH08w8lRGBIVRfoMAZiCAGLCxDeGG4A1pQEHhGLKFDx7ESDZwhQ6/WsRQD4SO8hpIMOmxaVNo6R93Ai9PRkHqWDVSzQTJxj/Coxb3AKMAQBic1mZzWx9U4AMCKcM/hHuKD6CgCww9aoEJcOwPnEIQD/cBCgLRBAKcYt8IDclBvCmbIqiCBr4USCOcVxCrEsRKHv8QRRBBPIt2k1oRaf5HL3LwT4G0mSGr5m6GkIRQSFVAEN8QxBP+wewnfGAIdziFAozw1ycMYRuC2EaAv1GBqqkhCS5AAQQaYpWj3hghRUAAJcxRTE1YyiCbFYg5zj1mEeNEGyR3+dD8MjqiAKjeLplMBgow50mK8KkcGJ5Ajv2PIXygCR8gQLP3/AQVFB7iFq+AD4TkDzBc3RsVwPjfD3WOPkPhAT7YwjavwwRcWMADlHDFJVLQ3RwaZO0FUbfc08orMGHiHGnuhQlWLZDUHsQoYfnnWCaBiQwgqXtV8UbinyAIgXxAEAJ2eLP/EeCCKz7AgnA0o/qghjcQgADe4Pr/bfCsgOB+ftr8YcIFQEF2UohgMgH8ckI8QI+UGwS8qAAVTjYg2tl3LnQJpGL5QjOTcBGXgCCMQAxvwkHtUhVGgAICRgAAJwh0QH0WN31TB3GQZ3GnwD3C4g9JEAgBhgLConkhk2vqcArPIBD/hg2g5z2tAHeplALupwkr1RCy5386uBC/8AgCOIALACCJcAmdUAmMIAHWdEkb5A8+0AUqkHguIAiJJ4H/IIUDsQ3/IHVAJ3Xb8AZN5Q8Q4A1B8A9XBwaJ5wAmyBhHcgr/sIKCMAgEgAKn4A19MBtFgAsiUAkZ9g9LAwrAVBBus4Ock4Z1YQKPMGunoSlNwQwmsBgt/+AGbmAEgDY+BQaFb4ACKCB139drA2dx33AKxwZxH1ABaaAVEBAEqEgGdNADd5B1FfAA8vUPeLUVwDUE/zAI1Jd4p1AB3vAAWGEOGABMGXYJm4Z6ZbUQOSiIOHI+h7UjXjMKOWAVL1AFAzEHXPCFs3EEp5B1h+cDD9CF3wBwBEAQ2/ABH/AELrCJu8aEQUAGWxcFfZAEEOAPFZd54tcCKvAMDyd1Wfh9w0WCDwBYTNAIo9AUovIqqxRhCyEK2mAqLaeMEOkQIvB+4mA+VTAHc/APFxAO5SAEDPVkWwAF3oACLvAGQcA9EscGikd9AmF9o/h8XvgPEHAHfdAHZLAD//+AigbAhEv2aFyXHQJni+NYff9ABwTQBCqYBkjlChdgCqvSS+yHOcl4C0FDCSSGE59gYgwRWhF5E3iFVwnBAj/IE7zHED1iCRdQPggwB+HwDyHwDwjwD4GwW/P1AMJFAGWgFdgIAQRQAb8mYFp4ChCAAhUwj6fIW5+0dacYBVqRdSqwdVx3BOrwfVlYEIKADS5gjigQYD7wAiUgKgypCRlRKt6WEJTAlQ2RT+zWlULxdQ3xJhFzcwvhCSfxbg7xJGnJAUIQAlzwD73pm8tQAl1QNf7wAHJ4Cm8weVsAARDQVFkRBEvWfKcgBqegdRDgAjJ5B1tnA6HQW/9wB6g4j/7/UAEV0GQMYQBogATfYIsoUAYV8H3iSJmJJwhN8ABGEJeo4lkCkYwkNmoIwX8iJxBTwpo/EUKuyWaPIJsNYQJ8YQIs1hDPmAG6GQ4UygVVUA7hcAFC0AMOwDpb8J4cqJcfEAR34ANbJySNhg138A3l2ARWMaLNuTG+YANXoAD+MIZBMAZMWAHYMG394QMqsA3q4ALfkAYAtpkSeH0S6AIq4AMYUH+BKBDnhgXHOEQ3EQADUVUCEUwEChcHyl55I003waBrcBSA4hCkkAjL4A9cwAUYaaFcIAR7MAcgMz7fAIeCUJhaEQSn2I5PZZcFJ1zb0ATvmQYQ0Aeh4AtEgAUJ/1Cj/jAGDwABsPgAb9AxXPcxoHeoPtAHkUqk7ymdT/B9RmAO0PBDHmAqAoEDomBdydgSLQcIISYQnzCgXVoX5PUINYdAACUQfIEcGQAO7IGIRSFjNFNiNvAHQlAFXWAGbpCsL0BbHOAGZnAED/B0n6gVPuCOYxgFAjGGfQCGbGiB5SgIqOgPRAAEiHEDMkABTYWj85gGb8Au1nE1uSYQfcCc/9AHm8qckCcQLjAEDyBVNIAqqOJt/nkTWdByD5l2tfoWzUgWAtGDk9ABvpcQiLAGJnASa4AJa5ADANIJyaEL9KYQl7AMNlAFvIiFgaAO/rAEf8A6R6AEcyAED/B92v+HFe04jztwB3eQk0GAeVLHoqAoXHewBQqQB3GQAP9wA/+gAVfgD31gqO3oDy5QAdRmG+E3EHX4DzsAAaF3B1a7fN6gAMVgpQJhC/8QAFoiCgDaE8dAEHLzD8zQsJqhcwKRDLY5UIjkCXyRA3y7EpMgCZ6ACIEycwphCaPwAlAwBJc5CFvgBhl5JGZQBX9goR4pgU2QFX0Qnv6AQf5gAGRwr0FwCpoIdBI4ov5wD1eQAEx7AwmgAa8LtZF6mNLmELdRpwOBk/ZqANxacP+QBpbgdllARFggFFiKEOfxNnSLEOKDEyeRDX4hrAzBF5NgAtWbFqThOUYhvUmyANpgBMb/iXn+gKwIYAKMIARCgJHh0JtHMI4ooBUOAAFkoBUkMA8PAAUGcAdRYJcWOJ9l8AE6KgP/IMA3EAERoK5XUAhgKJPyO7qECCIgU4eKuXV9sAXfqg4ocI69BA1ZgAoDgbaoUJoHgQNu5xAPubw3caAI8bAD0QE68gjmxRCmYWoNehTUmwGCy6uDWxD7AyegkAM1WwGFlazhkJFt+ZYYKgQ+8H2lmJOhSxXzsAJY8LRbAHqcOJ8BRgAnyQ83oAEDYcARIAMJYIp82qfy2h/UShAULBBN5QMP9w9j8A+tgKUe/A+sAMLFixDn5hCigANom6qYIxCricJxYQJ5A3wtQXMP/zEJa4BIvMFdnSARBpUQonAEJOkDQsAFRfwPc8CbbZmsI5AGRzl5OTmPAjAPAvACrCsDN/oAIzlc1Hls/+sPYhwBAmHASysDVzB5N4qKQfAAaeCj4oQEkKm1FCwkzUcAwlIMqJIFtCAQyDAwQSEqHXwQjWAqwkDIb3Ec42AreYsQDURQAcIbDZEIfzAQYNEALaB1SFACVfCWAhEObykBsREEQ0AHUDsGEGAALXCuL3APN6C6LBsEDuCPiheOp3AHqXsD/HDL/aCurqsVW8ucQdAE38DCI3MEPlln4XcKD2AL1TwLz8wKIda2dqENg6zNCYHRCGECnpAIk3B3LYEIyf9BFIkgCQdgAzkAMAORCPzSLwVBArMgAAlgBATgA0ugyRdZAr4ZAqzjD1WwDZkIhuXKtP+gtAKsy8WZdN/XfFJHB3l5D6+7tDcA0btM1e64A2Twv6ToEMmVrwIBmZyUFZ/o0dIszQRRx0FxjAShlcio0qKTIZNAzn3xCKPjF+MwEEbBCAfgJifRCTAjCZ3wB5IwCTYhGls6EMXgCw2ABC7wAG6wB4EgBH+gDEZgBlaBGGNAHRWgoznpD0hwBRHwuhpgwGIMBf5wBwAnjll4CnmpAFcgA+oaxqurFWRQxmlwBxKYiR7N0iJkbSOzBRdYBNcACCd8AoDcqq3qExBCq4D/nRPWqxDK4BcxpwCMMAKf27IXMQLa0AmRTBMzNAUEIQ27YAyzwASGWgHf0AUvcARmoASknBXaIAQf+hEPoNCbcAW1TRAHzA9bEAQCN47U94lV5w+Nyro3cAV58Lkj2gdgcAdlUHBDYHF5WswK8db5KtdtvIv/gAXGUAyvOhBYgNf/cAvMTBCtIBSN0C2rsEc8cbzidaDg4xB/Ii4F4RfLITEDkeR7MQKFJCR/8D3Peg7skxSi8dMFAVpM4APegA1b8AIXUAJC0Alo4AZVUAXhsARz8AAucKMy6Q9WLRD8YMAMfQM32ny5iA0CNgiT5xEacA8q8LlR4AD3anlNgJ1V/2it21CYDIEGg7O1nGQVUfCeW9cAWVAMefyQWQDktnDCdJHSDoEKeRyRQ94Q31waYzIQtUAU7j0CUYSe/xAO7ow9S3UePD0QA2QqmoAEDwB6ccqRe5DUmdybYR6peJUGIam0S/vFxM1JEAhsVah4kpdFBoBXd2CoaUAHfJAESdCzzQdxnUiKJmgVPmAEhzoQO/kPLhBcY2AD/fCQthDvnR7vA+HpcgFMZbXddKvCEHMQvYAJRvNSA2ESnVAFiLGTm8wFFxAI4VAYv3A2iQAKXEoQULEAfwASJTAHVXAB/1AOmhzr8vwPJUCtUYCK/5C0wu3F/4DLWp3btmiOEghxuP/tA+0YBABXZN9XkmpghgHGa9uADYlncN4A3WrIW5tKr3gl3cFlKDMuEPZOEE//D7NwsDmhCaF1C99NFzudGS/iJCNgPjuJkbEOpyVQDl1hZStkEDVwQwrAAeo7B+UgEL8Zz+UwBzYAAWNQ8qmr7F98ywnQVAX9D1gcohCQBlb7D/9bBtsgBvQZggA3EONoaA9XBtgABcJMSVtRPlnxhUHwntuAGFIWALMAqwSB9VifEBNA9TxRwlmPGUvxD7zh3mixmlohAdQIp24Zp0YwDi1zGRNpFinSrFUw97+J5hTKyVFwiocJ0PcwwLZc21dwBYGOBkGgbAMR9B0omGmwj9f/JwYuUAbcPnRAPxDeEHSMSwAOkAa+SCwOyFA7KSSkG04cgAt/bBCjzhmq3/o84XsAkgEme7IAESJcJyRL/v2rMocLB38PbCyx5MrSwW4H/4mwtMwGEi5c/pWzWK4Kl3AXwv0L8SJKkH8s81D4l+DKvytXbtxD429Lw1P/BP0TQ4AAtgpb0vzz8e3bvyf/vgn9kETNnZ7/CAz5d0qQ1iGCKqR5AOWBRTT/tvzjYGSnAX9jTqmAwsEijWsCaFn8Z+vYQVl4D2bxG1jwYMIWb3ko7PdWYsaNHT+GHFny5MKTMixY0AIBlz2bS4QTaRJNFDI+jowgteCfarwMSI164aZE/5UQIw+GO2nx5JYgpA/6gxLnXh516nb689eH7YMKgrD++0BgGwp/3ghU8KH1ydNtp9KAUUPgzpAPBwVtFURgqzf2W8Ke/Vf2RYsjPvz98/etwr8j8Q/aGIwVWygjkLIBC0QwQQUXXLCTgxbIwQYhRgLtHy7KKacEDiAg4580XqjEIlcOcuIfYlZb5oU0PPJrjhB0++cPCFiC4IEtovjNIghmbMKbnZj7ST31TvnKhTLU8+ENQZY6RTo61GjiIK1OEcMnAqL7oAmwoEAKCh/KyskGDmzwJ7sKsIGiLIs4uAaGg2axqC+/4LTIGMZaYfAfOvPks08//7TIk39MqOWfF/86ubCK3P5ZJpAj+ujwHwhsqESEg0ZE5Z8OLBpBxQpC8OjFf06q0MI/+ggiVR9SvSPVf+6A7gMUIHDhATQeQMEqLIXi1YVtCPDGh/0E2SY9OpKg4w4CnMrqG2x+7W4bFXx4YCy0HugvPhteUOEbFbyx8T68sPjnGEAEEOCgxfBa96BiGBNFlDwzDWwvQO/FN1/BEEnmH0EXeGEEBPY4CKSD/tiiwyBmfKGcBS45iIQADkrE0iVaqMCBCqrYo4oSOgrpHyGOSCNVlg6CwKKeZD2KWn/uODK9oGQWMj3vUiZAK/GQLe+fbYA6pbtmv3lg2moPutVWf1qw4YFC1Ak3MCP/3GTlIFvgLCaAif0qRpo9/aJX33/kFLtssxlE5KAcxskMJJFIwm2OVVuKYocgXligFMES+aeGP3xAQTr2PvDmGxe+CccjLi7w4Q4X0oDVhYMIoCM9obzzpoI+tkDhg6ais0pXQaJzwQXvfMBGyDuSaELyf55Vj2gVtFryAWz8qU9c/3A/wogjchIMDSxoSRcLW4zX8yBkLHoXTsAOAsUvUXCQjGy87D07e+0hS9sxT0xwsAoE/pkjnPERYGOlVHfgMIolLqmEFLx0SCSRShQQNr3Jzzuli/I7qgIHKnCVZeXqA9iwCgHK4BUIbENLENiPlUi3rCtdaXRiWEofKtCk/zuEoHOBOYULfKCOU7zlFGH5h60CM6YXoAFMFrnPTphwEAHsSWvv2gVebFGMqgkGT9DACw62piA4YW97R8weInqRgxw0xhM5qEUnWjCHf1xANyFww6pwJKkg+AAJy+CbX4Bhv048oCs/88nP0sCGkyTECA8oQ898dkBYladYpxhDGr4RhDRcySeS+4AgnvAB0JWHWC4QxB2ygw3PBfInxHLKNgbhjVtBQQWDEIsPkBIYf7xATLoLTAuMAY27FGNPWXhePyxCi2IAJl6DeR4OcBC2BMnCiEjEpb5qUSgTMOZ7mJhEJ14wBwwhBAHmw8vJ/hEFGywjMD8YYw0WAAEUBP+pJ8v6RgiOST4jpAEF0ApdeRygs1OoYwwogNVBfNWzCobuSh/YxgfuUCywnAIr6ilPzraBjRJWQB1lCgsU0vA7NMAHhmMCkH84SYMZ7PBdzbiLurCwvINw4x/QaMQ/RGGKg6iCMFl73mN6mEuSIrEXyihM9wr1vReU4CO0SVwV8LIDSbXkBcsYRcT+IYcxWmIUOcDVeaQjnX94gwtVKAdnhFVHn83xPPAkgAO4MIaDeGMbiAynOwPpKxfI0wFp+KoD3uDN0UEnnig4BQKrZZz7YEMBaVKTXzr5Ak0Oxh9FmEHVZEGLZvxjXVnAArkOcg1kZMEUr/wHR+cUACAeZAP/kaHF1x5zoJJmTy55UkQvCmMCT/RiDTkwgwmGeSGSoKQK8AkCTQ9yN1eg4haoSNc/gPEPUlzCDLj61bKs4oIldCQcQnBDGppUniodBFrp2UYV8HCEJjjgZ/L8QHH9KLPnOuAfDrhDGraxBSVUQAy5egaRTlGjIzwgDUQxC3KMUIFscfIFaKmrYFogAGmki2wjfdc/+vqPYphiYq1oBCUGcyDJMsYZkblFbCurvcviSxGeKNQ/lPgPlOaAES2gEEqqGMAZbXG1/1AALtI1AQschBSWEEZwpfOTQIrBBWw4ajhCwIFTfCOQoPNZPOOZOSFc4AHicZ080bjb0f0snv9w/wGsWhWEByjBAaDzBgS2sI0xPOAbQdsKFMR1BC7Z1QhxDZ4CGiAAvQqGFsighjOQMQtReAAHolBFRgcDCLxEtDG0XHCe7yWolP6jF4L63j+EaQQLvah8QpDAjBZmEZYg4R+o2IAX8CKCYBzBG9XUrThJwgUhvLECueVVqKVTASEo5AjZvcM879AzySmrZ0X21XXHEARFIkAIXkkhc7xkBhOmtQJaxk957dqC9xLGH0i4xthkUWBayIIXznAEMtZskSx4FE8HyRQrpWE9PXfb2355omYfkYxHUNgEijiCOmwzKi7MgTcWUa2hZkCCf1TDIiJIhDiO0JWmPOEZB1EBaf+XwAEUqEM6YpAjdIjlDSHYugtHCAIZaP2qo6gauqvuKuReFYRZkwEC3lCCcYTbHDT4wORjuTJ7IKAmkwsGOcUeNi1owQpbBsYWyGgGMpxRjzNfDQetwMEE8JIF6skCBwX+dtIri1IT5KAThUobJhCRDUZYWhBdaHfiSuAGD6WsJS1BQwBA4ISKHGQEl1jAEUq4rCA9I+tCQIM3lbJAoUDnKadwgxBKMAchmHMbZGhVqrOLRkASchuO0zisHBCEbfgDAQY5E9FMPnkIUOsBb/TBFr70AJNzQJNg8ocNWgBKv6CBBjn8x0gtgnN7/IMXwxjGNRJgjFscHRq32NosbIH/p2KggrJKBz5JH9HLf0DxIChVRPJzIECrVmAOMv1bYLrIBFzY+yCWWMAoujmEYokzvM8f2aqadIro/CqQDvjGEeawfk5LgCVUZUngkYxkWqehPKhmNATGEAXkdLflaEEDDjgO5KiWQngA5ECOs9iCFsg8ufCHMUmMhMILWTiwZtivfPiHfOgHAWCC92IaGsiLspEFwQq+EoQMBwkUT3iw5FuDcxCWZ/AG8qmCkfE6eDMUXNCES/gBYkiNSACcXRGDU/AGRSkB/DGva9qGoLAKbxAEKEiIOUCAzxiL9zsZVAuCxfsA7UoDyZGcDimZVGELfxiIB3gDI0DAM0RAgDuC//tAQwT0vBd4gQZzOQEwBnuRNor6B52Thn8YBgXADxtghWKAgWswBt0DIhL8k/0ywSNqAT8JtE1Rm0FRBmVghBfAlW9Qhwo4JjaIL7zYAgv4BGiylFdQBj0ipGcpjy4ADS4wDR9Ig+ZQj8nxBnjyhhK4gMUpgXLwgUiZka9jicWLnFcRxtXiuCAYQATggSV4BkKLDzU5QzVRIZdDQDGJwBkol4PIOWTAQJH5ByZoRB9IKBowhgS4BhzgKMCYpX9QsAWhuWFQPdVbxHjECz7zlz8AtE7IgUkYgRaAgkF4Brf7h63jkH+IlINogQkAgh/AjBRJnfRAIOkYiTkwAh8Qof8uUI+f0a3ucIEq4IELsEUe6IT2er+WiLgrDIJWmz/rgr9FQw400DsuUAcIuA8w+w3NOwiDkkb+SAwOwAIYIDNZSIWDODA+hLktaLAWGAe8gIaQApTlwUN5FJtGxBcFoMdJSIRJWIJOQIOnKJxbnDGv87ouKoJgKABiCIccABwyqKB/+4aOCIGGCIIj+IYYFAQm3IZvKJZtEIJ/2AwE8Es5rClG+7qj8MVlWhiqQsAW4AFbM4OziCtQ0onGeECdTIwXEIK6gL16UKVrmKHAoMkZggZAaCzCoIYEiYN/0Id8yC+oZE2/6ISJsIiJyABtGAeCw4Zn6K0SYIOWoKkx8Lr/uPJByyEAGyuqJSiHkTkCqvoHFXiAU8iVqhDCJSgBVbgAv7yATgsXCLgD36Sq7hRG6yoZ5YQABzgLBFSAxRQCP0QaiyjPGAJMvHBGuZBAYyO0BOgHGHAGAWDGwiiLhCJEIEI65VlNi8CzxKDAfxiAoGxNQJFKi2hQQFmAiXjNf8ABCcAVIayCKuiBI0gZsDwII7iEZTgHahod6bCxbeA0BaAPCMCRasmjIAgWpfAGBVCCErBFBLjOAPKHnDgL61qtsKSRB8CRDkOab/AHBZiB60QCNnS5+MBJu9qC+XgMI0CCI3hPzyS9E8CFyCIMZyhQyUCGAWgMeFzQyHhQsUkE/8wwsQW4gBfYhgUyg//ZRb+4A39YAnPAmCVRj2FRB20wg/p4gIWBgKPYhjSIQUnhvBeg0iXwyBkoByV4L53QiS2Av39Qzq/rTpPZiX9gAzfwhxJAADxQAkdzUqPcAvhADoUijJ3ggBfYAppEkILqnQZ7gSK4hmJABm7rE14o016NTYtgggVQgAXIAEuwAByQi9jhghIwA1TxCwg4gnS7kqY4iLGAgk7Iot4Q1KMYBOlAOaUwAi7IgXOwARvIACHgNPZEwD7wELzYIhoxxpbsgU4qtSXYlmxpOc/LPE0SQMbY0fd6Uk6ijGhdzxP4h9PkC2fQVYtADEBZWF/tNoN4kP8FOIEJCAAaECBCqoB2i4KBLMgUag4UiMFBTQOPKYEX2JFejAI3aM5ZIavhZEYh4AEo9MsJgaEzPAtY0T9LLcYgSFUu6AJ/WIDFRIAeIJM2bMkyKTlj+w00eAHS6xMk8AH5vFVoQAZaODBY+AdfwAtKEDq8+FKIFdvBENZfPQFUIAEvwAAmwBVfSYjdlL4aaQLLS7mE+AYf6EVlgoIPcIE+qCYUEIM3OAIuQIBICAco3LtHlVj8QMO6StlUOQrk8AElyAkeCMiihbmclExiy5cWUAcj2JYTaIBj0Dk7Sx6LIJffy5OnHNtF9IVImwEjIIDtkClL5U3VmjUf0J9qUjf/kqiWQD2Z/ojBLYAVfhJZDqgCa6RZv9wMJciWI+ADhjjD+LrCnLiPHvOHTlhMGxUCCQxY/EgMccGdpwWUTX0BBTCC83WTZIOFYgiFvoooPEOX1qVfyQgFAUAFGsAAUBiL8iAYUampSOmiGvsVN5jBLErZXgTZsagxbzG5I+2xC0BcUK1Oo/1QCmCDAUxAVCkL9WqDFuoBvsRRJZhPToLawVjATvSTVEUCZ2gBJiiGYygGhZWGPTyIvcCBdAlQsWGFoazffJGfwRiGUNjadKkGeTiCD1qKvfw6vCBMn1C3QGADDvBNZdokpPABFRAEFTAoI7BcJQhIbVgAG0UAY1AC/zO4Dxu4gvQ8Q7xAwC9YABvgO/JBgEBAgEhl2sYoC4bAF/gAhBMAkB7ihmPgVQzsi2Jol+0x3R/GFzlzDBBggjQYpH+IoJoaUkkpDaeQTi6gVAX+B9WCAi5hjlMApZZSADis1XGAQii8gB4IB8lVgh5AADBrTzPogRFwKY/0yxLIAGKbvKn9XsZoxCvNEyZFgn54ASwYgL6QBWQYKdibQEaW5gmggsHg2nfwC3iIZKZYlnarAt+YkR2gKkUyg0CYgxZIrYKUuCtOoUKoq01tASM4B0YVAiGoUb7cO1vjATTmyx74gv20XjQAY5eyCL6z5yVNVc1rRZMLZoswgIMotv8TDoxXhdXGuKwXSAAFoAEYYAUy/Yd6SIfCoDM+8WFpxqUJ8AL89Su/oAK2/aaf+BQheICU6Q2UwZGORZVejDeU8RE0mBYXOotG3IIqUAKPDIR/QAIbCAdbBFUo5AEhOIcWSColqN348Ic/6EgRnoNIeIFzGIcS2M82NgsOYMDMSwwjIGbCCEDPq2i5io/RQ4uNpoFioAUbDoxZuAV6m5NZWOQC2eGDGAaTXpARSQyheyzB8AIqAAY4GooyQFHy4QAX8ORlIkkOqcFPNpkYnNV/sAEj2IPPhkN7LoEiON+9XFbyuQAMQEbL9ctHpaKWVIJIwGfELWpVSGq5QsOb7Lz/Kz2CFvqHhy4M+GiBIkACqEGLm4zAmZwhOPHowtiLdxnKW3oMe/lrwS4boaO3RMaLVygA3MqxtgwBM5hT1fLQnbZBlljAtCCYEvjsGTyAcijXEZgBJs7lf+CBXESCBSBcIcCDpVYC+1CAhkMAKqJZXVYCQvO8J81ts9C8FsBJK3UMo7QBBVAAEvqHQWBO+zhh3VGTImiAqkHYyfoHOjuGkp6MmrPuBAmRwVgCAWOMecA2wgCCH9MtvJQpVmxilDkIj9vxsOzFI3BwGt00d+C0KtiW8WFtgq5nBBgHGyiCZUDGEmACJI0EPJgDf9hLmqUil1JtW0Pokpta3E7DGIpW/7lIC4leT/lQh4OgA7PKlf24WzTHC1v1hfzS2sKwHr562MlovX8w8RRvDFJQT8FQAEp4BbyYhSFSFwG4Ba4NUE1Y7Cb4lfRwAVWcgy7A25lCGfLeaZaQi5FQFNwAlX9ogRE4h4OQbYtYzG1h1BnogRIo13HwjOdrASUwn3Ybn4Mg3As48DQ0C9+WRnEpOQ4AcsYoixZAAhUQijtwgLpbsVPgxBwJjCKAaFnY88KgOT4EdJIKkRXHC01ohUxoLFZoh8YIaaX8B8VuTqL6gCHwBoKRGyvOcWViCZORACHojDbiuz+4ar5zgwxhah6IhFN3ByVAgBrdFm2w5yi0ouJmYv9cCMh/gHjmTc/fSFUsvtIzlAs06O22Xk8OQAIFEARsuLpvOI9BSB2f6Im6glpbeK8XYIVF+PPB6AsirocDk24CUUSrGVB1XEcTlICyaQdo6PnYoqzHKpHAIAdgSGIsgY7h5AIAMILJzvFnPZhRQaoZrILQZpEqQIK9BGNqB4mPUYJxQIMlqFGPyGfwJXCLaOqAvM4mullx6QMVduM1pA/+sPs0d7SnEIQyiKRi0S0rYWe/mIEZagFW2MbByIedP4h6aIY9DOzGCPF/mILGGMpFlpdthwx4sAgQaAzQ94tuCIYyqqa10wps8Bg2qMFL5XG/OJklcBHcAC4OKIceKAf/3MiQ/miDDPgHc6hRHJBZprFvi5iBmr2Y2CboiB+fiRcCUnXjVCV2+MDJLSCTJT0I03BMGPqP2emKngD/g6DWyXGK7ScLWkgANJivCPgHR+g2irqFDdh8GgqMa/+2oM+XrRkiShg7agaBVwCIV/+AEVvw4hQBQQn/DXFRgQsXH0H+BYGw498/ixg3bvTBJVyVEuU4nBMyZ0S5cFyqcKmggANGIRcu8NHmz5KSfxc2XkBwQYm/ciX+IeBp9MIMJBw5+vOH0ccWjGg2HuHg459TqVA3Zn3xT90/Qae2/dv2jezSfwSYLsWSoAgaVv3+6UtLN5Qzu3r38uXL6l+zf7Y2/97qa/gw4sSKFydW1eofvH/QoHGkglHgD2AdgHWyceqUILNht3XhUsINhMQQ3JSoImSBv3BCwqn8NwdjCST+tP0zxgWBErgzevQciuFfiQs8OqFRUhT33j17m2Lc4gMmVTQ2plLHyAH71BYqtm1DKAibWIwf9H6OGlXqvwRY/LGC8W8R32GcGG/cvzFwYsNgVNgxsgigVyjI8Lcgg3ZJ0GBaTvzzyT8bbASCZBjh8tg/lmGkw0AZ/JNBBgcJEtY3gqj4TUgOQJBaRktNhJFFEHQhWw4tlMCFOCrVVkI4aLiRQSA7zpHTC0pwURxGvyGAgBD+uMZRJGk9+Q89fXXnw/9V/2xRFVNZ/YMGTP6goY55Z4W1FgHlqWUXCjA61dSBfx0Yi171bETLgv7RlZgAgx3zF4SFGnooX5Y5AcIE/3igVyYYeSgCR51gBEwGnn2DkVhiucDFHEv4QAZFMJIBI0anBhHFP0Ig8YIQGIUwRzkb/dFCFUoosIQQXCjxwjlKXGDMP7gMuxFwbjSHXG8b4cIsbiWUMJWXS2XVVFNbPPDPVUaIidW3U0GhwKYInfIPQgSshdG6aaE1ZlNxwGAPGljcIOBGqeSD0SJ6QpgLPxgdco9iwxzIEaGIKrywYhRiBIqFe2kyIUbE1JIMIhgp8E8nHXTSwljfELCpitu8MUf/FT68yJFGG7H6zxFCGAHrP7WG8A8XGPVi20q/lvArEkrUitFOGCFQAgI81Iz0bRyByuwcR097GHVbWNXCEd9i9O0RCqAXslkiv8nuu+yqddVUHDh1zT8cHOwvRvY0wwoyeUGoD36K2ZIFRvgmjO8/CTM8OGIt/PPgg3w16uFGlnmxVzdyEPOPLgUsxUgHk5hwRLrYfHDuP96EsNOoEEw0hl4vdoGEDUosYXQ4TR+A0Ry0+uCPEDZkoIQ7RiOAwRxDJdeG4ee0EfU/xw0F3UbSvvfedHO+cMRVWnN0ZlignbLpyOpy1K7Z/1QA3+1YwNCPDWxjBPdG3Ohp94KG1LXY/2B6+Uk4/ocZrpiHjRomwg8wEgOMJGMSaTGBJV5AgA+0CXRP2EYF5hCOI7RsKaiiCMyEUIWi1QwjRzADzpbgLae0YAFCY1pRageA2ygBhFg5wgyEkBy+IGAO+zvMFvzhgxcYATtpoZYNKrCN9XzjA9jgyHq20aZ/rEddDPzcptjCNgUkoBmLGIY+9GEIjAAII/I7jIKW4gh+OGIjWzRMMfZij/yxkS+JYwyG5hGxw0jiH3X8hyXsMonOMBB8ZVmCbIwAoxnNaCNk2MER3KCEKjTtHyARwgsUoIQ/3C4rBuCAEsJxnGcBbw5C+IIQeKCbamHFCP9QghCKMgOicAQ4Xv+hGlb+YYOrbAF6TBGPQpa4lih+j10KOdFGKmDKMWXtYDfYV97KyJEy3o9BhiBYG5fCi2ge6ob8CUPkumEYRhyGES8QBANdwMQiOmQOITCDRDCIkYuskyJbWMkFwiEr17zADJDsjj+i4o8R8KAoF0DaT0QVyy3soKB9AJcPqtCD2WwkZzpBwDAR449ZOqWWekHC9shzHiNipGxnKWL4mPgPXo6JFdfQRz3qkUVkDGAjgNvEYpTJkfklRhb/AMTCukjNnW4EHyCqxj+0aRdu9kUSe3yBC9TlPXBCUJ5VeFFqCkkqGsFMnkNbyT8OkDM0dIerOZRlrJDzJCVkzR8H3cH/If+xAzgYNFu+Ksc1OPiPPyglMRzwivU4cgQVDGEtTfQcAcSwkAV29B9i4EgRUeCN99jgBdKIQD1yYQ9O2CNgG6nHM5eC2XtAAiM0zVNaDnGYNNriYDzlqTUPd6hLAKNBk0hGIkDGQLSs5wPfcGgf0mA6dZYqCH2AwBEQUAV5/qMKIagCcpvCnVhyxTRP+onapmqXgh4SAk0xoVD8WdyIHmaH3bELGjB6IieOt7YbSeJ5kbiW/RXBBjDA2z9Uup8v3scehrBvPcq4iM+m5YxpgSZGQgE4vsRBAAcy7fpEe9oF8ycDwODMgjzxjwJw8whKLYO6gPmNcggFNdJVlelW/+aGOXwkHOX4SKzUZi0xTdQ5JRDCH/wh3VQZciNwIEMacpgDoa2yKCVogQ8NkzVqkRI+RyiEIIZQHiVuhE1ONhswm4yRbWGEBvbZ1z9ycZ+NHKIeh9iiPjjBPrsAeC95G7NeBMCK+h3jH/D7R5kZzKBXbuRBXkmtXvBcKExwJGPEyMERhLjAda1HdFWA6FWiGoWJrIpGY0AZRlJS3CMQ2S5OQYO0uMADCWSFnTGi6j9OVVAx5NAImVweVw6FBhV4AzSE5chhk3iK2bJrPRx5lz8UAINY0BQZ9uCvPe6hRY7kg77LXIqCZ7qYv2ABFXzjTzPljCgW9EVE/zAggwZYCv8bpOEzhcWIGD6wB5WIqiKnyshEdvsPMzj0ZjgzA7b0MpVS9wBK+YRDX6RbUELksBPCepYSzpGYF3RpI+4Z0z9MeaJTCNbWTATNENilVCV+jiNP+AcKNgKDCORDpmRWZj5g8Y/7/gnZXuzvyBk0GFQs5oyfFbm0/6FnPZ9WwhuRRAESYQNvKHmJHPlUOC6QMnVjpCIbgYAZbrMSBISDDVlDzFSCR3DDXBAj+PaHOIjjyddVmi9dX0oLFKAQ7qlnI1EmixLLBmuMe2cjeMvHftMiU5u6HTGHsKzA+MMnwcRNMQpSKRdjDiFqD85yNi+A5YAxAgV+41xl4Ag2vLEHCZb/4AjSDYLR0e2D1zkSZyFwwO1saWmsmME5QCHVjKer1o0QAiv9/McM5pBXu7ygokt5jxFUsJYknyjK6XmTEp1oF7GArgX14DhM97sJ9rVUL/5lEJohtEZ/3T3AHHmz4PnzRr2M4x+1WJAkPNEBjNQiEeN4AB0+cHG0P/AfdziuO7hghj7MaNEXRLp2udAFKFyLaqASQtUxRgs4h2KgQfXoxRaoAFioiEI43AeoSFiA03n5EUecgje8QAsMANz9gyPowz1IQ1oMQD1gGUbIQijw12EoU7T1xTHsnWddVt9pGUbIYFrQXfZByPYthQElAmNg2z90QB11QA38QhCgAAMx/0TvdVQIcEE5hIrpEBJHBEFCxQ7OVIEKPMDsWcvWGEEoGYGneZpi1EpRcNdeXAdfQME/YAM2aM+JHBanaM9hmYVgRVlaXNw/KIUhtNT8PN8/cEJd1EPe/AMy6JRihMI/dFZiKIgApMJSuJxm6YUjEOINKoylMEhrYUQBZMwkaMELDNECqcgCPRABvF/QqYRE7BajqVMXdN5KfAMWLgYacIEG2RLqpQWp9AFa7UAfHNRSbIxhbMUPcYsKYMM3HNEQCMITmJda9N7FEZbDpYUYDMG5EJme/JqZbYQ0yIItIEO/lFzeOUKZJdvA8CEL8gX7YBaciRFHpNEkKowvLkgBSP+CtYlICnzMZ3gP6IhFQjASbZSAN6jbBUnh6NDON6hAH2ShXvhDJyTAHEAPvuniRVzEQ/aBFFQkGEjBP+BiwiFHlvBFVFCavEWU2m3EuaxLu/xSQrCJQmAE6GBEAmxEIDrf/ChILETfXiQbDPLDIezhPwzYGt2kGf2Dgt0DOXKgm7XjobjBRuTALxSKJ2TAAgiSER5RWLCkYLlACNDGMswBG/hAFEDAVx7dP0RBBZTDMnRUGZRJYkyFVzTk6mUkGVhBRoZaRvYBGFgBIVgBXr7BRUTFbVzA16VF7ekFB6jAHdiFCzxgVd5am7waoTHRSG6EMwxYX0iiXYSRTM5gyvH/xU9uRJmxjyPYA04i5eAkjgEpQA78Aw8yxiW21ii8gDfQAaHtHgMKwuRVwT8sYTphxBiwk29lpTyFgAucAkJORzmQFQScSh8Qwl3e5S5ehBTAgV7mZV4mgRUc1BE8BV8YAMzMnhF0AQWSJLokoZMJFrhthBtiRMYxjGjhJIDgCSfEmT1omcftC09ihH9g5n/51wqSJqJ0H6J4jCUsgIUtEFW+ybq4gHHRRjiUwBiEGLpBVXHxyBxUQOQVZ0LaQBsYQR+QQYdKgV7CQV7i23SKKF7CAR3MQQ+kAUa4ghKQ4Q/djl1wgDqsIkltxDb0XsQRVvA546uJ1Fqcizew0Ztl/xE2yt36xFdnjpnH9YUh+udivBHh7YXlWMoCLAgwpEBriQATBNpwEkDEseQRWWAEVeE/LMGitdMOrEoX3EbtnMs3YGie8UAVREUfCAGIjqgVSEHrEYKfWgEd/AMhwMEXtIFDVdrsoUF22kV2Hqhe9N65gI73qORiihRGiJPC2ORlIeJe6ImAPalRMsga3QNMQanCWBuDLEAiUApGVIINuAFCPOAHfABapIgguAAbhMNxYUS5iSVYQtrNDOcr8oeUBIJZScEKWQEcSKde7ilenqiywkEIHA/q/IMCSAdfcADNQcEwrkd6IhETQWCUJRXFpQWbfMCQslFd6FTe6GdaUP+mqZqqD27ECKzmYeSRCIhAJRyEUrELNpRHpwhCaYRDClXAE1LEmvbBzQxNGZxCBQwrf5iaP+wAIXwBHjwBszarXgqqXAoqHHwSBawitzAXYdIZR/iACnxGE9Gh2VXlRplNY84hjhrWXuiDaMXkYcDcP4BqM5WqYQwDqCpMUcarYiiACUyCzSWGqgKDK+QRRixAKVzCArRABWBquHLKmnyOcU1oFxzUbkFAHyxogzrAB5RBGnwXY3AAF8gYIeBBG1SBsmLEdOLlP3Rs3YbAF/RAFWTFEojeUpDJ9WCEEaBAZIIr1p4I2i1R76VIWWBEmBacOKEKCvJHZ7Zc3xAt5l7/Dl9cAg/m0Wq+Qg6kAQqoy/aw5Ikw0Im4gATljBC4SEZEAeqEwI60yjc4wDd8w1cxiD/8gVpVbBt8AR1gJF7mJUYQr9V90vFkRQUs6l7YEhqQCXg2rmKsIbqMZ6TeWlpoT3VkLmMM7VK8A/cODmttxCX8AzEkQimcQxoMwRN5TriKAWiAkxjciDvsQQi8gBuYgQMsASPlJnJ0gQs8AQrkLoM8r1pZgRC0gd52rJ8KakVKwRNIwRBIQQ+0gQJnBZgsBud8VLoixr+qyUjVZtnVIXr4XFp8FvaFrwovzJUexiVeAqu+wgs8g7o0HgPBLxKeixKxSKuYgSLJLqjUBsq4/8AQLC/aIoYtUccOIPAXfIESBEJFstUu1hIVb0EfuMEBnIPaZIWMKga3iidiJARCZA8BaA+btORSPNE3PMMjVi7Oxlx/HkbQ/oMNrrBhYBsDKEYi1MAlmIhiLoQgpEjZmAVLtIAGNU1t/EMXeMMdPKycwtI/gEEIUIAStIEi5BC2KOoRlBW2dHJWbEFgyhsHeA4aPyNf2CFHBHKOfmtanEj6/YM42dLkqnBgzPJGzIId448I4EMpJNAbgNOmVBzE0dZ6OMQRlMZK7AFyqUAXnIULvMEr+m1isBhXQcADVJJyIQGvKAE3h5IQ0EPtefJThHJaOMUDpOy6PF6lLoa5GP9GeRxheMZrnB0G3cFrLjNMHv8DOWTAA8zaAqFu44bG6frSA7RJGrhAGmyDC7iANzzBM/sA6yCKJ2NLC5SDN+/Ik2T0z9TOEuSAG9gAG7zOIwtu6ATWYy7IkBauuc7WENwBGNzzXoRRHevFTMN0g4iAOPCzER4jzX6OWKxfFJ1CGngDCghCExTjeDHRG/iADcyAKKRag8yJmfgDB4QDKj0JBvzO7wSPRicND/CAEFzBoj6yeBBAGdBhFAnWu3gPhjFGxGUcFASqG4oxyx7GG7eRpi5FCvPFXtt0YnSDCLxCDbxCLTxAuNU1YcXam0BABXgDbYJwnLzADHjAHACBwmD/i6mlUkbX0GZ3NgIsydEggFoiRtcYFiqnFzSWBUgpxkr+A/qlp/cwBp40yEza8mKgmT0vBSvorF8vSCn8AzMQaBM8UQWyLAFUQLflUvaMFMZ9wBbQwwSIwgRwCEfo04L4Q7BEQmjjwnaH9gx0t7QkjUgf8V4YQSHIrDurRYqos2G0CwE0ARpuTxnHM2J4r2HAQl0oyGQdSl7rRSwUW28ziECQwyVY2BE+ozMWNwG4AHmQlEOkARNcQBZ4gDEwQaUZsO7SQyr1RE88F+0QRWcnx69sDYaKyxHZFvYq40Yk1dggBq1iBCrnqKUain0sCAkahm0HOIN1g0AwwwvwtBjw/1JgLQX8AuyOnsfv0cEDuEIrPMo/NMBScMlIs8Ud8sA/efbv2EaxGE1Wp5I4e2TbKEXFnRdo0KzEOVm/IkYuocVgNUherCt/FEMq6EOTMkix5baO508NkIMNPMNpl4Upz3itochGxIkoqMI/UAIJ4IAovACRPR2x+gMXZjQrFUUKIQtHCMESTLViqMMTPIEfDXSgz2xiPCA2oGHozFqDyEI94AtvK4bdGIK7Lsh+5/lO9fgRQGDZWm9hnXEUqWSZN+MDmEMr8A0OYMQJcITJRmwChLYxHM2WY8QmOc2v4FNfRAVEz1o+jlMSNtmkxjZjoACqFwpmzjpfLIJ9xtdt5/+CPtCgrS9G0jbIJVxCAJFDETi2MkYR2lHlDUdg70UZCviAKIgC30yAB5DA30I6Y/gDEsSKQxlN0fgE7Vj6jjzvlLeAUjxQUs+4rZlwobBog+hUjtvF+8SXfe8Fyb37YpzDHUEIJfx2DQAAE6TB7i33cvO0uszhMUaqPm6DkptCADwbRqACKGyHU/TQlHOFOggLqnHEs0DHbUBJIZiJBg/ut7VsLyEKGhuKf+jDqxMOp6o8YryRCSjMJyjAA6CAOBEAVT4B47qJSK0H4h6R+zqED2SBKdwCJfyDKJAANDDBA9QVB3gLOfOFOjj8h/PEpUMHD4zQYrSADYTPSX+PSvP/FJHhi7mzUV6LXOWKPUZ0QGoayidUgiqIEJhmWEmmCA4z3C759M5XLzagwLAXOyp4wIEYQ+SrA1z8QxHAhHVLVND8E+yxEnQ8fbMIxRZr8AsUtWyKVGv7Z6CmhQsySBzXXUzdoDIMzgGU/bwyiCaYwz8sgzYcgRHuEtox0UKYMpsj4+cQtQ/QAA4c+y2AAsJviyCoQ+QfwWCO9FSUQ2hLPED8EygQwYWBCNwtQ+CP4UCHDx+2aPGPgCACYgQKEnNKUEeIH0GGFDlSpDGSJ0kiq2cPJSeUL2HGfClB5kttjbR9WmYDBQEC/z6cIvBN0MCiD7H9G7JtYEUUD8y1EhVg/wKqf+0e/HumQsGRf0XQCNwCUwgSISYR/MM1cG3aEv+EKPi3gOEWfzBfcBD4QSTTmn8BBxaYT7BMaYYKJ1YsmNKyf6OWHTl15+c2ppd/AhXEdy+BDz8JbAvdxIe5AFY3bPiHwIiLit8UvLDxQiaHEjZ4/Hub1iFvtwoCGWmIcuw/if9OMcXIeYhSQX6b7j3196jAzCOTgnbIgVVMxCGd/ZP2j+Vi8+dHmjEBcr3iZZ9WdRNn5Fvm6tbLZMz/T9B0/xaHQsEHenCZIIAsAtjAGCM4+kkFdc6pyQYhbCghrRnUWg2Bt9765wIeFDCmhbr+Ke4kDnxA7pRv+PPJs/0G8v8vo+koQonGU+gQ5JmMUBCJr6AwSgqwWJqBiBN9/qnnn2YcqWcR9F5aJJ9N/nkSSphyEKiXK/+5hBwRmLHBG6NI6sjMjIryhjQaLpjgH1RoQAUXI67zC4m7YDLiGn8uEKJDgQCYgyCCSkBiDzTwNPEkNMZSRyCmXAAKotBa3Oaby2CqiL9LkbOIpG2OOuoDzgprJp987NFH1fJEYvWv8bhMDJF/PMGkEy4p0eSfUmpgBokHehyiP+Su42u5nziiESOnHlAAF0qoQmWDOa8LTQUkJoKJK3/cWWIZIR7CQIglysFAtxcUaEhRmB6ogC8AL4Jouub4O4Wv604SCt9/6Mj/NyOI3kgjsFSG+Scfl8gbCUkkr+QHkoeojDUkRNDJ4CRJ/rFkEpt01eSHV8ZJI79vOK3xH6aKSnnTfyl6BgUImMDFC1NQqQoaKDylaBsxusITpQrSZUKBCf+ZQ1CBhMggEkFLeOFOPH2GyYfpQP3sM6ZIZZnFk/nrGqTPogNqmzRQuCOmH2kcKEWZkPkHmWYGsGeRVE9yROK70bv1n0RQ0uYfbV4RqBQFHmgCs8wQrzEzGTmiCBs60jhnBg8m8AAVDxow4hk66hOIDggZfamQIyRSggM8SvgTASEWuACDOURUYbi/wuqpqG0+ixck7bSDziGVY9x6IOHL1C6xZgxp/5swvJkHzBLBgIkJFBEuSWFwyXDnNGuuMf19oGcISMOIEkwxRbVqWiniAc8G+uCbIrJFKTZEyxlaiQtKuODCTtwpeg90o/YXH6BADJnBGvscsj1M+e4h20OOQxC4r68BRYIwkcVAitE2u6HnYTL5TmE80R68EeMvl7hElypxhAp8YzqgcWH3BJIspRALG6GxAS408YlqgAIUmfiHwAZSwOm8IIAiMYIP/MGBFyihBXPYzT/E5YolzGAOe2iBOvwRFsGoAwVPCI2LCugj7pnsIUXByIxEoq+PPME6FDmjTMLTNpDkAkpWqlLzkkESc7jiec0biDn+UYkTLuASSzhCRf8swhlK3ad9J7OM8MTwjReoYnqg+McUJnACH2TmXXdAgnBe4gO9tAANXKjEEg2SFiH4zU/04MAROKBFwNylDNh4AvGwIwhsMFIwFWBgRnaZNsBk8BrSoAVEFsEwxcRNSgNZXmF2QZI1nASQAxnBP5jgR4FcghSjOAIKttFCF5GxjRAUCkWSU4FJjkJXTqDEBGhwyPZh4w4q+EdYiviRI4wFDRxoAQVaYA4hpMWJxpiQENyBhrzoJTB3aRA5RyKj81TwgXfbBD8WQxjCYPQfH9TmR1FijkGm4BJGcF9TRHOZ6pRsIMqRjg+WoAlSgOIEoLAADo7Qr3liAwm0QYkP1sb/AX8woQT+4IFBBBWIF3CBB1Xwhw9asK6aoMEBjXMgSHbptcIIEyIp4yVg8lGkjxxJIB6ViZP00baInUesIxHhP0wwTZDoDaTAGIUNPgAvnzDFhQm0DgHOuZcKHCGbHtAVKKbQCpM+hEXqsIE/pPqRLRQni/5QwhGWWI4ScEEJ/1iCErTxVDR4RTD+eIBQnsBGo1DUj6KZEWC/WhPEdHAgZgXMAEAaEnTkkRH/+MM/+BirPoakEgsQiF2RYDarScohDEwZUQbCxic8YBytsKQo4NQAMWXtCYKwZz4/0hCGIIoDfBjq/QSiAA4ogR4M8QdpBYMGFg3hjTr7JUU4w1Uu/1kGeDLp0UCcgQxlOmRKhekHYkJxx9zOdSB9dIVxFwNhkYyiEsAFRiPMILCKgKY6xoMgsdr3lCK04h8euMUGSIALJggMOgQowynUwVDwDkS8DEFiEZbgDxwE4kPu4NOeGPIAWc6yXbwsw1d9AlHAXGdMk0JWRWvyhg/4wAgfpYEAEvCRWDyEjomh65Ycoje6ZgwiWTKPKyqhDVAsoxKkmEuXkBAEFETKavi6XRmT87uLvMAY78zCPwRwDcKhYFT8ScoTevoSn2URUbFpAbg2hAYbBMK9eRkyYMZUHRg2pSjGKtOHTWa2kWTNqzL5ADby+l8jKVi2f2mrQFy1YIFs7P8kfDsJKMxBCb8tw5KXEIEmMkCnFlGkWCKh10CGcIoXQAMVtxCALf5hDAFMJJzDOsUTVPBYmYz3LrBUAHrvtAeGvqAFDBUMFNTIO8Rpqr4hwUbjvIeR2PqVSxBwCAz+cVE7Mi/BS/romJlHiX9QohKuIEVwK2GJIwyiPz9CiQRdUIEW4CAAt5iFLWxBi368wOGnLgo2jHBpmLgXid/Onz9akBVGHQENawvMFmi0wAYy9ySCaA6NOh5DiLj8UcxbK0xsG5NUvBphsq6JJE5IzUZUWCCuEEgjFvCAMoyTk/Fqd0im8wAsYGgasqAFlv/REwMKZRvY+sddZgwRtK/dHwr/4MEMkEjZf6AoMGFBgxso1UZ8bfglpxASmVI2lIecIkVHwSVI7osSVed7AJDYt8SksWWjF2a4SXdI9BwiyBO62YSXWIAKl/sZXW56JC4gjQIa8I9j/APj12jBT1wjmh39o2dnl+xIauwPIyhhIVATKnxpVyKyf4MviYfJVWn+EHo1ASOqhQhfXKNkPxbsmYqJ9eRFAgxgkMLyIEm6Ji5R4W5cYvyfiHpffUKjvo6EAE8ApxFgIIALHqMY1+BABfAbGlTDGAlaTHt4z47tHg2L8CSW7qkw/CENzinJWEtivAEFDk8gwshFGlCMsO8CP+KtQkISnofzQkLgIOKEQCEY/8yhBf7L01SG9ELCi16gH2zh4v6BFkwiNMQA1bxGEHqqiHgO99jOH2IMT3wgLMwNMCZLHSpjjLSpyf4hAiUFbDgJAxVDH4KuJtYAzGIij17CFbqPJNxMV1ahEnyADrxonG7OwyBCeK6jBWbBJAZCAP6hCQbvJz5ABc6hiLZgCHGvRCrrvdZmC/bJBhLDtHzJP6rlSvBFv3bnH/JjnF6iAafwo/QhF+zBtqovJEwgGUxgVgTDE2phJBigS15iFErhMUbhH17BFdBgKQTPMhwuJDwDgfBrupAA34pBADBE6nDHInbJI7ZBHUYEIl5A5EjCvaLmFxVjC8bEUixFkZbLj/8cqHHMBNU0Yt5A7dSg8CEMQUlGgiWKbiAw5h+SQRc0kUsuoRFgohSXQVcCBwRcgQPsBbDGyYy06iF0xyHKwAVsYAYEYBbSKw2agFRsruH+QR1AKSKAb+SihuXu6f/6Tiiy468k0OhaESleghqxjyUwchPR4S8YQQMVQxs0gZ00oRFUQRu2YBCILe+Yq4JeceZQAA1MQhou6LQ+QAw+4AmuRiCI4lqM0SGOgCEdYtGixp98KjG8oUH+jvhgMTCMDyJ0yiE4oob4wyIn5TOuDvvu4RA6SiBWIiQ26Ep66x+QYG9AohS28CREQSBy6BVAQBR8YAh+Ai5liP2SzCHC6B//aEAgeEG7KghAOGJoEEUg0ABR0KAg/wIIlWgxfKACikJ4qLIRfwJGZOKLJsVGhuUa7wESOGogri8mdOERuIRvMO8TYEITPKARPuECzGGT8OsflJAkrGY67gMKxvIfimkHkeOAxuQbCDKA/IHcaiKAkOg8siJSrgT5QsIBKjMwWMT5oHAzYe0aBeKaBIJ6KiFwPkJXHsIUSLIRJkAVMGA1hWJZuMY5P4IRgYJ4JqIYYAALHkAMfWc66kMqsaH2BvPsChMoQcK9BvMIcBMwfEAFGuQxQQKIlhMloEDV4AUw+gO6YsXWAOMr5wglsPA8PjEGgMGEmO4jKMEc1VIgPoES/1RBIC7ALe1FUq6DjYyzKokPJNZmBhQgCF7R4USjOQ3tFFSgJ8PiBX5ylgbiBYoyMRZzM1AUJLLCHh9iRXXyXmJCl6DsL/JsCd+QSyxpBWRiwCbUrQRCFx6UJJZBFFQhLVsBB6jAA0BAFVrBFHCBHl4gPyziTTeMhZiQ/QQCtxJxwwArSBSgJwWi/wpjMIVDGAFjheBxCbHh78TITdPTxa5yJKqskfTsTV9CMiXmBDxA1r7RPGgN80QiJ1TBFB6iEcwRB1QBAVzpHeVQUpT0L2hhPOiAM0jFL14xkb4BCn6wRASzCPRTJH7zPwPjKAsNOTpHII4tgYLCGzyi55pSJP+gwAKlMy9lTa448iM+scFIgjRHdCBMAQT+wRRwgFvXVDLuo4D0ao2S7yMUIA6OAA5VwHcq6B3NIDbGSyBkYyAiaxiNg4hmpzBUABtQIGdQ4huSIuakjyQyY/GkMyQu9R+sFKQq1CNrwtYsgW8WIBhOAlRBFQfSEjVHFAfcYZPAJj2xhoI+Yi4/gim8IirpMfF2iQ4cQAFCDrIEwgZ8VdH+QTh61PYaqgUqwBsqECJU63ZUMiYQFSQKiEnvJotkglNByswKw2IHAkJRwhTSVCDcxE1wwQIkI8kYiRoR8SHSoF1+ycVC4hueYDrUAQmECj/HISzGYlcfgkf/4SfvNQ//BfOeHuANHMXUyql5vlYxDMAhBBUD2wPgFCP8ShElPgFUG0EUGqF8ROECNKkCXINGrSNkkeIyQcIFBAQFfJYeKYKXfIKNCGAI1EEd4KsFALEmFMUGRmtwYcJtH6BmE3b2FqwAHGK4jusfYgATmCdTRwLCDBciqDMRtBMmPLRb/0EUwtVe5O0f3qhR04ai7uUZ2qXnkONe2I13Oo3Y5CIY53Z1D/M3jaNH0a6hsmIIkFMwLkJTAsMn1vc8BFUUGhYkagCPXqITpJYkzvIlSFIUJgBUW4EGBshS9sI8AKsagUJ9Z9QzcK4zTiFezU4xhAyWgrIwmvE84jdhRcJ3QQJ3//+Bac+jM2OCroYXPUShAfLyFKZuQBfjA1AgDcgGYO8jM5ajb8sAbMoAMI2jodCgBXjU/wIjPxrkZw8UJCSVQDkYJmIgJOwUCi/V6c4DTVUhAEoAWr2hRTNYMJJjOv5VSKxGfSnoR+ISczuCAEQNW/y07sIiReD2I7AhpT7AG5Z1dxp1jEEqcJf4oxjhE/nGErIEkLQBBBOjGP4hAFQBGkyhAegBF8+2Tk7hef9iI5pjhh9CVicwVeWN+LrLgevTCGqTCOnVbQEjtWDxjs/T8J6va1B5j2uCS5dYb/hGLgSCkBPDIESBxDZACLCA2rhna7qXQXUuVb0n9IjH4ZKYAP+wwVEipG5JYgsUKn5kIpwmkm+Rc4O55I1d2TwSoZs7gQks6R9AwZYFAxooDgf+AQdA5IBPJrC6Rokh4i5dgGwYKILIyIV+wkEdEkf39uUkzZkh4tI4zIYzRZUhqL/scpsB4wygBDoVIweYwOlcQRP8JjG8dURV4QJMoSyyIih+RFS6puM8o5U74x9c4BQgQEiuTind6FwdKJ8HAnZHggP88ABPgoEcrt0UiGtWuadPYlUVeiQ6AD1+bjGk+EqyIC3/AQaQQGBskNguGXpZuR77IoYqAgIqoFFdpI55umsSTaZBQgiByvacGQ5tri/2JW0G1ojRmq2D2qJihTTNo3z//kEVcKAfmpoAwEeMcg4lfCcNqBkibPC+MiOOOcJ3noCnglSAANreoJeNkJN9YlV4WCow4PmtY2V5aCthAcAxHOICEkBMXAy2vjbwWhMligK2zhMimfQIO4OR+GIIHkQvwPohAldp+9AViTmNQnceUxKzXbmoHWIzn3jyjvcjShE769pNXiCl+raMhu0jMPegF/gZ8C8psGFHOi2vNAUzRGNFpxtNljnkcBUwpPkMLxl3XHFkz4TOTuJfgRq48Sabilu+HUIVVkEgMgFUI4FMSEKSofsqKbAohsAFyGAQf0IeK6oQI/IqMYJFyBugY4IDNJci00je1M8n5vQj4pud/69EmxcsBRJgsyFiM++heRJ3IJQ7JMQhEj4BB1bBIHzogRIYUoMoJKo5uloyjoXlAY6texkppZ7Q3UYlKfAwMWznJEh3L6ALbIRlM5ZSmuwbCrsst8jBYsVhFUpRFWZcJBZXrlWBZ3PyX6rDU9w6bMTpG9rlA4j4KPDFtT7iPqZXmf+hl48R1Io1Rvrj3b4KThmwJuIUcRx0yhNDF7RgJIT7I8LhSkZhFILBYj37JMyRNJeggOHcwzsDm6t6TKZuZLeHM1ikaAfic9tZDOypO+QCxDncKJL1kjf8gD+jctPTt0VCsoHiAzo8qIlAILLJIYb6JXz3B0BCo2StFJjhH/9WYRlWIVtDQq7/YXFL4HkMRyUB3CLIdcnQCQLAyYElUIJy5zwzQ2A/oBD8IQ62kgmISDBUoD4yI8+hUpf6QyD1rIB84i4dAqCVgWsqo18IwJ4I3SFA9S9AGCJwq75For//YdEVQxgEYsWZfSRW4RJy40jd9dPPlavbiCmSwiI8nVRIhcOczEW84Q1aQC5iocBs4AFUPUaUb94EgVO+ympYxH1fYuBbM68GYQz+ndctYHc7QNgTw6FDYkQD/kpIsxUefiQigcTGUlj+ViAoFXR/1jMqAAJkqMW254ZX2SdMT3yuoGD+QR9uoB6MQOX/QY+pw78bSIYk6nf05cwTUVj/aD0w0B6k3Mw8IiACdl4g8OAfaOOyIUIF43yGHIB9MEIMWr2YK9MzvAgN+iGakEEaCAYG4gBIiWOC0ARgHYJ4nOt7QAKaUQIdzHPvRXgxSBxvkh5K+gPjo4OvutpoL2IQHiCYMsLWY/WeHeJQt4E0sCABhoEXqkRuhuEGsKCHA4M5WD66kqXaRP0h/rPuO/gf3A/7tKi2HWIFaKDXYcLm934kwAUQJuACXMEH4NDdXSoiW9E+Xh/Hg0KG8yxlkkXTxshdb/0bOOAKZOEaBELA6sFuEkABAKIIh38ECxY88u/UkH8LDf7bhm3bN4nbBDn89wEjgQ8ELnr82OKjx4Yi/0uaPIky5T8iKgvE+Kflny6VNGvavEmwEcEZSAgSsGjRYEeDQP8FLTg0ZYUH2AgKOvWUwKmEHav+s5qU47Y3LQTAQFZwAMEBhur9o2EkpMMH/75dJZDU4CmpVKcabHL1bZl/KEQawAk4sE00N3/QfDlTsOLFNFsJITgVqqCMBLc5zIjZp9OHKDe69VbGctvNFi2b5rxtW8ZtBFwQSPNCSb58DvUt+lf2HxOTYjRiHmp64sNvlJ1mfPJz479BHv0xfoj3uWJ/zmt2aCk9u/aC0goaefbk7WbNljv2tngeo126DkVrXnr0bdS5eavGhUvgSQUOw2AMGMBPbRH8cwhtSP8YMZAPCfXlU3xw5fVUQhF6hFxBKIyB0HYaLkbYYhZsCGKI/7zAkFYEWCbIiUYN11tmlLmFUXhGQbXiaQ91dApx7WE00Ykq/sbRBxxBYUQC/wygD0G3/dMPJLTZU5A6LWQII0FAYoRlaTOO5pA3BDWRhogEvbQhJTf9JeJ12YjJZkoJ7OYTXJEVNJddRVmUFFBQ7UlVfQ9VkBBlSQ01KH5XZXbHP2gkAEMEizji0CZiGVRIhhIiZV9Vej4V4Sli7FVSh22OKhhLIp3wzwkfmlTAP9eRCitB0vQzgw8oBJmacO4d+k+LGvH6wROCwAhUapz52FZclRXEmo2QfTCEC///BGGEEmbRVlCSBCUJA0EtfIOCIOI+5N6Pvhnl1hBi0GgSmiVVd9OasdqklkMpEIQFKR6tAKdBpPygCUEizEvwP8Zc888DrqV4lUUyFsQpVNhwWtA32FRpUG+9IQVBBUGueNGgEM/VGwf1wJDPkgRhexGgxUHGUHwXuWUXqaSQAgwl9/7TKogsELTFdqh6QZCpBWenynbdIZyAAv+UIfPITgk3LFFuYWzQy/8k+o1VNFVFRxoImXVkQaGUdNquyjr08Ad9gbrhbjvz3DMiwIBogwTPiVq0TbfMffRJn2i4izTRzNDPESjsWlBGQ9XZ6UVNXVTUW9ukcStcJJ0Ulz/D//wD6T+LbJISVhAj5RABcOMVBaw6wETQCndvqHfQH9mO0gv1EmQBqihZgMo/ARc0ReAetRIiwtIIcYQ3rfV6GZ7LGtSsSRm5JgYdRjXlZV4oSXSVNySG0g+SNZ0mSFPY3HFKRakzSBC8Iq7wz8AOZQC08R4NhEa9t1wEOJPcbVW2CKD+CoKA7FzDGv/YBS3+gYUj5OdBeXnYPyYmMaFQ0CNi+An0IualmpkkKyjYAhZu8A9O0KQ3WKGPT9hFEDZ0ZCD/cBdOaEgT+hGkZwesiQ5XhZJXEcQD/yiGR17nEV0gooeCKcY1itEtFLjgYTQSxBCAgjXUcMYjygkSAZ4xBP9vACphNCmOWAakktVo8SgumJxJ/GEAvmloZyzRwRIfYTw5niQAK+FdSoBwHSQWhAZHPAkiEsNEgxWkAQYxoqwQRg0YFAICWCKNpiJzlPL4iXIepJN3VAKFzangHxFIkvw64z0sEQAv0TEeEQsCBMMQ5ACE0WObbOiR3UVjBUScgg5TwpJqfOgS/7gE0YhGk5ks8R94PCAOcBEAYxjjJNe4hj82whErNe4DzqPIidyYxYt8QIQGCcFJzFAQtqAhDiMqCHOopyJxOiQoHVGQSnBZkr/g0yaIxGGs/GmSM8TOIP2iSUEFlkjFIMwj3SnGLlhxBSQEgQ7l2ZhBJkaQyaX/L2ohS6el/uGOmgiCDAShoQUll5IglAR3FwkaSxPKJlsSpAOgEIwBC7ICIMIUJ90RSTSquYUmuOYj3mwLa6pWEuWoBH4ekRYBUNAXAujoItXbqUecZlWTAHRDgiyIJbK6nVT8wwYP6Esr5XIpqXSKoxepAhfYmsaubWQIHOFomFKJk33m76Uf6cNN/gBWd8m0bNr5ECELQiawmqQEKAELFgjigJM6pEfgI4hb1iYzNogkHCAzyTae0CKvXeSuZyqIXk17Er4SZLCK7eHsWrsYI8qiIB4L2Z7SJyEMpo9xXTtKFR6zGOlpMjtBO20NXXoS496QtR5RrnZ0cdPA/DKh/2hUzDQd+Y+FGuQa0pDGNRKAhlFWSDBrc0g5PBK0A5REEMLyTDZjpU827a6G7UJtc/0iGL1NtyTdyCEhzfSDg8LWI1XKgkO0SxBeFAMWXIDCP8LUPZFsI1GUZAslL9K6j7h1wBtC57yQqx1wqKQaqTKJBVZFgt6l4F791V8/RjVKDss4oRxAw19USyqd8tAjWPDdawPXreyKaBczLvJF5kswDuB4VK9MCSsKEl02ZcLIVK5yiJy7ncOK5LA0sEXfrAzmMFcZy0IrpENIIOY0q3nN2+EjQWiAKt95BJkFqSmb74xnNm21pfY1CBr2jJL9OkQABJGzgXmXAkL/wwNmCv/clC8yzQ0hWDD2zHOamRtf+sLrzypBc4kd8tiTCEDL//DyWSyN6lSDiEQXqfE/glZLgwD6IqFQtEcEcLaLcNkhgqYyLlQNbFjxDdbBjtWvi43sECG5ILNWiTkIwgpSo8TW0/hH8T4iy39kO9ncNsgpgd2hepH5I6hip00O+8CCNLnKx6YxF0pyhI92u2Ct4ysOxy2SJxPE09vZdlYZu6ExiKTSPpFWTcw575QYYOH3ZW5J9OYRNHBaMYfdwJs/4ouSHKO1kV7MBYTskHYUJB0OocY0ZxHqntAgBEjggBvKIHAH0GgqpHWIwA2SwHd75A/iuInDVaKWDgkWjiHqkPz/8Mlw5f78I0uXTqgNQmKU7LjK0+RFI2mSblQ0IHG20t5mDF4SnYPI1Rv6dk3krMNrX8QAHHCOP4x+3+00OyXSfnpKNh5sRuq9p9ltAFhO4shpzFbRNEDDNujwvArdAZfLUJSIkIvvmmQaJ4ddARACo1p/i8TsF2n6c/RtE33N2Igdx8U1oMFARdLCGnxPSQBksQFUeBkGxXjAA9LQBDI0RKUOmcNF9pDVenHeI8tkjGDXLhhWiyQkf/G8if+h04R/RLvS3MXfU5L6f/BiFrMoyE+vgAUjPOAU41yf45cgkvNqJ44GacHcUzJ8h4CiCGCusfNLkm5pS3+7BEEYwoyB5Hr99w/eRRPGQA0OgQxPNgOsoABGoHv0RRjA1Sa7836C4XZ/8QtfpSHxVxM/NxCRRxDXZxCU8Dly5hGfk3DGQGQzAA3YNSkl0XEFYXUPJHhihTIqlADDUAhI4GAEgQRiZwQEIYHa4VdNJ3GBAYKSVxA2MIGrdRPpZhAz0VXz1gDT1G4FsVClxyQgVxLF0H0G8UDYRRAP1FPdMgwKgFUEEQ7hIHaLwYH0VRDLNmDRZ1XNsH8oMQMKVBAHiBLSsAjOEAu7EGQs8w8lAFhymB16lGFs8oI2AXEF84g+9A/6tm5Tx0QBAQAh+QQFDQD/ACxlAAAAaQHyAAAI/wD/CRxIsKDBgwgTKkSkUKAngg8VJuvVsGLCUiNKdTLSYgsajy0+ouHQwggSUsuC/RtlyeLARC4rdlogkBHBEQeNxdzJs6fPn0CDCh3qU1kOhAUEdnD5yMTBhxH/5UjmUsQIEZdS2EDj70XIj//8DfRHtkWRf5UsARM4LuGkf28JtvxH81+nhOAISvrXckHLDAbfDaQysNRBUkQTK17MuLFjhAwVPozckHLBt3sFrolqEKbARJZcMWnBwcYKr2ITkuVwNoMCoXefmujldM3Au50SJTJDkGa1agMtETtYV2Clx8iTK1/O+BHDowY9IYpY698jTMrvem5ZickL00y4/v/bMr6gWPJh0dhgouA1wrkD/cY8+mgg1cz/GHXqhPjSv1LHVQRTJZowZ+CBCCYokDIFMZQUdgQhgoknEVH0k23/OPUPfgotMIIrLwCjzQv+bEFWeQ2t1kFcBsFnl0GdvLXfQCz+QxVEk2TWVyKkJFKJNqYIZEkpxSFkjkH+/ZPkQKMo6OSTTkJoEYUFHWDQI4ogUl1PughUo0X+MfEPGiiG1ZABY5EpyVoKZGDTi53ROBB+McrpkgUCtdIIYq64gthBrlTSyD+gDHRkQQFCqeiijBJUH0UsJPeIdDEVwYQNqZnZkz9oWDKjp5+9iRBN+8WWTJ2TiDqQhgYdCU0rogz/5KdLoFQSK0GHNqrrrk4mIyWjCyAmCqY8bXEEmZl25QpfjBQZZ3ClMvKQjgiZEBtaB+WaqEXb/qMNr+CGi1yk9VmWICKRWfKWjyT69AIHBJHX1QKA/eNZJ6DGBy1BjEiSr2eaZcjqQZUsKxCeBVdEibgMN5zcLwIdYENCiJhgrmJb2vvPKkVkapDHBqk3FqdknSWki8QRdO1LMNa4BA2i4KKQK9oYjBAlR+I5UJAO9+yzTxL/Y6VBFho4ChDw/sPBEQaRptCx5s0rnyXOFtQJIxj+g+ECMBVJr6p9uppQoK4sw+1A3/L889psH4Sl0AMxSFB1GT82pCstjGmSiQWh/3EE0wj9/bE/QFhCygKXwKQdwAJxWNMS8pFiCU4E/bFGIpQjqsko25ozqMKVeKDNrW2X7nCkkRqEOrkCSVlfci2RUgmm8Lo3eAuAG7TFxFG/sICYdM0kZEFr4CZQBn7JZztBOUzSiQV//rNwQTbnSnojuRpp+vaNKrLTL4hQVN/AEV75kyQZTDKJ7CmklgXvCHV1RIkj/wM4emF1xURaidDkmQieSQQjVAUfmhQHYIx4iwVo8I9jtOMfHhhIJWRHEJ1VZFCv+NZAPsG9DvpMEVkaCGWg8g9PyI0gykhdTxbQLEuM4gVoKMIFgEAQMn0MhmUiD/wEQhZlLaMS8jEIqf/GJisv0QRry0qHACBIup58DlcejCLbHlGupVDoLQ8xgVOK9o9esOB1PrnECMyBtCKIwoICsWHUjEAQA5BlK/jrIRI48AJL/WMEI1jSP9ZiEFL86U9HOtIIUDaLf+CgVgmxQKGwVZAgmWKRA5meQF5hEA1K8ZJOihQmvCcQdHjiUQhhkPdUuBPEJEIbRXiBzSziDzoS5I34y98RSNTDOrLHEoBBXkIkh5gj+QcnOIHJBP4BgkFFLyGg0OM/ckUJtS3siZiMZoJSN7SCnPAfinjdo7goEEhVhHwESYQr1uOOnZjoAUnL3wteUJ4TFSFvPOSULV3REj7SJUlzWdaRXFH/HEaA5h8crAT0drmwIHVLIKZI6D9iZQpoQtMg4pCmRIE2EIgNZIcNSV0vuMgq1CmkFhcTUjAyUIRyrOU8Y0rpx/JnMnV2JJ6lGVxXinACBUxuWZcIDSlw+g+DBYogTHCFJl5xzAlG70+CakhDdwZQgRSISQJRyUSnCqVeKKMX6OjFI3hjkaHpAl1dOggTfqc0M4kFZORBD1mMxUNjzc9EYoGCpubawxacQ3auOAEp8GSO41yiYOYwRwQFgpPjBPQfBXoqQUSQKMQotiAeuNXCmrgwDlL1sjGp5mKoGBTODEQEiCMjOzflDx/Iyx9HQI+JjpDO+JWlLQrQRiWWRM9//yCmFKtsSG0LIoLYMdIi5qCE2TBL3J9wEpsg7MmvBAJGi0wHESujhCWWoICQ+GQ1PgjLFt41Hh+gAQkqTZE/WmADJLijHJ/4K0GUOZBu/MOC3brEJQrlH1DsNCZqQ2hx96uc5g7kuAJRBCkXUosbCeQEC2BgRc56ELFwRSwPIMv8/tGCHlYEWfJEAhNUsQxzXMJm/EQI9PzUH4NUomAWWFYlIDnYgmRBFPmVJH9nnBBSWhQoBhbagJNrEIYwAjgWqBHIahhLj53IRHQc7wuOgISSLHm0CiEThseLhEtk4QLT2xY1VEEQUPjpEiIoSDI1YYEyt5hQCWmxJWm8XwDbQP+zB7ESgBfEzYOk7rhw5uScNfYP5PEuu6r52xG8OxZNiYU0/uAIFJCgDnUoAAlGEJxCGDyyFiBhATj4BAkKAgIxLcCnYSaIK9SbYgsGqRERzN5CByKKQTWRzcQdpdsQwWOEhFAgDBlfgK0DDu9lUyEMypgJgNGJD5tjHBD2iGrW+retjKxEqO2KDYqgDhR8oAzfsPYDwGIR8bzyBUy4xj8WsYt/zAIUeILeQEVAChGEmhSQBAUonrnq/BpksLHaAKxj7VHvwTkhddv1P/KyoH/IbWgsGNq/tWoufA0plepRY/3yt5oWfCeVrywRB/xRhCIYgQAfoMMdCOCAU3wjdxX/2YIRhnzgBCAjvbIaqH9S8O4CGeyYLsHBvvl7Y+sEmAWIYFAvFNHz1h0EpAVxzsB9PpB/vwcYLUkEEGzAATbG78FoeMGjBaICRxth49pd7dS/QQcCCKIMHziFIAhQAUBPWixHsLpA0nroM6pYoH91hQcGpYlG3CrePEGFQEQxzJ3vPOhF+8XEJCCQjFEk4F0MMDd37CXseGIpBJlEl14g9wZL2AgqcADIBUKAO6gDCVx58AuQYANsjPwDIMfGNrAB+zKQR+KD+4cNoFxoCq/gvj39kyjITCgZ7zS3hk8+mhBSHwBj4s4EOW4tPIEJ/wpEhbWOvsoEkuNJtKQ66phr/9T+EekKPIEA/yiDIIbwjVOIgQDqqHDWId2Fb6wd/acggNrLoH8CxNK1aKAAhMZDBPgCJ6BIgeU5lABNl5BuDfheyFcRS5R8NHYATscCGEgQvUAZv3BNTRdg1pcQnmUJkrAXc6QaYeED/gAFBNCCT/APBLANggCDH6ACAmhXRoBtp7ANMSh79teDH1ABEMBKZMIEUOAGgxZe//ACF4AnroA90gUoFDiFimEZ/gVO/2UQ3hOC/1A3iDEJiDB1YrF8IUMWUFAB/yAIJkcHaoh+sEcAXcdoKPCCpNeCancKH/AN/VcBfcBKXaECKlABp/AMD+B25IcLFkAJGtQNoVYK8P8WgVQYiTHhPb8AHW7gPQwBUp5VEP6GQgJnJwLhFzmACNlgBLj3SvnzAE3gAujHg/+ADYJgf98we/+gAgQgBoJAezAYg2bHi2RwB/+Qhx/gh3/oDflXAd8ABSooEEWACwpgCkLFbgIhAssCiT2RBQOBBRCEEI9VEN0oiW2zXP+ALgxBPh5IcASBhRlgCYkgApIwHAXgCRPzfwTIFT5gbS0Ig2KQhsFodvnXgug3BGq4dmv3fmvnAknQBCO3DRWAcsuWaIN4Cv9wCqcwBFDwAAKhChhQCdXgH6SQU3jiAdbIateYEJRAOiQgY+D4M+I4N8yFCZbHKibkE29BNUMiCRL/g1GDswVdMAT52I+wN4PDKAhP8AGwJ5BpyIuwF5QhpwZ0wIoxKGXi9QKngA1m9wzPsH7t9zfqUA5YZgm9NRCgEEHdqGqvxhgjuZLb81USUhCQ5xJ1UQrBAGh0xAXYwDeD8wAV0IJrR4f8SJAwCIMSmYb/aJC9SAAu8AaBQAcfIAgVgJEoSHHqgA1DQHr/SABvQIg+YAQKQAmgQA6kQEkL81RChVgE4QE44AHYmBgcJFVq+TORsmcFIZNduBNUchDE4BmHxgUl8A9V0ANIcCIZhwYq8A1FGXuztw0DsZToN3o8yIME+QQ8uJQfkAQhQAaNeZd+2AIKcAdDMIzA+AFD/+AC20CR3tBsGRArHkADEXQJf1IgKjkUghck3/iajdFaREFKHigQ0LET4dMldFIQksMMZNEJPBAC5VAF5cAFS3AiqdFDUKCHBWl2sBiYrniLSUkAZbAN2xCUp/AE/5h2LgAGSZAELoAN2CBXC8YpSFABwLiPBimRHXoKKIACLhBh0GAKHoAne+UKsSIKkcUYsXILUGSfUtSfCiGbiLAGORYcfRYJGxcOJVAFBDEH+ZNxPvAA3pCGsOcC9kd7MRiMYuqhaWByguANgiAIH8ChHEp7dFCi/lgGhrhsq8eP+QiQ+cih/zAED2ADM5CI5uBlPUUJLaZgQ5EFlCB4LnGWRv8qLorggRFhAiyCpAPhCWsgCSaQNcaRCKOgAGjABXNQBVbqm/8QDgggHiYCV0dQBvl3f3gKkGu3i3Z4Ct7wANswCIJYASigqxXQBKxKBiGgBk2QhxWwDfQYNWSBBNhQAeHXfqcgiHeof41ZAS2AAxMQQaAgCpO1aoYqFBGECh5QnwoRn43qM9MnguUyCVrkCRzSEg0KqlUwpeEgEFUAqt4WFhzwAB8qCO/HnI35nLx4oTI4ixgJAQ4QBGmQBoWogmRxB2qQBHeQhwQwhCvKZD5wBH3gA33wABCApjZKettQmSfAZQSxmgJhsgoxAThApAgRAOVKY5zhFCEFIzUhDjb/oAA9EAJcwAaluqBC8A9/wCkDwQHe8KpGe7QACZ3f8A8VkAY99LRnVSJ80ARkwH8U2YfitZk7AAFcuwN9AAFfmwbFqo//kAZIcA0nwKj/gLIKwbIvCy6nGEqq8xNtMRCMYAKbSDzeZxMLsAQcoARd0AUukAYh8A8MyiqlhQZokAb4eH7UaZQfIAZ3oKYg16E8iALJSQDe0LRb0AdP6xGm9Q/Y+Q930ATluaWTlj9ytwP/QLF9kLFnB6ZpUAS9eWY9UXhvuz0wyYmR53QGYQbQkQy1gLeYAU57ISMF8Qk3i7leegpCsBHEIhBzMAc9cADKKasE8A0VsL3cy727+r2n//AASbuhBBAEDvAAD2C+dzAGD0AGQRAEUQBhr/iYkYmvKjqEQ9gHO+ADUeADKlCeM2gDxuAB9oYKWACuRPpQBqFzBuGyB7EMCpy7TuI4WzIpqfMLRVepwXYUnlALb6GutVlC08eukpoMf0AXdFEKNvAAQ7CPKAAFHGAGQrAVbuAGvcAF4cAFXHCPIzeh6JulGesDQizEECDEW1C0HMqL5Qu2/gABJfK+XBsEYxC//9AH0IqfnmcEFMu1AwG2W6CX3kl+F7C2LkYCNHAL3ZoQERxB9ibBCBK3/FK3ImQCSKcQTpEDngC8GeAJIDwJMGECBIe3NeEUsTFImjAxg5C9D/9gBCUwB+6wBEJAvaX6D3PwB2mAvRUgnFC7BZ3LyRDwBmWAveJpBmlgIgpAASpoAFxLBv+Qviq4BaewBKj7dnFHsQIBAV47HmOQf3SwDUgwwIU0EJuGBbeQBQKgjUCBjbZwshaxgG5MFFjcEBmTA9hRfc3HSQ+hDCbAwXTsCSagOJQKF046PJbgA0NwCkYgw1Uwr6RaquEwr38AAZiLmE0AV68bBA+QBu+7z+/7i2KqnDzoAk3QBy9AAv5wDxTADxH2D8uXvvkTBCgAmZOGBmzEugJh0a/7D0VrPwogMwx8DAKwRGi8AVmgqD2hNqIwCyX9zLuSAf1pGW0xDojwCNP/NyEDsYFwIak58M0aMRdYWER0IQKq8AIEkAZHwAOiGgKFaxCAO5E7+AZO7A/u674OsAOeDAFBgNUQkHb5eG1EOQhOPAVEUAgJIAMJ8A+FIBBuZCJfG7FvMKeDcwRBIBBYy7qhe8kocAQKcA0MPG7/IAvTgAO20NcWQbIGMSio4MAWobbMzNJCMQnZMBDQ8ajVMYoP0Qt4HGB07CWeMA6JQHQtMRwuQQpM0ABGgA0+sM6FO684bLh48AeD5gbeMIv8RxZcG8VuRBZ9cAd9kNUQQAb4CLA16LlToAf38A8aIAP/cAURIAMRJhZpsNW9XAFwPBZLdhB9+MWnQAc+kAXI/7zMizB4t4DMCJEFhP0PwyUQJk0Q4loQ5OrYB1HdFTEcMvIQ3sMgyiAdtYDHR+E9kgoXnZADjFBh/mAZI7AytyEQXuABAsADCoDP9RoOQtCbVVAFQkClAvEHDlC0bBcWWJ3VLuBGhSAD/CAWZKDVLiCR6Pd+74cCEIAEocABMhAB/3ADzX0DV9Cwq9yCVkndEw1eF03XfWAAWWptR6ATOBDMAiEmWbDMBuFI/+C2IDAQrfAPAXDe8Anfu4J5OTAOOcAg5/B4yvAI+X0OmX0U+zECtCQB5fAHAe48djFEDSwA1EADWaoOIVACbuAVR1AF8MQpbjAGBIANZPfEWX0HJv9SD1egAVfwD9nl2xDNnM85BDsABODG3Dcw41dwD/5gAB/QB2TAyve3fipwrNZ9yQWxfFsKBTbQDw0kEHEQEzSACreic878OUHCwJ/z3gSR3lq+E9FMYQkBk/sNHRaiCJhAEW2RAxkwDie8AAtApVzhAG5wDnSxAPuhKgURC8jAC7OwAbD8mGhwDqKqBCsnEBzQA2bAAcWKhv4AxQ7gxJciEGV9BRF2BxBQpkcrCHTgDwJQ1jdwAwlwA+rgD33wAb8trMPYfxRZAaYeFi8ABVhbEBDwDG9wBDRwDbbg5ANBA7Og5AcheBNgCiNv2AIxTBtACS7LDKTQ3gSxCr8OFMH/fgBjPhDgQOaKcA7joM0GpxAZkAidMAoS0On+EAV/sACpdA6YExEj4Dj/YA3/gArm0AcKoHJzoLPhAEOMZwZ/sAQl4ANqhwIGj+9B4Lnj8A5EcA9n3Q8RcAUqiO8qDtAAOQj+oAJXkAB4Xwid/r590AQP+5Trt4uxWAYTHzVI4AO2PBBgjwIcgAPQINiAIBDFwLYFQetu+w9BYjbNJBDaoAqxMiiuMAp/EsGQ5RKX79jwxBPV8Qveg9lP8Q/DAfSJUAU+YABuFAJzgAAlwAU58ALnUAl/0BKrmSStAAJB4gpHAAFmoAThsKBV0Ak/S8klUA4lcAQE0AQQsAUugNVk/5CqYp0HVzDjNp4Ane4ClSkG3wB7PPgMDu8PD5AHEWYACJvPafAGJWqiScmD+ves2d9gnDd3APFPIJpvFQT5U9DgVjFbs4rhYIVK4MR/tir+u/UPFaB/piaawvFRFEWKE0ieRDkxQEqWLV2+hBlT5syYaEhyQClBYC1FAjEpqhWUpDKBPSdK+mfp34JJ5Wz68/dPSJVyUrnk4NBpgYWUpCqNaPFgarhwXMKVoDqxHBcfBFBE/RckyBYONvy9s3EjQYQbNzQk2OLvwZCJBD4Y/nYqDYQ+/voEceGNAIFTTwgkSfLGxYd/2zpjIzBE0ClvPlCiMXISwr9BR6LOMjYLkP8tLP+yCLSIsZjAkAJve/zngZJAVSwrVRI4EjjNlrmZP4ceXXrKFidfVHepDNE/TxO7/zOxnSQjRgJHdFp27ogbNxzccJkTbk4VcT24tFjwL0WikyIWaPNhiXKq4uKf+UhyZw4fRIMgCDKC2MGfE3yZwo4XroggAg34AkywUyYShIAQBakgjQcUO+UUApo47DBvMEvCBYE+nDHEb9LYBoqJ4PLnhQe22MIAgdL4BxsOokIiAecmkmUWimjxTSKNmKOkkYxYEqWV6bbksksvUcKJIpv+CfOfFyaqRaBHMPkng38mcfMfNlHqhJEMGJFkBExsKKGEPUr4Jwe1hHDDhxb/bGBKIP4mSoSUDB7ookCBQvgnHIHCCSGtIw77h4wGDegDCCKAGPMfvRKQAQqofKDRs38IEOObZ06BoAIIIEDBm20Qi5WNJMBwYRtsdt3mQ0EE+eYbH3xQIYod/0FDHYpM++YBuP6JwxhboLRtotsoomEDikaayRUSvkXJg39wcPJLd9+F97ky/3EWzZOQgmkSgRgZ4YU5/qkCgT3gq7ScEpZNwwcjkMvvHzn+0WKpSyxJYwlL/5H0Uo3/8aEJMogkw4fACmFChSv+SeAfGWoTyAA0/KGDxhDFEPEUQVBwwdY0Kvjng1N2/aCJX9MggKJdJ5usiSGhMA2qHs/0x4cK/1RIA6qJsOiHFVlYWZJrigQQAKVGvlxJoLLjRTvteLFL6ZHvKMqGIn2NogipSSxhpBM+q+Ciigv+WSacKkp4wYcgbn2hEkuQowiYRCoZJ41TCrzgYoHK4TscBKo4ook0gvjnAYGgKiSBPJhuOYo77vDBAH8mm6hFEUUrGgUfHqjgm12T/edXF4qGfQgCttk1jTTe8MEf1I6AVvnUWD1F2ajgauEajo7pViCOfBOAZZSWSY657WfCXm3zz2cJp3lT+sW7ifQ1Yc6dHqGogH+Q6iQD5JaQtMD4lhGCEbZwh7hAwAbauMQ/GDecpYziF0STj1owFo6qYGwJndvB5wLDAf8f1GsioNsGHQoCAX9sYRsoKMyIbLbCf+isAjULIWZ+FyKBuEAdKjjFB1CQsDe4xh8cwElgXqCOMvzjFD54mUC28A9/1MYW0tjWP7DHin/I4h9hE4BzigEN4BQHBDG5jUVm4RyTtMI5t5jFt5BBkXahz41cwk6ppmOCNFGkjhT5jgnWIL8OdGIENijLPzB3KS4swSa3+ocBxaGUE/xjJQVIBBO0cQTRbKMC8fEfpSplIDM8gACIjAKRxvBBuQSBAHcQRBnSIIjAzFAgnIGlIGi2jQ/k7FYp2lXvmjBDFULgAd5AQWI4poLA8AgqNlAAFIp5EiQoQQBx+If3AOGkCdD/QCBU9JYqXlGcjkAnAFgoGw7EOIt2ieJKVpTiG9UJR5iwLSa1mIQJOnESXeAREfaTRJ1eIARLlaUsg+MA6EaZSBuIYyICMIlAFrAAGwxhG4JwQEEqsIQKKKAKAoGPA9KwQzL8A2QOCsLnOFOBFqHgAZsRDApRIoZ/COIDLjJprbDxgSdkRkaCwIYgBFKBbSzrG4MoBMegcKQk9ogD6ljmSdAgjd0IJIspaWOXUGELK1oEXVKcBTonorV1dtWrLsmBJybxNu/QzY6MOIAZzGKpi1ZBCGboQ0dvhbhwWCKBFGFAIpbwAhTAEhsy6mga9iBIA7WFMpa5AwGe0FcVtfQU/4k9FgGMVwGRaYYkReuZYQjgAjG4wKSSwwYZkrBLnAoEaAQog8geoIIKHOF2TxmdDY4QGDlCi4lMuEYcnCSl85ETStgUCBacRAutWtN7NNHqV5Wr1OjcUU31FIigAhUoTzDCBhi7qIEwJoQjQGAHcUlkEI4APldQ5AeWWAYSjDAI2BnxH8L7RiA35wMUAE8gk5nlEFqkop9V4AG7qoA/cikQnd4XeDo9jAtoJZltNIFFw+PvZIjn3x8+YA9HwDBFoFIEuzARJS/I6kXeGOJmTCQjUZ0ICcq3XBbPhG3ufE4BtvO2RyBCftzphQnCighGvKAKFBTIRQW3xMcUEAIv0P8GSiYxgnGcSDL/0B2Bv9E3TJmBVYcxjEsNw6l/OECznDnFGHB0Slz97qb3fWmaaVm8YgnEy/fV3bBQcAoodCF5/ziCOlrgWg1zQAHr0zANjIFN3qZkjS8JG0pY4ZCJbEsaJAnA2Q7aYkp39cbuC48nEJEDE/TiH7Worg3YEAghA6wEVnaQd/+xgyC84D/llZsIbNCF9uK0WN6w2HxKcAAvww6zZSja0TJLgG8QQB1jKANnki2GAhP4vj1L8wfusA0TlUByzw5RBaL3ACKloZNW+zNJoGKE1FyLJEWAwTGkIYtHv6SpKWmFMViyLW5NxBkUsciKBaLvSvf7fOjwtJr/uAOe7dQiGZ4IOFrdgLF//K0sfzAceL/7jz4owANe+Ie4/hGDf4ziAMQankC+8QFvaI7hPviGIFz1qol4hlg5pak3quCAzmzjG8FyAYI30yJBBOsD25g20fqAOTJgNtirgYDNu/ANNtgZKqg5SVTCnZIXCOAa/4DSMEhyrok8WhSfoMjYSFJokhyaJPU+RhvH6G+2u0R9LGkBoE9y6YkkQyAm4A4i7gmeHIzgCCqowr8QIJ8qRCEIY0D6qh9gA2MI4BYa/8cIFvAAFIQIswRmw/+EYAPcNVunOpURpxQ7GiiMQAkQKOI/CHiHWr7yAzrN8q5W7wDIbEEJXXC56HDn/4MiVuCG3qiAnSdiGiUykQNFSE1KmtgAVgig3r7JwhhD4YxUOEMWovAIDkyhCZI4SdIuYQUrdlOMY8gCncB1CQn2TRKvtb3FbKttTB6hCxrbOLqSMAIEWFugAgnBWauhCAg4AkARiGoQiGUwAsn5uZDrGcESHCFQBsEQBMngjJ7pmZ5TOd2RJW9QASVYAtEhoA9Ig9XxDFh6vZZqqaCjPbmAADdoA44xDXXwr9vhNhUoCBIpDZZ4GrljohdoBlmgBd06iW+JBlbIh3XJAlUwha+bt5ZwBlpABlkohvH5hzTCuhKLiStxvy2EiQ5oCUT4DkRAB0TwtPBghCMYBBkMhP9KmQM766hROhwyaAFoCAASIAeBAIZReIFjCRHO0KlnKIM5EIcSMKQl2iGVarZXGjYXUAEhCKAHuAMyADoXmDaVoyXWg7ZaosRtIIMV9JRwcANG/KlvKCao2IIHgAIV4EBoiT8PewEOWCKSWCI0OIEBEABk4DdbsAXsGYZFEIh3+4cJWA5HqiJaYAiBuDeKcAZkiIUSYwWzmwgnSS4upMaWgDHmAMPtUITu+I5HkATZCqYp86f/A6+42AIagIc66AaBKIUcaAGSeqnh2RWZuxh/cA0o+CXDUMSc65mHUowKEAIEEALTCALauwPIIBKKWL1a4kQCigu5CBkb4INA8Ib/FPGGEloWIFGeLWgQdTgCWPQBDtoCkXmZ69LBIugHsGEFaGKSf3CGlxwGZziGa2AIUTAJHBiOkBCFlciN73sSZHg+gQhKlAhKlqzGtrvGmAi47wA1TwA1RdAFZUiEFxgE0egCtBACxAOdfxgoH1AHXJiCV0iKSGgB0MAy2POGzHMrDngAa+E2pHk2fdQy4BMCAOCBJYCANHCBgizINNAooFO9f9i5SXTIMTg8N3gANPgCM/ClhXOax3Sa0Bmsp6OLZVmWwkGJJfKHFhCAxqMIK4KSl0SGUGgGX5AFmmwAXFDNa/iWd5uFDUAnaBSIR5sGYISJrdHF5jhKr3o76VCE/xyQrhzQDnR4m16ohQyQGjHwhkoRnCKbCMRpBQtYhx8IhnNgld95Pczyhr4pAQ4KAmvBR5yhiHjUFZLrAowRSBsAwI4SqCAozDtISIEgoAdhQbkYt3BYAnVAgiTakcgUCB8IKsgcnReYrS2oLc3ELSzIjRKThXvTOl4YhmG4BhgQAhpgAiZQgH9gAnIqBnJqtHdhhWYwyt10vxYwUS+pMWXAu17IAUwYASOAgsqbshAoAeaZiInzAQUAARGogWVAA2zIoclwqH/whj0IBzwoFMREIghQBxWpwHh8PTGwpICcAwQ4GAKiObkYJQIiEtrTKBHEUq5UvYIsIX+wASUogf9v0JFYFLdrQQMoGBObQIOXgbriU6IS+gcbaDdpcAZ9SEaBiAZnGAAYcAQFeAE0sAEguNATIL9/mMJ1mYhjoAatY4lkhEIsTIlhYIWhfIlj+FMSNR8elAm78w5EwLsccJscmIQFeIEhiIwqCIE5YAPDAUCBMKBIAAJxsAGp+R1mGw0XiJQSYIMtOBzQQYMH+CkuI55KtLm6RIALEIK9QgnQAR2HnAguBa8giIIHICGoUAA8KAEVuDNxM1CrGT6UMFNRZSIsuAaV/IdUkAZk0LprSIA4OAcmUph/AAQlkIpZwAKNy4KVKL97a1SXoFR+owj0iwn0m0ZQ7SrnOokV1Rf/8DADQTGBSaiTDDAHJNio4eECgzmC70K8ieAASxCBEaCvYkGtZ2ipbwgYLiDWw6G5UywRFOqr4cHZb6jLTLBSBOCBFxglmhMIahVT0KG5OxgDBxiog7RPpzGGORCCoLItDduCcnOJwDATaGFTDXuBlBEAVoCFZkiFfICBf7AHk2SiWEQDJMCCBMiC23CSdqGFaMBCbniJdlNGfcNFmWjYk7Bbh3UjTKC7lJinTqgjjO0EPwKCC3gBKX0GFXjWKiiyrbwVDgCAFig2lTOMD8kZLgiBI3gMROIANDCDNPAGKX2pxPKZnRGChosELhBIK/sHAwBADypHiqBWbW0Q2voh/yGIhBLImNqyR5h4uuTTwSS5hmWMhRmQChqIv2vhgCRBAFTAgd1YiTWyot2QTdz4xQa4hgbAVJpINMD9Kp1AiRwANYFABKEgiYtNCn3JgETIgAxdqKS4hEuohI75AHXgmxAQndxFOhtwgBQpthopAys1Aw5wgFsxVh/oAtz5B2A7hfoKjTvoAh64gGd9WuCdrZfhyCwFr1EKWoEwzFIaJacxAx6IhBkoh2sxt+FVvh0BIhuwCTYVEjFBAutZSWs6UHEjExsIm6ZaCUyVBWqYzbsVSuawCOf4VPKFl1IR1VroBPvDBOdSCoFIXPlliQW4hFFAgiBINhWAD4iTC8M7nP9heijNeqgiNQs0cIMGAR0I4CDU4bbVSANs4ED9E4I+KQHNuQAeMAMPNsXbdRYWzFaZnZ7kcYcSiIS7nAgDZVNzdYmoIdCtVT7lmdMwMbdHRqKJQIMXmIFrWLt3wyZeiIlDU1jmGF8n7hLzhTuSmCd96YQqNoEMIKvysoRcXpSGoQit+AdSuAAjoAwX2AMaPQLRCS8IGAMfOCGkKRpvuEoh8BHDRLofeQCv1BUiRUVu41YkUAAkWAJtMAYEGOdAeIqXGWShBcCtLCAHwFo3cA0emIMS0IY9s8x7DsnRfYkf2lXiu1ri/YctOIIzEQgOAAIloYYp4gaLoAVDiAm8TS7/WGDlFuOApEQJNznO9yGrichQBVCKRJgnilCKcRABV0AFJhglb9CpTPHnVfvPMngGwtidECiHQvGBuRKIxGQeGQSdF6KMU0CewRmsDMgA4BXIDL1TdLYJdo4Lw4MAB2gMJgoBf0ACHkCASlAvyHy6EkKDllY+gYbFd+kRk5Q6GDgugQgF4FIXsWuJ8JOiZkDYiUYfV36OkBaIiSWJBVCK8liUlAgAJ8AFI0CBJ/gG7Vo4ogUdZFZZNT2HTmoQOE5swfAB0OgMFSkWbPiRefJZIZgBKy0BBFAC5pkeyKQLakW6O+jWf1iCLvAHJUCA1w7tqDDQ0WEiSU4JzURUNLDk/y8xAg7CiWIYyr+dCFHQOBKAvJOQBexht9tMWEplCbyV63fZ6JRwk0Xpa5TYgCn4oiAgjDIIgQJhYJcWiCioyGPxATeYD2I1PJLo6tVaTiLFBhWwFt02gzmIhH941ir97AtQAuNVBwXQatMwTNR2GjRQAoQIyNcmnC35IYJGmxeQLSBiV78WCA8IAGtyiT+VhSiUiWSMa5Jo4uhmDrqeCGCQrpbgD1jj5ZMQgHnwAioQBdHZLIyZAwiBAE8ZWoo7hZyaMjzggMUopRsPnfbmGMk5BRUgIUg2Ah74B0DBhQswhgyG7SWICh+gACH4A63egihwFjydAx9Vgip9ciV4gf9NngnNrAvzsQEkONSDRgmJpqYOFwhZmIYPZ4nduDfZTGWBCF8Rd2WLlg67/uWTQIV3uKYJWMcjIICicwEu4AK4CkBbFYg6E4LUOpz1juO52AJ1IMWKxAYk2kh/MIISuID+EwIuKIEqfe0emAOoaAElCAQesAv/rO0eUQKcABRSLwFpng6o4AAHTxsFqA09TeWXbAjmoAVp2HPpOIbtFfHnAHSXEIGJ0IRXADuV8AVf+IdQCAUSsAMQKII0oAOdyjz/rdWTUIFACAcfQDwhp4gH4CB1gPeC6OSBoIuJWIIMGAcksIHffW2BJHMzRdMvWPdHDmio+OxyEIILIHUhQAL/JDgC3Q7JZfnIVkTXqLBReNFMPEMGQ73Fk0gFlyQJLTwJX2xJ6NhzrVoxdOrbiQb2rCXxOHmOT2DrlPha7WaCB3iC1xOEWGWD/1O1od0CN3grHwBykgAdDuqCrsazgRiIHtEbG1gCixECHkjwf/+XcjDROYBaJTCD/vSHMeDXhccDqRBIaVYeqwGSkbRMix8do0obkREIBbiGFliBsiWJZhiGPqeiEU0JBl2SmPDJZ6c6lEhKEv+Hu2KOMho7QxcIbf8HKnACCGCRn7moEsg/dpaLf1i4N8ZxOH53ttSRf4DTgPbkIzEDIUiAXQ/I/OmT154DPIiEqkcAZVAA2C6Q/7RvbQT4FzxYgnNQgHJAgATQ6seEFg5aFjnikSOIO+K17ZgQaIGojtpogGFAJwj9h1hwCQEYI2QQAOf+B2SABWRYdpa43pY/ifIn/JPACSSgCFLIUFKQjsAXgA1wAtKfDAUTrL4JKKRfjT4AiH8QdvwrCKGgwH8+jhzx9wDNvy1o/L2wYWOLvxxC9giZo8CGKyEIEM5BgKAEgjk8ELhDcEGIEn8yjQiJlJJLCS5LxhXJ8QIhQplC/SH0YRQiQg4tkAJt2tQfGolEnVKlCHHqMVuyihVsRhUoloK2BBxjNUvAooL6Boj9SjWOrX/InLl16hXhtbp69/Lt69epBKA/Ef+aq/TXL4mCIB4Q+CBI0LYu/+a4iTKmYJB/QSAc5Ky56eYxPoxwUIpxC4clXAJxEWKGgxAhCpCE44FyDgaTJf4JuXCh3AUEIkv2lhlJZG4EOIXw6F3aB4eqQwtueeFjyz9/R6JPdWugIFQfD474mNrdLfcT/1ixWt9Xlq24x2QN+0eLFbKusvy2R4jM0GEBCjgggQX+NU9fABhBAAGC/POEGVXMUQVnB2VW0A4QZHahU1GQ909UFC3xTzjh/FNFFUq8kIMN7pSwW0GRIKRNETa8IA4CSghRjknCZeBPC0r8U0IkJY3kUk4TgXjdV0MRhUYf0A2ml1BoPACFICr8c8r/P9vx1UJ2NsAgwD9h/SULK7aUdd9+/8Rh3zEBeNWmgXXaeaeBYALlyj/m+OXLLfOQ2dcRp3zAZRnbfBOCEEZwiJleZBQ0hg1EcaCASOGUU044OCHRQhFKzFHCHP+c9M8MGfyDhCr/KKFEJDaAOkNKSpTHw6imIoQSBjlNxwF0TWEHnlDZHfFCdE1mlx0a6lSAAgFlCFJGQSqkwdRXNhT0Uyiz8CUALUDJIssx7rFC13z/HOMMXXi6+y68e/G5wD+GDTgoUCSQMAFQnxghhmOQ/ZNGFSf6IOlfGbphxBZIDFkwF1WEU8ISHCDhjg0iwUhijbyJpMQF/7ywRAk8hHNS/wn+TCISSSH3WEI5QckUEZNNCnUEGhwc4RZR/myhzjenCNLgPx/84w0BFWwzLFVoIBEdGsM0A9+50tS3l9TuZQ1Ue1f/dVe8YYutVyV89oknOxOg4tQDKBxKwBOCnGIGTm4c9A/Ce0FAxhEKtLZpp1UIEY4N53AxxxxgjmSqEef80xwOzFnkDm/GnFSOrf6AXNKQk/2Di25my2xpzcJO1EKlBT3ANELY+RMdtG8TsKXc/3zzjzqsA4WGAkb8E50jzfDyTyxg63UXmgjVB6CBcY39fICB/aXAntr8RcI7VKEyAb//dP+PCIyJ4eATBakj4R8+JPRoXRA8AHNB4Uhcgv8bL1SxW6c2/OF5EVwUFJwQlvCCZQgBRjhBwAyW4Y9ACKFz/+DCkXo0A+o5ZWYK0d2S/GGEiUzFKEDpGe/+IYgPfGNoDDLaP2a3pVNgCywOQ4MA9PGPdqViL81oBrua0R8C1SMfTSkXUIAIvSGGTRR3+h5C5ECKIzRhG2W4QxNSuA3VzOFge/vMVy6DEDcMzn+tqUJqUFIFLpRDJ2YYkTYSMKQLJEAIFdFRQXDxOZPwICMgK8gMmoIS4aijSQYgyhYeEpSdHYtYgCwdiArSmDKIYXYqbOQJ/9EEb0AlkdzBAhaIMoxFIIOTsPiHI5wyjGggJBZA8RooBQSgUJISKMX/KBcqbkHEWdLSLa1AiBe+QgxJHKGRtvvA7Eh4OAf04TB9WILExlgCG5UgBCgKWRVCsAc0OO4fPMLcCNCwDCWYyHMPvEBMXsDNOcioIP4riElesjOedVB9xkLDBkdXkD4Myx/q4FL5jAZMFP5jGwTwp9HoACakoKYgsgASMpCRD2Skol205ARCfEggOtWyorUERieO4LYyfINB33iCA+63BCv+gyB1IcgDqoCA+3GBAxqxJkIO9w8z2KAE10AALrigBGXYgAcm82ZOc/SCFrShHBj4x1GpohvfKatYW4COUVCXSJlRByNGUAEdHAm3RyryhHT4QBMe8CSFcMAfTOAF/xpscA1DyHAv9qgT8/TirQDF1aJ2JZAc/9GKTGRCL554wCkIIIZ+NoYALhgjF3zAPrcEIQhHiFgJwsGBcAhhBBcomDmPoIyNZOwlSmiBGZTAhZABRaYZeEEbwkHOB37FJELQE5WQokF42gw87tzng7DxD2w4aLdAIR8UmNaCndEABhWBwVuHkY+22gWVA4IoX+KDr7tS9y8TSAxQnKCXWwIFiQhhxD8skQGN7tNQDIrMHLjghh1kJm9OuZAP/nC4cLxgJGPs5j+6YIMl9KAE29HRDNDgjjuiBCgYuAAeFleQoyq4KQjoFXf2MrO0vmBKVMEOB1TwT63OTmgI6S1QTv9xiruJTFtYuMELmACDRdQDum5xRn70Ysrq0tiiG9gAQl6hl25QoRsIacRXOvEPYNTiAUMAZmGB6YIuhOAfErBQ+zIThT/MIRwtGC2JwtHkf5zDcZVtgUxK4o8S9OZITQkHN0n1j3LO4QJGkmkES9DCusiEAzbiAAY/aAS3FXZoJLQdQm7XlA8MQqzZsfM/SNAPGyggAZscRiipEi5nMDdAkX5rQYRYIE3X+C/SE5AEYDsoEHgPITgm0AImAYwC5KBtRhPEN/wJmW8gTh3FRAiH3KuZIyyjBC1Q7Rj/EQITHcEIztxgz87xghkIAScFMRJSXWUGEJWDBzMoEkJGktT/IT24BBHey3dsVBBgNekIFfjAEBjkIFl7uCAgRggBTqGOntkZIgkowjD6EQoZ5sPFeMJ0U+rhF4p2epYW/ooraICQ7pH6LwWgyiQyAIxspEGFiizIkjv1hyhshiqPgsARhMCBBZSgCpftJge+AQGZMEUmeCgwOl9mIoeBxwg5aiAAdpOTxS0uEHNWVlptwBSJBIU6GnZQh90twhQW7StlOIU7f4YGViTgBNfIR4tjjCeB36OuAD8MpwtOXdgu/B9p88sk6gIMSdQiDdBqioNo/UDFvhcoFVqCETohhGBzYWJHMIMPolKQq0zkD2rcTUlQUoILtEF613HdFv7g0wsc/3Vj2p4DRH5OFYsRq0lm4FIKVSi00dfOLbdzJweMANsBtFgfLI4XPwoCXYHz4xADFzsRP10X3SOECgXxcV06kXaEJAIYlgCKqrLhAgYVZBuKdME3OtUlEheE40CJAmeMQBM2mChmJegCzs6THde9oDdqRlxJPvuPYkoqQ67zxxJEFYmNmSq9mv+K9jtPFXML4gmN4dLt3M5jiNBj6BZCON82PMEpPMDgKdsiuN4/GILA/UOlEUhdFYTATeBbfZ1ThItbpYVfTGCNHdy7+J4c/MA/dEMYfAUjJIKQhZeqJAJCTEIBTIIZeMMQOF9BHEpByF0JSEAaYBGuIYSkPED5Bf9blnGB0I3VslSSTLgDyyTeHODBzMBBSZUUGZDBDhTT6+BBmZVKXy2AZPlFCyDBOglLRChECpWPuoHe7Djf0ACFxTHdKVyLGgJBAgAIW12gnQDIPThFLvAFweEeIepFDRCDXkhCQShiUxQAMRzA8hnWh81O9KnWtC0WiWkIduzBHsRPp5iBbPHMC/BAzkUCBqCZz7jFDmQhFwZDb5RKQSyBGZCdXrzA09AZFLhN0zFdP/Vi8wGFoIXYN2CHD7wADSRAPURaPvChCP4DREVaX3QdBgIFLDSjW5hJgYRCjDlUIYZNJxADIwyfXgCDJwAFDXbAJLjBM4AeHSpSlc1BFyj/1oZoxjw21j88gKbsQd9dQAgkFtOIn8xwAR7ghlARhaQQQhZeoRX2QR+wlxnA30rsRiTMwIf0hRF4CXh8kEIoTYMw38VFi7uxIy82xTfsDAfQwA3UwyYInD1sglP8YVOA4D9AAkKEEiQYgu0VRFzlZEHYwyHEnoCAjUv2hUwWIi3aCXgJSC0UBCJgQkF0ACbw0il4wwgJmvNBX4noBN2ZlN1dyBFcgPTJTyC4gQV9BURgRMYgAACcxMxICkNiyPr1wR1IQUn1QDD4A2WFzPyRRl+gTrI0iTqclwh9Az+tmyLpIF+gQT70QyAKnD7YQ+yxBUJYo2P+Qz0wTz3YHoDw/yTzAKVlhhI0FoQjVGCmNYUsaF1fhGY3wksHFAQTFISquEUinCNQQOU/OKUnOIC6UQu8QcY+hsMDXAj7bMZBMGDM/MMeIMASnGFTZYc/dMRJuMIL9IEW9gEcwMEWRsQOSIEUEEJ3zsEX/IE/CIep4IEC3J9T/MQ/NuE9NlEKCQJvwZtW8YXcnAIU/EML1Efs2YNqTqZlAsVo/idQwOQ/ECi80AI3ukV/diPv2ckB/IM4UoUMUsXDMSVCdEAB/JXQCJphQkY0SQj2VR9maEhCdMk/uENW7oEboOdT+IMbmAzMhAMggYEVcOf6wYEUXGeOWgEbtEEPPKQSLE7MACRVMP+VWzSL0fyTCBngDvriXszOEGyLZPqQPvBDPhRlQaBmXfBkUxionSQoVfhbQQyDNa6mXjyogRxfUxQfImboP+jCInbACBwA0nCJIymSb5YIZdAdic3j+kEB/BxOFTSEkjiF7vwRGnDBSgykzyQBIViBFXgnXdZod+ZoFXxBG9gKGrTBBdjGX34FhmnLsmRkB1XAP5QB8wWWfJ6qUyBmoCGZClBTHvJDUUqUXpDmP3imu4Dp8IjmX9CFlpppRb3gPyTDJCACsgJDDBwBHYzQYALaG0rfHKRPx3FIZtzNGExIiVQB4AWIBplKCShQE+DBG8DBo+IoXeJNjhJCF3xBD7T/wQj4AxLwgIywaEG0AAkixESoQxl8gD9tAzYoSvM1RtGUT/n8gxjETS8iIMBgw3k2WjPqw1oQ4psARZn6haYNorDOUoX+Qw1OwiREAZ9tA5e4YT9VWVb2Qcd9BQS4wRzEDAJ0wSnkWWwVEA88ZAh8QThYARxAKqSCQc9aAQrAwRt8gdF+AUYglX/xxREcJVAcQRegKoPMTnx2lUeVLEJ4mPPNjiAMgSBUgD/0xyJwgj2kBUQZgq3OEpYihDOQC1AASCgICJhuLC11bGwWKzAUwQN8VUGIwSn4raxtg/SJwxyMgWcgBPX9gxucCACEgApAAZFSiT/YgBKUQBH4wxz0/wAe0MGO1qgVgIEU+CwKtMHRzo4P6AhzuoUNfGrR+QMUqMAdbBgJwSdQGI0vCcJgUUUJLWlNllIP/cMDUuBXBGtT6OHFOsVl/kMoHO8/OA9CcCDdRi8xtOaQpV0BdAAw2ICs9VO7EdY2VFlBuAaJQgpQ9AGVcQEXhIAgQK6AQAVMtMAWzEEbtEEZQCp3EsIW9oHPsNwLjAAeqI8/7IESiKpepE5VqI/ovSEAKlJHsSPz3emWyOEc5kPcUsXxOleBsJVl0qQFvy2uUgWXNgXxRm/YiAAwAMOQdYAKT0I5IkIivAABxO7QKIoO/tM2jFHBONMWbMbKIkQU+EAXIIA4lP+AOqCAof1Fz/iDGfSAyynBF8xBue4ARkCFkzgJRIjTAkRuX/iDN5QQrD3GCH3AE9AwAXyDGTcFAjqpHBaEO/1DfaQFc0Gv8EqDMzBvUwzDBx+G1JCpgRyD8VgUC+CeCPwDMaCwJaDw8E0CeREANryabm2YC/SdiSjH4bJXD0fBs9mOfRKIP/jAHITZ/LYBB1EEczQHBiwBFBzBFE8YD0xVk6CnD4jk6DWpbxUEkzIdBDuFqRYFQuRxU7SLHJdSfTTDJ/0FH5IwMjcFCg6fmtIgMORAEKDAHYiQkgKabt1BBWTl/RguVZBBH7hB3zmTC6CAFrUvGnCQDwjBGAgFPuL/ikkgTmzYxgVUClEIjpztRb6CyAOYqgp1rcD4YsAu3S+m8V6gAFXwJ0KEMFCAIEIXKELMSUTxBQaLJpnWw9qCXTIXRIMGCL18BQoDxYT+QweIwDmkwRDsbgR3VRMcVpNxCheo3GZISnsVBGYFgmTcIydX8UzggbWZ4oOdRErgVMmUwCeohHCwrqGiR0rDncmW8RweYIE4AqYptFPogyEE8/PqBVYjBNkSCLtk9LsM8mEcciLYgBuUUKz9UzDacBeUSjgM8QNgX4VAigQ0EBdUwNBUQBu3L1RwEAekCEoAgJG8DFC7RKkggGqJnF7cH2D13y724ECrtTdAtYFgKVXz/4XAjTBYAzKeLEAiXMJfpAAp0MsLoIDc0O7SmZBhqYY1TcwDdAbiKgTKzsEpbAMKpIEW88XMyAQUKIHJdMRg60ZQ48KoADWpeFtGNs1XpJzXUiJifkN8zqFI/sVTI4RLBiJXW/cNgNIfxyRCVLBaWNRbiaAzdPdm90VIf4WakgL4/INnV0IGtM34iFBtUy0Cwto+PpDKeoaFcEDflVEXtM3MGsjMhJxI9AiCJ7iC90hx5HYaFh0aHEEjV3egucAOprE+OUV1fwA2JK7IIMQxP28u1APzLqhbVJodF0iMlUtcOO953wloWwIDqGl4iUAp5IDbxRvSRemHpdAzYNbe+f9DJkKAD+xBqZSACrjAIECdgydml8TfT5uE5aAE6PAKUIMO6MzBjpSlbhNFLvLiwe5FHHLY1Gr4LzqFGvmqUywjP4S4L6cSUNgDJ/zH2NgD8c7Vi9vJJXR0SIuAJWiCDTyDGAiaCzgGNQOsoriACjhTIBzAEYzBo0eBA0QIiXBBF6SBGKjACzS5hPUM6pDKgod6cKREpyJBofoFGvgAySpd7j6pkh3mhlGFQd8nULxBB19DPQyPwHGCHYugNYamPdiDNKS4gNSDPbx5nrtLKcggMYB2KfzDD4yCEbjA6PnTP3it1aaQN4TAUTdbemVbqWyDC6SbWNmrX2jQDIzDAiT/+B4lOOjoxqgoNqcDhXicmy1/QKvXxd8SzQN7JF9MZZd+JtjYA3anrV9gGninRYgrqF5gN0KEXbLvRdpJQkf/gwzOZnh9hQiIwA8UADm8wPh0ZC2nEIcH0zcQzAHIV6dgWcFUABSQwRDo9byfuz9AUKjfvJEIwfFx0LlvQRqgqtL5RXVbO8G6hatSxUL9g1dIlJgifTFjsAhutVukOPM41HRFvF5YgiJOAiI2BQoDw4QyAGiLQEhrwSD/y1Y5CIipmyPF3QOoQAWwQQXMfReogDo8g22rAAeYQ+oicQVlBz2UwLu7u3EDteWkxDVwwFXMO3YcARTwcxwWyHy6hWk7/wUb/EMhqBEEykWATANfDOWd3EMo5YLUuwXov/gk1IIkECtQWEJ6IwQpIGJIgzwwbQliOgiHG802DE0aQEAFuEChfwAZFPo/YcMgoAET8EARmPsWF4QCkOKCl9M/6GXioVmlnHpfPNXCEqaBHH1fDI2FNwXWMRdb1EN+THQtCVxoWjXWO5mAmMAkJGXrp0B7N8XGawFCMEMRIE0EB+zUljlACDol0EeFbacIIERI4N+2Cj5OzKA0ocW/f1ssZtS4kWNHBUIQICiBYE7GkiMR/Cthkcu/Tv7Q+PPXkaZFDlBOnfpwiiYBAttqBrX4DZvAjNsYavTpDUzGUPYG/DNksf/eIqn56jkTupVr1431LCJr5hWZV7MZaylicZatRU81GVi8RFOOlm5zjXijw1CQoH9+/w1s+O0gBGxiBAH9R+ADY0Fl+tjAYMHUBSJthSrgwSVlyZIZL6QELaQKhxYyZ56F8q+xxQ8dFSuNLZSAoG8Wy/wbw3jjE6Fgm8GS9k9aqIypytIEqw/z1lwd9emD1Zx6devVNf3TtAqJNxRAjS78ADhwYoavLSJd7BcCkwn/PGyAZmNj6ubuRv5LiUH/RlwZEeDBCA62kImtByrw6zaaZrPIp6S2Ao812IbICMKN8nHkn+mQOYaXfDIaqyNOwIKqJk70mUojQ8A6y5AU7cn/CMTraMzBKwloNKsUTS5ZxQgHnlBQwsCS6ksQAhZUL6nXtnEBCVxO+IeSABSw7x8r21oCjwvyE00jBC7IqIRyOPABNbP82eIBAp5QryMxvEJvIziV/GkjxpK6QxAXajqmGGfEYk6jqDiySqjharrnK6HyaUafa/jJUdIc16Lxh4xKuWQUJrxhbKCiLMLmNb4Gqs3BnRprbBsUyGCiFS8ywqWIjdCgjh4h8uvoPy9L+OOFmLAUaiYfHviLADitg9Onjr5pDTYH/1lwI06u+WcYi0TUqsWgHAFRHw3b2pamezgZS59QkEGXKxEnbdfdSwoABlMRyAHmgTLG++s2O8Eb/2KbZrH5B5ttCG7oPALScJWGf0SZAJQGrqRxjxJa+uezjbycYw5zUJsp2KB8MOIbO2lsMCPfGHuoJvI4Gisf5MT9x9CukInxn7FsHterF8EFFNC2BHVX6LZIIeafHxL5RLt/yPlngX8q2MmoZTMaaKBBgHpNDJ2OZQ2FBxQIQAAPJhBlg1Zu4SAjH3zADI0Z5oDbohk6WmmGcxL4o2M0LULwSOoapDq9xZrlKaMKLQIs4KBCyYcTe6bLKGiuolrEnlT+AXfEfw6xSFFyc+ZHc41G7yhydodO3aJertOklH+M/sGGIG3bRlTeXPsgNoJ5f6IxMcRAmAloQNngH+On+P+HiFr/4eAFzF7AFYFluAzT4pRWQiASIYoIZImOP64JDWLXuzDCoQTuCtl/HHCtow/kVI6Ta5M7a/KtCP1HUYtQtEgf1DWCDK105FqqM2BGWKAI6oDCIsv4RzfIUQpmHAEFLuBJwEy1LJItZjG8edBfvoaFVhiPYRvIghdmUITweeUFxtBGApZgipVYTD8l0EY5ViIEGyjBBmdaYUe2wAEEae0spPILEblSBggQgA7Mook3LAIDmiwiH7EYmoqksr9c3I8rnDigdSr1xUo0wiKVuMQrbCCIIP1FJ8Z6jaoaEkf1mOcfwUvDk8i2sPhkwRQboQ9bjFAC+lygCDzgkkX/LsCDIixjDiKxQQk4cKazcKBM+PJNUBoEFNsNziwBc0FuMhI/swwQW0OzRz1yZg8vxkxzpftijtBhkTAGZRKYcYXSMqINMkbCCGr8hkB84hefOCswg0vI4ATRhDtCQwA4+EcWUPEPiC1uJkZoixESgIYGCOEFJSiBxv6BAe5pYwYX4OY1gGUAtqChTE1ojF+E+RcLdSQpVmOI+YJSrCJxsiYQYoUULSINZ0RjOFy8zoz+USIv3gyLC5WZUGb2SqEoozk5yIZFElEdBoJCFTlIg6n6khHCNCSksQmpa2qDsCLggmymmAANXuqPISyuOUYohD8UwMNOCMEicwiHEMYR/5o5XOAFe5DkWbYQEyi+sUgQIkyzjhLHaJmMKxSkA3pE2RVWDKMYN/vHz4Smj6rkYxHXYM7k6rcVsMRMohvxBCI4kgGvyDUjkngaWygBCkr8AwRISMMTBBO89FHtggIJjyBEdYpVPQAJxvCAKVCRhQ0E4ARbQIFf1NGcB9z0eVxAAw9UYhEhLAEJPJiDMV5w05hY5IccSao/jFCBjABGMIkDlVI2QlWuJEW3NCkDCmT0D2REDjMGrckm9NG/mmgofxbZhcxU+UW4boWiFslBDs5Ri190ZRw2wugCMnqWvVqEEp/IwQMua6yMiMpBtqudvjSZODqkwQa4aNg/JpAFXP8wwbIES8rzzsJYf7Qgpy8oghKqJ4Q/GIGne3hBlcx0lpm8tg8qOFjiIFQUmko1YCM1S2xe8wAoNLEr2/BNENomxbRqJB/SwJxFcubVf3Cifsb9B3NidA2HckSsNZHFcCIqtLdwBRFDruU/ajFks8wlEa87izZeUYlISLATacCXBjk4z4MQqQwarGcajICL96BiAwLABQ22INsyYGMIKsgsa7sSMn84LwFVCuc/jCEEd3AAtBhogRH80UM4o2kmCwKlg6j2IEULrit4GhVHVJA4s/hmYV5srkWcEYtmxKIeoSigRTBnqBjZw8bNscciEAXAjmwiAv/YBHWU/MpLECP/vGzRhiZGUYpSWMIHKGBq8EQZX6QM+1SNccELGsBAi2TBGBbAyEbU8WavUNJjR1CCP16AYJFwwR9cKkELIgngQXfFQKvJ8rM4ic+tvPE1hrOIu4cklCe4YAhNCEozBDhAgcY4K9EpNVdc+Q+EbmTFbU1dIoz2D3mZ5ROVsEg3RHCEJxyL4ti40D3ZqGhEO+gFYs7CP24RgADcwrJy+gZPnqdOrqDhATNBAxoUwLGcXmAOPHjBDvHQgpic49mtrckRIn2nRC9a443+C+JoAtwjqftNySQDTZpxjGY0wxnNkIa5+PdQrsSYI1jMSME1YrmgvNrg1gFGRiXRljH+Y9bB/zgCb1IliGBPaDxIYZJF2jQEDrTCbGYDOS584Jt/2YYA6vhjV9BQoIvEZA+R3IMQzMkEfwghEC+QyRHaRp0tjEwj5NEkvwzWW5rAs0JJ4ROf3NeXNwZlZLx5AgRqEo2MIEojzpjOwKEz467owyrGrVbZgV+Ttf+DgZXgESlGkcaJy/Nv7o4jPKHFwZ2cwgc4CIDf/0EDXNjABT7Z2t9mZZYCyWT8CuAAGvJMMdhe4QiXFzdmguVBemKmQoK5kCBUYIQjvAFafultg9yJK+qHuISCH3KhHg5Q7E7kH26A96oj4P7hGIJvUizAFTaCFETAElbhCLrvnrIqlCbESDzsH/9cAAVawBRwQBUawQMswAL+AQlg73DEYMPIzUA65jQ+6x944DSEANCw7Qiuw3AahE5yS6q8Ap764jYMRxDuID0Qg+6CAoNAkGa+Chn+LSNipCq0LiiG4xrIziJwryaeQ3+CTHWiJCPM4R8skCsW7izmQiPWUARewQjS4LK6hiMwDk5YZiEWAwWOwBVAgPgsYK/i4R8g4DvOI30qgiswwgbJzx98IKlaIAGUoEpsgNsgUdCoAw1W4xmGQk7i64ik5dyEgieWcCPQwwiAiyfWZ/QUi+mCQhZkhBe4YhH2xytAxFAWKgyPqwwnxUYs4R8sYQks4q6cZityQK7a0CuMkfj/LsEVHM4SKmEc0sBgplCOrDGU2ARCUKA9VoEUHs4LWoEJOKAJhM0v6OPZtsIRO+blsM2cZMINCgQNAI1GHmQnpgoEP/AfaIc8Vq/zLil+7o+Y9nGfzIIWisEYxMIZnIEWuSVzpKI5okKKSs0eNiFSVMcEOCINibEYm1EjmMC6JqETMiDh2kITKkHZ5IIJ1uRv5Am3zAeeQMonoGAcQIAUGAhWWuEE0AAFkiIhPkAdVOgfVE5YPAZ8/MEAYMIfuEAFZMJMYMvnumIn6qmYuqL++IIjFGIrzG22jo5GkIEWaCEVBmjHMqLVMgICzwJEeDEo2pJGhswMaGIEaEIBngYJ/2jE4SrBFYLxH0ihE3ZD7qDQQj4vEVcPPRDGBhqBFC7BAkiBBhgIDdwE77yhCJiHKwwkYtgRNRRg/K7EBi6TOhAETviE2LhiPE5qMNkifvbR6DRiODrEf5xBH7iOrYBGQ+zhGqTIFyUnRhZBH74wdY6sOoihE0wyKI7TIkiBjBhoLlLAEkgBvbLGFEFxnmyjmMyHJxohBTRBr2jAC3DhCNLA3lgjSE4BCd7PKDPiKJESElfLH45gEasDCIvCVCYTD1kGBP2iFYVifThAHRLRIvhzMYAiQN/HImTLIs4hLTMiOHUvR0DkHjbBZmBBXFKhRTQkFzDSLCfFBNLuLOjKIv8kgRH+odb+weHY4hIYUxgvQeIEYX1mA6o0gqlM5g+zwwsoQQCmwBiQoCV9Y+kUAMDUkdyuhD2REiNeIPOsA4pCSiCDIlUupDW74g5wRzaiKj/v5AkOTSOuIKFskzlw7y1pIkP6wSLAZRi6xTg2ImjsIRc4lEMlKgP+wCI6QS45wsneUCNMtCMawRyygxQsYRnWZH0wrvNeUl8YzSJ8wAJEISNIABUCAAt8gDwF4gNQQAGQICnb4iiLNE049R9aoIdCEzN8wMKYjyGc7x+GIJhwK0eqEUvvhBR7QhB8jWUOz3/QMiMWASMtIhcuTShyVSNuYCzEhbherXMsonN4s63/SLIj6MrJ2CINtUEb1vBEjSBVOGh3JmRGP2hGv2Z4FmZhbKGZfIBq4OQOAkZtNtVIkdIm2k8qxedKpLONWnN9mAr1SLAmFEMxiGmeYBEp/I+qCgaOLKIJyTMjhmEAtJBQeDW4vMIBCYgjxOUKJaoTLMIEQlQj8jQooJUmGsH4/iFT5jBf1kcPmyp4jkQ9hLAhyuCOrmEDSGAWBOAfZgEGjkAwO08B5JMtUqM9i/Qf6FEmRvUsaqVcU1Uo/sVBrEZgDOvdtoyDGKJBaDUw9oUnYPFAs9JkLwlWweUWrYIfIOEeWIRGHNQrbhEzkqEtNBLJamFoXMEcLuESROCMkKD7/0gRPWoLtwBDTqj0BWYgC2whAGRRAGYACYLATjjvBTWROoz0SmoF28LNOvwhDUrlCGdrpN6LI4QNff7hDhKXBE2FLeLrMI2wIxyhHgyhYW9sKryuLQpgAqmjyP6hA9iiL72iEkbhH7jDBS7JK/0CG0oF+qo0jhijCY4gIWeBGlghis6Bck0RMFRgcSXXhzBiQLCNcZvHByxuS6Mvt9xtK7dSnvCR8+4JIaAFPZ7BUxKVNrqsjmYynjRiEOwDTnuV69yldWE31v5hHNwQRbsibi1iFPzqJ0ZRPeCHNaqUCAcHPWjgGsSSZgVACBQAvT6ADuTuAyYOCYaWcTPTJgBsg/+9wuUgoAk6JZOEAor+RZOyanNntCGAC2raQk6YhOnukMXMFAxXlzYPaDocgWJhlytEADNWwaPcx0IYQg/dtycQzRtsIAEyghYEAAaMYBvWTJj2BQlOQ1KOigPa7x+UVDQVw/muNlSMoiiONp4wDh8tAulWg828AomN+GrxyRAIBRK85cYOSFCW9YcNSIIW4HCjxX1ATDU1lx/FYAhaABdm4R9YQWzohh9TmDVyQx0sryPglSY6WNDSsy1i4gEizXxEbzDAYwQ1YnOxVCBWQwXgZ4wFFDaYbjagyCJuIH/ANkPsQS3PQoE6wjisSGjW1rpogkTLjjHfEACY4KMubp//lo6GM4JPfIAGGsAWoGEWaAEGKkIgAsYl1aEeOaKDibRnU+NXOMCarCPxgLdQx3hrlDYw+LNQF0Mn2kjSEtTeQlcohFcjRrE5yrYjbGC6NGK7LsU6DMWHaeItdEF//2FODYiBGpWMQnYU5kIbLMISHuAUUFhfS5cj1ogAIMAGIIYWbCEaJJUEL9jRvgGLP8abm+MIzo+cJbdt/gb0uOJHOSJfaALpHAQbaoXEauI1hOnu7nMjYJUmfpUmirIjdMCfH+EfzqA5Po06dIGPl0YTsuMfPuETIkEBqnFgeAs/hQIfa4MDjAGk/2EGsAAIi5jiCEABuHkjWu461MYfkGBI/zXPBQwCy8wCSboCm+PHri1iEK5RI5CF4oyCLVjZqI/6HyTgdTOCGAK6OSZHTavDEzzhlzVCmM8iLtjOLNZwjDRhr/bKFSr6vfbxqTCJAO7A3hqYFQQgAV7AG6i0PJ5gsKEgUydMI15gkzn5NGwArSUX9gqUnyTtSVGRQTAYsWTyH3Ijlkevd6NKVmlCSv8BoCzZIo46JsBhqTciBWZXB9jCNoUmo/aUKxZgLvzXLMYLFExBaWhgTTqoOhjjIJj4H2TBilrgSIQ3KW4jejWVtWYCCdS1OszEBlxaco1gEGQaaTEaFgmgDLLmCS5pGzACZfpptlLKLPL5LBL7IiwCR/9EYAUsgrtrAn//ASMPwX7bajg14kNdIhHoEjMoYa9MIRI8QDpL97BRUQy2YWv84Rp0ExDK0X36VQwGoQgqeSPG+ZLFh53wUnItYgtsQ7otAgVWdfSsQ7ZwPHe4ov4GJ8OF4qiLsgAOQBipQ6xQiX5pBLNpgrG3Yi461iKq+h9ewSLIqFEtwhTsvBXowb0hRGoM+zU8EQ1oAAYSwK/Wo446CGUtQgVQ2r9n4ubK2SKMYGebo9peGBZfOAoTYqi9QjGy3J5PATG+oQIOFqk0ggNwxCKIYS5C0hVEnC14rx5w+TqeJhE64dYtVkSFgi4lQcU1Ys7pXKLdwRQcuo8aQRX/ZjwLHsBfHPz5AvurBYsAoOB5+uHtevqk1KHRNfMfiiDAq8MIClxy0yBBLWQnLOgfikVfvSEIOsWevZw8hwCNraOeUIDcO2LDgwLfNWLhgKAmuGjWaUSzn4YRasl2xxszdMkU+ugfXGq83gMaarzBMS5A15cmSIyJKgAN8kEAfOANPFGN+zxUToHRcVAjHvelKVnJhcIHoCBqMt18hfumtwK/D7Um8rY5XLJdnme6TgDEXXB2aQISgoIfDmGfJyURGAG8MMoi1kDNa6IvbZcjluEVtEEV7vwfGqGPTEEVJuACIv6iQzlCrsqLFWCC493/jJC3TEwMsj2SNuIFwt1t/wic0qtDbcwHG2BPWtBjfQbmLD6QAKyJua+jCdqnmyXFmcyCQyNlj71CElg8KBJB6YUx183Cf4E9I1aB4TNCad4D2VuhEaChvYtOyuVpfaBPS58ABcb5sioVFYsEwX3ipC3v5P/hHL64po4g7ttC5WBLBYYAp0+ly5T5QDHjhSn1jYHPnxcmxIPibF+E8dtiDdbAItKWJhIhvCh/K/pSG+bixTOfI8hIFUTBFMZL4UXhArAAvQYnqIXajdQtdB/AB8rAG1Y2a0SlQs7eJ57gG9ThXTVCgwHin8CBBAsaHMjBxgsbBxs6JOgPyqkhAh8QJPAwo0aCp05l3DZQEEWMBP8EbRzo7+RDhv9A/QOWMcbARfz+5ftnb9FNnCpVeup0cMFAoUITEczwcFSlTwIvrfr3qmGjf0wHmhLViobFgRgzevTYtSGBsT4IeOQK9t+pD/8IDDH7jACdb+qM+Esp8EURvD0HbhGoEAmHf3z7okTjj0OFbwfFGDbsEYURgRUMfvhKcNvZx5zz/itA41+HgQUMYhp4wx6MmpD+6evckBHBSZOMFpT9j1En3D1LVf0XbNSyh41E/TNF6dNUVaFRePsHEuRGAh/CHvQ2CMKDb4IEfYgukLFAkwOri6ErmK9C2AJbcHhBmL3AlGh8qP3njY58jWzHixco3UHbYDPeP/r/3QFbYRvBJ9o/K+jwUoSYFGCJQIgUxE8Er+kzwGv73fZPJ0KBmFsnIwjESCK8/aMNi6UUxMBB4gikyiemFGTKbx784wE0JzxAwDYk/dPfSZuVhyQEKIxHXVv/CFIGSaeYRV5bp2CjghEc3PUPE4Ox54M/SKDx4T9ksuEkbGMFaJCURYbVQpptEkmZC2X2ZcMtBJX22Wj/iHCGQ/kscidBa2h0YowDrchiJSde8o8lpABX0Cj/TEWVKZoWNME/xnk6ATT/ANkWeOydYlJJS3pzSoDRBQldrEIW+eQHCiBhA2L/jENmZ1sg1oINCspnp2Ow0SrgQ1Bs1IR8BnxoiUwD/0FYaLUG8bZii5r09NtArRh340DGKAAkRt8MqZF1BZmlllnSoRsWSQQ8MZZAa5UhZEfqKPBCYgoM29MWdxWx5X4oEPihuuvKWRCbBVFk7WMHDDRpxA3lYrFASP3jSiWjYPqPJi821KJDrQgkSgA4bPAPudTBus0Hxmrk8JwCjYUzgDBDh013gnRl7Af9KaDQC3ax589CAPc1BHfVKWxYdQ+ZWhCqDjWb8WNMGNSBn58ZRmjWB8XI6ECfqEJcpgWdLJCo/+AQgHEKpEHHzyGVQTOqrWLzH0EmtQpBBZoJMmVb9WL0xAeEp2qvGBNdpkBd/7DUWUqTpbT0Qb1mXO9DUP879LnYGv3w2YX/kF5AoI+1JjpBuCla0DKuDDRcQ8uAXNAFArXiQdyizOAysicJ8g1IxB/UqkBpeFMSdLA+dFl5H9D7DxSCqPBPEQxWLuaXJ5G5+UGhnzTrY9v0/Y8DBmUu+sQFnTZaB6Sr5GFPrDsEu0oMkO3QUwNpaxWCROIgbENbFgTiDXoJLyPfmNJXELaurwjCIt9oYJp+VqWBlMEx/SEAY+allkGoQzBI48A5vNeTZ6zrCW3JoEDw1pPmPcSFAzmFnf5hgGe1jjMdoFYGqEWajMBgJwaBxAAwZpgTneghC1hAojQ2kEq4ZFsGGUUpMLWMbv1jFZYaiCgugIP/46jiU2lAl0oEYbwZRm9ULihJdYynuK6wpUgmYUvNBFIXMv2FM/5YD/sI8pwjhcQhdpojnWgWnoYgS0gIQkMOUdKTP2ZEhz3x2kBMd5AD/IAFAqmHPXb4j0qMaIkOocS2ukgpYQjkE5+gxElUEca3IXAsMxue3gTRs4OwxSTbQEEakhegKplkZiCBGkZ+RgAFHG2PfcGL9swUH4dUYEl9+ZkYSHLNOxpkcQcZZsNQQsnHGICZONQIOR8zv5MoQiCHKAgSrVW7gpCSIFPZ0UGCMRBmvHIgARDCOZ5Ty+GJxCTHK4jMxECgsXjjXH57EpIyIyvuNIwtygzfY4IFvohV/2eOTdqIRBV5kX8MQX0CCadKUmJSLj0ELyY9yWkOIpMOqA4c/wDHIwQCBFAeBJUo+4crKeFKgaDSUpWg0SrQNpBGaJEqqkAbDmBghJIMSWGaaddAHOcWbhoEJNXpDkZOwcKHPql5VYrXlEYyHjrQQZmYe4zAbHAEgViUI/+gZkNoeLO2dDVWU/NIGarkGII6FCQEQhNh/OHIksJmnBkpTEsfQoQGFSSdBaGpQPh0sX84wiDt7MyINEKJpUSxEpXQBj7/EU+NLCcSuiuBDYrEQSMJtjuIfEAa/orXf+SyO4Zc10Y/+A8k9Is9cZ1r+sQnPqkdkkjUGUIaH4KgJsiMVv+K66BAnnHDgTyrV4+dJCQtBpNsFKRinanJPwZwp6I6xAIEmVTJGjJAjfymFU5twGTYcrjxcYRwasltQX4GAYeC1JvCvMg1dUuAMnhkuIbZgsDQcAQ0hG8LEGSYIOVIgPNw5cB624h91lBLqxkEYo/xxzkFsgXG7lC9ku3JJgSSj01EoFAjy4grluExSLEYOJVgxigAkFqN0BdtA4zqd5pkR43ozXiCtHB3KGK3hlhNEIn7h2PYlOGOesQkCpCPhC3KGAQR5AMM5UoH72CdjZYEVUJz6PjMMLPers+tSHvWsyTZECAKxJIOWedAZvziGPdkRifp4o4fsg6CJHqVBon/RHwFMhy0qSISkwYADo5gtak6SUoeHNIcT+EY64h4IMZrAgQ6rC7q/M0k2MhwXj3IsOseQQG68rJKSGI3smaEhhXA2p4EIoYok3otBDnxRk6MF4GphJLddYht/gGphujikv9AxDope5AZy0cpB+kEezXyomDU2CDdegpSWzRpd5QLQLJq9x36QzX/tukDUH7SNvBqLHMVkk5J9tuShjE5FGYMG+fbKL9j2Gev/YIxNtQvQZr9XcV+COIHmYQI5gcEaU3bNNX+BycJ0o93/qMm5mUPaVX7D54OhKcA2DEOPrGKmA+E0mzbYibM8Y8K4HfTPD9m4TDCwSabhV3/XZJm/9LSmCfpbZdZDqYN3/APLPzjEAN4gcAtltC2HNjVhpmrePnUC4dmUBCVMUhiM5fiwhTG2H25+kM+9Q9qyURaBVnnTR/y4hc/BqlC1Yg9HXLavgtEd7+Z9Mwh/QltzIAe/whru8ED+XaPx4XJa1NV0zAQ6pHaXDGj0zc4WiSQ2MkHSPgHJ+yRgGFYvVCdgyjol7trjfjvH9N2ABpJ3JC/ULwgu8/9sNiukYoBoTQFmJ8lYzDtR2z8IfeADaYGOLuDkBd3BsHnaaOCNlz8IxMEecqjX6HUTPCABhKpoVWn6nCREv0hD4jLWJrMzSERVKrrgoINEuCLfvyjHlfAwuqjyf8eZhQrh5Nr6QJ1D1cQFnBAezY/LCRsOrU05IRnB8FeHbACBUEMBsEny2cQGqB38qEKuhNkQ1EQJfNoD5EJusN3BYEpF2AMuMADSFBG5HE+kadN3SRgujQQv8RV/5Bd49EqghBnBzcQd4AC/iANApGE6HUFXQZ8fYENBsduXIVksNcQMDQQxrVncScQigBnufSDxSZxJ3FOyjaGovNtkhVtBAETD9FZ+yEqSDWCUbSCK3gQqxBG2kcQJwMCJzgDuDABM3AOaWBXEsRzd+Qw7DJqtTQEH7Ak7sdcbfEfHhGFVzVW18QYjSgQXcYKu3AMnFAPAtGELSBJcVV2BuEY9HL/TQSAMPKidQI4HkOANwskEIMRPhagCSnABCnQhULYZMV2Tr03H4aRQ4UxVycgEKExBZHVEOSVgVvUNbTHJ5i1Q++1REtQEIk3exnhVAKBAf8wQAEAcv+gO40wAzbAPPOiMP3xBDX4JMXjeINUR0iySxVREAgzK2dRJHIWK46hDgJxDMhwXq9hD4bQDF3yAlroPehiSGqmZvMYUOyBQiKAjP+AjG34D+ukOCoQPigFG/ZRiz0RTpT0UgWxAlvDBBg5EBd4WQQhAn5SGsCgknuGSWLDYraQja0QSxqBCzjQCrgAAgdRDExRIyVgBPcWawbhQO3yi0o3W2KXGQpzLl3h/0JPKRBswQGy0AAEQSg6sQjDcAVFICwOMQQoEFjySH9SxThJ+Q9YeBATGBUBEFRbiAjqc4plkhISIAGwESftlQ+h8Q+8KAAXmAJAxIsNgTodpxGY5T7adifv9Q8BYApIBXP/AAKslBG/kQnQMAP6VxDchwuRMAMM8jJXmSzueINyZkhVUkZO4hHFc3BFUkhyBB36wRDIoA/5cA02sVkDcA1fmT0M9g9/gXnfAEEZ9B111CQHdlcEgT2wsTU1ORCnQQB2hRITyEeGwWcVORBAkAKRlYYEUQNbSG0ZsU6YEAO/YBMZI44GAQJIZYcFEUbGMBAwUBCiYgzXUAKcaWTAxv9XZxF0/NUzoNYmw9Q8Z3mWEPAcYlEgBHFlVkYQaCCQ/eCYBAEDBfkPV2AEpLgwYhCPBfEEW3dNARVqAgZDm2NnKnEC4XkQsqGFosMBvbcCVMSFAuFK83OYFtkQo4FtDUGN/0B3nRGf8ukQQ9oX0mAM0lAM11AMUEUACNKQy/Uz7kg8NYNkVWhILOQRzGMZhhOlIRUkeIMEV9AQ+bBZIxcK+qclIKmPg+SfwHaguJYZbBIFw6g5KmFPGQgplvSi7AEKJcmX6ikQkTUagOknEKJnhwkpP0oQfHYQFyKdOnWCDXEDG8ELShqD+5Zr84cwS+lAVtpzVhkeXdE3xwSVZSX/Q2U3AOjVEJvQfMn4AMsyEBDDS6R2ETjjisf0BEtyB2OQYjjEPjGqEdQCE5SQozu0PXeShsjInf9gAc0aIZSVmJwBcI+hOyoRlAPBd6ZAaQVhn/9QDAJBC8awpASxpLTAAxmQBjeUZb3FFu0IHd/wHFTWePlmcK/HUVvqlgNRPN5BhDezDXSwDU1AeqnnGg1RE57pA+qwNy30eR2FRnwViQRABhnGGEUiZhHHe//QAi2ABsK6ERYQff8AqBr7F9jpEH25Ef5ASSprEBdoATTAogeRUzlKOlpAng7hCQahCyX7GDvZEERWc+QYCdwnEJHgNjPwD99aEMawlQLRAEmo/6T/oAJKEj0VBKEdREvY5EEIQ1CUyF/EFiCOaDig846fxhU/c4T2MGOQcA2G8A9h4xBY9lUN6pYzE1bN0ywQ8IQFwQGD0ZcuaxBbc6wt1lic4QZlcnXsNQV75jU96hIEAZjlmbOVq1PwNXgqEa56SBDSIAv/8AJQECRTCnsY9no6w25VOljLRRJsQotE8otbMRCbQEQC2R5s2aCfZ4Vv6jB12hmCWxA51SU06bgIARGXi4AGIXBb0xBMoGcDQQLkVaOXhbM6BbQH8WiZcDLfaBgNQJ//YA0DQQ27mQBY4ANN4AKF01ClkpakexFfoYhSwhWs4hVqoXkFgTehyAn/EP8BAwC3BdEHAuGAGqGcfuNw7NNdyToQziC8LCkQMfADXnNTIHsQwmgYbmdswMudBWCBPVoQoCAC1SAQO5ICvPgDQVoQHKhT+lQQ3NsQosK54foP1/C9A3ENSQgDJcABgyA0uktb6/szbKFhiRSv0QGbPag4/SWx/2Vm5YEwG3oQCdClBZKaYyUkA4EC+qFddroR3bWXBSHDL5GjEMInHcCoyLuxBPEeD8EE4nUSOSqOlAAMEFK4aPwPn/sPbiMQRksQ36i0BYELxqDH+VkQW7mbMPC5MqgfwdQmuJpNA4pLAiqgGMEm4rFIIIo8XKy8yFNMw5Nz//AGr/sYZAK8BMH/vM0aWZG6bD0RJxasEXCno33xAzH7D7MwEN8GDHVsx+ToEJmQCRggD/JgDdyrh+35D/S5mwJRw8o8w6xwvmh2MxBEIGhkuu/oH8YDm7UaEibxg9BcEMuyoNTURtvQAlLMqgNBBs1pGC4wBuzDXSdFEMmqdx6wNRXCcYaBsuX0EFfXp/txAsxbB00hEMDgqJSrwnfSCGGsh7igtE/7DzMAzC7MuQcRvuKym9awm8awC9KABk3QegqDNzjzMw2EDXpT0vehN/JnIASwoAXxAN4gEtZ5EBwAcJUaew6xr5QxBr6bERbVbH/RtwPRASSwAqAgvf9wU5alzw4xkn0BccbF/0woFAoDAa0aIbzM+g/d4KwD0YYFLRAHfblu49AEoX2be8yiUgLXoNbHHMUvgHnb4DiNLMRXCnof+iR200Yu4A0tLRBz4IP/kM5yJRAIUBBpEAVG4Jkv5CTSwVHSkUGx5dQN8Udt5Reg2xB/J7wGUcqHyx4e+xg0UNUCocsHQQOzAxOuQAqk8J3PS3sDsXyIANYZ88f/MNYHIcgCUQxuYwzGYA1SO9bXgAwzYAv+oHOfs7WviNxcp5R1ZY+y29cVYCcEUAEKMgcl4ADYgHnxMQZ0VDjS8RXIEtf/gDUCxxfbNZxMfSf1Y5FeXcEfoiDk9LcSZwBaWAC0bBAiQMcFIf8AJtkSLJkIkRuYM7nLBFECjxEAE+20a83W4UqfxiALSvACD0AHH6DFrtcknXOvqRYgS8IFJmFcz5Cx/+AO/1AO4lN20vEEdeNQqonJQcDTD5FDrjzgd3JOfdlSGRW8/zC5gUka2MaMB7E1bSjCvDjaO4RUYSwf0FAM4XoNtb0L4KuE5UvcT0Me8VKAuAaLbnZDSxACB+HXD/HlNfRRD+EAImad35C4ZIjevde3f0cQQCEQrL2xQM1YzPSrJ/VIPW28nfHjFunBNtoSoACtpIPCM84ZgQwNu63WDQ6u+n0FNvAAKBCxApFd+ErXArJGn3wQXHASMPTD/GEnQdBM8Az/G+AgwhnTzw7Bsg6hezLONQYxswORApNyAh7Akif8wEBq6LDxvclsyAPx5NQgteObAP6gAgrEFTcjolyrV0fiTZAmEGYwEHNQBST+D+EgEHvwD5zeEIOgFjd4HWWQuL23e6vebKkeMXV+pxphwejeF4V+ECQwuekUpKqz633BuYTM1gMhDf1+DaygBFjgD2+AZlG2b1H6pQQhHn8xB+VQ7QPR5WY3Hey2ESGuEv18F7V27+UEfOEEMIXh2YbR5wcBDKQj6N+WAvBO1choHCnA3hkzqQIhtw3RBT1R8ycxvrXoAxbReWK1gwUh6gZhH0IwI3E1EOEQ5v/wB0bvUa2D/2f5XBD/aDHM5u4FIYGc8Xd2sAEW0AE5yl47/hmnzp1TcAIm/A/VQHx/LjZVMfMGMdF3cg20sPFzT/fOQhCbfbzWUpEwgYygcJhMIBOYFdr/MPJ1b/iHj/iJT4wO8cWkLRAnUA0xC0Sh8ayBecYDsd8CEeuKz/md7/nWguMN0acSphJ6Aq3IuCOdIhAArhIO/PmvD/uxf8Fm0vgSn3vDWfVUTRAnoCdTQAICIQBg3xDQ6vqyb/zHj/wrm/sZERrcyZKhIQACoCcDMblIfhCn7hCqnDVLlfzJH9Td3xAUt/wqQQLTD70E8fsEUeT3nszg7/7JX+5p7B7u0ReuHxqTy/8KkvsP+f8P6S8QKwAQArD88/BPx49/CRUuZNjQ4UOIESVOpFjR4kWMGTVK3PKv40aQITdKkGggIYeNJBqqzJjihEiYMWUuHDDT5k2QJnHulIlyooEWImloFFCRiMMCPJUuZdp04xadTqVyXIgy6MKrFy0wZBVx2ENf/4aqHNowBSiHMaauZdtWZlS3cRv6RHMyq0ULAiMaSvjV4bGFov69lFuYIb2JRmDeNZyxI9zGS+sm/PiP8cYJDIsu5PvvK6uuDf0ShChCYofIbGcdgRilYriIXFLPduuP6VaFKf6xVFjPM8NbC1ltoF0Rl8JrF5coNJaw3L/mF4ul+4fYjJn/fwpkozHSZOG2f0McUFwuOyGbf6wXCru8sPJDlJAt+kM5+Z99qR/RvKf6EG6LAxzyKaZUGFpBIZaG6awi3IrbKDmHGkjoGgkZis4hWhwK4J8AZvnHw1logGGGF9pLKI2FzGsINofm4Mgk/OZyMCEmxLJov4Tkw2jAFxQyybaHYsRoNIbKymgrWxKihCFgZrzouAsvMoYahqiBJqF2FOKmmN3COrEJOhhKA0gXEWAxoTnCUVGhJcS5TyEhp+IPozMcOmGKgS4C0sSMbCOJohY40EnHiHy7aTMnJYLwoSsTMqaBEhp4tARjirmGS4qKaVQhZD5MsphZBJjhGiR88Aa+/woSMjOhEMIpJ5wLFkLnHyAta8iAWjUa8KddI8r1H0sSyuUffRC9iFCl5KtPIX/ivCk0h4ZCK9GLKnzI2oaSkyYhbKuUhkoqE7LGliQTmqbTZv7xYRs6VBgkoT7QKMTFhP5MaM2EnLWp1xwbElLf936habCE8sxzKsh0+cfefnMM1DAvHKqjSWolmqEEiporJjpMJ4xoFgg7/geZa7ZNKENqBBgggTiOeGEHb7z7Z0DYkPjnuX8wZoi/X2eqlV+MTDKgLkVohIUfGf456qEDacNPX4uMjCgUhaiOqMGKJ4ruOIi25boBkWF4aJZ2PLSQll2ckeUfX2ihJsN/YPnnBqdW1HngiI78UWcO2TCWDQ+I5uQoV8Hha/iphbJRCLB/ptiNId4uYvjxh+astWfKZFo7a84ZMplbiGgB8R+4FyfdGVtYKTchfRJaOQ6bjWlBNndCUGgMpzA/HKcaIRqqqKYtqkYhfER6TK6CwOqcNmscKqaY0hWiRhpYOqVl7UUacib7f2Y45x8EyqF3KaAh+pPPwGPi5poCYSpcofedKkASjBiveqmAAAAh+QQFDQD/ACxoAAAAagHyAAAI/wD/CRxIsKDBgwgTKlzIkKAnhGuSNSyY6F+iUf8qKRhngwMaDgO3/ONgYMvHF+MWYHQ10MS/BS8NdlI4YqLNmzhz6tzJs6fPn0CD2mQh8BHCHJMGSrpJrBKTckw6vrCBBEmRFgJFFvSH0hITSxkm5SBoiWCnSUsTghVqUATbt3Djyp1Ll2cvTEUf/sOkS69AE2MnWkpkyVWnT1FfKGCCxIYPNAIhG4S8xQaTxQrKzpypwOYkv3VDix5NunRQT4hyKjNKMDDBpGOTNrz0LxKQF0WyFJn6ooW/kAUl/+P6j8mCmQeRM5xUcaGntKajS59OnWfqg64PPlJUUBnCScnWCP+utJvJBRtHjrxAA/m3Qq3/imQIe1B2QkmTCthvyEihqoG0JQRTdQQWaCBPRE2E1yPZFVUAQam5dJMrlplSBBI+EAQfQu4NVIRXAnXyh0wVEfaPJcf908mKyi2UTFkIFWOKKDBWkpAFB+ao444/udQgaAL1sldDZS2AYiNF2PCTP5YVkAEj9rUoEGEVlbUfUKbYpI05jQjEEo9ghhkXdwIpwlpOQCLUy5mPdDDRJTi2N1xD/vjzgHAC+fOhQJb0R1BzzQEFUykHaaPQMmImquhcv/xzwJAHErZAJTDq5E8LRwzkHhpIKKCAn1My8adFc9m4kKECmbPoqqzqRKajop3/qRBMTim5FXD/4KkpGpkaxEGRo41gjgc3mYMqJQZ92eqyzP7DAlGv5miJUy/8xitIW3V4EFcb5mmDqWoFtYAFyE4E7kDlNqsuswmSqUijCVKX1AKX2PBbC+pgi0aHdf6T4bZbGDGQAQNhxRIpZA1YKXQ2McIwQspWQu5BogyU5boYqytBgYkoQN5vir2Q0L4viJwrQXX2utU4KJ7Ip4oqWoLclZUixIgZN5nqys4EUYIqKBkHvaqs8noCljlMQFYEFkBoG9wWvx3RoUhQG7HvVjbgcMkCzaXwDymWFCnlRJ0wkgFDXyJsDijmpPsPqkLHbeDGi5alwAscFKGNAr5p/8phb/wOl55B/iBhBBOluBJ2WYsLdCVcl5BCiisIy215mNEe9ChcRCtEijm3FaGqvSgn5I8RUP+jlT+DB1dEnS2cY8kylJZ1SUUKbP0Tscki7IoFOKrKU5eXFw/U5pnjJOQ/3sllgZIKmDOOru+dvlULJmuK/a42pATTV52MkPuAOwVYECmJiECKBa6Y48q5NiI6EaHG1+9TownZmhCZ1920uUIs0QZHPseEat3EH5VBmQ98oC0msUdT/uDAC0QFtgUMqHJk+dpCzHcQlgCNEqBQ1kAaUbGBlNB+KJTL/2Clwobg5UTkKYI7KOSe39hwIayDjz98kL3htEB/c8oTGv9egARXLEBypBDVEQcCLnMMaAQZqAQlPsHEDloCYZr4xyVcAQopIoQG/zhhQkYRjGCk8IwKiVe8dLKdR4BDQkFJRCls4IplNI16t8pKnna4x474Aw0PGI4N8AjBCYbxSzvbYkYq8b5/uCIRlPpHIyjBkhRYYH0Y/AeOWEK8f7zCArxDiCj+g8ZS5uRR3OncTVTJFgVkAyqEpJNH8uQDkKxnhyLrFuG4wgR60MOICKuE7/6xBIIsQRtUdIvLFuK1TCbkYqaMZqz+sTxwpAYcbFGWO4BwtZ3UqT185EqdXkc1htRJMeaAps4EYip3CAQUwPiHJbnoTJxcDJrSzOdCFMH/AkWskCHbUYa7/qEXFrAyJ1nAkQKw1ZPAjURqRmCoTVJmBHNcYBkYMV8xBWKKLCbLJ1nCAT71SdKCZG6NahrSCxWBiIccNCcecKYuCcKBf5luODY0QgSrVad+cWhOG2IdEsyBAyo6Unj/aAc+cfQPZfYEFaTUCVJLGrTNvRBSANUL/uhSKYkeZAtYaR2HfsMBexkBCep5jE8XokvWiUoVJ/iHIfIBg39MoCyVk5xBOGgQuBkkCxPpJFUt90+sMsQEisBEYv+hiOT5pD8SQ5nTtjVEJKwHOL8Ryek40AJPVeAUKlBHRBlCNQQaBCtKMEQo6loMSiAMaKoyX4DO1TOF/2RhAjnx62Avd1UWDgQ0ZBLSal6KE0lkIBGMEME4OBtEc37kH415QVB/44NBdkYMgvjGB/5RhlzZVCF/hMJBkKCEBrRiA5bUpAJ2xlSbeCCUP0Gq/HZrOe849iDXwUQBGgSUePoLh0K0wVn/0Rl1JKkFmv3j6dCgAAL84wMEEMQ/sDEEAgRyIhEUb57m1AJcuPadAsmkR5HFVxMKhFiiAKxPRCFG+qJwhXpRxPL+MR/mvaUW/3gAEA8CGV6dAwmnEISD6SCIzojsj4JrgRE0LJBv/OMbgsDGPyAw0X9kjzIjueQVPTY5gbhNIW57b7kqhgMcuPjMDPEEjgdCjLlk4/8fTDadkjjgjQcT4M6CKIOEFSCwOlXXBioYCAGgLOVTCAQFGZqphuq05AVKRiQv+B0pNAHKSmji0hNxhSjACEYVI+QWKp4Fmkc9Gv0oabIGOQLUoFABCG9XEKfY7hMMjYQ/FsEI6viAGP5xZ15vw8l3JgAKFJ0tTinAGw/AykBsIApydQmEHrXJlxESACwIxBZmVkglXkHqbueEXknBi8lQPZz2sJoAKsCGg/8hYe4+4RsqMAJV1LFu7vKa10IWiCBQ4ICJKuYUTUABsrH1gkyAURSa8ICNYEQbTdTTy1PFLUGKEVhvW9xZr5pxQcQzLxV5whNJIvdWHlCBOzzYzuz/vveTv3EKbKjD0B9o9z+2EeRYE4Dm26jAd3GIa0EIYgiDOAUEQGIDBIjCFZowhzBpk4JL2Ki9N8k2QgDbYklaHSHTvviO+EvQc9wEL/wUCF7MoIuzGWQBwFjACEZwFiZ4lXC5MkIFXIACgXzA0BH+hoMlHOVd213v9961GCJMgLu7oAl9YAjBcpVubHxD3ac4BRQ4dQFcqEITKSiFCDYPCk00QrcH8fREZiH1qmu9eL1lSGPjhQ5PPMIEaRpIMBhxADdko4em80EFZE6Q7W5DIA4uvKEFMvzfS9jVhS88r00ucoEQ7HQKwEbkp0+ACjzAB0dQQDk+IYIatFmKbZvq/zuhPpEAZCEA/9jA6c8MGk8s9h8tHcjYVBIMA/6jBUpOnemwUYZT7D3lwDdzBjEEAwF49SZkLhBsd4ZoNoEvgSYQ3TVzKCBwEHAERrAACrAKjeB5piBYAwF6BGF61/YPFLd+u/UZZmcQLzQWKTgQ8RQM1vICS8AFCBAIUmM6D3AK2/AEwCdk22VyDOF76/Z7/4ACZCBkUjYE2EBsW3FWN3dyd7YNEoYCBJAG/3AEHKAAJfAPoABGN2EKlFAxqGCCFucJLdg/6JAanyEW/REolgAAIOEG4SAEc8AF4VACOjUyp1BhBEEAuyZh9UYQg9drZaCAwPcBKBAICXhnz2B9TP+oPUjwcts1iezWXQTAg9XnA0iAATRACZRUEJQgegORBV0iClJHhqRWAJiwigeBCZ/hOMphJOJwDv6gDYEwB+HwD+EQDlzQBeR2Oq22bhCmcttlEBBWhd4wdzcXfA42BHfQBIFwB8dIcxAQSyizZC9HB1TofwI4aC7wDy5QAS8ADV1IW2EEXwXBYnUhiqjIKrC3F/3zD2diApMwCYAxJRZRCSKABlxQArs4EHNYBXWiSxwABQ5AgPfGdykXiMA3aKfwAFboAv4nfDE3BC4ABnxAB0/wAd/AcjuXEEcQid8ABQ/gDd6wDUNAhcH3BAQwBA+gDa3wZY0whgrRChzlE/P/1Y4EUlg84QlmEHv/sBQPsR9q5w8XEAK7uIsXoIv/MAdrtUcP8ADq1mTtpnzF+A91JmjbIH0+EI4xB2GC8AQ/l4BJMBDZ9Q1WSCcCAQEZskB9AAEP8A39t11iYGj0IApsgyzqyBCigI4TgSjL8CVIlXU6eUZlkQiRwAFLIARcUAWOiQBVkIs9MAI3BJWCsHv+F2tB9g9/SBDYAGsORnPPEHkQAAEuUAZvEIWC8AwEcAdJoAZvcAco8HgPqZbitQNriZtR0Ac5qAIQNgRQoADQMCz/MCOiIX6FGTSptxCSUAszYSQj8Ac2IARV8GQPUAVc8A8lUAUM9RER1Ac0F2SE/0ZzVTmMM0d452lopzCa3tAvXUkHAVcBKNAEbKAGYEAALqB3hfiIAvECAjNlA4GbELAFEHAKUDYID6AAOOABKcaOpAGCyTkaQDkRa4AJ4vEPj7MQy/ACXBAC3nBzKhAIXHAO9vcPZhAIJbAE/vANFSAGPKhvEVZzsAaad6Zn3xBw3qCe/1ABadBTA/kAcAkBd6AGIdAEFdCRl3lhAIYQb9kHDqCDBGAECkBxffkPtyBqoDYBoqB+RhUUGOFJCNE2EWoaMnZfCiEhE0oinSAOLwAFCDibvcEFf/QCGzMH2FkFRzAEYXlyk+hzhCdkPvcBZfABFcCj/pAGm3kK4chAqv+DUz4aAvbpA4ZWAej2kQdhgQLRBwL6D4nXB33gDWKAAkEgQgSBBVmABajgoELxpQuhYqrajm9nE8spFjlAXC3hI50AJPGIEJC0ZCjQiGlwBEugBAqwBHMgELiYnSVwBCiwDYPKmcF2d4AaefF5Zwz0GxXwe58ZeRr2owu0BYlHAD0gEj4QBMkoZQzBAUiwA4mXqRqSBipQhfQADQZhbemHAxvghXDRpQXhl2MaGmaaEIGhH0khCWkqf64wDjmIAulxi/+AACFgh9k5EFXwAMl3jMFGhfIpn4IQlVBTJ33wBmVAhE92dxCABYBwD+pQJwZgABAQBEHQB0FwBzUEAWX/kJbgNWVUtpYokwbd9QBl9g/nJwqsIAu2MIqicAtzATQZATRZRJj/OhGx+jK1gD9rhj+I8GbM+Q8u0QEFMAKlsABJkaEDgTBcow1HwKNGIAT/+I+6WA4TKwS6p4B39llp4A2l+bE95QMvSwbeUHdOBnzb8AYcoAdMcAU3sA95kAcXZkMG4A994AJBUH1KmhA+4AYDQWWd+rhBQKn/gAPoVxDFkA5jKIqnCBSa8ApZhBGj4AFQG7ULoWwIgQmSMA6/xVg5ARg54AkjkAMvgAk1sRAwAQwpcAFGMAQQoADZmYv/EAJCEAJzwJgD4Q16OozV56M+tQVbALNpGQV3MHwC/7ENBPChR3ACU8ABCXADV/APCbC+91AIwxG5QnoH+za1A4EG/1kQLXuoD/YA10ADsyAKoSsADWGKr5ppAvGl4EJ+sDsQHPB29lsQkxADGPoPtWC7/+B1yymwktAebVbBDiN/fKJMNAADCnC3ylsCdOiYbrAEf4C5AmEGabB7/yCWb+CofiakZHAHURAEmUsG3SiAQtaeRaAH93AFMiADEfAPMkABc0IGpvkPTQBr32CNTLKz7foPj3uoTQAFSHANrAAIN2GT/0GTPkEJy5C6/5CTDYwTDxzBAzEOJuAd58AdrtE8BjEWZjACIOEPLFAFx8EIOTAijNABFyoQmoBbt/8Axv4ABergmEZwBG5gBIEgAR1iBiFQoGG5bz26Q2kAszO7xdtLZUHQw8k3EB/wAUPwDf4wBUacAEusARFwBQ/wG3fQB2TAg6O5DbPJEIGUxdorEIOQY52RBaJWbfY0UgIhghNhKMzcxgQhuwuhCwUxDm7CPHhsYwVxFgS2DGbgDy1rBsqABGMxArIxAmkRStMgDbuACv6QpP5gBiXABUrgBh3yB3iqZ0JGAGVQJzP7D2mQBn1gJ/dwDwgEAUAcBBAgvgZBAHTgA+9QBDLAxP+AuP58y2BAB0SokFBgv0rmrgTxABP4AiQADaImtCWIEMrcCqZ3ugPBtArhUUrbEOr/B80EsXgK4XUZvNML0R8ZwMcI5Md/sARu8AKNMiIvASoD0QANkAVb8AbYxwXU+Q869QJmwAZDLQRG4A1j+Qb+PGUwmzrr275QYFpT9o2992BNMAZoMAyIewX9UNYyW5pD2gRAfHxDQHPfsLMHYQOeahCdmwYtIARjSHEUd9IGEbpmpqUCIXEHMVLmqBD6atM+kRqsNMetgQm7OxPxFDYCqcX+QIdzMM9m8AK1ELwlhEG4pQBR6QZCAJlzsARmIARNOdpwiwSIOrgQ4A9BkAYQ4AA+7A9HrMQ3EAEUsA+q4wBCSgAGmsr+p1100Kb/UAj7YgBkEAQ7YLNqoAZEJgaf/7mQI8lkutIrfaAVW1ABTZApJzADthAAs2AL9IoQxyAQWaB+NokQ9y0QJIQTUWUT9krZBIEXmCArXPcPv4AIivBx9rEWruEPcxCx2BkCCDAVyzBJpOolmGKH/9CYCIAAufiPy7CsgLgN/iCkfTAG1WgDAgAEAiEDGsDEFPDLn6wCo0kQ2cXLIuGyM4vQQUB3/5AEYJAGDgZsgBisUPAvwsEBqlZa/+AAKuANIoEG1wANh30TImgKn2AKpjABrwsXBAzNXgV2GpcQH6ciMxE2ibAEGfACSOnCRjAiXNCY9GwGRRQ2ybIMLeAGHTqxSSkQCPAPcGsDN1cB2usCEDAGO/8ANUUwBUCgATegvgmgAQ/I24iKkAPx3B35AP33e02gjU9on+Zp4xVw5GnwGAVhNaA9ZYGGfQLBBDCACrZwtAxBAguRcKZw3x7IEKYQAGIM4BOBFdJcJgKBCPy1q/YoFpuxIjURsRfw4CUwArkoDsSEBlCAFaLSVAWxAC2gApEZDiHAlOUgP7l4ARJwChvpDyYHxZChU+8wDldwBRGgAt+kOpDrw4GLhFvpcxXmA876e/hZhWCQBEmQn3yHDRDmAIha1unxXUdgU31wChXQ8AVTAtDQ60BhAZpgijlRMeeHEBbv6wmxZjdxyJ1AGDNxDiNwrFzgDuFQBcf6D0JQ1pz/+gIWBCPd8A8/oEWdcATqwAXlAOgE0ZgC4fNHIGylybP/QAMkQARAoA77wAF/1Ad3cAfbQGVbkIMtd290e2en4ANpQIWY6ND26QLv1oMOZpIVoALaewSBBBlK/hsF+g9ucCfC4YW0EBSqQCwHTBDmNwHKjBM1DeAbPBGTIDMqMgIv8OcUewFVsAdCoGoC8QAcIDkcRAw/ID5HcJnHGgLVKRDY2bz/UAUtgAKpPGU4mytbUBJosAUOcAfjG2EVMBxbUAZQ9nMSJr5EWHgVAJcOVowfIPC5vZHIyHI5lvayb+pXiH1dAGcPoEt1RQuyPh39vfcE8eW+nlgHqxCgsgA5/2AGDz4QP18OJQDDAxFpBZFFMVAKOQAFBNAF2VkOc1AOufjyTfkHFvsPQQDEibebCl2aaXAHAEHnThkC2ARVeHBqi79vp7Cd+neKwESKEz9UCJIGxYeJd/6B8bZN4hBBKNI8+FbB2z91Kv798+fv5T8jKlCoeABzpj80/4rNBDpz1qygRY0eRTpT1L8As4oRTRpV6lSqVa1ePcrhpVasRj3l6Poyw78F/6oADQd0y8sg/4K0oDfin4UJE/4Bi7GsyEoxLv5xORvC7GAuSCCg2OYWAoS2QSBEgUDmn4vEgrZ9wEbgQZoKDE8J+idIzGQCH0iLIeACBQQXBLZtuwMmSf/rlS9FqvPx4A0UNEaMGEViw0dPowr+2TpuFBUWAWG7QgXq4Sgr59WtX8eefeqkf5PWyC3xb87Zf+WWCfHB9h+Ef0eqVHp5C9VLYJVeGKRo5lvKLlXSlivBiDSG+OafNIAi4w4yLjvllCcICO2z1Vx4wwcf3vDLKAJGs2i1Uy77oInZCIAotNJQOMUHDlTowp8jDpTpJX9aeCFGozgw5iVbqCsKmVu0mwoaqmgBskgjjywSkUeCyoG7SZQ55589yPsnnDn+8KGtmd5ahpSiagDmCAJEI00QyrwJBwH/5jgip8Qg/AAFCAlAYYgPUEtsG9dEeuAJFyrY4ol/IDzqAxf/PhBEEG98qODQJ0acqcB/PmiIA3+MUMcfFYPy5wXijkJiBiyMIuEfaZCciciXsLgli6KWiqpUVGelNSqusMOkKEle6iQHM4oKpwQ33NrynxeoBCqRSY5owrTE/sFm0G/meAnAFrwhdIiIXjKNokEJDQ3Rw+JMA4Im9AwtXdAGzdCbUx7YpgLOMixjUJHoVKECFdDw5wEj0ODAh7X+2cLSfz41ChdkkEsOqEWys6soWpBxBihZfAJqVKl+rLVjVG8tUhHugvLEBEw6OaeTEvaYCTAuWlgPqCB8WGYBbbIIgAZNYrjEhjQkehbCxJYIp2guuODAzX+eBWrdypYOjc80/wjwaC1ETTPtJRdAy3rrO44IpIuc/EJXzlNcSCO332IywuCZfLCht6hKOEZHJDUuqhhkhMLhH+iqas5jwQdHchIzOnFvj3LES7OKKGKW7KUWlhjFgqASOULPNzcUaZsqECivhCX8WYlSo+747KXPStsGCiEeQMEjOh44jFtEgaJ6sg/uQMEHM5ToQiOOUPjngZyWlqgC3PzZ4giEaTriBZiRmoFhI3+yBdakZCFKlouzU5Vw8YOa/qjnkfTEk1pMWMAGIwL7KxzBgGLvHx+6UOWf+aYgp4AFjtjIpGZimWn95R9CaAEHvOENMWQtKGP6B57G9C4hVAECu7vD7oJwh//SRM0vHLkD1+6wjSA4wAdzEEIFyFCBDzwgPVA4QhkGIQhseKMCvIlJH4qChBb86ygwa8BLfhIUvAHFFP/o21GI8jdXBcUZqvreP8J3nL91pW7jw2JUyoeqJf0DEx3wxCQYYQN1HG1+QnBDFMigpTEE4QhIENI8/uEEEZRCEW4YBKHyNIRt7EEw5UAjwNTxM9CAaxtlEISkJiIGlSxBCWY4ELcy9BpBgKs0gjDNoe6wyTH8LhAhSE8a8vUAdTSoAoP4zA3XYqODwQQJL0DCPwxgFBpc4zjNMEoAOLYLZBTDFCBQRSv+QQlKvESYyCkGKlgRgH+wSooYAwothmKUKwL/xRZ7O0oUs7hNbgYFE5hABCYSYYMFVoELgkEAG7JUv5f44Dcv2UAN/hGMF3yjNB0EjQtUEA4uLEEIZuiJD6Dwgc+sK2pZm5OevKECISDAByQkVho+oCXdeYQjt/vHJt3iRhTaICEqeFdM/MEBCHjjGSqAAjaGs4W1PC84L0AKDOLAillUcyZF/IcsJrAUUfRNFMuwGCCGGBSb+qSoFhsqLQChqinaLSiB62ZUBQcWRHgCER1ARCdaYJIlcOEC4RDCEdhD0fbMYAMbcIIcapABRn3ATkurpDpCMB4hLCFgaDjCMwTxoRIBRXMvGdM2SsCDJTxAoyR0gCYpOcJBMTai/200oTtKwAU+KOAU31ipijhQsNywRGBbsJAPWnAErbgvlpx6CSCm8Q9WWO8lApjFxSr2ku8VAwd9m0D+9CfEpFAsFc441WyLcsW98UgWTZ2OVJXbMRP8Q0mICKckPIoCG3JBfmzowxge55YdtAAa7ADFP7TAjBZkBjSK/EcFwkOtpGllM5/Rlrb+ERJ03ZMA3uBCCYRgBMPO5A4bbEsaXOCAyWzyCR5JUEb/oV0HHIEHACiBAgLmA+bFBCYx2cIDoKACkXaYA1pBAw/P9w8k2NIotCiqLKQhzSwkUXtZQAUOqmiUi8kCm0mpmyyaIQseZWy5PyYcIswATnCGE3H/GP8CfucaArEiaAs0aIUX5LCKHByhAoXcK2ZOUQJx/IUDR3CDTPL6GYgkysxL289GsKGOGUzWBx8Yg9a09IE0EHh3CvZLEBJDYAcEYQxpGGkbFuAKLhyhlUUR6QMKceEO+8MGMFUAGrZAHBvRghas0OY/rsgNXA5DFsegRgCgYQxj4ICZUBH1fKKJFORm56hAhrV1PNHFl9QiGwvg7wy3QVc3sOcxMzECLqrxj1FwQB3Y0FMityGINOwhHMsoQcAc05MHPGTZpjGzQRVFgDSUAAE8MMNY/yGZABuoMcTyr4HQHYSF+CMcPJgDD96JlJh0tihosIGmbBCUTwUx05rGZVD/+gENGpyABrb9RxOR+JIZO4cVxo11xAfXi38owhP/yMYjOoCJKrQAIvEqAT99ELn6HQEH3WDGAV5QAT2RqJI25PJ+feAA9oz0CCogAEkikihkf+OiVPPnHBBggzYGhcD/fcl/N+lAj7wkDUWPCQZKEAnRzWRgiJZJemSyEJ2cTyYygaktpIEMbMIiH//IRyyQAYN/DOMENoDZC2xQhH8YY6hMeeY/ovFqozhDuEGRbVVk4VqJF746tXjJHxTgAxT8qUpz6PUOgNIHJuABJo16ULf+8Y0qAMgMWwhCFGbGPCicojZYk+iksDFCAkAhEHO4gBBeEOd/PA4C2o3zgciq/2CZ/YPAMOmJ0DHAhZd8ysKovZGhk6IAIUixYotAhj721o9/0ED5yseCLUtgiyzYRZj/WCpyZOEM6tQjKc24+3BpbJVWG979L0nGPy5+8QxYghGWWMYR9uoCZ48HAjtYI8l7gBeIBH/AhiHwljERBDYwI38olwcIggfQFHV4hn9ogm8RMIJAHteoghn4BwTAAyzxCI+gPd9bMN5rjDjTEgjYBkrzASW4gBm4ACXYNzSgNFayFUM7Pn7jAGm4BkxLBWSABX1IANaCqZcomAn7h8AJAGZChSFChlQwCinEjvR7vyt0DjB6CQVQgAWwhEpYgAdAJG8YjzkYuX+QvLaIAv80KL03eQnU8AZnKwHQq5+ZkbQKgIDUGJQLMpRtaA2R8AYhiAShQ4BoY7e1UMOpYIwouIM++Dp/cAMhmAMuQAAEID6g4JeqcBGpeAEPpAVpsAe2w4J8S4oWYAIkEjXeEooiOQZkODsshMWu6AResYRZtIR/UIBKSIRyeACOSIw5mINvUKOXYAz7ubI5mRTT67weOALROx77cRFvOJAGSQMb+gcUmQjqqoKpI75vQwIdZB7WOMFIWjfaYwwtwSt/qAI8uAD94gElOK2doIqYIC2dkBGrg4kXYIVruAZnEABSBAqeKIoXKIIZILWZoIZhmIliiAYTs466ubFYlMipKIv/owCGf6iEJVi8JnAAdUgLNiBGYpyZNCAIcNGPECiBN+CAIIicjLIUNzAej3iAPqCdk9gMtDkQIRACPLBEBAgEg+EXnmAPpBO3l3icINigVeqCI/AHDChEBMgBG5DK0PowlpoKsBsxfjOWBAIYesvEl4ClIJKiiMwp2noJVQuLU3kJWgi4iXTLqkgE45gLEvOIlQDGE3wJySCDPkCBb3grMVCHrpI9Q4vAtsgJgZovN4CQGnIN04OCNKhGI3gBuQOQSNgvTMQwRkTKovAzMnickeoBf8gBHhjEcigCBbgAeqiwzWKpzzKKrZOJLUoKG8lKGUma9HgJG2ACaSiGYvie/9U6kl3QtLckzqqYxX+wBLqghwegg9YRjyU4isNoCG8QhDL6Byx5xvUYgz5ImvRqgs2IiG3IFyigyQfgAiHwNi4sgXYMhOLrOpGKgu0iRtFzDBnhAQrzNkLkgcGCTp0QqaucTBzMjiNQPjRggmsoBltQlVZ8la7QpmEQgLYsTqnKFePIgLGYikT4Bw2tyMs5Sy+QB7h6Fi6gPbJKD+KZLz8KKwSpH9AqngpQnQpIiUVZpS3ohIYyBp1sRy5Qgv58CeVjtC1gj6JLAy2BRCHwBySAQVwAxgtAIfKoTdRaCOkRUO2YTDToRFkYIlZABiLxBeuomGPguwkFEtmsiov7h/9ZRDyqOM6kiBgqWIGE8AZJqZeYoSgf2I/5qoI56AIf8DWgSAMX/Rlj/BMoGJgKY76/iIQLQAArKQcl+AMZMYIrYAMg7bAo2ILtigklsBQl+AenLMQSmANIDYsZMVMgsYHg8AcagIFZMIaJYVDnEADqcAYbOxIJJVPsqEUMTYpbfAkvSQoBcIJ/mIAjcBCgOILdox0fIJAuOE/aibOWbIv0eICVKKn0eoCrO5gtQAIeYNT8ijch4IFwUIJoo0cKUAIuqJGD8QcDYCV/WAAM8AdJxIA5wIUSwFcEUIJ5m0eCcRGQMRIsZYK5SwBMO5VQIBJaiIauQAZMi9VchViy6FD/oIiYJSwVEOiTEEqMtNiuxpDPlAiBKsgwxvg/NHSL4fgHFViU9BKYe7wwIyiBvmmzcsiBHFiA9SyBHjCCmNCKHhjXI+QUNGgDNHCFhgoHqisHuaORCfusKA3IQ/sYG5AFNBAAh2SFinEGZMBVquglsqQtLY3YbuoEEZgKL5lYTSiKUPgHX2DbOWKJJ+CIJzgLC9KStojW9jjPZC3GoHAMmXAJRgnUomCpF0iAEliAyfwHVxi0EsCFSlSCOWiBmFgGHuCBhsrEGJlXUZVEEMRXLmizd4zctbAQFxKYKK1S7di6f+CAfmACJkgAAaBCWpBCWBjTqHiiHpsJsvu7sA2L/1OtClJgAleQCuOwBC9phFeQinewA5r4gA94gm3AhmChHaOsn8dpATOoAh/YAXYKSWjcggrwU3XgClbilyOQzCIoRPT0VG2YiTkYrHDINxuAsFEtUH8Ygx5AgLtMU6LRL1EFqkYTKdFSkRFz2uuwwYNhgn6wgWsYu5k4hn9LCpzCsa3lXauQgOoQXuEFVqQwh5eAj38AARAoitX60pcgAVxAApJ8CXX4B9HxgXLJy/o5kD4gKzIo2caQiQKxEPeUkUmzFC7ggQTwXPQch3PQBm8TVaETVx5YACZYBgy4gDaIVJjgAp50ynr9BzNQgHNAggVQgoq8OgC2kBfQVtdEkv+WIhhIq1rWCgVnoEKqEIDd/Qd9MISgaEsIruCOuYR/2OOo8NVgZabAmQcSAIFxOBS9SqRz0q764V4bLgrJUw80QMwjWKV/aIEukEzf4AA0mEUgLodxsAFACo8SAIAPHGVx9bZ2dIcv5gkeeIlKrEQUet8t3rfZFCkOaIH0AJjTRYoXoOSqEBiZ0IprsIXAqd08Lk5XEN6uqFijaA4qYAKNEIMS2dMRyJLNjIxizInd25IHkGRKHhj3qAIkOIvJEoI/eAElKIdU/Yf9pJYP9EAlAJ0mlgsEmIGnRNIFaCjxAB1YrsQZEAIlkLRNoTeRAq3Rgtqj+LoQO4f/TIqB+bL/GJFLwkPmXDUOEVjmrhCAZj6KCRiHAwENP2HA9gAKSEa3pBiDhWDKnlCAPXADN5iDECC+cqiCfTUDG4g9amlUnSwBS4ilucuAGxVEaJvBGmkooROPV54DCCvEcoiJgY6KDiMY3JQKU3ShF9kMqkYKvPoUD6zoPN7jZWbfoJjYsHiHTMiAB2gCrqEuLpiDJTjDl5C8lkQKP2MpENsDMzgHt36JcACkOXg0BShXnf4HJXAHYzlNBAhoJRCC4LCBJSiBHO2UL0jql6AWY4BlXOAB4/hPC9GKKl0LmMk6rVaL3ExhAkiJU6gAiHgXpFgLGgAKaSjhr47YDT4KUmgEjQbh/3/AhX9oBSBIA0EZlA/whi4YD7HiXkV8AKb0BwlYghxYhjlIC7OIaWXggE547uY7TyFwB7k7bPQM6CqQStSsXMtEAn/wQJ3m639g1EIEK6D9z1w2H2OxlBgpmKBoqVouDTpwlpf4BjZwAF5GAmkQ5ryj7Vwdhz7+B23IaFIABVAQ4a7YALuIB2hoBRCIZgKoQI7gQCHoApI96bp+iYX6FcBQk3Lw6yoQgnDggBcQArc+AJ3s0bkLDx7AgRJQZ7nT1+bL32/zhyVw5Xce5VduXHx9HpGSZK5YpfTggHmzMNBCGA6IJZdAF4mYCJIoCSg4XRuAAaB9yANHFZjxXaTg1f8Pnoli+gcqCAtmmgARnoB4+GhoqYwn8IYqCAGHaosdkM+k0HN1yOvwAIy0WAYnnUwV9w8uMIP8gqVvC489YOxHU2whYNRIQIBHRYMWgEFva9+ZqMQL6AEf5RRhblmYAFLMbKeB6Q112IYHeZanAax4YUqjaIEEMA4OYIVXxApkUMh/cIZdB/NcDYB5QMuZaHMQWAUKLAPUcLmLAMZwUw+6Ngra+YOjKY/FCQFoMwdHa77D3lPiswGANgYuwGwl6IQf91TQGWUEaIMakec5wACguMsit+fzOVQM22GCUWgLewCtwJRvKEnAKhGCAqx/qFQZGan0aIEfVN1/GADsgIX/f3iYl4j4X9+mYSf2T6iOT0iBSzACTMIGjgAszjOLdZIKNfSBPwCdKjELsGpoVyiBsyiaEugCXE7X/DpPHqARxU6TbkQAJzWWHmDUdzaKnueBWTxg89m6gGmP0z0+8y2QSsqTl1iX2qCDQfEG2mRKW++HBBqGa9AHI6HgI8Fjiq+OVoiHo0gEHWAAIzCviVidDwiBtDiC7T23pJgZ6RYM6Z6DQIB0LlgGoym0D/MHQLJEdW4BcTh3fi7ESJiBZXCklDeK8PhAxa5BfNfWneAXfDMWe8Q6WEoMbKhTCJnmbjkFBzoIrabkFhgGGIC7ZhgAe/gH8/t67ahjoFDbrnAV/9wNCq8l+7AAgd4uioucBE8YAQkYk+ediQaqAOLrNfXgZvpxg7gvmse77uZbnM6rghdYPH6Jpdh7pcq9gBYGHWoJFlnOX6SIhFfG14Mx3Zk4ghawAYAtCik/rRLRk58D+Zn4eNOzEelpgXwAiBlIOOTLF+rfP3sIFzJs6PAhxIgS/2VBKG0ixowaN3Ls6PHjP0mdiCUyQuAUgW8ECCC8UyVcCTMQHgYJgrDmvyAPHJQIhzBEiRfLhCz5d0EcAm02ynHwh8YfhwRKbGRQ8g/Bvxn/jM1BwEMJgDlCeGQs4bUTQjQZ/R1p0eKfjy0NDTz9h0Qdin9ltjFkSUAQyzILBf//w+Zj4ZEXL67BUGADYT5H/ySDRHhQoqF6HVkhPPbPmSyIwxoiq2z6NOrUDjt0OILCBQG+/7YJctElHJcFfWw2jMI7ykIfXbj4DDfnxT8uVbAuIfrCiNN/T/1xsTFCiU+GkRAQberPXw7sV412bXjh3xwOHV8cqbtFPUK5CI0oOIWQL36/H/59YNnwZEMvtODMNVgggZA99SyCkWaqbcQZQqHp4yCFFVrYUQGT/GPJP4lgYhJLp3xwiiD/uLCETyP4gJNDOAUBQRBRLDFHOOEIgVwJVcxRBRdCqIOGD98t9N0LPfBwwRz/lLAQV0LuQEYfbLWRXZJLYoXLeOVIt9H/CzY8JiQaasn3zwv1AXbfSn39499D2DBkxAv2wICFADD80yBCnECkUD16PqSQQ/f8w09qyBiSjyEXKgrRW4umloGGwBSQzQOD7MfXiAS4UAECOvoAAYz/zBSRBOXsUcWNCizJxQj/hHCEPxChwQF2Sy6ZHAII0PhPH2RAAAcZ/hjxRTlJ5toQd0VtFCt0Q8aqVlpGnPKNm7MRsN8HblZb4kMpBWeEDTDos+CEGdmjpyH6JPjPaJP9s+BEeHYUGkITItMuRPBG6Ci//UKUSAbEFFCSEUNc+80/JJq4HBdzuDHqixK5wUU5QpyDBFE7CvHHFrE+G+s/HvuzhFXGMIlA/wlzWAXBDnD00bGwySGEJZZWEhUyR8g9JJ8NKhAwxH9rIpTmP9wGHZwNTCSgZy7/JKrRIe5+pNnTHHnGkJ8TNZ0KLf563ZAEFgKDUAf/FPAPIzaMIeJsCAkiiDdd7FjCEaOK6pBvM7kxBxdL2EAUFwix8VSYDYH83d/njcdFrtz500fIHPyxRyctWCLEBcdihdAFQhy4kQ3tSdQCEvZxq9+1CFX7zxOyLXQtYf+08AINCdRDaEKq6ctR0w7JgoUt/0DIUGnyMkTZ18l7TQxCAidiwzYGi2Gf0JtSPIcZPpCx0PYt/uNGyi8IoeM/G9eFM0Mg/9OxyVaijHIJPHzXAv8zY5UwAw8zbIfyQrjkKoQCNsIBOD3EY3Yp2kkEYR9ssKREJUpdGYymJm8gJDERyMcm+JQ1jWziH5DAHSQkAiiJZI0Ws+iaACIStaopr4Uu7MDZCkCMA3hjCCXCFEKe8Y0dIeAPn7IbRKLQhRsFTjmdgg9GvoMEHmBlf2YxixDmwAMhNC5XmXuf5nIlkI34wAjQcohcXqCOU/DlbbKxITYEYUP+qElN1WrBAPpxD30kahHlkgjyHiKof2ywMvQSACts0TXILISFLjyk185mtn8QwwY2ZNNCvFGBOWhpDyuaCW/uBgFfrY8LSPhD4NDDhWZpBCpCSFlXMDAHBQhBlXj/0NUcnvg+/9ESAf4zhhCMkLMvPkQdPnOTfYBWNIQojGgSZIggBvGAf9ggAQOIDB8VtEeJDCAiHcTINR+yCIWc6x9XA+QJ/4GMBu1uXoiEiM4eEjbkpHMi7VSNCY5Aov5sIzZu+0bgLhAOGEEgCv10yEyioABljM8nIeiC+iJiwO+o6iq5KsE5qNgVs1SxolbEyhw695iMIIeXDjmCCvaDsHoaDVtFY9sxGYIwhLwABtDMx52QMcJyYqRqhszTRhqUwlkwhBZXOydQXViATrjmgcWczTaMo6Qj3C0iMTrH5rigHFh1xB8tUAICMPBQIwiBcRStqP9y5b/xlO9WGbGB/3qe5ZAW1OcvKEldRFDaLZbosggJaBon+rSQBV2TUCOsTNSiNhF7JCprsuDpvjZymaCeJmwWYt5EPLEQYCgCCrEh6UKy5QDilIMLblgRQoC4kCD4gEc8+scePuuPMa3FH1XAAxdKcAEejIOVF8jcKS3KuP1hhTkcSahDdBmbb5SInhEpLhsZIpu8+KMIN/irHR2yiGHgCyKCVRQrfoeKf1yEsS1kQUckOxFgLCADIWnI2cQ71HPUcE0AWoggytAwJbEBtDgZw6i6dwQhLKNKbPCGAQtouJD5wwdR/IdWeYAGPChBCUKwQXNs+T5jNI7CZlHCOyOiGIm8QAV7CVF/jv8pBjYp7Ex9EUPCoPAPGkCkQYfAIEKa0RCYBioj9XjaXxcir1DIeJDs0ki6EHJT7x4yEYxECFoiAgxMNM8G3vCWX4RWosDVaAmXtMkOWLaQHfigAjVKzhu68ADgltK16OmKEMLBgRyY4QXjkC0CroiA3cZZtkJwh5AwIiCJGCGkLNlGfwKd3DYKGiH7GbELTqGOf2DBTtVciD1gqq9FNKO7Oi6kR3C3EUDYgl4LqS5HaIEMZwiPyItCy9ga0glXcGgSSWZIB4ihoeYdwWCQTNM28ImQzh4hk6FFyA5ENaNy4MaXY/4IkZQQ2zmIhQcKWEL8LvBV/5mF2vxrikch4sX/hrinwypZCBnJOEwUI3Ola8rUP8TwgW+cgnoLGUC69CSZBYXCHougMYXyGK9h0MIWpKYQvk39NQ4tYCSw/ocnGFG2ZJhtEkeoAEmxQc8GboPKVSiBBDD5EC4Tp0ZVgEIFWFtV1woBACnL1SstWlEk5aoHL3AKmR2CBqqmbyFIGMQxY+Of/dzHRINmyIjxcwpsqOdpdByUPiQT6Yb8VRbFePQ0M5KZO0lEM5ppho9zKpFRC/xCf5CsJ149WYSkunmJmAQxAgapf0ziHA/Iy35WkibiVjxw5UAAFLQ3KvyOYSEPCAFx5lABbJxC5L9lS1dPrtWHzvJ9Wi1BCSLhuTDl/1mhAxTwEZBQhjt8+x9D6I/QokxiB9rH3QyxD1MdMmRO2BtQMobFRhI15EMQ9h9NIxdCxpnjhvSuI//uTNdRc46F1CIbEJEEQyyh/LH/A4bAMMIDRHQpoUWSOOe5QBV++I8dRCHYMxHOHEYQjhDUUx0xX9bIpJ05xqm8cexPFlS+c36GHEZW0pr40ShYT55nlo38pz4dPERpNITxIISmKcR1eYSgGFI9gNqgUF1DTMiE7F5EZF3wncZGoUUn1MI/LEBHAIOsmZcIyBNgfADQyF3CFM1yIISN+ECwbc+L9N0/CBECiIOrSNKxVQaR7MFXgZXK4UIJcAEHtAfMzB9C1P+fQ1iVAqAYuVXL/9mHX7gOipXIFDpEXlAQBDTKkDGEvlggRBxELEwE7T0EOS2EvlHdHV2gC+UASEAWQ2SADljCEfzFB0yftSSMN7yEloyfOoAWQ8wEBLyEcXRB9OQgSAgJEsxA+23OyimBEcCH/BkhXDRKcJEIS/yffvBH6vAfckVEmqTBNlRATS3EdTkdGS6ETBXDj6UhR1Bg7jSDKzZEGKqhomgIh1SGJYgAMBBVBQAGAQhGlCGMIMxIOEQCTGyDluXETUCAN+ABQiCAOgiCChiiDoqPWDXekmAJQmgFURxBkESiJPpAhv0DByiAGlFf6RHN65iY2/AHSlDPezX/xOpERNR9xvDEQizeiSoWIEboSdac4UKEkETQFC2qxqxBhHk9RNktBBCIwDg8wBA8A9EU2je4gApcQAiUwzJ4FmgFG0KQgQ90XAhUgUp8Q5CghildlK5kTuYsxJxxhw0UYSS6ExIxRId9AB1w4ttAktv8RUNMX665wDAhEEckiqZthEI0g4xtxFJ+RkE+5T+I3UdYwgKQAnuhxNCkSYio0TcEwvjZiD/9Q9/1E5QAnnFwQRoQQAWkgSQ2hOEtBBrcT+OElZJcAC7Y5VXcGQcEiQGA40S0ABIyhAL0h3+sBIkMQT3xZGEA3UkpkKGZHjE5hEDWGEIMQATwEULwI2ZC/xpnZkQqOEheQaVqdEIObKBEdIKr/cMlIISRMUQi1IAljIINsBs25IcagR5t/EMVIMQchIAf4oQPECMXhIADEAAKAFhbagQHKGJvLURGGcVCXMAMwMl0zOREcAAlLoQYPYEDiFs7ogknDlMZPUEdJgx+NIQgIMw8/kPqScQwOII+bII+zOJDZFNlNCVqaEY+imYLLUA3JMLD7STomd42nIJtzIE4bAwHPEAURIEP+MAY7AHfBE4ZYEMFQEHlcYTIxQoHlAAG/EMm9M94fChCLIkCtAA4golEbEFgSocRVMATDGXnueP/vc6akAiOKlBKxeg/PMNHjEs+JCBD7KdG1P8eagiWZvKnahADh1ClRtTAOPjAceIat/AcAUikN+yBjXRoFVRBBVTBH/QEQnCBA3yAIEBBU1AIB1BRB8oMQ+jKeFyAAqSo/HlEOabOJp5eotVoz9GGA71NSt0BQlBQJDVB7BnpnwSVPdxDN3mTkipKJ7SKB0qECKhmIrxCEaTBWxEAE0Lhe52nG4CPUl2FT0jVoqGAA3zH+VTVQ+xXKwjBP1SMkvwDliQJlmCANmgD5flltk2EDTDQSjyBDQnTfbBNQxCXuJmUAplb//3HNzwDGDiEPaRhPeTRHgkpY9VeojjgozoIAyBZRpTCOaTlNmCDA6wJj75O3K2bD6jDlyr/RwjEawWwQRp8AAo8AOmsD4W8AA/ARDlEVCQ4J3o45wLQKfpsBBq8qEk1kNEQ1zxiSxq1TY2SG3GlG0ss0wMJAp8iBKmNhr3I3rvopxriZ7cuigi0ZkPc4j/8ADmcwzM80OexiVv9WdBBQAV4gyBsAwpsQxl8A20YJ4ZWRE2mxn6dBw/wa1bcillhQAn8Qfz55W+1gDr8zD/MKCQpDDFFGVZKRIEihA09gCgKxno2xDDsztHhlBrqA4+V7KktAMo2xCWMDQOIQCnYAOgNTXmKSOvY0z9UgA94QxkQwB3YUx36hfnRgCm0gg0kJ5cIQScgQCAUwRJxwTIE4eY8ERKk/+jBcsnUrlsKts1C1Ka5bWIabQOgiYHrMERtMsQDXOLbRIQ0DICMSYNCOAJ+6gMrulBo+g5CzEIcsO2FqKxD1MBCvIINiMg3kNsw7YfR6GwaVMoTEEAaXaLbqIA/KEAmnEAmWAC0MO5EHEEP2IA2KEHm3s8SNMeSGENsBcLizqT3vkkXiAGKlYGVep4CYctK6ShKuRVEOCZDQEEApitGcBMqmlrvMYQvHMMA/gPsAS9CgNei1EA31EANAAB7pU49ed5CfMOhpQQEIOYTqBvb8MWm9IERIMAJgAIl/EMRPESvZgStvMA5KMGcctWDJV4RHa3mboR8kM7dUp/brBH/Mf/v8hJTSn2naiADfXpXuhxwPdhDMyyxaDoWRlCxhVwCCJjBk/Gv144IO56EJYaIOp7CAzABLoDCBvxDK1hAdp7Gmj5iAihAmLiDEriCDYhHCZRDUOxwR9iA5iWQQ5QeEbsNjn4n0MSOA3eEQtzDIkxTtb7LfHIXkT2CwFWqal4COTDDAgTBa/Cv0fCtPWUwGylvwowBEkCDF3jABIACLhSBR71vQ3gSGuxBIDiF+NzIAigBDyKAAmSudXqEDagY9aRuibFRcb3ubOyHUB5xImdE0ukDJ0yIjJEaMuSRFyKSJ4xDalgxSFRCKRAvKZgDKHSDJpsEMtvHuonb9MQdPKb/W+Cyzj/QQRogQSt4QBpPQACIAlotRMe8MEbsARKgwR2jKBrMcAlwwAUoQTmQrw18Y4ZqxBZsQTCLyOc1hPJSoXgG8sY28wBX5oTww3RRGidIwxY6MHhBsGrEADFcAihcQjXUQCm4xoi4TnERK0u4CQeTJ1xVJAoEgQKYwhSIwj94gAfgwglQ4qxURi/PSiB0wmqhAclcAEJ3lXTACiwzBAcEM/VKhEltNEeDhD3om778XiJzM4UYmQiIgCaAAjnUAD5cgrT45AaviQNRr2H+Ikug2F8MARQwQT1PgChsAAlMQCu/Bcj480QYQSE4hRvsgaq+ABdcARP4wwUkQC4B/+ZVfwsUoIBioucwdaKNbkRng0QqkLULAUpJf/VpVMIlXMLbPgQp/EMl1EAiyIENaOzqOgTP1ZNsBNrnKcwHNAEEMAEO3AINZAElmAIlTAAOFAF8ACZIbEFAs0UXcAAaRDQH7IESWDdX8YFbZDZLSa30CiVEkPKgZfBoo6cgYIM3QKZpJKnXAOQFUvIhVQJCrOakPkQ1/EMj/EMpAAASwAbPuffQjNtQ8u8vpgETQEMWAPZ2TYAHtMIKPCJcxoe+ZkTCeoc6tMd3cEB2J8D87IEQtABiu9M/bHbqdsSIIYR5hy64FQYzb0QC/EPuqrai9AIiXcK3LuREXMIqIEEaPP8Bt6T4Bteosj6BC9yrAuCAKGTBg4vCLbRCpp6DWrDH5q5FXKiqCniHVWV3LT+FAihAi3JEe6TBuYFEnhI5ehJXev7Dk7GtQtS4jZuBg1xCKXRIRmiCJqyCJbzdiPxDSHm2e/kHYJAIsAKGghuDB9zCP2yAKPCUMRiB66QVP5dSln9Hu04Hi26BCsypqsbFlWdEezwcRHj1f6hjt7hjPC6EmSspn8i3jXuCG3wPR7gha3aEJlzCJ7jCAwg5imEWXRMloWuiCQ4uYJABEgTABhw3DaCCPUODEQgqmbAwL71lEqboFlh1JD5onVbGy/lAu5H3T17L2NpoA5mUQ6Ruibj/QP15g6895RxxAsna+EKMg2StgUd0wmtrhCYgxCeowhE0AWxIrxcvRPQ4xEqMmEqUyBPMszGIwgRUxD/cggcUQ+uKQQUMRAss7sjRKTiGCbeLiVtqhM74DFydWHoPeroNZdCkd8pfYGbUmwsFHL9ggiIgAgdyhNvmd0ZQgn0jhDb8gzbMIaciBI8ChuliCyTtH0I8KwGkQRHgAlEzOkKgAg4UjCAI6oFQlQHwsMh4fJiEyRYQjrVjxEm+3REHGrMql1/wZI2qOUOkQVouygL/w2J9BEzpJ6yrdi0oQ0eQwiUsgDBsRCNog1Dr+T+8AnuiAGDckM+6W2dD0p8awTV4/4DVI4SyB4ARGGpKqAASFEGjlL3hfD3pe3xamAbYqryOFnEZfXZHOFBEMJW4U0gzfCa9uxCTZQQpJALPY0QlrLDPNwImk4OTCYJ5w71EXIsNQMOyI0QaB0AAvACxioHnbxT6OQuBua91Dm1HoAFK1OYHLBoODbH//dxHEGpQDUPd3z5qsKFG3KLb3jpGAD9/g4JqkoOku4nRxLhDIJfOHgFAQKN069+/DahupTMihsA/Qf9OqRhXcEtBixcx+vNXUKPGfx1Bgvz3ogVGkycxGqkgqIyYU4Je/hPzbxtNhzJvokT5oeApnT+BBgUaS2hRo0eRJlWq85wki4kY/YtqFP9UQW2vKpUqZSTNEIwEGgat+dDhqSMeNtCwiONfMQUu/n1oSAdbUo8fN3oMiXfjvxYvllo8UsYhAbI3Dz88PDNwY8cnpT2WPJlyYGCTkrr6t+zfKIsiNCnzRuBbUpj/CHxA8a8JBBoTbnmYQGKCqH9IasYtOMibXYt9Q+q966MgmoIGkm455bMggVO5K0enrG+YdOvXT1oqSCrpJEaTiD0W8a8SkgcEoBdM/zMszScPmEBrNIESKPv/gPhY3fzDMyMcHstLpLs4AMy4wB5AgaeC7hBqQesYw64ti/JprJ/HFpFQKQX+WQClJTg8KRFLEkkEKe4K8qwSV0DrJEECnrj/6LmiUqMJBTKYyKSqiwI44YjUYnRODCQAE6qvjPgasK8CH/Phmxohao/G6KS06KEIA5PlmMhiuSbDpb7UUEyMtFmiQ5O408wiE5CopaAMHiOlklFcucSSFhT8Zj2TeDrNogcFEcMGVS4BJYV/TnDiHxqI++o3x4QDrq8iHYOCAGz6NEyobfREryGfhjgMKRRcgPLP1KpUShZjnLEImTCP4kQfTh7T5596TIJkzJ9GqMRMiyxRwAKMTOhgKsc0uaQR7hY4Yj+hFDvpA69eMKUaULqxgBLZoDnCpotUeLSxvPA67h80KG3sgQqSao80d5lLKo2cLmoo1cB4QeYfZ4hK/2oRXG0tagCUNtFpk4B3tagSixYIkYk3PSwoB086oWzhfyyppIWX9HTMGxR8YLGbf0CxwIN/cGmhRrgY+gaJyiRF0jEnNX1QrE63aS+mUQtCwYEqeZJLMmlYucYZZ5qpMClcJbMHJVh31cwc7Ub4h8NOFKg4KO0e026UF8AqDaWwsKkJLIbqhegIBUAhhZJ/PKBELShKbe6fGNXx9shxfzvyhQMdMwK1D2wWGyXmGtqGrBiROmVBKOK1CPFP+6RXKMFh+AeWgpQ2Sp9/oa7skH90TTjEi063KEQzJCSFCW1eeCi1ujDKuSCba4/rlCCQqO8fUTxApaoi5iUgwifC5f8I0pP6AjwwNDhQwU8rRb2o45r2RAo6AMXSDXegmrAoAcccSdh8jH49s6DUT7qksTmtKuIOFKovKML2zJbS3jtsgAaUCf4hAIRA4wQP0A1YwKIO5U1mbwXxAXEamBQ0rKt+EDkJNnxyisRVJnxjA0tBKgi+gnhLMqOzSPl0cgIS/ASF5zsKZkySg3/A8B+kcF9gKlEJS7zCCN5g3LegZaXCEOAIptgAJTaAhVm0hUMKYhCMfOI86xyhJP+oyFI24gN1vIQAcLndehx3k7Ho5iT3OkkIdyIGQQjNKIRDwbykQ6uj6Ksg9qiOCwNTi3HIkBHjKcgrHAOKS9RgGeYJ35P/UANE+2FkQca7iQua8IIsBEAAtxDAP2RRDFr4oAm2Q80QTqEO7l1nC39xIN8gh0ZphZEhCAwbambyvZN8I3I/gYmQLKeTD7zkGXg0yS4KIg1D/GOYvmxMAapWmVJYIhg5OE9usGcSr5jEVDwhwAMU0AAsCEAAs8hkP8BGuJeI4RtPUAdgrhidjRhnIyRszBbqYqqjeNKTQrPXTz4wPaD8kIxGeQgcT4IwoYQiMLZaRKuM+ZhjcaYg4QlMKZbhok5ahABlkKWjNKWYLp5jBgIoxjSOIYt/JMAI23iCYd5VEHRKyAbtTKcEP5KgbdRySggcnPF8glKd5DR5QDnFEJwT/zQHxURUL00YQf8h0IQuxSlAuSED1MQ1nZDiEy4qwzbYWJSghYUnLlhNP2SBDFtcRIFdNJU6WnqdA72Ae0ZFinG24BxOXVQnueFq2cr2jdGY5DBe9KdDBKHPoijOrxgpHT+EgqsLKUVfqUDoT4opoWM5xqH/uEQpLPsPc2D2highBzOaNdHs2RInZBEDyGgATFbYohgj9ZZNE8kuKUrHBgBK1/PScIonmVEnrrQpNshCO5/SKIMwUeUZc1rBgv0jdNLRhyOQ0a+lak1rJ6HhUVAklFKIIBjN8hRvdfmnf3gVPg24pC28+Q8ODOIOJ+2T7SIYHR+48zFb2MIWA9su9f805BuC6Jh0FBOqfhoFuNP0pb6aoQ86LrUgWisASqby4MpcQgTcYcYynAWd436lSqd9QUfTMQtbXIMVxJFLQ2LEnFFeZ77+qKJbkeIPH6jAORZ01EkIQ1GwaDAsoioNSrFUlAxCRHZCBApQaepLpyVUAXCSxFQ8IUOUuOk6l7XsA17iX7qaZCbRzJkLtkEHDtCgGFmYhQBgoAADYrXHMVJAC+KrzpFQxoAfEFvQbDfa23lDjR8Ac0Hgkhux5SaMW65XLGWHPaKyh8EnucdStdNUFzbCKpRuxI8EESqxbTiR9wTLNlrDBPOKFAZGgEIZGsIQMQAVBQpoKXLSWZEjwdj/KD4wjg1muxQnoWCDDzHbgHubyLSdhjmCcGRRZsLPTnvFwI12dlCq2wkTSHpMVxkFVj5hijGIoS6hyu9g1VOTD9RkCC2AgS2QIQsYoCGMN33IHU6BhCPt7S71LUgVJfOAb3zDzluNZlK6XJC6fNtuSMGfeJ+d8KRQ+zFv+4koGvG2T/wDBw+gA1x8bdHAPEEQTeAALmDQjARgQT/CLgiqUVMII8S3xZK5In0dA0+fVO6ejdkgG2eaafCa5BQYNBw1FR50jEzCRLva0UUaQel/qALTwT1KYY1HADKgIAjjmAErEqCOBMnSXkSK738ko5FcN4ZmucvlLE/y7zMK9yg//6dob4Qe9+peh6EFcbhJGgGCf3QLPXHZNFJiFC2ecCABkfHBHe4107ApgAMbeSkH5B2gvghuMmgYQwXGeE9DF8SAQD8JqMLSbFsmmSZsb/QKHhb3giQTO5rQxt2R3ghT5B0HR+A4G9cYmNSclgNYKDyePhBkjfIECkhAQwRfkNbJ2CA6a8ZJ/naqwYukQRA5w969NHW7tqPEvyB0NhEs0gHVW0cT/0j6xFVxEdv8YwKfAIEqcHEel0hO9zO9G1pHfoQm8MST3rdgKBXg+P4BOSyiCFasMVoACfBNMjjArh7CJ9DoA66Kop4D1V7iAm2sXjRoRrQqgzos4WgACAhm/P+kY+IwYvZeIdsKwgj4TzLsCi7QYHwcYNzWY0G2wXYIoPjQAHA2IgHjDCmu6AeVwgdyjP5M4gZRw696g1MEDbDOzib0jPsILu4ORQQLAhMqY8lUL+lEQRWUziSooCBEAReMgA58rSeWYiZc6Y2YwFLsyiJqIkLkYiaggPHIpSDO4QjmTSkqwgaMYAED44q2AAq2gddITzdOCg4t5wIFiwJvySieoMB2jgQpsSAmoiAwgwEkA+Jeof1MARpY0JGEitHUI7ysCMuyx3Z8omxqRB3UAc4eRQF8QAiLolFo8a0UqCAggPuAC1Pi4ti4Cid+gnGA67+A4kHkgieU7Sj8ATn/blHh5EhC5i4p1u8k1o8+jMEInIjT0oY0fu0iPqACvCFBpMkBEQMKFcAInIcDmOBAHC8wjOC2+GbGaIo57G/RCk6IwuIQy8Ii2KWmJlEnCLAST0hD/uAipOooViEoyq8gLsAI3Kso6OAiaqJBRgsF+uA5GkIQGoQsPgg1FCNQaEIFkEAPLeIFIs8xjiD55PExIMDtLiKw/ESWRjEohAtKDtDzNg8lnnH8IusnGE4owkMTOyG7OgsoFnIVTCH9dIISUAEHcMAGUCBnIuQRncPTTiLIrKQMoKDz/A4jYkIu1gjFdkMF/mPyVi7mbOAIaosy/IHySOswsucw7uVKPqj7/6SvUShKkWTiWRpN/KyQwZJBKTohKiYLKbRBG8zvd0yhGjEiALLgAW4vdzJtCo/Cvz4FuEyC33JCwOrFnP7hCI4PDRSwJ3nyL17AQFyIG00OHBUxNwRhIi2CM/fkHylRF/Co6IzCQ0xkAabRKD4B9n4iAKABEPSPJ9AGDYes07SnAh6gN0wvK/mjJ2ACGxSgJP2BHQGE1oDiijjABl7AW4xj7IQCJmPyCYOiLm/CXkrDJ7zBOf8BCmxsIwmyIH6gPi/iNxEzKFrhE/fA4qhpG4ArsGgnCs+zIMRGP+RybIRm5h7CBcgCrYxDAUxTJ76ThMizKFRpJ7VvkbhMNwKOU/+8ARsaogiHAKtKowjHT8IKQvwSRhOBImKSyTdNYliOYuIWshFM8CcmABciswlKJT0QSBl7y5Wkr+ZKIw3SgH6AwtgOlAAaJIGI5AWKoEJRwgbaUkxY00NxQg0tQg5PIZZQAvOuYw/xUzJYzySODilkTycC4B/6ExfooQwFYRlpZKsWFCMggDnMc2xAKFAaIlzSsQjSKkOBokCUL+giJ5pqUlSkxAHOFCPuE48OUyc0UTctwkaNQhUmzgTTLxJMohX6E2VoYOtED+EYbcewkizEMbecNCAP4wM47m5CSQWKzySXwjh8YC2tFOCOoiUwQgxQzv/QECN6aYF+QhCLYiD/zdQxJEzCJvUfdOERhC5igOUfNEFNisIULIJbTcIUcEAVPAAHTKEB1OwDTC8smpCaWEY36sdeHkI/csZRZQkuWjCRjE1PQskv7vCtzkUdjcJQKRPYOvSMTJEDhWIgBZLBAjNSL6L8GsEcPqHuFPIkuFUVUGEG1MyJvtFKDjHqYEk6DeMU5mU1UO5sSK89yAksHqQmsEEBkTUpWq5XUeJ++NL7tFIRfcpA+cZhl+piNGEhhTMoLuAnGoFcZ0DrDCNG1O4fSsM8UWWrykhoHmJPjfEo+K/m5KI0Gs8xcK1fJwOvdiIovKKCAusbCksynBGPXLQA2rYgcNPZPEMntGH2/85PKLz1IjijaDECgHBgBpAACpzIaWtMOc1Wp8xoI32iAl7SG2vOp5BTd8DxUvwCiz6CA1aOnSRDVXXLYKOFmggAlAzHZnouOhTWZ4MCEpoLKRLBWk9CMa2jFVShAWxAPmOCCTdzar1n5/JVXlGFomKpRrLqNQviCaJJgbiHZkOza81HqOhKLD9lNrGHZxVOwhp2V1roOmBXKVoBJZjSIVHGBj4mljRIM0FoZ6gJbY4NJUrD2B5gJg12bHziv3IqZ1yminq1nTpiV67kJnJWKvIJJh5EDlVvC8aOGK73H7QVKYAhKDoHsd4ns4JiboNiKYGCLUAVF17Ah2rEIi+iLv+wYRmj9heD4hkGAQI4hSz2RHhTpT1ktSaeZCZIMuZmUXn1C0RtklPSRkJOVykMQGAtwhM0ARj8SPxYNDB0ZbnE5BXmNhj+gRmEIhLy1iRA1VMx4AUEdyai5XNpoubGaIuBwjDeaEn/wTMxwtiMrS7+9wjf4Ahy8Z3wqNiSTPzKKSzcpQxERWF/UAgrgjsl41AwQgS29x9iQENKZzLMIUUAyX2gmDI+IRI+4Yrhznl1w6+sb7wIdlNO4QFeElBxx2biydDWqAkeoAWuYLHwkzMbAqBMQhEEjiKhlHmUJ776ooc1ZAUuQgdQVyca4RVeAbMoIxNUIf0+oQTEV1O0uLT/OGyRtpSitkFBdYIxJucnditcriAfbsBn2SAI/HiW+MsxbHmXE46Ct/UnVsEzNIEHNhgZvSeTC7aMjrCLtwEC8Lj63rCSUQVCeYtV/SEfZmAAcCUfcC1Sw1mcDVopPEATJnZHf6J7CwID+PYkFDoScEEJbOCNESmjpIU9hnfYQGYlkmxY+/EnbuQNfIBDhkFfhuEG8gHfuLObzQeIZeRxedIAbFhCUgCXD/oiKIESTGEZyu97UWJH33SoJ44tMGAkiuwXhcqvanJsZSmaTmFP8wmErClVjssFyuABfEAJWEEanOFVRioOOCAnSdqYCvon6ADugKInb7ooUu8igCGu/w/aHBpzM0AVKD5BqH/iGhoATnHBBpbjH+IpZOlSGPfSZuwF+3wiXCrAmsL05I4QYTFiEGCkAvyhH2AAGfIhHxbBHgZm5M4lKAyI15ZKpr/USNIaO2jtBE4Ablp0p1FiiiUjC45Ig4mqv+hFi0e4jGWS7Z46tcdNPforavmNH79i3NiAAxQgAS7pHza7QhZhpWmAeXsLqDjUNSkDEIXCNp1ttS3iBEQQGHT5UNY0to/CRWX7IoyBEibAFADIadEwVGrJK3QqJ2qJpvlkOl2pNbsnB02ZFTpnYJKKwMdBNcMrIAnyrVsSKAYSQKooBVIAGU4AkBMmG/5BAmTboS/gGv9IqGNgIj3yC0/zawrX+HZClyZyIxlTA7kx4g4wOw76gcBNIh+GCQZua4K8Eg71h67EQDaBArXF2QBg7SJc2TE1FU0KQlvVGygeDBEuglrXuyCMwRgC4BqiRD2AqjBuJ6fQRvqM61SfV8tzqjk0OijuYKtfIAEMYQBo/CI2AbESYBhaAEAAh11O9ca4b8rB2SKqiHuqoSCAAPzGqjI6AMqh/CSOWJyNYe9mgLDvFUGxp2xkgoXRNQPPWFqkZIy0OyhUwx/iAAaUyiIGIGDqIQFYATCO4Bm+wS+BAmr38h9QwLv5PMiPZMWSPIGBghQKWSkSfb2NwRquYYnKmDnt5ir/d+tV0Rh9NXBsc+IjQSggv4EOoIADrjkCOgcjIkAL/0GLCsIraErPCO3GgLzRtuCtC0LDlxVwuPskWOEkXpvPhTqvkQLLLaIYEgAJINQmWpYmOmWrSFyWcu+M7Wy80IPgGKKjv3TFPyLU62HUk+oeBOoFToFJzSa7M1lFr6NkpHVZB9B8etisLfwf6BojXlv8ohUozuDZLvYiMEAV9C4TijailSIaNDYNvuuD4vDkcta4uviDgJ4vP+ixbcKOA+8nvAIFvIHwFmEAFsGzg4LX/EoNo7OM9hEjhNwodDnJE7brXei5hcILVoAGbJTra2jk/+HB4jZuC2LRE4bm6d0x/7Acy9MMjqCDei1CwHImaJ4guG/HgIAk72XnEIOmCfyhEC6i1B2knTGeEsHbYQkCJXTgDFL+DHp9qfTuHzJB8y0CAxw6KYCpIAaGFxrgGCBAQaSkesx2iDztp8xoCMigt5jdeljCgm5gAAomm7MXns+xmV0I7X0DPy1cAA7lTTUB+IXi1xMOGgri8/cOJRq9IFrLIuwdECxuert4v6D9VGSSq2oEjbEKkwfHG/K8IbbsFCDppG/F3gsim6OZ8RH7L2khQIQO7IUCCzAmKIBh0dnefDb/IgCi1b+B/wQSxEVwYINMCRsSvPavwb9d0iTuQgbjCIoPBMSc+veRwLZ/HP8/OPwn6OM/AiIJrHT5b4hJQQlTnjTpUszJgS4r/BsAQ5+hnQ0F6aR59J8YmER3GvDXNCrBFgNfJLQlNevALVq7et15YqCFr0SrEdTxr8M/cIgInsFENq5DBA2hEUVIsJjdhMak7byGjEchCARMDhQk8t+2b9uYOhxCs7FJjjQHGk7o+J8LniwvnyzzwMi/YTCiDkGp1DLJ1Z67QpVrIKGEhJAG0pCLm2jshLvJ2kgYVqvak2P/mf33AwhRXbm/BiDYyqDcYsUGtktYzOI/axgFGKGTUOW2U0hP0kTcmOXKhJNJDiFw+h+HkyOboqjg70aCf/n+oWnaEgFPnIeSYzr/NeGaQ2j0dtJ8Uh3SXEJbvCbXfxEOFMs/t0W1QlNmgVLKcQOh9U8BF564E147GfMPi/9csNeKD0mjkQuFESCIURcultI2Q3gzEIInBUGQgwNlwNVAEPD3zxFeqURQYvX9M0ZzFGZl5EAmEjUcinFtwUGWX3XYEBH/kCnVCaQg9w8pKWiFSFv/6CKnlzvF2JWKA+kZVQLqpIHCHR+RF1drA41EExQOlfMPFw290RSR/v2zpFTqYeYYGT7YqZU/Vl2Y5JWczkfVpl4Fl5AOKfxwEgkNvfmPqsh1U5xUXXJKFJ4sznASQy22yGdTfg1UzC7XaIRoZWQ94VBKiMGk00Ah/wwUzkDekJHQMk2pAFJDm9VnmKEEOSBhhKI2xCCn6eI6kJhkuYlmB8Ro5YFyJGbFHLtdXbNfUy4mhOdO1EA0kSxXIPEACoKclllUpxAgXng5DjQCF9EmNO0cA3GblWHMEgRllISiNhC5Dp37lQEW/uNPkgmtTFC+zXEVW7owe4XyP33YeS9BaBKEqgcrdENDrfoeHaE00lxTjDTFJMCBCh884dk2yiZ08XlXJ4RCQtg0dfFOXQz0RMPighfXul4t6M9uBqiNNEE540Yh3E1N0ZBarGolAqwbxg14XNdcs0sx1PzjSwJYcPDGHal1NejCDhHg0z/V/qNOVO78AeB6Xf89sNhAc0e48hZPaeWKnaP/Y7eX4PzDxD+snGTmloHfTlR1cVlzEkRME/zPCwm3N1nDNZHc4E7hLJ/Q2P/81tTHnlW5kxu4kwUKErjCfLPo+h5AUDM7iRDrSXufJKIH1+cmkUS44eAQRMbw8g8vGLE8RpUO0FTplENSSpQSXO4kSxhIFz5lwKaErCmzWd9XzHC0ubXgP63DDToIcgwLTCEFFviZ0ZqCKrE4MCqNaIjv+BI3dwHOZCNsoQsd4qAKouhnTdmS7V7IPhzqcIc8tJMBfiPD9d1KQz0kinSKiMQkKnGJXekZE3GYuSdKcYpUPEkIq4jFLGoxcA5CILriosL/f+DtVASZxT8+uMU0qnGNXmlBGDm1CFj8jSwbcMi9vKBEDLBxj3zsSvccAjcjvdF2Y2xIIWUngIEcgyCJPMns/kEJ9fVxkpTsI1WiwoEoEGURRGnkV9BYyVCKEov/UaGDHPTHhnhSK4+UyhwbUgDbxWCUtKwl7lyWFbWBbyD22FAjV0kWM/3jBCtAozZOckNbKnN9l1zjylaXEA4oYiCbuApROOEV9cFqmTp84xPf5iXtVTGICiKIIuqAjIHUUYwDcRVuRHDIhpxvJ8nk5nOIYipuysUfqYzL3Mh5khes6z/NvBAWBkJDggzDK7eQIiq68i9cGaMY98wcDfYQAhv4/8MMXWPhof7xgIH4wFFxYUMwvNkUaEplN7hk3e0stDo0LChtLe0nWTiZkG0SpJUEgQUI/0ECPEpSjRIJVnPMOBAWmREa1UHFLGyRhQQAggNHeMPH/vGNfzzjQmwgHadU2pwrupQgAE1InabyDzdOyktDPMlBcdPQfww1jbyS6D/MqLvDHW4atBhIabQnCLStRyWxIek/qmClXK61OTermVdCcZJXEsVCu/lPS8k5y4QAsZxOselJ7pEbdzrkTZQ4ySX0SZR7Emsgz6GFNWQxC6eSgBUJYMURIOCCCtxhqy9jA10usD6wDoSywy1oUxjUAWDIxRRpfdkXpfKIhkCPLP/GDaLMftoUyRKkOKDEYl05dbiB8O4ks8Ari/iFBScN5BRf848/lsAFw2qlpXQjS5LWBTfjZmVDIZwXQf4o3KxMl7MobYgXLxRPZRpVLgEohjHCO5BZ+IUaTv1HNIqhj3+UhhVGOEIQUIA2z+omN0aSIczK+sVf4AYUTbEpi0fsEPoO5HQM6ucfnUGQtzYEjbPjhysniZeANSSiXkmH7v7R139Q5x/S4MY/nDyNdF5jKPxRwQugsKn5cAEBe5CKjMkSJrk1hSsF9opymqPW/xJkwAMBZlS+fBIRL7YhSXbI+USLWuANmV26O/I/ZIEMWZwkw//ACAxoQBUbvKAESHxODdyCeLMD66sOcUNDmR1iIhw3JLoDOYGOHSI+1MatGNaghZ7pXL/+5IMiqbhGaTQsTtyJeGeanPNX9Iui7gau1mTR6UDmehIqRyVDgAsIACH5BAUNAP8ALGoAAABoAfIAAAj/AP8JHEiwoMGDCBMqXGhQWa9/LBj+82RC4kJXGYoYedGihQ8D/7ZsEbjliA0kTCqNqFTwzz9GFmPKXHIQ16uBly7F1Cmzp8+fQIMKHUoUaAyBiAwqiunJE9BEpIB9+lfEho1/Rjb6E+gPTUE0NoqQsiQwg9OBC3x2WpjsnwmYCzfMK6gl5oiiePPq3cu3L8McRAHLTGRpwQJi5oq8CGvkHwc0W3y0QLgVzQsgTMgKhAlz7UHPBDsJVlhxkkVQflOrXs26ddGzrgmqKoIViREkWF8caXFEMhqvXAm2KKJAoeaEiQR2SrsQnWmFI0TEnk69uvXrFtdIHDVO4Il/415w//C3deDvg+X9XS0u8Pm/BZ4zlP0H2vQk0DLXJl+gwBUlHATRhNcy2BVo4IEIHmRJJWlZsowNRqBR3j8TSqQeE/9k0MkfZFkin2ZpMZdIcscxJwlBJyaFEAMFQTOBKaQI5IqMFrBUECX/aJLgjjz2WNQj/6h4kC4DEanQJebs9VwGlnhmiSXJ/VPYcu/RRxBsA+WAzkFRCqTNP7hA08hCNhpU5kJJ+qjmmhaxEFFqbQFF1iWVzMjBTyIZZAMw8GXoYYdoJWRJAQR16dOZCU1lEY5sNurjaAT9ctABfgHZUy0DWQJMIqUAIVSFA/lTBJOeEcOkQCBWWZB7xxFUUQ7uHf+UpqO01mrrQZhg8g84BS1l0Jb/6PpPcq6s8AJBoG5FHmVo+BDSs/+0wAQjZBkqEHMILQCXlNgO9IckFbWYEGoEfYnQmASJcuu6a1I6lJBBAQkvpgJhusBYA23RAqgCAZcQGncaxAF7BT05LEKgjQCocongd9CMBc2qzYyVmMLuxRi/FixDyQh7EJCweYLII56sVckPx/5zRHFd8cvBSJQ5S9BI6sl4XHIOG4QfxAWtxZwrAfwDzaylDMTzP41UokrGTN/q7j9P96RMLVge5CtBirwJJCaw5foPnykcy4Gnk8Fc0FaR+evvP5AddAQTKRw87JMGW8nQAtYmNCuiAln/4KWOCAFO0ChNF44xYIhUbfWbAyGCSZwEPSfKVS+scAFtCS0b2UheKUshQi2cYE4lqV477IkWdauQjq64wrdE6houe8ZJIfJQL54AO9BSjAvkpkCKOI5QYi3YcMIylgSsUOcvs42s8gRZxYENGEpHFrbyFSVKI+b43dOsSZoj+OzkY6wMQpbuDjy8ETNhlTaXWIA5Q14BTHOoj/0DEldGOEveC0iwgGGA4YondUJACakETxhiLploQ12xk9FAxle+CvroEefrSdQOsoBKKIYJC3CFp2KiLLNxBXrB8Rd5OMAEBZAiAxi6RKsKFqMYKcR7BlFdTxhlwR4arnf/gBR9/y5RCiZcpnXauIpF0pMn/JkwJOM5m3rG4YoQ5g0tdfqHDQ/yuph44CCi4KEPxyg7khkEGP9YRlWYcDSfdEWFMgtVEw3yP5RMzCCksCHFAnW0Bf6jjRL5ROy+aApRLCOCByEcGRc5lKWw72MSUcTVkKKzPxahCB4gRREmQ0JkeVJC6Ylj5l5QBO+twAJ5lJE2lhC+tMDkE5/IyRb/gcoYxY0gCxSjQERhMYXokpHADMoBspYXIAokfcNiwiWY5IoiBOyJS5xQebagrDvxCz0vGAcTRFGcPDboPSyZkSuW0QhNkCIRqESIdAZyy4NQApEx0RE8g0lPpVBqkkvJYF9E4P83UFxiRkp03k8mdB5/3IkDUbwmQYBjGeKYgxITW4COvqQNUlSCHgJh3Rb/+Q8bkaIbArkEBetJUtYcIGqKeMTIFKIrZFqEUP/YwBctgQQbrK0oEroTGhoDrZ56cqHTK8I4P5GWSjCoYsbAAboIIgJSXGKL6yRIF0tKVaFM0neUrJ2khrTVn6yFEimgVoQ+lxBnrW0k0HQeByCgHhSm9ZOhGo4CWrGMJIFiAzA4SDUKskAbuc4/CplnVQfrUoNsUCAP+cfVepFYrPmEXilwRSeAsUkOiBIhzkqZQcxGs8ag4RyTeQFHbkoZgYbqBUyghAD+4YhrDOAfrdCiDgkSI7//7VEhABqIYAfLW4K4a5JAekRhi9KkUriCFEAQj2f1txB/DOcId3prC4zgDyQgQQXqUAASFFAVC1FIZjBTzwxuMJBZNAKHpHAFSA92nEuQyyeIREUve1tSIMKrsOBYynB7AozlKOASiuHA/CxkUNrYIIomrK56VICCfxDgCXQQAzYghELKHOEBAhNAAgLgBCbEiGdP1eJeGwFIn2TBIARSiCLpS0Z6dWAgwkpcXoBBFg29uAW4Wd4JSTkO3BRHMQIByUhs0JHiCMLB//gGHf5xCuj6lDJGOMLMKESDXlpCR6iZESUoMYGDvBcoqMgtiwcrAYbESiDnM6ZF+puBEw3H/0LTtYECCHAKgdDZCIqREEk4goQn/OMDR3YBNj7wDzGoIIoWQkLKpMnGP85QE0vV0SwlcuIsnHjMmF5IRdCIPqB0ghgC0exCdmoDH3xDIH7+wCnEsGQFKHErpByIGAhAEEEIggAVoOaTf4oGJuCmQiz0QNwA+eVMGzsvmNLVJDKw1714AnWkNQgaMIyGUzz4yAQgwDb+IYgPlMHV/iAlEtRxhz//wwXcHogg6PCEU0QbPXc6BRRE+QIFmMMcomAJS9p57H7rBTA56FZEsiGRnKHoFznedajqp4JT1PnPBMCGne9AhzsUojFIGIS3CX3kb9T5FIIoQ7ZRsO0l2qACp/+owLZlxoF3ksIDn6BEiSfYk1kI5Zf+bs27EVLmgiQFSOEqiFMqoiHQXLV3+iVI9gaSiAx0QIgM8YcKPvCBJzzhyIR+hkC+kW0XWPsfd3gCoAEthm7fGuKC4PplFVJdbJDhH0NAwQMg8I8XaAMXphhFjNZJiqgC5dI+oUSKUZHz6dy05xaZxNUslYOrwmYS3bkqQbpzEE5HlQGTkAQSONlcNHjD2rQWg0A+Pmsm0znicBcEyIegelofWSDYRsE33hoqrhxBBYLAhq0rwOQHTI8eF5jKOvldoCkU3laP/Ae9EoIJyRc8pP/Qzjl+EdDm3l70BPnGtgmtajsP4R8SV/f/91FtkA+ggO7N5QoStP+Nb9z6Dk04RRo4cAQkGPISifA7XwUyq+OTb+c8MgKJcBMGsXYHsQUPNxCqVnLcRgCCwHoEQWsOCHLW9nVHpnpMtg0oUGGUcSx3cAqEBndMlnLe8AD1lwGvMF9ShRqgUGwHgQpY4H+DJRjOF0Q/QRYjcBcCIQFVUAWB8Aa0Z3sVgG4C8QHhR2jbJgjbwIAheGvb8AHbJnFpZ2sQ+GfyFhM+YARlEIKFxm3b8IH/gAJpkAYP0BiM4goW4AqgoIb/8EULAXg/MVUy6BcA6BNBhxfHsQpbYQYhIBBz8A9CUH10xAEogH3qpm4fJ4F/JobYoG1+/2aIDkYA6IYChBZxKoBhEoEGtBFhW5d725BtTzgET9BgCoALAuEBfmMBOOIBbngQgNNlCFEMB2ExqNAIDTSHroEG+zMpanYQjNUXmnIBx8IFQsAFVfAPVVAOJZBwB4EGXVBntFaE4Sd6F7iEA7ENp+ADDfcPqfYPn/gELvAGYgiC3MZ1QSgcSDAEKlAGTCYQDbZtp8CAUiZB/5AmOJQQKngoBjFSuFggw6UMHvMPynCHtbB8MbEAllAOm6QEfTgQ5fAPJUAwm6UOXEdrIZhtSPZ6dXZqdsZkI8GRXBeJLoACgdAEI/mBglABaXCO/YIVD/AAPvCSafB5Kbdt0SgQ0P+AGknihrAoFLNgc7IoEBswEIxyi/24F17BeRLxWzu4ELVgAp6AKTkwlQZZC4qACbpwZnMzCs7EB1UQAlwgEOGAjOEwlglxBC6wZO0Ie9vGhKfGkdr2DytJcg4Wj7Qmf3SgBk1ABnEpCChggAcRZTvQBxDQBwLhAzHpDSqAjej2ACdgCh5QbDQwFFlgC1kgAFhAeGL2CnJ4lOuCKZIwlUHkFLxyN9qABiUQlktAE1wQDsooBG6QENswCApxk0iWbgTAdSm5DT7wBrrZiBRIBm/wD3TwAdlmbdtQh6GmDoRJEBAAAYiZBkMIcv/ABMZwjz2RBa0AhwVhC/8QB/9waav/YJSn6JlMgynPgQjnE5AGkQHLYARL0Iff4A1pEALhUAKwWSHKEkfuV3Lhx40MKI3boH3ZtoByBwEaiALW5g1QSAB3kARJQIS2dgqxST8DsQMF4Sw+4A0kV2cYYnytaBFZAEGERxAnhgqAMAtY4J3eaZ4+1GaN4xSQk0MjcBUqkAZcpw5CMAfs4RVHAJYh0AU+UAEo0G0xcWsFinLYKHLbUAEYxgEP0AQo0AQVQGcfAAZq8AZ3QGffgA3ZuBAgYQSYSBB9sAVlamp+tg1IYAz/MAExOBABEKIIoS5DuTQEcQvl9Q8tKhApphBL5aLT0VUW4QkFMBpOkRSKcy2MkGIu//B9p9AFSMABk0Ee/vAHQiAQIfAAcYkQ8aiEBPqJXvgGguAPEDCE2fYE7EhWMfmBFAehYMCXTFYBUCARHIAbhikQtyoSaJAG1pYGSFACCjGUCSEKQymnAiFmNqc9gGpaeJFYBskQJjAJgAEXbaEiz2o6BNGYL9AJQpCF4dCaAoEA/yCu5lZr6eZxsIcNoIoCFVABbAUBe+AC2baFTTCmlHqvfKAGfPAGD5AGZcChY1oQu6iJ/0B3fYCh6GcAW6GgLpADuEADQTMQlbYQF3ALAjABlJCPBaGxC4Fz4emZSqkXALl8yXcQ0poU4LItMlEBWXipabSjXDAH4RACVcAF7v+KAuhWdgPxBAQwdoLAoSlXBtuwkuQhnd5gpElGAFvAB/8gM/e6FYXJtFwBAW8AmARxBEFwgCOBYRVwBCdQAl2GCpeGmRYxASPaCLv1sXu6rAiiIsETE8tmGhmACAzAAKXACCp7EH7kLA8wqwPxhwLxkIAbAugnfgOqeilHpUtId5QKAXdQAR8wBHSWZP/grnEgAzcQATKwD99ZCIUgZXrmD2ngjbhmtQLBAUZwqzOzFRjWYFiQrAQhC5RmEBzbk3nxpmxbHR3gFNnACJJwYJLQGQhBDC5Ra0NaEDBbAnOwBGPwAmggrwr4Z9+guKcwdxTSuD4QBEGAoCAIcg74AW7/cAoCJlpXkAACkQAJEAEUwB5ZS3dGWAHKiR5b4QDZ1gLGEIM4kKysQBR42hrGWnjncR2SYAaTcA6d4C4vwCJVkrcI4QAPUAV7MAeBIBC24QbOOxBdQAYNVmiSWAHf4APhRR5bMHd3kLX/sL2geoEg+A0fcAQCMA9EgAZXIBAR8A83oAL/EAXaSwaf6ICVu3PvBjMcmga0Ibv/AAh4MQHwdGLvRYC5qyb79Q+SsBwVobD/sATwYQL6kQEMLBDSUAw+IAhQIARv4AYnsQRVUBkCYQQSYJyDlm0VUG6UKhJ9oL3be8IC8ZxBEI/ppoRmQAdGsC+hQARYkL43YL4CkQbb/wsBxumAXgq/CAEWBTESIGGYgyBlDRCDuIsXOBA7YkYKLtgTwrrJT5wXjaUzC1AFAWMAZowEObAEjOAZDBwL/0ANM+CNhRAtgFiMBHUEQoAGkzt6DeYPI6G9DpAGfbAsAlFuAmHCa2ln30AeRfAORTDDMpDHaVCYSdAEfimBpzAILIsQNuC3BjEGAnEEM5AFQYnEemEKtusKmqAJOGKnRLFapcwXdyglDeM8UVCzS3AOL1ALOogQuxAmlSsQwPoP4bAVEsAGNXsBF3AEq4YN4NgEFLIF7RsECms2uewPZBAFBZuWuheF2xByEFAEUzAFL3APueycTaAGbPABz8B6tv+mbU7ajMxIEGmAAt7AAcVgDJcGDQnBCjhgC2LGEMugCl80FaTgNx4bE5c2sfecFyZQkDmARteTAXPwAuXxB0oQDgiQmjbwAobxD73EEyAQU/8ABUcgZWG5jP/wh2F5AWuMpNtQtf7QB2MQBFFwB1uABvtAATKguVdAXv6gvRDgANxmbazngGXgAiIhErtId2TwdhAKvQ9na2mwDVBgTVcLBfezFb15Co1hA8bwkwUxC8eACi1aov9w1AgxAXaKCtvzp33BnVPdE4qQA49gAoDRAaCxBGYQLRKABk+DAFUgBCNA1jKHKCJgDgrgLN/6D30YswpdDjVbDmEZj9vQBEH/4A/ZCwHa61wc8B0RcAODfQMzfNji7YAPeHb/8Azf4ALifQfe5gBUx7NXqgZJcAfwPWtp95LqgGEEFSEGYMXlqA4BwwTXcAtZYMQEgcSr1b8yQdsW00tJoi5PvRBrKxDsnNtB4dtl0V/0YRgtMAepaQw9KJblIMFcYAOzRQzukAMDo9AE8ZDk+g9ccAEPcGvTS8wl/NEq4wsqXRzkdQP3gIn+4AAQgAKTm4AOWAHeEMbxJ3IWLQYamAQh8ATY0KX/ILQdTAAxiQ2iVGoGAByj+w8PUD82IA02B7uzQAu3cAz/UAyzUAwdbhGI5AGMIqw9YQsCsL/LGr9B0QknMtxS/yIQo/ACVVACNVsFFzDdD+kGpIoEnWAJNGEoIxBlXRCWBFEF4ZCMfMoFLyCGTZDM2xsEW0B//3ACAqAHSHAFeZCwRGsAQYCS5Ui5QyAG1vacDmakaTe6SUAAR+tgLtBwH2BqFUAebA0zaPBrcvmMDuAvPqAA1yAAQYm7SEzKPxEAPMTtMdG/EA7iRPEca9EkU2IDl4oA3+qa2s0FvaHmztJ//6AFBaANLwAFEneMZnnj4xotKABh/rADiiwQK4AMW2ADdiC6JJG12PC4IREEXJiqArF9BECJiS20dkZoasB6pUffTVoBffAALNsVL0AzUIqAp+YA41EeaKAOyPAPx/9wDP0rAPa8EG64NGIUQbg9EDdPEDhA4QJhc+NuED3vfxyIF8smECxB13Q9luXwrVzQLG/3dkUlEF7wDz8QA6NgBN6AjHN9EGOpDf6wbvR9x8iyBQbQB81JB/J97GPoAzqsbZt6jd6ohCwrr3R/B2Aw7Ma52ILgA0fwDXvge2x9rxRyBIWQklPOL85w2j/54TipEBZji/+gKAWhzgEAu91pEBF7ELRA7n1hH8phA8pbEGN5BCDdzFfMN8RwCUYgCC5QBXPQmp6eQ1swknIJwiDhAzuAoeasvUsWkg43huKL35FomwMBiiy7wdmWl5IbkrfmpD6QBnvQ2WJae2igAJf/iGgksRU0YL4x/w+zIAu2cP6y8PkGoQqwxOF3PhB4iqe2wPl6GjRCLfqsgQhQCRiJcA5mkNAA8a9cuCr/zPxD+C8KwiX/UrjaEOBfB2ILbBD45wJbwYQXEiI0g4bAnX8QFCJM8+9BkDtpnnz4R0AmgWdD/qWp4MOHijsEYG77J+ijoA+CMPqo4GLbnQ9JmrhIKOjbtgr//G1Z8oCDzo//Xlj11zWhkRmzsCAU8C8tq38T/t0Si0PUsk8IRXXNIlYvwrwJj+3V+xfwYMKFDR9GnBghB8WNDRdAOGmJhDn/uISbU+4f5i0pEQYJ8q8h2gma/iWydKQCzA/fvv3bdupf/+V/VYT8Q6MOBUIyZEK7cPEBRRkCsRFukynoVJogp7D5OPUE479TyhFiLOpCDAoIOI2SSUJHKMJTBE59qJDGnz8VRrYc8fHRn5HBaGx9hIvQFqr7gFUNzquVf0RJSy9a8PtHIscWZLBBBx+EsDATPPlnjU6+Ciecj0pww6SP0uBgiVIsQAiVhIrwRjbWsLljuQouC4cLBGzY4hsCUBivCTpkq664f0jyibVTIPhAuO7++eAJQWoaTxAlkSxqGx8IgMoFMK4zShAVjPKmAiPWU+cBf+D7qIWvwtqrgbTiUCstWwpEyBnF3BrQlr70kiUhDwYUa8+uBIswUEEHFasFQv9NQMiESSYxAZEFXphjD4RCEAiBMbq6NIoFliHFRIR0KOUXb3YLCjaEvuGoNoR8iE2oD8pASChsZIuKAKO2KeMZB1zwiQAInvqnJlunE8Qb2AgYFQIV3ohtmw+mI+CbWU85BQp1dNrigSPQ+GcLq7xNiFu9oDkQ0FlQSeejfBpzAiEc3kIomo9kkaaZhATo7yMSCOW3X3/9zQEhT3K48A8EEMpQHIUgWOifHRJSYBRSSLCFEnIKePQ8sQgwg4tUw/HH0ISmi8nZj7Z5jcVTvFFhDBU/6G664BLaZhtBXBgvDQd8UIILN7BzloAysDEWIQhyssoIxhJajwMbBjvBQAf/VWnElDv/sUWWA/P8hxZpEGLFTsLy/bdss88mrJcKGTnAiMsSwuwBCEILzaQjclDFFYQmqKaUcR7oMaoPtlmCCy7+Wcagf46AIiYksSxVjK6Qc0GFEh6gI7gPWrK1J5iiAspUFxxI4wglQnDAZox8heCBNKiKKaf1fAAXIQPGjC+x/P4hYRrHPP1nloRk4fojZI5hC+sD0WYe7aWb38uTSZLpBKkYqwihijnG8NDhhJAAgIQCuynFBm9sGgrZKjzajAsO0DgiI5JLLfLz0J8pzhshliAyIRdS8gaQPpcRJG0DOAG8gw/CwYMQuAAF2+jDP75hhC6ggFrPUE4FvHWV/4R4Cw1osAGaAMMmvdAAawi5xj+uphdA/ONdCuKT8BIyjGLcCyHFA4wthjG8jwigGDiEXhANwy3GiKts0jNBAUagkobMoSBzMINJHvaP0PzDBsb4BztIQAUtXOIIKBggRpTUhcu87QXraRypBoi/ko3MPFC4AA8e4ICMBOcOBnwCVHwSK2QhZHQuYAkE3IAHIZRgCxCQDRT8wQEOPMBYFVCBCipgRLAgRGlPE2Fh7oMDLNSQG/9IhZxUMQFTTOATmjBNQkw0i1vYQnh+QsjyEsKK5B2mhv+QxV/gJJZdCtGXv/yH2jyRAURIohNHEIM6qlCC2SysKxCIX0K0UINsPP+gV7EKijc0U44q9OCMuPGBN6QzwKCE7mRlSEMVeBAOH9yBDAS8wx1ccAehAGWem3NBAD2TkjtsIRIlMMMe1FEGFXgQDf5Agw8esFBt/UMntMOW016Qu8H0ki9daaGf7tKIRiREFGYxhgxvSAtWYDEhyACiXuylteIdo4WAsSgwZYq2XlDoH7qYBCZG8AJxfiMEh/vMPy6VEB8YIwD7wsc6jCAG2UznA6dwgfbKobAjvIBbaHjAEAggBgI8ISrVcdx1XFABIQihCz5wwBhI0pKWOIApj4PJE7xRJZYIFTQvaIMQerZQrjDtKvGpwBHWgwYObOGhL7CBDZ4nlk/uxRb/f9kh2PI0iyy45T//8EgAaCFLXP4DGQlxBjJi0YzPfnYwcqJFngCFkH0NppYz/ZfICtU8CnlCFzlIoqGeoQ6B/OOsQfDQ3NBAA3h4ART/sIQPUMCrf2BDEIJoERc0IwT4RCEs8LPJ6vxoq/EcRxDqEAIChOCDlNCNimm4Q2jeSQbWFA0l//ANS3zQAiVowwjq2FYmEbIef/hAAYsFyxFaYIRv7oUtq9VPV1ghJ1vgwC10sksAAtAfke6FFpFFDIILYy/Y/hLA/jJBLTyhjH/Y1AgP2E0VDMeFKFSRqEjAxT9qAIAXYGOcphLKHhCwDCEcQKjx++D5bIakXsFEJv9o/wJ1UjIHPCzhCEG4FB1Bs41L6ewfdEzIGGCy1jGA5g7rUQAPSqCEL31wLwg9grdq948W2AANRaikgRUcJ9M648KzKAYOipGFW+AZGhJpBZ75g5BbwuIfhk7ILTu86EAxRraN6cRhEKG2f/ziEf9ARAf+wAFqpeFtEggqQnbwlRVQQRlHOE/9sAGTbzhRCEgQqkNXpY4hMJcOH1AKRmRFnX/soQSREIIbjOZi86bBM3TEcpYREgWSWMUMJbjAHOhBmINysCtoagGsDYPaZjQDw//42j+MUQJoXKABgBAANEoklmPIybP/YIWiGT1v2E7CMAH7Rw6UgahHKAIRJrCIEf+G8I0yzMGJVOyKf0HwAw4MoqtEfsJyLiOETTOnityqgI3iihyfPNdUg0NICcLRAgeYxOSXQjnCEUISqCDkUkGIwjY85I8qzAABGCiBEdcsH4R+xFs2MBRi93INH540FClExiKaEYt/LIIG5/hKEU6ABAFIgy2UbUULb0mN5MWiXkunM79eS2+yMwjfCUGUJ5BgzCUsjQBV0MyTXbwDH2TgHxxQDsqQ05qoYkYBPoCASTwTFm+wMXQPcMFroiUIA9rmHxgY+UKqCBrzfkgsVbxDw9ZTgjlAWwlI0G99cIOQ2vVh5whBwgwQYi97IGQYpP1HAmjAGMbYgAktBMQG/qH/+wDggLLHEF68/6IPZDgjGsV3xgAetKayNz9CJpDEPxCFqIR0whLA8EoaUJCGJVCKDSVxOXyr6h1aSVCCh5uDP8bwzjGMIT5bUMEDjEIekzwQSwToghAigQFVKMEG3NuCPki2l4OvAnwnOiKJKnOxRRKzm0MAHrCKj6CkwfCHrwAMhBIAGECIUBiGWKiHG4CBAaABkbmuLWizE4ABQHCL3psFBZGXj4gFZEC0f/CFdUkIWHA3CBm7HHS+HlSMRPiHS/g7mfA0y0C4uikJpHCBUxCDbygKolABLpgDI1Ao+FoIb/GBLniA15CKmyiDn0iIB9gDADgYhtCJwZqbk/iI/6FyOcmbG3A5KCPgAY+QkR4oBKZpjAkUCw6whRmghmvghX+AgTjQtgssgnHLD1MYjBcEDGpAjEDkQR+URISoBQixhBFYAFU4guB4hi5AAC7whu75iG9Isq26jm/gghJYAn+AgiggQG8Jk8b5h6oAoPIgjzL4BiGYAc77B/GStS1Yj4WQsjQMv4Sookvxhwf4EnoIL2ZCgCVQADQwLMMys8EAFzPpFr3ANhqIAwHAAhPyuf0Kx2zDghmQN8CghVl4h/vYHb2IxK7oh1gYhphyvUlknkfTixyohbNzkEi7hEqgGSgoiApww4SAAOUCis9xgyoIByHgAAJ0OViMD7npFv+TkJs0cJ0HUAkFWIILKAEEuAAhqAJuQRP+Woj06goXY7b9YqYW6AGPXAILRIIjWBqd6AMf0MOu+CCKukDcqLacTIj3Gb1/oAFwPMd/iMT8gCXAqCXTskF7hMpJsLt/sLcSm4RKZBBSwIGnoZLCCbVYc6g0cA7l6EQuEIK/EzajSUKVaC4oqACT+IYKOIUKgAKGEkMuMId/gLNfUwLB2i9vuR1/iCC1NEYi2aAq+BIEwAUEgLay4oG+BAv+Cj35WJwXiCbCULMtOL2ueI+PSL3k0bCPgKG22D0BAYxhSIVDQwjlS4yxg8oIsUCg7ApP6IQMqEpFSQwFSAgg+IdKCAD/JjCJYgmBEPiDhJiim9iC82C8Z6iAyuCAJ/uIKFAPH4CPQTiFINBIbJiVB9iCD7qKToBAJZiDVBxPJYhAcSTJ/voM4CqJOxhMDrgNG1ACj0xFBADJErBAxWAMnuQXfxAAY0jHwhhNxAg3sBmb0HzNBsnPxuDHf4g0wkiEBbCEf1iAf1AAUqhQhHACXEA1XOtF8PsIMmgdpPiHgZsD6oqPhpmb9luV+vMBKJgVFaAd+TgCIcAB+8RR8fKIsPCHLlis9TikMViJkhNHDggLB2SmjzxRwZJNwJioJm0QG6CP2BOAlIoQ4hEL04qlYzjK1UtQYKpKxchQhEgBtdgXEGCC/yB4iW2oAgRISxczxgpwg5+qghbwDIToA4aJQG4ZBCjoAxXQzp37oBdYp4/MUWBLHKt4AD5YAj0E0to5AoBEAINDiAcMBB7Qqw87jAUllMv8hxkgGwdxTcTotlH90n/RVL3QG8MYU7DxhX8AgRVwiSJZgkjxENBwuUvxhi4Ihx5omMqrIsbIljt8AEDdzDG5DXHzxX/Qvx7gAcZAKB6ggJiMwNopSQhcAB6wT2dEAgU4BzP4O2lsDHwcFDR5ga+QhXc8m1zyrNBS11NdENkCNb0gVwaZ0H8AheP6CFZwAiogkVv7gMbxmcAD0Y94Ay74Ax9gz4SIAj3doAeoCo3czP9uaYES4AJMDYRwUAAjqISPHLMRqDYFaIMe0IZ69QczEILEuYBJtc8LyIAFWIA/MIOaxJaJ7YpUDRQ0eAFW8IfW6gp9SAgJ2xcrzbC9QFB4hUpQgDBfGIZQQIt/8IA0oIN/eIJt6IJ/YIMdEMV3WhyUdYMgYI69EFbAgT9PzUYehU8EUICn6VZtQADV2wwl4AHdXKRyUIIvKIFC9CAlYMwS8Nu3LSshwIUxKwfJbBrqbAGh/CV/iAMaeBq9+KzJ8hThsVJHKIxI5BoeDFWkjVfmoRMIS4hZ8IIJUK47EIPEq4JAgM5nupQjaDFiFIsNcoAHwCqbRYMWKIIXyAEuCIT/QCgrVUzSSRWCuT0jGwCAEuCBw+HREPjIXuS8csg2bWBWv23Q/VoPr2gB/iwbcbWKF4ABOBOLHGQFVGClAJiFzW1Nzv2leo0Q3fuHd0gI+GUHEHgBYhmrZQuNh3GxO2gdUXQYFxNWb0gzjewK2SqsJVCC8HpbtS0CbWhezsMA8eIBCPynE+UBQ4ECJYiEkEMAPJiDGeACJniBIkDgELrAq+CAidqvauwXH0DbYqDHhGiGFzxfxyqbYdBS9f2lFewKENiLW2iFFkCfZ0kDzDiC4FqIhm2xwoigMTEsGr27F5jJM8qAxkSAIigC2zDUygDJg5mBE50DHBUCLvAHxgTJ/8oI420tq3JYW5wsDCDNXiiFEKvaLywAUMKgBVs4i70gIR3244S4BL0AHr141XnQ0CM4sq6Ky3/4A40MPJPIX1HTiykSLM0cyi0wAmg0gyUIhH8ogTGmrxIoAhu4gB64DYObg0iQ4BKwhBeQXjP+h7ktYzz40A3pxbflgUAIixkljKa5O+3lzAbhybOwBVNNDKL94z9mh8IQAFTwAjartSHgqiaMkWQDPzgFjCBw4W4Rl6f5g0xOiMOxjQzIARtYAh6Yg5U1VARQghJgAhsoggzwyDksB/ukLn/ggQ1OCC62Ty7AgJBUgMHqK8Iw0i3YisJ4Ad5sjBRGEybQwAQ15v9kNgyA7ApXIAW9gF/DCAB2QAV5AAHlWp3pULEqaJigUi/KW8+gEpPR8wcF4AIbUAAhyAzLkF5VbAEFUAJano0Z8MhCGgcbSD3klemfXgYQVoL50NZ/iDGEgFtP/shXO9v+4kkDuFmmOSSb/QcgcIDyOww00c1/OIEUkmh4nVcIQSXR1CVTJQESoAQBQYVWoIIWWK49igk3OJyDqLzorKIl/gxeDgsu0M05WIKBIAiKewEzqAIkEALMCGMuUIJySCx3YNY4CgckMALNgLZP9odOwAOPYJ8wrtSVjYQZALCruEmxmMz+Oj0OMIJCdAwmYE0kSAVkLrs+hlf2bYy81Av/EDBNAYAHAwUMQxaQ346HblCuIkEfyZmNKmBDNgQMCCCDPtgDIz0Ci12f2sgQbTA4G/Axiz0HT2bM+LQBu2UmXSyCc3jAB7ZYf2CmGCvDrghjECY9nnsPYESIqzLbb3FjqhaZUxCPvcAGwoC1+FGAfAiFo20+BB/rvVhV6V3VtsiEf4gHCZslegwAJzDNCcgEDKiGLQCj6UhIFTBiSDaMufGBENjk22BIbYC7KtCeRq1VjykBI2jTssqBFyiHQqpnIdCG8S4kj5jUSOCBL7mNgwEq2rhl8WKCocxG6+VlbjFbEeov2kMCKPic+UmIIeiuvfiKJTeCAehAe+GwBbGH/9b7h3rQi4hGjBhe8MEAR8DwYYRohQkYULEAAR++c7dgAsyxRZoBCiG4ljWcvEFXr17jvILQkOsxSzN4gUIyQocsAgp4lE5IgDGOIx6wATNowITABdB2YfNkJmMow6aOYCWIzfP0q61QdWDGQ3GZDljpCkGA9X/YksU6KCtKgLRAggHghK9BcwZx2jM/c0P4B2I/jEX4B9/pCvRtc0Ghgo/oqFQSiwB4doRQxLq4hHOAgLmenNlgg4Zl2L0W90c+ghDQkElBiGUaOTdQ8eEMgU7wBxu48bKSkQtQgpETh/n0CIvlPJadjyL/CNDuZ/vkVMoEixdIYcOgD+sAcNawFf8UIGBsY7MBOAZ/cOgdaoZFGIandJAcrsfBQAbOcj2Rt7Bmh5BPaJe9cIs4/wdy+IFSkACp/Qflnvkr2wPDUdjDAC4HKPJz95j9eYGGVDFKWW+r0AYlEHXPU4BGn8PKiOAw7jwlOKgj+IJwiPBOxwBKNVSLvfXJjMCwEDrCeJpB8Cq9kBUfEQSHkw2SVIkKBMThgoFQqAfLFXYabL52NHlf0oGJSAQbSIkjO47BYYM5CIGFeBgPiYLj/IiHKc6uGIFXs4Ex/gdKsQx3ICJ/OGfGtPdRhkDMuIBIAIATTeA5AMb1qIIEZibQRhiQbLLDeM4z4mV6VQBd85HjAAyP64r/EPJer1AAGBgGYteHeuCEZmi9YA8UY28MvM/7xtA90N2LlDcMYJiEUniBJdQu/0EI6tLalIYvDxFRoxn8zVjuITeC21D0/emvFgDGGv3nBHgBnEYAACCkL4hlwTaCatPM9RjvEqh0HnBWCASIHjOEZPhn8CBChC+MGPTngwOahP84KPhHhwBGiQkF/dtG4N+QhDb8GbxRhNU/Q/UMGUQW6yAnjTINvpxp8ybOg8hy8uzp86dPEhOASgTmqYCnf38mMXpB4MOHhB//XfjnBmGUIAYh7NiKcEm4f1XKjRDiRpmQKv/mzBGy58g/H1tIovGHoMS/F0t6lJujpMoSI3MP//bZUdgf4sSJj5gxcsQNEjxIcvqz8cJgXTQRE5779mTqzahRDW5DoRDNiwH/aCAbUM+gIX3/kNUbZhtZMaIy673+JytnnJsoD9rTbfy4zxYJW0hIODRnt5vJOhmUVKAFCkGnPCZUW+LPTQj/IAQRb7AcgipCjJwTEiJc2hwQ425BWLnNi3JCIpVQwgHxP0cEYtoOhiHmgxtdmLGEOgogoQ4SNhyAhBs2cODDTSS14Jh9/9R1kA3qGHSKQaCRdlBUTySEgjcHGcEBEgMsUg8M+jhikD0ssWSQL8j9U1xxCA2TExb/HOMjkknmpeRBRyJpQidRGmTGERdtc5BHV1IVzv8SHIwx3j9BRDFTH0u4w4V6EQqhzRL/HLBZgPUdhBgaRiAgBAIXKDEXYgBQIIQDi4VTghA8FDrHQDzMgUehjWKG02VwznlQC0h8RCKWWH6j3YkccfTPKVBsdkQLRfwzjEr8JHSjPcPcmE9P+TST0GtBchIkUMMdJJtvTPr6K7AaLTAJMInU4kN2p2QE2hJciJXVQVpJpNUOYyyTnhAKvCCEO/8oMdlBkh60BRo2KIFAOUookFgPX7xAZxeF8oDAHJEg8M+9c3CBABfGIBCJEGZ4iNMW52CoEUkIYYrQR6BFJUYZoIIKmmb/GNECDTAsws+OsM5040292QQJQvrsmJP/rsGqrLJyl10mUzU2AVMAQon8s0BBk4xgAwR0jHYQAdu4wMYF4cyxhbTQziQBmly8sa1aAc8pp0ZzcRFOJ0q0kJgPJPlTlqL2zkHvvf/gZdDYCCDAg3I5wVUf1Qm9oIAYBHzzDUJXZtlRiR8IIgjeHRFwysGXFfnPPf+AbNwhKfFKq0SqEudjPdKsfDnm/4igUSeMSGTJPx1MwoAND1RwZUb/PDNEi/+E8M8Y5uWkrxAvzGFQID1x8I8/cC2Ah2KIHaFED/9iwNYFeRp0b57GOHtXCW1niBNFg3MkxkGfMrzNwmU8/M8HKDxAkg02qAYJryf/lPg9Oy7SuESH1JNL/z4k2ySLLQblrxGumfvPU3N8RZ2DmOAfSemAJF6Qhs9oySDY+Ic3umCQEhxBPNK6yRxKYIMl3K4Ee/hQTvhkhCosQzEcCMe51FaCSByEhcmrSlUOMocL1C4nL7DBTEgyGbuNSHsS2UbgPnIl0URlGyR5ARZgUA9YDcMeHgMK/A5iiJhoBBL26M2QZNIMVgSnSIDIzaxkkjic9O9/ZpQIzSQBDJwwghihOyACjZAGFDTwICGRYRdkJzuJ9KEKJVjCAdLiuoP1JDF/UEJikMADJQCgBHPAAL3+gQF8UfJexliLJNW2npwggQMGmIkNFGAiH94xIZjy1D848oFTEO4fFf/RkT1AJrKfGOIejVOfRFgyP41sQor/GI4tjrE//uXijMa0SQBtYh1J4EQXHTDgQSbRAhdgpI6pXF4VENAFH5AnTHvsTgm2VQEu7OEqQDkQH5agnuKFhZIHKZtE9MWvPPEAXDbhgBESphEYkegUz7imxCRCABeMCCEEjUqLMISFBKykHrx63Mr00bhFJMQQZdRVFiQyyzIes6MHYcFMQKfMDExCFwU5CCL+kUCMBO4fH9FOBEPABXL6IA3hcQMPGFIOsagjboXUjAKUkMIS4GWSyzvI2dz5Tui1s2oGOQJcGiIRG6jgZ6M5hSCwoZ2Xlsgg2EvdP8qQ1X884B8CgIH/IfLBksXlpDhjDJZKbIJLj9LVJyK9ySQMgoiU/kMXk0DWB6ait448gw23u0AVuPlNhJTHDFxqGhvGx7tzasYGv0veXQCAL7UlhHmdVdudpDcTcSGkBQq4g0s1AjggAu6HCfnAA08Bl+AYRDb54McTbTKkfETRmPqwR0wgWtfhHqQWPxlBJ2wWA0SUtDqYGAMKtJopULmACzL9hxCOUB6ZaAUChg1HOGRqBnMShU5o0NYvisCEEUASF5zNxEFwYbZ/yNcg7i3BnaIqEZLg0yaTCew/sHHHVXLKpRH7BkZ+ljfw/WMQbvAHK2CAuH8sopc8gYVEI5eTt+qmGTM62a3+/yEbjhK3xHg9SAEmkdIC/AICKAhsHQUx0ArMQS1cMEIfbBKEPlKFNCogZHkRY4Rz8QC/9sIsvvTV43vty16gFcIIoHDPskqkPi9QwQNLJIgPWJM039AbNrDXwG1sWRBiuGMcNlGc3PLkZL39n8eymLjFUdHEdv4HKW4COkl4IhE28MbCGtaRU7igCu3UJobEQwaNREEtVajxN04hHtLe5JO8S8weeHAB/EJSbfK93VERkgnQ3qUcFZnJEV62X4aEFWjfkK5EOIIR7aVOxs/4G4myuIiYUHQRJL6zTKI4V2DT1WYzScY/MjAzg7yAmnjziKzx5gIaqyUsopXIoqsQgv9yhGAJBPXRYoSwljloFp5rieFmnYXZPJXAHUjw6aSAjBAOMOEDdxizDxOCDSAGdLBj1eog4mKQfvDCHrFw4v+KaRMgnSo3MwlFTih6EGfsJCFOIrZMDgAsYHQAGJMoAAJf0AQFo+gfLgjB6wyynih8SSZjcNY/uDBtAsj7nInRDzzr+48ZxHd57lWhCrmAhLclZDDwNogRSDS4BppougaJ2NNTC3VQyZis/7jBTjx2I4nPJIs9ebMU4/qPW56qtsaZJUVJtohhIOPizsD4yvJ6kM0hhGYdSKknHuCzjGBDNDIemkFeh4AK7LHwDhALF9jAkVMc/SeJeYES0C0TdK//m2xpsUHNG3IESlcKewxu3Uy6nBCCikFLKqqr/E72Vn2E0VecKGZufwP3gyQzWJcwiLFBVwBi/AK6qwQV001egRDczlnOumBCfFCFq4WgCyzdHXIUcwH5JnUmpAYtHjLAhRboNyH+YLVEjKACH2Kq6V1t+hOwujBis099+oD4j34yS4PYrx69rDP8Z/8/YvygEwU4aegQwwu0SNBcilUJgjrcTlhcABdUEHe1Exd4w+CICJIkkrjhxPU9mRGEAxpknoeoGkKclxicAsnJhOgdxJfZRAkGSzPkVsUhRE1cjuXon0yA1OV0XC1sTgoAww8sgFOAlVR8wDidh0E8AJVJ/wSNYVcVeESoJElicEACUBJe4IE28Me9UB8C/BwCHE8JGEGbdF8I4hBC1IcNdMET/Iz5DZrErJ9B5NsKBpRBzF+SXNLY5cSw9QSH3aHsyUQq0CByDBAaHUSe/QMwZMALvNjfyAQBeMN7gFdYPEAUWNBBiEcJPNrpCMIznMIRRh+mlUBV0At/jIMQXMDYbBZmjU0J7MxkXFu4yIQRCFpOANEJ9sTUMclr2IbiIMSvqQxH3aEfBkte2YxIlUIGGAEiplYd2Y0YGNY/7BQXIE2YRGNccEFf7IEDmNkpZFkh5VBiKACeYNKdnMMC8IDZZCFo4QJb1A4SMASl8Q7VbMaV3f9BGWjjTCxMKQ3XycSGiMXCLJEMLFyO2CGEM7TeLwLLSdWAQViCGx0EMSRCKYzDA5DgEPgQARQYAfwTqLGBP0CAeHBkEGyBWrjO3XBPS1Xg4ykBzOGLELiCDYwi2YwNKmaQUrCifejTP9RHESgLtN3E3syiRqThrxgCP2xCP/xDM8SgTOBK/gFLyggkDdaeRuQAT9yVTSTCJ/zZ4CidRkTFP/1DWBiBGXTBA4zBEYyBEchUX/yBjGVjO+oGYswFB5yNMcjkRAjB2dxX0ZQAHiyAWNjkfvllC6jDixmY1BXkP9RKLqgGTtSDI7zdP9SZMSGlYf5EInjOP1jmP1gCA0j/RCXYgBj8DRq2IZaERLVhl9FwwRyEAxcUzT98gzcIwhBQoJLwSWKUgDaQYgeVABpYgkuCljYsgBDUztwMTE9cWWBFxd/kG1EAJUAK127oAzLAiq91FC38IlRSZkKAziXcHkJ0ww+MwhFMJN/AIlahCArcARf8gTeIm2qqZsz9gwqkQVR8m19yYmJcgDGYywXwHA+8QHt42l0sgDnY5T9AQdf4BBqoA4loSfkxGE94XoO2Zk68gXHsiGzI4UwY3GPeROOAHZJc3GTeBHUwgrFpxGYaxO3dlQhcQnj6zTXJWAMpJwd8gwSlXMxVQRd4w5lBAQgqSfBMxu6s0J1YBjni/xcNIQECfEuAtKVMvAASmEZHCIKKwJgD8URWtRbfbMM9RlRPeF0z7GKwZNEwhahMdMIa/ENyaUSJJsQaac4/aMMRDKayXM+IgMZHoIBNmdw2rNIHSNA3/AwazMCpMUnwDA8HFIESGEROoUEJBMIW3oW5CAFEbOL0/ANVEVh5MoxPTGQ/dZVNVB0dXI7CJYTEOWfmHAMtgCiZSoQJeEIngIdE8KVEJMLmMIAW1ACLPgFHbIrgXEnVHYSy9EEFtMgQ/cMdHN5ocAAuuAKwBI8/CMFI4MWdIIE/XEDu3IkC2EA63WTj2YQRVITn0aNUIITnbURJzmKo3sFTnEhCvOBBKP/cb/0ErOASrNiDux4HhsqEYwKbDdLeT3jCGrSpQQisTdSAHIiAJsQpqIzGHU3F1FWAD2DDrNFaiVQAB0ADKIACNKxAfR6Hsy5BF3xfAlwAD6xLIcwAF5QAH9iAOyjBy3SrTMAIRvQT9xzEA3GqINSiTFwpG8oECRpEVGxKBZTMIsiGjWyobnBC+uBIR3EDQXrUdS5NThSQQcid5iTCCCykTJCDCJDDKhgBnS7YNmSZaKSB3XzAZ9xj+GzBCZiCBYiCB1gqoQaPD5QDYuxLtviDN5YAF4gbBlTfT7wABWoJmanWHYnZTGCpHSGEaaRBwEmEa0qEbBjCTszKNfxEjsT/4WEaxD+uKlAoA070a5Iw081sDnfOxCtsThHcWmoBDSwaxDfMI0I8ENNBgAW0Qtz+QwCcQI96rLNWwX8ggRAI6vdpmrpwgBKAn25kh0sNgegVmKeqVqfiRM+2mkxQFC/8gzPQQh/6xIworUVBZk+QjC9KhPh67k9IQidQ7YnyxDoggdkWEd4ETUeUJE64QBP4gALggEFsAA38ylsCiBGMDxr07bq05J1wQEsyKU4gAa+WSOFuhA/55E1gKQFy2T9gj2jghDTsa0+E2BJ5cJKcb3HcK/r2hEICA8HaRCVoAkLmgAOEqs1OTEVW8CmUq/gMqiigwgTwr48GD1n5A2qo/0tl2KUx+MMeGEHFON4/FMEDoMDdlAhG1DCwKudWqh8Ft+6WtaGsfdvuRGFCNIMJ3wRFOQIyUNRSJonWyQYycIIIn/DKVIIIaAEwPAABlCtC/KpocsdHOK+nQgAN4AAJUMIEmMIU/MMKqNoWeCBlALE//IddLEHv9IcNoMESMLBNLETS0WOx5vGWJq79akRUed7PlOuifZtuzKBGQOcY/4TJpEQuHgTbwbHoakQtI4cIyEFBmMb6VWT3EMBnJASCfcopiM//GoQHoEIWbAAdHgQY+gSAJEZDzIUCtMkLzIBuGoGUIUdZrZIQTbEp4YQG95NyTsUptI7SpQ6moNbpIf8ES6zdbNiEI0jDLvCSNJwvk5yxH/aCj5yDT6zp3BmECPyAJSCLC2hl4eYbdzDMls0nCvgAsxqEKPyDKhgENACBSXqNs27BumzLH3wf9MHsTDCEN5QB/dYRVv1NS6WgCeIE/YKe6myEluoWyJiqQTSDKmvECw5DvPrPrDytRz3CcRzAL1itTdCdRmjCP3zCP1RDJSgs6sws1Y1rQgxB6hDAAyhAK9iE9HQsTkjzpSWMP2zBFnAA5PkAVD2ySI/0CwRBE5zh61oPVmnVXFvx67buuD70CaoSHvMNQkznT3Ad5OgGmEoEN1AYm9HVLzxCDowDAOlGsdiMrGoEKUTCC5D/IGhEMENrRLFqyQn8Q0ZJBBA8gD0pLzSLNYA0RNeMUFwAyFrLxGTY8adcSbnCpqcEjhXPRNOZc/YoYh7nW2L3BFsdxHCf7+PkqysHdUJgQujkAAD6xElJgs2scGaerkyAgja8gqVuAx3I2OAQgFWXZ81y6sQYxEQeGNTp3EEUCRK0yDnUhT8H2ZykdmI8AENgyFhTak/ctwp0FdMpi3hSXfXOBIIJVMBBwTeIDzakHxwmRBbHCq1w3dbZBHLTkh1ijjIoQjYwN1FQbUJY909Egg1QUyrhTd9pCrCy6+jNxEQHgH6ZyguAoFcjTE3ah1zoE2roBviddB5LBb/lxAdE/+5WbgpcEABqDS0EvdZdJ0RTEoXItCCJOYOvBfdPzMqIERcicDiSrBGIH0REJ4QmuAIl/EMjRAL8kqD6eTcQ/qREoDJCbAAJpMM1nYI6MISplNdN0CaIYLIoGwQUmMaSm9KV8gRdS8QTYAM2mG2DS4SyMCew2PSPUNSoEsXbnXH9xDJPFCWSCLVBPEItJMVxrJEwjsII+EQjkDlFI0E3/1sRQXDoyYQo0MAGIAQ0QMEz/Ok2qEP57M6M79dXB09dvMBroxoy9riDX9MGf+qzSYQ3qMADfIo3PID3IMRoFCBCtM6iIQdPIwPItPKuDDdPKKZB0MLlapQx8VVC5ACoI/+EwKzMqVPCJ1jCEX4VQPVEwzTQLRzELJBAA/zD6XGElPVusBgBkPW6KOONKqkAb/vx4EzM9J6fbmvqVioMAeybSyGYSXdEJ8vEGLfeMPDCC1a4TQi2RFzDAOTDyRuE181EPvQSyXcUp/+DVP5KJfzDKFDCKKxCBjxAO6NOKOfEzxsEKkCDEfT1GT3zTzzQvZd4cmqK3rC0yfk1TlxJCgZ9yfXkSX+mRLxdTs+EbEhDCx7Hys9E1w+Xh8tEQZy9Qay7kpz6P2hCMJBDJQBWBl+T1X8qAVyGB8w6KujuBliD6uRbJ/mPjP/EFnRzpvbQgJFIof+sf7O5RKyO1Dd4oTP/+sJqRG457Uyc8ZCIvHFwAj2Xu05srk3bj0FwWDyvDBP8w+pjjiYodQuPgub4wBD4zVzvNoNdiZtLLEL4wET/w1DkuwDIV566lKP/igIjB4b8qSAMFvBlyphBv8J4ilZtKd6IQZ4i+YKJLd/8KmqZxgvsYerrdJA0Qx+Ke+bsBKSXDBzbxKkrNYrigw88RdAoC177ar1rBKYczKz/gwfcAkB4+McECp1/B/+dwvaPA0KHDyFGlIiQw5F//g5inCgRDRQCDukIOpVwI8SPBwmMVEly4sgHJT+mTPjtYYWSD0M549UMVkRINw/mkwjjID+Ii5D9y+cIaFOnEhUseDr1/+mlf6WOiHnyAeG2iFyfijoo8N+EEwddIExZhGpbfzYutkXo45SYrv8E5X3otWleQXj/bnRw02vhb9sCI0x7c1YAZLQk3vsXS25Taf+Q6Zt46J+jepWfKjh4FjRES1QvkXr1wsWHUyL/EpCNEOzGMlwhQARFCRWNBwYPfhiysHRTf0fQlOZgBsUzlP+GCBry/CQBricjphSZMPFDMbU3ev3omqVavP+alCxWLOm/yxCFll40cdM/Tv8W2dvoyGjxjQo6ceUgqfwD5rRERJiKlEpsSIMAvyQaCZvtHHqCr38ISCuTh7wwBZUj6PDmG5FoQmgL/yZ6a4sTQUvjlNm4uv/wIK8EmXBCmh5c6JsPYgLqtSFkLMkuoAgoA4W4IHoPxYju+0czh64Z4Jp/nLmJsyWnWkKb0koZcBSgQEHoFRscKOOg1yBSKTHwMMTwpE8eamSDf4y447WRVPhHtIMMwBIhjI7zobQtfOjiiYj4upOkkRx67UHzSloJxRdPCfKhYhDqCcsI/qlvo1AeGsAeR+JzaJFS/URoDT0FTDWRf169actESLkEiSAI2ObB7h4qUZASHfJqMascouQEDIzw5q8L1WEoVYiOaOgfFttC4wEULHXoMLwcfQgbsAgYciO9eAVN13P/YbOtJp89iJMq2wWqk4PMcYhA0Cr5Z4EFKun/8qbU/rkkg2vPFOTBcBGC7UFGI8IGhZceEmWKVhR4oIm/MP4HCbiobdei4iyaELvs0lTYrsR6hKg2dScSieWIGL7pp4jqYbe4+Q6aj6lF4I03ol48yWFViO4F7d5STpuoXn1TsMQSH5pwYcTp0nUIm21KzHbGf9KAaAIPbvENr0MJeEKMZn0+qIUXivMHDRfL3bpGiH59KLrobKTq5fPiVrmkZp7Mx5AlcZaIFcz+GeafZkBNG7SkHcqgpHynokQbyhe4VtKMLxSvTYi2ifkfVA4iAZVbbLFIRuAcR8OIf5ID7TgVRlZrpZO6QxMlla5ziOq+/xmsdoSi0x1LTzcq/3wiSPpDSLKDsEDIHqTco8xxhJRB0QSI/G3KqkoosbcSH1BIyyuuFks44W0Ko+0fxFg/iAZb/hGgGCNcOPQfMRYi4By2fYYGAEqrMhy4VpDSxyMMuSAwywLW+86TvvTd5EgfoImuHPIBvWxkNk/RB1OeNY6IDONJTnoICBGivMrowj85KAnkSlIvUFDOIUeQTQf/8cDgFC9lE3rRRmhhCxgoAIMYwoZsFPCxdvnjLbADIGge8A2qcZBbGEOTuNzkphum6VEP+YjBaLQ/oPxFXHsL1T8G8JD5bMIQNmvLEw+SRnc5IxbOWEQJU7U9T1wPKKQ4iCb+0ap/jEkQPMoWuP+kw6tfee4OEJGFLW5xDRoEITrq+wdcYuen5GAkOXApDRrUkTJEvW9bB8kLV9jUvkNqDSE7qlpTUHkn4KlRM83zUwckkpRm8CJtJtheRBIhlaJB5JcPoSFVtPEKV3TjIUhoTZHUIh3tnCkh2AkdAba1jfjRIhSsgAwvAHHA65ziCQqwARo04rGGvCCdlbEIDrNThmmmhCa8Es9saseXcmEsV3xrWZsMNsv9tKsFELFeZ/RjH1Slao8Tkdy8JIKIiByzKpq4RCVGcJBSiGAVRsjLYYiDkP5tY0J64UtJM4gQ+s3iGPRDhixywxUw5rAImfSTRtZ2EJtSBVBp8UZI9wL/wSChEiI4GqoOH4ICmRLJL1l75VPcyEfETSZtDY2I5EoyCRSRAhRfgkgkkOCNHMUtJjz8nPH+8YT0OEQAj4QBFn5TsLR+pKA+q8g/lAiaLTzgiHsTpUOw6EV4nsduixGrQEVKB6IOzykz8w8adjqRJxniM1I9CEQVUEynACMGVAGkRFaBhEbmRXRCxUsl9Tk396WBLf+gX/3olAbFVjI4/4DjszTig7rKznUXHOUhnyrSrWUQqVksz1fSZR1/tk8igX0IUeQSWQI6JBsIkcZBMYNCqQ5zIp6AKC5BA8gwPeQSAFBA10TSP4fMJnQiSZno9NeCa9ziIMeghRzt5LJT/3zjUK/zmUY4AJd2UiWyE5FJwrpYu5HA8yOnUJeZDIyXmBiMPBj6oXmqY0mf9akkyFhEZS0LEYhCFCGaPYgIERIrFJV3HA6CIKS25o27ZEsMNOnDP2YhgIPs4h9Q49VH1OFfiXSsMj7YwoBBk8nhnS8iGBMDYgCbLr4U0Yz/gELBnAouvGj5LxqUsCqrXK2GHAC8SEIIJv4Bjn8UACKDCzFQhsZHTQCACRA4EnYeGBPi/PUuf4HYQXyhJ4uBZyRP+IYCOACoP02L0Z8UFJYggB3swCao//Bhdpallh4+5A5HamUlPxLG4amEpFjLYkwO42DDOYRTTvGkQ4DxZv9Mwv8EkvhlJ1zYFmKV5BOXyAQSHDRLGS051DXW4AtmIABpHO7RXyFAkNOWzumWxkFbvMnCvIJF/QUrJgo8SEgrdRAXW3pG1jlYsE4riENN6H39ZOWgEPIIRSBEC/8gRqwxAUNZOyXOD8n1UwRZEk1ggAk7UKr7Qi1GMVCKJMVbVEwO1QJj7EIWA0BGQ14mBhWooyFEJhSKNPJq/+z3c9nBJk1uE2FTAhTCN3wvFAjYO1M+ShDyLDmGZD6dbjH2sQ75wb791G/P+nEjgNREJlpcWlJeSMbt/ahhsIa+fzwACTwYxluhNsGuLEQFIn9I7IjcFg4IGUWDeLFEQj0SFGBH6UT/9ZtrLgThIzGKyRqc6VDPE8ZTWidmYS6JAQqMkFi3FuhPYcQaJCEJRlTmFYH8hylK0ohM1PngNxknbHxYowvZpaDFuAL+0G7KkXxswGQvThGQ3CKloiCw2xiCC04Rezc4JW66EkN1Foa2kLQpjCsXpcHscj6dbyQKS3qEJApfnMVf9g+VcQWcENKIjWAACWRAWCHbTSP9tS9jmU6MGNIHg2u8wCC1v9EpOB4RG9y2NHktDgfKcIrRZpBl+wXqP4JwkCGgEqlErVulbCI42gc83G0vuohvcsRPTiTwqOIzEkqFHAeiVAw0QEEboA8iIO8fPkEVTCETRKOSsKGSYOOH/8hDdFYiZv7CG15HxwiryT4iT/6hrtIpORiQKpig2fzjASqAUSQNIWTsFJpAXQig/FCiSG7v5mbOYGrLJDDM2g7iGyjlV4CquBatLcKusZJvI5DvKSbwH5bhAiOiEUyBElThH2agCB5AdygEa5gMQppK77DvudwH7cCiRBLtIV7A6yqjBivjJT4gSP7MBb5FuYjnV7qj24LLffzOfLIvqNgHWMyIw+RCElEExPYtEbbwKbzqHzRhSzThE6RvIlQBB/4BF5jgCOZu5bYD3EKHO9RETSDMIUQjfjaCnP6hWXYLIYoAB7MQdBxgCLCodlLGNdaw3WTjCF3w9t7NPHQFnv/aBwqXsRcdgjOiShohIhFixasIBE5W4SCWQQPjZAz/gRJmAAke4EE+wELOQ+bSagnP7iC2LTgC0NNaSSIwhg4IIIl2CglSjyrGTg+VAwqg0Owe4gmWJVdGhqicCx79BkN0xe/Q7SCcKzeAghLjBQjYDCJyIYWcZCms0SGEASIa7x9I4QJVoRFEoRHK0CFMQfo+wRQwIIkqIDAUBQlVTiK6pgXRqlEksmqKIBdbgB+vkCqQ4Aj6sVpuMSKrSJ4GSxUbpu80DSHoESZs8iMdAhGAYN44pdUWwWb0AXms8iAYgBFGoBJewV9GoRIAiRmWIRQ5MU7EkBJwAQ2N54Gg7Cb/FCtbbKKQGsgpnEMdzqmG/OMFjqCmsIRFGGUrUOIgiyilXkYQxMAvKAQJp3Iht2Yb0hHoYu0hOqsDwIvNOkAXWusrHcIjT6UaqWL5NoK7VlMkHeIbR0EVXhIcJ+AgwucfVEEUJgAXirICwIJRFAhNKuxzaiMmKGVIsMkbfKAC7onnHKIMrOgUkOCJbMAihhIojswIXuco2wIKdhDvCK2aWkZSVi4eT40WJYImPqACmmAw3kwC/oEs1mwiOgsiNmGyvnIAUPN5mkLoHuJeunAqvvEfggEcD2IlTQEHIE8sKCED/8EYTtEJg2WkTMsdL4TCHHIkxKruSqIwNKifbKAh//yhCNiPKnzABoxARIsDDWTsHR2xES2T3QQhGu8CxsLyHyTqHywAGDYzIs7APvtDH6YnofxDNSUioxYgQEviS4hlJCcCBBAiALIAFUjxHzbgGkQjAAWrCL3In3pEpvTsFLzhJXDnn1AiFnNo4wRsFyvDH07kBbRT0bizJBaCd9B0vc7kwFpCJCxzvZqyIvlkI+aUKligNDbSSRbBlhDCEcDSGndtI9wSBybAFALgHwLAAxrACI6gUphMLfjiDxXRlDKt1MQoIbyhCSBARBCiT00V3NJREBrpHDgADZCADzfiRBqiVjMCNCbtH5zDfdQxh/jiUD7AuZ7uIV4mV0gKIv9hxzhSRZC06iDKbCIOACGaAYT0wxL/YVSehQFAgxluAgz/ARoOohX+gQRu8QhmMmHUxIfqaX9eZDoYLBFfRBCW0wXktUYmk+HOxC5qrE0GgwmwZOwG9dqcczIhZSdFr7aIUekICyGNo2AZ6iE6AM2AoCQU4ecQQijw6EocQlGLI6OeQgxXciPE9UDNtRV08x8awBxFpztKiWEMErm8JTDSIAhsQgS3pW4qDSU+4GfNBAb9wx9awCJsVSKeoWxahrZm7LQiIjpGhmctz2F70QaAgb4QIkchIiOzViLw6CA2gR9arV1YUyJOlipwIABwYANmoFkc5YvkCpuwozgfIqD/7uKGYu8cf2Q7gLFUn5Alau4OzO0fgANtZOcijOA6SwJlWvAhdvIkYg9PuYUlSnBcZGMqb3QiptUhCqA+/+EX/mHeSmITNsGxXOVVYuXfsAQlL8AUFvSSqs0hEqjdnmJU/4Jq0sDFrAmCHKgwugORpmw6DxevOOkperVTq6aRLvMfJigxZtRF0aIk3K44LNKyykwHJoIYLGsTHyKYVIwUwkcTKKERPgtFOLAVcMEG8s95mwwohIM7ElYQKgACLoxbOmg8qHKH9ORjFLck1s8paAdDGNc5e+RgeYf24qV6HUKBqUIRFCFBIoLNMpJrD6LesjCYRNYhPmEZylcucGBA/8dxAkTBGBqEOIML4oCCucRIRtLAYtCNDc+uT5nMLhbC/aaCw9ymJCxiRAY370KvAMOjcW9C1Ww0cw+ChSIYInSBgj+SoqAPFDt4I2qzJEwBStFXfR9WCZ+jCA8wIr4hJdIuOL7oFNKQX3twi7c4NqgJL1DgLwASO3XqJbizAvpHXAq45JQQn4b4wLIFEdsEO7TUiB8CE7C3JJC4Mhp1Iwq0KZZgIj5BG5iBosLwJhoPTi5gBl4gDRPjNbDP7grwA77lt/DiG5pOqE4hCERkg3xYlYQ4OEoqoBTCtkrj0ZCsIwbhGbqDLxJul4eLRnZF9BbyZQ7j3AR5I8ALl4jgIP80Nm08NlU20SpAuDIuEEoRAr0ktzt8CG5DbxV1+Uy8IQ2OBEjmsE6xIxhFj3HL6Y1vQiOGMk+OKMKGR49hBrHq9kxeRmInok8Y2HFSA4KfIgVgwHQhAmRTpRK0YRSCoRSCgXtLQhVK9iHK8BOo2W+6LLnA6Go6lHffR39kYxvKYEwdIjFUeBv0Z58o7S++YUTMSXaQLABDDWUwc1TbhB0TDjpMaYomQnQlLGOEC7fSBpcE9iAkigmIjs0O+SZuIBf6Y2wr4wKKY5GXpARKeG8qGiHudGRqZzIf5Bsosn7TM9W0YyT27za+4QgJ4A4KIQ896WghIpM6BjjQpFMxms//7vgfYvE1Us45/8EMtKWV+MKGb4JF/MEi9TlQJQKfIWIFkvkfNpMUOniZ2yWRH6IcDsId0iYSHvqhoa8bDwIK9mZ63dGSVFhYR0QvGslMeLrSTsIwYAwh76AJ/CEOiEKdUQSVYmK0FQOketKvlRWnuxZH32fhVIZqN8IA+gSxnwUIdOBi/UhrN+IRDgK6H4Kpi4Oy/2FLLCsS/mEVViESOLsUucZvIeJkJuL2tg2rt0NRRsKlSQaeROJCPsBMZsMB3sAHaCABOMMGaLs0yKjdXIPPRC9bRqRFmxVJjnojsEFo/2GfrXKxVwAhTKFHgQIR0AwoBBo/bqJksVuqxOFA/+HkG6XPk06CEXuSWUPan6IzhyrFOvRiZWjjYGhEOIPnfR7mCJQAVG5gGOD0IPo3o+t2elm7qGZJS5HsoW8Chxn8IRA7yRGCySGiA0ihVY4ZIZhYopy7JAia167HQYECA/7hHK5sd8RzmgQqtZfqxNeTfj165fonJm5oRGzumuhu//LRH2AgFPIBBvSDFQizNP47h5D1i83DuYCzbgM5IlZAPsclIoiMgSmxYJ3cyf8hBQr5IPwombmWglmoACC7KSRbGs1VItyyAUoAr7gia7IJgqoMtKvGxVHaBzy6MBxFlzGon5a3fcoGtrEgAQ5HGnjsH7DgBXLxJg79xahwef+dAgWKDyKY6AV0gAZAQ9IX3FmL42IfIgUigjSA+x9oqD6Z+Cbq49MLj1w3JCJwgWURwsuBZRXVmLwXXVzIe9uOsAJ8QNAFeAhczuViY64rZSTyYhua4AiQ4ApYgRekgVSI4gpoIERLog8ezTxra4Z35CTYUSJAGQXeQGtipyFOAF3HcZ2BYsCQO1Wm4CCsXfAu4cH/oQM2UxUyUqLKbHOLOSIuABe4/CGuYUo48RqMwJWGM12+uFxkw9CGKyKe4GpGBKUgAPZasd0CCogLUtMIoAsw4nD+gceINAFsiw/93alMCemZizhIardR5DZlTVpmFYepHVclIgsQIqix1wL/DgLbH+LnfiBHOwARdEDf5jPE3L4tqvQgyn0iUEEVwgcJruwJZAMF0e5+j13/Gu4jfECs0Kom9RpDymAb3gANsAAGemZjPQXap73xbZThyPOPb0LrmgKQSCci0IGeD7My5P4hsBayVZ4+nQKXKvwgbsBnVPbmb6IVWtcpyHUGfJXCHgiUJeIhr8bW0/O9BfgbJl/+RuR9EONRcDvKXKAJHoBt8iEfvvYgmCIBkABalv99wKJ3TYp2qdDsJKDHN2L3IQLi4yWdmNwigUDREWL2I4L/r/zAAeKfwA4CCxosyO+gwoUMF84qGGlhRIa4VP2D1rDgtRLQGqiD8u+DQQLb//4JKnjypEiRGQUS+LftlMCUKnz8O/VSJswhL122/PYBBZo4CfJFWBhBH6d8N2y8+Ofvn5GT/3oKjPlSkE6tN7VqLflvCNWqCqNCbYmWIZp/BtK6bbnWLYct/+JaWOiBSIqCezPq+BdjYYG3hN9S+hfA1Cptby/g+meMcIB/SLwt7KlVpCBs/755fTmk5amSKQXaDAlzYcnVDF3QScMhQb1/+Rw1DNXvxr8WLwQR0GkQbEhspUl/K3hqLMhBTQoLbPuPw1uC0aE7v15YukBS2AVeGty9IKbwaIthZHjhoCmDu5zP+PcsNUPfXEPKBM4wtMDQ94/74FzGcQq9RCBZB/+dckcT/hCVywCGuAUSNigwlJVA93W11X0ilYHCS9oVZB15CvXVkAEhioiiW8DcdZAlgKUI40HnETbjQsUIdGNkkBlDCwz/pGFZQ4KIYRJVpHF2EJFXkQQTZ0MSIAgEBGDzwTfbgMVSaqwJ9MFLT/iWBhoJhJLPbPYYlI8hCRV02h1QmmRVSQSsRBw2YGHjTXJFntKhcHLVFVdaJzhnVngSPGVQoGi1gN0K/7D4D4mBiVCQLhlZehA4iGCnY3qEWZRRA/8MgNY11/xzjY43rZRccmW49CZLYg2xzZwEvFQlrgSIAaWeXglymkkm3beZQLwqOdIQHzThgwI31DPANQ//tlQBalzCadVN115GJBv/RHGicx+GF26MBa1VLlokOAfKdgV58E8NkDJUAHjm3lvqQrRQ9gyyMxX0wSlZziQWTExeZbAgggjHmgsLUQWlfpdV9cQ3/sBw6j+b5CMicYT1gW94hYbMFskKrbDuBn3BexCmlQpk772gmpwRNQLBgMWBWr0kxin+WrjqrQURSaBVnr103ClpCPRqr10JJOBBu/5zRxloKJDAg0dNuxAdb+EnYroM4XOdojQXtAVdbyGalqP/CPDPX6gMKgJ3acV8NozGqOpWzgXNqRBLKxV5Uq0My4d4li6kAZw3SApiZUZ20vGAP9fAoI+PAiWAVmkp/11ZoLlijwhE3qYLxOh1NPzDREGtt0RdQXif3h00uNz4T44LnYrxNQ+kdmvwZCFLpFcyPd7q0xmFFiQKOumZ0TcEeG3DFbMVJhaxepKWLYxqp8VKyCPTLtB6Bnlh0A8K2VJQjWid4TL52D0WGd9uzYDEB35mufC1mhXcmW0oDEktyQlDBtYQr3FgAP0gFVrwI4iCbWYsJnmC6N5yiH8QAV+je4vZ0KKdAxiERDBTyBQOEjv5mSwT7cOFCwUCjVO1RyE+2sUxEoCFBxysS7Di0pt6FhY53YczWZGYQnQSrMIUQmNX+McwWhKwLAVMW/dhzYSWhpZwpauDBUGfycYnLv+0BCp1jEqFQQYlEB2k4C8HOaFAiECdeRkEGC87gwrnBw2MzMgYD3mMQm50ox4J5A5f4oyVrLSw0TRpNRb8Eky28QE6dMlNH6Bgd8zwoWb1g00TYsgpHBC14FyrOd8iV3hSGDItGuB75EEDB8wWv4MwgY0HgdsdVYiLPO4OLTiDQBMchhwMbaYnxMFP0VhSBqFlxB3OSQMWOTAGXJEnCFEgH7okUJDYLYJ2rCRPFNYyLpNV45YxyqUuC2MMawjhH08YnJ1Moh+qeCaUhZvTByZpLXN9owzVMsjP7sjFFqDrj2g8HRfJidCEGiRjBZmhQPb1N2zIRHrAwUlVTvGNbyj/qUDKgtKrXkWybQyCAEP45y25uJbv2dEgIDFdN69jgA+G8zqUaklBFUrOy5mlkgUBiwAVpqWDXIl8owFqQyx4OjBmxAAzLQg2z6ZUwkQlqjitqrmkARlkMFQgDgtdk3ZmIJfUCoFnq09G4nOdl5rMHFZVKDHaiiL3ocUYpmrAVg0iDawag31GqEpprFKhwvVUqAwJh3PCYdiGkAauhZFj3j7YEg68kiFU7U5g0LI6xi7Ej2jpgkDoEbUsMEQaNvvHvkKAht9t6y1BAOYRFCKOf7DNLYnVLIzUYVuDVLMFo0udQk7gxX/cNLdv+URBsLrOg9wVX74lrnOfS56ZQvYf/6UTiGMX4jbMQne73O2uZqHTlqYq5LreLa95z4tehmzqH+tyC8vSC9/4yje3d7HlfO+L3/yaK1DTzexBhmsQ/6KFvLc0n37Le9DycqC5DZmuQcQrkPCF4my0VKiBl3uva2D1wBxu63RRWRi/uYWEHVYIL0octpKVeLZvYZSDyTOF8L2FwORMrslEheIcn5Rk9iXP7HR8nTEAechwOQuRj4xkIr9YIT1Osunc4GTCqJW7EOZAggsC4X/cwr8AdjJnowxmk105LSQOs0DsVxAcMAQQC1miQYqRjoKYoSF3GMlBGNySF6i2IHhO0Zhx+uci1yXQKLLvhM0skK3aD8M4Wv9IAGYRAGigAtJ0PggZrtOCJXSnsgohNDk97RZQIxpFaF7ILjCMjIbMIs5xzkj3nFMOqfwjGKMWL6fpda6CgFHUb1lXk0uM4/KkaiGlPrOqsXoN9jFEDKQMD5R53RKBQnfJGVHEQi5rOogmetT5MhWjr6MjhzKEgOGZMs2ovRBzo6hc0O40t89m15aoSq5peYhCaJHqjMTBBv8ggxH/UQLGqi3LLWl3qDedYoY0tRkCiUNhtgnm5QbbLe0QiDSiURBjyEIhsBAIxwQyDAX0dar/mAMXGLsWdFc1GwYRQHvJSXCG/JrbwW5AwBfaEnozZNX/0EdBeHEqLCDhHwLm7q0o5Qcpsono6GmRzofeyxAav/uWHxfIOZwbc7j2GS3qJs9rC9Ljdm03IAAh+QQFDQD/ACxrAAAAZwHyAAAI/wD/CRxIsKDBgwgTGjShsKHDhw5HVPonbsG4Fxy2HPGxBY0PNANBSjj3z5XARBBTqky4YaVCUi5jypxJs6bNmy57/at1sMC/RzhluiKlDQjGf0gUIDGi0N8LGwsWDMwQFKetdgJrECOG0CTCiVXDih1LtixBRQJ5mrWpbZzAcUyKtPjH4R9IkAnd/uukQOCkPwoZrf1nQVMjTQKBHUQ5cNTgx5AjSz6ozNNkg50SjkhkDogNGyfi/rMh0MfALQk52GBC0NLlg6X+xU4o9RKo17hz665pedJYSQ8tCywAeGAlSzaKqPL8b25Bf31QJ3xR5B/VDJkFus7+z5Lr7WYZJP8q1ffrP7C706tfH1ktxEmdXMMkdam6uSI2/AmUvvKFXkadSLXXdwr5Nolre90kIHsMNuhgZDkIhAlBiCCk2AKsMUFKJUy88MJNHIyTSIDcCVRiQRFO4ltV5lzy4IswxmhThRUOpIhPCiXyHRAt6LcFfxCZVtALVP2zQGZILoDgQQeuKOOTUEa5Vi+IsACUQEApk5BvUpGigFw0cWRQC9lZ0smZ1g3kHUIn4maSNlLGKSdZiFxZIVpA1WgQJnqSQgoSdQmUkUtO/aOfQGj4A9UC5VnC2E2CrUUJnHDOaemlM2WDSTaP9PLIARQi9AhV9BVx6BE2cHTofj44h5A/Hhn/5M85TDC6ppEFRooQcAmaZUGl5mAq7LAroXWQewIp4tsllgBRFwdAsGboQT4YEeg/QBr6Al7T/vOCApaIIBWCtyakK2EFCQbgo/8YUxUl6BEr75zCNcRCQzVmw5NUzja3QjlHdHuQfg9MC6Q/Plw7kGrf9ZXdgoIBt6BBpCwpEEMFtdKIQK4sUZMrwc4rcmS/2DiTni4J50mR/yjACBCG2kDDP0xwm5A/HDC16sIfPmeEBf+ghKAIap67UnkGgeJKJTDNhNjIUMMoXC003pRBBpYoaQNI2jDds0MgFbxzc0IS5M8RSIzjilQoFYmSkzPZ8o8HHMPk1Ux3R603ZPcq/wQcIr3oYtmVMxUAN48vfEJK3g+90ELABkE+kAH+IIEGGqqpPcISWRuZddMWTAzROxvMXNAlTbukjSkCUbr367spYuyMBZEGNCkdpgSSDy+UPZCrCzNVUBFMXMKEayPgOhMlLXvlleguPV0p7NTjJAFEhM/EkOD/VLjAKK4RQYo50rr0whE2oyG5QIp+TRB1TGTgokAugmUxROak/g/RQK/kAevVCyBOSFOsR2hJIPVyyOwIkgjF/KMIrgBaEQKVLYPwBzVHABIHFGYXklhQUMmRykRSYAkLWOJu8SIIKF7hosUpgHGkmJj+CNIIAApEFKb4BOuYJ8AemkUR4HgEOP/AsQZwJPAg2QvVP87FBHO4okNjewhehMc+1HREIEh4SLWIF0GB5G9plWCcQDbWEJiABXX/eFpNmCGQZfjwjUEBxwFpoiRLqK0zA4likPgDK3/8yFvAa0gLnmIBkJVEIONLCNG6AxExDsSGN4SjJMvyiAolcSWY0BUJBLIEBbTAZjIJlD8OZRo0CK+Cp+kWzuAnCo6BZWkH6Z/dCMI/wjgyIapwSCXIOMlePmSO/1igArsnEEQ4aZM061FY9OMDHyjqLnYRGEJAMkoOvOBXhzykI/VHn4HM75YIEUUrfUlOm1SSQsJMiCLyJJxETMRu+Ynmq5wiJofoxx8Fe8pzIML/rS2QBgf/MAUlLCCAARCkfIi0yQQguRJwltOXaOnbSkywAFIADTn6Wd9BLtdMI7QgW6s6FEYq9wIjGOEIKE2JjwgyFybQQG4Uq8o4x/nQmq4EEcpQxiMu6RJ3/uM+hZKmQnL2GQ7oxwDYUmV1BtIXBZgUCVu7WVIFotEXQAMhQOvfTRbqkka40aZSSidOejE7ibokA+zSp0rQ0AKmFGFbQj0HSLL4hCd84An/2IYPUKrRV/XOIFj4Rysm0LTFzdAmAVDI9MDK2GACJRvBxElJG3JFeSJBBf9QARLOYYNAHsFD6miCQMRAAGyc4hQoMFSiKGsXI3DrUCdohSvRFZlG/9C0sYzF04QcgrGhUvFV7AvYEdRBADF84B+CUAdJyoYGG3xoCHcgwD8I8AFBfEMQgjgF+lKpEFO+1jj/cJFDU3LbmvAQt5PkqUJ0YZAOCEQdD8GZEc53ioFItwwfqO/XjGADI6jguE/4BgGkO93U/qNgECmUOg4WUCY0AmjjPQjzeChO9FrYZAp5hFgHstti/gNuHViRHp+jEYRV4BTHza9ABvwNFUCOJCqAr0C2MRBB/IMO1EUBBFRqBAWo4AEfQRQTTKFVlUzEFbe5MBxB5RKU0W5/AqFKEcYRSOCerQIDPoV0CSAIAuCXADTOoo+fgY1/oPgUNs7rgM1MgDcICf+VBTGlQFAABSBPCxom2VgK/5Fkg+yyyEqe14hpUgug1Eu9BSEcyyRRABx9eCdr/YcPKvCND1BXINddsUC8MYhv/MMb2IACAaJr6X9UN6/Tre4p4HwQDqhjG10eAgG+kTAmIOACn/iHn5QHTq/Q7SBZAOg/EhtosNZCETohCOGS/Q+GCAg4mbGEi0bEE2pSFmFmpvF0tfwE6Wp5wOAO94CPa5BSE+AU2o7vEVQgCFmXtgLPgMARFOAOHDSiGuE1o0I8cJvyFntYTA5LLyKEQGBiSSDMFgi4ChIMgmDiQ6xGlKEEYeAap1nTyAWzxmdMALwahMbUZYPvGuKDy1p61l3/RoF2fWCDF1ZiGa64xMbUmFALWIAG5z2IsP+x839HqWQ38YQZHMJT1izARSKwxCoycK26HGHQIXlABcidcelSfbpimO6aQb5mTNv3vl32dIJb/oQuf3sbnj7tA45gBCRUAgegmEDOCeIBGqDC3wJpSVBo7nMH/bYgmPBEDkyArILQyDImkIQlgLEmS4yiODjLQTj+sQdQUsvUBCGwQFKseUx/oNIat7QLTE1dMH/DG8WtwMhvxgEklKHsYrDxuGHNZrsgQBWU+LX+bkMJSuA9oAHIAs/7LqUON6RIaAE6QUyACPf+g+AHSbYkBr8G7TBmAV99QRf+UQIElKMEHDQI/xoEXJCsj3bLBCEtAdJwikyfO7s27jL8UfzuiOdRUVMXiJYvrnEXVAAKH+IBdHM3iOF7uTczeNdzBDELQaGAxAcZQPcIHrQQnjAJtbAiTlYQk4AOS3QSA0EaSMAFQhAO5fAP4cAFBPQqTHF107V5mEdaA2FXArZjqIZ2goANlvYBH7AN5JZfp+ANCeYD6qByWvYPZfAPT0B/pfYGHGAOFwAKITMQNOABvfcP/vZrwocTr/CAQRF+yTcJwAB0G5YsClGBObAihbcQa2ACjIASKLEMTOAPSyAEA1EOXPAPQuAGCgEF//AN9XVx8Vdj6FcGpiUIFeANk/Z5W3ZcsucCXv+Wg6hFgw0xSn2RBg8AATsWBN4AcjdGB/8ABUVgDB6wMRRmCr9WYeE0AVlYELZAbCrxe1y4EuE3EE4iOzRxRLzFEFkzAgvgDwsQCOEweVVgguEQAsVxEBsBBXewYuR2aSkmEIJgaTa2DQTgDRXQB2mAAkBIYNR4B0mAAi5gXCr2g/bHPkaAYP/QB/8AAVvgAxDwbToIBTaAAJRgDn32ijSwAVmACgSBBbOAAzD1EI0QhbFoFiXzC8qXMjEhCZmRCOXAAQogBFUwkcIYAkLQA9cjfvr3h8XVeRdnENmVXx9QAZ8Gb+9HfncQCG8QXSu2DRWAjk3BdjtAEH2wA33QB6b/IQg4hg1FMAMn8A9TiEsHcQv/wI8MeBAMKAAFmR6zowgZuBaToHiuYQNu0ANd4AbboA53GAj/wAYFUReoIXanUGalZ24saF1ah2IoYF3ZtQVAiF1DAGvR+A9q8AZUN2tdUI6wYgN9IIkCoY6xUlxDcAQXgAPM00q/1nOLlXf/oHcFAVDB9w+ruJS5oRaK4HzElJBkER9t9AIq8A3fAI4kiQBIMBdI5Q/hMAdccIf1dVcGgX7ot21sVgYE0AT+gAICVgbPIGlvgG7o9g9DgHlJYH6zBmaTaChUpI7/YJPs4wAo1gQ+wATQIE4CkAVESZQCMQGioAr+xjqtwFBFKRA4/yALlLkbOQBZO7FbBjQQE+gQKYKLbOIb2fEA0uUNLvAGZvACxcEBEnA9wShpyHUQZVmWA0GNO9hm/sB+04gCBbMF2/AG2xChgrANdwAGavAPy1hfKMAGfSUrRxAEO+aX7FMw2TVvuDAzpiOZA4EFAOWAAfWiBjEBqlOeY1ELCIkIE1ILBeAJPAF9BuGjA9EJngAgCORhBsEIBGIDUicQfPgPSuBBcyAEdDgH/zAHptF5A6F+Hal1K+YNp9AEb+APEAChbFab0jFpTXBihYACYJAEXLmMlvgAqzYwebQFRqCcf7kfklYB/vcCWQANLXELgUUCOBBY/yCoqIADkIQDif8aAI2QS2SBncPXd7O4EieiJZigDLODCekUIQlnAp5gAu5FFSbgG2toEApQJPcRMD/2DyEgEFWgmsVYBcEYDlUQMBM6ly44XdQYjXZlaSjwBgTwAH5EptjQq00AAaO0H0EAZM5UBnSQBEGQR1pmec+xPsqpnFtAAEOgDi9gDDiAAxNAA7JgC7awAfwomXfnAZM5EDu3mKLgogpRjwVhqO1KoylhfJyqEL3gCTqBMcAhGKAqCSPAAJ2QCGtwJoyQDAYxLqWAIGnAFHdoggNxASIoEIEgJGi2YuJWnGZWAWxAAC6wBaM0ptqWXwP2kiowSizLshwQAf3QD2IDAQ7wAU3/0KQ3YwOSCAEzGR3YclqgOAMkcJQCUQyNWRC/5q4GAamHeqj3ihB9lntJ+w9Kaagqiq8OoRZpiBCC9w8HJBy+Aao50AmgwgGTkAhseDUFkQgCYhIvMKxcMHkCMQdUWqUhYAaPcwQPEJztNhDY0G4fgA0ugAIVQGkPQLKj1AdBoIk4xnHWiAb3cAU3EAERcA8yEAE3cAUJIANXMEqLGwR0QHHTejNtZUGoAQEfgAI+wKJEma4CYQqu6xJ3dwtTKxNyIzdKibUywSsJIXiIwBAJlBk5MLZ1YQDKIBVcsW//kABIAIRcYIdCUAJmwBTKYFR5lAb/8Az1FboDJrioZbjO/zRKWwABQUAG65iN0pV1A1YGaVAIhxAH9SADFJAANzC58utMd0C+G3lgClG6BeGzD4ANKHAExlAMwheQN3ELWfA/sZsSwoedT6u71qo946AWeiGfnfAhBiAB4sAII9AJTlJ9BrEBPrkF2ABfIYAEH1IFJcA+ddECHEAAuwm4HTehWEasLSsQdzC6IBoEZXCyf/sNQ2AERaAHdgAERXADm8u5hXAoTQAGLnCspfUPY5AQLUAaNHiTW0A5gwuAMIADVzUQ5WO1AvlIWfC0fKcQAoAFqPC0Eay7OHGM//AHf+BH/jAG5/AUCzACjOAbvDsQ0UALMHACW2CI6uADOUCldf9rF9gWBctoZli3DYQbviT7AJeIVH1ABiD6D2QAAWXwkfV1Cg/gCysABL6gB6SRAFfQxD3MB3Qww0JsY2mASk9RMFmsjj4gCKIlAMUwC2E8C79mtA4howjRwCqRa+EJxw1RqUHRCeHQAgZAOX8gkZnxAmwLpDsnDzNTCEAmt1SKBm4wjFWgACX4t8g1BNX1BjgMKz4QBJTjAyogHeQbBFEAAYPbgtfFZWE6SnrACiSABhHwAOr4AEHwBknwBmQwoR8wetOVBiIaM+5TEM/QAoHljwMhzDOBQ6YQLK3ES/ODzBAxM+3aSrBYbMy8Egk3EJ6wJGZSBRFyTyGgmlzAA0j/4A/ZEAks0zSyZQxZhL1cWaUKMM3ct5oIMAduAIRCDGYk6UdbMAZ3UMkRQAFXQAEaABLjm78OMGDZ1Yfcig2qRxcZ4QMGEAXTCgFNcKFv4AICBm6n4AIPkAZQAEof0gdRYEUHRpJx/Q/XIACumAWygArk6RCuaIWPBBt0swGUANIqYcy4ddIhERSFBn2TwBC+UR4LwHRm8AdmYAMSMIJ3WALhoBoIAGitQ5U+MIzEeILiUA5yy8I+4A3dRgcuibh94ADK6g+Z+w8yoAEy8A8UIDb/YNtZRhDUSACqBwFkcAd3QKF/S11v8A9J8MjYZWqnBaDOgRdIYBqoYQBooGXY/2AaL0ADxoDAgKASWaDYA8G0BAE0M5PGEEG0XOjYKxEhnoCLZmIJjFBHdLEMPcB9QrAASzAHrP0PXFACL3CwwDBD5mADaEPgAlGCdzixAsEFL/AAKKDUztTOZRAdRkADNlAPVyAQV4C5QuIPZJ26mpdd78egabANonWE2PUNUqAGSSB7pnVu3pBdFdCOG/EPSHVgq4UGhohZ13INCPwPtjALAnDkIwxJsvUQtZsSs2ALx6DMVeEbEdIJjOBA+CEEHvMPy/CqJtjCaNAHCnAOUQFlWsBJH2IGeyDhBzF5HAABIityfrTDywoEUyAARRAHCXAPYuOOQVCyWe1pOBh6Sf+IAmkAomAmBgImCKMX3S/oAt6gjXfNEedzEM7ZBWPAH5ATB7QgEEueEJIKo8bBSwjhmDFR3v9Q5VYeFH/gG9uxBBxApXBOgnnIH0iwmP8QAwJhBPX1DxdAsQYRt3qLAiiwDQhDB1FgFwJwAj5AAnagB+yjuMto3GNwx8tIjZnXgvnFBmngA02wDe3X1gZ9acC5fthLiBDwkjgDOW3lD1BQX2kASgpwDf8Q6jWB6g2RuykhN/penvJ9Ew9jAxkwB3ILq3uQ6wIR7udQCfPzawWgDRUuBm/Qwv/5DyVIEFzAAQ8Qmgjz1B/4FB/hDyB6BzkYoafwjk2tYghBXS5Ovpb/PmDRal82dgrhrgIV8N3qEDCXw1QtdrgG8QKsgNH9rhD8rhBZAFP+/hBGz+o2VWVxBhGa6RKdAG2d4+ATPhBd8OPrGAVIQAkL4gQD4QNoRgB7oJpqbxAP6QNvoKzT6kcCEaIgSgZk4ALbTpvYgA0PUAZG4AP4lRAo22XviG4CVvNoR+7/4NXf0AcPoPOmMXJIAF+o5AMKAAOs4Az/MOUIwQrwPRMHnBCfz6jFJvV0IRn13QGl2gkZQECTxwW0+uYmv46cjMiOcQK3MAGIUQPj4ANDMKEuMJJs8AZsMIwhILdLcMcooI5B0AdVbL6drMl4b2oeV9yCIKe09pb/MHos/wiNQxDuY2lj5quNz/ANvTprqocahQASCuO/CPEC+A4IAhAHTE61OvdI50UJWVDSCIEDb3wQAMHq30CCBQ0eRJhQ4UKGDR0+VMjhn8SCFCEy7FCrQIYF55BwCVewipAjEIIMhPDvQY5RA0kEqPavVJEHKL79I0DAxT8XzwZW+ReuRKd/RyrcMfnPJJk0aQicwolCUEEC2yq4cIqi6alTOcUQGCgG55MPOiv4+KdO0CkXYJp4G5hTLoEqPvwdoYfmX4uBev/5TYjlmoAAA48htJWwEQhTAycU3HCRoIBZiRvKEihZ82bOnTUDrujZoKcCiDxJOpeDSxUEP7mE2KKUIP8ZH0iCEXz8b5SNbQQ+TBUjRpDOKiEGhpuDxN+D4f8+3KHzAQXUIVP/bTPYW5CDIGwJ+EDhgsDwD3Fd/Nb54cO3B3wejKeDwic2nN62beNayO4RJGiOxB6IAxsWsuGaf47BgiBACnLGFlkSMucfUQiipLMEEzxsIQcF+IdDhiwTLUQRRzzIos5yGAhFRArAZBKPSuCCIOTM8IGMlP4JYgcIzvkEt25+WMCGrv4p4yZBtjnyghIfqGAgOpoYCLubBiqvPAK+KQsbbB7QTisqffMtrt92uo8ACJa4wox/uFprmyIHQmGbNCDwxi4fjPDHn7/0LOofAA1qgYRUBADxoAj/dmHIg4FaMaiVLB56UCBaGsrwQBIvxZQzifhiyETPTMgBEUw8qcWMFwyqYg4JHiDoRgiMKMWVgRQlZpIjCCpvIEFc6GIgJVubCApByCurSuwKoo+AZ7b54IFAbt3pmyCCqO4O7MoTJFfncLzjgxeEEKKLNL7Z9RRBVICCVZUIKIM+PT31x4iB/iyICQMDmJQzHAYK4JZ/GpPMw4KKqdQwgwrONGGFQ1t4oWQ88cS0ZDr44x8zSvgnxoEc+IcMgoKAYIwXmCFog0v++QWu8a67jjgEjHNHCCgm8sYFqMCKkgAHqoprzWF1rkIJH7a5Y1cCyMAG23+sGy9bnj4IwoUx/4zggYcKtvFmujpTwgbnp+DyhyK90OAADXkTeqEBWorJ1yAskLkogGI0e7CgzBrGO++EOHUIME89y6GDFXNgIAcmjxtIzZNkU+qIVf65hYRGavjnBacJOpKnjAmaQy+J0ohyqrJw+vIDbIZlqwsl2DjCgTsGqvm8XMFyeqeiQYeAADQumEOIPU6BwAcfZlYBBalQGAKFCmbm8x898XxhQITQoOEaLB50xiAS/qkbGWcmVAiVf8T/59FMj7m77YQK1bt9iCziG7Qo+JasAE8OMuGfTkxYpgVseg3JP5aAlhspJQhbwAEq5sGhGgCDSc84hdK2QZ85/KMcGvvLrfDzDf+cbQs7TxnIE5YmiF4JwQgnSYMDQOecbt3nderBFtGIhiMXHIEH/zDGCx4AFR+8YAsQeMA3vIENb6TrPz7gABK3EJu70K8gCpjBQNRXvizM4h+h+Mcu4Pavf4DgIFkIQGEo8w/2hWiLrEiMvwzmPja2ESKYIEgv/oEIRNTCBPdjxDmOIIiZhSMcGGwVGThAjwYMRATreIEYsCEcMRHgAXsIyj+EMBHnHeEBrzvWtnayE9HphADeKMEcuNACjk2LW2lwwR1euMIPuCCVOzlJEATZCSV0QgiB+AdaJpJEDvTyH95QARKc5zk0+OMFL4hXQjjQAA4do4wJEUVjclOQR93/ghZjTNiDBAa5g9hiim4Ep2ac+JD8FaSc/4BYMiSRiBeYqwsVtFhKFkcQBTRgA15YhyUeUJ2neO0OFcAYF3rQgiNIhAOWRMEHhlQ6cy0tggRIAyR5YIaUrHAMBEGl7XDVsYJwDEc+qBoCMPYADrQADcU8adjuhK48tTRPNuil9AziDybAYCB3I0jdDhKAVuzrH4z6h08fNAwBIExEOp1VOJWqKYOAJkQRQ8RAwHFHRhzgAYNwQRViVIU+FARkELBBFOuASCgMIVc341VQQjDKI1w0lz5wQBlYhrmyWGcIYVGBEESqy3+4NQgcu0MQVPm6VP5jha/7mAP64I8SRGIc/0IQpkL8sYV0zasgL/AcQgaEPpxyz4oFOQYy5iYKHJCWmtwkY2Ew1dmltrYhJpIApu43iX9gAo6eeMQj5tgJTCzDB9jwyT/mMIcCDkRHR2AUMxTgg6tA5R/PAMs3QjCHEJRjOQ9IiT+E9wwUgKWDOVnLV8YD0T2UQAhLiMJATnJR7vSVTKCz1kkQiyP1nsQfbuDBDIRgDIUw8S528ZOfPHfMpjoPC8Y4BsJokb2CIEMWAyiGMYzRAFwYo4py+wc0AjCLAHjom1tEiDQ0s03Xlnicf0HxQ2iLkFrUYiD3wy0ieqFbZZAmEUgAT0HckF7jdqwFJ2iEHDiAVe825wO9+v/HQB9wkgIe4RSY9NrTcCIeMfCqBwgQwgvGwOR/RMGtODLl6+Z70cVNa3Hx4oEqIiGEAdGLIHzSLoD+dFIj0ItPYf1HZhb0D2RkKBUDuUYCrkGDIhSBCUygQTEAMam5GUQWzUgY+7ZXYkqLhraJUEBCaCuJgbj4frrthQkY0YkFHOGTKFCrQUD2AGV84QUz6xpBxuMGeEZhyQXcAgewUQamlccFveGkQ70hhOFmOZaxpG9HFzffj40BKX7yRxf0iuU96Kl5B4kNXwfSggH9rS8HZrQ9huEIBg9kAP8YABNMGj0gMAEIs+BvMWah4X+0rWDl9sw3EcLaSuPtxN5WSC3/xpEBhayBIPlDxCOiKvB/dMAcRnCKIEDS5YOAtQjMxQ596PPLEIzkbAbsSxB3KDoUQMApX/qHEKVdAgCYcAzyZJxXk00Qv06rgP4YCQJEWgKyOcTaezKCXrag7YJMshjOYIU+mpEPaQzDQNdQTuXOMY4i0OAKQtjeLUTRig0UpjLDOEYz2rZFEOeUFQLhN0PI12+2X4RUHfgHigxC8LgTZSCmgeM/LPGPBViiEj4oxD/eECMkg2xxEDAKBwtyim0s4R8lqAIaTDmtW6WlKahe6Lam8kFQ8gABuBBCODjggDxBoJQf66tbL3rRwJ7Eo87zxw0HguWg/2ULk2WIP/Ri/4NTnXR9hfxHLK7YDBgkIAFMaJ6eCPoPJEg4MlYMQGJoAYthDOMf0yiIiKeoj38MwxdtB39nnMriqOZ9xQ1ZEUEWoPdlGKEm/9hDAFFC34uqQDtiUgdQQuCDKMQyJQZVAR84hSE6BezSCp2Iim1wAQUIvX/QuSy7lZairwLiMjBTryi4A724i3iZJATggTlQgkzbjOVbiBYwEFpABmSQhisABAUYP4LYgujJAiGIDGioooEYBlYwKmqQBWdQI0CTBmkIO4PIHn17iLILv4XhG744lRNDp9qiu3FAiBwgivUTQboriPXThuWKExUoiBXiKJOoAG25jyaZAzfwAaQ4if8dyCWVqCwV8ELBIwCbOAUhSoMKEAQlABccQgAlMBU4wz3uWD36OjykuIP0Wg4F8IdlmAEsW4AiOCYfOAJJFB43m6lcEhDnUQg0eAEBkIZrKAZpYAKL4BPQ0BMbUIATKIhZMJAOEQhqMIjv+0GCQEHWaoZmwDeFMKruS0K88RQnJAgXqwVEUIaDSASCsARGYIhMU4BymLKKa5WT+AZyMYgSUIYtmJP5SwOJQAJWSRd5eQAfCJ4HOIJJFMc0MAIFWIILQIA5KAEeaAF42QL5SoniGogtGwh/CATd44ELOK9OqAIYKaY8KSZLPIgWeIGCUggDIAiELBvdK0GCOIIE4Z7/g9jFhZCGfDgIZLC+fxAxXexFtostzbiEBVgABVi/f8i0vSuITmBJd1AAJgFDjhoIMrC5mnAuQagA5GgBN4AAG5kWMhiDgpIXKDiCNPCBNPAGdYACbDBH4UG8KigCG1iCRjQvYEExv5isPgis10OJO2AiJFAHfygHIcAAd/wH/SoHDvgTJmIItHgAg7xECVwIs5GpU/FEIxQNWAg+hNCpBDuYkKQ0LHSxhtg7lkzJgrCEY0wBCxAFXHiBnBgIjfEYj4klH/AGqPgAuBACBxBHCCigPoAAiYhLFaiAOlGBOuyT5NMTHhCCEmgNBPDHyOqLgsgT4cGRLZsW/+KBsPE8/wcULiwbJbnEtr74D4ATjQHRCyRQAhK7FCSsN4VwTsH0jFNZKpYkiBQgiFaYgngogjQwl1OAvMq7ERTigFPoGqjgghfovwJqslzCBhWgEy9UBzdbIn84ByG4AKF4PNhUgrM5KT7ggsqDvcniAAjoAwBZRDzpndkDFndsAeI8CD3RC4XMlBaQFw7wB1a4BlqYBWmomz/bDEg7iLrJnmHIxeikzvC7H7sbES+gAg8Aj514PK+MRl3agxD4Azezx6FTgTpJOXWozXz8CzRAgkkiCHaUTSVYgoFEgx5QgnD4uHxMPjQQAn/oBB4QCn/cQ9d8wbq8i1xTGA4wAnl5gQTwxP+j80jOGAa95J5m6MGcWqOHSNEVLTFSOAjsHAgBeAcYnQBTEwsVeI2+Mohbe4AqAAqD8BjGARAC/Yc4RIjb+wMh2JcSwJj80jkeUIIuaKlwoAAlKIEjoNB/YEgm4oEjeAEl+AcMQIAZ0AbeswHem8ShE9OFoNBbkVDOaB4kKAJ/EAAYEAhn4EtLuQhaSIU6vSlnOIZQgBuccgboNIiOtFPBPMZ/0AQLOAgSkAMQaAExIINPylGEAM0DYsAucNT5O4heuRWis01/EKYSUAIuuIAcSEfYZEc9bIE8oRooDQen8gcJUAIA0NKBgE13YI13nIOyWaKBEB4fIE5O9MV/OAH/bouifElBaHUIpCqIZhCIY9BYgrjFaRVZhNAELzqIULgFL8gEiIsOgqgCk6BAHIkCDsAYmFUvg9ClMVAHNBDSpvKLLSgmMxACgrOBc8gABfBHB2RVIeCBckAmf7CBcFCC/9TEPFGCVm2NOUAAd4DXBHBAD6QIazNQJCqbgoiNJFKYP3kBBSgGNGCFpuPIZliEi+CQxJjbhGCF7+MzZJUMvh3ZcDLZyIjFKcAFG0gDsviAmUFShAgChVxUVSvUSSyoL82TkioCBTCvgQBVUtC5x9NaeC2BBYhHcZgDPhDB+7oyBy2BHOA2JPDHqwU43cusN+u5hFmOdzmCfviHmhII/2TgPoKQVoc4t82whc/62+PFjX8IAL3tkH+YB1wAAgiAkutAMprsMcOap4IImVZh2IGovD8JG4b9j1TtR9B7Vddl1c8drguAVz3kgcaK0jwpATyAJ4zBsgQoBwWInk5QElvFPYd1HgCWDOS0rP8iCCyQhiIYiAdBBiyChVAYgOBFjDxjXjb6WOQNp2kqCAEgARBgggfgNbG4gyrApfTiMptVCAhIL12iVT2JDTR4ACToAgw1AolAAxuwVB5wBxswgnB4TeDUWt65Wl7dnDkAgB4Q1ZiJzf69APYVAlBdghewCOKs3CQCWoagl+j50hIhUAWAgSK4hmOQhmD1yLvVjP98uNu0IxEJxuDNiJBLeYd/0FsqIIKa+AB++gYhqAIem7n6EteBUBc00KVeOoJA4IIuMAONKYE/gFoloIF/KII5+EAHhM1/cOISgKlzmIELuCFW5YED0BMM+AdcIAhStl+dC0HtEuC6RKYj2OLLKgIkMIIW8IFXduFkig1hOoaiQiq+dASGuOB/QLo2dqORLLEA2J4iIAM68I0PeIJtSJW2Mgg2XAhcs6yBYEAzQIKArC4LKoEozoAiMC+MGS6MEeI5sAF/kDtJdt12JAl/6EDgJAid44IS8MenRYOhe2VazpNceuUjGAexVAcFMAN1qBNbPgJOeYFWFJjDwFiDiAP/hFiQsxOA7LlIYs4UYw4nVICHAGAHDGCCIEgo51CWJaggdrVMe9ReheSTJXjNJRAHGAEKP+ICBECCFqiCBLBfDLBfY1ACyGwBuTMvbVhbd/RAkyoBUW4NU/5a2MQAE3ozVXZLIr0LrRw6ouOTdFSHCpjD+LyJJvgGdi2Ij2sBVvgz4x2IaLhogqhTVpgUVhiGh8boSwFGhXCFShgRARCAKfAiXJgAeSgCb/iZZxaLjCFQlf5jchSbf+g4rUJUl/1mDohiS6ZknbsAJZiDIkCDDDCvcvC8onVNBOACd/SHFtBS++3feZ4Df6QfgsTNId2CWwEM3eMra0PFmUk5Tnpm/0H4BhQQ6wC5BoNiBWewWLiBNBL1DGSoh7kOP1XEa9FIBxLIjVZoBcBOA8wTHWxgg3ApCGq23oNYtcpJZAtCjnBwxtXgAiE4hxegvWX4h0g4SyyrpRZAghti31qygT5kR3cMJfyUPXia58cTKS4QugCDPZWQM6gdv9tc2LtQgSd4gsJ2qLjgoLPYGwEQJjTAgl3IDGZlbuS1zoUgBbymhDf2DFSYgMcwWSrAgBcQD57AErDoAuNoFQjwboRozyDwgQfAoCqQv9UogXDAUFX9BzfIgdd8QCG3gSfmgnJQgkhogSwNCp0LciVwg/vigUiwX1+ZZ5Ey8WuDPYc9FbRNCP84e4E4rBLnoA8RyglssApdAg0OwIJH9gfBCAVnEDdeYOMPn2sPAIV/aATHEI0U/wcV/xcfqCuSxoZv6IJ6VpOCsJGaVGlWYZUYAaSQqIIeiGIf1qqBQIIvuAA8YLMW8EB75gIlYAI0uMrHe+9I8NK7UIJfGQgA/1oeWAIwb9d8dGX/XVtsGIInYDxZGwjn+oAyIIAKuD0NZFgayB5/iIMB2CIscqNc4HP3EVwRoYJ/6JFGcIJ/IAW00JbXmRIBMggbR1elgLkoOAKNqYLzDocQcOnKsVStYrPKARfRY06tRWUeBlWd09pI+ActdSlt6AHXZLkknb05cLy3nIgQV4j/FlCArMGPpfGa3oCLa7kSdXEeirCXYhoGEbOHfEAGe+DF9vllkq92vNmeSSORHhEBEfiFI0C1Y3HzAQwBJTlXRdXeNOiDdzrvoPij9H4BI5gkoFBvgojSF3DycGBVjGmBBYj11rDUL7B1vpislnoBJNiD9NZUpp0DPMAy2lSIB3iX3x4bBUjP6hiEKsGZJ0iaGBcDFPCG5rmVFqCBBJBiZDAEe+AE7vvdhWFjM74UXkj5f7j2hKlWS1AG8LgWkubtrV9P2Ti87L3RP2iNcmj3cFiGmm4BlzbY9L5yTewCHJ4kDAgH11zyKMWDHsCDfxAHN7i9qHaplvKBTkBJBFgz/z9kCEHmAGRqQ4RwVyEVIYKgj2GZK1yJoFPoqoF4ASTCguOTCBDTh0XYc4Xx28IXTGL4h0s4B5ykilNQhxjBpWSLdIS4KKS0dPL24SbtnYAUAi6Ql4FkvgVgX2MAQRMQnnhM5yNI534uCgUAiD9c5pQod6HKnj1czNiw4e8hBzM+/lGsaHFLixZH0Px7OJHiFn9o/Nnogu1fmX+nBJ1SqZLAPwIy/7HcRuDbg4pHXnAQ8I+JDYr2KuqzaPQo0qRK/xVrtmgp1KhSp1KtavUolqtLiVns1CmRjSAoLNoUpK5KOQRGIexYGuVIFYrhqiybM4KDkHBchCjg+W/kv5BHlv8gKIFACYeHO0596UFhi84qh0vwKGFYCME5eOYIEaLEx8OpR1q86NjRH2SLHJCoEPTtA8VvFbFt+1e7ttGWFUmjYSXkxIsE/+oJ/SdNq1Zax4oib+78uVYJSjdQDDDBqJN/2acmozjpX6JJiV54kwlT5rZT3vZQVECRTNQgEMYY08alXLkSC1oIobgENEUPoZEaB25wpsRD/qSxQwhtUMDISFV4JgQG4cxRGALGILAhLghcWJgbf0mFxgtBWfRQan+x9g1MNOlGEQGn1HaeUdio8JFGL7CSwAkrwBAKRfU48g8n0FEVCjJDFmkkk006idQUFVEB3SQZUNTBeGkgBVv/FeH8Exd8QVAEgUVkUhSED12Es2Y4IVSCRglVlBBiRYAFtls4bYC2xQ59BjLHSP7gsdmGahV6KKIIcKFEaf5ExcELHyXlzwutDUERbLDFFNMHT8AmSEW4/YMTRRwYEVQ9MPRTz1AVGTLck8/5FCuttULlhVSaTGXJP1Xu58ITFqVEERde/iPpWhWxdWwVc1g4EF579EBngI7eWa0SbjzUxw5w7BCSP1G0MQdBhmHwDwIX4FJRh4ataxgCPPCU4lEhvWAEvUe1oAA2l1YEKqibnnISjDOxBNsDjvpwBBMJ5FLUUMwxmYuTqNh68cXStRBVNxWlMNV3BQAzSQsfECAq/0ViwGaspGb+s+zLbR3r7FwlvDAHRdTamVQVCUJASB8BHqDEQB/ijEAkalGk6NIXUFQCAEJwwQFUHDU6KRLffFOWIF13/YEgJncKkzcUhf0PbN+U/Q8HNtDwjz382PPqk8RhTBE/d+sNHROUWISPCNVQ1A0xBSRSADHfJUVMJxQBk0ELKAQcm0137BFOOVz4IGZFnBsF3xFylYCEXnNGxZE/C6PoQmr+VKFEOBiQiyG6mSidydIdUtRuOEr8AVULNnBUUYoG+GOECtgQgI0gNJ7ygW4siUFAppsW/A3VO7ECw5JENkn3PY7Y/Y8+dENXz6tP7b3+UdJRtDFS7kOVCP/9jFAkyVHfdfAPIsS4cUSLLPK8O7zhKhBwgxDk9Adt6KULWvEHBKz1DyHw4B8l+AfOLpRBQ6GrgxU5VAn2woHhHYVSG1EK1bpWkUzR6F8tEtVtZFKBiXBgGP1QH/okZpFWNaceh6jIJnSIHCFSxHzsO+JS5GcR/PXqHwxYwCX+IYKlFKAAiKDILz7yIpoQwAUhQICX3OAD+IyBc2Tw3D/I4INOkKsKS+DCl0JHldSA6x9HUMIMLoCBQhllDut61z8A+a7CEKQHSEADCS2CBhuELl8BYg30CiYwscFkJTOByTaG0MKcvAAGMNDHIsqnj0086YdF5Ack/rGIezBpE+P/G59RhjQkJEoFfkaRn8aUYkulMKIDjaMIKZKCCE9gAhMWecEDJKcptKFtGxUIgYXM0Acxwcxl/2hLmooljnDsIQRXSdFDjtCDDc3hAkqD41K4sKF/OG1DXLhMOBJZEX9kRCmUUkDzqic2QdzGNsw0WT+NUoGctAAG+XCEPvLxj2YgI29HMWJVWEkRfeSiHvfIm0T3xg9Y0vI5JlKKEv9hJccdhVdI6QAm9ocITHjiO2lAAcqY6YBi4awKW7jDNdFoETQVq1j/4MJ/rLKFiTwECm0QQgnwsM517e6DUOnQhmawjL6cKEBQ8QcSWiIT6bWQJS7xJ6iWGZMWqeMfwTFl/5CQko/uddQ5hkBGW5ETUq2MlCKdcI9RflmRAvwjpcZMKSI6wQEXwESsXAwHAuKyBw54LgpHIVMU3HEBzLXJCCCZow98gAYjlGAJEopXf5T2j0iwkyqFMacRoIAUDuzSKCQpq00+9c+TnIx6RjHspiqgWhsIhyqyoMhx4loRji7FGcPhoXC18tGoeKIWJogKMI5SAE/wb3+TMMELNIkSo7TIWDhLAzXPVJEwTaQczppDF7SEnBEmSCQv+EMgZlACY1SEvkexr1GgarMWUO1EPqCaAVbLmoKxpHnWM3CLAmgRMdAkJmVFQm+X8pRYOMkQpiRuVdhqFLo5tCJwTS6TSv9DFV6Z9B8lVkotjJmDLVQAKSfbRpeMZZG26LQtR4Cml9hAKuiIpMcvWII5K2JOp1mEyBYpZzkRAKn+zlOOru3IEVSAUxV6Cmy3bVE+mYmp87jgFGbggAJggGGL6MMRyDWShpMyjKoUhWIUmaX3QEyVAzjpKyZW3AISsYBORNcokyCGJCZxxXM8YAiy4S5M4MgFccwBr+J9rBtK4KU5VEAFOZHgVDD9lx4/BAnovGAJLlDBCyYFXgjATwsyaxR5mqZU/1AHHVTCEmy05Hn/GuuoGEwRUaXtFKeoABpmNQAkpvJJc7MbWuUc1wWY1BJ6td9RPNHnDnQAGDbQUkyb54L/bXTTWOVAg0532gczKEovFEGWc9r7EBtUEAA4+8cCQp0UDRbGHdqIlDwhhZSQGGEQ2JgyjEBVG4DBaFMECGuoqHcyX5cGzkpBn0KRoj6rHOIenGDlPVgJUYo0gypw/mFGjQLXDyubKiyoyMmlkohgVgTaSLFSBrgCDEwkogXbeMIpDPaplhTLS+88AgQ8F6aKRKFZFkwe9pqkbkop4Wh8UUACjFzfpRWmHH/ggA/y9QJWlwqSaDt4JY+iYBlR5EUzwbUdofKUIIaCiFdJdkV+mOxh1GPNVCkSkCpy5ooMyRmyyHvJQRzdDFCbES8IoMl0fXBvVMGbT0NC52Z87nKs/ykE3+CnprXSuqUboYKK6oENliCEC7ybIuZU5wWEsIQloIHJFQFQCSsl8K9/gHlhs60+jYJTTBkl1mNZij6CH/ilvHLjHj6G3WlJ56qkXCnLT0oiKBLFfzARKX2OLjAK0AkfNGGsWG4w473NBfY8ukxjkEsIHNCSzKd76QsYPbpsJgTRWgRenf2y6yniZKt2hF//CFbzTE9NnAeDIdzsSYVqKUVRFAU/LEI+DEDI/YNxPYfxGV9UTFxUJN/wVcXzVYXLIcUvydw4HAEKmMw/TA/v1UaLxcVP/UMUoJGZyIcDzIGcdEEmJaDSLZ0/WEY5CcEILJdFvJOoKYBl5d8/tP9WgByBOhhYylBP1xAM7/0DNsCGgk0MPzjcLPzDh0XgVRgfFzrHF27gUXSgUpzcARTBUZDCyq0ceDDCBx4FA4gAr4jAATjA7zXP5KiEC8SF4/3URJjJGb3MGEyEXnBBWQ3Uk+gg0xUGF8iLO1gGBh0NuiCAECAB5JXQ/s0T8tREwV3eKcgGHrZQwW3X2TkHKUECKSFD8sGC2zkH+vyDBWpFLiQfLTTD3LSiGD4Hr/RZUgBDIkzRJTBBJdiGrp2NUbAEHJXD6yFFEGyBGZSAOIRAF7iArejgZinBhgRCiVTQ0niJOZ2aEVhWCSHhX+CTWNnENsjGa/wT7iVFFe6aNUX/xasUWy4q4N6BGBlaxFwhhyREH1TwYvSJQA0swAvknEwEi67Fxsmw4FHABwQE3XzcxxzsAWHVSSKqW6AsAUUIgQ20ACTOwAJYyIbwQAZ0AbpVhIiZBr91wRMomHkIAjbIxvSgYCnCxvScXbBoBSQ4QgT8Az3WY1JsXDNoIFA6RydAG1eQWFRcwgL4QAV0We6ZIAF4QwX4lBCYwT9MUxBwzn9xARztQRrABLDRiiKiwUcIARP4gztcSAl0gtOoUyWKyL5VFQeowxBUz64dY4MR3K5tQz7Z1lFkUoPZRosNl1E4VJoVpVKwwj8wpmJKBVaCILxRxJ5NxSt0wgMMDHeN/9XWDIE3+JRZVYA6+EAUvAUHHEF/6IX6/QMKOBKPncbShQQ9/AMPKIA/QN0/zMA5lABBFMbWQQVkQAZJqAApAszJUIQmKU+tucRKbIpNjFVLDAFLXB4mKUUYvlmHHdEPxWJSHII9GNc0/AMtPKaRLAB4+OOJQQWh/d6CaRnzKA8ChEAVcEQIhIACmIEZNIsycoFsfEAFkGMOLt0RThA9+MM5BMKGBIU5zcAfsJ89IQFM4JTY+FPlHKfXDKZsnMwU2pbXqOMytURMQcV1Yow+AJ5zSMx4kqdW+KMTUQRXQMUlJMIr2IA3aFWD0WRMBMsTuIYQSECkbWRhVAQXeIML0P9BBbimkyhiSCyBEhSoESCVNhSBEpiTEGwdkhJPYPiDEsbEJ9bWKLqIrIHppnxKV5WdSqCAN6RBExTWFlUEBhKJPvxk3GHMq3DnhsGSYw7DkkygijYfxpTCtZ1HbexcQmLKNnhDinxlRSyBlozFvuxMrCiiSNiAEOwBpZSDEBjB+1GiNsglcHYE8jyBdD5BQNkG9TTnP0gnTfiLUahqRbTIBwzLpfBTTKyNYcKVLb5iPbqZRRgXKxzDbzlmxw2fn9JSDfwDM7yAIIiqRTRPKdqGIDQBBKgXjHxDSmwDNUrhPwRAJlwNWSqpP+QHPWkDo3DBDBxGC3BdvZSKAjzrURj/lle16a25owC1SBkIQgG9DawIhSEURcTZKRL9EMVU3Fv9wzCMpy1QhGOqqHMwwFKUGFeIgAgAakUSDJmKlSCUQRpsgbySgU1+gAqggTFogiqMg7oySWio218YQSCY5RvhxaKIY1W8QFl5TU6K1UqokF+6hl+GihReaKuCSobaBsE8J0VkRUU4Qz0gA3HMEslxXEexkplRxCKMzwTaAtIyrJGQgiUsADBEEcslwvRRhAiswyUkqzdoF4yIwaFZRIxswU2gDTY8w0qgKja0AC5YgAeYAi6k5LfCZmhswQvswX8VggJQaiBwgYPaU5TFBMBI56WEnc/OBkpgkkzIa7P+/0NOxO2Xdp/IxcKYUQQnLGBUJKZzbCdSDOsxUMTTai3KVQWJ5RkpLEAlBCTZioAcCEM3AIANZOYymSBSEKmtgQ3zXJ6L+AASOI0p/AMOrMDFhAbgdgQHqEC69kUndEZpnCxSYFV6yBpMqGok3dZg+hOnLAXZxVpmChzK4EbWvtmY2QMycII99N3qIoU96IPpVkXEGabrrg+vkMIwSt8/1EAN4IMAZ0Aa6AaLlEGMuJhBughMsoSMCMIDKEAmRMk/bAAOAOFFAm5I+MADjMZoHIZ7aK9RQIYPGIGvQXDdlu9RTM6MwAhujS8BlIGtSlLBgcE/4NUwDAAy8IJSDIC//v8DsC6sUTggRcCCVsDdP0iU+DyU6+Zjc3AFAJeCeVZEjFKEriBrU6JAtsKEMSqFGHQN2ZlNi53AP3jAP2TBtjIBgDaHBKVsgpzGEfgAT4gTFwyPCRtFiRwYF33NP2nZYB7noG5J227KEbSYwp3jNnguVTCHNAhAFiTsRFlE617FmcGSEUmDIawZn/bvFINHJWjC2FbEFP2DK0gROSwACX6o2QCv2xqFJ7LEE1iTB0yABxhDEaRkJr5me4HLwmBdFURYVYSEDbxABSgPgxXyZi7FJSFFTh5nbCznO2rFsMGCLTimca3V/KZVk2CgQ01YLj4X+wQwUvzAP5Byx5RCCzz/D0ycBG2gjbxqik0Apj/5ABrTgCj8gyh4AQ7ggKf28nNYixyzllkpwRsD5wscwSCgqvVYRalGBZkY2qhgsj7U71LAVT5MoIYNq3MgF8CWHCbkQC/ozShwTCn8wygU5DacBPCWYhO0pNmAHQFciq/hBhOsyy1k8D7jwgr4raPsMVU4ihwPlamEzoBcxWkm8jYMSzXHBBTSa7YxkyAMQW0MgRFoyVTGCke7KVK81SLMzYkaRVEQZS6OgyIwCSZYyRUjRVsfxSV0gwh0g7KqDNsmRUXSa0XvcAl4gChkwS1n8LrATwr/rYAcywtkr1ZMROh8gE0mxW3gYSD/i6Z0jcK9/ypFlIeWaFceOq4gMJg3zPBRCF+vVnI+BBdy2QMnMG3oiugng7Jz5ECv2A/LUQUoWEQjFMFLwTIBtGQVFmq10oS1pgESNIAa/8N18PMEoLGkiBhRG8nSocEDmIoRioaq0QEBFKCLnV3OjW/KjC+oCKBFQCF6IMU35KSR6IMSh5Ih5MOrmOg9SsUh4CJsl2FS1IKJVSZyXIIq5MADPIHKyPAJzmszL1xMpIENlAB1TMA+UwQ0GMVqkCVsso1Z+W1VjIYPnHdhCXKobEPP8t4yMZimLDKHY7ZLJCBunMwhRwV91wP+ltlom1n+7s2MJ1ex+pkJMMIkXHF65gpFMAMwPP9AE4z4VynFIcuEyZyCC/iAMeAAKmTBTltEGqgAElBNEWzMc+fgtQTPpyJFHXOE2W33CwsQX4JdZ5vNWHRvAufcmVfFJUsMKgnJsFnEQdXDU7yp/WJMa9PS+b2uReB3r7CowyLHJ7ByCSI5rMrtXrvYoA6BGHAADgRABquxGuOCEZDBKUAB5LVNrUBvRcisVYyGP0ABe+q1ReDlKM6IbQE3F3kDBARQGXupm8vCh90jfbfK+Nh6fNd3UnRCZC7FL2WAs/3DCCDHKzRCIzA1AXq3PYvd120DCnBAFki6B+z0LdBAA/wDYRGnWf2FUFsFpjkKGiLHROQEVJiHXp47Ct7/lq89wOQ8Q4OJNlVcAxBTxCbYQyhZhBIbRcSFNdwgBcSQkvk4nFbgOYiNtBn0ggkoQ0WElAl8x+w+USWU8lRogiZ8QjCEjsKt+wesI1Rsw00KAgr4gAK0QoNvgChsAC5cm5JnOuRZuITbQHVLxUT4gK0phax378fT3jt22RiszQNAQc7G81LgRrj9Ay98sj4MQygMq/nEQsdxMnDtOtW66SVfhcELV0k/wj/I9j+Qs1SkNKFrAjMcQfQU3MHtpXSKIk2UgYhXwAvQgHVQxz+gwgbMgA10mVGYyOIyyTFTxLeDROj4gzpUgEzOM9q3eVTk4bDkxrk3z0mcDXorhTPE/4JxrZnd3fkO5ftUlM9zcH7JZQCg/0Nai9TFaMMrlAI5rIMNUEsT6pMKoXrCZawPMAE0gILF7LQA4D0EqIeWqUPge5RFTkVS+4O5V3ZSTOhzfECIHsWKx7vkuwCrKqwEIsWa0Zc+LK09MBQy0DfA46o+eHRVBBeFDR8TGMUViT6tdCoomO0rGIE3XB6o9DY2eMr/uYjyPCuNfMDIKwA0MDhABPj3j8aMIhBOnSLwhMDAF//8DZQ4kWJFixf/GRmIBmNHfz46nhq4rSGBDx1RDhT0T5DIiiuHbHNpseSTi4aQSTw2MJasf8MM1Qvl7F+znykrcrJn6B/TjgMwLvrnCP8pxXxVsVrsNFHRI0RZwWp7VelSKSNphkz8IOhDWoonP5j8F3fbQAIoHhhp4GEiKmgnfKDYtlKiRrCH/6HRyBFx4m8Etrll+U+uSpZ1G9sleZHOQGwn1c4V/eHbQNATF+2saOsfq2HN9A0c1tipPk7/pFa82vF2Zt9gEWH63XGURBGakGwWRGAlYZYzJ5asu1zMPyg2cP3LsmEDKkqtaBxBAf0fEjQRh3dUvLFxRBQSt5WJuzx9yoYDK/wrbbG5dIpNJroBI6JiqW8gfRa5B5LYsOoNI0guYsVAihawKIN/cpjwIlIGQgKC90wLsTmL4vrgM7vE8OYFYygBoZF/LPD/IItiWjBpJhX+eUhDi4pgjLHD0KjgNNMq23GibbY5TRBB9gsNLpOwsaiCICSa4SJnfJKIwcPykYofBKvarSOmcvvHHiMtsuQiV9REkyJNFHiAgG+wSasMlU5piSYxCIiST0HEOOUFHBoB5Z9qvABlA2OOYHKluk5RxwY3J3oBpB+zMgAiwyj7Ji4+Q7RLIdF+u8+Bf46QyDm7JgP1vopASyMlZ0IZ6BrE8hnAHkdiE3NCXyc0gSJz/nFFAUpRqsSVS3J4YC7IkPynLrii/IehuCQq8gMUfEjhk4k8AMUYG9LA1sZ/ekTPzRdaULcxH0oLVD/KSBPkRJLiekKkJjNb/xWlIYeTZiCjMqMqKEO2RHa4NSqip6oKjSTlk0vYdAOFxwgoSdVXVR3IpskGQsEIJkAgRZN/+PICl3M+dIG5jP8Z56GItvinZg19SNU3f7bYLFTmRMpYaJgVVrhAWDLr7Z6gGtuk6Ioy7CTDrNrUsJJ/FrgkEVIeOomkuqb9xwXPsqWIvg9OyUsbEUr5hxQPLGgFCR+Yg+ufU3WktAUd3QXLnyNUOI3asp81sT5vnqaUKad8s4efxCla4p9jO6IcTVcWsEDNMpZraNQRo+NYT7uWfCESCySixIsJ/vFBSMpOOUkdjfrW8ObhOFDHpYZOspci5iAPvrGrcn0csTIZp/9U2H8myepC3yC2yBW3gbnkiJcpmw6xl4+YXiIS/kHlnxbK2Gybx1QwgoPE3ETjCEzbcxY+aSEbyFO5ABZef5TyYYoqqrAyDG44Axn1+IcBFdYLRgSvbQNBHUWuNpBKGGFsEhHJ51CCGcowBwUv8IAoNjCQLPxDIBwo0j9QEKUWUGoLEUHD7TKDBnWwimMDKUMN3QSii+RvfwZChiOcgbAyISsHW3maCJjwD2JIZHraMBQoLvEPSyzjCN54FdgII7h/gOoi8XmAAsBziywI4B/FAMQLEKcS4CFhUpRyIQdAsjPXueAUYKOfHdGWnvvcgSbOAVj9evgbGlxEYBMxXiD/O9KLf0jiEhzqSCImUjVDUUIb/9DGyUQgDiSkoXzRqQifuGgXmNlLEGX4YnbExxoJPURfmMGGGFSABPZR6jw2gF9WOMKRz/ALTdVZEkXewy8CiAQbeqoWIusTi5wMZJnIxAokkeK9iXiPEubQBoeAoUlnafAiQygRRXwmiCd8YBttHMgxBMAKHhgBAmVw2Vo2U4T1GUldcZxnZvwhv6eBhpchy9Z0vjEdHDqTS85IBVEIipQMLbCBHSHFJaJYEXM0ohIRHIg4OvEAweBwVRrr2D/yJJK4GOEas5AFNf7hExjQAAKsesw/Ypm3HaHnIUeIY2M4UrPRuakM1WHIRZ4x/5HSKOSCA33J74QHQ4yEYpkATGh6ojeRSlCCLBF12ycySgeT1I+bS4pdkc73tYA2KQ7GoEVr0nkNLAQmjf+4wwc+oIAVUmqeRripbyAAIPIYyG7/wAY3McIksAUULH0NXu0qUg9kFKgotXpqR6K4RLBcgpIWYcYCAkMSj0rkJAwJJdmIGbI0KAAG/ziGLWjBCl6EDK73GeY/zImm2y3GNy9EIaX8hRRiGjO3BKjORFbiyy3eKXGaSskwFmGPUBjlTI/dESUsEsVgLGCbX5NWx550mq9B5hstccETvGGDW81iFq2ZHAQIQMcnlYdTbjJMe/EJUmzt6CS/PWZH6idYkP+9hVSj8W9mEoZL52YmGQv8RwF+A11iSeQSIqhEMJYRRxTgMHYbpJcnL0iZsR2hGKgoBmoFkAAjVPhVBBgCFM5xSw29gCPm+Q3NEjKZ+0and5ShVIaHepGdbhF4A5YIAn1cEU/UIjOusKhFSvGJShzBm/AcCTiXlFsSOasYWLDFLIpxjQeUL8pP+gYS5moRxB7mBRxAQxFWqOKsfEQwlgHndQcjzqoMtIIdkcxFABlQyEBGEIA9THOx8pWi3SY2Tg3yQKBbkWX8oxKvaIQqQFKX3wJyIns1LGgIkIYTGIMVrLnGNXQ2mfvETh0ynYi6xlwVDjzkHDrKZWa24I0mrIT/hxpryBPufBgpg6WYeTpmhg89kOQNmBHLy0qiBzJRaVJkLJ+4QKo+wKe44LAuQ3iUqrrLEk9h4w4E0IgtkOGTLDxgnL+97rxcbDMjoWGFRyiCgdrp5yNtcNdZ4WFWmNMkwjamM41JM+SGmMAOZOXIokC2RRrRCEa5liUcndO0s+dboYUIBUcYoTR8kQDd0aGj+ukTEh5yz4H4I9VgSdULjKDmw/jDGzrsCDaMmhKZCHdCMX+aUoMNFk9kxXuURQklXiGKZ9PnMyfkbNFLdB/6+NatA2HCDJwhDRi84HUW+cY31PE+i5xn5LVFAxrmZqAtxK7e173LYcx978zYMefD/wkwpRj2m291xEW4eICcKbOSgbqWTxnre0O0Kga02QAG10gAFjQ6X7NFKkdbX59xYV1L+O7MWX+qiEuIaxFZgRTPW9xvfWzedjd54hGHYYSBG3MyQ/1j7hVphClaUQIjPEHvoXqLp/zrs+iIYQhHuFUCNtlT/kgrUunGFAdwnhmUi/w3sUaBYQfiApejxIp7tb19JjM2tGlvRIOpT8lFPxFdICaqiDGFJjQxClNMRBRUEMUEPtEKHODCCL69YYWPiudc2+UBWIBBaeXDxvylLbBBJGRpIG7HH14A/LLCCEztNwLDWqwNJWxtItLCJPoJLAhDTy5oRMoOKyAv/JDCE//ibn8uqSLW72RUYQJkjwxKLD1AAyQA4QjoADMu7bqWQx3UYa5+ZG8M5AFBT+1ogvPsQgg1UFr2DfeMECkYUAQlghHirgTdZAT+war+QRUo4RNEQRQsggpMAQTmzwa24aekZcZeDqlGgg4eQK0SbyJuaC5copS2ZWwe8AjCbDhsINSGo4Wg4BsmDCuIhmzmRY8uSCaCa79CzyOQadgGDJomom1cYWv+4UU+oZIuQhQS7h9MwRTozwUvzGvsY3ToIzpcQAGOQD4+w6uAK9+QRPdcqDDqYwuO4AiY78V0ZwisryqW8AibY1V2Mcj8RyJ0JfwugRkwArrWjyK48EU8oAH/jOADPmbi/uU5OC9PKmIP8kIhrqVPSCV2RsUytiGWRI4DzuHFbOYFbOAFkq89YOoizFBhUIADQcpfPjDISusfIEQYF8ZIqJDBJCIY/mHRKIISVMEizi/hREH2votwpjFKPKWO+omOHiBovkmLngxfbAwJ9NAHcYcDbKAWa6t1+mkUEWPSUsIFAGSFJjDK7ovWGiME0QTBMCICJCI33i7njPEfxGHuGqH1lBH+NNEY6MfCQod+hMvygOvyyiAN0iAeJ8MlrWUgxOCGPMcm6EQdoGAidKYJLyIizGyW6qMpkWqzLCIO4YMsWUUUM/AUHqP2WGUbps+5fuDAKAJCIiBX/wbiKhbB0Nyk/BCjOCTiRQISIyixFUyBBc/i3DiLv6Bv3iiC9pZSgzRI8VjlKWMHG7KSU9poHauihThg8tixixSzIzrqJAZKv5gEIzbjF/fHEozoH4BBJgdi/ChiAHKlS2LjJhOHCkcA9TqiEnxSIpJRInCACydCFaDBFIzBCJbObETnKQFxIO6ALdNyMjSIY/JkCNziWFIODT4SItixhV7gIVQOK3LRxkJvxzaox7KFqPQkAyvzJOCzhx6oIgYuBjACKmDgKqAieBigItbgNVPCEjECBypiBQ/zAowAM/YtScRmPxqzMr0PKj2HJZwjSexmLaJvTp6B1r7hDsRgG/86A+TGc2cgguRsaUes6J9ijvaKafeM7iLnM1sEgY7+AUCcSyaBYCA6AMFoEyOQwSb/QTfdxDexIhhGYRReIXUsAhoGohUmYgJwQChhbj1fxSWOScoO8WPM5h+2KcOGgGhoriT0pDJkxwjSEST9xgcMgzO3Bz0D8Uj+at/ug4vMTSV6za+gUvQELQUmQgRgEwg64Ec7IjYOCXIEdCJORiKObCCKIxgIVBso4UUEUoRMARpwIDkDQBQC4ALUIQ0gg7hIgjCs7TxRQgz27QG8oY7qaCBI7AXZolSr4w5sggDUQQFkyQhWiCu70gZ8gFexAmwozerYrgj76x9WkrD0ywn/wYcI/mHgJAIIgAFa5xIpgGUKJ8JI/8FQBNMiRmHRllQiMHEgWEcVClIioKEVoFRKZ2FS5FMa1ehOcRT7CtA5FOK1WIL2RI107uQUxKAJnqDf7uIbEuI6gBAswE4i3FQiSi5o4DROo+OlssVVkrIlXmtU1A4mnYlA7RMTZFM2kWkBoooK/9PpLqKhUgcgKeJcNXH9RGETzVUVsgAX6OEI9HUxnSM1t6jf4AN0+IPSSmMy/asit8gmMIMtsIU0MmJ9FtYjWiAruTIrq0L7MMJlok/HMmYtqqPOnFAi6vMXKKJHJaJan6of/4EBenMiVm8iVHYiPuETTCFcI6Ei5k4V/0QBBFj2HxauMi1C3ixoHk01qP6hAnSvS+/UcPMLptQh3cAiAY1ADzuiAt4j807185DiVarDb7t2IMBHBCsJMCnCbKfn4CwCSf8BdEn3Isz1Av5hD9SBahvDZ0PCG5oSYATBm441d00DQgcCChh35RLjAFMC/7CCNWmCMhlyc6sCWvWHY/+hFBoVdP9hoigB2VZBep/3dM81OAdCFaD0H17hbc+1BBDPVUHqcHVrmEIP5jJtWN1MAPHLOUyMj0CTCUfOBgxDYy+CS0kTEaPzyXTsImrGAMpTzJCpBv4BUHuobSeiH/+zUS+iOJL0QIEzJV6EC0dIAcYgM3ztozCix/9yUYvsxl90b5zmZBzuEDH8gY0wYsJqrHA/2JNKxFSV9zd04NBKwQqzYhSYIRjC9Qr/YRVQgmUL0kBPoP741jzr0ZO+wXV4qTpKAnhqDA2lEQXoAOR+owWKAAhVoMIcNBGx9rNQ4g/QNyWa9tD8tIfy1pJOt43NNiXmLknbJhi4NyXM9RMwQJbupIwlwiEpIqTAYpggoIRj+CIg9K/q6LXUtCrQAOXuaiS8bDQ9WDWl7R0zSJKJcCL0N+d2jkf/gVAnQtBET2XbdhUYuCoiAQGOheOGF3CHyXgHIi0qgAxsVD9kol6hA2akI4onI48GQgWOQH26bpMtQlMewAeYD3n/2fMwamiU8uMi/gDPiCsKFLZmzriH0lgiiEGBq2EgQNabP3k4nCYfK0IcQheRHm0g4oRnkSKgfml7TCkNXCKsXLXs6vQi3uAfsKAf2MU33GAgEAeWi5UCW2ItnmBeKUI49MNwKOIO4pKYnwoInNU4nhUY/uEHssEiEGGjnauOd4QGNEpzPabXWqIl+BcrBKEJ0uCW84ikJ1CURCnm+Kg8/iEChqFEM+MB/LAxwNgyngEFmoAMzljenhkBbQY9IDqQVsAibngivvkf8LMASg8rnGYiIqCq6yN1d0QTZgAbTSJDDVklCAuWNwgKPmQkGkJZ30yDpM0idDUBpOLwxqcx/zSCKgN5oFEiLi8CEWQSfUHkZgzgmn2sAJpaNpk3yIBOiI1EFXBhBmbgAZrgA+4EXiutpAuxJE+Bdv9QVQDZhmxsd7DnInJjGK4AC/ZmYf0BU1RgsolSF20MK9rKN4paf5IaMXb0Hybak/9B0J4andMZTXABA7p6mzBNx1QxM9biFNJAo7jWflKiviZipuOAma5htQZiha5ZVqxLpFUTk0FwQoB1ODSltpECWnO7WCYCP2+4A0Q5kLRBFYZzR5yUZuXESiFWX1qCYNUTIw63JZgyLn2to9xS4pybInYBN/4BKgRk1VBCFgfXl+2IrZN4JGJn3w6DvJ/KAPwB8tAAw/9RQgce6IYlSxTIdiI89qkq1UhwQQE0gpz+ahA/yfN01yKkTeLi4hue4UPmawOR1QN/SdKeTPC+gTEQ6kAkAoUh9yLE4GMEZ6ueu0uRcLYxQuU6IInA4bBUWFMKGCOWuiLaeyCAIYmQaYSK5mSwwAiGYCpRYv+isyROwhsmciVCKmfnPKQaYjLvowWm2yL4IRdio90QS09qTMArECmgcSUq4FTq48srYgs8vCowfMtrGCu+ZRnm1khw4Bri5IpmdPgW83cUD23M+vn6TCT8pTR+XDG9hgAQRwmkIcDqQSrq4dPADn5kpQwElzKqY76y685T4rdKQ9KrQhF6myIeHbz/EasDRGEKcHvSCQoXsOC2umvO8e19f8fWTgEKtiyW8eRvqfEbs+UJKmALhgEG+mEiesMeNmEADOHwWkB4O4ZoGuJ254PH6AVik/Iijj0zNHx/uM4iPAALVkDMwQJai52gcMCjJwQHYOBY5l2tsUJZycM0QdH7Rn0Ql/gtCAAKOAVMrAJC6iEBTPEFjiDballEWELwCsfe/UbYJ6LLKaIUmqeY0yO8jX3fkcKRBgIfnL0+TmBVqzGT7UxUQMqoFuK1UWC5NzvjK+IN0CABYIAm/yEfcqEi7kEiCmGenMPcCOOCXGXpKBslbB4lLNoilEqw0WSR6+PgCerSKYJ1feMa/4phciayNMYqtjMIMub03ng50yB7Hl+Gj8VGxiEDL4xACQyIQQpJIq4iAQYiySVTNIJKnCpyz2AYKxhwqbV6tweiaiQC5yli7DtC7TuC4Ccim0scOBQ6eC5dFUAAMRpAYKQhJxDPtYam08E9K7ahAphS6bIWLXkMlOgHBdBgNuxhEfLBQQJOIhDa80563mCGhpHi6zqimy3hFtKYQ5oaqjGiZkS/aMioKijhBJjdgf6BHIxD5zHisJ0pEzrCGLIDKxQAVDUUgH0jf0BjM/AeI5GXbJZjJQACypEr9v79G6DPoEKDHP7RWQgxYsRt/54YFCQxo8aNCgVw3Gjgo8iRJP9LLvTwL4VJkohWujQ5658xmRGt/WvwD4a0f9euPVhIIOhIihLLfMt4ahsKMtuE/iNQRgyBpgROSTz1hMCHJv/q/VuUc9Gmlx+nYiS7ZeUlESsgAitgkAjZuXRFqjS44d+JfzQMVrOwES5EwXVNrsrYaiMuktcGADJSWOFZQR/+uSAAcdvDs/8EYTb4WeM3BwTovEigL9+AkpVb//sQOuLUf2Uim+ywUGVLS/9i2P79+5bBvSIJAz+ecXHGnQpx/kOWwMiQyrTpDlGImYAY2Xf+EVXYHTNng9u2/zu19YEChQklHgWNXepT+RAxixn0r4KLuSFDGkw70l0GMQHRGcj/HegSKv+IoJAOL+mCYIQjwYAFBLGRFZtrEVXmzULeYHRhRJeRUYE/9eQTURoQWQURbK1pZdA3GirkH1n+LMQBGgjWKOFxuGVEhEoOSvTDRy0tBE6PImEQETQi7WKQNDCwcgQKH2A0nmQaZQmiVgRw5hlHKma0jVWnvOENZP/kU8iN/3Bl3kUKVRZmdk9hRt0/DrwEIEQ8zkWgkkoCZtCPEZGgEBDAENqWQihB1BKEgtIFTWIGzWRQANdAxNylOCXwgBgfiCEGenY+deVrLEr0JWbYfIDNNu8JgoIL+72h0AX/7PEdRw94w4ECcbzwDwQGVYbZQ6A1xWpED/hQkpu2/8GF4kJMuDKpSA21QBZx/wQpoEsMZnscphLNtMtMxRjEi0LSzAAItHcGRa9UHwwRJ5lDWPSUINgotCpZ6hz1AAQ+oPEAv9592Zmg/c1FbESOKATKP4GSqxEH3GIsESkcFxaJQbiUoJCTEZlr0LruzvDPM9nBht1rFf0Tq0EKS/blq9+cFfBL4VRhUBkosNjhsRqGyJG0ETa0ELG6ECHLehFlAsTHB64wpNVaL6Qc15ea7FyU/3T6TwJ90fENv6cSdRS9p3r31ClCYbYNV0vM4RICBoXwj3mU/cvwUwulwfB3WUKU1p/HNcRjB31FJHVKJaHx8HF9kgXMQkWS5UG4Cv/5tvVcuBjTtUGb8nSNNLT8U0wCHKgj+L9ALVTZdhT12mKW72V0uEJAvzcVjNQhnec/+IUeESYWRIH8SIojb5xxzYdNF5TUSIN9NDD8I2+LVl2n0HZ2Il3VHSyKY5AZEOEdkd5chMPFhn3Tu1FoSDdP6G+VN98RbxdHKCn+jaQE1IMIlNyFDIVYSWYVoQzAXEOR4kkkLVwoRzggAjSIMM0gVSjHPyx4wYVFkIE/iQgKBCgRQ02KA8+bVBwaRBJsSWRcoZsYXd6TQY2QjXW74kAUSigGBxxBI935xxhEcsEh+i5+bMgIG+JnkD/U5zwfUeKkWrgR9aEQOKz4hwca9ZL/imWkGyokVyMMYkNyYS8j3UOQFskShMttcY440hGCDOUt4GSuFKHLCx3/CMhAKsSOPcojWbwgyEQqcpGMXEkAXeI5iGCBjjFppCUvicmPCAci3gJjJj8JylCicAoSeRxEELWQdZkyItUwyOZCpwpRynKWZMHiSGICi5e0ZZOLjGVJAgCN0tFymJZUGjGbZwyTreSAx2wmuVpASEF6zJkaYSY1XxLNa2YEDRv7jSG1GaHVgHMuxhznSmwZmWn8g5QcESNEQGfOeMqTJNnkyAZHgsqR0EAW8+wncsopTzT0YST35AgJSLBKkkRSJGUcp4JYI5E9+PM4zwPobwpKo7l4/8QkxzDIQuWpMo4oUyH0UMAelmADI5ThAWPCDPgU8gKSvUQYGd2IHD9Sz/39o5sGeR46N/JNS9ZzI54c5+mQd4tieKB1CmhBBeiwjVRlRG8RStxCuLmQPw31Hzc90DcfxTHKkURpBuCpSCZ5IOlNVCLQ4EYluyiAFjwABXSozBN6xj6R2EAkQ7Xqf15iAB0FFkFoVQgRDNlV5A2WJF5Za2GuAQ2bzIUas5AFCVAxCwHMAAYK8EHRJPiPLuCNqhzh6VY1cqMa/RQiN5LWaQtDgv/9w5egbAk/HbtFa9jCILYoBi8SiAzIVAAFWVpPXkuS2JVEswUWhUg0t6C4kOiIkOrdGIlHVpACueTvtYCUAG5tc1Sa1IU5ARBAMaRBjS7+Awst4MATTigtIWTkuBkxAI8U19oamVWwx2mtQbhrEHZKhBKCbC5EhjEMl6xGvdoU5k0MItl/QIlldFkdMpzhLoXEIgF8eVYXtuAPHcUvhLYJSTmZV9OMQbOWq91oXMhSBIi4uDAP4wBGM2LWf9z2uxrZVAMcfK7IqE4hvY3SMGjBYWkogFhNFVRyMxYZAHNkBQQmiR6UxC0A66ibNEgwj19yuvBGaBG7cEQzFrE9071RSVKOSJsjcwuP5M8lKEbOk3G0EO+6hMHNCwgAIfkEBQ0A/wAsZwAAAG8B8gAACP8A/wkcSLCgwYMIEypcyLAhwTWMBK5JKOnfiAX/FlwaZY7Jvxb/0LTgIHDLlpAkfbwYZ0lbpUuWIhJcoMBgoj8YFSZKKJOhK1QOG14iWCmo0aNIkypdyrSpU4GIgj7C9K9X0GT/JpnYasIgOIGW/on4R8qVhSI2WrwogiTkP38C4RYkScqjQE8F7Q6clDPhpH8KMhz0lGOiQkuVJviSla4VQ1JPI0ueTLmy5aBRBXb9l1mhMmWPJtf6RwzsKCYvbPxjosBuiyM+OMg16K9FkdYKwv7r1DOpJEaeJmEV2EsXw78CQf1r9A9YwUqjMhK9TL269evYC+YYrWh0wkeZqR7/rYkwYs4FJ2ywxSHwxcAWPv69QDO7YAsklnYK1J/UUqcFkkiSDHL/mOCJJyZUZFBOYW00wksDDSUQZDtVoslC2gi0THYcduhhU+IhdcBCvYC3EIEGbcaZQIooKBBGqnmk10H0GYSGQGgUkUEnXXWCYkKW6LaXQJ1glYNAyfSCiHEHYUQKRo2oUskI/0QnUFEZuiIQleZ86OWXYCrV2VJWMYVXUJJMwl8lYS2jDRJHDFTfQjcKZEMGQgqZCCOJCPniQYJNIkmCJvzVi4oHaTlQAD4VlGGYkEYqqVLK/DPiPywoNKZCwyV0JpF5TmhDnUeR2t6Ok0RE3j+J8NdQRD2Z/9BpUK6QUtZBRRmk6KS89mqdIkmFZtSmBn16kJII2VqXe0n5E2dBNgTJ50AL+DmQqwgJiWhTlGhDia/ghiuuQ5hgooixBj3iibAIhfiPeDvV+g9JS4lkkA0KdMKqYBnN2JC+BB1pWKMC7TqQK4+Oq/DCC3viLkHl/sNuQ97pVsRAaJxUkMYLHWGqQPgFuZ+1Di3AH8BIkZJrQ98ixBzDMMMM7GXoDoRJif9UWtAjVk3M2WiWjGWqxwXdGN9CzBKEBhN9dpKI0xnoZwlG2KJssnREGjWKKwb/02XXMYctNlOhzVxQmQrVvJCwxL4Y3Y0tEPGWQjZwHJQNWpKyE8ALAP98ckFUTkiQvj8KhIsxBI0CmVIejO344wRlWtABv/xjNkFoL2V2BwKJ10klTMBlwwnLJJ3QC0fPzVALCriSSN+sGkT4bgRh2xA0lNDzIthBtTwd5MBHWjlCPluOSaWXF4uICYgU71Atn3aC2AscFEHJhnMi9EKcH39EY01WRmgQv5UtLqFDL3c50IXBt//lpZMj9MsvOq/t/EKYjNlJB31XUoRt7jALSBYCFw68wHQDoZdBjKCAHJBCAYsDl+8EkjD3WVBsiqAKJgpwkMxkblgC6YBzKsQEG9hAFZaYoELowwEboC4oRsgeQaaGNXl1SBOm+EcOE7IM9l3wh15SzUH/gJVBpEguKCG6hCsuBpiidC8h/kCDEVrwxIMg8CCXUMD5mLIygYwFiGAE3syEWJBzpG1FS3HYPzwhCciU8AT/4NoVGdLCFtRHgQVJXUJyoqVLYKQvQbGAoiADGd4tRBRhTCSYfpEp+NmsIeh4RFe2Fb+FAMMSwbABEFZTOhkeZDa1GRVBWmA3OzFkHP/QBiks4YoFtNKPhvxHriI4kAh+kSGfQCQiFclLLw3PIKBhyCOS8QgzNEVfibDAPyxAClUUIYpIKeCzBOKDG/lDLqLs2GoqIYpCKlOQXaKBIP8BE1dQ4mVacoU5XFGUoYiAlk55RS/nqZRMHTEyX2mIIxPC/wRgIIEU2ghdU0zlA7hc8yNzRAhcmEADbWiJmXG0QOvEQsEJAVI6OdniIQuyQ3p69Dr3VAgRnYIyL7CqIzVSXbNCggZ6kQSUQbkRE8yhCnMws0tdgsk/aJAF5bCSKBASiEaD0tGPGjVMVimRsMoWmSoqbWN0ConGXjq3UhqEY6mB48Fy9TJNXKI0tSRIDZaCg6KyT31HTatR9smihmSGklexFkiO4Ml/bMEHRzjCC6xqo/b4lSB8/WSd/PECJHjAFJTQ0gZgMICBIKYSoCDFJcZK0eQcBRWqwJBaPxpSM5Gos0ExFincY4TAyskfHACJDfBoV4wJxAjzYuI/jEC9mP+6JYEXCwAJFvEPGPxjAkzw41AucT6wDnUh7PlHFjbLXCMWRHIhwsv9IgOwRyGBJE4tSG2KYIQ6nQQuU4VtEdQhEBWoQwFIMAJdHdLSuazgCgRpGSiGQkiKpkApOFiuyxpRwebSMzS/LEjyjKUIYNVvMp3QF79aYAM9LsSARrABEtgyTaW1BTAEEMQ/nkCHO6CgLS+oax5vxDE0rOC3Ji0YebohoUv8QDnKQUpyD/Iy/350Zo+YWcQ6KB61CSSfTbGEcyYxCefYoMI0kosRkIAEdVTgFAIh73cHgoQWwHYgHxiCIOiwjQrAFpoKAe9684KLsCyAfexU5j+c8I9cWkb/FI3YpY03+zBLBeWDS7HEX/7iQodw4BwvSMMHnuCCf3yAANtQh2zcohIbqIMABhHEKdLgZZgqpLCsFYgytdRFFV6mcY2bs0cnlmOCJC8qdf6HdxgC2oP8pQhITgj3oICCIUD6H2UgwK1T1xYkQPkfYiiIILT8DwjEZSEncfQDBusWSqTgWzH+R7SFKkt4ivraDEned+p3JGV0pXBPyUEGiiBb0/7jWeQVxDf+IYgM/2MbH6DmPySsjlPcISHBvncfjIIEFWBjLgRB60yoXZDjYvvgAunsqjmjDHSJB0+lmW5QYAKZDpwDCPQS8UBoHW92QxnSBKADHQRRASio49GH/9ZwQU6h8n+wXC4aLy+kf000JuBC2gUTyy3JGVmG6Hcg0EA4EA0gmSPVQhHOE7flgpI8Ik+CGFPLQAYwMY6E0ubcZVC5pLdxa4EI4gn/GIIYMuxugrhA5R9Q+RDSEPOBvEAFZBjCEE7xDfLmiD0X2tUtXTFUNXt6IPoFikO+VWOhh+1ybIUY+RDSN0yUmiGLV3B+/qHnbHSAiUQPMxqOsG5IfyDr2Ih3uzv+7jIIpOsICbZA2PAAo2ADyoIItiCacG6QrKIVlRiLCESgiUeF2iChlrPhh58UcwVl8vtJxCX6lIEzoEWlCp2XG/5RaIFwXSEtZ0i8D/2NtgvkCCpoN/+UoVyGJgzhAQ8wgspKcQnIKOfvA/n9QQT/D/oT/4fa9hAj9EXDi1xiBIlwSR1wJLNBdOb2DUMgELL3D6h3etbHgAuhYbP3D00wBuyFBo92a7fGcmGncvghCjlkCoU3EOozYwZhf0axIffHS3BVEAunEAvAF63yByLQKkEiKEVAH963YQ+4De8GbD/4gAyoYT5oEEU4ED4Yb+ZmHyrQcU+QhOwmCB3nDfN2ActEA10kEKAmCvJHEFmAgis4Z0ciGRgxFK5gCcLlHxlgA/5gAG24EBXggCAHgT7YgLeGDYKAAg3ogAQBdqewDd6HBhd2eusmEGAneigwEJSgQsoBaln/4AEbEGq3UGNZMAsHUQxeKBDCR1RhyDAHFjAGkWqy8yciMDUL8EALwAgY14YaN31l94M+eISyCHLYkAbfcH1G+A2k9wSQ1noOESdQhgLrNoyIRhAocAQ4wIVdYwGPmENZIHyUsIkNIQrSqBDV2IlLMUCudRCJZ2rApAhmYEbEMYZH0RXVIlSWMAJoqBHpOA46+AJu8A1VUAVJw0JQAIFyyICnEG+3Voi6JhBpkAYocH1HqGsfwI/WVwEEsIQYowJp8ABH0Hrotw2nMJAb9o9LcHOaKBCNMAFaaAoegAqmEIKmAAL/kFkGgYmYSBCIBIYN0YXY6BQkoY3acRASIBDD/0NGCOFjDUEgQWIyiVAXlhAOUeQGVVACXDAH4SAEXEAqaPAAp7CHBVGEt4gNPqhuhQYBz6BrRygQLvAGYAdp6rYNKBAEOygQ+zYQfRAF3oACVCgQD8AEF/B7jfOI/yAKWDAQOwSCPkcQtxCTMcM5XrIZjGBmfmQybHIJpfMCQlACSlkO4cAF4TAH8XFNlNZuSMF1BIANBHAKEFAB3gBl/+Z11SdsKOBgQWFsxrYFvrhhLoAEuKBmByGNcrYBCIEDljgQthAUMAmYk3EjVfSJTJENXUGOxKEQTpMRlvAk1bIAo8A6SlAF4VAFkskF1BkIZuAPPrBuXEd6CngQ24CH7/+mkO0maS4QBBVAhN+gYdhQfWBwdgLxDWXQfU7hD613aC6gAK3gBSN4lwGnlwKBAx7wl74JM1HwJUQ2ELNCEE+XH4nwSpaQAhfwAksgBEuAAOXwmP/AAyHwBy3wABWAAhJYEFKJmQTwAW8AAR/wDd8wc1AAlYmIj6fgAk3QA2/gAkXYmVeWFGnpD1vQed6gDtBAAx55C4CQEI3QURMgCh5ZoL0kcUsxKz3SKtUSJClwCWkRnSFQBeUgnVzwDxJwTf4wBn9oeuB5EB9Alm+gnQPJdYIgCINwEir6Dyiwj97wlv9AB/9QiBWgAqiJEMZ2EJlnkEYwlz+XEIi1HHz5W07/yjAKxAGZ1xD5sxQ+IiSYEByb0gkAaDJPUgqJgAEvsAfzWAVzUA4lEAJLIKYtlQaDEJULoXoDeQpswHY+AAYfcAriSZYPIBdb4A3b8AaGmAT/oAYoUH0QIAh+yhBORXTG9qZGgAs0MAsmeJsOcagK8TJcdRAC0KgJQZMPNhAS8EuTsHgDoQgH9ng5IzsmcCQ5MAn+cReSwAAF8g8usht/VC1LYAEzcAS+mgYO0AV74AbUo1f0gTo+MJrsVogLeKsrSmkLqZ1vsA1EaGjYwGX1cU2q2Qd8AAYqBQHC2HY78A9pWRJoKRDeYAQlYJvKtRC9qZcCp0PXuD5FMQrwp18E/8qtSFEnN3KTLtgQZyKcBcIvqZIBVAKAB0AfNpAIiAITJqMNFnALJAADNuCmKAAFLbAHXCAEAsEFZhACJfAHRzB+LCe2p1C2z4ANKLAHu+oPKhqVGZaAhlYBUaACeYANPhCpcUEGgZAA/fAPPhAEPgiaDfEAgdoH+7YFcOEDKHCMCnANOOCSSNFRjIIKMUtBn/APmiAM/xAM/6BCf/lzeYmz35ppEGMUylALLzgQp0uvqfIfjKCDLXAJOfAHA0EMtCsWJ0ACtPAOM2ADY3cKECkE4TC8w8sFJdClJRC2ofkAt9huWiaFKFABDgBeQQABKnqiBMGiEKAAV5AA3vsP/f+wD3lwD+pwDzdAAeRVbIf2DxVggdEEFw9wa4CQBbspGaFGuQuRK/21kZZoiTcruklxoAUhQnt2RqiLEACzPKkiDNdkAGgQDPpSEb0xBR4gAAIQCsfQAFM7CFDgAzxAnSEwvHNAquFwAeEQtjPqAm6rj4JQCA+gMVHgANb7D3cQlS0nCP/mDwkgA/sgAwlwAzfQvUB8AydBBndwB3QQb7+qEFtAuoj7D0FQti9wDbJgGQHAHqrwv7PpLRsSPgahxQDcEN46ENnwD+SaFLzRAQ5TKCMQpn3gD+jwDzmQA31TC85Rf/9gwbxgC9BgCjawAwTgA1obwtY5nSYsmQjgA2X/+wDwlnLYUAYoYDdBcDTGRnYDcaKIJhdF8AL9cAX38A8/DBf3pgZ6+msE9A+yZVdPzG4CUQQzkAUrKRnTahA41LJhTBlPFBVnjBDZYJwz5CMFwABE24YO/AdmgASAxgDBcAI7NA3SMADFgAqugAM2AAUu8AAAywWBYLxJGQJzkJTlELbf8AAocGgnKr12ZQBE1wcQIMNBoL4xyrAn2gQ+QAJEMA9TUAT1QAH/kAc+em9NoAZmesl1+qextgWRmojFYAuxLBlZ0KSIWpcJobK3bBn5lxRPkwg5MALnwIoc0APerJQc8AO4QAL61QC4MAHmUAmVkF4QIAbfQAYsWggV/7AEWFsFW1oCPlABPgBvuuYCYGCW/qACRlAIhaACAlG9xuYNm8nK2OsCMUoE7yAAL6AAGFu9/yCsYJAGrByf3jDOUAVrB3Gg3sABgDALgLCbuRkZlFBUBdGfBpEFYFzRCjHG1QEMTzMCnaANJjSPf1AOS1AOWIsAJcABQBAJHtAKmbAc7BQkCsABIHoKoOkNPvAAbnAEsnFNaGCL5KxrDrAHEOAP6pAAVxDEpJ0A8QEB1RsETSBppyeWBPANfaAaBtAHBqDaEMDVgSqskLZuHQcBrVdKHEBeaWlNJLerTDADrBB0lDHL1wrXdP1gpDsZ6yoYPaFniZAB2gBoQoAAxv8bmf8AmdqwBzzAARmwCgJRAyLgCgpADJ/DARWwDcvWAqyTnWJ6TR/6kBWADQ6Qov5QCPAlAzJwAxFwDzJwBUit2jtwq9vQvEeoYft2By5ABmkQBGRg4RDAsdT3ptgrEDFqBEdjUDv6FmxbcnBjDAGw1k5xuTrEEJUb3U/BAQGGxnxxLYmwqYngHtZZneEQ3trgmEtwAS1ABQLxAz+AGMTQN0fwDFtwBOIwB0wZYi3lhkfgBuhHd1+5A/5QBHrwAlhwBRGgAQNO2kgNxW1biHw4nu+8uGT5DXUacnxYiAPtt8cGF1ZmEizkZAX1FkwAA3NtFDsEAtAN42GCSkjRCRn/QCDAgNd6NgI6XgVW+A/hEMJVIARmkDFHYAM/MBB4rQA3ngMP4ANdwJSSWQV/UAVsIOk2IARIsNll0GUhOwUCQAICQAQccAP/AOafLKbFFgSDwHXbAHaGdnouEIdaOaMsJwiFxrFpmqd3EG9/SAAQgNTaGeKlRXTaWQG76nbFMAuM4hT7ixTWSuhLMeNI0Rv5MTUjkA3n0APU+Q/WacJL6QabjX4vQOT4QBbLaQmjgASEKwRVIBDDWwL/UKrGiwB74A/t1gRk8A92QAKIOwXz8A9IIAM+gLh9ALhR4KMO8A+h9254aMrv1gRl8AACmYcEMATeIKw/2HJU+GQ+oA5x/+IDR6OzDxACboBH/hC6Ks4ht0C5grdDgtcIBMrQ5G4fCuE8F50BE3EmiA4MhfkfmvoCcwDvkk6d1Jm8Ga/aPmADHkkMP1AAT6J86iUIbKC1w1sOAyHY7sAFCuADrVpoTCAAdoAEQEAC+XxNWxAFR/wBdOANLgABRzCHCWuEBGB6DM6Bd6AGTygQH4C2JqsCcQjiJN4eS7YENIIE+gAIC+0l9buX0T3dCXRbQTGGgXLRnXAmkhA1FuE0mpoDSHCq8B6Z4VAOQkDzaaDaQRCR8lANEeI6lfAC3vDrAR/eVh8OWLsHCODvp9AEJ9ECekAC9vwCtZ3xQeAA99Zlx/oNPv+QBk0gllCG5gw4kG5JgVxHdx8ABrRHEHlYAS31AHtwbHJyXrHRPS8gDbxwDLTwD/s/EALQ/wDxT+BAgv9wFESYsGCrfxNMoRI4S+FEihUtXsSYUePGjS0oeipoIqGJHJ5E/ispMEMnlgtsLJgjsEq5cFVKHOnzD8IOCEG2IMH1rwAxYgtGWOrUgsA2QS6+7QnB5V+IKuHCieNi49+bLf62oPFnwECfPkHSQIDw4c6Hf4L+pUlTAcKDMh/Ybmv7je0/AnxdoIAA5d83gXfA0HEhqC9fxl19LPHxzx9BDlon0lAiQKAAzbZwSIS4GdBFUaKyZMQy8GDGYxxdv4YdW3b/QQ7/PCL01ElkDkmTGClEdFJgjkmTOmVI1EnbC3pSw3G5WmLJFjI9d+wI0odJg27/gIkYUQnYAh8VPhA4703BmxL/oHOx6WObCzRBxgQhs4MM/iA70vz7wK22hkhLjH98QOG8f54R5BSCtiEgsX8c/MebBJ9IAgUXBtrLrkIE2qIQH46Y7J8t/rHhhYlagGGWYwR48Z8AZPxnlmL+gUgWgWZECIfQBDrNIh0FSk0jWlqbLUkll2RSoQ48AUkS4QbyRJGQnjTBBN0mcUkBqS6oKpw5fAjinyB66mmLE3Cp5h9igLlEgVJ6SeMJQQyEcJtvXKjqgue48OEUFyCo7szq/85ygS0xBNwLAvQIgCCNvgRhS0IA+drriQcqKGSMU84joK9Qv8GrLyhUQIMDDh5owYcSJVNoMjQU+CcOiSSyJYtZSCgIGoGKWW2g0w4ShSCGmhyIFlv+WRZZZ5+V7bZ/artoEoEQmQjbgiQhrhZPJuF2uSUQ+CccP+f4oycz8wsiCB+YmAEf78BbQBwbthlCjEcVE0TPOf50p7whUPgHhTuGCPWOJhwUpIwGJ/zmlDHqIuCOfxx2S8CB3MKLrQ+O4IELKCp45gkC9PpnmwoqTOOBf1ae1mWBSuTAiIlsMIYVW1rTTMYbd/kHhn+o0WxIglAp9h9fBZpAFWEFmkbJZv//YQVaq69OEg2KtEUIkykJ0jYHLTsx4wghyr0qnC7KimIHM9NcsxtggEFugaRQ4HipPb/xJoRybXLjARSwwWvCZ74hYIjEQm3LhQi74AKCJ+5wgAAfQsXLrcUWTPnSbSAwoocqBK94GxQIOKVMCNwo43TC/kHjxIJeiCwhDmgohrNZbP3H6H/yGagZWYIdyBQgCfoEhwn+8QCHopmsGmvpp99I64lAssiEWkww6dtOJOlkkhHK+/ffEtItU/V2o3iomx+ASU6UF7whwGS+TmlQkAq4oCmcEl6Awim2gT/8keoUouILegSSBiEswXMf+E/pEJIovFiMDNvwwQWEwIX/NDSoCd5IQxRMVwEUDHAbgxAMrAYyGX9YJiFFmAErdOQMZ/yjZwgZxkVoMRBoSERGAvAh9YQ4RCIORBIcQcQkPCGl4nTCE8swwgPeUC5xUKUsAzGUmiZAjgIc5RIdCJRiRLWND4hhG2z4RxVCEI5ytKACevoH4QTBMYIsJVTeEIgR7rOXO+DxH3dwwR0EuZeBuMAB+0nDC3iAAC5EyAcP2NRgCFAGwqCAQgPRmtZ8gIYWqCghL4BGMeLAClpIA0cJgYVAjEeQVe5IRqgIwEFwMKOpZWQWtbSILWYRxCL2snqwwZ5GjmitDBjHmJ04ggq+AZ85VAEC/7iPTvbDAXO0/+IHl0iBDiphg7gQgA6hAqcguoCAKkAHAWVrwmL6UrhvvK4MosIGYW6Shr04oFLn2Qsd/qFPALnAkP+5AwQW8A8huAECz5hiCziAhiP8I3Atc9kDIuODhW4SdrSDHUKwcA3OGC16ArGFL5AB0k+0QhWtaERBfLQaVsyIBrjEyEd9OVOaJukR2MOWCRhRTO79wQb+9AZ0qpCTKJzJUD6gwSt0kAgRiAAJYWwLgOzojaqUowruCIENyiQQvGyDjGQ8BR4r+I8HSAUBfSAkFi02EDIIBI93SIPF/iOQFghBG8tQgjoEwoEWeGShsRtMBaBwIn8UdjKVIav1ZjcDgci0Vv+3GAg1jiGNWbQCByltRCMoQZCkFWNnsaGF72o62tEG0yLYK0AOEJGDnZoAESMI3CDEUAFyoqldO+EJEiagg0uIgBQcCOCdTqGvPKlgjc95jj8qwK+JPINgiltCe8i6VgcAdJD/8OdAKPefuQLUBwpQAgZKMFiBgGWFktnCA/ZghMnIbiAWTQgasBCNidhCFqmooT2uMQtonEYUqmjEai7QWCWJViDHuBFpFbxggmBiII/4hwkykIMCeOIPCBqZmBDSkygY4RXvsxcEPnWXR31gG1VoplWF0II04M/E6izcHPH3jyEENSYUqS52B+IAMxFkrgDyRxWEUIToSstECPH/gQoaqsID2aA2tSuIAvrBGSQRxBbDaMY/miENHdFiFkFS6Swx4oyRbqTKDEbzaNdQkDV4AhgsIcYRnnEKyHHBDWx9WxCO8ApgiMMMLTjFp4bAFzG8c4D/gg90/ME4hbTTQYlzwRKE0B4yQVO7f0wDj+8QhA8E8g544fGGxgABB+TkH3jAAw964AavIMS8sFMHtco7LZst+bwtuMYxWOFYWTRjGL74xyKcIQ0ZkqAY0DBGAG4xo6VlhBaxyMhIs5xmakOLCRnpxT+s9I8kZmAlDHjBA8RA1RIY9Ew6QQsEjhCDSyxgVRoSiGL2lgb40EQcJTBCECqwIQO5Ja3YmCMB/xygAB5g8ZkEicIC/5iQrf6jrToZyBwQEIkSlECxFHlAiTTeQhsY2XpStiFCaFHDfLCiHs6AgRAagAUa0CAAAdjhjXiJEGdcwzWyQNK0q71zjuSgIN7+RycEYglrUUQ4ygj6my3BCAUEyhsqgI8PHp7nPvygFDX7IAqeAPAPOOAUd9gD/9goBDOU5xkE6LdA7KKxf6ijCQPJxD+W/MyGV0TTBSEsB5SAADxEQgk2I8jFZ+YPrbFwWv7gQBEAXxAbcDTB9UAGJ5ChDywPAwb9gAErmGCDFBUBCTZK8GlGoxBk5LAipidI1XI4pFk4luevL8gvsGcCCP/DE7WwVviETv8Qa3UAEaYVyLeQ04kUkGeO6ihBFch0cJ2cqQjhrsAd7IhPvYVJG+fzhwoUFyoXUPIBad0TAdJQhX8ggFwveOZaKzIGLA7kmZE5kRuEQC6C0qOwGzks4Y3gXoKcoAH/QIZmqCF9+IccqodaUYDa4ICGKoYESABAWJbTiKUfKYgaqiGFuJEzS70DG4jWiIN/KBLYE0GVuBaBcDCBEAngi7AjSoaTqIWBELoFuARLaIQXSAM6+AYUewB1EQij4gBv2LdH4xfMsYmxMwOyqoDvWwp484vOEYQh+AZ1EIKYiIR/uDCL6Qn1Ewgeaxce60FLE4iEGwjw+ocSuABjgB3Dwwj/r0CDwlOIFmgAU0KGUBAIfbgBGKiHxTMRxGuBz0uALICIGZkFHpmIMisIaYiGLNshA+udCyzAjOCGEayp2pA9YoIwB9s22/M5hLCWTiSOoBuIBbAEUlgAJkCQp0OXShMInlC3NxqCzFkKu2in5AsHIUACrXkAB0mQBHGUtjCYfQq0NJKuMiwBH4CyPpirhqM7hhsDjSvDmBiw9vAIwBI8ilCVirABGIhDaRgAGIABLGCviUA8JkgABCABHAAWQkSIaTgG+kKIBrgGmyMIozEaU5JEEZSAgggfgRiHf+gAgXjBNRuIovuH37CEf7CEIxKIBXCFgTAHhnQFIwCnqNsq/55QHQt5gk8hAGwgIHWADwQIhCPggBOpAB+AAm/whs95gJZhyTSYj23whgQoASUoCCgLw4Kou/b7ANnhAH/wgfkrwxzQCq3wJIEwxpEUx2okCNmxASxwESw4gRcoEcV6lS04kRYoAl4KAHscCFNaBGQwGlvYgIIoBmkwPUMcCKPJh1QYM51zxHvEGiNTiBPEhEnIgIkQut/4uQUoBYZUAFMwAhPLwUC4s4GwyChoscUIEEH4BkHgAncQgnDwgSiAgC14pMhYyUdqGW94ABWQqLeApBdAgiLQhvLjAiV4gUxiuPRBiFIDEfIDvBIIBwW4gLx6FRYivIo4AqkUx4yCnf9MepWCcEPCQwIbOAFmSQhGdKWCQMth0MBgg0uekxa5FAhgQAhEsE6BEAGB6IRE0EuCOEiCcAVS8AJ5YALB4ZNmkjqBYD4IIJg4EgRsQI89sIkl4IDI6IP0EowKYAMoaLGXOYUkfIAjUIcK+IZAmAEEMAcFwACpmIOzmRkmEwj2Yz+CCKgS4YKGWiRcoL+gUIDc5L+KqA0SYRIO4E0iOYFi2KEdwggncA2gGQjXi05q+86VoJK7JAi7sYiDtIRKmIIpgIYTOIIncAGPDAfWZE93SYMSasxvSAP6FIID8IcjqI6uOAJjxJ8+OAX3xAYVMEbLtMwXiAn6G7A5kAoHei//m2RPhbtQuqJJGzgbcpGKf+ABJLi/iyiR21DK2NAawvqHGbgGmFqIibiFOBg5hZDDeZxRuDyJaxMIRy2IgTLIfxiogrAEUEAFVAABaNgA9zSxPSgoHvwH7JgLFzsFdQgBDTKDKW2XNCiR9ErCNPCBLi3JgpiMcsAD80OAMRUIHqAVJqMWAxiIKEi4rhgIJTjCOY27f4gJW7zTjGiBI/gKZzGCF+iKF0iAHYIGZIieVDql5amIIRGt52wGtLwInVtUJsnHi3hBgkyIBaCVSg3PhOCVDZiAeAAFCKADu6iAZjK1VYSmPmAKFAiCPdAgCYgMunsA6/EHKPiGiKoAmAlO/8kwg/mTOLMSCATggT3QuLNRgD0dQ5p8AZr8B4rLWCUgUY1APKmc2CUxgiU7ARiwBTQcKXNlkmGgBZu9iLdMV5qqBIRgAlewBKFFCBoQCF/QjAkAAS/wgSaQPgIw0yPAj4OrjlFrgiflgh5AWLLqQVeFFb7xgS2AAvucCCSYgTMsgRkQggQQgil8UB7QQ4IKR1ixSqNUAnPwhwSQuH/g0CKwDBs4kYkKW5AViBdQkZZVEsR6ARaRhtYYBjr8B54dCFySUYoYtt4BqdOTBW7tWWr7VUsY2nlNiAkABSoAhSOgGAJAsQoliDMBHagQAoQ9k6IyExIpEXUIW+oEkYGwgf8eYKw9UIAc8Fsk+IcLoL8S2EQVUQIueNaBELIlUIW9FYgL0IazQc3CasM2PDKkTIhovRrL8AcaOJtiGKlhe8vTuKGJCIXnLAhZSLCMuJHQ6tzZ0N2r8QVgQ9oJoAInaFq/WII5gAxlnFCzsTOpfQDWDIKMk51q/YcUmjWCmAwjcAfOewEzWIBlqM1hnIMS6AEecLd/QIKCW1WCiIwL4IH5QwAOJSglmLQ5aIGWxV7LvM/z+oeibBLDs4F+8NvMS4VhoCGB8NZ/KAbIYpaZI4gB2Fmd5Qh7OJL5fRZSQBYvoIITQJD5iKd/EcODc5sx8AGbWT6EOIJX+80H6IqRNOP/IzCCiWoBIygHBB2IGegEJJCuG3vbZZDj2mTejBKyjC3DSFCRF7ABBSgBIWheWy0sGebJ+s2a8qKBawCCa6iH1miNLGNfhfjASnbiTEaIS5gIVIgagXgHpvGAyAgVQXgGITMoMNyJlPGBnjjgqWsXBT4QEzHGvfIBJOCCEtgDNDaDxd07Y5hJLni+8nOP9sAAXS248hOCSDib2mnbXc3Y9giZJSgCueNehSAsxDNRTkLcighRhGivaf0HI0gAGhAAe4yFYUglaCOIHWLfZriyiqjchHAGH+IETR6ihqwESJUNpIUHC1C7BXoDAC4LUWW+NV1TdzHWA/GHFtAaDlCA/0AQgmo9gugSgipoASETAncwXElj1lwuPw0SggvIgEDe6BJAgEGeUiUYMILgAkbqVSHoAp+kKFgB2cPyAUWO0L0a529WCFehKwG4hlHK2WMgs7c0YoSQhaoBnoRAvY1A18jtQC3D553bAHZwAk2AgLc7GZWBDrRoOPZ7ph1EiP2AgLAFkbod2ax4gTXggsgsB0mzgbg23HJ4Zmi+gDnoASFQgBQZhwW4gJksgaBQAn9QAKAkCGPmW1WYaBaSYcLFpAWE7BbQCiToyVdNiLwbyckwtvdVCBatCGd46vZVzn9gX7as6kW9BQHwAnh4i4EgI0EIh7c46B6jCD1zleA87P9qlbQ1KierEIIcQIMXyGAECGyUzuBeLgITPhshKAfOqzjJMAIeGLCWLgjzE4ITxd5a3l0SXijJoOk9xcrBEAy8oKhu3queFIhfrZWBGIZ8AO3TA8DRhpYgpprUdhaAhg0BmAeGgAZu6pyL8YY9QIA9sO2EqA6BSPAyGVyRPAIhOwebgI9lEBMUK4GOywAWDuwNngMNUgLLznAhAGYleO5xKAeU/ofClsJjKYig6FX1di/Cu9Luhh2gHrwtmGH+U5GIoYOFOaCLybiJmJUSoYF+GAbPdg36jo2oBmIOxG9o4WSKSN+CIAF7DQDXxoUXcAGT+QC9eDpy+uKcRAi3eZv/Lv6JEgBgBAgHd/iHcpgKNrhoDugEafYfgeCCrNWGFhICEucCHqgCG5Bj93hpaCZeZp2I9ihk8JZhFaKW4MTe2hDWGm67P0IPSiEAb/iGLmC1iRACEmWCzKshJX9yNFvX2fhZhKDAjJiHsRSIoMAAGyADOxKISJOKwiTzi3im/+iCsymHRHOPNg8HHug4LgiErIiJDTbhGkYDd1CCEiiHE+6kQZYuXT0bM8AAZqW/gnhQ5jU8R/dJMnbGhHhWFUmcD6AYfXmCtmiQNPDpf1AALJgMBRgALCMIapjnUacpG06IUp8IhvwHbagESrAAUBAIKuAIdhhUELABMdgLArgT/wK4gAsoh0pzGzEniJ1AzD4wg2YqXjYaCDa3DSPYoJk5cXJRggVAg3NQAomrTXfwhz9YXmIuv109myIgcYKQ3jkAgAZC78FDa30XToJwATowI4HQlwCZFNTxhnBfb1mYDCSYd1hoBn1oBljoNSaXDc4AwX8wWprL3CrD+qpWZCPj94KgAQ8QiFZIGopgcYVg9YKfgE+wmbogoPPgnzkYtbDeqnZBiDKRP6tw83IoB3LRhn/xB0lbhn/ogZ4sAh4wB9REA79DADRUggx4gRKgbhdPYYnzVdjhgi/I6zJMYb4VCCGwP4soYyc7soqYlDLot5ORpAkRBBRAgYwT43GGgf/aoIEBgNxhMIQiWoR/cASFQJK3JzB8nx3bsAiIWB4qQAXjJwgQUBq4qxEWd9FVYoYUEIEjqBjGJJVn+IA5TWUyp91/EEPz33sOmImBmO3ZrqJO4IALgA//eeGM6gQ9n78SOE2t6IEO59X2AIhIQoT8K+jPjZI5kRDMKViQywwj/hxSrIhm4j80HCpy/EdgiEdBBD78c/HvAzaSJAX924Ymo8F/LxI88KcAxrB8wwrWQ/aPVcegQodyvPfP3r9j/2wlLaiUIw2iUqdSrWr1akUB7wRgDUqF4o8fiYw82SbmlCC0/7iEm7PEx9AgFYOMmRMOwb9y/0L8Cyfk3D8h4ar/zEHAwd9LNDb8aVOCAEGJEkpeKFByAQGuf4WFKOT8b+JLfxyEOH5sGoEQiVuIrjZykQPcjhsFPSFQEOQ/lgW/Edhm+5Tt2AVbJ8DiD8uAUPUKGurq/J/PfwP+NQfa8dbz7NqzS9guYN6/CVRxYajopFq1f8D+Fcgw4kHKlgUFbeNC2IwPuUIhOAwCwUwI4azV11pzvICEEIQFssRFn/3DwQtLVFHCHFyUIAQH7vxzwRwlPDaHYAgo8cdwO5jYR0E2KMEDh3hB9gJGQm3hzwsGTbTRcAW9dIpHFNk2Uke1lVHTahn5U0QCAhw3wE5H5QPddlgtklVBQGXRERZVRrkl/5dB1ejcV0KFCYJQBUjSCTFjnfIERYJ8E4JCb5HB31z6/ROEXA900dAc5XAhzhxztKCAEFzMYcYR/qw20REalYAgarj4k4kQqpzGEAJfCOGDojvAQYaJYpjojz/lKOFhhyUcNpRoHBiBo4MbTTQREiqoVdGPbfZIAArexDgjDTCEgsYw09XjiD1TdrmlLEE91eSy0XLZnVWfTKXJP+Q4lAIwyfyji0MFJPICbgV9IIg3XGxYBQcQ0ElRFHLxR6cPVYTDVkF2tTBCQWYgRqpDsdGKRwgEoTbQqTwIgQdpnZDah4kRSwxHH4rOwQMXkMEqVAsvHFHRrA4doc4p2/joEP8BJpmLKzbCoTHoAMi8gIwjw+hTEVLSWgXIP1zp/DOXLTgktNBViVfR0f94EFQ34SJCkSWdANPJESh8QFJBYmATzgWa5QfBGETtAPYF5QhIGBcvhKPEAhcV6dADL32mgBF6tSAiAgr8YYQZbpzzgg2H+bMDGaBKHHEfhZOagxJKcCF3Rwb840MLRnQUI44E8NhjQbaJpFtuFZ1SU0EbYZHPdAPo09w/Rv3jCNAVxTKUAMc0G13suUdLbUU0yIIKRUn/03RFxHdUQCf/ZDAJMEhULci5WN9hr59RQCBvR3b+h0AVFxAWDgd++Rujjh/H6IYRJdigDR8KkPr+P33Iv0P/4ocfTkYIfVBM6mgkQt5RC2xQNKHYABvyCR1LaLMSHrFpPlj7hzeKtBoaLAIp+kjFPxaxHN1JBRnNcogsbPFB5zyFgyYcigcEsAF2/GMeG/hHI/4RBodoyyGJyAAxJFGRSfyjE52QxAhsEAQUGDA3BOgNYdxRgiO4iwwVcWJFfLAELpSjCqUTkBlmBJPhwIV8HKiC2trghvcpqnCE8BThdnBGw0UMVHf4ghl2MKP3NehtFJmIDWA0FBvY6hTfOAmPAjkfAiTwJCf50R+3IDcjRONJ9dCgVDhBlSdV5RD/yEVQmvFBEWbHGSf8ZEF496WCBOCFTvhHNeSQLY4UYGqW/+BhRUaQAWBk4B+MeIED0KIbktwhBFVwBxfc8A8oRiEo/IlCOMIhjguEoAV++QMH5Aawf9jRIf4wght6sIT3baEPDmhjGuknToiRcwcEaMMcdkDGaXLsBQOUzT9uhbWR5Kog8eGIGP4Ymy0Y4Rqc0Ic9hhGKm1Vkg1NpjiPqsYmpYHIos6jI7ZxBUOc0A5QWpQgoCnLKoViiIIxIREcS4YkfMsIjtplnb/Ywh2XMYQ0FsRMU/xE2h9RFQAbySwheoEVWGWQLS1DCNPsgKjZUgX7z68MdrCCFpRJiqXDYQTi+8IWKGYCMRDHfHa2JBiRgowy8KQibtsEb2zhkJS0hpP9D7lAB0nFAATeoRy6ORR2OJEsfDXUI7CBREH78gx+WfF1HXGfJu2ayhBSB1kUTq9h/mOAfnnBsAfBz1jZ5w17JrAIa0iCVPpjBLuojTAjcsKqqbMEMeowfqJD6BTd4KrU7kIIVrAAHQlihqVIIBx56EAR/VHWdQUFDERrEkdXYQAEEuEPJilgQk5msImZB4DauVgYogOYa+TDEzYZRD0pSZBP6UNY/JHkUirBOKvfQa0FCsdj1stchpKhKAQqQAzqR1SEu+N4c2IWngryLTniCQBeEgIA//EEIe6iCD4Qjlar64AUvWU0axJmEL+BhqUw1kYVjC4fY8qANFBjDOq3/ypEahYwiktuIAcn6wCMSoAxpYUl957M5krikIAk4hD0MYQ9kLcJ1FIHEPWDHkb/ylR/o7cgh7pGLIHNpAEbxcUfEWxFatJc7/+CdULBckfUQwxIiIMrTKJKGMvQmdAVxQQUuEI5lPMhdL3UIneLVhz1clnv/cIOCp6IoRRYkcRCTQhO+0AMwwKHQhACDFFLbBx88wAd94IANJBJidlbkVXtUh8nSIhRBJLC5JtGNyT6gudXM5GYbXI498gHegqR6O8hinUGlQuWC4OAfI5yKlKs8rauYgSPJm0oB/tEB9vzDBi444lkTSIA/5gUBRvCBE/0TlDFQ8R94mUMXvLEx/z1H0yCpJXQI8NAGPCRBtnCY0QsmAYA54KHdQmBROSKhCkmL2CKA4Zhxy/IPMcTYIUWE8a4osrl/fMwGBZlOeJFMne+uTjusg/JVZs0cqTwpZw6ZqK4VW9J/dFQoYWbEEYAUakO6QAUDOpSC3/WPHRTEDSWw4oDM8ICYUGXPD6MYbOHwhja04QuJ2sIvllCC3HboIQUpwT8uVIUQd8RjQ7FcjwQxhAfGcz7YEEQRmdsRQaAALuN4K0X0oQ9OXJfVBUEGLYoBnVhLpbzaicM/ZoG7jFuUBVd2iJY50uugJGLjFFlARYIdg1rYAAVjDcl8vjGHruVlI3RyYkzH0Ac2NP/kXr7Exsyx4oM5PhUOTVDqyzm1BS7wgAd40EzSUV+eghijIWi4iG8rcgQSN10F28g0HToSSJI08IAoC8kgPhYVikxpERgvCCeQIY1/qP2izXKGJ+ne3rx3SRKI6MAkzvGAkjlEDIakOl9eIBfrVYTl9bKzZrwxua4Ih35SmC2KSDWHHgghEva3dmEaMiC8XIBCkyZfiggFGljOB+TeyVyd7y0X6DjE7QkCHXwACiQKctzM8jlEBRHUIy3HrbEdUTwZYVnFLByDxHWExUmFLBTD3ElfYk3Cr1FEB6yHJHgCAxzBM2gOyhRSLxVEODzKxwRFEPgAW+DFPyzBNqhDnlH/RQ/6wA5YAQpUTEF0QRsQxD9gQKAwRMZYGxYiXQlcgBIoA6nAHjutxrZRxBbUyFfRk0jwG0t8AHD4yIoVRMmcgqgRgAr4Q5Os2j8cH3s1i8X9FV5hRfSpYOzw0K+th0NIDUckAyN0ADHEVwEcAVo8EEl43wdUlv7ZBwfMFEf0gRuEgDgk0z90wTdQF1ZoBH/Jj0O8QA9gDIU0hIssREF0jYsc3T9MBtNRxDtlVa0AiUpczfclYG4Y0ALWl/dhw0a4nVBMiSOkIDIgnFA0hyUZAifwVVCo11AE4j/E2nLgoVBgoyBWBO+EY3Z0XEf4nQsCQwfEVy1UDdWhlUNMkbr0/8dc3AmAlYCAhEAwVUCilCJcVJOpUAiFYIAQesil/IMxOESHVBEFxB5FBI6XKAAdHNECOkQhcYQN1tfA8QizOQQy4owhXBfGzU5VdKRD8NU9kGQeSsVdoSSrceM3glIBMMIkAB5FwBIiEIMNpAEdcJp8PJDJfANeCMidwRn2BEEfBIg4rMUflUEPYoUd+UMLKMxCXIqLUOW1pV4JLINitAGnUFpBNCVHEKAhZU1vYIP3dUTWfQPWfIALQE+/UQXsABRFNF+U+OFQWFw9IFZQWBKUnZdDVNRLEsUBdInUdFRJJcKXQY2wAYMN0MZZcg5FdgEXqMs9OkRMxY8DVEhb7P+Bb6xfV7zNA5TAFZQAFT4GLpgGQf7DaT4G0lXIQW4h29DIFcCeVBQJB6iAArHMRGYNyqAFAfBb5wycc7jkVZRgtJAkYTlCc3hjYOpO8nQCTWpB4K2HJbwAcHzD7d0eR3wDvnTPP7jZd97JD35PMi2BN/hRNVnFFvgAGihAFSBBOXSIgFllQXIEbGYAjfCBg1CF5QDJyvzDHwnn76lFqPnnckVXAxngVBgf6zCFVdgMe/mELPhMc3aE3W2HK/BdSJECMIhAB9iARApSRbhJFahL11yDGajcd45BiGgGmZ1CHXaJGSyAAmCGjSIAFxykaUIGZlgbAhzkWihBEfjDEnT/QUdU040gAQHURkWWQeds3VjiBuh4zm1Y3Ug+iTNYB1U035FZBUtKhV1ShCykYIX+TC3pkCQkAiJyWUEsgAhcwjlUzbGFxEQOQRosAV/8A5sZoZ3sgA8ESDKpizekha90CRrQw4L032VkzGNsyGmoSyT4aCRQCIb4wx5sm3BVxAt0gajdAUvIYeeI2lB8GrOpxHyAjp1QxQY541XwQ0MRFHMe1l+23VWEAmCWKd5VxWBSRQvyyz/AEsdZgCUsgw08Q2682K3AIad1AdJxTyCMgX+RQR/sSTLNQQiwwTZgQwV00VWkZ0WoSAlYyI/23zK4gxBcxmkWhEA+xgzQqGPQ/8jeZVVWkUyZed8afgO/2WtF6OuL7VuPfMAQVCRW+OENUCNVwILF6WFBTIk1/sPsfKlz6CWudoUCBIUOdYQIgNQ/aGxBJMICjIINbINIkBVLNJd9CYJQckEIbIF+RIEPOAAVCWUFtOUgpAEAcgkHXIiiIsAF8MALaMhpLJ5p+MUIqCJgIAFH3OyXZFpP/p6pPu0hcYTAQqaUTMUm2APCeeOOSYXEMsvEZocyLIsmHMHVcZ9DnMJZyuEpuMASrNQ/+MvHHMEfBApbGFgFiCwKjFG0rEZiRAIPRMK6aswM8Ohpeki6moolqEg0gaU1/YPkFEQF1Feu2IZvfM7kigTn7P/mIf1kSVwFQRnCQgUFUuTDJhgseVFFrnEJN3yttHAsx93QVIzAEWhNtprsB2zkNzwDusDJ3XXBHhhKMt3LG2yDN0Cg3kpLYmQEF+ABh2SMEJjDCyhBfDIez+JFCbiDEthAFZjc/3QEGqjDRmodRYycqD1tyiiXRXrEHTwHMmyXVNiDxUnsBzoE25UgxLKuCopAKXDEm7KjmXWfR7RhZ4YAGlgRZ7CF/hHhP6DAtt7s3qLBEfQsAgjkBfSADZQDQV7KakIGGvTDFoHMfupKv53Uj/DbEalhwHUEsp0CFPjIRmpHxQnFBmFXes0VR5RXmOKvzlBfVySC6zpEDSRCDVz/AlmQxD2RhDv6SBpkXgUsARtUQMncHgSqw6CcVuyggdrgAWRYyBK8wLkuaqOehhBQhjoIBfkQAPpKLS+WmW9M4nyIle2WC0dgjco8h3EGhdhJAyzcqvympA6z7nsxjRFMHSEBB3AMwTYIqBgMyXyURHYCSQUYowfwwGKY0DmQXqrgABpMaiS4g0Bmhod4iBL02h4ERYyQzBC8JQDvCovV05ROhcn+Q+8BjSEgA0l+5B/jrw9XRCJcAkdUAll0TrbyZNN6xDcIAgRUwO0OUqdWgGjhgCqAggWMlu6oQAgQpBDYQA6cqwJ4iLWBcQnoBRJ4K0Ug7fjKhwuQBLNRIj2J/5oaglqZVUQaNzLVTkWsTkU9YJw+NAMHVsVycEI/VygPA40vjwAjvJcv/wMDYOwqDLK+htrAleU2VEAaeBoh9UbKNAEEHAE0LI0o4AIQOPDPVEEPPMbzvkBk5MDghnJhoAYCaEQ/BoXBmUsJn9QLm1m5xJhvAKdQkG1FUN2C3vFUJAtFRIfCDgUmNUdzzO8/3HMuB8VACwVIEUNQ1EBBlAI5MIMZzCkDnkxuYKcDBCznlC8KQEAaIAE0WIAHeIAo0EAu0hxrdMUyCBjpcQBq1ChrYgprbkR6xsg5qEM7HxLWYENzGWhQFChQB8VjYgUyNHZcVsVRY8XxRbb0KUJB1P+Cc0Q1RzxWR1xCIgRyRSTmP2hCN1CBAqwvA4qcmQUSGheRRLYxChCAEeCAFzgENAQXR3TvsixBoQgBEtjAFYwDhxhDZPAoahjcFpDzC5wDNvzI5D6prgxF5apy+nbFMPgELHwXVehh6j4HB4ZCzgiZIDaWZZ/QQic0RzCAVWvDKPxDKSiATrZjYdMzycphfX3DHUQgNFBCbbM1DlgxB5WDEGzhaEBnoaDKZSCAz2aEbjsE0uKG+TLtyAqFWUUPPXOED6CAERUzVZApUdzM/UoF22l32DmEPQR0xmH2suQAJmBCY20ZUVj1P1QCJfzDOmRAGiQrypDVNog1yjQQcNj/BjagwAPgwNGYwgbgwglQ8xguy0QMBA9YWxeXRgajhuU0OEbYAI80F0t430dMIqgOhanKWFokdud8A5n9r1Q0Ayz4xK0SBSw8UpSELjWSpHgXBGUrlmU/QrT8gkPkAFYQA1UXxCXUABPI6daNhMmUzEbyuH3Rmge8kCj8wxRAgw0I1+blDhoMBGUIgT8gyFSahhAwLsgYQQHdNAKVlYCOaJQmkI9ThIbHk8kk8YJKaFVUVAVShFPTqh9391MTRVT/Qga0uFV03A/8wyuMdg3gAynMnJssKSClBVmdcFDUxgNlQStkAfAoTSvQgIPpyMxlapcUyRFcARJwgBIcBg8Q/+TgooYwmbHleBVaTWkbxpPmUoSp6oaIVgQUZJ4LoPpY8opD9AMnIFws7DpHpO4/DedEme6vg5LGJsKwUUQlePYlQOIdEBI2eIMBma1zyYf6maxJHIEoiMItsLUoBAAoNAATVDLBkXOXEKmnG4Y/HIFllIDQcWGTp+IR+MDA0dhkXQXW6ca9m4z6zZgKAz1HZGDXCkWeH1TOGEI9NAdSnPjDt1eyu3cprIMR0Ia9IyB170rZ/gjXPQANZDspFQQozAAQ7CNcA42nLwHUcUGhaIOHjPpQOJ2a1zNVaJoc48oRYQ2+skSs68piqwwMTInqgFIuRD1Rw8LEhm2ZVsIqNP+mqGUavu79f351S4iaC5Q1PeBAFogCKtCAKfzDBqjCCZg6o+x8zKuAD9BNQSCBh/D2BXCB65cOBwBH78VyVQz9PM8xtP9DE/yDZh2zG1ZiSzQXRhCnCd3D1DuEh+MqOOiaBWS9JmhCJCCBN6DAWVQdVVxn4hEAFDABNGTBLeDACwXALTRAsSIBjLjKRMD8djDKEVx5ziIAEww4ZoUwRxwGQCj4N/Dfh1MDBQ08OJAAgX8JCRIkMMRhRIsEP/xLA6XiP4cdxTQsk7AimH9HACW4+G8AspWLBuZaOXOlvX/6Bhr6F4tmT58/gQa1CE4oi4tjhCYlSApEkTQOMybd9m//alQCD5hA2/AvCw2COI4QeIbkxT8b/pSmHYjmQcQLJcYpMVPiJ9q2URl+gCrowweSNCEC7YjiiQsIKAZO/bdwYEa9K4f9kxYRp9DKP13+uzcws9rOakGHXjnJwT83ooFWaqRJ06dOD1C4GDLzI00x/24PuWOkgSiuqAYGEOADxTNsSI60OIsW9c8jD/xt+WdGSJEe59gMlL7yBQeFFkM2PpUwZMOOoJ80HJj+nwvcBLedUu+RwBOg0lL9bJZTKKeBlUP5x5B8mnOkOdQ8EaoWT3JgMCKkDhyokYFcoUSbfyxpCyOfFMOoom0KswEaUTy45Z8JaEAliwfkc0gdG15A/8sAgraLkCAffGDuBSGQKAENH/6p8aIX0AgSm/MKIkCMJwR5Aq9/vjlwiCCeGSgNI+brySFvBiLDO4uuoaWnejILxZl6grLnsrQ2sdHNoZL5CZN/EHGzkn9AWemVgZipxIcKFotvsUEjOmg8xqgiICFBHDKGBFRwCE6AYv4Z4q9/mCgyyDctQuMI5o5QgAskvuzpCO/QiC+hjEgyj4BTomzMvIgWFUoQbBqrwAcVIlJvvijLiHKbqggyEKj96oHlH1gm4/QiZBaZTJr9BtrEJmfTQgdboC6hqZFLuhnliG8I6HCbbzqk9aGLICLgiAs2oGGCLLZCxZh/mLxtIHVi3P+204FaOIILNEqlKUeCvvHrr3KR7DUix2aCmKFen4xoKnMHQpeqD7BBjKDIepp2oGbyGWZaArGVBpl8rhlIZcr8PdCSmP8RYaU7/9FEBE3GFUS+hw5FiCCfiYbvPYgmmOCWSAfawBp1ytPLPiPQEtJZIzT9xwiBrJ4p6y7C67U8j+BzlaDbAlOXUY8ERYwAXGPFiNyGJy73oCAK7qmZWJDh+5/I0HRzgIFgySyVZgK8CCaa1sTWhH9ymEmBP5qT5KdScCaoEk1K+aeGf7xhWCKyqVpXEHSxWVQQkh4zBxUa6kXFRBrSOEWvD5jMlOZ/XigryAfQyDqoLaCwffWCZnv/qK+CkmyMeYnzSvL25/+5IyO66S5doozu+KeMnvL7R5aBnBkGlv2ofZNAZBofHObdQVvAAgxRYwSoPC0a5Z8FXjknDYZ+dhGoeOQbYjDUX6bygBNAQwC+IcFAcMGENNDBId8jgAqo1rU3+cB3OCKYdGYUlAeooF2KGprpLFKrWiGkNi30yPWUkr20FENMzdgFp+yxCHsMoB466YmxaAJE+K1EAa4YiAIWAJo1/KQbpLgIziqRAw0l6iIXM9rqICKIYT0hDePAxYkoAQox4oAGQHJBRb4xBCQwx19oOMs/gESjpGwhdWLwi742BD3n6VF6fbwdAdyTlnQlhnQROcJA/9xHEFsIABnHQIYz/uGMz0TIJflYXESa1ZND/EOIQ7TI/P5hRG1YghSdCMoCiDETmwkFXBkwgl58tg0kfah0BvQZDEOSBiRgABSa+IcFKPGP1z0Ad//AxjfkowDvbEGDEXIjWuK4qaAwJw2AeoIsBWEp2x2tIAkDz7pYGB4lfSRstEGUw1YSpXNeZBb/EJMzjtGsSdrIETbhB3/Uck+a8ARbAgmlE12RxAMlIhGrtEi3CNItbVyCFJY4Agq2sToCRKViM8HjQwjwAlVUwhwDsYAXGoGKsHBpX09QB9X8JZ03ouaQUPpINhklS4xxzJgN0QvGBvmYnjShJxfFiO0Q5f8x4RHkXgSZp5s20UNPvskrFvFnRIyYlEt0biA4QyhBNBHVS3SrIUXLmKHQmZfkKeQI2uhlN/7hhU9sABpGYBRJsLENFSAha2hho41soClmSjMpHFDHOX2WJcBUhLAWURT20kaHoJSwI+TqiZgiwk9s4aRxS7WRPwUakacGZQQEmVkGfGIJm/nlIYH5wG0+EKVYDVJo3kCBDyxxiUpcYn55yoQNHHC6+AyLAApoAc1OFaEKiAFt62ItGod209JZ8SfbgGFjWOuTD4yVeYG0bEQ4UY96oKyTjkjfdZOS2dCINhERAUZBZ0KKSlgiBZewQVcP0hH54Iomgj1FGnKgCVL/TGggU4CGRkaC0YkgwQZ8ddYRfGfgtMR3dGRD1HnMZpCMTIU8G3pVRPDoA3WsxIQhYRQeb2Mfw4I3IvesTCIHwg97+I3Eu2PAP0YhAksk4qr/uMTMVsLQiNBBMcmj8EOiVC6jseu+RjDFPyixAXrh7wF0wEt9NmxXZ0mnd3cVjcdoczGNJaYiaJvol3W6x5WcYioo6N7DqFfRlUTXsiijSfog2RNO+LDFK+mEKWcygq3eiQE1Tk1YZwXW8XxvxImRT0Jw4QEU/eOBt8DCEZrQ44jk7U3+4MBKmxMl6qbzUgxJ10vBWTcOf+807MLo2uoMFBjgk9F/u0h+/APeXojm/3HKoMkIVinen2hjtv8Ihg1coEKGJMSKK8wYIaPyAlFswAMbwIKJbKG1vgzLmAbhXcw85Q80NDMpsJElUHyM0Ym5R80yxAsKYDjR9jDvhTJM9T/y0cmeVOZanlyDtmidA1v3pLNJqQT+NGEE196muHisjdB8JpGGbKMJPsBCK0hgon8cQxbWaIGZTUg0BRzSypzyBwc7LhRoYqNFQvmyTw5FNGxULGEQWWev4svmb6qZIDf4xyL00SZPwsIZsADcP+pxSYvE2k1L1EVocoAJ0P7kc0rpliWYUQRvpAdiT2KYTyvm3H9gJSLj+8cMXmC9on1DsctxVtaC1y+WRonmEf+xlId+cjqfYIPQA5lN3JjXobQJULky/4dKBuDmIfKtfDCh87uBYoJaxKwREmT3us7p97nVJyEzuAUtlMUKViQACS64wzbjQwcF5HVb2/lSyKeJFg68fCXZlJWIZ/INoh2KVRMjyDnP41hU7502j6eJ0JtjgD4Q7Cf2CBAnKrutWeNbLQv6RwbOMQlnXcJmSFgMFiX2jVhtWoClGwIHriELZNhCFov8hwKCMATHJCQ9a+wJ6pMSsJMMVSmaiibvj42ruO5fkOouJEEqwD7soyOgArUm6prQxd3S4h4OTy3Q4Lfe7B/MBPHSwn6Wzlm6QRMyBIUWZTxMriN8oKkGghX/BAAGFCANnkA+hmAbyuAxKO1NSuW34A8oPs4bWG/2YCjh1EIBBwJXXMVVvm10ePD/LCKRDOEQ+EEmQgMCC2AllMURIOnnKLAnHgdycmAcLpBTNIEJru9W+KgnrEKWyIAQHgAJhOAfbMEWAkAysMAH6OAZ3k4x1KEFuM1NeudA0AACri9jKobYqGL/YM95eiIIVyVKAmO48o6WpqI+CoK5gELu8C4i+sEQGPDmHBAzCMIZeKIBh2jW0sIEyusfOoARdC0tcGZPBuISmGEBWsoD987YBkWdoGRobqMFrsEW0mENWcHmtqF73qpcnoDAZvBAcgQNMqg50AAK6KOQXA6A/14F/xZLPgxFsCZsVnyvwSoiB0NtGWkiFDYhF/RJLQxgCyCQJmwCZGYiF+rNWY7OE6oQNDKgFnDMTfQniUYCImQvbcKMMRgjVkgCLVDBFtqJFfrBB/xPIp5ABT4F2/zBH95oGIMigCLKBx1D61ZFDKrCST4gCDPCBWguzCJiO+7g5K4x1GYpKgJjCCPEALLhB7bFJoguNBJEJgfCfiLEly7kE/CnAwWRmxAidVIu5YbgG5oAApigAQRgFlihAeLgTyrlVaYLN55BAejvTYJHazhgRqQsNKoptS4MN7Lnw8plI+vubFainAjCBQBF4WoPxAzrB+cjBYnGPBQjIbgEV/8EICJWLWb8A/gIwvjWkVNMoBekDx4zYB5Dg6oiQhQuhA5Wh75y6jx06zt6UKLEoCzyMi+NYIIewlIm8g4QYyH/ASJBAy1aIMGawyArQgchhi9cjz74yBE5zLBKYyAUS6fwopj+kMvY5iChB0QeJiEOAgsIgjgvIoRshBMHwh4CE+gGQt5obQo9YCA+IVIQowXJpvb2jgUPbrmWkQOgAQaKYRjAjlfOw4L+oQJQysrqUClUbzRtJA3ipvYWcc2kh82QBP/OA1dOwT6Mjb5MyGE6QpYywuCYh+3s8B90IAV+YhMcAQktojnhDTqbwwyAYonwzF8m5BP+QRX+QbFkqnT/GEXNZHND0hNIhKAZwgQNsMwi9KIWO64Fogk1osMfsMZG0OBIwoxuhJNQaMKK2i4i4uYOZC91oLGKtCcRG6x5bKQDbAARuDAokg9+wGEN3jHVOHQgTMEYHqpAySa6/DB7PuKCWiABjgFT0rO+JkIdKA0NUFM0GpJ3WipCgOQrLUL9FgMb/scHHmBGezCsUM4iIOqA7FNAv9Q8xOkJxEAQeAqObEQR/sGJVuAimjAiIIFAKNQiAsdGmM8nOoFyuGUgMlQtOBQELuABamMR/UL9GsbYnsQ1T8EfxAQGWiB0sq4gbGc2lGkg7or0tAM1Lq0qQ6M0psKOSlJIpXElVnAb/wt1JarOJ5P0rbzJeQgrI8qgUQ8EOX2iAyp1IAgkHIFCOWlmAWwyKIihFH9iAoKJqP4BBXyGXBgFKgiQdFpTSHFlG1AAAhQgAfIBDbC1UL4ySlRAAd70H4I1QhzSBl4QNFbPI37mG+jLIlpQMaKiDMoySY70Vew0IgBUPT6MbDrirehjPy/WWV7BAhiUUjugOTZJKUBVLToBXXsCGEKjEVDxHxDgCBSGL5hnRD0kNx0ibc7lhVCAAxJAme6RY89lVVbuFJSxYH3ACBBWORYWNPx0FvXw9oINtXowoi4iSL3TJ7JRyHLKuIYMfqbTIrJhCpOi3+xHEkKxJ2TWIvjrIv84dAI89KHeVcCGgEnCyYUOslJ8JiSkQDrUwQ0cYhAGIiSOi3FPIiKKxAbmNDSqxiwOZCtVYAgo4vZGpwWx82LiKoYOpSIUK4WELGzqU2huQyXdBBNe7B/SNgVA6R9+oFLnZCUE7yIOYVObI07+wXISLyj8bCW04cj05B8+QRToIiOewG9XxTv1Rd2sgiAidrkKzBppwlImqnSlFj4HwgbKEU5foMCc5SAeIA3c9WwJaXGdNS+6jCACsQerF08dQ8guwj78zl86Sgd+SUEJYpVW9iJMjHfhZ1RnorzKdSUUc24JYkIawXgvYgJEgUvRTSF0UNQkYm0Oi24c4A2ABKL/KmJ0bY9shmB1/2EO12I06Yo0388YRTNCtqACAuM19dMnFuWwMPgisLUCHqOFUI1tUzYpFsEvPcmAa7K8EiGBaUISMkt/VsIUHpgmXsEULuAI/BZoDOu4QPYn0awMXOBPrMsPR3h5/uEZ7EMgMM0frK80CcIfXqAIwrc57uoZ6MAAtxMo6rcaScenFMM7nEx6gvYLV0JbI4KFhWJm+FcU6QQYYiAGjq7E/jLWCEQf+KEeYvIiJNQiliEokDgiyotmldgn7mQUblZLP+HIGsE3VgIEVME6C3SdnteiGNEqAksQUGAHCIWwCmvYlschTuGX51BT0CBKFUwpjmDD3KR7/y3m8a4nGxOlwti3wqQZLGWlJ+UjPnyKCGliRsaRkN8kgCOCAQxqJUwsQi0rbkXDiZ+4J0xhAv5hA6pY/QT5J+oXIt5OtyBgPAboIoprL77swsB3IDiACw15JT7OBgpMWIEia5Sx9agHzZ41dZn0YaQ3QC3CsdykoJOCZgmiA8DZJ1CMkygQnbHFFEAAB1ohUl6ggo807oAm4Q5iBSvgAc4FG/yz0ILTbNRBHZDgHEbzCNZYfEnFe7EFFkGtXRiC0PDvFz3ihy8iErVZHJt0JerEJyKAbUM5lJ1FFVK6BWyHtNKiokmnAxPiT/DCcRumSLmsl09hHDjAIYvgQGwAOv+ag/XyiLemF8zeoycrJo+ZlVO4+U084AT+oTDBeWWbsFsvYkptpN/4bSBI+h+SOC2yNCiMFxXWsAV4mMxOwYCIbFDGiW6mByXv639CgnGdufuGjbCwgWDVWJlFAwnimAaToricx8s8DFppZZw8QpxqT46XagUSeSA6QAdW9gyAwiX0YQAqAxJiJnPCeX/KdVTxxyeYIWeC4gIaGBpwwAeMR3oFCKaiJ7oGdDYcIp+bxP8sOovB7BTOyD6UMa+KoGDTggPcT+S8t6XXpa+fB7wFebTVDZuUwpvBiwk4uqNdEjSIuDle9yfilqAIYn7WNTQiISI8NCKgoRWc8mf0G6f/Zw6jtqECvAF94U7d+HjYPCJ5KOKCCMytm0Ny23ihB6JKWtoVv8cfV1vYXtGHlQQ7O7wnhHkmCPxAYuAnEpxtk2IeJ1wtNllLI4VpjJcDPhfDqgdRpo10lCTNwOkxXMsHsO/xFOOm3Rc3K8IFVOCk/kGhheJGhYJLosLv8ILcmPln04wAU4vCzsO6KNeyIBXJ32Rdi1c0PFS7G6EVtFsVAoC7OQAFCM4saxjCepuczBJiX6UtagV1KeKHbfs86ICzu0Br2FigMW1bbPssJV0/gRJ7/Xx3bC7F2sS5KZAS6jaYRqHBFVMoViEiTAEaFMAHJtZF2W3l1Pehgd26vsEF/0JHzJZ2NhYiN21PwJnnpDQ6ImbkCA4mKEqWno8VorcdyC6GdVHDAIZcLYjgH27XTcCVAhthQiD4H24dKLSbaSwi0XygCTLi0FBobiQiWXP8IwaJAJJ2Mb4sJbdphlGIdPRum0JDOqQDCjQF9SAqf4Umh1sa1CYaIQ7CsYLWk8ZdyAmiUss9KRBBsYMi3UlsQvaksgliFFgeKOR9MXGBHoCkL0giCF8IrOtmR5HUZ2SjXUFHPiTmePCiQ56Vy/zvaVEj2+jPH0pDtYKiI5PUsKaH3YZ2fTcS4Vd9JTTlACwh2v6hz9EUCA4860HjTipBHC5iFFReLVrhH8yBOAq0w//OxpdVlyGyOYU8cDG+4RnQ90Ud9oapPJpBNstTkgmqNih8YNR7sEWBori8SoDw6En+9bQEa179fAu0dQPOtE7G3llCWimanFOWgRmCQXNKWSja/h+ObOPcFfb6er3dtyduZRtWzkjLQEMc4577Aqoh5tvfRotohQlmOynQAg3uCgVgPyLi10XjCqrbbT0M7R+igO/MVuJTrUheoAVS4ARY4R8KABFSFgierwOqOjSumm01Abr/QRyCQX+GNyk8VBXmwBXMmsyY3dRaRWRNbWRdJV5P4TAAYtu/bac+ECDwLyFCgQoT/kOY8MkpAqcEOUz4AkmLi/4uevzY0d+Rjmj/HCI8+cTjh38rV16EOPBjw4qnEqKQqdCix284e/r02fEnzi0eUZH4R+rfCh1AEupIlPTfJaE9b0CiijWrw1IOLSTUpukfV61UP61K2OlhTJ09BREw6JIlxJYxt52Eq9aiQLdxLzKUudIbT54eE5D1WJJDSSgxE+L9aLGvQ7tqZT4hYHHImyA9fx3+DJpqSWIOVzgE1gHYvwJJEekgi+jijdC0/1WSSSls7Z8YFPgdglNQzYcExGB2yFYnxIMHjX+o4KMMtokoE2Lz+ZcvTRf/jhj558jev42hj5xSEXFgcZgeU/5tyFzmQe4V7jg0kLDA7qC7d5NPmEJUr732jwii/6hWGyxUbdKfRyN85EGDQvlG3D96ySScY285ZNBHAn3IUohlPODNcZHBNASIH8WF0Ac8naJOSQndwMo/HITWxSliWKheS+wlFJlMdr0nYZFG1pYUav3lchGDCeVzZJRYRZKJEif8c1NxCQ3n1kIJDXFQhR/BdFJjFjZBIkTAnRRmQx5lJ2YF/wwDgzT/1IMRB/xhhcJNKwpVRnJylenRG3Le5xEpokjJKEdYrUCEQ5d41QFt+n3mZKNSlvAPEv/Q8WOI3L0XKk4gklqGRwe5VKqZjrHUEh0+IHHFP878M8A/VyjwwhZ7+vQACpIlRFlPCHF3UVxEyiTjRVi4ko2m0v+ChmCji0y7WytC4QLCPwpAgAJDqYZokZcKxVdZQmWA6CVMH9zE2EtifIPuS0Q+8U2XUNzYTD7IOPIPDLry2uxPfr70z47JvchQvgRsMy6xL7KF2D8y3piQBR6k8A8o2B5Z8MciT6tKJg5pi9s/RjwQZk35qveQZKziFdeOfyoExQNbvmqQcyHSHOI/+QJXgT9xCNEMMv/oo88/nCSExAu/BscjTjydaJ9kgghi1wdPuFhGE0AlhHFX/zwLjGqPjGxkpB9VexiBFyFSKduMQqOKT6Z8gosN6T48LkTDXaTcQxZR3NM2N3nzz+BlPq6WiQ490YQLTRyBRAI1fmSIeEj/jBTyRX62unNB5HrkFpb2IcSGUET1tOg/cAuFH36aRpGVaRdFmgIw1VxUt0+x2U38P9dAidMFDbwQr0nEPgxksgoB7RN3BiGk8x0OU//WhnRdJAYdgqBxTZ2pKJ2QNPz8E4o9MLwgdUKhc4hdubH+c51c2GyNZRm4//Q6n9RCK1Mr0p7mlxVSqIYJTHBI8LASrdX8o25rKx5WVKGKRmAlVz1pxQxyZqHlLCdhENnRZbr0luGQaUsKq8kp7OINKAynIiQsVwkxo6WPoABzCUBeQgwBnoTcI2DDgF9WXGgumqQOIYhLSAU48w/b0cYSWgngkaQov578Bye3SEilOOYQ/x2kwCta6UAHYlC3B+5mGVLK20VMBpoZGCENCTEOsRAmF7UAZxtdyyOH2OOjh43hhceR2c+EdIc7CMIfA4AS8nKVq0XoQ5KhuNMLNjKdiOEEIiBi0YY88sQoqWYqCUHEDxxVPCxm5UoFImMZLbibTETCI3nDhUN8mJVzpGE9xFmhm3RCHTEhhz1u6dI/vFGiMhAAOFXj0eGa6ZEmbKEQCTgEeJTGIH5E4FrO8oFDBtcW5wGJJ6kKlRUlFKnYJYSV9/lVAftTMAOokiy6cwgYpbU5WHpkUbYUQgI4SJVZJMBT2PhAh6A3szxu7UJy6ZBKBBGXlWzjDmkogwvmErSEHf9Sa2/xRwIWcQ2PrE+I/+hHQnxwHeHsxSecjElLvmHQYWkFY2jQ0y+YcE+yTG2e/eHpR8rmkAgp5ScpqGdCTimU4dkNB0aaAVZ2cY0GXCOkvgkXYYA0hJWYkCbMCdxLgHnHqwoCBXcgCHF2ZDN1NU5F0eSAAq5Qj1wBMVcAk0kFUiLOnqCVJ8T8h9hAA1St6EeNh3kno3TASnb6xGO7uZQ+jRTSi0gjpMVowD8ewEzU/XIIeoGeQ/JlOq/hpEMfQNZC0npHiREupR71oQ9vJZSV+kSrqXXI/x6LW0ZF5Sf6OcNShYIyshTjJ9aQxgCuIY0ZKMAFXNNsMVlSE+agy4X/hzsIDWdioXVlt3E+c0gX/lGGD3CpmT4oxD+AGIEBADErhPqJW5Y1rQb+JLAWRKBDFIsVC3SRsT+p2xkupQt9GiMhzPjMgC9SAmNE9h8DtlMxYMAE5sZEe2+644lW4oJiDfNDMrPLM4jlEof6hY882cb+wOuPfhxXYFRB3EplaiHu0MFuCNptgHOaW4vJb35GzYorqaJUIOcYJw2ARkIGPFXjRXaq0uDFNWThg4Oxh12Rk66VxfsPZpbKS4z7RwXM9ZHo5oQAZPjOk6gikBVSJHqfdcgbYAya0LXAsf3NwJBBMwWfYCEhvyOLfgKcEN8+tlsNWrJDpGHZW7VADB/4/wup7igQvHRtQ89k60fs45CLZsVyW8DCADKFE+CUSwzbSKtsQ7WNIBj2M2gooH0/EsE7h6YDckuIHS4igo/UQSuAlvVPimEMW17kGgdmcEhZzIoEKMAHYksJTAiyEgIos0Ld25FBtgEcFTpkR+JClt+6oDMIdPkjPkDWQ1TAAcOI5ycPIySZxhXt3RiggL+qqUcegQmhvCYGviYbVRSLVK2QUiYBFjSd++0RbRk5IQv/xywObAzLSlbJM/BHE+5AKB9xyNrQ5d9gPvQwL30DWZIJBxeqMG6PzGEJHnkABIwwjBagwZso8OU/8ApNQbzMy+CdVuj8lpUzSEDW+KGvTP9ooBRW9rgnZCRGDexJlV5P6wKgEfY/rH4YWSj7VW5RmErOihAl7m8iJjmJ4mQSjiqUAyvbEEQQ6PiAjhBATletoWfDvJ/Q2Ds0W8ytAfouoXru9h9eWTrCDxOAiwz317zoBxquy7OPZDWFgkiRhb7BkJBPGpweqQKxMJ0Qd/yDC2Pq+RCuA9PReqhxh2/9RWxXNjS8OjQIwofwOnDwkc0ySsX2iDUiy4uBAUmmLhnvMxt3EL48pCaCOBROSJ8Qc18E+qr6xyCgTRZNur71JLFRg3B8EUrgmDQXyff2P2Onj0hjssNVGhp4AuMh0W8lyrFjHp8BETyw/CNqr+NhSFf/G6tGO+f3GUGBH80ye1HSQE1BgLXRew4xVbtgDMhwDe/wDwIAeAPxIpChUc+jIXzBTOHQE+EAAConFBLlE1D0GT4FQA0oIfZleNgSZC74EU4VUsSWEAu2YAwmDe8DBXTAHdV1bTBDP3/xPQRgH32wAAgggv8wB9PXhP+AHhdhchexBxhCPDwlgDSYFUbHha3HVAlBGDu4C/+wdUEwBv+gSNsAAXl1EWmYEGPgAAnRhhchBOKAE2sHGtJGPP5wTl8oFBiTgQ7hhbRRKcHjAX0GiB9DCzhxW4sIicWDBiyoT+AXiZeIiZnoERyAO1JUEiUxiLTxYxexAZpoiqeIij1R/4hRBA4/gXS0MQvAk4qzSIsIx1OP6BEvkBUC0BO35hBu0xP4VYvDSIw5doA2AlQylxCtSBaveBGN6BOjWIzT6GuUCIhGt4qHcRR7Ro3d6ILZaIqheBHOeIHeaI7nmGN9MIk4AY4/kU/oCI/xaDfrmBDiWBt4ohV55hGzI4+7oTP9mIrtSBW8+BkxyFsAiZC7IZD9JkULOTKElZARuRvncI6okBBHkRDcWBsQiRO1Bo+J1xN/+HznuHeYaI89QZBakZK4RosS1x/UsH8OsXgX4QqBoAD+kAYXp4L4AxFwOHqhEQyrmIA9YY0+8TpF6RHo44JIiRWW+BGV5I1TpWBHEv+LHlGVRjEDMOADHDAI/+ACdZcQdOQQ1DdfZMGUMnGWr9co+nhnN5KWHzEM2MKP51gM0aB4F0ENDvEOxXALtrAr/vAAb/YB5WJ9WUR6FyAOeFhFmlKSQuGH/yCSPbFeiyh7oDFEmqKIqRhxnOIROygTxdBwIHkR1vAPxRAAs4AFCUALRtACLkAHQJhZDnEoT/gT6DAyQRGZA1gkpqCWbxklUrSFOJF+EqkVpHl1WpEODkENxpCcF8ELrBAAAVAMAjAACSANRuADUIACqJUQMdkdCUGbIJFFUZSbgOWbH0EU5XkR9+SMGPkPryGM6ikTFZRUI8NvCOmSM8CZPbGfStb/ADP5E8Ygmh9hDNFwmgHAC7QwDNeQANewbA5ABsCBHwUUDqI3NpoiT7Xhm+RInBEZWZZlWQLjkj5hgc1pDHmZEMVQDMFnmtPAPk1GJ/8AC1iABUigDvjxAmaAEyHwEaqUnj7BU42JKM2yBWiwBec5nkLRRWzJlk6JKA4Raxe5gkMBmZApI7PnkP2RmYA4og7Rn8MGgenzD0bWD2XoEQFgC6fpE8eAPrNgC+mTD+JRPsYjDRRpRB8xdEaCRZV5EfSVgEh6EfK1TjKRAkLlE1t6JC2wJ1maEFLXExzaoTjRe575EVXZE7CADLFgJ7IwXKmQEAMQC0qzC9zEA55CG/J5HxEb4YV9wI6Hwao/wWKP+g/SEAtD9qrYcgw94Z7EExAAIfkEBQ0A/wAsZgAAAHAB8gAACP8A/wkcSLCgwYMIEypcyLChw4WVRmhbkEPCkX9bCKIp2OLcP3H/SCXq9DDhn5IKF6BcybKly5cwY8qc+dCEp383acZcwHOBKyT/OLR4YeSfjYUtirhSIHBSh5cko75MBEqn1atYs2rdilAZwVq9uJYEJjCRz6MvgDD5Z+QFBx9oNipca5Akyk6MpLa0K7av37+AxT4yaCLwQlIWjDIR9Q/I0SNtj7zw8U+uQX82FCzIYOlf55KdGTFS+dnlJYSkDKtezbr1v8KuvfIN+aKIAlP/kLTg8NCfQH8vxv3LIJDRw86SBCaPqQnhKNfQo0unWetl9cEwTRD7d0klcW2UmBz/Xelb4AuBnWYzlDTyXyeVDhMNlC/WHEFt0/Pr318QUa9a2L1k3AhmbfaPK0GxlNFB6XnmEHENOrQca/jxZ+GFB3k0UIBWeeLJGpggAptLoyVCiggimJPCQAb4s+BDlBm0AEnEOZSeegrlYBxgHmDo44+reeiVS4jU0skk/4xWCV2VseTPRQjViBKSDY1YEA6MAanlllwKVF1hiLwUpllmrXDeP/6U92J5C/kQo4yB4dJKll3WaadLEhxgVXWeYCITJp68l8glZ7awm0E+QPAiSgqU9g98CuH4UiV3VmqpQH7+FeafST66zFH+nAMUGhm9mNEDRmxkWUFQInSaQanB/7SGQdDQcKA5CApEqTkeLMMQnZcGK6xY2XhSiyWWLFMEB2mpwtAWR/CG0JkFcbBWaXZ1Jh8jVrq3gKP/0DfQrAfZRykpKimAYCWV2LeQOZpQOuy89K7EgkHVJQfMJNoUYYMNKzjr0HhsDvQmQf6sdYkl4g7USSITHvdPxAkhSIorrpyW60IV1uvxxyhlUONm/7pCiibSKhQXGq0WRC2rNPhkyauOSrpEpAUJkJBPB17iirwFuTuQfUKDbLSPuqCE3b2/FGSWQKkVcQFBWyx6GRotHBGtQS2MN5BcWZ958caJgFtuJR1DKlA6OPRISUEYCwSKK80VpA1jwBZ99N4e5//0T5jGkUKpDeYwMQoQDlmmNdctC7TqQEctNZyDCC1g32nfymfJxrjFWtDM/5hD6at8l35nWEOulANBiJBUSmIKk9ICRg9tsdHLCb3QuEAcHKXAudwJ1Bkpqbm7wKuddNYIM9yZcwk9ngskAimXvC1QYgv1aPr2IHsi3zLZRO5SXP/MPtARB6OJUHlonIegK0wQDzVClozQyShva/zPzQdRSkkKFiCF3giCG+4ZUDqPAIcnwBGTSQDDEqSwARNcoQ1Q0U4hBauMiwqSPvQxpH2hM0XchIYgD2TsUYkwRyMmQBDERI8gryCIK7Tnkhj+o2MHzCFNOLSSMIUpeaTIgA3/GmEOr7kkTVTTiBEbMo63VUIlapufQD5BulgBDTUr0qEWfZSpgdxrPf9IAYIm0i9/PO6IvtlIjGyQsvUVxB+8qUSWXJEY7KWGHqbQRDdMJJD3PadiNNnYFgcpEwb+g4cOSQEqGDMCI2RQJhlxU/nOYzWVDWR2NFAFDg/iqBVFkZCgnM6mDqIIFuhJEQ0pQCLq2Jg0trEgimvIgi7Su4QYICFmJAjhcKAKW1WDBrsYSCkKQj2/vKIRoTzaEv+BSpc0cyF6UggiMGElS8xuCw+QZe+KgkuBZC1UhtKa+Rzim4K1YC3Q+AcvBNKAAJgNIVdMpjwR8kVSOmSUAunFYJr5/wjsPDM+A5GXD6CgvkcO5EXL1AiagGIDJChAHQJRBzfTl5BKvoAuA2hAMZD5D+whSAQI0cQL55nMejLzH9H8R3UcEpZ/tPSQLUFE6gQCDAgtgAlC4eZBHgfHFxCFLT54ZHlmpwICCIIAHzjFNjiABooihGWPtFUrrPe+nwmOOy+shCuqAopNkpSkpXRpSfppEEQgEpGsSwZCSGKcFgDFIS+wAURV8A9sqEMBR/DNiyTDAQWggAACIYBgUXCKPvxmIRk5o0BOkInUjI072OMoQURqAUrlynpfzexAXkqQeymjiyo1yEy9iFKE1CJMwiHIkTwBBA68lSEtMEJsT4FUOv8I4h/fUIH59GoDt/7jA78VyAe28Q0CfOOVC4EMPNeCmII4QSGk4KpXNUvIfyLkmaAtiN8MYt2BWGJHaiVIIjqQgRe89pYJMUJeA/uEf9xWEEPIbauKYgQV3DapYvgHYE8hCBSog3wMKQ9TrBbd1FTobZSoRt0G0px4UneeKV1IL7L7kHsdAK2vqUWgCDIJSTRULgYlCGWg0IQPAPfE+n3CM/5xkdoABbgD+YZAtqFf/T4AwA+h6xEW1AJzUMIVjQCyq4p5wn84+MGEjHBJCrM6YDxFIRj2kkAgNYlsjONMlTSIXNoLWKMG9wOALYQRgELXwIoBsDWmcV1RkM2H9HX/INj4RzZfYIpWgMIc+AEF9gxCuofMohWzQPIB1bNdg2AiA1QSiEkHgk8eSiI5TztSLfyVODn/Nb9ndsFtsXFfArjgFB/4holh7F7hqpkA2ziFC5yakIu44B+nGEIZvPGP4DgryCcaiOeia+SH4AAHs/i1oLeojET3R3gCUYSfKMwQY0sCE+MYxzUPulN/PKALpYa1QARxCm3/4wndRjNBtlEGWHfZvfw9RZmfFVEVPMPLBKjAKR7gAyRoAwef6MaKUlM3Suz5egkB1rCpG6bVzWownsBnQzqjkkRMIgapdcgWaHzbbQvkGxQviJoJUgYYo5nGaj5FGhSLkBYogABP/wCsID5ABxSg4B8+OAISmACKUsinOZrAYZ8XgoOWCHzgWyp0jkywOoEofK0FUckTk5QIRDDpsAb1B63PPJBuC6TbtK1xYAeiZhkLtsZdLsM2KtDmAPumAmD+7Sno8I9tDOEUVj9PJDRhPUpcccEK2UAWBDKLvR8kCwUkiGSBXifOJmSlAyn6QTCxugyohyf/GIFAHF9RWP7j5QTIL4xlnG2Nt30bgAXubUE/48B+QMUwd7MC7oBUpHKexq+Wt3L/QYkfGwSzAb+FrRAiChIk5I+E55IizDAkwye+Iehoyk2MTZBviUABk6DPRl50y1L9ZhtpQEF+qy4ITvM3zlc/Mf8BaFtxwX49sObfPucb4o+itJe4SR132l1wEQ9UJTGXbQgqeo6QLNxCFFnAfwJxC5/gK8HHGmjgDxJQEkOyOsz2DzngCYrXEBFoAjtCEDfjCp9BFwWDXgXxABCgdQUhCBjXeQTBeYKwfaiGap6XVCiQBiixDXQAd4RVAeJGY3cgZ+aAA7snEPZRFQrhDv9QQNCABQcBgM5xgFsSIEpGGFMCgfAUEgtQCkWQRmaUJv7gA0ylPm5idRYnBvyFbtwGa9wGXDRGa8EFdgPhAjRWbvp1CigQguynAKfwDDD4AGmQBgTghqXWbUhgDAAnEJSwAQvBQgJBiAQRaHyXEIO3ELj/p4SG0V1WwXxTxhOWIAKXYANYmCY+4AZVsAdc8AZQQhll8AQVNxDvJQipKBArlmqX9wC0dlQDgWIrlnJg9g0iVxIEFYIh6APYtA0qQGreYAQlIIj/IAoeoD00VBCNQEPL+HeQmFmU+A8g5QoLQAopEENpggYSEA4lUIz/wAUlwAVNpV+yWBBYx23ctg3dJ2rpNnalNxAEMATeQAB8UAEuYIZKRXYtsQMCsQU+4A0v91tDcATGgHssZIgJMQGigAos8QnRGEo4MgIMgy7AoAotABz/IAQhEA4e+ZFz0AJQgFTtJRCv9m3vZXVHJWPYsIIkeFQQ8AY0Nn7bQHVP0AO//6VpqfYNFcBqBlEU/igQhuU4n1aT80YPE6ANfvcPeyeAzMiUx4iIEalZQpcQqSECDHACJDADHLAMc1CMXBCOHymORuACZTB+/4CPqXYK4Hd1sIZ1SpVuqvgPz+ADFVAB7vUN3AZYZaAGSfAErNd2/4CGLfEitAV6RwAIPdcjS0kQHmAKdJIFrTCVQCeB0zgQTECRKXACMGADnSAEHvkPHlkFH7kAc2AGD+CSBJAGJGiCEZWWtFYB9TiXGscGl+deFZcEYEAGYFZu/MgQZWcQGTEG/EUHR0APAfCMBbF3fgcsTvkSgkSZwZIDIWICJnBotUAxIKUSl4AYJLALJPACgf/gkVwQDlxQDnMQDuUQDkIgBFUAAWiHdfD5DZyWbfy1DW8gEH+VbttGAIT5DzBYASpgg2AgECU2XINZAXKoEC3gBgrhAyjwDChwEaIQAI1pELfAGhkqnX9RlQ/heJ5gnYjQYaLRLaWxADQgAO8wA0wABVxQBeVZniFQBVUAmslnBg5QcSKXBt+AcUNQY6cgkPxIfq3XdkclbiziA4a1BWrAdkP5D0EwBCiAXLBkBAuKKHCHDefhkP/QgwWxoT8XE9qwVUcmEEbIoVpRAIjnJxH3ECNhHMAgCX8wAmagOwfwaAkhANQgAAnQonfwDV1QBl0AoyUgBG6AhQ7wABWQgiL/d4q3BXf4iYZp4ABvWVxddgqJug+O9Eh9cAX9wAe04wJs54UHkRFWugMQ8KRPaoPq8AIzcKG89xceEKZoehU5EHF+gnglYRdr0AkjIAFYKAE4QgpMwQq+IAsB0ApIkJqaRgaCsA3eAKxY2D5ksKhJBaEugFQfIAZg9gYZ4Q93EARBYHGk5gIV4BtXkK4ycA/s+g/3kAAJcAV58A85eAcD+Z9a9gLBeRDlpgAloDMoEXhasYxnWqu8MxOroww3MVoP4h6iUQUscIVugAD/MAmdsXO+8A8BQAOmgARGwF8E8AZkgAZuwAUSIFv+4AaBABk8+QAPkHWChQ0u9485OGNo/+le+wWgCmAIcSAQV5AAAhEBApFN4koGKFBxspkQvbOvL5IG2EBXXFAMLNFzAdAXi2SwWNEntdA0JDIJozErLeIPDnAOvWA/tFcQyGoBP5MD9fUNKIAZ/1CMQsAFcwCjNSqSZeAD5QZ6qvaCaOIDd9AHchgEKPABLQlj2/ABKIAGiEME/9CzMhABVyADAjGurCd6A+GgCGEDRTGUhtUiW3AKg8BiAmGhJSEKFaoKtIq1PqIItVB0URYpDrcAJiAOLRC26IAAf7AELwAAuNA/mrAA2sC2KIANaLAEQjAH7rCeoXkB5YAA6pAGp2CXYGZUFXAHSLQFTwqg84h+gCWD3v9wAgJAAiSgB0yhAZ0bBDtwB3wQai1JAEe7EEVgWE+6ICjQk8ZQDKhQtQ/hkFU7ma4hlaxLE8YnI47nq7PSAg7gBsTHBSHABRxwAsagiLryD8AADDPiA2+ABlzgnuFwAaRpnqJZo0bgDRDgDXr5BCwXBGlCV4VgEKSWlzQGXJTBGzSQvWQAAUHQB2+gBk2QraTXXrcFgwgjEE6VBhXwDQlzDVkQaOn0EAJjFQZYElw6wAzhJ0dnEBPoMJ9RNpvxLRwwAkLwjSFQAuoZDnjQAt3AAwoZLolQAPYDi1AwoyEwB1xwnuVpx1wAvRDwAKAnaoOQBtZ2BQNxBQQ1ENn3Dz//Kpj6NXYPUDVbAAE6LBAheAdJ8A9ggMKlJljb0GbbW2tQsCYwVwEoQFAvcA22wL/FEADHEGgBEGjHYBCmwL9W0YhWfBCzQ6XShHAukTydQAwZ8C1oUKNVMKP/UAXrWQ41yhvkAFJTFn2MYAlHkAZukAbekMQV0AVLEAigOaNKYAbYhAKgRwBN4A8qQMgycAMaYBBBIFjwJYsfB6D/QAfhOq7jCgEfoAYFmgY/2pZxJsi7gwSfDHfHhSY0gMqAYAsULBB7t6HLKRCrixKUQESle8s0kSmF0S0LZwmZkzy8uwDuOQf/INLlaZ5CsAcl8ALM8yhunAgMkAguewoV0MkP/3AEPOkGUZCAcGQEPtCSoPYGLnID/3ADNxABlEsBh0wGQdAE4HZbVGdUXiZn4dYE44cCLtAEmMy3R3VUNFYB6gBHtHNLLmsAcpEGOtYkR6HQgCALB7HQA/sPhpihEW3RDoFKJoAkiMYS7yEJvvoCByAEAxEOVXABgj0HeyABboIExFEAsZIIIyAJpJAoFSAUS7DNXbCJWPgCEFAGQfoGcHEC/5IA/CADk1sQY3AH/KWK2wBygtlfOQjEaHZblxyYK4hm3vAACpBGBPHVvgEBp8AG3mAZaIAFxmAL08F/VTsByknXK3GZDFE2klA/ndACPFAFoinS6dnB6pARQfAAHP8gAj8AUiCVCIlADAvwAmnwAGaQvMfcPjbgAy/gD2xQBdbm1RVABv9AAhlba1cQAS/8D1HgDYYVBYkrWGVwiujYBHqoVFd3kib2WwRABy4gYyrQyU2CROUztHBHAOnDFAJAC7Rg3P8wC6ywGq3gd7egPW7NuljjOH/BMJ2gDS2wDGcsEB1Jtzv2D5IcBS1wGhjsGWbB0S3wAOrgwf9wAXPAkUIQCCAsBC1gqU2ABkTQPiQQCitgO/4AAWlwB3eQ3j4wBnTQdQgxXIAlziZmVGwXmALxBCXZbVDAG4+kDuqmDukDHPkw4gUh4qpxCw49ECVuxbpMEP/koShB3gXyAnP/MDUCkZ5f+RZ3AAE7EAQQ8AKNEAMCsQQQtABlo9l7MAciHQ7/sJ4gfMdccAEfe819YAOh0AJ64AuOlBFdDlxsFoepiWZoRqqCcJZytg0oIGq/RQdJAFyg5pbbYG3qsN0HgQQqsKwbhDA0AAO2UAwACx0UbAv+F426THIufhBZfBcOMhKO7bHgiMykGQLog9+oGgQ+UASXUDYiwNHIwtE+oIdsgJfheO/M+8FHgA3YwHYkMAW+kNsGIK5a/nXztg3q0Acz7WWvRmq0+QxYXVyqNgSvZop1tW3kpgKkYgRvrlBsoe1GALT/YNwiDggwgdzPqRBNDKt6LhC20PLYfklV/zIJniAlLIEkjEDeI/ALSHCe5fDz3gjODqDDOqzunYAPEDQzg0IMlcDTQ4DVq70HZSwQZ3yePiAIFdAH/nACTOAiWg7pd1Bu3Ta6vI6HpXZUZyZuqHZUQ4B9gbVyYBDV3qdfBFBmR/DmFBXoHHAMxcAKtlDitsCltMwShJihHtDn/fcPtxAAg18QtCAQseznaJoyaJAyfTIJd40I3d4QSJIeZvELNgCWezDqrwQBZLAFGSAChl4g9cMBhAtcqJYGUNAFdSuaF8AFS4AGf+UipFL0Wn4HPyoIzzAEUODHifuytIZmafcP2SoG7KhpKgsFLvCjwAV+c0lXKiBRALkFRv9QNV/zD0WwUwv1D9PuewshDQ/R+Afx+LTAClmwAYxh8goh/7Yw7YKm7bks8wRB6AjRCxQjEADR4d+/DJM6LThnJFC5f1zCzekCgUwQif8o+rA0qsOlBYkWVLo0yV+FJwP/ESBwaluXOQ/LlTAD4Q0ECHd2uICQJsiHU0P+CdpWAcqSBypRBHmG8sM2Aif/fRhIQNAHF6eCqFOiwhsKnv9UpvwHJc0DCN7+bfnX4ohJNCZtmIQ78EUxWgJk/bMVV+/AW3v9/h1I61+xULKK4QX8b5hfAYkdP4YcWfLkxy32coDbNm4Gyb2SxdWlrMA/EzkmZejUYcmLKg7/hePSxQf/XJpBOHQSYelgokuWlr14QOCDUuHbynj7g6AK7CNFyaSRGOROmlM/BWHbJsibin9HCGy78w8CChdQtz01SeCZi3/nCfhAIKRLGqkuvnn1NvvBNxSnTkFpiwPMBkJDM8v80cufE675hxVabMnLL2eO+UeUxyipEBXAikHmLlYcOyZCkyikrEQTT0TxRMv0GpAyXfaaZKBJGJmxk0lGcOOBEBwKByYIdghiIAiCCMKHDGqwpKMaPBqBg21QOAmlkwQhQB2HHEIArVNQSOkDFIYA85T7sPkHGyj+cQeCpoZz4Y4ymBrovIEEmeqngb6xgYd/lvhniG8qeMAHCE7hbhsy/7GpYK+2WsBMM73eGkgWZBLz5Z8AJtMwMRL3EuAYWe5KMVRRR0XRUVLjqsWTgXRR9Z8cZBxIktNSW8ANI9TZEbYu+iDDJIrI8CGHShL5ZwFggFmmiBagdOo6plzo4rUq5lCAAxXo/Ie9D7Chs1ts9/jHjAfC+8eBbMN74oMPpiKAvafYu8OBsfgMpIJT0ojzzH/+/GcQMU9ZC61/0OAADX9saFGvFi7955gP46IBC5M4tBCyLGjJYgMTbZnFJFBFfGzTU0cm+bGETRqwBTRWPBGTf8D5Z7TNGPmHEdTGGCSNQLhAgI0oxLNoSAiOENaS3H4Y4RI07ivDpDII+OY7Lv+uLMGGI5qQszoCniBADKnu2+4fIQa64w7wwPvgDjqFkyqqbdAm94ESSvhntgoS9eEIHx74x4h/+I6LAx/y3iJvhP9BMK4TGvhQML2KuSUUZwYqRhWGP2lkoEYC2CCAAKCBy4MURT6sZNNPRz11kzyRpKA/XniKjamX6MOkKGrz4ZxLiLHEEmDEKeKIQajcprq3uf1GOS6qQICD++JiCqp/0h1o7HA4MLfXgdp0N1vpoWoTqvAcCOIIJTrhYg8oKoDAh5N9MOKUQgZOsDsj/DF1oBau6RhUk7CIEC2iwYp8AGIgojCFKD7xDwzBxYD/6Jjq/iKA0v1jchLEYAZLJon/gRRAEjkYwQGgcIou7AhcNAkaRY5wgFcAQwQLIIY7jhA1QUwvSoI4RfdgU4UXNA16TUEJlR4ALiX4LQjmIlcQ5KQuqqjrKeQaiLkGwqcSCAFwZ0Fc4AbyAEcJrAVIOALs9kIDBvllFsfgxWKQ4YxiFAMH0DDFBOKIgwDgIFIadMxi8LhHygQIZRichCdidJpJSMIMR0DBNkIAGx/swCJCookbKmE0JoSjOU0QQ3UGcorhbGeRVWiNG8YDlaWAD4hMEYQ6hFACLsAlSHAJD/fURYZt4Msk4RkDBM5iBCX8AwlcmI1JEgcXDiwhmJk5gg0Op5ciCOFDD9PL5IYxOWkU/8MWFkqgB0SRhQoFgJt8lBA4xQkZluExRjZKRCcSMQlEuqA1dHtkr3L5ACMwwxKuEIfV3mAWnvQTJd/Ywxz+MYcqlOAIpxDEm4DSnp9IRRBP2AYXqijK8JBhDCbRnrbMRpV/QNFcRHrkP1yhJyWoI4txGSZmEocgf/jDCGhQhxgDZ4tiUMh/DZuUPv4Ri2keAxmNGQgursHNjkUIZONEalL90pb8DexkI8OEMkySAUtkYAQv4GQFHiKBgbwyCkWyQSksEQ4j+KACLmBb9ITjjQosrxxVEIIb3CCmpcjpPIJAa7bYIIQ5ANOWQSJSEC560bjY0pVdNckCMHABPChgmP+AaSoHXoAGJOBPmANBwjXi8I/NTo5CkxrIMIZRxn9k4QQKYAITjDGQABxVqa+F7cg6QZlJVPUfnQDGCJBAn1NwYQ5m+If2/pHLMbyAGQCw2llPkZKErqtdXShBOBDwkD344CvsehtU1vSdfyAADzxErC6H21HymiQN7CFsXILpDwSUAAMlCMRjT1q/gaDlYP5Yy15gRxdpOMMZzYDFTv8R4H/AoB40MEkRmHACGnQMBxFkGOoOc1PAUDi2F/6jq4Dhl1fJLDK1uG1Ve3eJHDyADtVBAHAfSRGL+GABwHkDe2o4EOdCa3kXqEI5uKClD3xjKe0BiqF44g3o/gMAA7n/6CsTA8X0BkmXCDKVEiBFP8lM9r57QcIMWHGXYdRjUs6ohz5g8I8itMUfLSgCIHp5jVvQYAKisxRcoEmZD4msGXec8198OhgM93kgA+KMq14UF9a9SjJULdYCOFKJq3mDZ1WonZAoEoRA/WkrBHhaQ/85NRzPQQgvOMIbPnCtbxSveE0pU1Oq2JBwSaBXfbgDcTFK3vAEKckmSW/fvmASDHBhmCx9DH5hF4VjEhMLYx6GIf5hiGEMIAH/mHKBEOePF9AABnSE4F7ufKpJDYMWE5LMBf087n9MQhEmeYRJctAqv7Qq0ANZQLHgrY0XfKetVdClLoO0g/apQ7mm3Ia6/75hhOWFo6BcUNlyEfoNHIrJPYMogyDMMDaThOMfP8NieCDws58pWS9RgCJaCtrd6gluCwLiwBYElhhQQ7YFWJjBNZBhj34kQBpISIw/itCPEmSBYaAzCStWy+2B/JfcR99LOcudgQ2DOC4xQvRfZmsJvUySFAswhRG8IYi2zkHfr4TAPJdbEqY0JWpukKhDhFCFvD0gDWMhC9/kDgGyeOMBQhhbCXAxECPkegtBqrVfggRyk+QHQfA8B7Rf6oPCYUZABvsLZgK2l8Sh4QSAuMY1BIDzv2whccocSATjIgvHoehTe2FFnh2zbaQj3QSz/QfVIdMJS1SiWJawAA4UUP+UiM6Bb/r+B78p4kOmFF8QUJjaHxRgBpX5jTrY4I7CU+INKDyALAN5y0sisUoBYbG+UbClePVyB4z7o5X/6KUQ/kDy/NI38u3/C0sZ1dL4WxZxYuyH6INe+hPxv/X/9wuuggxicAxGkL3EMgkPmIAZYAIokIoqwANRAqmBABI1OYmoeZrhCAEFOIc9oIe3UClsOAX2mROwSYNt4A4F4AI9WYZzQIA58DSGqC8qEw/pCKnxusEqMKk5KIEZiIu3kC/HeAHHewzNaAsDiL8WsAHNMILUmoHTGzCReYx8QL2BsAcAxEK9aCpSYYIFyAEF+AdtIoEZOAEIoIM7YIMQYDv/uBC+mkCo4cgOR2sNIVCAk8OiLVCBU3A768vDK+qDIziC81s1urmAfwBDAqE/k/g7wXqkMfC4NvgHmTKJ7YO/yHC8YnuMlfMLf9gCKOg+BdAyw/CQBoGMxrAwuBC3LMxCSRwZUrAEYvmHCZDFBlCANHgCaJmDEDCJHSCDHxESe8EhqVC7PZgsKECLFhgcr7A+6/qbgokLXhoILsCAgUAAsZFBxOkCddCMxxoSjDOJ9WOsC6hGHKjGtCgRH4AdTByVLTCCMPIHAbiGCrKgaHiMW8iCvKAFPdILKVTFPlO6PZoAXGCC8WgPibodX6lBCHCBIaATddAxBECCwSELtECL/6IYiy3gG3X8s7kZiEK8AIESGx4QI4ExpsRAAj5YmX8oRJLDgxJAADTYApicjLUQv5FRQiR4gRdIgFkoBmMwOtBKDBzoi2zrx6IEJ2hygnhgArQ6wxAQAgfoOFeKAgd4EzMoByEohyG8vrhgK5rYggqoRGLKu3/ggRLopX9wrxJog2iZIrKcA6WDPMwAQyV4wYGAp0hkFPVivKcyCZbRRFLBr3PAAn+ggQQAqnpIDKDii4EQulEJBaOETMnQBCfAgCKgD57YKyliw4vzAXXAOS44AowMlH9Ig2LDBsOzPrYYiF9bwe5agiVAgi8Ux9fgASHgPMsQAiXgvLiwuHIAyf+BaggFiMGVainLSrnB4ct/HJkhvIYieAHSAoxviguJaZgBO8XIvDABHCc5iIcXQIEaEgTuqIK4eKUgiAI3UAIFIDZI2ovqqxuUQRD4aY7Z8AczKIKByAEkWIBy0JMZkKgXVIISUIC2AIBq/M22WItwaElqvEv0q6z6k8nB0YwhVJ0XQIIE4AASuIYLkoVU+ItZMCqgYgUBULbqVB3Ww07IYEWkmoB6S6vzCz6E3DhAvDiNgwtv3II6rBvMOLmhEIJAoMMXQMYX2AO8CwQlOEtDpJu54UEe6IFhEYLt64EWmZsGnYMpexW/CTaY9AHLyMvUUUIypIEB+BBJoRAU/Yf/TNELxTyEFHXTkgGqDXCCf/jOKQHOrirP4bKlXvQ4AjEJviGYgTiCa/hRBXADH7CBKugBI+AAIeCCsdEGG0CzdxIoH2zQC8CFusQMXqpGuplGtMSDC1CADEACZKRB92upI1CLLYSssKQ877MBAdCyO2OFUEDMyLAFAdDHN+XVUxEAEpiAaqg3KhkCMuCT9ROSGxQ/JXMkP6UfmSwmJagCnDSCJSiBHUHLFpitarGBeBObOShHBNCTcsyBEvjIcOABPukCHogEldwLuukBR2E8MwOMF8AfCS3CSIwMlfuzOHgQWXgYZLgznXIM1wIMND2RZkjFXvWzvCABEvCCOjCr/7sSA7RkOxZDrGbdiyB5gF8rGIWYAyM4hz/wtOWRlhKgt4Fo14EAVwSIj7J0zhY4h05wBSHQE7Rs0L2oxvYqh18DRB9gVbR4C88rnNn4S7YQ2RZ4rCA8KTSwgcSBx0hRPQyaWoYVFS/lo3dABdCZgBU4gieImu/QpAe4KI1NjJ/hIvhEAiHYAzeADYLShoEYz2IREItTyZ0FznB4iwzgAh/sJXPQT4GiGwXAg79gJSFYAvnixNCkV7gIzV8rnKP9Bw5AAiM4k+rjG6aFi4NxlDH7B+psEILtM9G1WtQhhRRhhwCAhwmghAeoAEHwCamBDdIkz+C6wQl8APviO0ftkf9w0IbZpUZB9TVtuEuX/YdIeAEb4E+0FIIEyAALNVCO5JPfhAsMAFc65MT5QhzMAFoEsa+wpD8OeCzMUAcoMd9EOQ/JPQt/wMmBUAAYqBSlutXSRarTLRESEAAviEXQAQFQCA6gCLI3CIcQkEC/8EXE6sV/6IMHiIIjWLsc+weGsDiDC5cW6AI+6AL8Ossesc0XKEQhsDjEbYEcoDgEuIBV2hOK04v2igQlUE5hwq+VM1XK011zJJOSuIM1KZ7jUN+/OUS/8RTRq1r6JWK4AIETOeJ/aAVcoAJVeIAmkJ6BAJc5IIpGTIxekbsx4IASYIhy6M1/qAJxgMEXMIISgMH/I/CHRr1edziHJQhQl+2l5B0bcBWoTJgDPbHW7srZgSpLHgWMNNabfzgHVl1N/fEKVIMLsBgIddDISASEtbCBa+AGZ/DQWPiHVOBHCdrVgi1i/fKLF46LCXgM/YMLUY5FKmgEKKCD4aASQegCLliGOXiBXjFPx6CIIeGAKihECn4NMC6BP8hlMOYCPmkpd8A7GyBhR2W1BeAAi7sAO4KLakQDuFrZ4DUJc9XczOgOyWrkQnZfb1APRDYJ2AULwwocafCbFsgHZHjMehiGNJomkpnaKwwtOfsL6exkxwBlyPAck1BTkxBlU54AEOgGV3AXqBkIrXLXuEjg4BIvMuC3/78RLAceT3dwiDkQ4xKQ4+VB3AIxGDPQEwVQgnblQWjLk7FZLVy4AGNAgMVSAjQwnyX9h72T6RnYg2yOCzS+rMfIJL0oHqfIw6VCBonhAGlYBDUKhXxYhAxyhLggMLiIkKHMZ8nYZ8cQ5VYwib0ziQWign9YoE/4BBHwpTSgg5KooW14nnJJVvFo1gNGoRrcgrm9xmkJhxZQAPW7SjP4tRfIgRko14aYm2LqrvaKxuNtifP5M5vtEZIjOSFQB/sDjBaQbDRmPEWZXDvxCp8utfbgqG9ImEYRAAPigAFoBn0Is9SZX8AQ4jvSC9Cd6sRAMBMR5QiLiyTWhG4ghgIghv8MMLEodgE2gAteeQzAgQAHAGFWA2MQfgFxSG7QBLbJRYMAbaYTbp4Z6IFWkmlwXSweiNuTuoAvWGy7vIAEuB/t3YuXQhyDSbkuGpi2eIZ9+YvrACKpABTHLRASgIGCATC9oGeSKdGBoMLHsAV8fm0JqgbIEAFPAIYCYIBzSIMhEIMovg+LQwJvrEmElDSzot6CAsNW6pGXeiwfaCkF4IEiiI8LQIPC7S6QdFmeVQKLa6k+oD/0jARwNYlPy17A4ADLyC+mismTQpBB+Au0jpO3YSiogbzuEHESSABlgQGENZ17MBHFLPCkI5muntPEaBVgYADL5AqTOA8s0YsJ1Iv/XixNBJBBgkJcI1glNzgC8YWLwsEfl5KykW6BBHDJmUbLf1gtLpbWltIlQgi+luKAL5iDGs9UHHcMSJavv7wVx1CJRBZBArgivUEDATBMIBgz0kWdQ5DyyfC/KjfkviSZLO8GQksGRDCJGLEBKCgJ84Dv4y1JIfmqPr1BM3CIuRWCTiBhXwvCk1spDiiiC2gBHnDZufnIAnXZC2gDN0jEgeiVXmypOeiBSGivEoDzxJBUfcY5cfYLMjGJ+3iSK2qBIWyMfBCAAaiH1H4tAhf1vdBOkjnwf5ADv+AgAlwAG7gDnzCJpZgaVqPlv3CkXomCKogE30RLM/iDwI7z+sr2//TuYLrEBRgU1xLgATyozTa4H38QdGgXEjHYgRFXgpfAhYfXC7SA5MRoi1MoiTnxC01C5BkzxoFAxxdohli4wnrgBA2Scnb3Cywg5Xc3R3GahEIqJAbHKp6IHtL08F2EjDGgGxnMATMA1wNYqrgokGVAA2PX7tp8TQVYgj+oAvEV7r2QiB2QcSRQAhzAdvOOi5x0DCNQAWwgJczeC0SOYq8IJst4gWHQh0U4bQxStjYdlU32eaFPkRiJCxopemLIgTEYAk7iJDvZERkErMQIEjaYLrldAN/iE29GnKNtqSacg8VqgxLo2OIszi2AADiYNeGC6B2Ag9pBTxiVLySkMv9Cdl+nGAh+B4ymmDGn6FhB3blF4IeR4XS/2HnE/z8PK5ai7wBikARMiIJEovuBuEVBRII+cLLE8AFdXI4K4JMB1mmUUhR/uIBO8M0iUv1I80WIjlFHkv/g40XvO4e5BQxXTYzgfwxERokrAggbcWD8+1evIMKEChcybOjwIUSF+v6FQjYsIsaMGjdy7JhRUkFGkywlTNTpH7ACxF6oGzJE0Klt/1yU4XLBXQkk/8g0DIIQgpsSVcpVqfJvTgkzaDb6M6OgSpsj//xt6UNmx78dWq9u3XGVa9atW5b+8/Fvy8MXRjx6/KBC3VI0L4YNMKTPrkZ7nPTlYus3oqG/ggf/s21B2OGkkwUnLQ55RNAHmQq5JNzCM+KSC1yWuftHuYJZjf78PUAiRMFUf1bBas2aFYLWHbBja4UwFSHZh4YPQyRAoAuHqUb6/bNn8N7GibyXM2/u/HlCxf88Mf7XgZFUht9CIAwX+p/PhTyjcJkTrlw4LuofBM/obyqHNT3eU+VJu2tBOF73XyVEh0xo70VkWG7QFSRIGSo8MBUWN9SzST2L5AMhb30ZmNBByDm0yIXNvSCYBAtJ5xciBQFjwz+n/PNBQoJ4E0I4Fxi1W0FYJQQBBD64IcQS5SxDGRddjPFPgRH5g8YDqI2GFRl99AGbk33E1keNUmpVRg9BUIkW/0ZHZOcQatj8Q4BfZBLgDQpD+oNMPxwWpI80zB3UIUR9OXIRnXlCFOJCnhSQwT+AdtQBIoX+U8A/aaAgWULe7IHQHFA4ZNs/LVQRzj/u+IjAEt98J5o/a6GhGhn67UCIFKkWJGUfqaIgBR2xhfCFA1sYICBEobb3EAEsmsnWN0+IUYakaOQTQT4I4fmchYIFoBAnGEWrp3N8DladJ38hmsgLjCq0zR7c/YPAQlEk5NMY54lTzj+YqmMEl0yhYdZoEOjXahJNEGKFFVKA8RW/hKCqVThfVDGaARhtsZZGZP71xAcEwOUPDMkixI+BGvJmXEbI/DNMPR5TO7JCIYaIYv8tmPBWgFQOt+iNUeHMQRmXPPkURHhAlRBju5j+461o806FRhpXwYECGHjAIQW//UqxL9SEoEAGHl/w0MdouD70ZUQffPMzRi4nFPEHFXDQAgyQ/LNJnhr7hUwzBQX20CEch0Iy3hnV8o8JHk2C6D+S6IQCiwUVXhAbPhdkFo42RnGzDzWFg2kVSVXAFlX07tcHGHA08QUAcDRNhhVLO830G1UL4YPWW+/qkMOSPaHQN94SIBPQBMxOwCkt0Ghxh2r/BTxCczLEcd4XWhsRCwuZQAxbf5dogzdjLiSGIF3McZRRDBd0WUFBRFHF9phSpo4KBRVpJAes+2PvP3Ts+/n/F1LAQcjSqZq6Qx9VFUxBEEaDltYVhD5GWN9CgFaQ2QniH4LAxuFgIjaEiGEmFWiBTjbiCMEIjzdzY8gHG1KR5JFQMBkARkFA8o+vKaRw2+GCUeawhC2E5x+UigIExsCFySFEHf+oQLxA5YNR+aNJO2AaHGbVhmAwTQr888cLfjGKYAAAAEt4gT9aoIwjEbBAaECRQwahkQYWBHcsTMg2PhCxbfiAAwPIB/GW47Z/5GODzXpOYIxXwr80ryB9/EsOFJCDhFwiI5P426EaUwHfKOQUBChDGijDnXJQJnzgsaENHzAHdqlnDw74hzfQEkQjZVFAWlna0pTYBjFgzQYK/8BA1UqAhznwAANKyEnWWtc6LzWEaw0h0za+piKEfO2MCFGRyxaUgIxMBHl7dMghEBLCZ1LTISBhhEMEhZIDjCli3xJEr9iAkGX8wwiPWwgEzDCHoRjFgadIA+a+wz+mWYEMc2jDfJoiBCFgoCA+mwMAEHCBOfQAAUQcjUN88KlGOqR2YMMdGsmYwISU4ULOYIhyOjTNhQzjGNVsToj+yJAFFEQECEkEChMCvZNYAhjAGMlMJogQFrlgCZPhEgRq6IMdFgSGghAEChbkEaGdBTZNlAIetsiGHiihIJEoAVIQUgIElKAE5VACFlNDQIR8SkCGwQYdgilT6/1qrLzZy/9HAZPWkYkUIoVEiAoRAr1DTgKl0EvEAgkATus5sCBk2l7P5iDUS9pQhz4LgQp+egpKeYR1WxgNGcBgv9H4QAk9aNccMnuUzQJ2s0LIAUIRuhB/tLEhKniCRBvCqGD+AxsKVIiZoPCAbTQBI/YYAPJmkTx7bDAi0dzoWpfDzYwsYAT/MO5DgGCdRJi0IBk4AgqsJ4YJ3q4CXOCkOLhwuZuFhw3rBCxrn/GX0KDFSUS8QBu4gIA5YAAB/cSFQoyxWfgqAQmjeuxWjxBELqnjFIU7RQW7NruMnOKTZoXIskhoj2geBhbB7RAD8PoQRKFwAS11hQ0Ga7jYlRFmivvHEqj/5BrbCKEKMCzB5f4R1PEihEtHKkEPMJXZSHRWoAi5wD9w/I8SaKOprEuNQt6DQNSksa/O8QZjH6zkJW+EEQtgQEZI8Y8U/EMcL/iGb6b7BAJUMMBlOEVNyyeOErjBkjjaoY5/9oFBqGCUHOnDUrbApcpOVQgIINdUx4WLOyOEXJm4cab+sYdRoQGBCkFDBg834L+k9h9DODBE2BaB3jLZIw6uNGEYAQzkRmQBeBWBlBv4gSEwqoEycYEDVSAuz4zBB63WkRA+7NAVjzeIZuDBHKiq3juTi9d87jO5dlyOchTBM3zggJAjooDpduQbjaZdi06xUExTu9obees/KuFo/78W5BRYdpizh+AN9VCSC0dwwxHMEIKdFYRc33ABAZqgYb88wCwPUIIS8OBeLky1veSCr54RIt+CyFcI/9CGEfxxhARAYasK8V5HzDTMFpmJURV97UOA5yZqA9faziHpP0jyEEuIwBJSRsgQPoA9MtbudivaRgO/EYgqCMEISwgBZXB83R+6YBsoIECSBeODhb3ADHuoqp13xusLXODOTf91Qi4Q6wwg2x9QsO9DggNpiJhxIWRTyAPgyZGL/uMQ9Zjjg0Po0SUvj4SFJAkKC1kDhvCugWQSNV9BfIRAqKene6jC11zwBjO0wNCC2YIZ/mCGJUy18RcowT/2fGeAK/8E8iV+AbL/cYQDtlghHyoTX4fJqAlG9wHR3cginJmQjlNLjx5/sE0T0twGiuEUDRQECz8wO2z06gkuEMQYHsAdo3yj+C7whiAqIBV3gLE5aDDCPjVzZy5EQhvj8jWfg12QOVxACQrAL5GGyJDcbF0j3kTIax226IfoYyJ11EcsFpJRvHXcOAJ4PQlHUAopk0TCmjgQQkgUON1eGfiGXkHAKVRPGn1ARTESBxgBDuDCOBgIGpzDDAhBJLgXAuDCAvQaVW0gVSkEHkSC5g0NkYDJEBgZW6gIMuUdQ0DXRiCDI1jMIswf6y2EI8zJxhXHX9wg/j0TKVzCJRRADWjBPyz/gxukHEKIje6t1ikEgW/c3hl9QBr4AD2UgAVoQit8nvMVBAdoQ0Hx2j8UATk93fXl2LiMC/cJAfg5hFSkoOGMzdZJ1ICxyOEshKRUFEb0QzPACcdIA9wkhCOITETsYD3UQ4J1BHL4YKW1FTUZYQ3IQQ28wgt4QwoyG7eBzV6pyF4NGGo5EgSkgTrgggWAwj/gwAkYHmGgBfRhgHpxQX25g53xGnz9W7AhQDksQWhFBExkou01BAsyhO1NXEOI10IQ48cgxN1ES29llD0wYkG43vzlxUIAV9z84B6RXEEIwyUAQIYN0x0e4z88AwGIyYr84jb4xinYQCsUBA14wAWg/4gXLcdYvMA+PZXUvUD2aV+vFYQQmEEgZJ5CxEux9dVPQRTuEdNqOQTuYRxEnN8/0MA/+AJCXNo/WCRCxI3rRcREkJ1HoB02phUpkEJz/cMlgEAGiB04kcl0GeA/MJsgMErh+EYZOFtBQMMGeEBBBAAN0EhBPBY9thsPUFVV2YASXMCeTdWeJQQPLAAXKIBoLcSn9GIBdltCuAxWHiPvRJxCcIBEQsdEEKJfOALaGcJGLpkipFUiFJK2/cMrLIAPoACqtUgZ4d4H/BSvHI4HbIAo5CQuFCRamIUqDhWRhAMPNB2IFQEP7MHS9aNn/IMNCIHDVcqYOIypgQ0aORAZOf9kOkpG+aFfTOqJPojlX1BaQcQRtY1DiThiCWlCN5DDAkDXTBrg7dmeOYrJ3RUECzGSVKCCKExAQXiAMbxAnKnFbRxGnBVaFSjBnfGADXxgnkUeUxZBCaDIKHGJEagAI50jTPTiiljlL0ZEOmrZTAngQmwlKHWI6nnEWf7DDmIaJgzSIKWVtmlBKfiAfznQ7XyDHf4MmYiJORpOfsbhEYgCDqCCB5DAP+gkDezKEbwOc6DBCPDAU5HLYfqajpXABfBADiieQ9gAEvjGajnUQtxeRDjU1/EVGYldiRbEELhABbDog61nSD4T9CQEOZQCOYzCh5TjmDjSr6TgMD4b2PT/ijsu6D/8Jg54QQMoF0KgyGQKhj8sgxJc1T+YRjkggDEEWz/tGBe8wKMsBFoUgRGgAB2y4LOx4NY5zK/41fphJmwhhAucXo3WqWDUgidg20g5xCV0gwhowgtw2Zb5Z2sBoGRA0HeyiERJhiu0QhYg6QSIAgmUQEFCJhc+Bxf0QDnwABpwgTvgxPQ1Hb+5QwvE3kIsxYLQwdh8p3Y4ZJy+KkdEhkMgAxxdyHvaqZ5kSzLglZ5yxCWswjmgCV/JhKKi6S9W0EqS2kv6RhogAS5IpAfcwj/4ZgCoQvNhnXMsBQdU1T8oAAcogREwZ64JVONxABYg0BagSIptBJEyhyMN/ypEDMA/TONyMBiu4g11/EMiiJxDSNhCvMI/REIRpIGwfECAaqJuyiR3ctmKEEAaMAHk/cMEeIAoTGs7folxPsdStEDSoUEu3hq/RUKWIoAQYNC0lUXLMATGpZarDkbLFgQvFAMyXEMi3iu1PAJ0JEIi3GhHaMMrrIICPEATwNt5/uJW6hXSgtNMgtILXINEZsEtPCoWjKEYqAOUYmuEnqANzEF9oQEPcGxBzMAC4MQMtIARQOjiSIUPRQQyumDeEETMvl5vQWNa9QLeXAIzkMIDYE9DgFNn8meiviRmphwHZMGz8CXFosKjGqwCoMgRnCxvLEUOhMAe+IM7NG4PaP/GBXTCDHCB77hZWUAu+t2OXrHqQ1HTNVpbM5glNpoAOvwDay6HK1DCPzSCJjTCD7ltt3WnX00X7VnPB8QECnDABgQAKkjsP/DlLEiFGLxFwmUQdBSaGZTAC7TANeRYVeXiVHEA2nqhVPjSEoZeo30mtXgkky0CMjjYXnDIrS6ZJ6jMINktYYACvyaEOWjCMjRCMBhBQp7nTzUkBBlpgHkmUPmAOQxcQVQsIDgQf6qDAnxI9x4Gl6BBFdgABzTuPyiBet3aoBla+5RF25bRNsDcAy0tnCZPELSALTzTtGSELABiQZgmncwnQ8gvRmQLb7jCJbjCQ/wsGOlO6fqVmob/b4uUQYChgA+cAC4IAHAWBCpAwwvQqQK8CxhF6WAYARQ8oDr4Az3AABcUgRCUQ6E1BGn8AwegycFSUJGSlQoeRtAB4O2ZSXdeJkfQqGDM4CIMQPtCBL1iWloyx8kxxCj8AzNgcX6GYzD9LUTBlhqpKhI0wLRWLA4IACR7Q0ykoJhUqoE8IAc8nz9wQCBQ72k8BFUQMUME2On2J3QYaX/O5O25QAizRT7YMUbA0RtpBGlSS9+slSn+n0JowjpcwiuMAxRsp9eMSS8GqDlmJe/IRBqcQwMoaEHMAgnAwMCegiMJLhJcKnQcwYe0ERcLgT2uRQQf5z+oQDiSSQSxcbv6/0X1/FxlMiztOQyyOoc+yHBGRMsi8IMjYExExMlGtOe98nAl9GrtVkIplAITmB6qpaOJZmY65p3L3aXvvQAkA+ctTAAq4MJCL8qW6Y4KcLPG/uRU2CPHdnJGVA9sbUMsFwQqL0fhpNY6XyU1cQjbOAdqDsYarJUIlGRB/F8lNIIQ6u2i1GaK+BWbIrWLysTtDYEYvAAuKG6kbsAEQAMNmJ4gfPQpDAL0GohQoQF+cYHNDWZCQJwQ5wmZDEEaQMAbQKRBnrDN5k0ydEgnSILIxZVD1IFPI4QQioA2fIhk4CUdo98vRUzttUArVOw/eAGSuuiBtGA584YPfHVBGIERlP9ZRJA1Wt9BQaAaJsbh69VD+y2HQK8VY3QCXkkYNvkrQlDZPxCBQlRCxVZCEI6CDTgMNnQnbpYVWcGhmAQvmaBAgTbCKPyfTv4DCRxBAWKD7Z3WP3xIVDoH1sWLG0T2qRYttfjcgpipy/zuy35ULOgDJOxxXDdESiGEyJFc/TqElLlCJVhCJdgAzDEhVhq210nGB9xBE/gAE0SCBSSEB0CxkXlbnrzH2SYEGjwuR0QOX8HhYXz3quJqTpf3tYGcSb7VXiMEKJCCJpCCBVRCEcBcidKmWbUkQpTBA9hAJnD4P4ACJXgBLjSfCk7gWRiIEXzekfzDYIHuyLQphf+4c+j/MP9ZOHorBA8XhMmZpBHAst3Zoct1k1l1pn+6wAuoAimUAihYAO12dj3r5tpeyAt8XlyQhWZzVfr4OGFAOJCvuUfg1XqbpEJ8wjL8XyAbgdhx2W6ayWd/S0Lw3ilUOfImqXQKbYvQqRULRgvIozl7xDeYaRsLhjqzOUJsQh8jxHu2sKQvxAJgG5EXxJxn2z8Q9MDOjlsTq5FGxH4asCi4eMXqpAIot7c4+IVwAPj6RUt3BCqr+b2SdzJWOpCXAkKIgIW/eajjbiNoAyjwMCmYgem59Zg4NJqf5+3sNw08i4JKKyrcX0FUFDKRUcJAhz/oxKEbCTbIOkeMVbSX0BuQ/4mMIwQ+ZzrekATPNsQoLIN7ZxsSPACp5x2xvmT6necDjMOzSus0lyDE9IoxXYg/2AChDUata4eKgk3PJVA7N4fd/V5D3IEgNAFnLwSmP8fUYoQ+zAndelynu1UwdMKQAI2vCK52VNAdlsE22AB8bcA/zMI0sEID2IA3ATfvWG2HnG2OCwYHPEAFVPwSMhJvPzhHPMEwpghMvGllIj1zjLs0vYm93lGdBvJD4G5BaNsrdEIxP1upmW4ZfSfL3UEZvIAxSLNuFYQCQCGqrZGKcJ5zCMgRtIDVQ8QWPAD5uhxELTLeCMJLOFDhNwRNNUQN7hH7wntETJErDJZETVdEb/+nQkxX7XkmARwBFlCDLFBDQQgADGCB6bGpb3yDEfhk1T+3SLNFJ6PJmhqg0rvtgZkV1TvE07NgOvdKGYTjhah+RqSe47tV2BuOW98B6Tp7Jjq5ivkAFlyDLNDCCv+DMQCCDwzt8p8D8DOHdYvGMT17QnyDSovJGVUcnFb+CnkdQ3inRzAhXEthQtBpF/rFxw9/SRUEObwCKUiFTKAyAdQOQJwS80/QP4MH/xFQSKBgQjrejCSwRUuAQWvXOJxq+OHbE4M2EIYUOZJkSB8HT5ZUKfJBhZAasZFcmDDkTEHYGv4bWJAAwlMfVgZF2PPlzZxCkSZVupRp05VIJnVyOtL/VSWDl4ItoPmE6L8hQIGq3PYP7D8XT069+HfrHyuD14yhYUiU4z8kRvxNFYoG5BG9CP1xgELWIIGxgr6J/NDT8L+xBrcZJpqzbNiSlpFaPhxSUOfEf4UaAD2a9EqtBhOVRggq2LJ/TRb7JNtVJllBDE+5ePMPi7Fis4r9S6DgSBPaCtUhQaNyC2i1HEr7eHCwp8ZTtEN+uC6y66lTnAmc+lz6+7fwRw1+V11y+Xr3pc3k+JeDgftS/6wqXLwfe+2RdCBQ4JqKDBLCCG8Mw2YbOv755g4CjIDuPZTaI225MqijaSXMJkzKG4OeAEoQ9RB6rMMTUWxqhPkQec+qRj7x/2ssMRjSSaj9fLrNBRtmMCgdWhI46JTHNOJKHbVQPAmk0oJIqL+ROEwxKJw00sk69KRUzZAsQ+qEkZX+mO+cfzpJzb1PLkhoGxoNijIkExv8J6bCPkCBg2uAk+UaQDhQqEEiP7hDASMOao5L0FwgyKA5T8QRNMTaVFROBw4aK7ZDm6pHH0wN+jIp+VCz5LS/KDGoEWMeaKygVZ90siATgSJqIB9OCLIZGE54ALYysPNOgRZGymu9JUmbDkMusXTqu58IGmLEZxNaNllOg6qHWoMsYUqqBS7xdCpNTL3gAUEeq+wywiK1zAUU3LCBB1qQSeCFDx2rF7J6kQzJH0OvDf/Knw/hzLBfpUQkiNzPtvlmG4+OHdjhkr7USqqgsk3EEqtGK7WRf1JFqCA2R+ppIBM/VvSDCjiY4RoYrlnOIw0L++cUdRSo8CB/jjhJWND8ek8hEod6eCTrDBLjyqO/mVbopQ0KMykz/1lxqkZMKfUfVUowwoXGwGKMpLIQAmprMer0gYYgC/GhCRc+WFUkRkcidL2dQfMHAqTSkLLVmBWj7VWE9bKZ6cH1MuWfTw4yZYYjbispWZFjro4nswhN4AhecfMKs/C+iQnYf3ZGA4md+XWqCA7o/sufljCrMu+xUEChNK+TchvL8dA1Ty/RRCO8qWR8/4dqq/8p4YWtpyL/oOtnBBFDkDv+QaOfaxICKunOCHimpJ4RkpA0tfIdbYtnUID+oG+mk3kbbNwk7djB7M0OXS5TDx6pZCQJ3hRVWsFhhhduA7c2EWAgKmETARnjERRAAAtxUJsLvoOTIaxEBUZYznLy8gK5la4pJ4mQavxyHaAJaT0zudc/PleSnUjJH7070absJyWqNUIV+/vH8Z5AI7At5VJtWowRjPCNsChPeZ2Bm/a29gEVoFAk3PuLDV5QhNI05wFL/MfdEpKo94zFRNuoElICpjT75eMf9lgEDGOIok8YzhSKe8HCCuOnpTTuIAVJ1AN8UIHN4UYQE5SNQVwiN9IBxikviGL4/+oGhREuyn1BE5hS9pZGhECCE4PLFpneg7iNhaQRG9sYAnA4QC1Csodt6sxrDvIBy3QGPWy7zWIGVSE0KIA0R3gBsUjDwbCIkWCE4WKlcpOUX5LEhWnUxyKYYq31fIkRiWCE1P6RmlGBxhSbFJ7wcNCIVohiBjZAnkJK9hLaccZGfNtGGcjwD9npJCZEI9F1VGUQK4LOIBygJWiM8AL4lSZ9QaPNN0Ymx5WEhzHqgROzDJLCyxDAJcSU5D/ISEaDDOCM/YKmSCY2FVVc7R+G6+hGN9q/CQTCCGlwlh/dNMygfKYxKTHIiDbTppfdZH03AYoK1HEE7yFhKobii11Uh/+QJozklwWcX1CM0iwx8jIp9bPfIpBhkHwc81oMeOZBUnPRoIxCJYabAEJE0b9WIMQGzFOP27gDM6Xc7TvhkZNHaEM05X2xDNigpQ2ENSa94AwkLtVLF1AgwAxhh6myWVYkSVPMhyLTHv8YAESptaL6QO0ff8iAU1yzyY0a5KvbbMUFcPCPLGQtDSiw6WxEolKlPAYF3tCIEeV0EI8MiSjP6MlhgJKcg8hNL0dAAi479BjatO9tRunMIh86oahKFRb/QOYmrjXNfxBDKaOohDnAtTHEIW4kOChGaE0xAQ/wAAlQ8EhYcJfWpcypSQlLmFFIhDmZNQSlDKKDOv7hRKf/NIcDLVCAhJw6Gq+tsJfJfRh0B+etf4DrIK4QiVVegbF/LANGSMnCDBTQsZUGLCgEiMlYfKAworTzRjopCzbUQahx6LcpwGUK88RSr4EQ18A1Hlhq6pOUUmjjH6BAiCo+EQmShNYgpthmApCQBgatZDsa4jBJNIKCvFFnRF55aU2qkxCuQJAAsqPlEYqg0KaggQlq4WBTGEOuOLqqRjEsZoBtTK0cB+USI+FqSDyK58ThAAdCSLJk+qYdLk6mXqolqksYo1LMPCZRSvvAnMJMT6dwgKdTEcQOr/yqo1ZKUU8OGYqEta84G/gV/xhFnSW8ipBsViWbhQYOYHAO5D1O/yFUCgmVbluSGgnibl4csFyRIsdTKGB0Pf2HDXjLlBX6miDqGWeJaPJslZjQPTuD86gdxuOQVCIRVmGwSKw5kk+I45qgvYYN0tCqgizGvZxGzC/dZBkUTGdI9VqVH50yQRsITil5qbRQqE0YoHDRWZ0u55sKLUzEPnQF2CbVQT5RiTr/QxjfXsoqhPwPHCTABpGJ9sfVsyyQE0B3C5dZ+gpaMOjRGOFWNgi/mzrLaz9y4HU0bFq9lnOAp1klM3f4z0USDIOU4j5N4a4pcNAK46X7Hy+71zawtI2xKCjhIqGX8g4CAS0S6elzfOkTUvwXDUq6JAJJZWOubLD4lUhhCf/3uGKo5fPRFADoo7lPKZihl43iABcvWKS0Y/YYXKuVOgIlFxYJb/Kv+WpJZxYKpcVMQoPcwemOi2BaR6RzpNBIoA51Sl62oNi6268UEgeNKi4AQAIihKUqoRK8FVPKU2i9PCUCNCRvc+l/qACRS2kBEhzvE7cKnNM1PWXY5qrokvwCVCdcidxDEvzRH4qr2kZK0Z0Cgn9EIhIIkBnhQ2LUKjMrVuBvGE0EUYEHIKgpY+lJQeD2nQocIdlNob9IWh8lZzWZJgf9PvhJ4gcwge6mjySAIQX6BcGAbqMi4QJaQGFYjiG+4bVyQtNirCvcQCc8LcbGQh1wAjfIoAmOoAX/2uC/Pm8L/AINMvB8oE4l1m3Tuo4pEEEXCnAkOqAGr4WGVAEB1AbTLG9EnC7Xpm1I5i0N6m0pGONYcuIOmsAb/AEGNiEB9GoqHuAbKkB7YialaoTARIJo5AcpVjBFmoN3cNAgcqEMhQcDSqAFEMorksU8EgOm3G08qgStXooAvMEHUGADScJ27I0hBEEF/CEOgiQCYICQlKIKTSspLKNtUkpSmIIGsyTUyA4NUcQSXMEDUEQbTOETMIAHGsClWAU9Cmr8RKKAlFBRKKMCvOEBQg7g7BAsvOMxaOAKhsEgbsAtIk8kDIBuTgFDOIxXhCJZ3q6wEIIJ/qFFLHFwIGE9/0jhH8whRRrAINzhCFDgA84LBh3DMNLrXHZoIDzsbnyQJBKFQ1zgM5rgAQhFAIYhqlghAbDgH7ynwFpuJcSAfVTi/JACE/7BEgiQS0RvGd/jkkRBSr6KBoqDOjzCqEQCppCLnLhwRLzBCG2tHv/hWCIyglAAv/7BGaThGs7oseBRHoXJILqx/AzIwwpPIEGDBlhyASzgH4jHPfqhAUJrY5CRMASrJgDwXEbiG4KgArah4MKm6lTiFNLpH46hH2ThIMjIEYTizDikRobkCfiwMMQgMT6gBUHjsmpQE2+QSw6BJSkBAzLsGjuMNHoCG4yFAPCNJwEvZnaDt6ASIXJhU/9sACScKib25iH/byQwZMlK4wcQglJYUjUOQCBx4R9wQRVmAAlckalGaTQKghXVTcbMYiQSZTCEQCUMYRg4jreEpZ/qiENOKSY84ioPQgW6QAJU4xEK0CUR8DBTRBqMYTH/4Z6EouSEYif6gwDKAH0Yoz+MRjviMix8gCMPMSQ2hR8M4hCTbQjWCS5vw4t4YiGMZiXYYAxoEzR8LEti4C/IjXCCwxj+oQGgwS5IcxiRwg6RL1U+4CucQpW+4xA3wTlF4h424R6es6/SIzIqDyGGgMNYrjRm0yA6IDxF4B8Tqjsd1Cmk4RgfAC3/4jgcQx8LYxvSQHf8ctr+4Q04IB7/zVAoekbxRO5hNkATGfRBWdQpfAMGmAACdGc9hog7CCAITmHlmMIqHWM5QkGi9AGNSGIw7nEYbwJTGk4kNAEBJREYWvRJl4J6ouEfEBIrJaVDXwonmi78yAKgttBPgIIOBIIr1s5xDkJ2CuEfbvEfKgmZkGkkUMDkMPRQmEAAVsBJY9LAYA40CBNKlyJCaQEujoAO4EgW2zNpdpImOETqpiyVgmKC6MJOEEIB/2G5HI4tmEAE/uFAY2hP9cIl/bQk0nMkqOcfpIEX4sUI5NMY60g8sOdrds1PEKOA2qwk/yEOnMsSMzUFkvShAlIvSEAvVrQAjQEaSlUkqOcarsHP/1RpgLxu2o6C0IDCMIXpA+jgJBYhHx6rKcoxVEePCXTgHzT1BiXREqEhOEhiF1YmXhSA6SZECE2yJ8gndiZvNz3iAXTTKT5G8bx1Qp7xPZQRDXEBXUnVIKShAWbhCAhgMpuCIS2SJOglQEMiRICCDURCRHlIG/s1S04ANCbuHzqgT/9hWB30GqQhGhLAvISxQJGwJ1lsJaazBa7gINAgCmQrM/h1Y5tiHjGFZGmzVGkBBjIM69TDYedoxvimKbjgHzjSID6k0uzGK5yOZe1lOnX280riDK4WRUr1I6XhEK2WNAorHFaiChAiJvqJO5vAOm1ua9/De/ICDbYADXjWbf85RQheYELFqFaDwlBXgmwjJSQuoArKQTaIwv2eQGLtdkJ6cSTqdnFVwxh4QRp24R8qFx655zfnwjjNrg+7ESkGASGW9igTgo4g91f/oj3k1gC2wHt2EXLXg3oi9BqCQzC4gt3kp+ZUUyQ6cyTCYXQFISmVooCo9klRF1M4FXb1YhekwRpO9h+mwSD8oRBcCy5BJmcRogRIohzm4EP/YQ+Ud3c8tUOSlEAMggR6NXyZYhpp9yNlIQGM4AgaKmnLAl5VQnhLIgTMtlAK8HipxX8nJH03lQYw9i800W0j9B944SBgIARwZjocADOo9UReVn2Vgm5VAoCVAhxKwg5UAwH/fdZbzdOCCxD6kitcieAfPjaESbiF7bZjXTiGZdjGAnaGbdh+xtc9VsB8A/iGfVhnBQBUf3iI7TaHlYKHiTiJlfgfYHiJnZhFH/eJpZiEo5hphHiKsXhxfUEvwjOLvdg9qphajuEgkveLzXhgjPhQNHWIUWE00kQlnOaMQYMDwrhDgjVYy1iGfWNUk8MduEAtvGHJ7mDKxMMgmsQgtNcgKjgcwqSCpSSNJQkNIBmDRy1P+xU3/wIa0rMYbmEWlAAL0GA6mqBx/IReHK5xHU5YYFOOrwWTm8I30uEfrCE4oEEAjCFIlsM4sA4o1ql3Rnd/0wiSByaKTZiVfWdU/yEA/8yTGv5hFlBBAGzBVEEnDQThGrNHJJZ2cI15m7lkhKdCGphZJKBhFtqiclmBOI4ACrqMf2lpdA1CulhURLHAfFM4hsOy7npkfQk2KZC5JMjZINYUBqQhDiAzCICzOZYjkRECfAenjuXYOdd0JZzUT49VKdqBnJ/XIIyBFv7BPG3hHYohFZDBGpBhEQYgASTqAVrIH3hqDvagewuXB0JCmNNYodrD8SSZU4LVIJKUuk7EfzX4Wirakkevot0jmts4APZZJJwBeg2iIooBmVLhGDYlSHjKJbv3d/WCXyC5d2q6X+oZIB2Xm5kmgf+BoyXXsfJhcmEhQpXgH2LtH+YAcArfg6ujh6zRMCAAACH5BAUNAP8ALGkAAABoAfIAAAj/AP8JHEiwoMGDCBMqLKhrocOHEAcuGDVCgQ0f/zgINPBvyz8DW7YckcBkyTKBlg52OsjoX0tGDCIa/GNwJU2BqGQqJKWzp8+fQIMKHUpUoLKBvQY+Kio0katEyxT886fxn5EXBz0OtGGBlNSVCSeh/JfyHzCIntDlGNipU6KCYFNueGfwLMJKlf6NYsq3r9+/gIFiwvQPkdi1/wgHXviWbMoi/1oUKYLExr8XR1r4QCOQc0EOSARmWPDQbsS0vdARbFkWYTUPoMgKFFGw8b+8eRfr3s27d88cngauDY5IoAnfAxsnonTZxgkgRYwIPMLBh4/MGD0bZAKRdG2BYP95/yeYNGLeS4kujYCoyaE25PDjy2eq+J8nRTJZ/EPs6bjOm2RpY4MNRYBSxAscaEeQPwu1IF5w/6wkFUFnJQIWaaa1tMAaYgFVCQ7oWXLJEgLhNt+JKKboUH3F+XRURJjgl5Qik3QIUY0CkWKJNpC5coINVfnEmT82uDJJa/9kYAmSFpK1wCRP/nOkjRHFVNAE//BUUG4FNeKQK++pKOaYgC3lkH4D4bfQcRAq8ggiyRgn00oLWOKKJUyUA8QLnGkVEYML2pDSAhkQtCRBiVjS2Fo4+uWKlrhxWZA5ZFZqKWCKqKlQcJpChIgJJhAXqkCIeNIiRJ0sKUJKrpyDUUdTyf/kQ5AC+VOEKwWlpChcA02ZUA5rDIVbmJcWa+xfnZb5U4uGFdoJKaRAVquQB9k6niVKThihQLaxhdBxksgZkQUEXYLXseim6xsLB+iUQ6ieIPbPI/UphMiLtXiyZAquAOHgQIAWpOBCDHqEBhO4IvmTAm9N0gkjnaxhxk+gaKnuxRg79ItAB6CpkAT/eLxQcDmAw6ZA2RSnSHkrPlILWZcAURVmR/hZKwcBI+TPEQITWdNCVCLUUlDtyURsxkgbK3JBai6tkC4QPgKhfWaauZBiK12yAmctAJGRwTljdASDORdks0AaOaWweAp1QqJ3cLe0UifjyfTKP58gBApzSff/ja7TBbX704sCmamIfoAXhEgOiSxwQgto2LBCI7QepNGrsBLEs0EMWjD0P42RllJb4ZLGiJIEcWfJAkOv5J9BleCKq0G4Fi0pJeaIog3ffvfuO1GJGySWoq7YIBmlXj+0+cCRbV4QEh5o0zjoWZLFiCWfGyQiidy2lv1AoJhDrMUExRZbQecz98rRv7fvvl/LHDgOPa5YwDzn/viDeUE+/FuQc0VYHSnWkyrv2Ih7bFOJQG5CA4OQiyBamh25ZicQ3hFEG156nwb7diqEqElwyRpIAbj1D0oJBAcCsUzZqlU5gnDgAQVhUBHQkL8X2GAB3pkdaXhCQVbJjoICkUTd/yYFJmjdZlIm/EfRBJLB92SwIEvcoBSRJrgVIYJOA3HHP96zwmrVqgXSMRtE/PGCcTBsAcBgABPWcwmFKcAC2jJII7DkkPPxZBTtOZ8Wc/cPL0WRIHuZoiABU0WFWM0+D2FB8AYSnAWMgIK4wopMaAhGgYWRIP86GxmLwJ1LPAo3pFmA7HJ0kFVUr4QFoc0/gGgOnlTifAQRhU/uNsha6uQAhSTIIQeyscL9g2UHAeGKiKGXDBRBAZbQ4k/0tz80SNIgBhuIVvzRAhtwRxMTskBZKqEAXB0tA5XQhCagJalcrXIg9btEEv9hin+4QxQNHIgsbUlPvoRwXsAcCAseAf+Of2hqlwZZJHjo5grIgGIZoZmWQs7mkWY6L4Ut5MiCIscE2LzHFa4wUSt1yKVSJIQUqnyiQGBZEA/Isp0GsWAfT1LPlg4klwvZJ0wF0s9+Fg6Y7ZppQZYSHGAsgAm0qQSPpAkU7VTnH2jgzAue+RBnMsEdmoidQESZ0USochmNIEcKFkK+h4jiqwKJp0vHCpSl6Qeg/nxEUgynEIFuSwTkokQlSAGE+wVFKzx7ARK6iJBp6vUfq8gLKIQ6V1EKhJaX+Gi3IGLSh1RCpWSNLEIUaRDFiEwZ9wzZZA9iiQ1kQTxMsKtC+DoQznDAQUWoHGk5EjCqMEEbqiCsK9Yzz1P/GoQnqtQJDjxgitr+g2+QlSw9f+HWhEjtZZoiTL0Kp9OEWCIvW2WCg/KXEJEIBEERQVBCF7SQs6ENCTTQhgdIYYENDKAgQDxIOf/CUlQK933NXUgvlGGCR3QKrRwbCMgMssupFQorSX3IUS8SQ4PYkEBJzR91ZWKzF0CGBgI47z+uMQEmQMsVlyCFRwUCjMS+98NCKSR+8+vPgZghNfg5ZFJqmlmBqGm5CdziQGC4kABfF23V0opejQCFHrsBCkegIWkVeoRLZsQGMIABL2BwjX94YCBdtS06IyIKFCoErALRhCnEC2K/3fchB8BPBx/xiI0ly2pVDB46dlmWSYzA/2uYScgKTWuZcySkCDZAAhRQ8IRT/OMUZajAAxI0xn889B9FgEEDoCGQDBfkEq8gxSVym6MHPiQAXc70QzDxsn90IDH/6LTi/kE4fR4EE1PjbEaM7EW0GWG7fv6HZQB2GTyr4x93OIUg/HwKAgi60ETa32VwUTRccYkUHmAOSVGUN01fzEy9PIgEWGCDdtUCEbUwU6oJop/5kvgf+9UsIsfdqwwUYByVVIhnGPRqAgzBBf/4wD+2oYCqDMkqRhhCvLfxjW1ggwAEEAQKNvOncQysBRgdS0G8cIIxrdfZxvqFm0Zs6lKXOCEd9GdxB0KMEdrgkkPmgBE+TgBdDwTgBv/Jc2jkLYaAY0MQKC+DClqoEHsT5AUZvcQn1qkJCywb4kBvK0SUYfGFuIlpCAGOqHsFmSFLM4woEIRAtlGQWw8ECVaf90GeIBBvbMHpmlMAFP7hA0C9QAGfqIRvg852hbRYIPUKTi9gnJC3P2QSHRhH8j5SYwZV4BuAJoDWpU4HOvyjAlBQwDcGIRAx/IMAHxD84wlQeKrrxB9QUME/hgAFCGCkBSbcWyMqUQ1L1GAglk7pYv7YdjIhziGZKo4nfnEUkhUdKI1KSQYyQHeH+LnP8ra84LchBq6jQAx+Dvg/pC5veU99+QT4BkS0YrNTfAMKLXAQCseJKx2lQARcKif/rihBD740ESFRZH3rUbT0hJjgXTkIGlAK1ats0H3ITRiIIMoA8P6jXPDONxCO93/PJxDS9w3epRBhhA2bJxBSxwF2lgmt1BrBJRCuQAnM4QGfRRQVuH4a1AuekBS35xNvkVjYUgDS4hCmdQpcJxDYQHXbIHkwqHUGEXkzSHWO9w/9hgJBAHalhQS69g1SB3ObFwQW6AqvkAikEEjBFRtPlhAbKBMohTcemDFrkXH/EG2KECqvMxQjkB7rkQHA0AFek4AH8Q0oUHIOCHBlMHkyaBBCKBAEYHla9wG8JnU+YIYHcQSaN4QoJ3CDIAgQ8AA2sAqqAAqNIEsekF5782RP/1gQawcR89RsVZg0EHIUhBMcJnBtRdEJl4BDvSJnMQRDXBd82wBzzBdv0ocN8CYGMRhvBfEBTzCEsLgNdogNosU5SMCAwkd13vB4f+YNLRAaogAKg5UXS0QDqWcQphCFPvFwlTgUuZgQI7QQypABZmIG5zAqLnZt9EcUPEEKGXA6WKFJsQIoLXAKEIAQsfZnuwZzjncKaeBrMyh1yPeOBNFraaCHBsGHkqd/BCEIhucP9JAJvwUKrkBSNDCFA5EFWdAezegTqNAI7BONyME81WiN/4AOdoYY7TIYfBFKyNQJdXUQBiBRJuYDKFAQpygQ7Sh9tXgK2/AA/OeAsDiE+v8meHSwDWVwCjTWVH+mb4BXgy4gBtjwAsTmAeeBkP9gAVaGENqAZRHxiNBokfDRS9nQLiPmMcoATJiQAdvWewihMJTSRh4GK/5wkie5YP8AQ9iQfMDoboLXfwRBAG85YwTRfAKRk3b4Z1THgBFhBOowdmkAAd7gDUP5Zx+wDSjwABfQChgICqZACQ00AZHIRM3GkASBaf/AmejzW1NplWXSfgsRbUk3Ndu2FHHiENzxFnUzJP7gTGZgBkuAES+kAoIQgAKhl3qpg8LnDRUQBDRJhwMBb1yXm1OHAg4QES0AQ31Adv+wjhAABafwDKdwByhwBAsZXgLxiAYhSxj4W5f/iRCYlhPvoX6iGR/Z9g/tYnd3x18DgYUEUSd1QgpOQQqu8C8GEJtHsARcUAJC8A+BwAWY12stCIxxSZfuqHyC94sPEIPS145isJJvcAcw2RNV8ZwE0Qd9kAYV8AFl8ARpgAS4QFIb8A9PCYkyUQwIsU7peSK79EE9IRZL9yYIsW2hRBZvQQrmAJtHUAVCMAdcMBDhwAVHoGtSV5wA13z/+HiRB5jBOHAVgAItKQi7hmtqkAQ/8XWWsY4CsQMAkwYo8AF04AJGgAsm9Yi38A+R2AiUIApZoIhruhBYIAACQQIe4GHtNVgvihxiSR8C0RAEIRahoyOkgWGVUAJZEJs+/1AFJTAHVfAPITCkCBAOJXAEH/ANdnkK8iimFzp5jwecaVCXgncKHXp4j7cNXCcGhreb0Ndv0ygQPzkQO9AHeYgRp/AB2GAEM7CQomCnCeElF4CiE+CdBoFpt5AFtiAQszBS2kCJGzYQ0dOngQEMopaR7qkTqNkJqzkQQ5MoAvFACkBGAVoO/zCkISAQVRAOc2AGQVAGdrgN6milB5Gbb+ClUzeX0YlUQYACFVAB/PYPZEAQOfgPbyBsCHEER/CcGloQMAR5RoAAcHqiG4gFCDFP40kQOEADySoQrNCsSmSB/7Ae4KNEVUmtzIkQ45ANnkYQ8lkY4yAvwnGjstchnv/Arf8gCQwAihYDYdzQACsAATwQqf9QDkX6D3OwB1zArl0gplQHc2kwBEl6cpKHEW+wnN8AmMQ5FQ/wAILAeCs5EHeQBgOLrw7Bal7qJ4Kwq8yaojkRFBOQBSf6D1nQrMeABXNrEOdDiShbVLBXAC1mNfKSA2vRhaGYDP0BISMAMSawEsqxVf9wC+lAC7tgA4EQDuc6B/8QDuEQApi7rkK6jqcIeRCAAi7QjvNGAG/AGW8Ac5bHawe6IAaAERCQBHegBguykghbYGAqnQZRBoLwDTaAplkQABsoACQwp0ExTxr4DySwrJqpF31bFDRHEIhRKr3XaeVRC++yLT8zOoz/MAIHIAFkZCUisABvUQqQOw3pkA6tYAQosARlsARsEAIhMAfhUAVV0ANz8AfpSACD4GsQQIQ2uWsVMHViOrV5+Q83EActgJID0Q83wAb8cA//0Ae2OAgzlxBRUE0C0QfrCKaw4hGchwQNsAGeKRDv8LZBgWmtUFt0lBCBNL0+4T8KoRi1QJpIES8EgWoJwQh/MAl/QLIZMQKIITpw9Q+08A/LmmwvIAZ3AG//kAbb0AX3KwRjMBX+kGvupo4mB3lligJtKBDrGLsIkQD/gMYy8A8akAA3IBBxEJ1kEAQlJ5OidWhEBZ1NoJ2AkBPKi6LAeiIZi7JBUr03khAvkwMt/wIcoSYlApEvwQExFrIeaznEI7AAHZAXYvUPsuBkB2UD6jCX2IkCv/gHZBObTcCCp+ANQfCh0CdvK+kPy5nFTUoQdPAA6nAPChAHGkABEXADMiADCSADDCKdKMeACHhnBtGwEKBrNnANs8DCAxHIPdEKBSHNNCwmnTCCRpEQkgAW4+AJC0AywfACaTkVEuBMI3AByzgQlbAAlcAzMOcCb5AG1rEENeQGfPIAq6wCKhl814kNYWuEDvENTeAPRGAHelAEDZfGCeCc/xAEEEAGQ8CKvcapetilArEFfeAPX/cAFTCibAoNWLCs/wAE9IAFjAYUaaq32QwUhuxCDjEO4/+AENlWCzX9ft5bE23BCIxQMEfgBgdwAWbAAZ2QACSQCuXzD594CefwDRUgCBVwHY76nwhQAgAaDtrQAtjwDQ9ApX/mACowkMxM0AgheEzABFMwD/8wDP8QB2k5sALbA8vHqZGnhrPKd/+wXQPBEVvgAG8wYbfAov/QrA3kAbOQwjrhJXxrEKrw0g9hw6U1EJUzMCH0dujAzR3SGBnQCX+QA2jgn0LABZCKtC8ABAlACzAwZUtyCTbgDadQAWiABEHqTkU7EOVQAgrwAFDAzwKJnXfwD4XwD3lAuwQtxXI4ENtQAc/pDwJwAlUBAWadBLbbkt8QeTpIAOqgIBBsECr/mQZogAVL3La2AAjMWthGIxCPPciwA9kQwQEaEdNAYbgcxgisEyGJ0AF00wkcoLnlIA7m6k5coAQ2AARfUA2mIFQCMQmJMAJFkAZ74ANQ0APLEA6Vqrmbi79c0AW9fdEEgAJ3sAURQAEDYcFRMKoDkXwK/MEs3pZ9EAR9AAYCEXVUZ5eCMATegOKbc5KRkXV9wFrACUNMMAMCALJ0G7nl/RMg8A8xTBCU4AFNPhAVmKLuLd+AkQgvYSHYsgBoUAKee7QCsbRLIAQc0AHkwADvTBAjYANQ4A0+UAFLMKn327lEegFV4ANpcAq8CAZokAdXIBARIBDDraHA23gH4QNk/xDcuJYGLsDogpcEbwBvMXjjgkB1p+ADXevd/DMIXpcRxjALs/BZy+qMRg4RpsDYCDEFv2VBUU4QcbsQzkitkn3lomEJwJAIGbAMLVAO+CsQmHuuyzAHcxAI5dACwjAQZbEADIAE3gAFrewNZfCLksoFPSAEQSoES7AFlr4Nw60CFLDGNxABMnAFaxzRjweTK/4PbwkBjKmDYfsEzyDj/3C6LkkQUP0AZfMC0rEFfv0A/hwrCtAAt2ALtlDqQTFHChHrB4EKVO7eBmHlTLEkbtEBS2ADZpCuvo655eCo4eAGfUAdQPADdZISWB4M55AGR+ANDsCpf6epZeAGRzAGbv9QBW6wBRXgAgIHAf6gAApwBf2QxleQBwRh1tiA3QSxDc9QAevYoEmKDdK+mIo5bytJAH0AZFp8ddPkAyowNgPxAtdgC56p8OZRQVDO5GYfEe0kCq3u8ALDG6ujKKPRCS8gBOFQDkM6pOUQAj3gBj4w0YM4CTUAinXyhR0AQypQAd7QtQ8AAV93ystwBGJqh3fgD+9AAgNB7nyXBpfjfLoZiyHttQB3Cvqm6ASwnA4oj7F9wA8gbGgAGVsAAXswaAVhsawgCwb/E5OJQnSEA9ZMEJYvEIoNFHnL9kzRGMDQCWs+AhjOuZtL2tYRBBIdBC2wADHQPRFCDJ+WBt4QOW7/YAZugAbwrWDhIARooGt38Ab+oAf/QATvoAeFoHl98AHvKn0aUcsHQe8+YH2TXvT/sJP8FoMAsU1QnwfqCh3xweHfwoUckKh78wANQ4ZGBsSRtTDLPxLHKH4EGfLfBFGo/kHDEUCUyIU4KG5kGVPmTJo1bd7EmbOhzpuM/nUS0cnVixJV5pRjyAUBmgdkIEDYAcHHgh//Flj9N0KSpX9pjPybU2KOkDllhfw5cgCJjwoulkAAQmKLnnkt/hkY82/bt2//Kjz4R6dMTAIEBJ3yBlgQAb0Lm3z4J2ihoCF7fPjzAejIkS0fbfxD+M/fRw4eF9qa9c90yNUUVX00JSrA/7/UtFKHtDUSJDSevX3/Bg5yIk4OdoPPXNCJQY4XXML9C/e8SgkOEP5ZfzrmRY2siRYm6gQeYZUQCy9A/8flXwkuJdBA+YANhT8BU0gw8dfnX5Ag/+4wJKACH7xhTKQPCPjPGwEfQEGQbfxL4gPICIDswDveuGwLASkazR8bRhNJASVyEyC3f24p8USKBMjoNoZW2sikfzaC0SYsigEkI4puCYmVhXSkYaEgjyOySCOPjOmqRCRJZAvn/qminHBK6MKHIKy7jgwfgEhhgQVqsCS5MBfgAIU7vOnro3Cq+AcBBXxA4ZQdighliiP82eGOByCgsDBsHjiFgG0gaGsIkf8EEeQDFFwQUJ1nThHDhTfocIEAMQr7IM09AOssJB9eWKhTitCYYcdjZpsFRWRQQZE2ZEz8h5IJPDApABKCSw3WhQT4R0fakAQ2WOCGE5aiBRiZJAMOzJjjuX9CCMHKf3ZYCCoIMvhhARGYGGGh8Do5YognGPJmoXCQ4sKoHABr4h8misiPjCshWPSfD6Co4J8+/xEjTYYM/UggAlzwIRAGAdzmg4S/OeUfKCDw5s6JQAz1HxuIZQhEBf6Jg+N/eMViIV6cOUYAVmQ5JjeY/gHFA1E2oEHGmGhhKeSQVio2Z513/giRhRDJgaZJGLHkiAdKKEfKcLiAgIz9sNQyA2D/EklkgUQuCVMcI7ZBQQwAn1FnIefCcfOBx7bYoo8gBuVvMAobVuIIxk65Iw0UFnpbsoUqFFSQCiBQwGB+GUMQBRS2eeCBNLYhAEPRfMh4IX+QYMmGBo6ZxSNeUUvHFkf0GSCfaP6hpbV/TAnAg1sCmCWL2USiuRidcvORZ9tvP04ZTxTxpKZJgOnEjQegKC+cOf7QD8vrpJpEB2AWCnOEAhaA0wWKBH3GDfWgROAIb5roY4s05G36wA8SJSCNPUIS1F68KXJBYUFcUIcAf+bgAtA7JGyCjD0dOEWgvvGEhomGYv4YTQuOULNisGIWthBArhaCBWoshB//aAbNQIKD/yygSEaNMMXKTvOPAJiOIrxhiK5wt0IW9iYZPmNIL/4RtJAA7xIvQMEDqsCFZcyhC31wGkOa5gNLJAJ4V6mEJT5hg20UsDHfON83ujCHHXLhCIIYBAQ+QIbC0IEADYqMvcIGmsFEhgBc1BtIzkeAZ2yjUmkAlcGegQICQOABASLAKfyGgnyp4CMIBM1oFBISICSgNs5ohuwE4IEZyYSRoljFP1rxkWIUA1aoiUkAdOQRHeVGhS0EZSg/4ola/KyU/5AhRSZBkU5Ywg1BQAEEeIiALmDnOtcJgg+AshBGiGABWDuCHg/EuH98g3HeWMLS1nSECnijQXrsk4QYY70q8P+AIdbbBhkgs41LUURCkHHANty4tqkIgUqA8UbD0vmPUzwgMQ9Y4BZ8ME8O+ABtR0DDZ/4hqoW8oAEMoUUzWDI62JhiIZX4R0lo45KPzCIAtqFJ6UQ5UYqyxAQz/Mcj/vHCj6xyIZMYwboCCB0qqu0fQdxBLr0EvURY4hUHeEAFEnUgdi5GUEaZDhKgoMdnGCZQkRnMEBClDnM+IAgucIALrDeZB02mpwKJjDghMwYHvIAHF1jgQgDzjxcgZJ5QUMEz/IgGBG4BDRzwxxG6CqqQkOAavPJVS0ImAG4MgyGmaIVBGUKJf6ACB351EUNk4QtkyI4ltSNSXCu62N/UYhL/uvuHLv7RO5BMYhKe6MAkJIACb+zBeGyAwLyqJRUFiOAfwFBAIkrBBLZUam+G0ZcLurCQcliRAH1xEKLGlShspDMI6pmDDx5UN4r8x42Q0dsdJOOANDigK//wQRUsMQIhdMUHCyQrWf3BFBUsYRCQw9g/ijAcijHEBjPgFWIpEjJkUGMY0liIKPT6j0YwxCXFsNlCbsWKk9ECGTYRaG8+yVgC/8OjDOkASyh7UYyyZBImGJoRsPGNCuCvCiZdiNMc0AJteWcBGYjEC1TQF68xBBuF+cYcGIKAKDzmegt50IM+0AUh8GBjDtgqSIz7ARckDMb/WOo/xgCBBfIgHBwA/wwgJceQzhQCCh2qmA+K0FWRZGEaLGngQlKhD2n4yiUKXcjrQgLfZvwXvse5VYHVTBHjMISyOHlzTEzQiRwkwgYuEAQbwFIFW2IpCC/oxCUSQQogzOEFRhgEZCiix94mM10IQMIdgay3bU6GDt5QT3UZ8h9OL2R/DNnG/vbX3P0sJAoO8IEZFhKIPYSXyaEqhEhs8IIXuNpd/ViICTE4jHw0Yxg+0uAERiIKHNhsArNBFUjs+l8idWzNz2ZIC2xwSpooYyGlpKFIuGLg8PzCbn0Jh6b3w5+TQmAJl/hHByJhhJjiWagLOcUHTrEXpZyrBG4IYJD7VIbCDKwC5qwC5P8W0p9/OCAIzl3IUuPXmE6nQcgMQQoChPAVmaBBBYMkjRHaTJEXSOMYshDAMez6I4oYohnNYEU0YBUAVpEQGgGY5CwmoN6FnNlINIf2RFuw8X9ggiK96wRIrK1RGmZ7JsToxB2fEYJyhCDHy8tlBrAWCTe0ljGKnowgFOQcLoRAGWM4nPvch6kDlUviLyC48krNkDRYr1zDfW5IphMJHqhaNDGRJ0jswgG2MmQ4G/sHfwFKuoXowxkYXIjsgiSALBh2Fi4aMEWk4WyZqFexOcf8QjLws38oYiHPE0nQfUIRGIJkasDQxhHocAfP3ju01OrPlZRxiUjY4Ahg7NOlDiT/qDeopxzNekETErU3ResNBeorAQJK8JEg5GUhziX4A6z3AVIjfCFj0M9Cll+CSMQtJxNBA1sF7pkTFQMZtLAHMjjxD3v8YxH/GMA/YNAxWtugCEwQgCWhUQxUTDKih5+J1Tg8Dco8aOO5hcAERPA5bwm9OFsIZbA201uAJTCCD9i6cAAMtXuKF4iEFrAbYpqMgUkYQegCrpuOPribbSgXNAmjb1iMhaiuSLCYB4gChoiCIROJ/nA46xMJFUu+Ulmym6A4W0MDGriGfyiGkWs/ZBiGekgAZ1CAQXqBc8ACayqGRSIBnLEJZhMJlHEGxDoGnCvAzPMEn4Gh0qMIBjMD/4+yOzT8B67gikTQhK1hlN9LA+sgt4FDCHVAgTTpi+KTt23gunJQgheYozjpglOogAI5BTz7B0xbCOVbghZwgD64QQeIAoLzj1Jzrv+4vofjQTdQgvNAAARQAnrgCRuAHCX7iBdIAC78h1j4B0OQPwUgr+3iAA74igRQkcRjiCu7CVhgCJQDCQAcw2LBuN6YCM9biASDIc8zOpxgAlfYNlKohHG4o23wLDPAsFLLJRUogxeUMUGRqm/ggnOcks3YhjRIg8RJnMRQh3S6LT1jiLFoATeQHB/IC4LTRJCAgCHjwYVQsX9QgjkwByPYghaYp4TwAVsjDbbiJ47rh2tApP9hOMJroIFBcrWz+od/ugVASKjfyAiRC7x/gMVjzLlkTLcFRARPiECRuAqYzApScAWGAAUFYBBBYBMzgIoMGzcIwAZ9Ebv2OYxyqQIzMAMkOKtyeUR3SgOmZIgH8IElKAEeqK4L4IEq4RDlyQvne7jruAPryD58jEQV06cluIztQgM0kCe0YYktYKvy+qMWwIJrgIF+kAUaMA5/MACRQAO7yA1jKBJdQ0loG6RsGId/GIfEVEyGoDacYASfUIARYIKEwoHbUxAECDhNJLgBKQMfAxB5C4IuyAYF2IMlqDW2UER4KybDOQUpSgMVUAAjsIGaRIALKESQ8Ict4Echooj/O/wIN7CmEjiX6hIChXBIkfAHWktOiuC7vruJurQFAswJFGq/y1uIfIg/nGCFkyzM45CAaOOqbNC8HPAo8jSBDHjMj/AOlliChajJf4CHAPiK26KirOLHHUicCviAzyQcrnGAEJiSHrDFBYIChmk7Y3IiClOBLlgCJRACJcgAd9g+cQvCfeqDgIy9j4CC9ZgDwfTQs1syufTLWSPRvvyjEZ0Jfxgk1CgswgyJTwhJhliE/6IFMfyHfMiJUAiw70QSCWgBvquFHHiezeuEzSsS1ZEHaIATvdihGgwJWApKe0kYC6wC46kCUPGBFkCDbxiExPAGyPEjd/SBxMmAObgA/wRoEzVVvu25uz/yAZP6j36kCGtqD4EMC5WkCQ8xK5wgUeGQCMAoAtWYBeoUCVPwAoa4hZXgFYqgPIrQIOwUCVZ4VB+9ifAECUxlCE39iYWgISQ1khOgF0H4hj0ogSvBJW+EkyHQoyFwwVPwLCGwxYWwJ3ZaCCPgABVQh86IyBYQi+QrCw8FC4o4CxKVp6cQFR54TzVdsX/AgH8AQuK4kz89jo2bBcOKiQngkYVgJIaqCYEyxppYhJGz1N6QgObUGRBYAYqgMSPIxIWgllsig7spDG/ognIoRXySyn8gFqiEguQkRfZoEzxVAuMYDiOg1oroJ4FEgEw7lxzYzZwAlf+E/Q1dtAEO0CeayC8sa1TWwIn3Ktfg4Lmd2xknoIJ/AAK7eYLDKAE2sBLloRYISAMKuYNvWAKyCLgy/QiHSxw0UIfkRAOlWAgIXYgZUDE1XYJBsrvLqJh9kpyreoEvcNZ/EMxZEy8b2IKsAo2tHb+YoFjg+AwBgAEcFQlbSDPuxIlm+MJwDdmKtR0qyISMXRTn6FqG6I8uMANtCAEhMIMx2AJ42hOK8IYOBQ1RoVYl4AJ3GIdZswEbKIELQNOFWIC9Q4AeWII/zYFRRFztC4tlqC4lyNiMoRiFDC89PZLws5giqC4QpSiBsitDbdvM2wAAmRudnAMorZb+GDIj6IH/KugEDogCmdVZtSNLuKyYFwAMN1AHwEADJPgMG1CAJeCC8xACDEAAXIDBL4gEyvkFJeCB8YOySBAC5VsId6CHcLAmIRACdN0up2WIukWSv9yYF+AFVhDG8wsJmZkFVhCAji2WkJMJ2I3diTrZ81kIbzAKN3jXj8gLyNHHPFyI7BMNKLALt/wHI+CC9fmHQFABJDCCIzACc+qHEvjccEBSyFU+8jUyBJgDJTCB0egMMxACGRzI5zACI3gBG5jAV/NaFt0ntMqZ0VCChYCBf6iHHqUIWOmkShUWsh3gmdBU40A3nEAonJiAFsgXfvGLEviDabmlqEycrggCqQytBgYR/4UYvyO4AHOCNBymHCHggs9Q0xkoh0F9AST4VaqNBBWbgRpDADzATS7okDmI1uX7ByFIgBq7AHdgyIasCY7kAHQFCeiUCSmUhbf6Lx3xzo/gX2HxTrZ9YpwYDleIyZyYACf4B5P9BxAIiXkgIU1giMOQrXSx2+AluDEQuJgttbygmAcAESQwp38wARtAgioSmxdA3HPgVxtACh5gYRk85PVQACQIuikhSPXwhx5gU4q4gAtQChJGTjQ23VHxO+iSiU6xAVyV5H/g0oVoARqAL1uQhSz7B5AVCQHYVpORvH+wOZ4QqE0OZZs4wCqOT55wAv8TCVT+CAd5krU7KYe2W/+GwEGG4IDO2AMhKIdzIMujWIgoEQJlbgEkQAolKIHl42PN4yroRcUhxgDcjORhpQjsLelndYePkKcjeGly9mF+FQ2m2AJjZQjnpYlOydqFmIgzQ6xQkImSybWSXAi74gaZIFeRWD99oAhQDmh2ZghQ4VRW8g1X/ofZDeuYYIKcDCOdvI4MLDVbCgk7qls0oIcSWAIzKI+Ofg6q5AJQudnquk2GEDcOGFRDLoEFuOPxFYI7GU5mpQg0zU2eJq/iGD/wu+C6FaSN/IeH+AcV2AYHwKeZiEKQoLxjEEaRCCxbqB1WYOo4YAW7soWpZggnbgZJ1dGsnuSP4FQLIIV/qIT/gvYNX+hYVICHhWgFIgC7piqmgX1o5usPpwmip8hHHzACNKDKmlaxmqatchCCLuCAPUCCBXDmhcDeGrOE8FsCXjQGJZiBxs0AFDbFzlZTQ2YIXFC+EkjY3ZRKhRAkyuFpcp4IFF0IPyKAdhkpJwqJ0QAE/U5ihvCFSVWsuJIFgYLRj+kVY8TRQgWJyKPtTaUI3gYOH+Hfs8VeDKjMj3ABFdDMPosJPyPjO/ABNygBbWAIZ/kHcQgBLmgBIwiBQGje8Z2DUlSCZdg5pPgHU+UBpUQoTSvFw0aDIVZsbhYCJGjfmECDLZ0ItWKJKA8/FRiXTPGxJ/CGMuALHqYIBbCZ/9D9B2eAr2GQBdc+rKtGktnG8AwHCaPThvikgSm+CZlhhwkQtoWABhBIgX+4m4ZJkylxA68MIpkIrStpASFYBorQYOw2AzTQBnP6A38wAiUoRR4wtBegXmM4j0hAgyJg8oGV77hpAR6QwSZfDwSYAbRySP/ezRcA7HIWjn9wiH8YAjrwmjxaiHFhCH8BCXW4hokAldFeiGYgqIYySYaoB5ILV6X+B6sOFv/9zq6mCGyPCXMAhd+YB7GmCBBggpnVmwcBN7QUIrUDiT5Qu3DQYPRAl06H4+dAY/VFgBxoAaRYPsi9bGKBZoF0aX+4KpGYgcvdb5l4gQSKSOEYB0d5gv8nSBOsA5Ay8AaHTADAsAi7OrxmWARJpYh68E6rpkWrXj+dAEk5xwltpwhRAAG+MpIJ0ISyFj5+m5v0CAfISXSQCCLA0N0jsLvzSBrNVAoOOIcEcAf1mFZ/6OM7JmlSrN4pHOIVe2+svFxMV4JyeFaKOO8PqQnkRNi8q5yFoINtKCN406NFixw240WHyQdHqAdDqAdOoPaYWD9p9w1qh/v1k0WQiHCU13CWEOs+X4j6Ao4igII7sBQKEYOmugBpiVeW0Fp23h42+QfqXYhuWQ8b52kO+F0zUAKyqfxAWFY4Po9uRuELKGkQmRwlsO56FGQokwnICQ1czOklAxXE1xf/4z6fF8QbeXPfJeOAIxSveka8ZrBqJOYJNp+Jw8uNwPL7r9Yv3TCSHIBKxrgDFTgXBHCD0GIJ8lm7Fl++SCdIJOCAylWPP5DsfUKCcFgAsvDxxoXQ88DeTJhaU4yEM14IBWiDUvTrPWDFtwSIf/842Pjn7x+ag1vQGPTnr8W/UwQmThRIQOApgRo3btzy0JnBEzBC/TOUT2M9jv9CpVTpUuWily9nyaxp8ybOnDo5QtzpkoSAfxs2NvpX9J+Tbj41Pnty8d+2fxerlHAD4d8OmVc5slEp5MU/IeXMmOFwkOE/H/6qLDGHB8EFHi8WtJkjRAgCgRf+XcDDw4jCNCrm/4B9gQfPv0gIhFTxx/BgzRZgOQg86BCyQIbqBAnS+CHjN5vbMHtsgQzGPwX/WraUSXKpIZ36/h0TYGsp7ty6ZfbkKGE3cEQaEyHxVlGgoIxzUssks1VjkAcCw/0Lx+VfjxZulCDZ4hEN2i0+NJuZEwnDPxt/qphZMAIBj/jxlYRz2GcHoR1DlFBY7u9PDzz8M8MSDuX0Qk9baISZQEgoMAQBGVEUEYUvdbaRWS9Ic4MCWAwgkCGz1TRbPZz4dMg9N6Ui4kasAPcijEv9FuM/lHihkRM2FfCPJBoBY8QzLggS1Qf/CJIXAqoFwdFVEFy1pEBkaFQOddi10EkPR7gknv+CaRkhBA9fXTamP+K14JFDW/ThXR8QfNEDBQBkpgAGPRTIoEwvvEBZTS+o84QgBHizUWjfRLWRGBo9pRF4/1zTDCvOxPTPMPWwqBIyutVjSGsCHSNLi60dIxAWzdB4Kqr/zJhnTQGoNNQ/E1Tzz6xykPOSJxp18k8nk1jixkTblCHQNhmVcyxOSy6p5XXlhDUXH0eclVla0iIk0BJKSFvmDni08S0e3/Th0AMqhINACTwgsIwZR6DRwiRK8OmQAlwYaJMPRoClYJcaKdiCOioESuFTnRGAjU2DCsQBZdKYNKlALcXmUkuO7JRSa52+JpA9q2102z8gaWRiqiWbrJv/Ui8ls1EnO/IowRCCfCBzVA5s5INOUXzJ1x5CcPCHEEg02pFH/3TZwh+XOflED1/gQYESaikQYAlCzIEBumDeVY4NYzZ02U1H2IDWS2gJgvBF2BT5wVNFEqtRoipt0YINAyDzoSP6xJJpTchMLNOlG4mYCz+x1aMxR7RoZIsAo7rk+MmR8+bTjRqxI5Am/8iB0yTE8NqJJf8AsyMm/wwSKAGJFlnlP2DhBMEWXFyH3QtLCMGFD3x2hCfOBu3gwg5PfNEGBWa0/gUPxlwAFy4I4HKBMQhEcsEMPCjRtYFp4rmgRue0sP1GRki1UUY4LSrQVgz/A4NJJJ+EUy7/kMzR/yEcxb+Rxf9YnGJJHGXKiiymIRABuEgjIAmc5BKYm95shAoCUUrKNhKDf2SAI50AhiUSIZACAOMfvxiDkYZwqAv9oxxcEN9zXpKV6ghECC0YgRBCYAbwqQRnCgnCDnYAhh6IQyBcUIJiECDEIRIRXVy4QNXA1pCckM0lAPvGB2bGEbepZBsEONQ/PhMajbSACQkYxgA6Zo9UAMcQ/IDYTVijEVmwIgCKa9ExnNGMk4DEEfbInwLzeKoU2GRXNekRVCbilIuIYRt7mJ0borADKclkSeUJiw0UMIdlCEEtN+HAQgTSBzgs0g0CWQAPSlCOTBQRLlgr5WLuVCajGaQmRv9goEq6iIRTJGdtNvnAEChEy/J54yAMowEMFtEx+f0DjTHiH4j+AYmIzU8jx2AFyDhiKvoNU4/WPFUHGTAcmezIZQL5wBNsKRUX7GEjffhHFFySTo1U4Vx/6EQgqvAPNtBwI2g4p0Z8AIEdHCQHXyhBJIKIyoHCRQnGI1s9BwKYjnDvH0hQgQsIEKioYNFChurMNy70DYZs4QVYgEE9YqIPBLrEHn8DHE6muZFh2ISlGrFH/a4pU+BkoIM9GsE/LiGQRHSQI4iYBEcAWSQSQkWeApEnI0GIziWBcAxXKcHsuBCCsKiliS7xQb++BgUhKOECzTsl9LjwPFIKsXklcB7/ugDKHX/ZxAhHMABbNwIWFdhsIgbbyPk0skvknMIpaRlIQfKBIk7dJDbGVElM+YGbesTCJS4CFSxcugmOzVSPrjvQS1yWQQ3iBBOSOMADUFAkKwoklxX4x+x6t0Lo/ANKXSiBPNvJqFbWJKsGeYEQenDWOSBgDsvjAlyCS9AiCkE1ODGC7hiKhiKoIyLb4MyFSGsR516IhBT9QGh6d5IQcWyk+vhQZQ3IiqD8gybR3EhjPdap8FrzADfpwD+8uQAR7GQSRrjDUxZFgA9UYDkC8SSUOAIlHywHAOWYQzjmUAXA2NYlfejdP9wApt2ecojNK+WFi2jWOczBoLN1yWOs/3qtgkQlOU+ASkbQdpx/YCM5oYmbRfarEQ404BCLsNjfJisTk96DsC856YtoEYBZuApUlN1INTWyMfbu5LIxqoVAmPAPS/gxJ8JhxPhUcgoXsIEL4aCOVVK4kRWG4MvhEEcIqtAFS+IEq1UIUwkAMNw5o/KsXLjdhzlyBCfzpLkh/Ecun1Dip2zDUBfZojihwjYUPOAhHNHHIvKxCH00kyPOGIYjFHuxpbxGFrQAhECkUZMkM9lkLHDJqU+9Ko70dCOcVQl8NSIJoFYIr9j14bp8oNR/SMk5rdWIOPzLhSV8A8I44cCX1DWDtEbvrGZFq7PRBe1pl2AOVTMDPYSQZ/8Mbcmh6tjvKT4jiCFgIyMSosheaWkkldDhYP6wQQLqN6lhzg/HHLHHegWCTBidtBjFoIU0XBoTE3E3yUAudWU9MYkMaFCDgKygSnJVAKAighHnEIgLssgRF1SgCs4qxwVg+Y998noL8iyHPNPsjW80+CY+iEs4hFCC5Q3Xt3NenjaqxgEfaPslY5Nb6yLigkJludAKW9QW/wFFdgvkG+9OQD1QExNHmArSNjk4TvCIk07JQhZk5FunUqR1hOtm1T4pCK/+YQZGSPkfCwBGTzNYZR79YxK+kkSPNPgM5PwDxhgVCAKqJJ2sBHgrs8uL0k8BhcfsZCHZQoI7wsRbaWf/mIiVH+JZ0eWKcghhLy1QgIjR0DWXvFsBgkABXqEyPopIlJYIW3cV140GGgjkJOvVG0cOe6LdBEViSPYx2YMvEEuoJhGhq3siJgHIjRRAEh1Y/j9Q/80qhqPM/0C8SrYiYaMq2Bun6CVuzGIEJejLElWj83BnnoO7aAnZtBWIZF5CGT+rXioUrT9UXi+I0FxxihcZlkA4Qj8kU00cVjHoXkxtRIrwQ4rUz/3oBDJIDL5pRIpgnUwUg/CpikaYHW4gwT/kCpT9AykIRKv9A5ZxBAfBF8TlQGh9BoVAiEXcAQuh1kZAgK8JhA+EABcsx3J0wTYwmmX4xHdwgBBoA3gg/4EQJADNZR4CRE/zPFuGzRwPnIPMLQFCqA9HGJtcqUP5RMT5hIZEGMmwZNT4EJVnoMBzacQNnATf4M89OEJKkNo/wEIa6di++UQ1DUMoyCEB1sQb/oOp+EIGxognqYRxCQQjXBCtyQR8vYALsM3Z8F2gfADHcUEVWOI/HEEKpVMUjEE4UEl1hEBEDYGY7QRmqAMXvMBDKABeFNHy6AXg7cX15UUJIMESIAA9JBdHtFwmqsAduA108Z/SyUT/acSJTZfGSZ9MHM6mrEaniJpNNMMwOEOn5NtilRRO2IOIFNBGiMwgJtAI+kjdFUAnHAEBnJjrJUdETKJR0SB07MCTQP+AGZQAXwhEF+RTbtyLQ/iACqhDQuBWK+LCRgjRPwhkb8mOFC4AKypAQYjYtcQVGhhXRezShVBk+RyKuhEKbtheHy4FHQbfxLjUN57MCC4AUC3ilIkOq2VABrRAE2QccmQUwiCMN9zjlNDjOmlEH3gZO30bDupGECYEGvhA7qABB1yAthHR9S0lXDDG+qFLOaCBEmTAxbmELmqElghCIZVYzJzPaCHjuHHGS2BRGuSEYZXIP7zRTTRDM6hl8EHOSKbKAridjnjOP/BRB5RCERRiZyTHRQiL0kUICyFYCdjkyDnHN8wBlTjLHnyDRFUAL+IEZJyFQzAMePgD53GYRnD/gTEsZQmIUgsICB5cgD8ggCuIz5YwyFk8lEBgw0SYG0cMwWcI5vlEhRgs3cDsxgPuRCgoDkyphEjqw5JFjlsKRHrF5YskAn1pBFDtyvLVgl3eZSm8gCdRUWdU1EWU0+z8g2FCSTjsxV5wgRucwjN0YT421NdcZs69RCTsAT2igTb8g2KuRTh44EtsT08sCjaU2FD1pXne30aQEBVdk8jUw9hxhEhKzuGEzEyd2kY4aOT40XLW3UtYwiUkAikkQiW0gP7RUsyEoRd6QwXQo4IJQToFQRr4gBtMVTnkRSAoQIsJQgVooU9kT6MsRJoI5YEJRGfOnDG4gzbghT+YQ7o0/0YnkN8S2YQ/GEFznWNy3OY6YsRF6FeWqd5nsI0YEEDS4cYyRcA/3IBruERMLJNKWGAeycJ5IedudAIxzGVN2KUlHN8CvAABPMNEJJo42WTK+UAQXMUahMBy7EVhUsggvMi9VAaZXIYPZKYs/kMJdII28ABpZgAPzIECLKkQWMv7vQTA5JJjGonbEMm69aWRyNg3DQlU8F9FtSZH8JlKDGcBtoY9eCP+RIw1qimuLsWrTdkyxNg3XVHMuMQcuEMg/AFDmMEeIEAPIYB7VoAZPBcKFCJwZI9HVGuZKKoNaKajXgB33FmB2ABijM0RzEBDRKZG2IDAyIQZTqm6BUqgPP+BRISbolTpUsxGPpBMgrrE+0QAH2pEbFSaNcFlrubGrtoEfc3lKbSYxkGixm3ENkDVQCBko1ZBCHyDNwTKEOyaoSYqx0Zkz+WFENhAOPAAEvjDCyiBOKjiEQSClijRfZ5DRg3oFHEEgFoE29TsRhSJlsCeTZyEPmSKPuwmxQgEMuTrvfHmyRjZwCqQCFghRvzqNgSrIPUdVOyBDexMlSxBBXSBGVSkWZQMx14GGuBWj3JBCQAkYPDcBWRIAizIoeIJByCBwr4e+bBNgKYYsZxCliaKOhpJ+dxVEDSBGb6EyABsS8QUi7zPcW4ESXCCPsBCG8qEmVJgbijt0koOfWn/kESxTZFkqUs8V5GoaAV8Axr+wx2UmAu8gXSYg8jFSNg6BBr8AT2GxRLYwO0kBAeQrEO0ABcg1KFuxLs1l6HILM+qq8wO1RZJV05o40oMw0kA7EZgTD0gg+7V3j801q2+xDDdoUBU7xoJhEuxFDLQhPBxoOTUwEaIgPqmwAtUQFR8al45LAqUJeqBWw0dgSrMANqZzOvagBLkhRK0wBIkwKX6A88dwfccQWNUxqbak9xWRPyqWwTHL/lUCKFJB04YQjPAqvb+gz5YDK0iWTJB71I8YKX9jZElaDFY7uWaDDF0wwK0QBimod2+BAr0gYRIxQdswx0IAh14Q9wKASg0/wIlXKXrIqrYPsRnIgAOcIASlIBa+MMR7EFC+MMYbMslFcGQXFFFZVyWWlGoUtShrapADApp9R/d4oSIvI9A6EOSoREbuwRJ/Gz2LgUJG5ksxAGmtPCLAMNc4lROiaBGaME/iMAnmE5Ywi9HCGYFoGgZkFAZqE4aQMAhUgIutN3JWMZlcAAaCNH1LEEPqMNlPMADVPERRHFOiIF5Korn2m8uXVHqrBsFN51uyOEiQGPHcEIcJqDRlgRJLUU9LBMeCe1LCJBATEMva4T3Mpn54sTxiYBO/UMpRPM/EHINkMMhQwFnnAIZ55LqeQMEZKQVEYlsEoAR4MA/gMIU/AM6b/9qQgGvZI6JUXKBpBIhF3zPPo7HPlYx+IBPLqmNZ8iY3Yrl9CVaAjkDGWmEqNHqMHAjM9kDCS9Fv8pEcdpEQsclhKpECL7EJVyCNlSCBXAE+r4CKWCADXjDP3MzvcqyFb2mSkzEoOBC5dAALpyAVb3zUkAGeLRAV1HGV1QxGjRa2N7nQGhEJO+Xfg1M6xGMXRVvAqUCMoBEY4UIxDgCKzi09RITjHDvS3BCPpAEMhzD4lbWIzCZK6iEFnRDKShFEWBwsC5yDV/E0NnfMLrNRRgDKPyDB6RzZzIwWOB0jVaGgzjGV3CAKhrw6wahVdb1wn7TzKiOoHFz22gcTL4E3RL/r08Mg6f9AzR6cGcjw3i9hKW01Kh58E64FEiEsAhrRDLHpQkgwk/dRCm4xFlTggjIQQYYQUUAIBZpqSpbxCmQYRZJlOqVzwZkwT8g9y3sdWXQDapoMlY5BBS4CxpsS2JnD0dAhjqIlsax3kX45XGkcXKMd0YuskZsKW5IAyw4QyikNmdbTEyQkcDKxkYQc0nYQy788otENI2YQK78gjLASFX+gwnkxCVUgkDEQE+RAinUgJRJ36FQ8JQW2oX0X5FwHDubwgQIRTpfcm+0QOvSiD9g0mW0QBRfN6sMY+qVVl+KkEok3Vu7RGeI08qld8S4CDX6j2rjhDdOdDHpj/3A/wh/s1dGq0QRvLZw7ARnJYImuIIm1EAYkMIRoMATPAEZpvGUPoW5PcUg2PWi0MCG/4OGz0ARoGeqgIdZCKUBHzaKywQXBmiW+61MDItFCuj4uCbCFMv8luVFrPJGyCAdaEQ+GMIAIkMqNMNY5wb0JiAkYMxqnJR+n7YClbU1MThHvJrLTOg//MAoRAlnhIbMEBpyDC7+uQ094MBei4JGeIFAYqXk8HOZWHdiy8QDGEEZ1KaV7hfa8B3NzqtGQJct0e0ReLNN3EEaC4SpmAo0IkPkAuKLVOA/cLWJpIg+qJRuxIK187E3/UMl6NRs30R8dsMIlvnTyrJUTMi8fncYtv/eA0gZFnAEJUxAK2xEEQA2bmiyAcT6dbusPYlPExCjXSkservEhOwVRpyNsJjjfhkHwfe6ALQt0QrER6aKGJlEAKqEj+NGpPPxS7gpR8TnJajCP2DwdQL02wAaQYuxqP6gdLiKQKh6FtwCOtf6uWby17zbvv+uXFEIjDksUu+f8qrEKwdojPPdU9ABNgzKum7DNjSp2zhkZ5dMSgw5L8DIMl9TLwiEzfgEMfCUQEzzPxTsRrhCXoNC5lDQN3VhMVYECU2pVKx7oqQBEvQ1Ou/1XkvZIdZ7AxtqK10G9iR20cSSTVSEcG8RBZO3OsbrUBF7GWsENjD+ShPVZV2DRgj/0KSdii7r8sGZ1EY4DE5UrxglemUZj0oUOUdrxLfLhCaMIDUvwbBj9sJyxuvd3zaEk1Q8QRoYATQgdzpPACi0Ag28wB2IgZ/ZgBGLeEPI+qzjxg4TSzG+xBUl79rs39scyqFQ0YBCfk2ASlj/Q7Pvhoi0hNG+zzDoQz5svEYgQyiISEzE8U24f8koQw7ohptawrd3ukogeHxWwtm/widoCUB8+DeQ4D8xBP4hJIgQoaBT2wwSkPiiAY4J/1Bt+DfF2oN/Lk4NRFPQX0GTJ1Gm/OeP5ZGVJVnGlJnSh4+BKFAKWiixYEiTDv9hAzrwlCChpxgWJBByG1KVTwmm+icN/9m/YVCxFqxnqN4/ZIYIgh3IKZ8jfVh3OVv0b1HZrG/hvn30y6QbghLiliI18JJJSv8omftLSdPAI0UHiiGo+APCojoJQtxGAGJCF0dw4BhIaYOHCa2OHLxDQJ0NGwNLoo4LNbW/Iy9kxo5Jk8O/2ikFJpxc+R/vgduwbfsW9J/AJzoFfZP87UNIb/+e/PPYW2Xuk6xM8lqNtd5WZPby/bNHsN54R9IWjUc5oCCta9dOiv3naPt2T09zIDKZgyA6uJcSqaGvk0AZyJR/CkOQqH+c4mmgpAhoTJAIF/xHp9yM2QCVLALIApVbimHwm6JUqM/Ef1qwYTbZWEppC5vQcP+jguH+UaGhooZAKceHFupxKQZFXOgUbJ5rgkGXCPKpIMUIKOMbhbbDzpnVxtOHE3sWOcukRerJRUuUvjxJvRNNNAEq/gh6pL5EGAEmEWIYQIkSVzRxBZRKBqphoDSo8604CiW08BTreiNgwhfoaeWfi27J4p8Z/uHzQRrJjEvFFmVjjaDhCMhxoOEEgewnC6EKVSfeIDLqKT8HEtXEY/4pZsqsuiJIH33MsifMgW7dZNenpBlIqrc2qdSk+05aACs0sQKGkX8KGChOkyz4p0CCXjHJpx8HZeighBoTaChwK/CBBkUdvQiLBpAYCBuBoDQWKzSKGAnTmVLD6keCHBr/l9+Q3lVpKYeaEshg4lr9h1Lc/v3HU7i4IYgTuNYaKMsB3CoILEPuqdikXYItqCqC6Ftt5PruMxOlBTp5CpPVJAHmH2IKGsGVf0bBcyBtNCnlkksU+K2x4gSxDiFxi2KOuoVQgACJazxAxYN/NIPmhMSG2OYDnZCU9ykjart3pnn/wYkyHgXJkbKCtg4VpYbcVli430g1CMicSAVKkFTrfisagv6GK8wsSzZpYhMPf+qsX73+R+V/bg7a2EQKotwkTSr5ZKBR/uFZhFIWcKloCP8pg2/k/iRgYTFQgOK0DUTR6B8PFE1o0DImVMGIxp+yoYWXUJvtLSgqOCikhZPC/6q5ux9MyHkoQ2rulN0rQC6k6weKjtuFT0qAd4IOQUm+7RJfDdbGH89gCZQm+QfZ+mg+6eaC5v/ZpdwANck6gXZTrqBvOACNv7zCA9Xi0BFQ8Iw/QccI+fpeQV7wguC9xIFYaUEXtrG3tRWEVSoxlUkks7TegEprdHOIGNwWqqPlzVUnmQ6Z8jEmlZSsHsWCCuMeuJ1OWAJy/1BWDlEyIJw1oi99qcTuujU0Bw2qOEJRW0J0chBB0OEUHGiEKjRRoAJtABpGaIi7BnIbIIZRRSshSIvi4pG0MUhUCOkgSo7Cwec5TzEDcUFxKuCSgQ0BKEU5BWNyhA2HOY9Ud2wce/9Scriz5AORKiFLlngXCmP94Q97oQdB1ke/1VguLn0ZhSuUdYQPzC0lj5GMEye0GEGggAOaaMS1BgIKXCDhAy5gjqEGcpoxisSMZ0QjXI6gk+gQsj7+G4h1jNJCUtbNkJHZRggLYiqEfGOYAzFSBY2Vi4GMJx+GyMfE2sKrlIRHPE/pCie0uUuUTM0CCzDHDwcCT7hwMit1esUlSCECGzxhW6UsHZOQcj1RoeAICviEK7rxDy8MBBoKSANOinOKO1QACTBRpxgtqpqsoAEKhBRVZfz4lOUp6STHW4pCTvEN7vlojgzBXkJw+cwP4AQCjQvfP8qij3BiyR7s8RhKtqL/zu2YgRGZ/F4i9gKXBQzoCJT5xnPgKCp/HcwbTfBBDjR3iWogyBytUMADDPWBb0xRDArgwBbUaYPbwKQ1cEHDYVJVzf/RbTvAEY5wvOHUVKKADCgJITQFlVco9pNQleLHT/+hj7K4pXDlIwh7xreaieEQLsxynElAOZAltKxSpJAnVH5GEDc0ByLIvJ5A6tjPeCEkDUZoxD9eCxiqYcEmzWEIaQaC1jG+wCXYXI0PmgkqpyQMKohJnqCIO9bT4sRIUIgXdVzq0mMS03pADM/iyskPgvDDEZzYRMUWAZZ6eEy7Qn2Kmdbwh5PwECWSe4vMOlkJHhrBBXXEW0r8tMoH/xxBFQVa6EBuwQWwjvKYYlCBLnfZAgkaaySJsdBKC3uS5EBzrDRSTGX8pxOGPKcCcuNXn/wXoRDat4U5PJw9zEJDsGhTPmLBzsnMmxL/qBcuf3hcSkL7D5klFSt/wVwKKoGEvJY4pNXpiUBaIMB/0GAgsyABfCSikJmGBA2+bRwHTtNgMvlgRDq53nNXFdDmASXECSkDt445U2uehCHDadAcW3XaBX5PHyw2CVdywY8IFIQ9WKAzYrOijIGYYA1waZ8nLAsVemJFG5XwpBH4hJz8ahiwJ9mGA1CAqAlMrckCKAYSwNoqAtwhR7/bpb16eSIO2MRfKrke3/oHzbVNRv9hCePNHQhiOoJsjTqqGpqsg1Lpk0iKIDasDznn8w+uJBbQTFZcSiCBEvoULsZvEeJqRLAMM6ixKGf+iXGh8oEmHOEEtfuHLWRxDBgw4QEoGCsfE6KABe+yXlsQ44l84A0UgLknce4XYqAiSJM8gSETohS/t/EwmN72IPASlEOSJ4ZvNKEPfP5HtB2LlXRCBS9QkWG141KLWrRvl5x7YZ/4lTWUp4QnD2BCA5xtC+w0gLZNUCBkEFJRIGo5l8aqzUP4bRIapa3EcYFS0VFiHWD/6QOoNAmFam0S+kQW5FUniCV4yN6B1OIk0VLnMhbAJ/s2KTlJ0jDLS/cPOrjACDP/uAUt0jEQVuwCELWZ0BA+UAY6qAAJ986hDVwy7y1j4xQkdfW/BVEGlLyUbcXpEROfYniJPKkgQ+jUUHijkDLg2iQ25O4Ds6EfMtEHErX6HmdbluhqMyN7CrEvB2kEEahWJpk6GQIHrjELWtiCFv9gBS360dQSBucgfReqBBFsrA4DnOkRafquPxDh5z89MkTBPET9ilqo8++DpuLeE/CaPCPN0PRWLwiKkw3EThCjE53IgSRQwlnzVo/50exnUMaFPTFYlQa7EIAAbMH3WGEGjEBr+kUh1EGoeM5r9I36COBb4kUxiu5CHJCYCELhUqLoLi96xAB3hGLXoi4uos38/7ZkIPjhphonAwbCEtaAEdqP63hnflBCZwhiBKovSX5DORam7H7Deu4ABVyuAQTgH2YhAIthBmxCMtyGANxrjFxjIEytUtDABwZB4HrDYChE4ponwopjpf5KhN7CYMaqUCajmlzlG5qJ1o6rIG7AcCjLRBSh6khOJdSrZZ7FcXIgGaDCEmrgs1SCBmMrljQhqRKkbBIuKQ7uyygPSELCMZBCDIaAIgIQ3QYCCx6ADhZxU2xDnVqgNk7DyuJiC6BABbzseZAjXlLpvnAJpRSmIaBkCIJD6I7uAVvKUGwxbSDCFvXH8FwADDTmHw4B0LbjAKBlIOIQE0SvPoTRWFTwH/+exQTe5ymaUCUuoRT+YUDMgdPMoUBocFM+COWWo3/YRoUgQx2ewAc85NxkYQhBbYFGLfqeAAmiEIg4oDa6plLQqvB8AjhW5ehcoGh0kGBwiVR8A9b0h67oJoOiz1QIzDKeKULiJTeEiQBcoAk6Kj7IBBOIoAMG4hfmYiCQDSqozlbSLyXa58aWBS4YwRLu8B94bCBc4RJE4BJo8GbwpBL+woNaCrmskM0ucOJ84ASM4R9uQRb+AQZqYzSiLMo+QAXu8YEMIDUaCBTjggOm4wOb520yr1tGZEQcJor86Lne6AbhzDF2AkqiTK7miim2wx8W8CTgayA4MizocgRPQh//JKlSUBIlGOEP2i8u5M8lZdBa/kEEMIsvCqMQo0lVwDEWPUwTH0KqKOMIZkAAhoEVBoDJUOAOJqPphuYJEjDGXmAejQWqRigET8KYiCJVVmoMTeL1qC8DKYTomm85wAWE1LI+KsgAwKEgYuAfLCEF/qEDmOAf4tBwUkI9qI0glvG8VEJZ4iclBI294sQwocIcNEExX6ESGkExv00pvswCQ4KPAqpJKgMiWsAYdoEVuCEBgoYOGJFBCkYF1MHv/gENdKtxbOJ7kAJU9MUketI3cIdUSIdVeGQr2cgsQQimIFCJeEIteePkoMIAUIM0hRMrIMEQIOEeVKK8jKUXonEg/zirfeDPJEzgDmGwIEqhZfxwILLlJBTztV5UJe6KOprJ+xLSbgYiKIVgGKQBKQWJDvanaARCAUgztx6oCKhyO6BgrL7wJIyGRj+F16rPNlOiJxeCC+kGC4emeSKEpMpAQuOCQgviPqzzHy40JcrPJNb0REJ0IO5w0VACTeRJWTjrElyB9Z7iEyiBEq5oIALRJYBDqlSrJ/rlKGAzIV4gAVghAQLQ/hzsCXBC50wCDRroH8jUWGxgP6WQIAaBYNwsjXQS4uCsICDqOXDubg5iYIajJ3liKWG1S3mHAzgSGC7hBIqTIFZgOE3iEDjUQ0kQToUVJW5mWkzCBrUBKhphWf8HA7au6CLchQdNaGl8gjYnbNcmBCI4QBp4wT2PKX8+yjCwKWzOqFIEr1OlowIOJugIAhsU7yTuaCAbQqxOp28EYdzKZlQm5B/75VNYs/EkYiyrbS5N8B8ybjWuIljrwxRE4R8aliAawAjgrY9OZScRQpBASjHqKGsqAAKuBga4TClwaY1gERsUoIwwlSA4YHca5xy+ZwvGYCAwsKQkoo8kLCxtx+EShgLpAPsoxbiw58uYCDYVAksfSBdQApY64GUa52AV9i0aIQsYVhM+wRRU4R+M4Dh2FhxRLjcETjIektZsAwYAIV/lc+ggwwBJo4FQDQq/pwiOdMseoAKayTD/omk3UM40d1ZLp8s4pmgg9DbqhMNUDtTISNASRIBpH+gNK6Ulv6cRGpYSJsAUQEAUjEH4emM8SZV0oKQpSPUDckQQ6mgLpCFkxKUxDAUxosM4TkEd1KHKUu0Ikq9SiqAI8vNEdGsLICABkS6i+tW2Pgx00yY3P0wCocNBwKgogkNzAcYhtLQCiK3qeEwH5jIbUOIQPi4rRlIlXPAfaCwr2sVrktUUXqsRJHdRsHY0kgN1JEw1a81/Mu9BXAAJ2mUpMMx5PAU4+IcO1EHe8mUkWFZejMA0GscfWsAHfMIjZqTouhQxkCMWmyIrK4/ohELgOnfoJGzCsOHCniJTy7Uk/zyYTJztLbTrLID1LbaXTOSUIDiHIFYYKkDAFD6hEaCVEozhNE6KPBePW3j4zRrvHxDoy55rg7CBAFDAIb5Bd94ybrejBVzWPlXNBx7AVZ9C4vplRxhRuiTYj/7tHzrMmnIxJxgPJZb0H3QrhEEue58ihZ+iE2wQK9jkgQ4kthJEFEoAh1OFR07iOSJYJ74WDLOH8r44hApLMiiDQraB72DjjJDgLevjBYzgXE9kJKYQArYFLd9G4FDnroZDOdKWhZBDhazDuYTNQiADG2Y21bICjd8iVwfiB7oOTGxoTE6Yd37IcQvCEkp0IKYxTxJB60xiGRqhG03iQKx2ID4BPv9E5bQuuWbzZteqFZe+hXWit9Usz16JYjjUwQgcWQpt4FJ5R/F6t2+pIzeGi1QK6yCig33tBpORN5sJogICV6NMYgsMAK1AmCBYWWXv83ZRApZdOCXCx1fSw2mfNi6C4RMqARSSNSVM4UA2QwjqVzfy5l3WhjF0sjKgLiFqqm9+IluJ4hkIQKQVAgleoMGY+C1a4wWKQJLJZN84Dy6ulW9MIpDCJS4EliTewoOxCZvatiAwYYQLgGCxYhP4wVf0QY1N5I1Xo0WxYkY/oY4JAgRUAhf+gV0Eq4clmJ2T5Eco48ySJwg+JQOZ7wPo4OwUJjSfUgoHuJtXQ5AUIqcbD3T/mYffCk6M60O3qJJM/QGtKHSfT0LLKuhqvE4H4EKbBmCREsvYGoeYoaJ7sUIcTOIVCoQSyLcRtAGiT8Ii/mGilYTXGqMzHa+Km69LK04re8OPEeZCPsC+kICbGydF1vpEwC8otNQ3OHhQRzuavlVW/8cbnmSc6xlTAZuei1slUgOtFszZvG470K+cvoepVYKpR2CXnyIY/qEU6gRnNIcgVgErAgAHGsAGPvBC9Lj5UvMkdC0rV+kp1qZLVYcVscEBTsHbmECCioBM+rrKTBpJi0nCdGSNDjKMmyeP/5ONDO+gifWVCwIIYLm5oYKcjPpppfspRsFnOqc+RCEzjACq/46HrqAO3lalPp40J0LlW0KTA/Lb56qNNnm7YuXIxaECw9p7Nco4K24cKyBcwRsHu0fhFcihEWbUJK72JALgHwIgAK7BCFIpOZYnK5EmXhIcKuaWVdZwISwPd56ArtW1RE7DpePCH3hLlesqNiPjel6PiY5LuqacX151jLMidw+6ABDhN+GikXgcK/AEllCihR12IFrhAlRBFHBhDw6DuKbrbg7GYARpBwH5JxCuUEy5iZgnMsAvAcPXjE/EHwJ4ePxqH8u5OAgsmZ75wwC81BdUrp8WigmiGQcCaYuxPtp0IEaQQ78Hl1OCc0phhrs7JTTnakWBqi8AyVvBGHygCf8YU2AcBDZJnXR6AiKwbyeI4wNL621OAT4Hgj430URKIkVOhNeKQspqFonjiIvNXaTIM5mUl1SF2+qOmyBSgGALANbfwntQIgI2gbGL7XuMFSruiXMoYRngQuCv1hRwgR6OAD6Tvd+YaNF7cuFLPJUNWR0OOYqyIjpCwvjIxHeywvAg4o7OsNod4DgybGIn9r4sZGKHDn7znEx2nEz64R/wfDv83GtK4RU4R+AH4rtPouAJ4hNU4QImABdoAIHGUiGSB60VtHTiu2FO4TncTdpXqHlO4ZoTQ84KAjQz3cZRgwn8+SQqAEsHBWdZaki4+OHOvUdkNqI2kHkor839ezX/3n2jeCcZCcKVB2LPIiDaavktWtix32IZVsEV/FzXSwG75zglhh1QI8EUIuFRFACBxSUDA0Zk28avyFIMTCoyCECwbvBCPuiNBlIKx0El+IRdFybCxsptcoNSuKfzk871Wx65bYPVTeQR9OM4USIkC2I5/X47siUx/2EdBkKyYesVeh0rNONR2A0FxhIooowCE+Jb6iNeHt4CebLVFu4fwFylfWB3bpcDBKFE0puNjJZ5iqxVjiKZ3vU5oITE4sijeafKonLuU6KvH0hN7P4kBiACYOBEAOLTv4H/KhE8iBBhpVGXRv1rdImgwVGr/lEymDAjjn+RtGEoAoXAhycE/8UQGCjonyBsH0T+O7lNJIGUA7cN/Gbz5cGcD06dJBAz478PBFcKSvkt4bZTL8UoaCE0KkJ/NqAiLOMiY1KgKrueJLhV0BCa2P59W2lTUNKBbxCaIWlWLcKyUusSNGA3b0K8eTtkLJDwEbioN/Qa3mhYr8OBi6OKqzhQ1T9VJc6FXIqN6cAhS/+dElSG4M+UpJl+I6C57ilsg15+Pfg5tph/Nj8wPUXyZEu4hVrYSIwQr78jW/4VR/gtNdfOUWeb1Cna9dfUB/2aOXiS5lDgGY9n9MeXOxqEE4QWAPYvBaiCdgdnjDAQPsJNmwhCAt44qqjE2v5pkywUAActE8kqeP8YkYZaNm2Dk0oNCnJKS4LIRBRYNdEW1TYVMhjTgjfllNRR2iHkzVct3fHGFkj8Y4RV3P3jjz8cbAEFdi/lhBCD3mCI40AVEuShh3n1mFNKr714F5JKDoTeQJP8o0MHKfwTgyL/AFYXJgSx8s8hSi7zCncW4GCORXoJJNBAFyS0SiQHCYSADad8hg2E/9R5m51A/WQSTXRCmF1dR4Tkkm6wQWjbiLFFR4ALFTzwTwIR8GPDCweFp9cRp6Cw1odlHKnTT9BhONuEBHAFql7KzPWET/+MNxCmS86KZBZ+/fNDBzG8GIpdA/yzCK20NgJgQgI1UmCa/8zwggtP4BlUnV3/kUabIDFlhRKQtPV40HNp9PTBEAMd2SlKKYFok6gv1ejMQDfE8c8RSELRxSmzIafhiDyO+1pKRHHlalantBUVZAnRNVtr//AFHnf+ZCSrsFEx8Y8rAwEhsVD86GVKXomQQomSxWYEwkCvIIvLPzZMaOdtos5GlFg73eQZahBq91OeTaThTWjb0GSzaT4ONUTONktHFBpMJNDuMFecYIMR3KGAwqnbJZRdbJ3ee2hXZX1VwT9p1FVyXSc9fCmsGa9tFxFLdoBlQvKx/c8C/tF9EA5rYsDDC6e48NlRDnoY81HU1fSgSvoqbvi3GCKXEFFEBTnUTNvQ8Q8HCXApzT/D/6hsgw/cwfXjzBYSJHmq/nb1TxkQsI12xmrjXRcQFtwqFWCIDKRlB4hoWVd9EicyUMd4Q/PPNTP8g0smR5wq1s2Lbsd1tgSdsg2eiKL0E54DjZ1Qqy3zK2rQH5T1AR2n+DPANb4g808+izRzgw1IuBiVpU201BTr/8D1ks+opE5fAZVNnuCSU+yPbUGIVewMEDskcWB2tItbRnD3FyghAoN6EV5dVDEyoSzBYo1QUisyQZCR9SN5xvjHBChxDXCR5Gcn0cw2bvizsahEhwh5Qm1gwyASPQAFnrkatZTyuHGZ5AMqQIMClMCKawwAGfbIRyzyAYMXQIEDQuHiuEZ1kP+kEDBmZRHchSSHDatho1y0wxsavDirbOTFbXrZHZMIssErCcUQbRRWJlrxj1aU5yAJYN4/AhBIHBxBBYJAoI9qCKiB3KaIh7tJ+QJ4EKJ4o0YIQQ0lsRaqoeyvCQ+wVEYWYQgY2OMfSKBgUai2jTJsJYDqGheEyuWq15wmKU34h+jWho4+CoVhwLHECuohFVLQioMHkUB8uBNCYQYSkBmh5gWM4YMK8G8mV/tGiTo0LTPmqCgDwUb2SrcjFHCLNBMaJ+VGsj54IWMXnbsGJ/QRrHr0gxUtKMJBtgCpb5yGAN9wwVdcgLO4EMAkP2snTdKyUILICzhNkqaSXFk7D9j/ZQVLwoRf8mjHJa2JdgYbCGKigkhjXMMY0DCGpiKKEM3kTHqeIc1MBGiocfmEmzHxxo42I7nbEKVPRcnlN55AFHldoR43yAcfOQGsewwkFAmgAUKgwJRWfeBTtJHpKU5T0+0oKpMDCc0LOBDBvKRAmXmRBMRoBUeEwCpidrHARjm6pAKEFCEWtKhUJKMKav4DeVLpXD3/0Y8jCMIFY2EJ6v7BNQWdBCdHGQI2oOWgLw4lei/5QBCYwlMMnaucadFh9E7VxAQgkyDBGggk9EEQGLDoH1GYiYnKBaJsHSUoA0wIESWG16jArY1+0MQ/TEkrCwRXKOhZrgiEgrGo9NWv/4mRTMeggTxe/EO2dTEGPYuhspncDCHaaZUndSI9msoUNjWNUI9yVrNQTXJRfxsIvIC1CHwORKoJgVcampCaxWXETtCpJNvYekfDQJCuhrHrP5xJq1+cIC80WEFFhfLcukyXunrBAQgHco3tcreFdkmADVwQLrVsCGba4tCeRKQ4DpWFOiIizdhKR5sGocucRyljSrwhunrog4+LgB8WYauUBZ4uI1xhHL8E8Yyy5uVheImrUPbzD4r9Yz2JKU5aOTwQ/Fk1ulJZbl6whAldHGSvaxtpG8FbDPAepAGGpQUMkGDQC4kmaDgyilBjYydxgWosiyrRScRgJOhs5V/bIf8AXXzpjBvUI1h8rEsvsZWX1AVaLv9gg5LwVxcLXDgqC+bwl4WSAguQoGL/8AKtqvEPNWckBrKeFQrB/I9ikLgBCAnxP2QBAwWkwbbjVNCHMksbUZlWdZwZ145yciRu1QQmA3kGAVpwBSRHBSqpAg5NBCGG1PRhScjF9dpOfZDZTSkvGkVSrdsYzYREwniJIWwxkOfr5P2jAdeAwTWocY0YosBISIXLUgiaLpXcJpaenK++bFNE19BkCCSZDQFCkxCcJOUzKHgAFgaSj8JIBYBKKssgwuZXBx9kwwjZAoPNjRCVD2QKZ7CgDjIyYZgraRWCfRF4SYwQww4AEA94giD/IB6hxzV5Lc8uqukSop1TRHkod0BijjT0DxdUHSq/Ak7CE/PbgaBbmDdnudhjRatyZ0zLCCHGQdb98YysZxZMilsB5KhzhFDz1onxtZzrYgRrKXy0RmGvwsFilFNZTSgr+cYgpO2/6JDzAx9Agsij8mjq1NKNY18Sm9vIRe+wbcJmPggNbkEQQP7gH1PY0kDWfRD31OXzeUcILpAHXl4TxNchdgYPFPC6yQllG8+AvGh1LJUJYcMFv32dXaxGADIQjLuFzPRyhPmwzg9E7bPSfmLGg9GBhF+uBCHsQHLel3Vz1K5W/QesufOI2uulGLQQyi7+sQtjwIAWR0ABxLWT/2gowRk+oRkSslMXZzbeUAiz9Q/OJxU2wRQAhlxYEFfVkxFc5UYvlxAcwH2G8RvYF2bDlBjcEBU6sHoI0XoDQUfu9w/thmBEcHPylzG39w8lIA3ScA1/hxAJYAQikRvZUhsGtxyP9kXepBfItQd1cR0H4QMKwCUHgTMrAUb/0yPilBfjByMchlZ5Z2UhSEF0hxArOCt2BXt4JIMSwws6qF3JgwwwAAj/8AZZYVvrhRr9YmC9pRfLUA7hYBcl8A/qMHXxMjTecFklcYc1BTZ6URwaqHNYOCsY1QIt0IXpNhCyJzFieBA6QDxnCBxAJxQt1DkZYQzSMANH8Ax08COkgf9UX9EhONFtiREO4VAOeZGES+ANg3ASGLdx1gaFX5MRmuEGv/Qd5FcXaWWMmbMF3mcXk0g3DBZ+LfBGsOIXTzgQTFB6dnECNMB2CGEJB/EIgPFuCBGOSOJmSuKJUWENByEN95eOvCAL/6AAQ7R4A0FE6xVZhzNJejEH3FEhR4GLkiRgGRF20hQe44aMxkFdxWGQGcOIdQF7WHKNoUYQo8aJwnKOu/cPtICD9JgVKpZ1YsR4IxJEyZcQVWAYGAeQR/GDDPkimIIGyihNDSMeUuGIGWFmqwcYMchhuxN/FZkY1PAPNxgNCaAOQ5QWN0FgKOE905MoRFgXXBAOXJARCPD/gDtkFj4pfpyIF2gQHjW5dscgFCsYgzqJav/gYO02EG6HlcKSjglxfzd4DdLQAICABipAeaLFQ1mHbNTiIQE5i/8glXz4D4L5DwjQBVhoklA4ECRHXTBZkRTUkDSpF+0CHHolFMolc2tJXUDJC7ZwBUbAAWPwDw1EAA5AED81EJAyNhDwAJAyUaPZgFHhBn/Ah4T5Bwhxm/Inepo5Za40I8YBHqcGjW+VGCnIegRRhokxYTp5K2bHm8LSOV0ojM9JndTZApEZV155MW0UkbtSnRJzf9qlAt9JnuVJEM5UHNqJEMwYc8qZmVKRnJrph+ZJn/VJKwYwiY7ZRZnzKlER/5F6kYLt9w+YaJ9KwkYFiqBcyGHod35RgXoNGhUCCqEJqiS6R6EXam7qaRgcwKFe1JPUqIIMinMJ8Z68SW9+1XUYSje7qaKJEZkJAWqp8A+rZhgCgBBZYKPkeaItyqMtiin6uYwZIaEJQY3vaBdD2qNJCnP+kIwsqqTkGZ9PKqVT6pMvx5600itUWqBRkKAvmqASUG6OyAGgtpbOKUz9oaVpyqN8AX55d4JvNxBoVp8XaZ6i2aV5sSKayaIaKhTvRxACYJw0mhHDkKNliRAZdkHkSafmhmlqahf+wKdSAaR5wUURhIUa+jkEAS8rUKJC8aD/IACssAH/8FyF6qh2sf+GdWEOJaAAaDBEWZEGVXcaYrAjdvoP+xggBOFpHUg7kZoQQKptwoQXGshFL/AbxeGkbDMF/wkcOYqkpxoV5ncQqIcKAjADPOgP3vAGLqES41kBDXRcGRECg2lR6DZ+jOilL/KekWoldWEppsSiL5euIGp6eGOmp9qWswCUA5EOtFCt/0CBaHAKKKcTp2Ca4zEH4xoOyzAQIeAmf0mudpGsG4g36UoQWfqI/yQsyIWsBDEeycqVCGEVV5oQxikVphoVnUqhKYMQbakXYJhrA2ENtkALATALWAADM0ADHOADLtAEVdcjHDACAyGVBGGS5VC0LsqJyrhaYPYCE8s2JEv/EI5wYNA6EIuaENZgDOnwD9GQb7bwD2BrC7wAAzDwcT6gDmXAFAdbNwghL/NpbmSaFxB0KYlRejQgqGQ5K8DjphN6JTo4K+ihlt9pofymF9cADQ3gXSSWb5/4D1jrDAEQAKhgDfDzOTDwOUeQBtqUfRlRDonpMPWZqaYmf80AsIJ6ECIKHEQgAgTao/cHdMsjFXTHtenwc/uaa+AVir7wD7HwD7+SSjcwDOpwBGbgA1ShDhmBBzpnsbTiaqh7nK+XYP1JN9o3O2rDFwZAV3LLNp7op+ZpoQfRuEKhe0B3DbxmobNAd2ArrQkBP9FgC2CZkf+gD87ACgMAAzioANslQrcHIDFdSZ3PuqQLsxd3wb3auySqa7WHq2+GAbgZgQwy+mtuGcH9Vkj9kKcJ4atCscHCNABQ9SKvyTYdbG4oa1EBAQAh+QQFDQD/ACxmAAAAbgHyAAAI/wD/CRxIsKDBgwgTFkxWMIfCh/8e/VP2TxFDiBgPVqrERAIHgmj+hUTDAY2PFgeYiPvnKhFGRg9hZvwn8+CIfxP+RZP20BKph5dmCh1KtKjRo0iTHqVI0OFQRRIrVlRksBZSEf9IWRr1r4hAI0j+fdxSMKRADi9OuPrXadLDTjMVKDTByO3DeQLaBfgnQoskg5ZGVLqk7V+lgTeVKl7MuLFjxVQRYkI00KrRR72Y/nOKKBm6zUUtJfKZiONAJnL/tXixBY0/ga/LnhtoCWMMpTng0kwIitJAYg+1jSr8uLjx48iTG/T0DxFzor3AFVSESaAJT5NMTMqRIQPRS5ZKkf+y8W/FWvICfRw5IhY2wtQQXSqdZJft0QX/CP9rdDCY8v8ABiigQFFF1FxRjyhTXXWKHECQCdrlIKEJBf11kG4CkRLUPx4wYYNZBrUGkSVOGaUbhQU9J1AtGWB4UCsJEffTYYclxNWAOOaoo1EsMEaZIioaaF0OnjgHkSQFjHbJApeAR4o247CHFBrjLIHRArXRBhcj+CWCYlsGrfEPMPIVhIVAohxkgUGjfLLjm3DGKWdkvUhUJCKUYUQfMBli6YpcH8V2FBpGCJRYRt518odCvTyIokHQBODBQWu5stZAr2izjJycdopjZP/8UlCPBjFU4Ewl/iPmQHkqpKIk9Y3/Zg4TLxwlokBkUUlQmQdlyRYjnSzQwa5tJdPJo4/mR9AsLF1KUKW+CcSfp9RWK6CoOCJC1S+POFhQNpXV0sEkljBiiTZMJEWWQWggYYlbnbh4UCLe/fOHhQcxJ4kkqR60xE8COduswAK5ae3BCOd4AHpIITKZJ5hAddCCB2LXZWGC/rNuQRkfdMQWG5+V1T+8JiQvQQskUtdu1tX0EH4DmUPwQNMWZErCOOecEKgE8UwQed5CZFUtp/6DSaNRscDzI55QZ5nRdpWCRGwfIeQPGiFj9FoRusG8K6/JKsQAy/94rZCz5iQUrc5st33gQz4TKFDQRGEiUTLVTVcQZYiU/+iWaSIVccI//hROUOEc+FB1QiAWVFvJA+EHHLAJ+dqJJWKSS5AxCbmiyT++8adKQWmKskzNbqfuNt0HkdqYgwe4njeeAuXNSCLavODPC0BcQF7Wh3MgJeEFtYDQOIAPBFOwXObWyfLvEYRvQcQ5TlDaaf5TfcCqd8923D0fB6oiv1BHkCf1stWJKkXsbkEkpIz0EPADoXWQEebUmAh+ay0Q7D+S6ATkEmK2gaRjIJ8rCMAEsraCpG0//9DEA71HwU5JIEAskE5BWGe0guyvEuNAwwtccYly1EpjEEHD8AhivIO0QAHjEEglFrCADGBpAZSzBBMKOJQ0acNZC1TWP//SNsEhDkQbCTRHAyvIxCY+JEgD4RlVqJInt9SGFKMowgtA4YrkQaRwJhEJSFY4ECOUhAM2KIIrAEYKGjJiQz+ZGUJGcDOBZIElhanRQOS4RNDFTBS+SSCmnEjIHF2QYZDBSHV0USRM1AcI/ziBAlJAihbOxB9bWJxASOKxglTNFQtQQLAW8KeyDeUVIEAdQRZ4KTmSzo99LEUhZ2nIxVCGg8tpjrbyJILCAMEVKVAFekCmNcL5ICQGGAgZ/0FGs4RkHArQxk1gdokgRi4hl7AAKdYkMFL+AxSgmIkoRDEpWpoTQLhUjIqoks691YIyteEIesrhFfdk5DVZ44AlNxn/ltcISlAvOMcJlgE4UG4IZnr0n0BekZ8m8VAgTRIkRm5GHIme86L/cZ3EBqLBh7RqJp4oQAcYAZNKAAEIlEDXQOh3OI817gEGOeFDWsAEV4iii6SoxE8usZYf/mQEiTDHJ7ygpjUaRRSmoMTosofRpuLoAIpwHUaUoZmhjOYflqhEEX6SLnsOJSTA28Jr0IBIiLSgCPQoh04Pgp90aeMT3bCmKzMEEVPYVSDldKpe/+Ogpf2jUQmxm0CkihH5bEAgC+hqY07yj0L5owhgLaZIkLAEHGjCqBDVYwQVKENr9rCOew3tYopGkAPQ7RHgKwhpM0KhBUyABFhVgCatNhQf/zBTIC+Q6RfFKJDVKKAcLJGh2VTJF4j+owZH/QdoRctc4xAWE4KdCWETwggLeOAnfErhFk6yzIRwwAi7q+dAkunVljaOmUxwhzuidUcYpEMVGyIIVt401+Y2t2g5SK1BHjHdh6DIHCdzDHg5gIQj2CC3LZitQkIiqJAgwQKDg4FAGhCALK1pIGNLiinSlFf7ejghH00IuKyjDMAeZy1F/GLHVCMQ8m5yd0ZAgzr+oQIVKEAdCiiCER5w3hCdxbYDMZ40EjBhaPRxIImYr1I8MDqIfGIZ4fxwaNMJRalkpJ0HAcawMuCSHj9En0YIc5hfAOSBmOWsRlDBBwTxhCdsQ/8FBT4mRkISqIHYQAAJ2IsCLrXTNTWimjNpRIoPwlQpG5ogj8BWQfKWEaRZGcsH8Q6fWlBWxhnhCFD4xylo/A91HMG2gvJKEaBwCgIM4QME+Eeq32Dm+YkkxisVCQ2arFkPrEUTHqAEcYsyARyg4tDAHohm9HsU0wqE2AOZhBZ3+w/bcuABXTiFIFL9j294GkRVQ8KmCTBtQfxDEJs+BUxnQtaO+SMDvgE0XTVBCRr4ESOF/gdTcZCFLMQ72N3zMlG8Jar+GgXSHvzHsGZi4NdU4Bv/GIKq//EEbPwDCWYJs6YF8gGCDOEbpxhCBR6w4oOQ5QVI8EFscgUEUJCiEZX/mNRyC3KYanzzlQgphh3xDezqiAqqABfKahNSAIGI9yEibGwTtuFtg7igAmExiBj+4QJqe1sQ3q5AmTFSKHYxIRKuoEQlTBFfgciHp3QliCbWRIl5G0QUt8jCLO5Ic+Y66GkHqM4ud26c+pT3ILFRQam/AW5q/2MbCheIOk6hgoGUYSDfwPi0CXAKwrOUIBub8RtOgY0HwBQNJ8BFu5UYziQPJJxrjDJBopw9UWwAB8wSyF7aXkgXD4XR/6jyUwZip7hZyCVteZpQKoANbz9jIBXfBgEqrupnEGDpAtnGP5A/fIRPG+OZngkHKvDtIaAABSo4AgfOkSZTXCoopCDF//ctRb0Oz5wgbBcIDpLSQNGz3m10H4pEKFNlu3cQCbpNSGwqQACEf+AUxAd80sZt3/ZtpSYQp7B03uZ3lIcC45YRSMB/qSYGykcAaeADRmAJrfAJmvADKWAJlyBINDIQF0YQODAp6WcQKfh+baNvOaIIkeEcF2E9XvccP6d/zfYPH9Bw0/Z7AUhxOhiEBKF8Txd4xMdtjfd4ICEWKvANBPCEw/cPZYACaQBTCsAEoFAKKXAJmWJRAmEB5kAD7sZA2nMQ68eCF6VoBRE2USRsGdEqEGMCyRBiiLUAM4RViTBiM+EGaeACQigIqKZ8AvF0BQhuquZ3/1d0AoFwAwEFHf+HEGERhYz3Ad8gfNeXBsx0DgBACcuQVHtkEBtQOh4QTu5nELeAhoV0c/8AcFMEGrXAFERyHZOAPudjAixQYrFXC2xYNonQiwIxCRkQQ2WBEEfgDWmAAoj3AQ6niMM3fMxYEL2niATxBM8gdeSmAARAB2wmEEv3Ac8gBqeAAoPwAC9QDqNzXRaAhQNxZPfmGKWIikphPK6nc0JhAr+QA4+iDBmAjwJRZY3iCUzzDz2XEJ53CcAgCZZAHj1mFuTFAQ5XAd4miH4HfBP4AWJAAN7wDciIgJpWdBM5bY33iPcDkUMAboKADb9HAE9wkXfwD2mABCXwTR5QgngVbzjAVO3/KBCAAI9NlCe/QDe4FBWAJSpM4xAm0C8E4gmAtYuOoxU41B5lYTgEkWmNB3UI9w0Nl3ADEYWCIAYgCQGbRokFMQQZSQCCiGq1pQIocAqDsGkK538MRwAugALssTau0GEcVhCawB8rp3r/YAt/ORReyJM7Mi5quIrHZhCzgQma0Qv86AlwMRkEUR2e4AkmYAYvA36JIEscgysG4GK2FX2atg3I2IxPx21o+YT/pwIQsHCbZoAfQH2Ld4j/gAJT9xBQYAS/55ICMQgUl2oVdwT0wCEeYA7klFcriCYQsZP/kHqEWS0uKBDpAxGz4V//YBWw5zCIgA4BRhCWgCUK4Cte/1U45Fk4tsV4hhcEgqB8wjcQROd3CIcCfsiIhSgIdJAEFEd01XYK3hCdBLEe/9CardkHPvAA21AB7UkHD6AACAAK5jApTGVvA7I9z7kjyDYUkoAIGWCZ5wMa9oEQcWRhJwRW5KlCZtAFngZT0sZwm/YAC6iD2yYQBFgQaZAGJDmIcikQTRCE0pgRLwBTAwp5MNWez2AEM+ABXmB+R+GczlmhcKJgAYIJutcUbOEQU1oQH2gpLYQGriFCVlICXFACISAEH5Fq4LZmELCRiqh8qNYEzCiRTJdwwicGYkAHPQAGLkCIp9AFIjkQrwFTO4AQEEB9/3cEMfkPY7gjEOqks/9EIdMjEK0CPcCBZD/RRpcQCRYQKIXjBv/QA0KAAFUAXOXABUZgoxUnCMiYBjG6ldUWoPDpbY74D5PHpqnmAmDAB3h6eGZZBmnQp8rUmgbRB4Sjkf8gdYBATo7xa6v3EDfCqNUSf4ohCWvQnWXTVTPABCUhQlXwqf8QDv8QAgIRDiXwAN6gamsmbi5QiX8HfMq3XTvKiNuAcbblAwdaBqdwB10pEGpAcdf3D2wwExDQmoFqEFJim/TQCh5wio0xAR7wa86qV0eJj/aXCNODjwUwCZJAIQLkEt8pEJZgASQwD/8ABFz6B0LABe4wEOUQDuBaDnPgAA9QAWgpCIPKnkL/CBsQEAItqYjeRp9o4A2nUAHI+JpJ8AYuQAYPIKztoYQcIJoF4WLfYI2+ln7JSRSoYAo58bDJAaVIAZDM8QitkhkHYZQmMJ0DcSxF4glEIgkMwLbysgDAcBMk8A7F0ADtYwRCUAUIMBAXUAX/IA5cIK5jkAaHh6r/4A1PyJ4Kp3yxgYndxog/OF7AKqxggJ8Doarf4J//MAbAWhDCugXhyEwXkLWP0ZdaezCWwRy9gAmkUpk5oAxmQIf/QBcFAROMkAHA0BYm8Dws8AIlkQO+uECwFQ3/0A6oUAQcEAhVwAXLywUCEQLhMAfhIA5zQK5lIAjfMI5DQADY4HCa9g1v/+ADW+ACQSCj/5d8FZAGKsAPUMABKxYE/VAIFPAa5UsAFeANIimVAhGoSutim5YuJNCkjOGwp9s2DnEqRsIUKKIMRmkQutEWjCAJjCAOtVI4R+A8iQUwxfAOCMRM9+oCLpCRFVAFe8C8czAHeOADjjttaeqH5ioIbxASDiAQLfkBQxCvB3EF/3AF/BABEXAD/0BkFJAH/3AHQXAHN1wBQSCSW7BP4yUQR3CA/4B6/0DABZw6mosRAXSdtSghawhAyZIDlKEbfwAsVdACV+MPF/QPo5BVXjAFA8EsoGABh2EERiht26AOXVAFPVAFf8ACUcB/1gcBQPuaTGeN5Vu+O/84tB9weNhAhYUQB0aABRFwBUQmEEA8EBBABu75BkuMED4gU537D8kUBG2Jt/8ACLbAnEpBummisFdsHOfFtQ8xiwVBVdTBM55gFQ6xKisyCYywBrDSCSPAAv6QTGiAmS3wC0JAArfACrIgEMxCRDbwAM0oBh9AiXu6DeXpDxCwvSgAAU+wZth8B28AAa8RBQZxajMqiAMBBEVwA0R2BURsAGQAAWDQBB9AB6eavo94BFUnEH2wBQbwGkNwClCASMuqFKaABVUby4zxEVmMEYrwNNVxDhLBmHljpfBhEDDhEiOABgUtPEvABQiQRgnwDtfwDzxhDtpQCV20Y4UHhab/9g2OeMwFbQCNdwrbELOoRgDmXL4DIdRj8H8X2aMCcQ568A6+YAM8TDibDAF3wAd08ARPOIjUd5uvkUYC0bnr0q+2AJjNKdaKcUemu1AQfRRQSssDIQGpRRFwnV8VAS4mdiFZYgYtshn+4w8t8KXhUA7bagRFoATWsNJDVBsKgB9HMAiViA2nkAZ9gBZHIAFjoDg/Kqs+kM1P6ABv0Af+sA8qAFN90JJSLaffRnwu8AbkdQK0giucHLAC4cnfEIAVZ9MrVp0GEbMIpwDXcCYPPRTRcjNnvWtpHSAOYyCwZzSvGypUkQPVkSpwkWHlQh+KMgIgFwhcwAXlcAHh0LdK/2ADNsADLjdDlpABlmAJIcd/3/AAR/ACS7AH/zAHYHqyCKAA7PEAe3egZOAPV6DD/wDEBpDIh/d3Uiyf4XubQQABO7DJAoGnRbdtz/AA5QtkhvMCUKC0YqUxQjtjL9AA/yBzjDEBqtBkBeEBaTKYxV0U5+XEAoEeFk0VU0oRznEO2lKZdqEbwIEfJBXBGbAELWAGfRwO3voPwMUFS8AHj7UKWJEl5j0OPrB3PlABQjAHoloF3M0F2iAECuAP2yBt2IwC/iADCSDmQSwDGnMHO3AHqcZ30kh8QUB8aRAEdOACYnAH+JkEd4ANyph83pYGaAABZGQDxIR48ko4NHANqP/AysjhCu6WkymeFPmnGt7CFJHBHPZ3PsyRJXBxOSRzO7Y7CaNwDuegBH4b3wKxshdA3y3wCokQRLVBr4/tBlXQBdk9ByEgvdBr0mYQ5aia2v5wD1dg5pksJX1ABmpebVdNEIJAfeVqyNubjf+QBFZNn5vW5WJBZ8QDoK7hktT3gDYAA7IwC4AQzQUhALTw6NbC1gaRNxLBHf8AjAiRHfBCMlZULokwCQwQL52QAy+Qt//gt8v7rWL6MeqwBEWAFf7jP65QBD4AtPSqDt/gBl1A62N66/9QAnxIeVZtBiLECjp8AxQQFuvSBw5glmaZfFu5DTu6fAIReIJgq2qg5tj/C45BUO3lShaZVEZVE8Vd4AAbgwZYcA2KPhC/PRO/tn6NYAFZQAOOju5qrRBF86gF4cuRAxdkMhqSQAwZoA0tUAJD/u/SOwdC8ABbUL5hZglj4xL78w/Yig3eAAHf8Mhd4A0H5wZuwAbhcLKlWmpBiwZ2MA/+cAj/LXJBMAZjYExlgJYTVxAa6W3egAJE2OD/oI3StmluT2MqAAXtiyt29g8PUAYHd5tIMAPmXu7/cAsCnBE347BsR7qw7PRFwQGynxx2Zy77czsZMAI2YAZCMOR667dcgAZKG7AvAFSJAFQ0AQwZ4APj+ABv8A3e8PhR8E8vUAK2ZZ9QQAC7QwJ2/2ADZh7gY5BqBCBy7bnnJVkQlggB1p6AQK2Des5tvbcNKiyrO5Y1PgAF2NCEtykWzkALtuCcACHgX5Z/BQ0eRJjwH44sEwzOIqhQ4kSKFS1exJhR48aNaDhwBHkw0T9gkhYkGpHoTzAbXP6FcPkv3JwSHCCQ+UcmCIQXrxaISFQq0YJ/pHxUgIDNh48XR16g8YcGjQF/W14YQfEBG5gt//yRYMXBH4SCBFCk2fbG3wMUBcX8E/Tvg8EPpwp++1cBLtwn/9r+20bgH4FTSLcc2WPEBxqE6v6pe/Cva0F/XmkkQCjr36zNCFkdNKVqIcJGOFr9K1YQVUjWrV2/hn2wxf/H2LAnJQS2gFEnG3MKVnEZrsTSIP+CRAnio0gpov+GMvqnwMcpCEfnCAnhe06vF/58CEGS5pQ3wmho+KKBpk/BNHK/bWsPoc+guHLj2v3nIr+gbSiS64XLGzCacIEwg075oILI/FHhCNoOMoKxhLrigIfUbImDIlZmSa0gVUYRzZSFTMGhkX9EGag2imgpyIOCLFAxRhlnlHDGgjrJbaR/OhnhHCRKCCecf8ophws3fGhPpx2S6+SSRJx0LiVLfPjHB0FQqKAK314SIpA5JDDjHxX4Q8qIUGzYAoI7cnKgoCHSIOADFPz5KyHBAhusPijwirOJJNIYYq4nAv0HP4P/qEToiBYSqgwqLBoQ6B8BbuHsoFtsmcWZYqSBpiBTPgHBlABMmSAL0Wyc6BhUV2UVtkVju+0fT/6ZJNZJOpkEiSNKqKKgIKug8ibjbtoig5GGsmSEfxbI4Z800kDBmzu28eYlX8MR4oV/vuFWBY/86YOM9oq76xS0BKGjujoPEuSDduOM0649xBusiXfnssubNHxwIbJ/jpCoMolsgNQWLG75B2GEbJGlGFk4w6EgUSgRhVRRJmgkAIoq3QiLhQsSwJZWRya5olcNehA2EzC5jRETCgJmkhHMQGyOmf6ZowsIgtCpoDGSy6AGkZyj+YFvDB2PCy7KgakKJY4QL84t/7bguSAy6trmH28CeWCbDz6gY7KE6itoG/5OecAMJbw5hYCsv76LygfKMBQhqSziIItrbGHlGJERcgQGXqSxxeF/GgGhoIhF0bhTiqb5xxiQPvuHxYQULjlzzWdExJNmJ3m5kxsnWcAHdXp9KZw/fChuB+OQ80H0oUgZapJKckAD2n+e8Lquf/YQMshwkBijbTK2yHoHCNK4gwAXtoGiixIK+sAFFPrwmmyEPnjmg23ucMGFF4T4xw0CnnCB7sh8+KCMtgTRT2zJLuoKCCEEkCV/hfr5R5+CNENIFki0moN8AkUHkQUtOJaRvwHCM5uDIARP9ppZxUp0orvRAmxgBv8VlIALMzHDzlxXkJ3FTgTAKMhJElEJG0ynLKcQhNtOYYYPyqQER4jhnHbGswIVBBsEgEIgtFGQ5zkLAk342lwQsg0XfGMuafjAA5bAgxJAYS7bkNsbytKWIdStK1v4yFP+McGDvKABxfjMNSp3EI8VRBoHiZgqRIGKT5lINQWBxiwIuBkAYoRFf2PFZ1QVQUKO7EE1oiBCXjYJS9SqEwpwgxu2UYEQzOQPfSgOuXbGgQwwwBJDQck/RoAGb5RBEG/J0zZUaQYEVKEcF3gBNk7hAgg8wV2DgRfZkECW/BDgWXPRD0LiAr873KFNd2gBFYVQn1N8I5LqUEe1HrAUKiH/CmWMeZDADOIPGjQAC4H8x99atMZYIMMZJZKYKlwER40pZBbIsFxFFigpylGukPdkFRk3UoB/ICIhOYgVrXa0o0nk4AgowEs5SrCEHf5jDAUJAsBIIQJSLOASltDGOXyAAgIYqKNlOJo3lgCTELjjARWAwilQIIi+/PADd+qCEoRwBAe0iVpluwP6PtC8dgHzAw5wwZru8IA/KCEciCzIUgryABX8YwmUoYw/BNaCpvwDqf8wwgwKEs+DSG4azihIAE51EBQFIAsBQEUxFFYMGmAkGrTg6kFs0Yx/gBWfd8UrQjBhAkUgohcV8UQGbjOJB8yyAhcIxxKQZBzGBqEF/8pKRLIWYIlRvGBuWcNsnAQhCG9UgAtaMkJKXaDEOxGRAA8IhEHaRK72yOUfd5jLtNhzh3G1tiBCyAECHgOFf2jLIB5BEhT08hH5/cMGjVIIGuKQmmPY0yBZ2FQ0PjNPE2lirLPI0DFYdItIoSauCOHYLPr43byWt1Wzkkiz+jkRRnhiErPKAK4ScYRTPKMKM1kCcnz2jyUdYQGk+IcIgLIAxVagLoLAxmBe+A2bceGGpzAbDAEzGAQNwQ2o09kY2qMfB6Shw8eM7U7XdEyHvvYfSPgH+fyRMqRSSQWOmZ9XChIhFCPVH0yAwT/6eBBaaAasdGXRakREKoOgAhqWY/+FyFgBQLsiBKwN6xAC//c/8lYEGebFcmwQ4U+JvAy+GajFJBJhA7l04YNcwCR/+VtCoixgsguoxAvUcScCxFCVWvHGfcNRhQLDabNZi8tLoRcI3R7koQ81SBCCuSbmkeEOQbAt9TySLQUowQgBM4g6AIYQf1z6XwrxrXMPgoxmIGMYOkZGas46EEpE5B+oiFjECiLqimiGMw1rhmaQnGVel+yv662FRCSRjE50whKdYMQkSnEOKKDgFFyYA0PJBdGIWpQUwKgEAnygglOIQTBx6eiBCbCHOVRBCD4IjNngUmcCvMV7e0hxCB2gJoPgxAVB+ICHyXVvh7bJ3/+Yd0H/hoiHEkyvIp1WyBFsYINNH4QqArhGIHdMizcWBBan/gc1ZtEAXMAAF9eALofEGQDxYtwigSxGMeIJVlo4Y8e9hjlFEKlPf1oiIYr4B84Ngl6EKAMRnTCBzRWwI2BIx3kfDIEPRjisNLTAzUO5AAegMIRn9GUb73GbE7EBby11wQdlcAHZslagP+OsBC1AtEHSTq4gjLjtjB1DFBxeEDfw4B8YEMJVGQXq3qojZQYpQgP+MciCNMMZyLhyPk4tDczMggkLZ4ICJOe4iMzCFrSgtUTAucaYd742s3pEQXSkkNtIgiLIvtEnL3oEOmwDOOUYAwR4+Y+dPWABKRCHNlqg/w4YCubAdvlGndlgsxAMJ/iAUSVCCOAGL82YLGTRbyYN8mjqByHtDw0CuQzwDzP0AAO6rfGEJIIGCs24CFZFCAcgTnHD/wMWdf2HI+yJBRugwQbnV8AKSGAMjeHAcf+LhcxLiGPAOLqKkSsbPM8zr0fAhNArCPVSiJcxCEzop7+Cr4Eihn+wBFc4Al+CtzlIOzVzrCWIBCSYjkC5kyeIIVxKn0DYMyFoIQBBPrwoCHOBt4LAAzcIQeMoDgjYQYhirIOYNn/AloK4ACX4O42oDCNIQn9AAq1CwH+4MroahhvQByawKh8wAiQAhBwTglnAgg2YiCabCFlwhrhiBbqqp/+XuwhWSI2KU8Dy0gVZ4bKEsLmCyABJeK+V+Ydgcw4MWpZlIQVXsIGs0AsEqBYymD08tIEHeIMYEow7GYIY4g8VEIISQIASUAAOeIMiKpQKUKm4wAZ1EAIM+IdM+Acu4IBt2AIDIItpK7GDwImEWJNtSoBCwzslkBCp4AC9S4gjABgOKC7G8IdrUKNmCAX3M4gb+IeUeRCPwYIAmIB2QoWGiQWNQIZUMAi7iivCm7KLuMY4ZA194oiP+IWDeAQ/LAgJ/Ad2XEeD8MNJ+IOBOogNtARReAFvEIMsmQM3eD41k70j4D0XwIYrKouOegICwIsFQIJOMIIteID1ob0HgAD/ffGL3+GBCygI3TqCtKOahPhHuaO2KLgD+XkAIbiAOeABLtAGBXiBrlgUCimuhLAmbToIgWmAaUCGejCINjII+akMJkgAjYyYLOAMaTBA1mAFzLMrNhRHBayR2/CEYHuvOqSIZNMR0dlAhbAAUQiPIfgGaFsdIFQerakAtvE9BdMKX4IAN7ABI1iCCFGHZ/AXZ2mPujRCJfgHBECAGdgDYZQIWAzC7CsIkfSBXqkCrSo07rshr5CqqvDFpEKUmfwtbdkUaRiGE/CtGOO0ghiHa8CFyLE8+KsIVAgZhRgGWRiGY7CrZqCrpMwIU3vKCHoVTAg2z6GIWLnDgxCdDPgH/yyML6LAwn8ABQuYgXFQBxdQhzdAAKTYCf6SOwh4gI5CJRqEiwc4AjeANwTggy5AAzcAxS3QC1kCRW5TgchAghZogeEsiHNgjO0ziPV4LdkDQhIizILoAR8QGCFQFoNQAiSwSYtoASoJ0PHjgCRMCKgwCDKbAWMohkxpOYzIAgKKMoPINR0DK3sICdaczQiSAAqkFRPwTYpwhd20OZsbiqLQwGV5KgALgACYgRMQD2/YA16JAkW0mp25yDozFFP6hhD4By3hgvoTyAo4ijQ4qX+IyAeAAnX4hhsshxcwBpXkAiVAFMaQH166Pu2jO71UgB4ITZzRrRIQAniLzIQ40P8I2TuZqwiAYQwbUAAYoAVkcEqJmIBbcDWEOIZjQMBh0MaC4IWN8IU67dDXIMeCUE9/GomAUoihYwJLmMeDMJaCeFQ8tDlKKIgJSIAi6CG9CAGA+cedgQBBAL6wCwzlrIBySLElOFCINFIl7YpToCYf2AJaRQIhQAAhsDtMnIMqtQgfVLsY84cr0E/y2ctM1K1MDAcEnQg0cApmbQ0fOIIVsz8hYJGUo4VjkAVvPAgxTJF/IIEMOYjPUM3Ck4YozIjmKtR8QohXCTab6wCeYw2ba46DgIcZsAHrcQHguCGywIm0k7BnkCXD4gJ34MsXOFCvUAcVkE4txAYOsCaUsTv/3fq+vSwBlawRMzvTgygH8kECvdySYyU4K+WII6DM13gKsfgHGpgBW4CGYmDNbFQIVPDWhDkRbu2xde089ZQIP/RNea0NKmgFJ5CHWJKLCiiBEHgAkeSl4mgPb5jEZ1CHJdhOM+gKRDmKyKiOBzjZFhCSvUzJfyg4g0MUf+CCNlCsiqCSaS2IEriAMS24cHCHKrgbjPAHB4FW13gADpjWF8gxB5UGXTMIEjCItsKckUFXnV0VCfg7YMjAGZkAeGiFF4ChBHGwEAoC19kJnGCLZ9gGx7gATNQVDsBOgzgC6VBSxZgIDshEHuABzMCDOaAJg/gDCXGDf1CCp0K/n7wt/69wByHwDSB5Aao6gCJ4S5qUDL1zVqs62dcwAoZbWcz4h2YIR5MriJpNmJARgO6KDV8oCDKkCPBV3M5zAhBwAg5AgQLRzjkwA9YBSIMogzLoAlcKhzDxgfURm4g8AjRgwhjjgBY4gqW4mwtAAiN4AW1pATR4WwT42hwQmAvgASRUiEVZAgVAyRTzoAUI3UtUgnpF3i1ApOVdlY9AghewgWvIn4pDPHfqDHEa3w6doAfJgEswCA+OjTD4hxM4qG2ggwRbKJEcliVRsD0oUweJSNqL2MgAI2t6AartEiGQDvW8CiQoEirSS2MV2x4Qggz4CH9YhjYAxPlBgDnAgwvGBf9zCF0lUAIqytWGm4j7XYxmdOMZqQwbWAFuugZfMKdjCNTENQiGSUB8ItQXLiODWBQyQiF6nZF3+IfyXYGNKhRfiYmECAIOgAk3CGDjmCau/S2uBeGPOIIl6JI5UIBzWJQqeAGn6ZJAkClN1BbyuVic4QFcPYdyQIBZJtAqsTsAuK1R6K3ZeAEkoIcukTGLuNoqQRWBQYMXuIYXOL9/GAZnIEPY7IzN4F5CLi8JMBmJADDYaKeC8N5/8AJ5AAJocZdtWAIuUFoIWDqfSY4BfYA06EGadBDJ4AALTkVTPocl6NUS+IM/KIFr4ANVKAJtKYIhQoApNUWDi+ASmAG+VAL/3yoHuzsI8qGi77OAhaOqOC4IvbvapXjJ3TUI+OTMgmjeg3DWyWgBWyABMnMGukKGAAyFWFgEah48caWIZDyIU9PpgnBhbI6NQ22NBXCFjNCjWfsH750AEDgCb1MwNiiSNPjHnZC+B/iZ4liPIOBkk97fKnGJJTDh3KokPXukIH0KJIDgFDsIlcTEghi6C0BJMrUqozK4jUzFQisBHgiEqkgqxqhbTlsUk83brqiMA24B5GpTsWGCK7ABAegxFtlJZ3AEicCC7mouWxCIfPiHfBgAhZCFa94ImD68QQZqFSlqiqCCivACh1gpg2CwKpA9dqZkSNuJiI3YyjgCPuAC/wXIgXTmFZlwCZtBgoWbIrGNCd2SqXIgM6qygSVYYwTABSFxg8pYzLbdSFxAgEgQgjDpaMbwgb+rjC3wh6fYtLulVU6rjDDaWKiyKk/zhxOIuMFTFWdYhH9IRkPQscxw4VOTBcohtYRQw0DWCLpyBk5wMkLWZopIcNdwhdOmCIeYiEemHrnAjyOARSCmiK6QCmoSgl4ZASBR1eCpgkDolReICY3ULY1MsRJ+gQXIVSVogyG1v1i2CL7ERB5QWZTmgJckxn/YPh+YDflBg+9OiBdQgNCCJiiIZyqJTP5d0GvoMUD4DFOrB9I2CFWxnAxxIM0QCAEMCQ01CIHgVkJ+lf9Dbg1XADBK+GaKmId5KohMAAKyWEFTSkWMmLZp80E32AJeWZogDW6Z0MjnVdUeGDqxlRwlKIH0dHG9nJ5loKrIIZ8LaANpzci9lIgLSIAFKFDK+G6xGW8QDtDKWAyBMYIXK4i2QIG2qAAsat5z2LQXoIVpmAVVSYVm4IZ/mAYrNwhBQmo/jhSBqLKJiIZdL+2LWPCQAAVQWA1GvggSuGY8OL+3cJuCqIBwUNogpAgg9gGySAM0eD2ZcKUhqQLgQGUaasYS6ARcTTEeGAd/KIKJxeDh1S0eQACNpCJ/MIMZCF0VN4gFzlWhpoxa7Wi8ZJTJuDTr3J3BeKm86OoCtYH/4WyBhkkyg9CMY7Dpk4snOCz2p3SIF+WGWUiHimCHf5iH1DaI0IQRuCiDSDaILpjj+swJg9gX9tDCfxCHrz0IB1OAluhVM+AAJKiEgutgNCiCFOfLf8iBFhCdiz1CIZgBBZCqSlPVurZrXHBbqVIImwxvMmPv5CIzShQMg+iowfgGBaFMG6CFReEAGoABynm/18BvA08I0GaNn15XMwd4jjAHgwCB04CHf/g/OyUBaZSIyBCDhRcM31DVX80JkdyJFvhtVS2HIBHlXjmHIEWAUeqtgpgDqloGnMnibPkDPkgxghOCTjjsx3QqJah3qhfbI+yOg9tdhJ0IwjZyb1Oi/4PIpcFAgYitkQFAMfU7RmjmBVW5+IzQ0Mk+CLrf+LtyAolInH+Ifov4+1ZInFcAggk/CI28AIARTCEcFvaokiooAXcoB9RJrO0O0g7vcAnphCtg1RH4AteNYDyYBH9QAJf8X15cCiMARmIEiHM8eITD8O/gjDl4uBxs6PAhmhb+/qHh8ADNw39bMLb4p+4UgZD/CJwS9PDDv2+nMDb0cfCagH8cnC0a1jCfo4w6dzrkxHOnoX+hWBVriOzgrQ0/lzJt6vQp1KceHJI4uGGC0n9eGn6K6jDHAwIm/7lo6A6BBAj/1GaEEOTggygHIUgoseRguX/hDr54IYRLFSFmJv9OVPLPhhIeIRZIeOFvYoYcOapc+IcAgRAhPDRztvF4mZI5cwCU+BdJCBKKTCtyOHLQnw80Ew9uOYhGnYp/KA9u+9db55BTDxx2/CfrGsV6yOzVO8jvn02vTKPrbN5M1kFbDVkdm8UUu/Tw4nlKGK8T1UMn5g8mWovCRZmGFSrPMaOTTMMdcMfQrjKH4T/lcBGOEC24IcQcQizhgz8sdWGGK20c8Zg/W1S410BCzFBCaaX9c8FlCIimBAYvoOHDEuIIYVlmTU10BBqpvfaPDxxMVBtF6igw1kEEZHQKSr81gQ0HDR3BAQesJGBiPkE9ZE9GPv2jz3ih6LRIU94dFNP/el16+SVPTmjyDxVaiTdJIm6E1dAHBJS1l2tu6QSBXC45FMJeeelVAgc5/NNDJxKhIdugRighBIWwtXDEoQiAyAVDCFgWYoglOFrCZuHYYMMIJZTDg2NNcdDRCxltceprRvwDUkPb+OjjQdi0iZIgp2zzjWsH+XCkkiegMUA+VjIF5ZThOamTT82xYguXB2GhUxbZgTmtV+WVl1FxTGXFUwBTkKleU54cZAkD50CBAo//CBICF3Oow9ZO/D0AAX8QuFEaF+WUE8IcHCzxl6oObWRDG1wk2gUCSChRwhwMU0qpMQhYmhDDGICIqRC4XKCEO091BOM/NzZ0am1HqHCK/04iHWQSrCCdgnJLpR4DgwA29LNIPTktdZR5hjQXFXasHNTMPzGBd4xQD0lDLdNNZbsUDc02BEBG4HbDVDKeFPDPH/8sYEMasDbkDYBz6ZeRfvAGwUEVe1XRNp//LsEScf78GzJsHyJ2QbsicvEw4I8i8DcCkYB4QQnhFAlVrjxxgATMPLY5UkoO7WbrWg1x0Jcz1+hTBAzDUMkTLQ4R2yUt4P0ktOkOId007F5e+xAJ7/wzwU+g/HP1Qbz/U81BMeyUyBFpoAurIM/we8FdP8npEEN77dWCip0UOdtBNqIRSBVoDPLPF+UswQPilpovcYi4SIoALpOqb+n7EpdwAf8HdP+ExqYhjwxRatj8I8ZuxvKq36gLSLpBGcwOcgQb6GMAw6ABDIIygKbdgymw+AnSiOaQn8Wug7DzwOuYArx/yOEpjGjBN8RgK7H4JgT/mMNddvCWthwkCmf7R+LCUYV/tEB6j8nIRHygBATgAVMjKIelABeiOQBuUkq8zN+EUBn7PQRHLzDC04BoA3V8g4W0Kon/BCEIAvRGEN9wVQAd4o8jgO4fi8jH6ZYyOq/o7B+HIJYh4rgT7cjCFqy7CdFU58FB7mR2TplKQ+ZxkEb8Iww8mYQkJLGTSRzEBC8YwjdqVSsf+Sccc7BTRmYYhFH+4whzCAcXxmeDKnBBCC//wFFDZFMbNCBgYV+4wDWkmMSInQ9+6DsI/HDIPvSpDxdMVIINmOKPTVHxIaXKDQGw8apX+W9VJFnVqiSHguFo5AUnSIAjBoAlTsxRJ3U8xFPQ6RBIVDAoz/lHLpiinYe8kSlYIiQ+l1KqnXSjhOTIyCQ6IVCnHGEILuARJ8PhySPIZU41DEIfluDCgxyAlXPoAlM4YAQzmGEJe2jlXyg1BxBdpjIHqYykTOqoEB3OfHtZChqMsM+dxEgdrxpJb1ylLnWNkSdjTINtXkCDKekjH/9oRjmhQ617oLOCB5HSQZAGiKZIYxFFzSdWmzZCnTDiKafAhiZXtsMdHsAtN+TP/0HwkzlIeVIBS2jXHpxCodfYgB48SN/62jeHf7TPMn19iPouo74EFcg2O3mBDRanE38YQQFlgNUQeqMyh2yjJAQQQ0gyuw0UbAMjW3jBHzlRD5w9pB6GYOp4OGjHdzpCH4ZwrT7uKUienE61Wb3tQwz5FGCYR4wmKeMQ0sDKZSDADS6Bl0Mg4BZ7zYGVVfjDf/bgBhdhD2/+4MAFhHCW+Vmmu02h1AXmgATUqGax/5ip/hxSqkxOLl0Z+cZIZkVGAuxGBR3B3zAmWMeMLMIex3IKJ6C6wZw46WeH0MdzbLsUBeO2wU2jpEMykAyHdLWA2QSJAyrgoa79Qz9qhQB+Zv8YBVYeZA+nnEMF5Fpd1dhIAUrIgTaUgLjLCEGwONwJAiJ2TBsswU+OM4Ji1UgRI3CxIdH8QALj4xDLjiSzIYnPcPwRh3/UYxOj/ccES3sIQ/x3PfU4hFHtkQun/sMeAm7I646hDw4y2MFujh0mDjKJZPJULPB9ggt2qNBybOEtM3yIWqLALr3sJQRV8MYsl7JivA3KHy0Qgg2KoIRPKcAIQrDUdzGTAQUo4CcvaJyQ19jpV7lALP6rppFHYhKU7EY3ZCzlTeJplObcsyGvDY86H3KsO+oEGdEBhHbQ848Q/qPLbz72eogxia39AxEP6eoYmsAbhzyjIS8FMU+icAT/IQz3IFzownQXDUTsJeoxhFJCX5TABSUowC+RiISH/pGJhpTmMpZSAhKQoIAV18ZCFFn0RIqEDVYJAqwHSaCrm/xqsQlCyQ6ZwSY20eVaP7UhZ+YJmRuSa4zvRBazsAUObiG0pTXF2ENDNlPKk60shicDlmDKsh/CbN2kjAwS/UcV4t2WKATBB20LUDnCgYAKqKOh1C030v0RiEH9RQkteAEP9HRS0+x1r+sLhAJa0GlxowHUD2FsF8Z4Rju7N76uDpJvfpIAfajTHvcwc8eZYvKNF/spTmKFLLBwC1s4g2dRqYc9nKFUlItHt14ixj8SAQzEN7sD4vpHDDqBVgIe/+Qbz8Cot+8C1IP4+S0QeEC7UHmQEOCGNipOurkfs4eIzEAIrvCHwsomKQyI5h8zWF8CkPACdczI9Dxkuf44cDIfjaUkQ3hIGSZ308c6BL4PgYGtqXyQ/f4jFqVjyju9tHGP/4NnetQJ3QmP23H4uBOJX4r5dVKEn3ygC+EwaThA2ZYxcHuiQo4K6st9pBeUwJX+OMGKSEokmMYLYZqkiNcV8USofF3IPIAKCAIdfIAgfAA2bIMYpR2rgRVYiZFYWGCPEBDCbcI1OEI93EObNQVTVRBqNURzcFBS7QR1/IOW/INRiZ8HHcB6ZEBkAMPLdRWETdI/SEIGOAQD/M9PIP/MPwDGeSFXQ4xBROlFEgbGP1RAGoibouVfgzwGB/iADSSAOdRPQhiEpByEpJzPDHzaAzwGLKEKej1EC6iATfmIGKgLWJVE5CxZQwAJymRW2bnRa70WJ3yfdGRcRlBfUxyDBtmgIu7EEGbAAnRCe3gFhA1BH/6PGYRAZZRDZcjfnyWh/XHBG0whN3kFYSAdoVDIESiMiSBGOMTbDIwASjERRXzaD1URTzCWCrTJNuxGJpXETrGaL/4DJarLb+QUf01JPsRCPeiDPVBcQxhiRqggCx6EzxxYUPjMNDJF6cQgIUaF3y2iQ7DAUhieTnRNZFCSK/wDKTBFB0hCARADs5n/wRGkGE94Q9UdRCCsBX90nr0IgegdxBJ4QwUw4VOUIhY2CAfswR40iBnwgLfh0F1UisFcxFypV5A5hA8owBA8AeVUzsEJEEn0FAtNm/JtQxMQgPP9QwIIRZgZG5W84ILpmsn9AyG2IFDApFM04z8InrCAI7V4wjmMS1MM4T9AGCK0Rxo8QSWKwW+830HYB7y4RR90TWXoEBdYoNiYx1yhHgd0AZK8wF5VZQnkAHddAA90QhbuRAs000Goii62SlY2REq6SazwBhl1UaohnIMZWww6RHT0JVToA88gTemAB076ZEaIIw7+g26JSyQuBW9JUkP8wCR0BH3xxB04BAC8/xSgRQGkJOELVYA0VQAsaeVWJoqFtMYWPI4Q4ABKIUESZQKkbSVEpFfvCR9OOZzC1SUdzmFHOoQcKtlYeAONNEV/GUL2eRCxXNx46IzgIWZ4LKZDjIOcrYf5cQAKoJ1ONBzOdec/TNdBpM0DlACJ/UMIdIFOlaZ4GGTSFcpGcMBfWEYJQB3itFKi6MQWMIhOuGVICFBOvZoZNQRH0lcFYgNH9gattBpQfUAFXKROJCdU/Ewi5uQGTctsQWdTiKNOnINApZ867tY/iIAIXMI/jIINrMlS5EZeRMo/jGIQCNpnsgsXQAFIqMBEsCX+vcZpWghsMEiMqNsMLIACCIExrP9bMskG18mf5ihA5ARjk/EGSEyTbwAJATwBH65MrBhUtX0DPWKol2DHhIKjhq6Hj0mHJSTCAljCCLQoXYoHGfTBGISAOBBa5ZkEFMDOD5XbWjoah0Dav3DBHigARlRkbeqEyTyEWAiCqyyqb8Qlm9CXTulGreiUC7hGJe5EPG0ChE7LCXqpeUinl3hoeJDCAozoAmQTHr5XQ+DJP9QLGUQB25zYC5kBymDDNnlJns5GriYKkqDBkLqSMQhBFxhBRYSMvznFbdwZ8viilFbgbx4EZoXVq4CRqzhf8kHFJvxDBDTELpgH3TlJM4QCYFLjejhDmHqqJObAD0oHMJQCWAz/wgXuRG8I5EGEw6D9w4v6HM7pyTfY1DOgAD4h3Wq2gLq9QA8sSGwY5FOUiqyIxfHFa9odBAVS1k5Rzje8JZb+AzY0jgtIm9dlRCwcRKdSIz9ka1OM6zeWGVR040MQG3SSY1SUaVS4wgIkwiX8gRtkp142BGYhWWbKaBXAxhGYAaSUw39wgRnAF8oEzJcQhv6QW7nViD/swRIM6YmgAY9WpBU6hKoQAB2ElUkUHzYcn7oMweUw3DS5TK0o7XgYglGdoJhl2U7UWk86hLH9DAmaB5fME7oOUiI85iUwwCWMgxuExK38Ax1khE59Q3xUQQ/8wxJUARIBRghUwBKYgaIO/wEBPICSdhDS6ecRdE/C7qpTcADvfcA2PAGr0dy0jcUF9mGr5RR8Od9wPIHBPcU95EOyFNvPLIIG3Vo+nGuv7YRqzWTfYuglnCrilQLzrt8HVizPsgpKVkAgdAEaACobLEEXVMA2bEOpVZ5GqGfT6Kr+1Q+DsKd1OYUNqADy0JxJYNa0OsQTUGKUrkz9VtNNocwDAGx4CAuZQcnb/gNyLIXOzJEg2pGuZcTIHi9WdZUlkMI6PkQpjMMR7CGbACerhYQLpJg3bEPYHFSpfQNYpdgLKACd5VO5Jdb56ij5bq1DxFQXINmsXCzNGePKDONvGdzk7JRO7QblwVerOcVzcP9CX0IJM0aFlQhLzvyDTxhvRgRFTiwwA/PEmI6HCBxEBDuEFgQDE1iqy1AeNg1cAbmAD1TAB6BASGCWb/yGDeAADxSBg3oQryIBkuqov9ViRinANxzfSDbEGGWl8X2RACHqk3rkU0CjyWaEUeWDbDkENO6aFOvEsRDYFDtYe1zCy2VxezBBqfgW6xpZwUkg8oStBcrKPwzCKbTAOJSAJoDCBZzwILEnYxEKC+soQX2EGM0KzUngNpzayoiRUioqWH3ADk9bRtxpXF7qcz7EMmfEAMtdDB4wCjZEKjjE9VXyTlSxeDAAI1zxOpbCQVxxQ+DDP6zCP+TGwQGxHxufSaj/xSmo8UFYKawYQby1wlChsP5NSENorQvHkhFkEn2FhB32yEce3D8w3wdo7k6hmibpZQU8ADKLZEOE4kH4wkte4wm6llcccB5BhcmNKzZTcUbILFf9rdeQKImGaA38w0qPzauEMnA2RBm4wHCgmpERwB2cwhGYgkOYVMCWW0xBLfmKChLAynwFaMSW7bNmrG6Y7WUKQmQtGdmmHcSShUOEAs8wp0PEAkj/RCxoEC84xGE+hT50NTZrM2PqRC/oxNYkgjiLwCi4wiWsI4nWAD6UwpgsWdhCb6udgjeghE1fsAvAsgcEAD0AH9OgHpDZcj8LTCl9xJNeE8y0jOKOBKv0/4hY+AgCgTIVOgT9CiisnED1NQcjHwTJMXNG+AIBl5NPcALLGieZjY4ToyvMRsUQSkL6pbQEi0AlkAM5dEWqmTIYHwRQDbdDmERfgcIEUAIQ4SneWNcRFAceQ0Wp+EgZRex8IVmkOkRZ1EoG+lY1uYoZIZnzncIzPMFvdNGreAMYiO+wyUIz9B21MAc/SHNTjA4yaHVId8mpvsI/XEIl6DYWH8QlrAObPIEd+qYR9hS6LAUBGAE03MJBiIIXbEC00AaqjG8LH4bTRgWOpAZHzqEKTeplmVofOllG3JTE7mxItspDgOdRCbAhDINqx3hGuKxxHsRR+Fck74QjIEMoxP+W8NI204zCQ6TjQTDeJVyCOEvbTrnX5ez1TpzxPxjDQ1ACNPjKCzs3HtsASzR2RrSGEZQER66M8+myIce0b5zRQQyjDfMwzFy2o/pGE3QiDYaCNCADdgyDff+EfEsf0TSHPtRRn/OEM9StQ/xRAmNobUOFODcE4x2E7lwCOaTAQdABAZAtj4h4U0zgKRgBDkzAhf8DDghwevlAGzZtnupPYt2fi2jU/hIQgmeTiqdMIWv6SAhnGWwSNnzPA3Cw/7wlfN2BtDm5Q4TZQVyQV1hVmfHCaLmWaTUHHtnRaw+v4CGVUwz5fvPEozcEUTZEBAeDW6JEZiu4xPpPVBv3Bvv/QBaYgig8xASUwAm3unPbRhwvbAs8gF6u7nE3LEqY+wClZEYopcD7yJ1qbMFRXuLqxDAQsXLog7Y/KJtJnz20VlKNtRwhA3LUoI47A58/BKI7GHW62SuUAjlEQjKJzWU5nzF+QBkYt5EBrDnYs0MEgChAgznsU0zFMkUMB44uBcgshW9O9mTtxBxaN1P0Bv8mdHzd4XbUHc5gSciGh4DpkU02hDM2RWlLvVNgvAfVQjaQNFaZwzqUAsr/dY+85aq5GqMed/cS0AmU+qjfDi7YABTMVKrDDrGGB4z4wJzze6ys7nCz+UPsrFOgrm4YqMAfhFGlAi30XcTzhCNAiYId/7F4UImyJ00NNgM2Npgy7MRaK8I/ZEAtvNnJI4HxrAwLTRaKP0RUH9Cd4gIiNcQGGMOY896Q4dOnacQt7sSR0I17Dbdk7cScvy9BM8VlIhniLpmTs4400AI1iIdoEbEciRZPUEl+T8dBZD2yrfXIu1kjuEIkjENSuu5D/Gfb+3H3qsvmpkZV/EMWoIIHTAA0HIEYYIPunRdiU0tr8P2gGDNA/BMocBuBgQcRJhQoiEDBbdgERVQ48d8pgQ4GbhOkkBUtih8F1oulrx5CffYS6jMpsFk9kgMXDeSF7B9Nlv9KgqR4TWdPhb3+KfNUy2fRhKUUulIYScE/FAKfDPlwyv9g1X8EsCo0uO2fGG9GZoga6GHCBGhH/m38x8GfUbcJ0Rh5K/AImn8PLBL8x9XgXIRZ84LsO3CwQKr/7ihEButtPpwTYx6MPFGfoZwCI0uTvNgeJ32T/YamCO5frxw6gRodIbDSAoFKDzZC2KjSJ0sPoH4Q9EQvwYYN9w78IJyhjX+oaPwTKwrVvxdD0lrk8MKH6KJocPu1u0WF2uC+3UIcwrCg7qveFUI5JXEiV0HQE/qay7imQJX/UCZsltCRvZKGKrtvIMf+AU0gzXpyxLq5PHELI59au2S1hDQRSCyBXnlFkxcqKGgjQb4RaLiF0hpoo20GEaivJtDKIgCBNrj/xSwfUGCIgAoUsAGtf7ZY8CN/jGhhLrv+8Qc3bAzb6IO+GPrwnw++MUiMErl6wqL1rvJrvCsr8m4q4YwaBj+QnPlnGENCoiifmBxRiUCE3hxoGAR9rNOoRxSZi5FEOlkgkQlnq4SSSv7RhpJSyAnGBnW+GW5EinQTg4CI1CJguCceMAcaD2AUJYCzCPhGkFPEOAUJJOz88YUXrNtRoo0s7cvRJ4PjajgXMtrrG4d6KgOhDyM6haspbX3yAyR9ElOa/VKiiaYy86Mov3zEDE3AVLGtMxFJgJFEoEQSIlSbhD6xQdRJuwoRuIRGhRW+irZp4oEToNkARlQ2mOEFGy0V/0gBIbNFyJ8jWBWNA2weFUjSR6fciFhSSzToCYjWQxY9hbDpiw21Sh31H4jAJOAUOoyKZSCTEao2lmop+uyfNgdAKOaEYgaNzgI/4kehOAPOlph/EpmkE6APWoDQV8b9p8J/CK2kiDROWZKwiT6ATtaDtnkiDSMa+GcCsSb45xobvJn0iQ8K6lkhDowTzUiBxus3y7RCVHFuhbnSNe1dC1Po3bv/GSJvvn8tKpWBbhb7MZ5b5gSh/ga4diJeVlLb8rcYOOhnhEBxRbZ/QPlnmX9SeCUH3LKmdauoEcJmPYv+rto7e70WpQEj0kiLgCFO6V0BNNrquUc0ChbtgUEmtf8Kq8ASYl55w0TO27pQ+67TmVia8WyTj1DSx3FH6kETJJXsoZw/v1i5XCFLFtz8H2AUsoDzBUT4x5UWCuNK+oMSfhLhjAhwBGNcCHRTgAYTqtMwQajjH0gontr8YQMihWYL6hDEMyJVK4pID1f6a8iuvlOU4YhBVLUCjv5AuCBn2cMeAOpJ+OqhMzQtojOXOQjLHMM49b2lKQNxjVGIwQiEwI99/6jBREhxEEoIJIkLQMtgokQrhjSMAJIqVfWwYgRKtIISFfKABwJAAw6s51GCUEHbhFekF3DAOsH7xxMkhZX+EUZkJBpIYChmkDl2BTH/+AYKcIOC6tmtIoVUiDf/BAIBn0gDFjTpnmQUsokW/gMS/0ATJPhRSf4gwxADMIQOQ4OyVCmgEz0MTQY+Ai6fuKISltCGDTTSN2HxzUPRWdKIIAKcF9BDGxYgRTW89g9jvGAbU6nUKRh4OX+8AGCi8YG6Qvid/qWtMN4B0UMCNze+WGUgQqqSXgwiKmy4h3lYm8pT3rK9yP3DZecD0H8sqTOELGIYoThIPiS3Q78kRymEWkIRKVIAYEziI6UoRRInAgpzgOISmkhiJYywP8K8DitYUVLyYBWi3snFFBbQBCg8oIkJ4MIGd6gilpakgOoYwHIt2JHb/jK1q2TlIBXlZnS69DrCMGSmg5HLILmk/9GL3dFSgmjCXUDCiTfd4x/52ESANDnP8OnsHoa4x/Yec5D82FOfosmBAmDjw390AqAg6cBAKoEUcI3ghxQhlEAsgVAj3GGo0SFPiVqnP4GUIQ1IuMAlKiE/D1ggE8bhjUMspYIjuLFnLXigX/zhAxXgiiJ92YryNoJIP/4KRFdZUt4KkzuqIUSiftQNuvayjaf0wSgKApD4FPKfylgyH/2ZjCGqBQN7KMgvouRqtgg6EKUUEVBvqQShLFE/nTRCG6AgRVj/kYYaKcRGWRoqxbq0DRuo4h9LpIQHvNAKBSiSPKxTAaoYa6ce/QMNaBQNGr6Rl6qgFnA9relB2oWsj/9tdjD0NYxdcUpIhcDOkHQYJEJ4Ik+B5IIfM0vJQB6Zw004VcH/UKc9HMzbrurkNJMw5YRMmZAcZAAJp0kIKZT7D0u0lSLNZaIr4Pc03rArV/wzUd0sFaJyCOSLE6AEKrBwhKdAqSIfGAQS2NiztkywjRDw1RvTRlrhVC/KMnXPkyxyYCE9Yzxp+Vt9TWSUKxxEct1znEIEdB/YshOrlnzZRIYhyp6AckFAGVpROpGDSWQAlUdh8SUuARJBHcQVpEjiA1CwR7sNchsWURdXXiAKU2zAA7cgQQA2MAsfNOFD4/GVAh6rXoEEL71z8QcUulSVuv7DVxVV0U0PolPdzfT/kHDzWE1pijUs+eSlApGnJg2xiasOJKpNhYw+ii0QGPyDBBs2ijJMMNZ/rMFfRRnHRAJ9EITqRBuVgE0lkICbvrFnXSXCJWnLcAQmtAIUzfnHi0pwBAI8IW8IQ6ZcLLdMNjLZ1C2ogKR08tnq8TQhdRMEsko7kEHwlOBTK61GZGqdTdjQfBQ5sz5gYMOiRMvZqflHtP+xBBWr8h8KKOtcMtcTbSxtFCy+76uH9V+7BuYpCsDFP24hkGKwIgFGuKUhT6ECfFvuCGwJ9VtOXYFVI2RJchzIlHoCH4PUla4L6e8UKwuyhDwKPs1ESIUxw/G3HN1kZ96wJECeDIEkA+QZ/3B5QhDhCRMXJcUfWSKhRvGPYPzDCOXE2tUedaz3EOSwNjAGCXI+CwH8ox9FeABqNxJfgawXW8FblXMWFNm0JPwvjgJ8UTgvEE5TF8zBGc41D6xozCSktjgLWJmcjRC1/8MTd06Ea1icgzvT3hPBzdZb/zEKc+AGPa6278XapaJviGEj15jFP6ghEAHAQAF4MU9V/B4wHxSM8pA9da/iKDf/CmYv2Y/mfZ88IoP4aklcou68B4LO1U9kwj1DE5o0fLk1NOggDeK/Tv4vYBYg2/7hFZqCDuaIKyjrakArIm5pOALIGAbiGP5BAK4BC6pjbhjiA1Tqcoik6DbMKiLiwP+2biIGw/yuYhu+AZEODrE0i5Y2CK96wnuyKvZ8xFsmYu4QguQQwhPizgRqQYj+oQDURhzG5Sm+zKayAuoqql2s4gkA6R+6RvGOQQB4ARCErOEGotcCxh+8bkG67yMGw2OwCySWzqLkr3k+Jr8WQr+Mb8DeQuPU5rdscCDubPd6QggRIgZU7CCyjQATwhWWJviSJiFkpSqgbi/65T08zwXEAFd44vkGoh+cAiHWQwV2SEi2b0GAx5AE7OUewj3q5iNGSCH06iOubCDqRq8cZRQTwj224cuW7XKyYSLsiQ57Bg8pghEYYfc8gdrs0PfG6u1Yw2j+4XNA5yMuoRtAZxT/VoHGpk4goqQqpAZdkGVSLOIprqE5ZqEYjgEGck6QVCQvsEEdSg1bfGALzvEt7CK9kOR5amoI+kJYpO5KxhDWpNFsDsIFnIcqdOofCwlLomSoqucNdugX/gERHqEOE6JB0CEA/0FopK0DdJD2BkLuPAFc3m41JmQZjst+JoJQEApp6mtWKOt/7gb5vOwIaKAYKnAWWKEfCuEInkxEDOIUjGAd7SQDX4DfIMuPsmIE9QribNI8isWziokAXIArVrAqTjGa7GIrFOYgHo2WXDHmKEK/EkOzEMLseuKsFAIIEOEfwCFPGM8G8RAiD8L3IBIYBwITFCIRkMJ+AK0SAOu5/wpxNhAiEs4jzBoG15QQKmKNIdKACQTCFtIhfV5ABTBiViCQR37EOnqEVUDQR4KH4PToMCRq6oSFjrLiJg+OpuBR9AQCBUorv8iwDUelVGwsIUolEUlwIgyApYpiLAci/zYM5BKSIoamE0wABz8iA07uHyZEG0qhEkZhdAAxXJBRNoYOTBKGcJ5EbhACI1TrBXjgH6rwGgDhHyrgSfgGRMQAGxSACwPGLlxK1E7hGxqlL9xjxggjvmhKED7gCeDTRNxTg/LmFBLjH1AtEWsshUDomqTx57QiIe6zJ2hzLSgiEdgHE9iHBRgyIWbvIIZGlXgwIXrBMAciEZYT0ESnUP/shxLM4R8GyxyYiyJ8DitiMdUAR79MUERYhAaWzRdmAAv+4aiccqY2AgnaYkGLpC3C0GCOLjREC0SUpwwuZhvKIDpfjqI+UQzBZG7qqAwsa6auZFTekSIs4gkGwzxHDS4EgqX4bQX+AQgm9CB0czfXFCF60ye8BVA89B+Wc2m+ayCQ8ROWSE9LwAjKhkQcUCmnFCRsZQi+Qgk6IgGOgIGWhOGq6BueQQWSTGB8slUqVTtccY54SmS+rAR9I/RergQFR1c+Alh84huCwCd08iBWIAX+QQf+wUzrZM1CQxcUohOGhhg/YgQSYRQmpBQyFK1GoREG0UT/wRSOcSCuIaL/5Ag6rMYn9qcMxGAI7qACOAAGnAEGrkFIRmRJomLqoEMdfNILt6NO0jPziMquTrAiygATFUK0JC+n1A8kTuEZ/FMhhqPR+MW+cGrXJuI77aQFiPAfSEF+VsACXNVVUyXZ3sLjEGIBGME1/kAgJrYnAu1nAg34EIJQGsEU3IGAGoGAGsikamkjcEXR1hU/NwsCFAAG+gFH/6GDSCRuOsu9IHNgJs9HiOdS3cKNyqCD+GZJNAstHgBXbk1EXEC0NGhTK6sS1VBl3TNls4kiUjU00IClWCrUTiAh0DRN2xQhRkCIJkQXBcIMsGUCkBUZ/2EGdEcJ+8aYsMRJUnAvoIMq/6LCCGAgAZBgf27NorIimcK0R3ygbYZ0LqgjVfCCKiZFDKbEILJD9D6AZGC0v7BCSf21PWiF3zaVaWPlKgC0q9bLNknhBCyAU5IjTRVCLXHPh4DTDgMGWU2hEVRBFSYAAc7BBX7jKfHrvixFat4oIqYEV3zAGfrhBQigPz/gUaqIVr4kTBECTLVjjRDXSutzPNcDNyzCXmfF6hwC4fbiVQi1yvinX26pc1H3IEJnIAyza/+hFutQEnDQLQXCT8TK5YAzxATtFf7hGQdCZD8hbangH1qhEW6HPmPF6bBmnPKCiiTFYcqg0eCIANJAARKAA96AspZPykTmWBQA39CA8v9soGBWtSe8UHrbaNSgQOmmJA33Civi6DAMVHEtCgVzpdwAyHMR8YClNU3d5x9ogHThR33kcCKSATglgaD0EFsuYRQ+4SMuBFm5i7tegB/ndneLsqZ8Vy/0hyGMwAjU4VimBklgVAWlRh0Aph3/wQa+MDRKGA14tmdRDUabNtY8b4S2giBvyRUP4hnyIl/ppsr6JTYtJzkAKgUKoBZ/QCDY93yLIol7Yh0EItBG5yO4638FwhT0xUTKydHOI1Y2F4v2ogK8QbLQwzumrtVWk4VzVh0m1Wr9IYLWmI23AGD9uCieB7UGoya5aZBOL0tEpo4QgrJskFOAQX4UWSDgp4f/GXlPdlAhHLkokjM2kDUhYhd2ncMb1kNwXFGz/kc3miR52SOEGq2OoGQEKcUwAm8i1niEewJ4YvknBeIBvmEQuNKOJqJRmLeoLsZRkvJYxNfG/LhsVDAj6OCoEoKdF6QDsIAJiOgfiKFrYbUAbHPOBIJWEULsesJbHJnkeBAYkphsPyLvxOETJvkfuEshcOAT0HaYFlEgYDT5bplu5rFxx/EOHjcRrehq+nYgusAG3CguBgKhJ+KV2StgQi/XSgRJ/atiagpLoE7r7rkvoLrGfgRI2fEfgJRVpgAhBlYg6g4k5Kk/sqVC9QQhoEshRmFYtUEbRgeKE4K7JoB2A4Fs/2jlL94jIoZSVwi0yOory04In9Wvf9BjCJqiBdzoOdkYDdCicN8CkZK3U3uDtJjkILpspypbGvfaEGvZBrEWJII4NOyBJHDTR4J1B0tbIIbGmJlGfzc2NljbFKK4FQ7CRaZZtodJKg4Mpn0ZevAqyxLCGxCNsvdzYcJM1wRi6C6vqOdCHQfmnUVDkHlXj/8rMLikuoEZDoNj6SwHSFv5H1R7kf8hkYuiHhYhJlQC7CzntP1lic76IEanpJlhXGTXFEyhpA8CinHAObR3kCLCe1Hov+vaO07BBZrAB8R3M6VGurEPcKX3jXXCH4Ban8QXbYIjhRZCbiflVSJiVyw8If8Eh8hqko2xBU27+iDA0icsw3EMxC9SzjoyoBLyMiFeQRhGoRJeIRhkY4kaoYkVAhoEAgcmIAv0RRCI267Xo4ro6+DIUF8P446EpTpwdypYdLftueCQhJWdIwPhWcuFOjSWpyIIwFdeR8kzQtaaensZ2S9gVSFsVScQJGYGwB5yATLqcNsKihmAz1BkQ20HIr//AQcoARquYV8M2G8uSrAHtISURIpYDQKwmQmzmKrfaNITQgUeYGcz7xwcnPx6gldOBG5SURpL9Ts6PM1rcyBK3C1W3MLeDL19pLj+wZEVQGMnIhiYQSB4vBEaYQI+4aQnAgdaAQdmoAVI5SYj20X/k3y668hfm653nOJKaer0AuPJBCHR4sgpTqECGOgFupwiWgCxw8O6VGQzsUJUmnzm8GvX/uaXp3MqdNcoiITfrNrU78nYDmITKukQfG2HYJ1DQSLvKGHv/uETOpbHQ3QvVYG+oaEEjPds0IUo06JJ6EYVs8QVO0t3xLMMIAAjGAIiJGJWVBCFwCk47oCT07gtuj0hjgB6O71SRIRK+OJTNehWpIacokyiiuVWzo/eTz28/SKfEGKI/eIS1NtYKWLv9o7gCX7gj3Ug+HcgEj7hfXwG5G1hslST24WGcyrrnfADngJguR7L1KJidnowHKUMTqGDUX65i+QctLwoWJN5/z7kyF/41dx2bjlTRaxUvlwNyWElVTrb2TpgLMX7xHXiWjZhETTJ1QXwICyAEighfSmkFEbnE/Ku8v+XL31dLy8AGhDAsz5LBgOnSToz4jA7wCj+H+ggDY70rpOX3v5Yb2aeeZ/ES8sz5ROCA8C9Jz795WXH3MT5hMj3Ozy+MEAoyn5O9WBq1H7Uck6cCCe6Mc7SLfIOQhJidIpriSZCGJYmaVThf5FRGwAAIfg3Emx70xp4zLHezJNa3T0Rp1qNAKBAZMLeRqx0S7J+l6trSQib29m+RwDCxot/BAsaPEhwG8KCBE79O/WBgKB/BL79K1ORgEYCFAueEvRxW0WFIP85/vsmRqLEf0MWunxp0MBBmTBr2jwoAiY4hEAO3vi3KdfNoUQJ/nH5qVKwg8IujXqlapRBU/9WSTX4SdWnVv9w7WmhkSLIhSsRTjx58sM/tRQ/fBOE7Q6BDyiCbBOEF+8/vQUn+p07d+1abHpNflMw8N+WogeNcCB69iBHkdssEiRA+Z/CjmsDb8OWsKCYfylHe4Qb0TTjgjL9ra7pWmaLFq9fgtvJorbu3QRf/btKcNk/bQtNtVrl0lSkCbjMHanA1iFhhB9PTSZYHa5fihtBTuRYFrR3hyYfjreY8qFGQUNKQhyCTd0RNLyPcHDNe/PG/Qb5Y3ao2RsH4QUgQRP/qaXWE13wFpMBWzio24MMIoTIP7pMyJtwq31SikuNLKVKQSG+dEEj/zBhxF7/PLFdQaDt9Y1CCqnFHkkQdYYgNp+lVRBJgh3Ell+CpBQRZwcSRIdE36jwmG4cDESfQZZBphlmJokUHUVYrijRMwgpQpqO5T3B1hAuNIFhmmkWYBAij6gJp27MfLIUcp+AcAFVL0VCkCtGfPTSRtah5dFDDJ1WoIoEoFBlgeSJBZ6BKgoCniBlGPSBIGq9MMw/UTLm2hE++CDZZQQ8MVR1DY2V6ERiFFgBmi49wdGlnGk2k0w0vbZYrxEaBAwqBFlAEDA4aTLUhXHCFA4Oyxa01DKR//iWlW/FfUIQBkxAUZZLRa7F116RITSlYBFZ5A2ANJIWWERstXvQjjESVEELnQrAQZOvHeGPD978WO5QlSVUrguCoTouTJZtZhCjcS727An/pEAQEwSx+UOFBnUwVAz/CACnNsw8exAAVYnYiFYj/qPVP5+sssonJSjwQAWBflAGgqQ5mjCibLE70QP/ctbSNxqJJJZoe21k0VzbqPUGB1j8E8oVWOT7D35F0aeCzf01NJR3hElXEmlpEgCavgQZ8KlLu86kWNxxrrDCiSkAU/dBRFxcECKIFMAxyYIP9UkkfDaC3D98HoRciDzQvFJZ5W2jENqCfOPlui6+yzDDav+V8S+jJqFWpc5HqrXeED//08QLSChRTz/1XHEC1qv9qaJBlH4r6XZn6UjQvNuQFxkKXtukLEEt5T4hTc4PXvFCHRAReAzJM3YInNjaZIkrHuimZyaLL7TM9v/QYAQKRUba19JtwbSfQ9mBp5Y3aZDH0UdMr/RNdQxRShAaoQAKL0iAM5AxAH2EIgEKeEzbblKeySVKd0uLDEcoVZHLFOQDAhqa3IqiuYJobW0Qg8nboPcPHZBiYgdZoQ4QYqzAvaQABTiDx4oCiWWZQ3AT+McMCFIEbmmqXeXZ4GU+8LSFIGglE6mMuwjiDQgMaIhqGQ2NTuG5H2lKEIPgQBxgQAv/aXQKGflgoNpukhmGuYRSeFkdkH4EE4iNEFjGKohFUCUgES7khAeZI2tKmCaL/cMCFPtHHQkiMYIA4YWM+ZvGUOiSHYpCcA1owD9agYFz/Ks07NMdR4awow2+xSFlEAmrQCKS9PzDGw+4FfBYwsa9ACohBEgJBv+Bggco4AqsuMY1EjiAfCwiASfwI0KOEBqdvapy7SHIiwylNL9YxzqCUCNCevVAgnzvJRUYQ00gtJoonDFNFiDF92RYkEP+owDGYhM6DcKm3WxCTaQgFiWQtRpoOGt74/vHNf7RD0v+AxS4UIA3JBIRGY3uO1s6yWfyghfChKsjRfwHFKSopbe8/9Ig1sTV0z5AB4MdIQHIQMY/eHENfehjEcMYgDQUkM2ayGgtQ3gG5TTzAd5JiYLYGQpttFkQGpCiA++EiTEXclSCcCCmq8GES3pSkEIiJJ4GuV5BinqQ7EGSILf4h7V0Aw2E/MQYjRCWKjCggDQ08zsM4YjR/IOdI22jPYD6ywccMgQClEEdrVxaRwPzEovYygfDgMEwpLELoBBEmGWc2lI/+BL9GOhSGOFORV1SHkAyhhKrMUBStxpVC5iUIHkjyA9YWBCopukAoCUIDQKgm+2FKKwwUcUMkIANVIVGP9ZUC2WwqCIftdFAYFvjbidYExfQwRtG6Ec+rpGPZhQkH//5+Ich8gEDJCCBIFFC5mUDuJm7pHEbLsipMwVXoUfeRFethUkHJrmQF6pWNxrrAJve1N44GcMlYf3nNYwxAwUMTyGq8Rz+KPJM+SEqMpkpzVse4JDxXG49NSnbKThQ2HwMgB+LsO4/HKEPoRgCBqz4xzk89Q8jYIOU0OSOqTqCOhfbCnp1xGp+X/O9RBqECKc90T9KWxsb3xC0tF1WMQhyjQbgAgYqztS3fKuZGMXouypB2mVkVM2NsOUbD0ABwwSh244GD0inSIM6COKI6h5knv+oB0H08Y8XHMEy7Ena6QCrQQzxcSiX6NuNa0MKiwn6H1igAUFIkYK9xcmpf4b/rW5+UhNf/jfFz1Dd6BgyTQBi1sWSAU9YcJmGg9rKOxtxCTZAaZB6wWAAbLbuAF4yNShUoJMsMVSkTMIRN/JmC0wd1sb+PKEiEMsgiewUege3sji9+h9Hhsk/mXxQwFgRMx7Fxs88SJBp9+VoQ7K2QdKAy7v4BVWAvWmPxFA5b5ThAWcmiJvzIY3p3gMh6kDBRO34L53xTrcTok2vX4IIj1kC2LthAsVoMDWCg5ZPySaKMZp9EF8y2xr9MII34GK2yuU5ghshSce5gzpqN0SnTcugOiL6jTKwNc/WeZGaE8iJmhivp4c6yNgkNWYUkiCFOHnNnturg0TqGHoyTJ56/0HbcDXx4h8JQILBBPOdCCLEynKltkJnamVy5cVHdyVUaAjyBFb+w9gdvgl7euYQ4THvu4NTbQZ+RfAmlRjIWwWTwgnClX7u5p+vnsYMEk5cF+s1zxS5lEQcEhGNGM2yYancdzN9iv5ZalzW0YiXm3CEEg9gABEgioIPYhqNzPJWWwUCoif02dYmku42ocGwk+Vnghz97hj65z9g8A8fJOobBjvLRLz9dbBzpjIXn1yVMH4QBzD0iMDnjrhxxvSyE2TZZo8XdhRiEY+3t451ywmGUh+nxJAWIZhQp02+52jyP3L2/7Ax7WsjUGf84wjqo06tR4+QVxkqO9W566qQS/8QM3YZi8dRAZRLNJAA7lYUY5MwXyYRMycIQfB+nWUAu/JzgwNf54NI/zBfCJETRWZoBAEyAFcTikBVE8ggCcBXANhTl4YpudMeeiFN0iRmfSUZ7bYQFeAPCQQDuDd//0AGMnU5CxEwKGiEjGED6Xc+IUgQ7kcE3icxEvN6FHKEavJP8RZx15AAcUAqfvEiAOIdC4Ewy3M54/Eq4/EBQ2AreeQSslIQj7EoQxACurRYBUEHNtMzHCU/qpFrwAYO2bAsu+IPFwgbuzEQsRBUB3FajLQQqkUsmtAhLqFeQ0Z7uMAgEmcM+1UQ/2QMMMABADJTH9B8T0RcRYJlKuFbYrD/Ok/3gwRRBaBSCHEwEKTCKLqGEGxHejcmCYNzVOA3iENhOwcxdKxHFMDgfVUoOP9kDfGGhb4Ub7TAQA/ghoRRRJQyGiCRILW0H57WEdcIgOEQDuVAFN8Abg9AKh0xMJUlZnf3b1ulK/5QQnw0QoRoEI/BARLwD7nxEu7HGyf4fpZoibqxXw0gcQWBhf9AC7wgBBxQAU+AdZzWOybxLaYUPAoFRwYRAlXABathHYzyBOrgAni2juzoEmfABLOAjFuzVGojAEPHG8DQDYNUE38zgQL1GgH5D8Zgk5v4X9ZACwUBHQcxamC4QREUY++DZwixkUSxkWw0FwCCDUXkTSj4/2/stxsQollrIzg/dRD5UGIYognEeBD+mJJFUQIuIQ3S4F+aeHKd4SMnkRficiDwkos3EQ6voREfQG7/8EwFIYFlmZLg9w/0eBArwIS1IVWA+SxYaEmJ9U+JdWTp8A9xwAFv8ERjsRd01WLaaEErwW9xchZX8g9/qZgo5CtaSZiM8TwLkQIrYAsFMQUfk5ivsQLbVBA9Vpp7txC212yskABHkD4ukHZhQSCb8RHm1Xs9oiKs+BJLSRAq4BJ3OWYsshYsmJsD5Y595FNowJUNwl0IoWgjaDFxwCAuqXAXAD0L8hK2Fw0EIQsJUAj+0EoYZRAOABPgRppj4ACk6RKveP8Q4kcQc3CduoGD7cVeBNEru0If7ZhN6PAS5rkaxFJIkfgPuDmgcDJaCBEFF8qhBBdTb/NYr8GPvCExOmB+RmgN+bUEHcqiKQkxCtqdrLEQAFoQQzeFaUKJE/iTtlcQONmiP8ob7dhaaCCkNzFOrIEO7CdItRGCgFeW5gOkUSqlNUGjVciIGzilWaql34SML8BacbITC7GkELoQNxpfWYoBu+GDW8qmpakvJWYIMHGYBjGCBbFzBDGbB5GnE3iWNsEVRcYYA8CjbUqoDPIpNlCkRBGjBmFoc7oQWSCMB+GkxSKluOCjhYqpmHqnvGGmA9qnBLGTGNIPmUqqDPICL5D/qGriqAsRm0Vhmy0KqKUqq5D0U/SRlRO4pAuxAvg0q73qq82Tknu6pZc6oMj0q8daEHV6EF8JE5u6pfRAEMR6E4P6Guf4gsgqpQeqJiOYp8MQCthqECn6GoCAUu1JENSAEBBHEFDwD/TABUggn254eJhhnzchoDBRBT8lmC6RqjZBH6m5EBlaEzmwa5DVr4Kzp3E6FNVAEM4KrgRxkDbRbLYwCwEwC4ZmDKhaASgQFxQxQYv6Dxt5Af75LEfKG/u6G2R6nfpoEMy6EMZWG68KrtKwjC6BrsyWk9QADToLDSSwCwlgaFDgMAUxtAYhDiS7kc7pEih7EAtaQrfaWYNZ/xBQ+7CduhAO26vUuhpaW5AwEQDSUAxgWwyogAoBIAAJ0A/bBW5PEHYG8SnoyRggaxOfFYxw853rJTgLWhtyC2wx97Bx8k+jJa5oia7UoIm7IAuzoLjXEAplxArbBQWnwCh3ILUIMQYjAIxoALAvsaFFsStUq5WgC66w4BKtiqw/RBAz8KkGsboIcQ3NNrirEW+jZQv7NVoJeGYQcAplUIExJZ0m5Br+wLQIQaQyYaufJUdRS3ubSzJuRmjLKpO80YFAiroIEaoFcb0IAQ3vYBDSYK45uaOy8A/TgAy+IA0wkA+I+A/OgAUPYASD6A/bVRuxwRhnpLlDIbrEi6C7IVuWuWmyD/oPWJu1CyGtaNkVNWGxRCELr/kPcJYKJ9UPJLVdWksQLHuhw3sT/fsszPsazaCyFjCpBgGzv5q9u4GSjCEN1OdSsJDCNsG3B9G5dxfDaULBs+qy7xcQACH5BAUNAP8ALGYAAABtAfIAAAj/AP8JHEiwoMGDCBMq/GfCoKd/iBYmTIbunxmJGAlqU2BDYIsWHP712fLPAMko/84pEEjK0r8cFw92+tdpwcyMAyXhPHhN4qWFpHYKHUq0qNGjSJMKNVGroCKkTXMo/VfJlcAXSP7ZMOLDn8AtJL0OtOFKgcsMDxNO+rfgH6ObRNfMdKnwHat0C9v+NNh2qt+/gAML9osJotK0mHQ9bGj0El2BQP4dQeOvcuWBBv6hIcghK02BKx8bLLAzbUHGnRIlrCaxr9VKBGEPnk27tm2/yl76fSQwB6JOiyetLWq1iIciNo4Y2XrEI2WEaIy4AjYzA0HRbDFiNyhJp0BGRlVX/9J0u7z58+gzemqK9GlDRJ5MmJhkYs3pjNoEQkt+JKRANGhw4MMRLShkgwIZZLCSUanBZRpBngz3j06qCdWWVeYkVEp6HHbo4WFIKfMICwVlYEIOD3nyoEHCCQRbJaUUwYQpNviX0BabFUSSQDYsYImCSDEyiZAIXUSMQAuM8I8FQ7lSSSWN/AMKRpS4+OGVWGaZWy9ELZabMohEBJ98//TC21MIFWAJXY6RkgETHHi1o1E9rgWXUdYJxJhBloCnVH6gyJbloITSlttQD6GJk4pr5JADOslIJRB7XBa2p0ARCXSnJZdog4RYQ23R1UBeFWHJAn4OVeEkfaV60BJU9f+VEXmF1mrrrUd56dBAaD5SmEC/YtpJBokQE5QNoBI1WUFoMDFsdUYBQ5B9Ba3VlqwLvSgortx22yELByw1EGIC8WYQl4ZFhAkitdx0SSUdCcQBB5slq5lC9RLkTws+urRdRp0oSZB3A80ksCjQLGTOtgSZ8k+V3kYscVEkHiTBPxVLlCJGJPaCLiKPwDfQb9nFG90L//hgEGX2FvRCjgT1yIRAFRaErVFKuiKKQoIu8+Q/OxsU5cREF51QxgShifRCnkRkQi8mPLIiQeYORFoBpjGSyDJFePXCCi+0/A+ORvwjtkAEgkpSswLtdR3NEyb0b8ECmWMOLgkxSdU/+f3/Y8rQDg/UN8RGFx7x0gaFS5SiAjHe+EDmetKBQNkgeQkTXpHlCswHofECsgmFbRBZa1bIhI//WJJIJwQftOBCVh0UFFuwaeOk31LWbfjuvCteblKHKsTCU5lOmlYBrFoQUhGaqILsFmfLa8ScLreMxgmjCCTCP6Qs0P13nyHVjOAEueKkK4H2HTvv7Lf/YfGddODjKGEXoU0progV/Y41HrQsszLKwOw6cSrVDSRPRrlLFqxEELclhVbui6AEcfKLfzykA8A4VSK0gbkiqMISjUAZRnCUHJJQ7wU2+kq9OIAVJpgDdUugywjmlpFp4AAhP4GNBRhGlE9M8Ie2QVO8/xYSrKL4blwMyRQjLEEKbbzgBaZggja6hhGYGUF0BHkZQUjiAxF65AVMWAkTVEcKBdwMJz4MnEIsYJUcGiV7QIzjlYpXFMf1xoKY+MVDSDEKJrRgBd5zhX8uIxEcIeE5YkEhQTbTP1JpxgbjcMUCKsGpf/zke9hZ30HMcQl6cE+OoJRYxUhUwYUowwTBOwriPIGJdVHHHDYowjKAsYwhFpIzRkjWEajHo4Fw7ipIUIDPWrI+UpCiLAZRUn5oZb4lMMmBbSHFK2YHiiaG8pq4qto/tMkxjGEkTCTjng1OYIELeMZsQvHHEbAomZAISCBFKNBCWjAOc7jDHClgYxtlk/8hqyxgAa5oxARmN5DbDeYV2EzoThAnvEcccaHeBFgGLwEEsvxjHAPhJU6OACot+kBlT8QJB8ahAFWYzxKCsoACXKGalSyjEeQwCA8LYgFS6E2hOL0SibhJm8dEqRKRScoRVIa2zP0jJNHb4kBagARNvNQqrtCnKxx4FE3m9KpHeWg3d8LTnazFEhtY4AJmFqqM/oeoaGhOC1BmA3liJDNi6UxJtVEJBVTFIBC0Da0yhNW+Ns6OtGGo3CoBMThJpDI4es5C8vWPFtgyI/VKpAtxYDtXsOYfA2iHKTbk186iB7BTeQSaFAUujKTFAggkpESeqMiFDMhrL/gIvXDiD7D/LNUGFqAECTArkGtMgKxW9axwi9Yro9SuI+/cSRdtsCNQXcYfXTOCOl6njrKNCiFhEUjZ0DmQa8Cgt8XwwHDHuzsWjKgoP6LJAqgYkl8ipDIBsoF8j1oQ/b1AAQQYCAHUAYUuxgkj0D0IDBrwDw+QwoGVmN2ByctgWxWxKDd5YnMUYkJ/hAQJwVTHNzD8ggmPTTJPVAEBBJHff3wABafoAlElAlcqZhEXmoAqQWoqkKFNZYENzvFBFDE1hIA2I5MgjWQWKxB1rnMbBLiDy4p8L5QRwAUmPsU/SEyAU6DgujcaSAoH8oJj/sMVZP3yX3BwQx2zr73M8svkypQQxXV1/yfEQMQkXgCFey3EH0bgwBGs/AQCVPkDJP5HLnmEMhVs4xSCkLJAqjyQFWMkOfZ6QU1FEOMpQeyySUmYQARgZh0jojARIZFUEBhR0QoWI2u2851J8oAKfGAbHyjxQMSgAs041ggqEIMgBPGNXROkxCrQqEKq+wAcjYUenWpEQGfa6Wa3B1OG+QcCn3KAR7xZKJXDTEYqkF8/l/gD/7gDAcTwD3Vg+B/fKEOVE70Ngij6H9gA8H8K8Q8UpKHYlbFBKygBClAQTiApEMhNh6JpZ+9uM+5VSi0eYQI6Ug1YSCmisA0CgSnHGtz/2IYgpvwPPwvkCSZ+Qq8REmtBxFsozf8RxBAGcYpt5JIsF/hHXgVSA6RkIQAGzzFvHD6bWoyjQJnB19hQvHExPGHjBfE4ucXwDY8HBg3qQLSJUUCHf9z7AQrQBg6W0Q1LiEAElhZIcHNO9oI8JFPKyAG6BMOejPjDGyi4uIk/5JXmEADkgmg3ClBAgAcImnua2N4oKGEOiBG07IiH3ELafpBkYGQBwAAGJjIQtjkZIOgfHsgRND7ijy9aISVut2AUcIpYV70MokfBP4bgdxuUIhK5FYWNE0/7gejRILVoiIQSIqmEHKkDawFVZi5/EB+kIQjqXvTG251fWOs31syfTQu6gHGOf+ADzxDDiQfxjc4ggBKioIT/VQ5f+/ITRD6XMopLGECQyw//XqB6gN+7TZCTC4Tc/5AyAWLtZ6Qb5N1GgQQooH0d9wGnQG5PoH1G1wT+4A64QAmzZ35lx3gGESbKMDWPsHsLsTpE4Ev/4QYVsAd7UGeawQGtdnHelgbfcGgCIXqLBm7PIAhl8A9iIGvu5oJCkRkTNgSqx3elB2gC8QHk1gIzIBB9MyVTMQs2J4GFoyI9ZkENERMH8RgukW30wgFmIARCMAflwAVCgDJ65mqq52fbAAEokGjdpn8GiHEVkAbeUHodNxBDgHTfgG76tRNQFwRpMAZ+tw3fUAFx2HEg9wDmkAmg0AhMQgNC4QETwIQN/yYVDqcI67IWDZF+SMIWqsN+A+EGcyAEVVAF4XABVcAFXSAQ2DByGUcAfRB3uyYI+NdxdWhiJQYBJOZ/HecCbPAPLvABSlZibZhUWbRiKlNxDnAKcPgPIPcPF6CI/wY0CyFepkADqDAQxeCInbUYvZcuAqGBDaQaC3AJKTA0HMAFJcAF4WCO5RAOXbgEHPAMAlGHJ0YGLIgQJtdnT4ANgoACEFABaFgQYPAPdOACnfcP3pAyGXEEFVcQPrAFEPANqtduBYkLFiAKiogUSlhw1qhQllgQtRAhAtFjSpII3XMJqnBUQsAF6RgOVVAO/1AO7iCKPgCIoocNpwABBLCCc/9ni3F4fVP2DQXJj7b4BLioBnSwDckYiFUkEBXXBwppjH7mAn43BQWmFGVGFDuDYxlZNJLTCZ3geAaRDDqxFjWTOtojAisgAI2ABkKwB+h4jue4B3PwD1zgA3xHAO02BAWJAt+gaLa4DU2QBsznZ7T2D62mekUnEEqmBk1QgwJhjH4nFAkpEF3xAHF3k0dgDB6wb0mhRjvBWVnJOybQCRpYEQKRDKmWTJYQcLvwAuHQmq4ZDsoIiv/QiT7QBBqXcYMAAQI5EOC2cU/2DxVXAUgGbuA2CALxAN32ZE/wAVaQBAAZhFX2DcDII0wJnATBlFCXX2lgBCXgAVmwAUrRjEb/0TefySHK8BSWshCTwJUSMh8RwggMEJYM0BYiqRqzAwMnYANbyIVLwAVVIBBeKAR/wFFpwHeiBwGngI+I9m7g9gD+8AZQRgfjdpP/UGuS6ZD5Z2LOqZh0gAJNcAcV4A1ekVTGh12EWQF4aQMNQAM4NxC7tROE0wjiWZ5EIyKH8ghrdxCewJUz4XhpUQuMYAISYAKt8w+JkAJtIQACMANI8AZc8A3f4ADb4AIuYAbbUAEVYBle8Q1pQAAo4I4oMI8ndgouUAF+1wT4OGLDmXFXVhB9cG8OAAFq8A9goGSS+QZYhl1GsAMDwadfQZj59QBMgAvg2YhDMY0OIwraAH5E/8FsNOoh8YERXAkenZADjLAAcVIZI9AJQaEX/3ALAmELCWAEwikGRvcEc3ilEABfHNACV8QV2+CG68aXSukPaZAGA4F0CkoHUGAZB3EHgZAA9AactqlqB0ESZROZZhUEe3cEojALWIkUJXkb0fqogvGEBLEW4KETnfAHDCAWaBAO//QHliAtAkELvjALt4ALRtClJAZoLjAEUrCqEmAGVRAC5VgC+jpUf1aDYnAHKFAGJKFkENCbeOdn3tAEWxAB/ZAHhaAO6lAIhXAPAhEBdoarN9mr+IKQIrFFJMGs//AC1+ABOKeEEwOq1uoXdOQJOaoW4MMIwYAyJOEGn2MDq//gCjTwov9AC0uiCudgBIiWgH/oDT7gBlwwB3MQDlzIBXvgDlygAD4AoX72BN7wBkFQW0o2Bv8QBH4Wbzf5AXvpACpwCHFwAwkwEAmgATJwBVtgAErmAnSAaCHqFQmnFX06EH3gFWNgbwrQALeQBbNgCxgRNFOZsu7DJTkQPA9mELpXMH8Af/9QAgtwDmiAC9cgAMcgEDhXFQrwAhXgr01AIF74mq4JAOLABW4ABVKHl2/QtkFwB0sZBDYpBvi4nMiIjCGhB0BwX//ADzKQADdgNkp2B3eADd4WojvCS2hQBH5XnSKxI1fqAzhgDGU2jQtBuIZbNBIiOQJxgb/AJQv/R4EKcROTUEv+gAY2sASfWAJCsAT+QAIwwAvGQBWVMEkLYAMJWm8PoA5bqLT+m7RJe7qp23Jk+gaI1bYJCQGvq3pS9g3E2QQQQAI0MA92AARF8A/AKxJ34AN00AN3QAfgdpP5lacD8bPK2mhVZgQzwGlKGLjlEYHZOxRuNRS1cA7/cJ7n8BSkaRCvoyklAiDkiABccAF7sARCsAD+kADGgAq2IxCWIA4+MASIRrQhsARsUAUVcK9J25p4EA4lYATeMGJkUAEGkAdXYLYU6w96uLUfgI9RZmJ+mSMkIABFILH+oMACMae8mF9Vt4KnkAb2YmEKwJAp0wfVGQRv8AA2/1ACAnBDgKAQxxAA0Fpg1jsUQcGZGFGtMSwvGAFqj6MbXKIIuZEiO5odRpoI9KkawJABCyAVF9yS6iiXrckFTzQDlpAfiTAJiTACRfCGVYeg3vANbvANXdAFWooGy1ACNnkKDlABG3wFMnADGpAAV8BdFdfGh9lxKQY9RRASBsCUsvu6A/EM7RZoKPAAaWCm9mIEjykSQSdlzYEF12ALLZrJjQExjQDDGFHP5bllW4YQ4OAJvNFjvFFKAmEdM0EdZFkhucwqcokAsPkPsOmfKlkOQtACGVAKwNAqI4AJbqCdLrCcxogCvaqlPlAFexCTLhDHaRvN/6C2FFASQfBxov9XYnY5Yn0AATtABv/gAIApblBGpy6gf3H4pfurDslSBD5gyCXRgiTtNTNgCyZrG+bgAeGHEeApEJXMhP98FI8ADgwR1uKbEFpDM30hCRkwAi+wDEkbCRItEOoY0YEQDjZADiJQISMwAkzQanUYzCi2nQACIJWBDkpgBFCwfwQAiPXwDzcQAY79DxZrnXCrza+YX6egAg+guk1g06XXBEIdaASBAhVQcf0lFi0ABVvQB8RHmRVgbP8gz6hgC1JdG1UyAdirEJxGozP8H4vDEGuRAdyYEKmhNS5xqW6RCEVgwwIhDgSxDFwQCEawS3b7somgAMgJnATQBQ9QtCtRBYH/AIp/wAHn8ABhnNj+MAVEEC/UrA7WzNN2iIPL+Yd+RwYocActd5N3kARJENQ9WaAFSZj+8FFEVSNB9wDYUAFYdg5CIABTTRvmIApZIF4ZsdUHkdubrBDBjRGqYQnE0CAL8AI98J8SHdHlMAdcYJ23WgSXUDMjwABqnX9pYAZo0AlIm7QIcAFccLRCALWudnJFEApAEAf/IAP+kBkOwN6AioMtyJubDQX7eGJP6ZwhPGWn8AD7eAoFOWhExQGDhqtL4KAFMQsJIwu2QeEX/iEtkilwsToVMhOd8J/9ORCtKdFJe8czHQQ+YAOjsNEu0QnEkAgv4ALxhgZV4IkUfY6g//iJQtCup0AHM40GHYgFedCxUroNUeAVMxiLJHdo3oCrr7Z/VTfUi+YC9vYPWMrO3HUVzcEGo90yTAADcUALgisQOrsTPkQbJjvrocrVxvoXjIAIk8ON4EEX/zSbAhECEt2FXowGfie7ZLAFTDAKSiIrpWADz3AKW2DECzAHVWDiSZuOAOwGsNYEPkACJ/APJPCxAjGD+4iruJp0LkgAZTAE154GLbd6YqB6QDgQDrkNfQAFFQAF8pcsSBDwC5nqJNEC13ANLVwoDU6Nj9rVSJEDjCFnaSEJcFHcqpEDblACArEHLCmKQgCcsgsBCvwCHeA2DL152LAFIbANU+oAfv/WBW0ZinUGwTYgAPMwM6x2fYf2AC6ApxAwBDR4EOCmfSTW6VA61GmQBDwpCCu9fysoKuqw3Y62vIpVX6+tBKzwD0qq1RmxDAKBA4SLyX7x8GdeFCoiELqQJxngKn3BfmYQlwMhitDtA0HAp+HseuaKJIxAP9hwbyM2YoAG8+ow1+VQBSVwBALpoJTRB0HA034mZacQBadwB28w9M9gg/dnEOcc8HP3BnF3gDT4tWVQAZOBBB2mL08kFpgXFi+QAJjLabcwC/y8EI3QCmXWCml/FBLvIZLwswBK4iGA9366tRBgA3dNM6js4vgrf6ew+SQG8y3nANweAlUwf7UFAWT/EAQzDX3wJhkh/QG3SvQGwfmx9gClSJnLWYshjGQJKn8+kFabsWL+0D8TRwM98Q9xIAAkoOsAIe3fQIIF/6nSlAUHQVELDT6EGFHiRIoVLV7EmFFjQQ4bPRo0IbHTJIM5jJTY84+LSi5VfNyBQOZfkH8QXpQC9vDSvwo+VHwj+OGUIDrbqoQox2UJhDcQ+tyB8m8HhDL/BP1TYUSJkQ//XPxLM2Tb1YJdCQrqSuCfkhBp/olRe+rfkCcVvKXxAYXAKX//jvRF849DXyR9+w7cQhBIP1azjtli9W9WRVP/KhNEBe3jxWP/hm0GHVr0aNKgPRXMYPDFnH/l/l2oggBJ/xSaUmtCsCECWKIFAzsx6PSAAIQnaNUKEoRtmzc2CKqUOPLvgz8INIMEIdBV7wMuQl58cPFhCBkXaCu6oAPhT489D059wHZ1CDYID1yckkvASN/E/wIPRGM/iPzhoJhiIqMlwVlmccigzgYy5bJ/RPknACwKMiWLiGQprUMPPwQxxIoYUa2KOcJhLYQ5HoBgJjJogiCNmzpIYQFSehshhwe+gaACQQg4zioCvAlBpXCOaMIH4u74gIAmBrrPmz2E4MIHAu74Z5sP0ghyog/I2OYIDOZAwA247ILAh29Q+OcZbE5BoYLoOAgMDR/sbOGFFw57iAkYBJBFFmmQ4ZCEgf80HAiZhyJ8rSBZAhjIQFtE41BESy+tqKOBNMXUoF4IWuOfSSRJ7Z9OclhAgiP2ODGEKqrcgSCayMBtFGAsISgRYc55xodT1OpqGwKQ26YLLtzhwowHsEEBBUEGIWCIYE9J4xQhSlDgCLK8uWMbrz54wiACvmIySzKOWEIIBEogAJuaBqoAPxTUgeKBB+b8J7HE/HkhwP4esqEBAeKg5RhnBCiIhn84TKUYZLJQRUJTVlnmEwlFo6VTjTemqAWOByrgtNRKNSiRcyA45Y0TL+BijBavaxEC3JaxRARLXFkgkRFsqBcFtQoa9hsTTTwChWfaPGWbU4Daaxt11J3BDTf/PsAyPOmsalK6n7fB8g4H/iHvBXWFMGMLb94g6AEjjnjgHyigKOQBPgkK0J/CDPDnX/9soSUyZyAq5h9uPvunmMpUsWwgTf6ZAAdUcJgFYWhm8SCAjP/+6JjOMv64c89DRAQiE2ohqBYfxPBGpWVWqo2gqY5YQIR/FgBmgUkWMAICB94z6JtTzBioBLCGuOobpbcxPjsixybIrYGq/seBbr963gUyuv7nDh+6aG0EPrj/x4cW6OTAhwegUEGF8A0r6AE9bZibICZmiEOWyR7CghZkqPkMEIM+ocRD/Oe/fyikUguzyKSaQZBAPehzD4SgaDwxwdNMIgchEVVqWoAc/2wghQu5G8NMBhKTIywDGJeo2T9G0YkjQIBNQCIIUKTEhTlw4QWDIMg24pO0YQlCBXiIBB5cYhYsEQRLaXCBC7ahRK9AxAE+UIIQBuIDH/hnIHPbAhTcpTfEnMMGRggMF19wjb6xYlIGOZBnGEgQHLSiEQPZwEAC4MCByCJwAxkURc54EQ9E0I8PJJ1FeoFBUw0kBxlgwAF8UIE0VOEf0ImZCF9XCVJYojc6y4EPqpWlgiTNWEc5gDeSNpAeKm0uZJhDDf+Ru+c9JA1busNXrPO11l1nDBL4A2umRKeIoIEDSIBC/NBggxcg4S8QocE1BIAwiDRjGPn4RzNYIQ1azP9CFBMQRTYngKgJzBFSA8mYov7hDHFKhBaB49wf1blOgmAiI57ICYk60YlE5OAIBPDdP+ZghCiwaCAuI0MUtJEIUoxgFJZYxgt8wKaCCAufSCnBEv7RBBfAsCu++8Y3XFABIcyhBNeo4nWMmL3oZe+VXrtaK2U1EDwMhA9IoEiA5GYQG3DACEhAAxf9YQMY2AIZAsDcQI5RTkN4Rha++Acu/jGDGQROMwH45kAEQIsFEqScErkqO7X6R2VYRBEDIdVIBmKJAixDOoIQGhe6AIEoECQK1fHBCETAgEokYhQ58Ic3mEYQIG0DbSpBg48+YBbkWEUQT/DGc8KhBCSwtSD/rRsITb5WRFnVJoSHCUcJACCEKvBnIuMjSGJe8A9/FGFPfEoMGmggjQsVRH+0+Js+/oYMGPQDYUD4RxFoELhiQKpBgAhUiGSxx60Wt3OEFBWuCLIAS4zCCGSgKKuq0IdaxsQHC9hJMERQjhc8wGic/MdePlCBf6SrBb5LS9b+ER+MMu8FtRxDWylCEyzV5g770idrIrFK0j4kfojxhz9acA5/+IAJgYkfEq5hCw45oxnNcIQaFzGMVBBEAaQdpg2KcMZZoMJB1KCjRdK5RgdFxrgnvlQBCvLVR4C1E/9IjXJJkYEH0OEDrwpHFQsiMx/kYBUiuAQCavoGb2SnIA7o/4JzhHCEHRgZKIUdyDMIIBPWcKEEHHDeCN9FkRD+M3wFKYHwEIAAKxqEiw9BQxECc4RjGgQNxZhFMWThYDUOhBW1pUELAnwEI9BgBpFS2EPmPFQTX6TQFAlxRBKNYkZDpDcTCVWuSDGQRLjiHxZ4QRPuEILMuixWMAqCDxSwikuUgMA+Cu/PChIOIRjhARXYC3mTNhSyEMBbA0EA2XzggD5UJw3yjQiWaCnCEPYHDTwgyLrccZgAB7giRdCz2/5DkL4oIAFAFWdR/wGDf8RhtABGQwvUccZiOOR+/0hFMxTFjc18ZhobOWCjO8cp0OTAIp1gwlgLIlERKOAF42IDiv/KZh354iYYACAwUKwinSYNgbwK4AJOowKWNEAhDcrZyzfKkIYKlCAcwQtf2/5hAE1OhCbArs5hAoMEPCDgAiW4Fh/+ojdnS+QF8MtXdNCMhQTQQhrDGIY+/sGYb7PPIBfOQlQTRSjiYkzeT6cIyUYz6QW4whyz+wcpTtAAGzxho1xoiQ+C8Gna2KQEv/AHrOVCyn/Yi5h/2JM37qIOsLSobS1KQ8WVwAN1GSMSSliCjg3yNYm06IpX7EtH1xWO1NxcoRzYwhYQ/N98/ePmRofIC05wjWvA4Bq+oIEN3GxmfjEhAQsx0GQUNal4gwYZ+tMj1OV9mlo8InQFefE/EhH/kRfjqhMk+ocCmKsJGtzCGkAIwjPUEQgTib3s/6CND9imAmxkZ69RUEc5wsEDBbTgCPGqwAOe0SwxLK0L6kjdC1pgAwWUgMwrOUBHzkwRBxjAwv7QyiPnsARLkFkIlJ8IDQNAN3uBI+iXjHgB0wsAY4AGRREID5EFZHjAiDg02TOuHCCJf3AniBCBR4sIXNm9fxiBf9iJzCABGDgB79qGPaAhwhOhmvABdbAL8bqKcEmJ7rCBxIACoHgAjksDQVgabMiooRi6JbgpdSETwYgI+aKsmYCA+/KHwFAAN3gkBDAG9ysBHoC5EqiivrC/iNCXf7ABJBiM+Zu/itiCI+iI/yh8ARpIAFsQCGM4hlgIkUWgwNazQHWqhdPAPYjovQXIPYgQvoJwBVEIoFYIAFyggS1gpCpAEfAJAtqIPm9ADuMYiMRSCS6sIjSgu7apAAiAAkYSOR8osnKYAynyOH1aFzIDEIhoK8mKnj5QOSXAv9e4AFwDgIEQgsFAQDIcQDCsiAfowoG4hmKwBc0IHGSoQKcbJ4o4oGXMQxEZLY+JiEACjQtTrn/YABD4h3iAhnaYAB8YhG2ogBPJMesYIZkhLzH4BkFInQtYFwWwqbbxCXVwjwfwhiVQQ454gXJoOYigEj4Jh4mLCB/gE+j4B8VjxUgQnhkQAoO8iMQ4AiTYk/8zBA1htBMOkAVjKAZjcAb9cYZoII1DCyqDwMNo3KpAJI0JAIEJgIYAgIYtQIEP+IZwCAcueBmpeBkIGJYsSR1Wq4LRWpsWMQKg+Aa2obuIOAd1GQguILMayrUSoMYjUAIlsAiqrMWP26+E/AcM+IdwaDaMWL8t+EXQMIL+KIIE+Adp8MgFKidmupSTXEuU7BSPaQFq7BQqaEl4MIYjEKVt4AJxmAMHaBuZiKwo8JZvYINTXKUt0KQerCJ1wIY0WBsomDbEIAgjQLYEkKLgwQMhYEglEIfDWAke+IOJ4AHRUwIEwIBIuMIMGC09KcCHWEPKq6nKA5GOKKY34zZkcIb/ehiIksSIqgKNUEgUutyYu9wYUKACKpgAJ5iBFngPctS+wJMJmpiKIOiDPQiBjrKiIHALuMKKtsui1AKwACPLpzEVLxI99VsC1mDFcxgIrYii+YsOfygH99uvMVsC4VmLLhAmgqDGuVG/SxG9fDsBIWCFYnAGDpEG4ZQIDckChlk05LTQDnECJ2hOEGgFDviGYakAsFsRsHHCHYiCKDCWI6CimcCLIKgPx1QHMBK5K5K8nJK8NLSBMVwAp/TPR1oq1vgHHsCrf3AHIdgKzEy8pfqHp3QHghACIbgAHoDIXuoPNewLG7hMEBkmJjiBF4ABVoCFYWgGZ4gwWJgI4noM/074B0MgnIq4owt9umzcDCrQBL3cADSovur7yhIwg7aKlZq4Dh9IDMNziuq4Dse0TL+o0SgkEMdUP38wgydNyO74h2tYghywsjFbUjLjgSVogRK4ACXoLCtyg9VMpVMVQ2IqAgW4lrKkmyrigD3BlBZg1X9ghV3ojKFCoIzgmwiDU6iLgm+ziEkriJ3IiA2YACqABw9Agw/FJxNBgD3wAZnYSV+DGS27jgfggLbaAg7wVm+VPA44AnWogkD4BzMwghlwDR6YAW0gJl2sQlyYAwyAR6t8PyXggsO4llP1SiBVCUswrRcoH2dDsAHxi2HiAPuLHz6Zmyi0iLDkgLTk0v8vncOBaFOKmIVbUBRf4LZEcYQHhNB/4Jxz8wji/NWJENYOCYAJ+AcqoIgp+IdqoAIA8IAt+JFv+AAVcMQq2bIRmgqZAVqgHYMxkD/DQIOj9Ycj4J6bVIkQOAdz5YKasgFzQEVcmwN3OEV8VQCJyqzFciSPucWVKIhbhLm1IBBxzRfDoDwfENgplQjP6ggsrbmJ4MV/OIdiuIZxuIZj+DnZ+oeswgihM4g4gAgCOoYBItmTVSdtEI06cAJycIJxcI8mOQUX4ILMcoPqiBVCdVEXJYjqiBFwzReylLwuCARW1CffMIZ+QQIMiCJNnQOGXIsLsAQNQwJtMFIpEgfLU4f/HsA1glCq4IkEIVgCwJiif2iBLEUwFW0Bi8TM/ioCMHLeghDUgXgBOLOGgXBLbQMNzIHGBZJLxfWQlN2IJTCHqzMIdsAILwCFVxCBcfAVIXmGEEWRjjA8yDIImSGDMZg5pKWTcmA+gnCNElgJNLgwHkBdl5sDHuCCPxjDKsCBEoiiCzACXPPdCtbUiMg1nDMI8TFI1FqlpHVVwUACJFCHb6gAB/CGIyjYiFDRvmgBLJAGAWAFLGiwYxBcjwhfBzFZW/0H4BRfP3IFTeijj/CwZP2BUjgH7wKS96DfOeiCkqMVgwgCwzRU/iVdDpCb/mzSf6iC1fkHN9CTJzWGfzCG/3W5gCcthyKY2pZ6pARYgBzVBjFTggDxzh41iDAbQHHVscQYRojAL8GIjgsLr2f4lRGaW4PggGCSPAH4PIII088QyY1wBjpyhpBtRovN5CA+WRDQBJuxAR+gAzEoinHZg3BYhkDwAflqHcPbMsOTG8f0hi0wgxJwpFtMZeS1gTmoAlZdl9dAACUIhxy9RSjNtXIYw2DWzxIw1yPggXDIYIMIVQ6WiPLpLyyNKeSFKTEIilsTJTaQG8/CTDRAAo9BgxP4k3iLDFm4WM54U9/k5EazOohAqn9oBY9IVhEgBhOIAgJ4goy7sRNJFld+rHdxGRcdg1CLER9wA3P9OB+1Af+PER5AVLzuKAFi9lGyzVEJJrMxk1f/ZMrghQh6M4gv9A8Vlb68eVvLg6lvGOWq+IdweQK5EAQUSAM0mBtrRoL9QAMs+DwHGgZq2IwxNc5FAOKMaOd4jggJwJR7rpCM4EZVmIBuEIEOYAQfGCyvGBdHvMmBexdXRsfWeYAowIsjEALGJYg/4ADg8WJUduYLOMXUGGB4lOAceIGPuwCWIROPU4LoQAJhFjODyLUFGGFrNgJeigjAMOF2DC+DQI4hSJu5EZ9/YAIsiEIF+NJYGAZ7IIgehggIrQd9MFPgVFPOpogc9mFwUmrSED2IcNnRYFlu/IduqIFEAIYCeAEo8Jn/MvhQFUAAaHYJkXqZ2rhOQ5WZofUBsPuHIsGDCn7opKChF+ACJSiBIvjrEoiEVBICDHiBHIgihlzFGQhVAO2LKlCCl0MAkcaAYCZpiVBDNiswty2IKEQCFTCLifgV8jLI/ximVYKB0cKCfggF0V7Tf5gwg2CFrKpng3AEaCpwgkjqiwgcVkCFW1ht5JUIpp4ImDUIJ2BZgnCCzXijS/gBeuoAYMjtQ84OFSiHC3CHcGiBSIK+V4ZlsYtENxAeTlPCr/yHPdgDR2QN4n2BUIVHlzvrFjjFvCYzsnUHwGu2AIvSl4uEMSMTIQDL/qIILG2zQD68YVIAVZOIrC6/ByhY/zSIDi/NNwU4cGTIB2TQh3xwhgj/GM0ZhuG68A9R3w4p8UTIgAwABht4teP5lctdhhKgwhbZgT/t3OqAQRYhg474OCujZS9OiptkNT0zgqXkASmfAyXIBtELsyAVghnAgJZSAgUIsGmFgADLwkhY7zEZCPnM0pE2gvXDTTP/j8N4AQX4BnehCLUIFwKoAFkkCPh5gXxgBdUagKK+hoHYBNQeCOP8W4150zq689KYhw5BhEkoAGLw8w5IBA5AGa35AEFYAlSGYrEDa9CFvlDr3F1zFRpCg96ogldxxCrwDgIp1fckYJizgU6g7pZahq1dgPfkgRHIm0RXeFaHUvcbs///uwjRo2aC+OCBaAH1WTiJsDVOEpZTmKmB+IsXGIBrsAGFIRzTjohmSAV74ATAvRS4vHaI0PCK+HBtJwZJSARiuG1g6AQtroAPFRYX4DQuAIBw2IIpFqFZccIHOGgIiA3W2IL8hG5t0O49oZMWEIIM2HSY60cK4NMnB/u8IQSFT3QyCDD4FJ4EgCmKqFID9Ky5wWkDxgbioQhvuYofqT51mDack4YbYAKEMQR94F6JyIdFsIeiMlONyZgH2eEgnnmKwAILD5GcGInbHoctOAVv+AAxGAu/chWbVPeTQ8cRctGFBruOngN6sEmglMeccjYuWIAqb4ELaAM3CLA+UHj/WtmBPuB9MiD73A+wa/E/jBgmFqYII0hKsbAIbhYW3+mDYg+Ma+iHE2gt4Bz8grijRXhzgzhq4Yr5pcaIDYD5DpEEGAOGSUiEIniAQfgRIBksTlMyNqNiWAYbmUEyIUgJAk6K/LQhY3vyPQCIP0J4vLhwpYW/LWLI7NjBkCEcMgwbUqzojwOPf/+q+POn8SNIjR6P/HsR0qPIfzbU/RMEckjIkAQIfPs3E8UDf2j+GfF4Lda/Rfk0LoppdJE9Th9j5TNk9CnUqLL+OYtq9SrWrFq3Stj6j8ZUr1AL/JukcZIkTx0S2SDz74PGD9u2seESLhwXNz5ABtEIIUifIBDI//Tp8qcEhzkh3N0toeAjB5Ed/SFZ0ANJuDY+/EGo6PmzZzJbLiZu0/MfSqMobdjYCfUFEmwE/tXcdirqttkfxGgckgblEcoDhsGopy8rMrFQk9bTKiCs8ujSpz9tQX06o0SSGHWaVGDSpFIvxJyaTUAQgW1Lws0Jh+DPA77/IMz/F2SM4CraXtjl0n/JP1uclJA/NixQTjhXuOFPH4Q4tAMcFD304GcSjbYZB0bkJKBVPhTxQmoxoVGECrMJ4pJyNKFUBBo0DCCUIfoYMhRWuVznyCFN8YOVANf5+GN01gEpliSTEJPMJLUAk8gL57n0AQEfCKIOF1XcxUVkGkXR1/9Hf0UBQRQqhbORleEE4lpMk3FwDhdtmMHgRKDJSVFnF402GRohPmXDEVk+dYQ6BNwmyAffwLWVGE9sw6ENHAzDST0D2PPPpNcdZ5VTGk3qyJCdevopqCAxIokJjBRQQAeTADPJCyhsY9M36D0zhH/hlDOHDUFwad9HggH2jX7/IFilEEZchYYESnC0RYQUsTFhsxRWBIYLffjjw2h5ToaSnhpl+AIaBjzFgQLfKHrebMoJUsFm/yBhQwJNyZiPI/bsGF2m/0AC0j1PKWUUK7TMAl2oBRv8kZAFmzUJIgyjSsw5D6CwW3pRPnGHlSFUUYIZYfL1FwQhQ+BGmVVoPEf/FVkdwYdGnvXRHkV97NAHzRURxoUQF267LWpPvQBuVEWwBGWhcin3QRk58cRKP/U0ZU9RRjVn1SEh5avR1cj9A8jBXXutXCJZIaJRB999Z4kbaUxsHnqKVtHeHP75+REZIJPhA4AhhHMBe1yQNHdIHLqxxz8+SFRRZhDIDIcVYEhBB0XNzqHZtTtPFpURCH2kZwtI3HYKXIJsg6JXH1SgNA0JzIjUP8f1yy9WjuyL9Y6cPvU6VPVE/TXvViUMqgkgJWLJVbVohAgimEhSgHguzGTTTB9cvF44VnKx5Q7/ZL+9yH2wgfJdeneBJlQCqrPXYA3BIQUcPfQARkNSSGEF//3zz7wDBHj0wEbllncb4D/QYIP/aQQNRuiCCzSCjUMpZ3Rl+M0/FACDpHykOTsaQExolBXb/SNT99JI1TSSi+ZUqncmBBILjNIVq2SgLP8AxlMkkQGzxAR5k0gVA5qEIjGgS0oVaI9dSqChXQlmMIPpQwjscqUqkCQrfRDQ4XYABjgQogdtqAL7IEQH+jGOfX14whcoMAL/XU41LRggVFqgApeQLjrPI4A6PIIF1d3LEc14CgenYyOv7Wt3+frXCX20Qup0QiMwHAsNNYKJsd1wEjHohA9QcAebvCVKBHBBBWpVDi4sYQtu4RLIRPa2u+AlaVlxTR8Y0ocpWoEMX/9owxfkx771WWF+tbTCG9qAB46QkYAqIV9M1PGN0f2IDoJQgRxvUMKr0OJHIfyIIy41pKxpRB/JCWSnUjjIFGqkK12xQUw88Q9LhC0mYRtBJxZANoaNbXk3LEUO4kNJKKFHEMOcQzn45h8f9IU+/6ibr6IAt3CUoAJd2AtWthCZPliBobZMwheUEAz8PSBkfUgDhOiHgle2IRwJudPOoILGP73lCUNqwikig0FqxsQehrgmqDixzILxoirYvClUEgmSRJ5BEv/oQKqAmohfjMFVw9SIJY1Gyo0dwZ/22dJf+uAGJe6tC27QChrQ15n5SWEHTURNSLdlwE4I4Q+9/J//QqOCBiT8Izcy+cdtxEIAb1SgBRy4xiL0sTs8NgOmoJKmwcJiU5ziNAPEgIpPy5II8AQVGB0QxxG2URPdoAs9DrALgvDyB4T66i9B2IEbSiCOcABgD+pwgFZaICAIpIEMXeVQzzjggyPMtrabsdwWcuu/lGzuCL8LCUna6KNXnQIKHIABDAgbnWf+Y2rKfW6nFla2WpzqByPwwTdq0taWoEsMH6iCEpdxgT3wcz5BgCpgqMeeLpxiDFrxQWS20AeEfsQI7BkIHngwECEoQQgIcEcVFGCGPk3mtjwDCYeOAEyQCPMtMdGuWA6FDSggRCkz9RpLo+PcqOQRuh6OimPN/1K2JXUCAhWA0kdOIYjyEOAOe6gV3HwQMl35Kj9w48I3TkHfq8gzJp3ILw9KMAcAzCESCDiykefwDyB/gQeBuJaBwbonAh5BBU9IF5AEtd2rLKIem7hwTP+xx6zUo2oZDglzP6xmjRz2LJ4owAw7UIoDOEAMhMLGq+r5gT13QYlWKoEb+mBekQFGWHfZAxSwsZUt7LgF2hjIkSM9h0hTugQX+EgkuMCH4PCsWwLiAIiMslZsTBJUdMCKPlKt5mEYRyPN2TBUALmcEw7yKrXmHTCA8Z1cM6AFzuNhitEDJRcsgQv/wMvburClzgrGDUKwyxwUcAqWbMU6RoACAvpbAv8EYGAOkzYGAia9bXEbGQHGmEMJllyOkEIFoeIKyQsKIYghoLhTr3pKCY9zKVk/d1L+XjPApUMMXatqAZ3gAOii1BtBYEPY2yhDlTLLBSQEBmQ7CIIP2IAAK1VBHUOAMFYvoAQEXIAL6L7ApFMe7pXP4QIkR8A/lNzyf3Dalxo5AmxDggR1POEDC4QJ7/Ko13xgULmwrgesodIMaQScKyYExsAd+wk+oWA2LEbPTKKXSQShrARNJbRgfCAEvOCFrj3WSmTOMXIE4AIB2wZ32zEQ6ba/3e3mjnQCbtszkhqlyibFpj7G/I8PBlzwRtnEP/wK5g/fGpuJEEGuLSGCBbT/wBsTewvoHCwlKHljD6Rkj4w9+6UtLCEE5djYN55hSrGg4QEv8K/LKS172cf+5QiohMg7YhUFG8VY/6BDApVreA8n/SlID0nxCctNqCzfa5cIWyJIkYgFVCIDHBhC1q1Oz5mUZxs/vFI4tgCYkI3BDCHAZziEsITcsIv1AdzDC3hw5LbPf+XjZvvsj1wCG4RDCdyqDppwCLk8iYPh1CKcmVcMn1GEQvKBxDPFyFUoRZppxDE03XM9XiJcAhOIgw08gJO0VayoGHdBiWTpzcZc1RF0ARtUT3vYyhywARR8w8flnFf4QCDYQP7lYP5dQAmUQAu0yU4smEbs2EfYgAKc/wclPRe9dNAdKUczNOFVNCDyWSAKXYc4+RhWhI0lXMIliEA4gFNLqNjnCIpLzMSKeUMScQEHotu2odwm+UcXzMW6pAF1GIEQmMO24d/szYDd0Z0eXgAG8AAHMAEFAA5UeMRaoQDvCFdMFMUA5Mg/DEU/8NtTzM5HhAJUvFSnJN80aAQraMQsUKEVQgUjZKEIWAIwlEIlHAEKuICKic5R0UZubMOepUcIBMID/EEIVEDKVAEvlkEXeMOKEQBO+Ig69EA5tOG41V4OcgECRELLlYAQoMELKIEQQsURCsIdICHvBF9LVVNyNAcCutpHVIUGxYQ0nKMormNZdIJPleJHjP+AOl3FJZCDCIgAMZRCJ6QBeZhHXKHIKZAHeoiBZFUABKBAGmxD8D1DW42O5Z3CV10HPfBAucXetuWhDsYdDyoBB3AAR2aFsZTHRyRK74BBSDgCMvDCR+yVVQxAPsRCM6SCOoKEPaTC4oFE1nRYVFAiO1JHBnBHd5STRmRHVjCACDxeKYzDA5xC8P3dR1jdMyxQlOAECniXeezZEEjWujzANUqHOzzb/sWNDuYgBvAgYvyDNV4FB3iO1zDQVRyHTR2HTkLFLhyDM9xR0sECpVAQVkxNA+7LOBoFwXhY80VHIYHEAszjRwhlSDyeCJSCCDBDEUBB1bGRSNbTeZyCbQj/giRBwT8AG5Tw0AdoZiHM1qe4Qg8oQQZkgBAYWQksAB9uG/1xWx+63QUkAIBwgBBchUk8T0jcmwkVgqYE5lNcE0vGxFziyz9IoKZohCzYQk92DfFgxT3WQClkw1KimFvahGRtwwL9wxBAQI6hGAGI5hMIwhtsgQIwQVcqh2scQQlkxDIEgm22gBIkozMaWblVmsiZxAtcFeacQup5SlzFhDdEpFHQwEfc5FXIwifOpKttGGB5Rb4UBadUSjNhQXQaRWFqRfDEBDGQwj8swCX8gwgYRQ10oWR6g4mIIFJlHZSkxz94g9rQk0aIIbGhgQLMgAe0hqegAQc44z+4Q8kp/wFrKAEg6qHs0V0JGJsBGSJIeE66FOhTlIFN1JtVWFJMOJVVhAKEeo0UbsVgPdcVKhcpkEIlnGhIyIFGQCY5nEMaZOV50Bu9bZewRckp0AdcTBJvoIc3vAEHFAEPVAMCnEB7Uodp/cMFIIEQTKMNrN1YhpsQgFNWpVFWKNp3bmdUEM10+IIzVEpyIMOE+shghWNM8CROAtY5IgMU3tQjKEeH/oiIQgU5lAIziMArXFuKgcRsbOZNeIMrWumLnsKfQoANzEA1NIIXtAIQFMweGIuAXYEr/IPa4Z8fGkMezkB8PkYAidRjOGWWJuFVkCdwaoRnYsVjOMUmXMru6OU00f+IAl7FCD0FUGxoqMDQCFTCP1TCrIaEvpZCN5BDETyAnD5J5j2lbqCHdrnEq1ieN/jDBUwAJTSCBUyABfxWpzxAZLSAEVyBsTyqM0ZaCbiDO1gayQnBBRyqDUABXGgqSCjaZLmAWxnNjcJVbpwHXGDZP+BZSVSATZzIUwgaSNyRLDSDLCBDM0HFJ27FcfilctiDIwzDl46pvfaOJlQCOZCCZEIBvUVPgaZLbpiUPe3siu1sjonGC+CCB/yDB9wCDRjDOEgGIvpIlghgx4JTNXrbkV0ADxhBk/KADRiDsamV77ksSNxb9rVESLRs1mkEb9zbEFCpVOrsRzBkBpEj0z3/RVj4VUzAQtJ+hL5JB+6EBOf2ZKx+TQ08JhIQ7KuEjuI6rozCSsMprm2kAQS4gA9cmihILCp4gDGYRAH9iIAsGBoUS0n4l5Dx4AXYQKMqgAIEQof8AxRQrlHUhKJtV8vyKlzMouZ9ROgoYlx4QwHyqkaUGkikQuvQAibGhDMUA1Ygw2CFwjIB0uhW7dc0XnRMq0aIADlkQJwSCvdpBBtZqcKBJ4pkJjacAh2wCzSIwgb8wwZkAQm0Ag24xhYgqHQICGyhiQrAFwckABKgHAJMKhcA7hIASFSY5okBncu+CjZ8g2+SzqvwkBiUwRuVIW2cCMh9wDPgsFag5CKU7j8w/x2DEgUyoG9I9AuNyFr92m8gVUKJZkWJ3uMlKMAD9JyDEYpbVkz2Wu5HOBAH0EMA0MAGkPE/aCgRuMYLYKyn6JY/cEE1pp8RLIEQtMASmMHuAe+plY73ap7OEs1VFrA8ia9RiIEL9BwjvtowPAXVaoVe1m+m1EMTG8yoXocimNCs6msUX8UnkEI31MAnWIIHEsDfieCU2gRv8KqMti67nEAACIAooAIWTMA/oAIlGIGA+EMRfI0/tIA6KEB/FYEZzEAEQSm8Oa5WlKuD8QawZXF5YtlVgq+DUa9b7eysGUXRYQVxipATX+BWvEI9MgMTeCADPclRtW5IMGwgE8ADIP8BNNDALUCwhrrLA5zDe/IyqPHXEeyBAhgQVsBXXPDxZ8aFwpEOpwa09GAFitWGCXECYPFDEXfzkHRADoAE1P0DA2yFB2hDI2jCJyzBA5zYlMJFnz7F5xzwByBkEUDDP8TzP7SCF9DcIITEoQKJDwBpORipEHAAG8fEC+yFCkwHG6EL+VJuPWFFKh+MhYYp1ARSJQdcDoxDJxRSYh3lVrhCKVCCBTRCIyxAJKHz6xLgU+RG9gqCC9gALohCFnjABlACSMw0ZHhNpXZCCcBGt/ImB3gE9W5F9nLeMO0GoXwObxguVGwvqEiKPlzDP7SqQ4dEMxynRIOEMvzDq15HIU3/52KKhSZoQycvZRnyECPGxPUiFfS4gBEYAyqQsShohDFoBCYhgU743sH4A0m4wxIQoQZXB0LBNSUl9VWUNLAV9EA/pUuA62+CBJWChDdGxdRAwlNrRGMbBapGdlT0QmVPRydgdnRoAyWYgyZcwiu8gFTWm4S5rD1tg1OeJwGk7T/QcjwHQABQEhmgQGs0StfQdknQVrVZhw+IpFwQ9lMwbowajYSBJ0nvtWxHhXYBJxGGxCLc0R0hg1/FpDTYQ+bSSCVHdGRft3J9Ajn8AykYwW383RttV6/GhNUN4zagwBFAAypsACpkASr8QwO8wOd8QyEYC/DaHJDQ4KLl2LwJ/0IZgDZWkI6JEDDljvZTNLhR5AZoxsQw7AJIqFriIfFbNlfUVEqMzGVRbEI2UzdONQJ4i4AmvAAxPaVGvIqaQ8WJoIcLNMELiAIOPPCMC8AM2IB3xoU6FEGCW6BnugSp+aZ0oAdIADhcxcTXAm1IPIELDEETgMTSluNHfK5R5MNQhG7SKcW9tKpGuCSYE9YyNIKJLsPK9nFciKR5IPqKleFMPIACGIMHoAIOtHQDFMEz1ARM3AYS1LSPAO90HEEFALqhcKNXELq4Nu5eCwIdMGTVGUVcpQu6PIGd/ZMQ1qszSAOng8TUOEKY/sMm5AMl0stzR8e4fzpIgEKbugIxfP/CAXiD2C7sgmPFLOIEEuDCBqztauMCEtAhUj3BKSiAMasZBwQKwxWKMvN1AQuwojt7Sv+DOhjuEKCIobyFdsGFNP+DSoKEXrovOv4D+n65VUyoQ387VDhDI5s7kIzCP2jDP1wCKYxCBkjMq7ToiW8qJdkGATyDDTRAFkgs2/4DE3hm5p3nIJxGqPD4VqiDItoGWHtFbmBdHzPs64bEA0WFsVNS7GJFMzgDLRSD+3L8YsMCMggFZGOFPYC8v6B8qLgCF4pAJSCBEWCZ614F996bodgADoiCKWi1BwTACZBEy57nEBi9wfT5VgCHSHOndDBuoXdvSMTKEwCdUbS590b/iaLVvFFI+T9MBQ6EYuZWhTNIuCN86VPklXJkLpdN8sFot6cwQGJFhai3/L52gsSkeXmKYNMf7jzZhGayuCZoRDVYwBTQMitCz2g+gQIg/XQof1ZwQI7JBubHhGQhs0zwkJYVoGYurqlbxcyn+m2AHIJN+j90fXxDx9gr9lCQfkzozkcgnlWof+nDf6f8wR/0a6cAwzy2WVS4QiVM6yUsAECMISDoX8GCA7ERNLiwYMJ/2/4JIkDgCBIcKUD925DFg48m/wj8+/ZNkIIXDFGmVLnSIAeWLw1COfWhIE2VNGmK+ZfT4AcCNocQVKhwoU2WIXcKxfnz50OkKa8VZAWT/6pBTv/u6XP0b1E+lJvqGSrY71/UqmfRovWQlmqigq5WaqrEkJSrIyjYooS4DYU3JJ8MUmoFzQedJyFtFjLi7x/jvI8f+6hA4BRLoRExEwUpMaTml09rHpUIkjRk0wv5LbI3QKW+gmJPx4ZphtES2bf/pVhwZOA2iKYJiKGoilTGgq1euPggBqdEJP624Fb5wmXsByiWly66kzvOfy567jwliDzmzdpDo/S+3btP8NtAh1+ITLprrv+2ouRU7/bVgvylK8iEAE3T5J9L/iHFkkuOKGi8tDwL7psjRAnsH1yQSAOFbU7p7AkFXIqOQMc4OMm68SpLCTSCdCJor3+Ee//ioH9SpLE0+Gp6Sp2QCBjCoKZoYvGpb4xiyDPpNtGHE3v+4eelexZhyD8CHxtwjT+oRMucf+BSEJgl3BiIKEFqPAs+F1DwwRwcDKIBGgUeoAOkpsRQoQXHsvynOtm8qcwnlnyCaL3uuDMSohYLpaq97pp66ZuUEjCNvqiYxG+lUBYaJk/T0PkHy03ZIsUCS5YxAkUayTwypQgJQOEBJnD5R4ANUEEFiwfEo0wMOlSwwR8DqCQRN8mwmQiliXic6LKCILpM1YWEim8lZzOTjyEZBcELoo/+seE0faL8x6u08gP1rF5SYsJcg0phqBJtCkpBBG2McCGohaRNqUyRPqD/4wEbWpmChn9QKYaGwgrl0Qg0QHWJMTwhu65Z8ygubVDvwPvg0Wo/mInQqtoDEqRtFEIq3yL/geExfvSBLctKszTBE5gWsATUDFKqJKNLUljFDWxum+gOFF4whoRZUGGFFmlewM5kkEAEFQ1ffWAYMoaPOMVH7ljETLiuCxIupW90sqlDAuBDal8VD9IJWhgplkjs4AYKL0UfIMsF1FwWKRe3PxhZlyqbLRGBIXgrEUGEZZDwpu7T0P6gjBdosMWWYmwp6Ig7DILPJ3VMzHNqf1oI8TRvCpIxtEVRRnk7hYCuGCZslrr4452AFFlw2Ryxb/ffC1pggQRRQvCSVy6J/6TxfNmayIUKfMDiGm4MugEJMow9hcMnVHgB4gCj89Xh2CRzEbMgM1uReVbRowpZg7SvdrSB6C9t2a2BZwnTrzbNIX+VUlC8GhjkEss4RxrkFBtBbOMDT0iDEWAgAFoYRAEQwItBOIeN54DqBWhg2Pcew6FnoG0nsUsPQ1yHwkZZ6yXZyVjuPrCNO9DBJzU8SJHolsL/FQRmDLFHuGKzhh1SxXD/MBBKkBeJczhONmI4hRjEgI0XzOAfspBFyhSQBmXR7hR3qIzV8tTBf4jROigY4YN0+CNkGetHc3pf2GS3krrNbY2koRtDgmMQMZThjS9ZRC6mRKVYcMV3QzSku//mUpBSrCJDzGPLn1DAgQAY4x+z+McM6hUSIqEtJOoAY5bw9o8jfPIx/uAA6uqWxjY6ZSE46lx4BrWSP4XMJiKjyW+YYpSlgERjDImUuHq3iU1J45AqkcQ/OmFIBI3iEgg4B3zW9hgyEcAHCoDBLWjhjDE2wSiuVEGDNnWEO7UgdJDxBxok0yHOyA9fcuMRHu04I7S4Mlrzi6exJvK1Y2EGNNzKxQ3+MYBNCHNT/AHQupIxs4Lo4h+eGNBCklmVcfyjFrhpVykMR44LNBI3BHAcBJBQgn/Y4hpY4I1vMPgP7oEzTw7DGwgf84BTjGR15VmgQQhCwoKAh3MgKZtPdFr/FTnxSJdAIs9Rj4o7VXYOPCk5aFUQgRIdIKID/1DEIY+ZjIJE9X+JLIgFFkKJf7yiIJrIBBKgiZsnnqIFMxAAK2AAJ27ekw6nUAdMDSKiq3kPDYvRq2l8gI0aPe5xCymDPHEKN2b1kSqDSMncxFajwaJPOGwMTXk+cEHcGIAYBSlAMQui1YIo1DTZoNIyyooAjsqGcz8pAxqKQY1jwIA3d6AJkJ7wpz3h9TRHYMwocfMAAnzDsuVRCXwYKCPP0emV23mkUgtyr3/cC2UvsomMarQryLTgH2h4RErcAlqGPPQxyvBfeNECL0powgKAKYhYyVqQazQulpCRyAegd4IB/2DKBtixkXmGEBKWZok6Y+SuWg9St2gdFyY8GlO1HFmQZdVPwembH0Ekh76FPGqpVInCXw33g6oWU7QLRQdp84LitCxDrC9BgDrSQCBBfAB0DSipD1Aww/tK2CdQ2CBKSLndO43vNpLZBpHk86xVqseWhOoYVXJaOyc7GTSDukOE81KAA/zDZv8AhngX4gkhQgYJj3GFNj4RX4aYwiCiaECZO6wo7dGPAC+Axg0UsA06VDhVDlLAnVLiPdmA0wZBvloaKvCbAMfRfTPKIxzBVpU5IgVRleaR27im0oI0gQ1xfsmB//GIRCCICClwxQrAbJBOnWZ4CxlBVeDlXoM0wv8UjWBIjG+jsW8MYWg+EEACXvCT9QANG25TwWIYwhhD56U6HeQtWzjQGZJ4bMZNxRVMCIJknPiIJLej0Ta4xZAhsG7Kf0Ls+ZCCa7QAKyWgRgkRqBKWQqZ6JZ1gwD9efZoKwVM2BCjDkSXiqoFJxgUe6xG+tHdsxvxVT7LZAsM44NvoMPwxRuDlQw6zsRg39UcKOUwZUAC0aJmQWSmRkZz21OAULSslh4XMxKtygoGxhKAGccQ96E0VBhwzNpoAzAb+UYRcoy0oM36CGIxQCCiUYcZJPZY6urCwvP4jOj4op2nQ4L1ns+UbqpuxQRq0sYV4rLmrO8sTBJFAwbHbIGz/T4nbB5cC08Jk3nni+W3u/Y+8FwRBaDHFBAoiCiq6QHWyqeFPUHCEuzQwlylSFu4EoQIkcOBhBRFRyh8Tvi34Azq32cIbBPGEJwziFOBxefu+nrroSnjsLJHRYXJuAIrfxkn/0Buo1jBm6XS5IKP4RyVGIAKvsoQStgY80A+I5bMYhSCngIJkLr6STQ53jI7Bk9RP83Dfcn7rZzlCBQoOrds+pGz4MrdzV6ivt7HEczlnSAww8Y/O/mPEKIGE+/NSCWb4/R9/f68x3IDjpGM8Dq5Vduxtsucf+IgAFAAK0ABPrM80tmDzWmALKE86oOANCuJRBGFrSuZIIkzJ1i/c/1QiWfJk9tKi/mKA/lZiKw4hSwJnd5YhGILhLBpBE2ytQg7IR0QwL77BG1AAAoQrJzKmMjSplyJCIuxKHS5wISwuNiyQjIrsAfDGjGjoII5sUO4rX3IpBBVCAFMClwhE9mQDGNSlqmIgBhhqJVhjXSIq1VShEVrhHxrACLpOsQyPn7ahDxwvdkTwnf7hAaqD4lCQKqrGVwKkAk5vA3GiMtZGnzBtbo4CKeqLQ2ppIqLJ/X4A/wKkFOAlLdisFdhECDIksRRoY5ijIK5Dwh5kpx4CXw5iPFBAHZCtIDDPNMgJN6Cg4xIrjcjNhv6B5PDIlcIQMThRJbjqGIdIFESxIP+gwRRmoHEKL0BQ4BTwAgI65Ec+gJsqbANTpGOARgXUAdAKwltMwzFs4BZ98Cl+I7FUZcG6ERDxJVnocWxCQ+yUMR9BBbVg4gL+AQcC4B9KMU88Ci84hyiepoGOzBtmjCdISB265wELIpROgwOuLTa8I6gior6E4/BwCCeIDX58QhLRZhtop53CjwT1MSVYYCVNAzA+YRVUohgMohmNYBtkBNM2Zdd6BCJC4kXCpugQgwAO4wNUQJR+y/KoLi9+y93yAgQRpeRuK18WLWyISn2i0jw4aSUDSCXOICUeYQVd8jGWoRFkDSX8URUmQBQmoASK4Bk8bZ5YiSB8AFcYLyL/GAglHkRZQqLwKmMcGUMd2eLHAqS+Sm79zA+62APyEubJxhIlPgslwOExH8MUPiESbE0lcGEO/yELBFLJMlHSQGIItqEC0gAbh2A8fCK3HC13LgxunOgfFOMFzPE0aFPGKmYmzqbR5DEi+Cx9/kGzKHMhNtEgItOq0sJl9HEV4CUzVUIVCgIgA6FeXkIMVOUpmIdkRuYUtuDSHO872umGKJFrCIBzFKAIBgwyjIAim6cd2cORmq5sbCn9GDFRnGs4CwII6u848VMl2sU0QKAgVCEAcOAaLlIlYgR/1GiNXGfclOUUYmw3GSKy3EhsXnHJ/sGxTGTZXiIwkYBDG6yP/yyU9VAi0iwtbMhOj/rzLPhTGYcPLfqOIcThH1QBOlfiMlUBF0rAVPCwKOizlZCCGFUoGKnJN86PqIAGOzUMbtrxymji6vLiCGrTTELDPXVHWuqrlnxCVQxzRQ0iGV3yRQ1CG1oMJWpwrFRC1pZBJhcCMFrBFKBBpIzAv+SoBNeIeZBFe1AgCBDNKO7IQdbp8DAUFp0yL5CgUD/jTllvfoyxjR7kwRj1A1IThySiB72UFMQy1bbkNmjwTFdi/xjC1kwhEgqCCxpkGvHIF7tQL9Bm2v4Bek6hWG4iL81tYtwTWnYRUdGCA8qMSnPkA4CVUcCwJr4uzorEIbbQSxdCB/+UlSrIav/UDCVIlSVwQBVaoRWgUzhRQmwU9EcgS0WC4wPIYxAeANHI5LiuUicf7VhUAES6byXQ4Ah28SxAQ7pgJMIgUSVUUo0sVCdP412bFTL80fdiIxj+0yDci81Y4hP8cVo/MTtU5PxIg4Fs6Pw2BkfQBhs4BAqwUSISgthqqSYeZYU64x+eIQkrNRgVAGBXQvHOojLogCikzCZ+Yy90h9FSoljqZyHYgBMl4DRa9DYiIC2mlUpWwfdI1RQywUYXAgAWAjoRgB6CM20mgo/OQlYfpwz+JEi5ozQhQDO6dCJudUhp5HmMwAGBJToYBkQZAm8aJMg8Q1B/k0QPCyn/xqQ3PwNofHJdtg7uVKL+DIJZFwKsgOf+Ug0woDMzV8FTTYFpcQFOiGtQqaIjuwNYf1Qi0oAM/gF1MMw+b+go4Ogf3iB6EsBbWBYloLT9Wge6mkMQEoI8IowmZETdEHQmgHVsvfTLqiJo16VozcUfAUMmASASIgE6V8G9EGBTgYqxQrcEfer0eAQoDSINrq1jhCNfrdIy5uQfwOABTiIC7uEkoENtTcMo9In1Kg3dYC/BpAU8mkBzYaLo4nIlv6wrO0BwjTN/d8gff7f/UgJxP4EZ0mwG6MHi4mOwVsJHPEY7eYknQvc3Qkk3LbR1uHC6FOIU3MAf+mEA6iEBsEB8/x9DBVYED3Wp3BrlUXE3LworOIfzDA1ixHQXJdbwf6b1LMX0HxKBFMoUMjCAf1FiFV7BOQNxX0EmIlKk61qER/RWj7BDuB5iJp4iG80uJZogepvAH7AgAZoBBobhCpjAHDkvLbZmYizrndYHp5BCGO1rM/CCDdjOb5uVGCJzho/xEzelAQqiV5XvJsyD5AyTKXyA7GaMhASlJ370IGaMDnzABq6AFZxBGnahGa7ABl6gEPl4ITIGPM1OYosJjgXng0yDcFXiht0PXhQWMjKBJa6Bii6kVyPkiWEiNjV20tpnRiBgIQ6jsoKKeY8FCvxBi6lBGhwhKoYB2MyRbYOzY//M+NHYiG6K0uQobH4hw5NVwgBOFyaoWSW84Ms6oDi78jkLgHf/QZyl4wJ8VyWWAC4aIVrzoocLIiAN4hoo6R+0oQ6xwQXGEyc+4CCv8/wctB39tSEEAfoIsGK7Ax8jthD8gRX64YryQRrqIR+awSs4oBZRQkRSczF5KY/OT41hUZpBiz3b7ppZwjPPUAeAoCCM42+3Chn/AUyHyDOPyDTOOTAADwQuAAqg6G3uqwk60E6Rom55mSE82o1EQgFfognuQAMVIAFYISr0oQ35IRQSQADEF2KsRlbHjzJsRKi78SWajoX7YCx19Ta6EtW+OTdYECUydYh8+CU8gDNPI5X/Z4AsFgIaUjmVjUG4esrJ6OAOMEs9XICBKuOm4MNtGBininqjLfUfyKAJoOAFlEBTFqJ3eEEfipkGXuAJHeNA72tst5Q7hKOnMpEmHCsKmjWb8wILiIcqYnQlMOGzYBo3anp3VAYlKAkXcOEaTIWwrBJbKuZpTBBZfoOTOYN26yhRsBM7FQINYKaQwAIs/iEB1GVKrdEx5Qkf8xW7VyIIuit/LHmICFd3BSBwFyIF0potZnuH4JlKiMkYjOEaSuAasgafS84YPRLtlKop2jGW9niQWe+WgvQnIKJGcOUKDKLm/qEf9CY/nJoKGeKJtO2xvC1gVUIOMqKsZeMECkIE/0S5rNQaJQZIH6HBXBogvkvgAQZBXJesUu12TuwJjc9jJSrgHwxYchfUsuCGA66g9lJCwf8hDkSaNCTxWFK2lqmEFF6ODCFj5qYUJlj2wxkivfNCLFctT956h+LbGHxg3F7JN8rP3HZMyqwyzoKgAmapm2ARb5sAAgrBIOquZZ7qqKPoMJklhQTVzgkkrVP6q9btH1R7U7gr5XaYIapKyUNcNkosT9hkXeY5JWzsCBYIOxkCY/N1ZNvIdVO4IIKQQx4EG4LCWJ7F5SCCDo4ABgZgALoCJirDXldizgJ6XSzhFgrCZpR8pVPN7dgOtb87NwhXygsC1RbCC+jiNujYwv8nKAnHE3cNGqSVPQ0KzkWIyj7tttsiwkcszj7a8CVayx6R1XOBR10KQtxX8nusprypXCVmTh8b3ZCcQWVizGzQ1cWzTS+x7R8siEY40GSrVlqw4Rti9UHQgLWj5LbBWl+WBYo3fV2YQARIId3TItBTAhFN4xYJF+ITPSWA3cJPgz443TdRRSUUYj2KJFA0El/uQKYWyD3TaGO+AZ+/wR+GAQZcgz/GJS3K7zwKeVhlncMxHlQ0nCqq42cNgtzZAhg4HC2OvaU53iA8fnMlS+S7LbTx6H240AU0pPWgRY2R4gna3AgQXFzQIhMRkmwGQVtumS0MjQOsBkoZQtgLAt7/mFLtuUviGyMlUu6UA6Q41bvp6eN6HmJjBAGhO0cQ+prJdEJ6SbQyajwv7sCx7MErfsnt98l518UADowDiH4l4B54gMXuV6I6DqDE12XpqeK7VnQXCsKkRKJYzlUljPDI8QW5kPwUFlEltBVvTiEbfUAdCkIf3vwfDj8txu930GDzL//uqUKvOEC1HcODUIK7qEcldAB/qUKsMgLX81Gk8sIYisEsVIKYaPIutvfg7x3cnZ0hBBAN0l74FaVQghWpgYdhkL9ZDS3pGcLo02IuXEEE9L/pAQLav4HGrg08eNDgwTQEPgwkQAChRDESKw50KOjftoEoLHr8+A/bwDQI/yuAtAjx1MAmJ0EaaAkzpsyYW/5teTkzJpp/aFr8A1fRQk6YJ/6JAIloqFKQJf4ZW3oQV0sYgLagEPPhVFaEG2cSEOTQIVSQHQd++3fW65B/DqKMfTvQJ1yL/uYqbYGGw85/BUD2tQs4sESFghESbqECYtiHghrmjNi48FyWki3WHHgZpjq4ez1mlszhX2iQOirHqIxacDFaM7BsqeBioFaHDSPK/uA4te7dA/3h5A0cLpMpEjuMXfHPePDlaRM+/WewAcJiA6n9OwIFxUaKA11EJCDme/iWGZebb/n5/O+D6c8PpPGPBHImb0kgJOb+fEGPxpDxogXDNWg04V1E2P+IVVtYtV1UHkJi2VWORc0NNEZ+Fra0HhqfhbYebyn8g8VBwFxIYk7NZfFRKgMJcMURPgQhUYUXHSTjR0GQBBcXIOHGXYk+ntQeXgMZcFNdCMklmB0HCQVXNz+W2J80/yD5kVtP/hPblVrKtFN7/xiARmdbjinRLmSS2ceZakp0AHsV7TTammTK8o90ct6JZ55nMrmlKXr+CehuoVEZaKGGHoroTBLYUJGRgSGXKHDPKTVApJZeWpEHQ92C6XJSdgpqZb8d4KhHYg5l3z9FxRRiTj+ECmusf54qkwAC/HNrZXxGKpCsvpIoQQtxagnfTKD8imyyhZrj4T8pHKtstNL/qlksakdNi222Q1lpl1y7/sOKRKlqa5E7Y0ljjDHUKcXtQVmSG2iHqX0YH0L11PORpsnaOVQxDUwKUgADmYHEP8xu9k8ZB53yjW3wfqSPUnvJOxPFhe1Ea0uhYNuAVAPhwm9O0lxjjHVOmXySLf8U4wEM/RjBgQr/RPQgiTj5ZPFSv+Wc7bAVDQNSuHaNmOxTk5b8TypInyTwQSqz0sID/6Dw7pD5bcFhal7GREO1MfE85qot5fpWNeRKSd2nKwNcES3U0ELLP7ZQ108CNLwAwT931Pxwshi3ZAhc31rawAxKKSRNA4TJ9N8/qAyUCtAw0MCBEf9UoNJBS5BIaEWdpfc9Fn6QDxQ3Ql6TG/LhhqndEjL/RPPPLAghM80/MCjwQrsDCSFRhMDx/HfGoC8FdL0IFV8ZEMP/k85JKsYOeyzUXRNKPv9UeoRNoiFcxUA68r7U1gedmnOXYKtJtoWfgwRf+i0tvjxcznzEyz/2HGT5Cy9Y1GZOOx9UKqjUBA0B9EiGMFVA4p1OIguM35YWoQTbmUFOwqtImg4lvn/4wD3H2FJAAAAh+QQFDQD/ACxnAAAAagHyAAAI/wD/CRxIsKDBgwgTEvSksCHBXsoG9vr3yKHFhNrMIWnxr4WEKFEMbEFDkAMHCedIafuX6F8tEwgZDfyTsNPFmzhz6tzJs6fPn0CDNsQEtOK/iBSFIhx1wsY/pwqMvCDoj6RAkv44FHH1L8OkDgiBdbKpVKklga4q/RtVtq3bt3DjyjX4aOI/FrXivuDwT4ENfwgBAx7o7wgTSxkEyqTZk1GnHMlgxrVk6VIltWrnat7MuXPnR4iSxrVBg0nHgTaM/DvCwarBrEwWZBhbkDbORJMmmZBkQhfcBeYGahrIVmBxgcs8K1/OPKgJojuVPXrE4m3mf0yc7uVLUHVCNFptkv9NvNOS5H854pIqeGngdcvN48uf7xBRaJ7KTDyCrtQykAytGLFFQ/4MRtVAHMRm02wCdbKATpJIolklFuQ0HH0YZrhcaEjplJddCDEkUF43cUXYTwki5hh5OiXjWUaN/BPcRTNqaOONZRFlH0/nIVSXIjmJ8E8RPfkzUkEtKMDSAp2cRZCTChWA45RUVhmUItX9Aw5BBwiESYc/KQLkQff9YwkjC1zXkw8DUmWDAqb9I56ZVtZp552beSKiT4pMJ1CZFh15kIGDOlWQP0goSVBLeDbq6KNdChSpcn4CStAkAl0y1T/+2HDEP64R1maoBXFXkgIPMnIWYvSt9OirsB7/1OWYDhm1Z30WSWkQWA8SlF1g/vhghA8nEuTDpoJxaqgrDzIqZ0EybeZBctqoMpAoA6miCSX/eBDrt+D2VItRCPFnECMjEJlpsa/584KhB31qUAtMkDIJZYng+6ycuSkX4z9quTqQOReGazBzvxSUsFuYgIhQlgZJguk/KVgFSiQKGUBYC0hwatARpIJXhDmkUCaQk2c2B0qNoPyzTCOgeEAJtwfXrNykQt1K0CN99qTmCKA6ZBUHSJCqkA1FjNOrJQswbWYnjvXFWYUmCiTwPzTbrPWrecFELkViXhTang8C8VSMlKgrdFU2nPMxsVSN2oIR46TFlQgPLqA3Z1l7//vTcVsH3tPCPT2C1J4VVYezQtBJsndH5ihQCROmKgQYSS8UMZVrR3BUbOVotGADE5Uo2UklC6y3meoHZS3463ciAprDX+dkGTAcEDHCAsvAaxFWLwBBksb/+PDAgSAP1GZWo//DbNWXrNdrWf/Cbn18XS6eEzjoCARmTwpoQ2Q5Z6ntkIFo+FOEvAgiyT76V9nQiav1DnTJ9Aq159PV3eIE+PUADMrXHoEOZfQCNP+w1E5a4oqRpcAcUGoTTvyhAJJYhVgStIHRCAWqF9BAG9oghSvWYxkTVWJGQJvRJfSXkBlVDWsC8YAosBVDbJniHzcMoA6bMxG70Ko8AiEFMP8SswALcIeDCpGgu0zFmo7wpQWe+51T3BEcEXLFFVhEXa/41xBXWGI9rCsIDWdIwx2acS5A+p5AKgIOh+kkBQIBhSsU4I4XIDEnHGBf0K7iHZxwwAiucIcq1KSNEwKsIGFsSMv+USGB0KOMNRQIJM9IySvVbiCIyNKWekKKCWALGO7wnU4uZyB3/eMFxHrBpixyOdSMbBmmMFGNBPIKFv6DFIkUiAgq0R4LvBAh3ppkJYcJlFog4oe68JMZgiKCSbjCKWiQYEI4IM2EDAgN2nlNQwzQSlBhkwk0cIcHSGGBW/wDBulwD1os0kiBVI+Y8HSLfhLyQ5+YqAgW/F0eWwD/N4V0DjUvOEJAq0mgjRWBCbYQSD4GUpkFRK89pCCGQrjSMmHG86I/MUMvuncQAhplTNVhAcQschnNKe8m/uBIFE/6jy04pWMqUMc3BHKE1PTTIZXLCgmuMBBo0AwU8CnYQdYzy7KYQht+w+gwCTcQNQqEBdpLCDD+QQzKZIcDTrkjQVzDgRZ8qgh9LEjHbKAOgRCAAP84RQVaehM0nINUHKBBAv4xgROI0H43mRG2XJeQHCr1rzepiHTW+NScJKYTRZhbQS/HgXOoQwUKUEEX/oEEp2TwKf8YAloFIggCbOMNx2NrEvdYEDTQAAQWwKWaLrJawLrWLcpQRMJYQB3D/7rEbGEdVEGMcIohCOIDm/0GEvhCEu4oqiDb+IcLVnO+oB3LIGiIU2txUglv+fW1sCNoUGSnM4KEhlaXbEgiMpABGxQNMMRLyBGIVQYxDOSsBJipOrijGnWcQhAGOQVa8Rva5iLBB2wSCGAS5EtKVLchw1FLO7EWTA9cF7sQLkh4BRK2qFpkiAVI2Ka0u1WCdPYDpxDINgjwjL5wYCod66xBkjvib6CgDzlRxzYe0M8XWKCoCCGF/mzZPyz0L8JAzgmQ6nkRm2CisiThcEH6+w33mvUfH/jHNlAQU3VUQMVPfnIZ9IuNEDukTT5QgVorgIIHhLYEE5hlN4QkpH+0DP+oQuVKhTzgLRwE+c4DmZhFJtIjhEwiMdkoAl8MpF3A3BS/wI2yGL6R3GcI4hTY+AcBgJvWKCdXygI5xUwj3dx/POAUIyYAClCgDq8ywc7VazMLSXEZgqiFZh7IAp5n3RBaSccEyQBLQxCRGMvuZNJQ/ocY9Atl39530vfFBn4FwWmCEEAQFUjDTbagAAJ4+QNPaMIditexC3xCEzoQiCZc0TIPUI0gjXAwrdfdkAGeB3/4S4YniiaQ9CZkucslSHKjHGXO+jbYURYEowWCDUX/A7/PLsNNG6KabUT5rJIuA02PgITTVYIYpXBIUtnNcYTApLt4zddXapHVa3pMwAT/+XRB0Crxgw8ExGnl7MHve1+DeLYMMxUtTlWg2URDeRv4JcgLOoGAf4hiAhsHSkI7DliHgYgscnrQJc4yCYZsLmhoWK9BtvCANHhZxATwBrD7fQpvCILZ2w64lBk99rMPRBBN6K9DbKCCO0wa2P+gw8E7O+pjlQCGGtIEjpl+kMpRqRcmcFgOJEGb1A2EUYkAxiSA0YLBcKAKXChBIPYAt/T5IOcDmfJ7JY3wmT/6FCH+9z8ijVYCPOHr/3CxyRsSZv0C+8NZdsEbfMCEBoCCEovUiawNEgDCAxnerFvAJBIxDs85QAhc4EI4ylGCKoCqQN5ogguG4PIQt57fBTn7/zfS8A1lD/zRiaZ06P/hDVb+Awo5r4C11fps8P9jrdAQhesGf5NZ/AMVO/EKxgcrOaBABjEJa2ACxABvvSICiZAIleAUR8AFQrAH0scFAjEHg/YAKLANXuZifbAN35BzWNZ694UCp8CB3PcN1nZWUYYCLkBsmfYPEHARRwAF0laDAuECo9aB//AEl+YKrWABfLUBFyEKqGBOBFF8AmFnP8FXA2gjJgByBcEQevZ4AjECiTAClhAO2AR9mPcP5SAO5VAOp9RS3wBc24AN8SVpmsZo/UZwd8B9yqUCaZAGZZBcv3V22CBtb+ACI+ZeyeUNRlN4RgBjxuJpBHBlvmI1PP+RBUlXEP6XV1G4Q0ICNGaCATZgA0LgDmYoEOUwB/9wAf9gBv6gDm9QBtYGbX1AAHmIX5dGECjgDbb3AWuFDSPoZacgBoLgAoGgBtrWekF3EVBwEBDQBz6QBlemdwSRVBNAV/+3E5NYiZuxUhoCclDCNFL3D5EABP5QAuEQjgIRDhdQBeFQBTzgAMECAV73ASgQBBzocr8Vi9tQBt6wBU3wW9jggf/ggwOhXx8QZUkABmSAVl3WfhaxSjR4KO2HX2JgBAigbgMha06oEKJghM9IjYB1KzLxIMwidamDC2iwDHMQDnMQfSHwD5kXDkrgBgVSFW9wcCgAAd+AegTxgf//8AYD4o8fMAgCEZP/UGIE0QT/kARvQAYoIGnMhVMPsAMCgYhPuQWfZ5ADgQN1NhBYEAA4YIQNUZEaCU+e4CICkR4SsgCMYgmm8SDQUAScWAUhUAF/0AWnYAZd0AVCwAFVwU1jEATegAL4VQH8uH5QFm0wlm+Stg3RNhBssGUfIAhPkAZgkARJ0ARkoHdrdRF95JQMGXP/UHSiIAD/oIQDIZoD0QhZwFcWZSFf6ROGpxwMUQsFICHRgol6swArsUgtEAhv6QIuEJBi8ATQViCtcQRdYAYKcAQP4A3F+HXKJmJsYJgoMGnDeAptsgXeEGls0I90oAZJoAY/OJNdEFpK/7YacocQBAAFbuMBADiRdkaaBqEKtwAIRodubQGaAjF8X2l41pgT5mIRmEJenpADROEJiPAHa3AAB8AIk4AmNtE0AkEC1mALU7ENDkAAv/kEaYUCPuAuS1AFJZB5ISAEAnJWoIdoUbZcBDAEBfds95WGC0kVHBAFD9AHZJB3STAQNTieAmF4UIkQt4AD60kQPoYt3hKJxVeRjfBgivQPmvA/jTBJQbqarckTxvQItUCFCHEWUyUJejICErCjDMAIXiQQwbACXJkODeAPz4YCH1AGZ0eUW3AEIyAEczAH5cAFIcAFexAIPgCYrfcEQzBll3kHQeBs6kcAaaBHBZEHEf9wBTkYBA6nAlPKLjugg5opEDrYF8aQmgiRkQThhEqqE8EAeKupEMVlEBaGEMb0D0CSHjhhE4znpf/ATSNQBSNAFnD0D6zwD7QwCxfQAuowjFPGGlxQp+Z4rOFAhlXwKQjXmPeXBkYiEGMgbcDFbPiVb/dwBRrADxGQB/dwDzJwBTeQADLgD30AjygKewjxAseTqQXxBBpqDMMXpQXBlQNhCmXEqRYhCpWgCaNaEBuHnwShr0xXiBdxpYhAIkUmIYwxAmsAKgZgAC1wAERnARZgr7KACt7yAsXYerP4AkrABQgQDgMhBHQqBOd4BB14Xy4gf1uwBRBQg4X6Xnd3cKL/tgX1oAD1EAEUMBAykAA3YK4CUaNpZZMnVyo4WoOX+g/SJgjqcA2owIQIgQoC6xMXIlQIYa8CcQtVW6p80Rp7lKoHoQwK+6r/sAAmMKrE4w9/gASqNAMCIQv/MImuEA42AAVe1rJbEAJdUAFsUJdcUAVuoEp/gABV8ACbtQ2DAAEFYgAQMLMCcQdPAF+t148PAARTQARD8g8aoAFXcA//QKN9QAdPkG+Ptg2DQWgCkRovWm8C0X4PQA//kAXTaBAAyIQPZgqhmhCuwC2N8KR0VUazxLUBQEPuCbBS+gJMJRAsUhbRQhgSsAZH8AdCIIYc0AD/sAsNsAEWIIDloAAQ/7BWUXaHoNaY27AHUfCS/lAFc7AHRnBliClteXADeVAg/+AA/7CXz8Z9p4ChgkAHLmA2dvAOevACc/Upj7sDd8AHpIuhRfsNWvUuMNajnhZiSNAAXXsQCQWkPtEelABJW6mkqECvpVoSO9oaYJsTTtUQy4QptdA7e7EAQlACJ7kEgZsDJHENmdISC7AELVBzBNB1KCAGv1kBfaC+IVoO4TlT9egPc/UPV3AFhRC6mBp7ShllT+CO8qIHu9q4ZHCMRPkPlPlkbUrFB4EEiNgHL/u6AlEE/XARS0cQu6tx/yCABcE/SFjCpqoQBIoTMFELOQAlAyETZ5EMljAJS7CJxf/6oQJhfQTBEapjCTYhDhzbgWUmcCiYBunTAm4gUBQnBCrgAHn4Bv5wD3OlAREgAxSQB6FbqHQAcS7ngbYIYwUCs0HwxWRABmGcBCOGadb2DVAAAdW0QcUjEP1Vu/KJEP7nlTyhtdcCQ3NcwqEyqdCBQAihDP35D1f4JAQBNRwAfQZBsir5D25gAwBwKU+Bt5L2aacAYEewBCYZolxQDkbgVR0YhzwlEDcAxeoAGEEQBGgFakGXaE1ALGSwbdvwyqJGtEnwAfsYaQW3DdL2AIrKrgSVmAMhAMWABceQzPt6n9Q1tWjzL1hAtXr8yO0jEBzBAQsDHZjAUQQBEyZggAb/4SwOos2NLBAYWBDl0AN7sATVKwJTRxDLJGWnkAYq4A9mkHkIcJIXGA48AL5DLBDvEgdXIAMaIBAJcDwDQgYOHGzOtlZpMGpi7GT/W5QrOxBDMFNHLSxtYgBo0DEEUYwoJxANIAC9Krfy4Ve2IAokcNIIYRL0VBCTkB6umhNkYRPn4B2BWxDQt17+4AAKkA2X8IBL0glHEGJp8AAtQA9zUI5mGI7lgHnhsAR9AIOhWwSh8ALzWxAaQywBWRDu9QHbEIs7mHMBCQZ5R9uVi3oO8AAqgAbHMxhHcASD4XUG4RRxjCHMDNgO0SWYgAhEkc3pUbYKsQDAwACKIRBL8A/g/6jTjhwOQrAEwv3PEDA6leAkeaOyouYPrgB94ix9nx24c2AGEJCUTbAF/gAE80AkoOtp7FfFrVd+sYdWAYeCXXeT/6DbTdB+aBWoPiBqNKYkgxY0HEAsKjDFrjEgAmAMuyoQtlC7ywENc/sPzkwLzl0uJLIn2TAiWIrTBQElaOIJ53CS/xACbikQJUATMwsBY8AByyDjU3UEXbYagTCCMvYNdlcBeFoO4YAEwO1ZJrECvsAExFKDY/AB3xBayeVZaDVw9idqPiBwIwZi2+aXJdZZxJZcUHCKSCJTa7VwAnEOA+Bj/7B09pkT7+QTJJziCVERiKBrOP0cDvG8AyEhAv/xIKOqACiLgaKoklzgD9tGtOd9tvhTCUeAX+Qbg2JgoWXwAc/wDTttBM9AADr5D6FQ5aG7DUEgCHdQAYibXA8Qxl0OZZ8OZRYKg+Gr5o6Zkx/gWxL3aLD+ADHpD31EEkegKHeEBtLQAAml0bJwDBdhLf9A7QPxCTuBLSJuECgeZJOKJBYRGjlAIuRh3ap6EFDXCSPwAjvtDuP4D4GwBUEAAZoJAUdwDqUADFxIEDbwDBrKgp7ldkD3BN9gfXPwKTd7YuZaqBBAAHY3BGlQg2oMgyA2YliGb8o1kwg5lChKaWWnAsdTAcbNPumTWwZxXHf+Dx7dEEcF0kYntTqRQ83/HbfdznGGd6oWYROYohtQlxCIsM2JYBPREvScqNPTFw4lkHMxq4MQgO8iIMgCgQLYAAGqqGKu51tPsNQm6QOngALS1gePewc1mJSTFm3eEGX+8GiSZmzDKGK47gN8cDxo1QQV4AJWb9TewAUPwAEqwCZHIEFoYD4GwQEwkFC2IAB9jhA4YC0TkAWoIApKSLAK4cwEYU41P4DfbhDpAR1VFxSTsAAtIH2kKBAXsNNVjKluEAydkAj48w+DEPFsimmkNwSvHg5csFZqJe9B8Kg/mHdGwAVs0PBotQVJKY8G8QHbthoVwAf/oAKehaGUBlz6tQ39hARI5FWzihAKMAM+/2bnOGEKFdWEkejnB7GfKZ0TtZDNiP14I/AmJVAORdeZIUCj/9Dj824Eo+AsBQEBYmezEDcEADFkW4V/JcykqZCGTJAgEIKQQUHg35NvKhK88CaxyRYCBD78e/bho6B/JQV9vPPPhxAh3r5JJLmtyaBTQR48cFnBB4eS//z9Q1PyyJF/W3oetXHtny1aAo4+hfoPlah/pv55CBDg3wZUUb1+BRtW7FiyZc2eRQu1RVq2PSdZ6rQgQ6JzRkKE+8eloBkIff8F6QnBxihgrp4e0ZnmVE8CYgh4O5VG7xwfFVxA+LfwjiAU/wR9NiMk0D9vglyg6IuCJEmorO8QCIJESP8JN9+GuCBQ4QEEH/++Vego6BQaoyV/Gn8QNKo/GiX+sSomS5ZZU11NVXfaVvt27t29m+V5tpZ2ScD+ESs5aUmLJVxC/At3oYoPMpj//oVgpNQCBlCx9VFhm8X+ceyfU1yo4r0KjEDBhQ+28Ua1Uz6YUKJAnFvrHwJO26IJb54B645t/injBeeEMMOFyLa4qYJTOjtlkJJUAGqLnYpqAQ00bPiqiKVY+acZac5SJQAcPMDqH1q+Y7JJJ5+Esi1G/umkk0lMyGEBIyoLoZxwEKgiin92GDMKMiF4YRnzFqDynyK82U011kqi0Jsq8HLDAc9OeeIUAVkjwI0q/uGhCx//CLjjDhdcEGSzbUiS6B8X/nlw0n9SCsIMHi7go4st+vBGN2+w+UcFKLzp7Z8HemO1qCM4eOGIoFLtiQNjisFiumHCcgYHq/6h5FdtGqGkpFmiHMsWZJdltlmxMphkEkw6OeIZFUrgIpwSoqivJDIyy68SSxK5JJF/RiENgghH3KYj3wQhqJw9ILDMs562+UamD7ooiYd/xpg0JUs13GZESkvyqCRLHziihNlaeAAbglR6YdV/oFBBHXWgoLWnFog6wgd/tjjOOBoaeO6fY6Ay5qlZVJkABxA++eQfmlvJopUAoHG2Z59//tmTkjr5J5EX/tlmjyrKCcGNPo4CDAIJ/7QBRoSSEumkBSj6IGBEMZDuWpBvShLiBXUEeeYZksZubE7n/uEAU8Ak7Smlp8hI9KhvzfhHnDmUAOmfFjhowZ+RfXhAHYKMMKrxkow4wh8jvHqhAaemE2uWAFShyqpXKInkn1Zw4Jm7Y4FGPXWgJzkqWkZyiOKUbxDQ1tDM7vsHgiMqSYGYUhIJxoYHLibgFDEG/ACFlGp/YEBsQOxIogbZ2LRQCO4YIw09/zLYKzJcsLukO1rogYcSMiDKp5KUQw6KQtI/qgUkXmhh8pKN4gCLIZtZOSxktMJBK1QBAqtcAAcX2NmStmOLlqnOgQ+M0pT+4YlOzIURh4JCFWgXgv8tzO0+QfABYS4xgn8oIFYQSINqxoYwu4WDCz5AAYhW8wECCEIiYkiDcxDwjxfMDTAe3F5UwjeG+AmhEzYQAlBK9pSRIaY4PfHHUF7wAvYdRQFCYIWyvMIKfRylGAA0BQ7+IcbRaWU7mINgGtXIHaI9JQPaOEK7vsEFOo4hCGT6VkMecAkRkKIUlWACxHpDQwOVZBvqyJYQjuCGCqhNOMKhoQ3/MSgECKEcl4JAEEuiyaOkAXd6IuI/xISGL/wDAT3gEVj84QMoUM4IL1hiUFpwjVmsTBasGIYzSuKMZsDCHo74BzJowQoSGEMpDUBZMf4xi64sZTvIWGM0pYmWNnb/whIjQAIEGrSEPcyBb2NyCATIsIUFVGIUligH/RAnu4/0JFR5sV1pPuMNSZHqYCGYwwUUKSbuNGR9QogEAnjwh7HYCIpAEQoSlqMAlAngcj1Bxq6Q0YznDAAG1zjBCpiwUSehcZofhaAEMeEVcx1FBAu4hAKO0AQCzHEOC+jDt0rCkCN0YhWXeAUTggIBKGDDXSVRxx8CEY5A+KMJnxnRZ57hJ0oJgkZfYQgROXkUPW3PPj1BAw92eIF/wK8oX33iE6Fov/khFCn9KEYxeKnLkjSjGbyIaD4SwIpAwu0FRThBdsbYHbaKxaMgBWyTTOAJE/QCKqx7ygIWYIlltKAj/9u4UwiIgpkgRAGECgiGK+bQglg9YGIxKQkXllASCJQBaUchyYT+IZmezOEfDrDPVWd6FqMcQQhbFQIXjvPEJS7nBb1BAxKqaBwFXIMV0PxHKCjqjFAMQ64DUOg/bGADLACuH8ciAdCAFFjuMmkNJfkmWFCKUk3YADdpuIsQ3IAZmdrkAKaYgw2OAIUtuMhPpu1CBfjAhR5A4Qhv6Mk3IgKjpBLkbT1ww2QvlTuvEFGmR7kDZo7jBsAV5AI8mBxafJC+I2T4KUcbZknqsYiS5OMK11CAcnQEKyRg4R9ZyAoqAqDMnm23uzdmYw7E4ooFuMISuEDCAx60Dfew4WkQIP9T7hpGPyj4QwXfGFtn8LUENyyBb7uBwKpuAtuCuQhBc9BLSUrAAU8W5XpHuaoHoXaUn5TDtXopgRJKMDIOFEesXimZP4Y3XDf9QxrIgKZSYJAP4X4FDT7CQVdOB6X+HaWvOIb0WYjWCQmGxQKg2IAxTuCDJjCKC2CybF/Y6wMjGOEUW8DGKWy4jVWV2gjuEMopQuUNWkMhDWkgyk0ewJJ/XAAXO1QAB+6nZrCouTdPxMU/chCrFtwoKL1lok9eEB6zMnEcR9nVOZTzk976Q0cvgEHLGgil/fWEF5FG91lKWkKxiMKh6cDBC07xmPbQpg93pGxJHtCHeQviCSRxAx3/HQY5DsypzEMoHgq+oY40GIFHSxDCBd4GS6+k4YdC7EllSuivguQACbB+qlm2YIMMjeVoYlmiP1L5Dxo7SZjphnlbFCAWSkxAc+yARgM4UIGXdCEQXKjC03rCkBYJomAUac8csLWTdaqgzNgos4sI0soEcMEMTBCzcUpy56ET+6BC+EkCJL7DJJZkCdAOiw9ipT6o/ITPYfHHx5ToD6z/wxi0kMZfvTMkvsZcOydn0sx7YgmyoAIaAbgFLl7wGY+8wT0KFmUQdk5Pz3hDBeEIRwiCHTLEkaY3afABNhbeVaDuAQFvu4Br54AAJbx9zQu+wxORoBdtmBLOPbEk2sFC/zjAh4XrYNmwEjlAg2vo1e/HL0vJe18WUvyDhD1JxGKhwqZ/TGACrWBHK+AxixlghABl8Ea91+tPwDwgDWvrAheUnk7S3gQKY6sAqgqhDllBcQv6fIpzMICANmTIB1WgOKh4GrFqgyhSgh3qiQtAAH0KB91bDpWQOwdsC1l5AGE7gQaYhZZDvg1ElpmLCwlaN6+4BdERnVa4hQYwgjTwiA/4BhcagQ7CD1GCADZYgioQgjkIByNAg0zyAcSBABo5BfrSja17CsFzrZJQQARYPSU4uSXogRLoAmqDiqAIB765QSWUOEKZDSNCC1jxAdfjDllRKB6hBVrQQA5EQ6iQgP+SkEKzWIBOWIIFIAXqg4rsKgnru754QIUZEB4UkKM9KIcSOAJ/2o0g2AIn5II/MAIOOAKA6YsgGIPhGRU0UIeOQaigMIMZEKieUIIkmgMMCB2C6qoksqTeMBwDOAo0UAJ/oMN/wIUL8JHp4hFa2QmScUAfaDY0kMAJlJ8i8AcX87M0FEYnIRpLYBNSCMGo0AQnuMMJaIAgGwIaoqdw8KZwyh0IGAMO2BIfiILh8Qts9AFP8oYoGh6zqiK+QYA4zIBzsIEisAF32ColaMCSmLk4Gy2ogLgMUAKu+gfXWoZ/cAcucJgMIQ4baTae2AJhW5+S4KxlKYIWYAW6+4fsaLT/YUS+tVjD7eixfzAMsAiF5CqJMOgJKmiFo3GMriGAbHkh0nIIm0CcIEiDLHtEPXoAjmGRp9gCkimKoIguBSgHBfyHBDClf8AALuABIdCGtXgBrTpKntitAzxA53hFhymJC9Gt35uV4PvCnui939uOKQIEJlg5iyTL7uhIs2gEJ7A+SvCHl2iXB1kae5sbUaus3NmB2Aon0EODHnS79RkZg/KwoygBBVCAEtghw6RKBFAoVVA6PlCon7DBfwCA1TPMKZIuJKAHJVAHstgCgoSbZUEDopiBFkCGVCjLGyu5ZamEkljNr3iHOvwHtcwEGnAygvGIbDEGNvCBhxgTcPmW/4VoCCLSI+A6tmcrCg7YsBbozH10GCV4PgUIs5KYgaFMIh7ggmvIJyUYLVUMKMOczrdBAC1pAbljs55AxajIkPsZi11cn1RJHyAQgGsYBr0bC0BQoAE4TQ4UvLEgAeOrg3/QBAwoglaSCI/wBjYQrRIojnwriST7C8tqiLlpQ2FrAb4RSADUCyFYgqO5x6NAgHLYoU3RBq5SwBJQhR54AU0xJQQsCQQUgk7Uix70gS0ICjB8tvoDC+NEg4/xtrE4guJAAvnEOuM7izgIsS46C1mIA/rMT+6ah6OYgpIgh0uwASioEDH4hjuoAC4oB6sLx9sBi/oAPaxiQ38wgwvJi/9/CITiMiEjwIWy8woFOAcFWABjEKgSyIRIwDCIO8KoWMB/iC5vCz6dRE8bCM2FDIsjmC7DKYv0OYdrsAVq+IdbQgs04iXtYNImBSkSeNKt+IduIAdiWIYXgIInoBSUTMml4QLk/JarwowdsCz8YAgIGJmi8LagGI0qiE6SY4ISmIHovL1/oL4lcI7vzJJ8SiIkwIMs9ArXOpoqKo42RMX6g1avKDkoUAeGIdSvYMSSeAFaQAYg0SuKoiixiAOJ4g5WyFRNZRLDaM2zUBY7PAonqIEfSAQbiBAaKp4H6aYvWYIH8KGoUbNwugNTbJwt2INswbwdcjgkWALA4YJkwwD/QjmKDuWqEjiHfyiHJIqEUjKDEphYsBi3KVSVg5Kcz4QKKUSDF5g5gkCBFzmFFVkfrgsun4DIIaGF6YiFs2CrXXmKRxuLcmXXsMhI7ViCjiyW7WiFbsCHDkiEA4ACQjpVBAmHcoC43cidWJ2tBsWPB8hJVTnEu/iHPXgPDrDQsUTADg0truIBJLCBcuCBfFLCJFKAGeDHrwhMr+gYtUs7oDgCBRiR4iGDkRAwAqCVbiO9f8CCXVCSkgC0IDGLOEgZBdKlYXCrof2Z6IoKV9AETTAjsriFTv2KAmCEc0iDJtDXxyKIquWCzYSKi/OWlpTEKJANvOCCJHoB19KGHHwb/2NQgnQqghLwF8pUgqSEuOksCQx4m7P0ikyA0wc0Tlmxn7DgCQWYN1M9ConYhpf1Wtd7TBr4h2l4q3/YWV7w2aOYDqCFiokqCU6wh3+oh6OQV6/4SLIgUsw1i9J5Cqe4X6nwgqeYgKOQBDd4gBr6iLf8hrtwofnwCiTrTVH7i1XRiyoogUH5hx4oCQo2Ar04wOnSi8MMB16zgaMcSiTkgZkTSARQ4agoAbWlXukqnK/CMx9RB+x9ColYDFWLit5AAgHQxZ6AhWboIhL7CqGNiiGuByT9BySVXBoA36fIB/RdNPwNi947gLZIq5SZ4rBAD0RgBMSwIZiYNxzEwRd6sP+jSDK8BFgfWII5qAIeUIajcQ4uLYk/6IQr+IMXuKSewINyUAJxaIFRUAIuMIYjtFOq/Icu4AFoYNGn4IHlM88PUx8w7InJ+QY6iIqE0RCT8AobaAAeoQEYOApg8gr19QolpuLuaoSSgIbSaQVX/gpgAAYGmARoWbyDoZRn+IZBWeDeYNDenKmG2E1sNJGecANsyQtdvZMq6AQjUAYECDOl2wMlMKEeSCLDRICACigU4UQVVmF+hOYS2OOxEJnxLAlLhCKzgRSoSJgwxuWSgRWULQn8zIdmMM33fRIjBouKBIsnPr7UbJZGmABV4BljwIVkG4tJAIZJWIAXiJ6OcIz/OerSEI4Cv4iK4OxaNp6DcmAPjR2UKnCtjT27ZdBOvEAAXOACwLEBiC2B1TvpgvgbnzAcH5DH/duhXyuJx0Q5oJjer9otNvyHVDsKdjEeAngCbOgeA3milRWcifzFfzAEfIYSTigJVI4KG9MiEfyHEUzl74CGVkBosWCdApiETsCIe/kGo9OgpQmEUIIKCPaBmVwJbeAAOiqJ95hgKPQHDpC4KtCGEkg9JtRHOPu0by6BcFCCBxiZcfKHKlhWbE69f8ADgP4KtcNFsVAAe3qKj6ChdjoYU10hroSbYZAGDhA8Q4gSqj4LQOjq1AnrrzCXDiCGSUiEFlCRTRaOVsIn/21xg6GDim7JjCDogz0Qh6V0IbyANRda0732h4ddgksSXg6IhH08PaVDgP3bP+s0nIcQA8PRqpc2JR7AC7gT7a84DiSI2SF4itWoIaPmk5JQtfiDtmuAgRfAAlGOklz4h1zghyfh59Z2kgJ4CmCAlgwgBvRRDYJBGnrSCxcyA6OQrZmirIbIMgAUgmWWF72gIzegFSOgZjYZs0oq0W++gCSsJEbtCTKIIj5IvR1yLRc27yNotrCwAXuKFEM6mM6OFJFY5ycKwH8YB/CtB6nWjig+4kUg8rDYFTECcAcygX8oa7eAlrI+ggRvFwMJP2qkxgrQ2jUDjDEIJzdQBiFwIf+mwQttKQEeeaIjUIIFWAIeaIEZuK3Ta/EZ4IGjxIPW8wc4OGOfuF3D7DUe8CrgWzuxQJ4CLYmvIQnHYI0noJCvyFgYoIFhGID6BQto0gdOsOqjqAdSPor3TXKxUKAmT51kqOV/yID0MIFJKIBEQIQjUG8NsSGR6AIEWAYvYWCo4CcKx0YjuPAwwzyBnBwOqNH1CQQ2sYESAOw5IHM8OMolMNRb9Yc+z4w+8IeorO6ymKI2fIq1UIGl4mxT9YgC5ewc12RZ6wlDxTpMFwskfgp7iN+SQO13NwR9mHeyMGVSBxprYp1JkATEIoYRwIQH6Azc9ogtvYAvqYJBfF2/iCr/21qa4w7EORC2H9Y6f9AGVWgB85G4Qm6D+TCcT+mDkf8ab+kJMom7NrgADNBQtlMlNQcLhcLe9p4TQJGk9n6Kbaii7Lr3sYhifTiEr8iFePeKTRiLfNd3Z7EEYGCTWgYGEyiAArAESSCFA0iDr2EXSvkAM9CLctBVX74qWKUsh9iCO8G8SWKactARPJNTdwDsEsCBOeABYfsJMiCDHbB7am9QCeuEfVSCGBYLGR/0p+CRlwjqTSYQhNEQzy53k+gIWmkBadgEezDyqCB6sLiHp/B0slDtkhiGY/jcpFedTkgG9OiJAgCGETgCkpDadtkGFUjYcgjEsUxx0moIIzhC/y+hI71Y++UwAkuwhLdXdsOBgK8hEwc1eW/ZATgwDn+oJCHgdt96lZ/guhdgAnVoDNWKiRUqEETviUXviVAyAgW4AXsYgHpwX6om4qPo769whCRH7c73CnQV/Y9ChKfwBNpOBB8YAtZHmEcBiG1cwpULhwAJhCAQ/jFkGOSfQghG5oQL9+9CuDkt0DTs6LGLJSEIEPDw5+8fnB0NVe5o2bGly4YclHDxaFPmPw4cb/4z8u/Utn+CCPwj8KGj0X8fktrcRuAUlIYJ9C3Sx6kep39ZPfL7V48nQ0M29YFtaGhY2HxZ0ZZt6/Yt3Lhy59Kte7ODpE6lJj1AEbQhAaPfuP8MxMiFA0MyNiGQ8aGC4r+BJbqc5FnZxxIFPC4oaeFvC5kdoVX+g6lYMemW/nxUVlfz7YuGW27GLhqYIVGiuIsWHXrzFENvHBX8WzQgl9V/m76aZXiPp9h/hsQe+nfoedzqDevFsuv9O/jwcz2J/weMWA4IKFwoxT00zR6LVcpxORJl4UOHpSFE6XLhn0UZ+VTWSRwY4cocQixgUkujMQQThKWVptoWszFkIVgn+VObZf+o8FNvgiy1lG7tHXVURycG9g1HSCRQTy7MkWUPT2TFFV1Z92R1iI3l+fjjjxIASRcwHZRyDhSn+MaQGIFtU8UcF1VRghk+5JcfRAn5UAX/AgUNtIcPb6HRwjIl8MDBZ6KZxhKEobmpGmJzccDhTWF29AQBTRYlRkN8MinUP3wKEqhSdJxyxD/EdcQJW3Hds8k/kHgn6ZAdheJRd5Vquul3kwBTihHsDZoiAS4sASBB4VSxxRgL/eNqFK9uGWVFIbixE4E6LaGEAib1ASGwwQL7GYZwvRBnWSUqldSJYDV74gcfVBAmccw50laPXDlCo3V1HcIcpx05E+6mQtZVSycmiCdJIh2I88Ip8SpLgCDbsBElgOWU4MZLir0ahA81hZBRF/y65Q8aHFRxhWf+vOkSsKipCZM/aFZmLEMGgEUvbyTmNiqgDI3K8aBDNVkB/6KsJHBIV45cCx52c31VD47l1dxQKsiQa5e5m0riUSduTcKQLv94MkkHIozgQxc8EZAGG6+pWoICVr7aEARZP8BQRhWUYWdbCP+zRwkmgTahsGmrVukgKHb0zYiBYjPUiEwNOrcgg97xzxH+xDGAPZACvgi3ddHM3M1u6bwz40OyAJ4lGTTkKVw/F/DP5QWIIIICEFSwDXB9CvXBN1EWRBACW6TRUKsKpXEEAuEQtsQ2YBN4EhdLmBSo2r3DwRAaF9tV7D90PGFTbn6eomeeupWsZFFlbKMOGvkk0NEi+VT1FlqF29SVXOA2Pj75QF5O3j8iAFOJDd4QMPcpzQoyhP8LQQ00sOxH+LCDq1kHEcURBiI7N3wDLmLjwgvStKbRtAQCO/iVsGYjwR8xpSHYaJbImMWUIZSMgx47AhoG8A99UOUf+dDHy8pyDFrYZGb/eJT3bJKL8tGwhj8qQAE6cLmhJSIYLyjZP75xm5IR4C8MkYwZFjKGf5CBMQpxQwnEMYcKVGB1bynQqf4BGjKE5lcQfGCDWgLB0BCiQlvYnVtwtbEM0o1jgQGZR4hyityEDhty9IghyFKPFDYkWwxpRjFsUrOY2VA7NjzkDf/RgX8gbQEZQAMKhiIIuP3DKUVxgQuqILWBLKEP/4gCaRKyhT1wgT5d+IarDuaPFiDqHw7/E6MVrEAHMvxKClaQAi7pQIc+/KqLn5FLCyxjhA+ZSDcoKpGyVBTHD2BDKccrgyfhEkNksJAhfkQkNuNygGy2ZRJIA8YkEgGMUbTACIMIDDpLNBT21ER2sltCFIKgEsUoZAwl8BIUphUXf4DQJD4YDRikQIhbSqEltoRDLOkABpg84YzEKwuyGqIxhqigSUS0aKDQ+Y8h6KlPukGm8xgCBTCkMiw3MUQ9FhEzWzDkWx1JHDdjKlO4MAIYlwPGJSzhDzMAxyhF1E1QxOCCCkSJC1WQ3R980L+EQKALCIhMF9RxCtu5ZSO+oiUYYmkFFMRSCimxpVbhgMsdhCAQqxEe/09mQ1XiHSU3bmuWUwa1FMAsxYhBiSs26NARqnokH4ewh1iiw9JA1uMrW5lpRxwxLsQy1iM/YAgwRBCJIqBBBUNwI28GJYZTOGAJhHGnEI7QIIgEYQxbiBJFvgGUuYTJJA4kBFiT0AStHlSgAx2oLcPRBjf4wwBo5QlfGRIbZfGmiB4FIsiAuJt0DsUb/3gCNpoAF0r9kSHbaqxdYIHdGlpCkaT4xzJa8IAhPKOjdzWmIFxTBVRVAQ0+OEILeDkGzHBhGSFYggtQYMW4nNFXO/CqFeAQDjw8Qau3tMJAAwwHhAqhDVUwyW/rxBMbNGSuy7KJ2xiS4Uoa1ymWfK5z///BnrfwMTnbPTH5gjaX76bgH6WILDAiMQ4OeENJPaVjk5pURC4MrBxCsIEESumOKqijC4EoZTmq4IB47fdgDfGVWLUagi+II8G2vC1tm/CFL5wJwm+JKPB4IzLcdJRJeQIUn7ZRQNzchs1nZkgZnuAnm1TnEC+DFENusIhrDQAtzUCxW7qTKUC7pRYMmcTPGMGQRCRC0SrmCTES4WJLiEAV+iNKM91KKA0T4BllEMLAlmADIYSjBDVBQE24cIEquKELgjiUXT6TYDgQAgBtmEOCEaxrQtDaClNuQw+MgDAvR9iVN2mlUnxTQYYEZUlEweCFTbRpAvCVTh5ZREMcoQ//6hK620BCBCO7yxBiMEKcc/nEAnwwx6EMoTcfC1QZhkKACnChB27gQheWUIEq7GFs/6jAN4b6jSFMtS4a68OVt7rlL7ABlw5/uBXe8IU2bLkMCOtvscEyoN3cRKPp7I1HiKhso5ThOzczpLc74ouUw8XQnpDE0DoiaZtYggEd+S4wLJGIT7xAiAz5Rt5UpKJnLMt9p3hAqVwgiDsEXBD1c8E3tvHqqKhRLgjXdQXw0IY2cGFiX2xJGXjQ4B6YAcJmj0sLVLCkDXfkvHeVdoqKaWHG6WOxLGfcNislubmUQtwMKcU/LvGPGmgDXsYMYkaV/YQhLEVEKIDANobwhDmi//MDaq5A3k6BjarP5eqEIEQg2gAAs5O+9J8xG8bR+JamveU26cQoYATVPD7xiShYqgtMbaJdWFzz7nfvhKI7YnObXELwMq8BMS5Rg0r4AAVB9HAlkdL45zolDUGoAHvmWiqi1AsFz3gA591SrC3gEgwoOAWEzVCFZcyHC0OuggL+4AY3sAbCaMC4KzPeEXUAHZk8cXv0xU3cKRP5sAIyLA42HQNDsMJNsJTvfYcIMMQI2EQNaIEw/EM3VMID9NQcfcOaIYW8sZvnfMBliRkB4Mm8PQCY8ZeFVAhj9AGE5YAS8IDY4YGZCAEeCIHY0SCXCUHu3B+x6R9DOMYQNEFyhf9MHKVTbojZmClX+SADA3KTADhgFD5gW6CLXCwA4FEgQ9QAKTQfQ7iAUaCI0P2UtEBAGjxFJZ3XiFTAGX0H8VQGBwjBP0TCHDwVFyDABYyEHl7ERczBHJRAsJFe/rmFagnC8dwEiqyZh5mhTy1Tslkh7o1QQzigJLrFL0wC+sSF8dlEN9TAOizABn7cn2gWbmxDGXzAA0jPN2RaUUzeUPkAEghhWyDbP+yEOiiBSOABH/ZiCYwEQwTiPxgDQwhB8ATPSTgUWBhIyXGYU5gXQ2Qa7aEZx8heR83ZJXoHN2QjXGxieIgAKYxCBhyB8znj4bnNNjRJEAQF6cjVsgwVGmj/RgKF35fZDuz0QC/moz5yAWr9xz+UwBwg4+6onk34AP9Fn5mhIyNWGEMCBjdWiiw8ZFk8gnh0Q0MowCg6hToJhfPQCwHcwecARU8NxTOgDBLwACVYAD3CxRYcASnNgRLc4RxgACAiAE2OBC4gQE7iwj82BAb8Q07m4CyiUYRVBhIQisgRFxMiF8kg4RHCBdtJpFRqCihcQjdcAjn03REMArNxJIbhxgeUAdSp2VGIQbuVig2UACWYAi4UAS3WohCQGgIA4h5GQi/OwR7mox/+gx7OARcIImWYxEr+Q2ycgl61R/Y1hCXdhgA+oqZZGDY6DULGhTPY3VReplsQQzUI/55F/gM5WAI5ioFmKWXtNY8LhM5PyNsHoMADlEArvMIEeMA1zONgQpQNkIQq6ONIYMBc2iQf5uRTAScCGIMQcMYcrGBHmIQNGEEFIMXHEUWOoZnoDIpGCUpcNFNRzB1PXApmdmdcROA/bCY5gGPzzRm0PYtQnMIzYKfl5U0FPIARzAAl/IMHZAEOYAGusAZdGMELaAMPLINuBigw6uOpXIAQKMHGecRscMQRlEEFfRgbMotHlCVDRmVbPMETHEVQtBtPYJt3fmhbVAM5/AM+uJgoXphGgmD0NMkHAEf8vNEpuMAWIAEuTMA/3IIoCEADAEFD+EAw0QUXGMESbAZvjv/EDPTmkf5iTiopAjCpEGiDAigBqrWBotzEnJDjP0hXRtlGZnmkE4ocRzohXOxNW2GLh4IomtpEJRifCIxCbPhJvRjXW0UfOyoFUExSGkCAOvgALngAfdInCbRlZQhbXQTCEmjDL+rmHuZhXubjHl4ADygAEsRlOCiBGfDERjQoUNGpR2mnTbAHANYiT3xAIn7AYV5mPfxZmspFIwheKZBDJFAYyPhcs53ZoIDONAoC/DxDE6QBBxhDbE5AFtwCQ9BAAhGmtcXFBczABTDpHQrnTeqkbpYApGaADRgngt5EC3AAoiyhUpKZg3qEdXorE3acnRgRQ0AXEq4rN+oMCq3/qlyUwiuUgqXBUVlK6AC2h8jEjxviAA74KQkMawAwgRH0zT/YQG2CxR5shoA2LB/ekxL8xwtwwYHaIkNshA8YD1EExcZO6LdyX1e+HVwIwupgp4ikaQzBq1toQiNoQiQYQVzZhhOSanJ5pG6UXGBwwAngwgagQhbQZwMggQsQkw8ca13QQwI06XBK6y8yaQmUAAbkZdNyAQ/YAA8oAUMYgRIEQkEyxCngLMd9lLt5RBmcwmXNUXH9lCTxRFQcHcjZhoVmo6r+A430nso2RCX8gya4AiW4QiS8wDO4TUfN6W5Am4ahwBacQAmQgCgwbta6wBCwHqHOBUegARJIqaMi/0CZPO0CLEAJCMEvNmpxosEF9ABHzCFV2QkSoMCGBUViWmiGbYPU5Y1jCmBHzJE3bM3d5YNduKtX3K1baMMr5O0FtM9tGJF1WmcG6QlqruYDIEED/EMA+GkWzEB2EoACtEALUBhdxMkLxCW1Cmc5ZMAScAbVjIOBgu5IGOiZ0EeBJGhOWEg5OqSZmWKy8EaZkdlNAF1wlKvN/AMfAclhwcUuOMMiLA6eAS9YaII2NEIdGkEaCkJkitmGsShTGAVrjlrAboApTAAuHIGpko4CvMCxYshD3UTlxqMO5uFIPG05nIMNLEBcKgATIIErlEBMlgNJoEEJ/KhOdAQHYAjpQP+fhsFdyHkEOy7bIzbE3jAbcEABniBe23lHKlgmkFBFPaQsXFzDAKQUuaxBt70CKJjCK5CDOJxDGqDAnK0tyCCTg5YB8wzF5HFAA+DAP+BAADDEERQRHTxDFyABEvzod3DAAuhiHv5iAyjBInGADbhDs2ZAOeFBTJrJC2SRFjVE5RIm5aktUtiEJJHmP2CDknjk21bSJLWF620XzXREPsyQWzTD4oiQApdFJWiC4CEB0lUoYkqfMy6LipQBB+xBK0hvFmzANfyDN1CnIEQV94bHC5RDD4iEHpauuFVMJf/YP5xDXAqBJbTACb/NUDjFB4iB4CqivjrLhX1rstguYiH/A1now9za7U3ojBZ7Gzr8iDYwxPBewihgZBMElW7AnkeJbfPI1bTQAM/aqADAgA3wr0YBiQ0sAXHyQAkQJwJ4Ro+qwHr9QzlIabLaxNaQyPJ4RCKCoDqbGUZNI0MQnU00o200CXbK1JniyLYsAvi0BQLeHbgxRC0ogzL4SCVQwiiMQgosQwa4gc1yGseBJaci0yQ9QBEQYwBMALGOA0NCRaWgwQssAMXG5Qycw0nshEsyRJRa7LGplruNYTGNmeFKpk/9Bdx8YEdEBWBc8ElzCu/GRQDL1M/8SC0wQczlwN6BR95WggNH4AL4RIuOzD+4dEPIW/L83PM8gA3gAgnY/+gG/MNRhuEcYYMKNHN57IRWd8JfXkEJIMpO+MCAlMCpfPOFiLLkhSv3NWWzeeX9ckxZ0IFu6O6ypPKJUYU8T6WhUeU/fFcwBA0cx91cFBC9eEML4AIo/AMoeAEuYCRuU1JWe8QLGIE7BMIebMROIMYRVCkKM8TWwFG6Qludxm1dNHHbnZmn0t278kReK7ACdAIT/EhhX8J3XcICHEFztoe8+S+nKYuSZNo3cEBQg4IHWAA0YKSYfdimZPIt8g0UIAYapLBZS9hzXRBvxGyaJd5d20a8bRpqwhmoIs9isvfO1PMs/8Ng/4grrGlh54BPNNNqMZtxtR3QYcOHjcoQOP8ACviAAjRCNUw3DtAAOcqVUZT0hWyKwQIPhiDnxtirT5VzEbde3TwBuoLFgeuqrhL4zgyAPgxAfb94RywAkICCK1jAdzGECCQ2x41MmzU2Hamh1xIRARiBEajCJcTmP2TBHmeUhO9M0YYHohiRsokpXXgremsYHL1aap44DYlQAsOruKk5p1yCK/xDpzeECGTAGDRjnRMxnfp4JYXOJOUNInrDC6iCRVJCdLfA9sluUWDDOeyMIH8HR3Clvmrad8SN5f3fEZ+yXJMPtpG5tsmU+MyFuryFpmvKCOStTbBYnJsLOlFnnYdyR0xSHNusILTAMHfEEdwBKS8FNgQyubD/EgVJJ5NQulw0D5d6sifr2CFtgj4Ywk3LVD7s9Vzcc1n4nU2YQSfkwHDXRaeTgrhFd0MAAynIOaGoiMgKBQd5LFw9wZC7g43+gwUYAwTH0TeMMKe4dl1sAQGUoLS1tVzUTVywblLkL/nk3t1G+x+YQCd0wqWCB6d/uijkLX//gzZgqceR4mJTupcCygc0gQ/sbGbfAjTQgKtIDwarw4Ak7PCIdnj0BXK5x3fYLG63hcE89JDY4qV3BD9wG2Kd+Vz0AsB7x09/xyXkswXcXCVYwsPvMdx8fVcql6pL6FKIAcZDNTHOwi0YgwIgyge4Y5iTy06QPFxwgMmkvJiH4WRm/7kGHQV6iyaIMAQEpGKzwKmP3AFH9INNeGhwcxO2yXx5dIICLNLOVMJ3kcIykKOatRnmIyVSMA9GhSUB0AEU2IAxCMAsyII0zALYHEUiUo+mEM+G7+crblrrTeZo2i+HliK+5sQ/OECJuOij10VSBPg/XAEe3QPao7nRGPymfHpOAb0NwLvkK/eV//pStMAs/IMsrNBskkGp/sPeKABivCVA/BM4kGDBgv6O/OPgw2BDhwM5VBD078O/iQ8LbitYsSLGjho1Ovw2UdAQjCcHViRAwNtDfihhxpQ5kybMWpMGdjBRoCZKV/8qAdVUyd2RIRoJCCxTkaTFgU3/JXU6kf/AExQ+APE6VuwfDBv/yij9QIDOQDQ9Z6LZgtZgmoFSYSaF2xSuQ0EEOJ4UdIotwW9R//EVeJbgIUOH/h1a1JdxY8cdCCJyjPGSwFJBbRAQYzBvx5QUKcKV6+IqDSHHZv0TMtCFQAIOnqj4Ovmkv8lbBNPcTNCzoIsWSWIrmNvgk4kg/4X85wJvXY8EfxM0pC+XPoGG6tHWvv1hp1o5JP/TlaOTQJzcBQY9curu7oKbf0/cvZLgyqrebCS4xVXgg1MfxhLkGwIqMMI29P5Z64gWtquAJuUI2uyJf+CziK8hogNsmyE+cJCgU+TS0CnnJuMHEkc2uWcTBFmkTZJ/TMj/wZN/eCIoA4wWIIgUnwa6rBwjCIDQPYHyInKgbZwbSYxnjhDCln8E+EcBCFyjCMAJD2SRg4Re4KAxNNCAwim2OgOtIpCE2wi0gvBKzk3lPENrSIFgGGixFvGcrMaBJjEhEROYEMgSjH6iRKZS/tFklEhsKAPJKqEjoCmo6OtIqpJ8uIaWf2RJ4B8U/mkizn/UYbDFLRLiYDbGsiTzUqc0EjAwv94CMaQHlhNMrlMgNCgp4hoCNs9hh81BIBP+qeWfQR+qLNGYLqnsFQCMcAGbsZLa5iKOpOpMrg/44mskQejwgQZpogTks7zmRNCfF/4hDM82R2Wz12yDNEiQMgE8/2WtbJNrNyV68fqL2IP7Oi9hjBJxiBRNHPrpJ4Ig/gcXG0aizyAxpKpQQor4ajOqbZp4QIEGBJgBi4QEqhAwMVu0jQOEtPuLAOLcIxGmeil0Cr4nPAM1Lo6jIrqm1hBOejJGZoq2kooNqiQoiv8x1Ahtx+KsuSIF4lWga4Mc6Vps8hMgASOgGMu9uwRSR14EfTB1LdrCYpPbyeDKa5u6ZxqLW55hylDpwdkqDyVt/lmmIW0aGcUhY6qVK8SG6LOPt1+ZupmDGaKB4QgHn5DUNbYLgTfBgrwUaG600EjoCIZoU+EbYYkVvCfACc99uzXKQyIHYPpyxdCGGmnkHySQNv9TZ6mQ/CBNIy39pwIOzBaoiag8A/AfbNTBiGVWGZp79caa8EZn3dFPX/2CmO2pEVdM+aSRT/6h/58JVLnGiJGSk0tgqezDFL/IRRAlU8AVkJA2wGzmeVL5XkFaADvG+IAwrWIVFEDlv55Ya326C1QHabOjvojCFP8w3j9EMRBV/KNaFvlGmdhEEKRIaiQ289s/HoAEJXAABRMSA3tG5Bl1pO4gLziLBWdiAH+kjoiTKYPN/mGwG+ZOMBNKHggLsgKBfFB3M/pHeLA4EEPZoCOUuplAkLSSOL0KW/9JDgqOUAhcZe4ucyGAcEr1D9sYQI99bIw/DmQENNgGiT2BGVv/wnK+njxvILMzmEVEtz4LaNEC/6ikDtQHRiyqwhQTKIERjLQZngFOZK5JY0keEJGsaQYvfNnXm1SQR4EQUiEPbIzpaKOxDvpGIK3ZBiO51kFSAC+MYQRBFlqWFDE4qn+/nCHHbEckXe7FGw8og8Z8UxXNDORj6njg3F4gQbbY5gVd8qNjKqDIFlGFTadYG1+wURJlRnJ9J9Bi+2BiCHsUM08pNMIdzNQz6J3EM3CS1CD+gdC3TKg5FsmaQFTwkCM0ES1LfMHrtCNOhG1NOBFcDvP+8kKCYKhXIGxYDB6yGOssIjv8RNAEjJcZjlWOJJKiZzTf4pqkoCANaaiAwXID/xd4PuoU3YvXUc+ChLfVBJD/eEELBNmgwdXlAbCDEBCh49KB8ESTBFkEJ/5hiH+sSKsEYRptbMA2sPFLNNxywTZ6pZEA5Ys9zfuWKcPWvH+ISaO0SYgNKNoYtyStOUNqgTfKspy/XKsgOM0dMWLyEn04Qh/T2YR1aHNW7TDgHw2bzDVesE22iZZCv4rQh3gj0ORUAAKCCAkrd3Um+4gSRIUAJUH8YdRzxuRtLSgCd/imHWgKSz4FESeJHllWEWDkJfmYDnfWgB7PCsRxNGnECgfySRdMKKDSfGhnhAMXg9mxDN6AQAVa4rd4xnNN2JvVIAig0KVOJpy7ZYxj0TLFhv8I0E0KKSttwIoezW5HcYiqLltw0AAjuHG0RgJMY5+SUzNiAwUP+MZfrjm6oD6qPoJIEy5ZyBg+oiGq/z0fVP7LopfABDHoewVQEPUPxNUEf61gYVVi6JqHQi+evmHkg4FzCgikgS8f2IYo18TKHXPXw1JK5XZaAOLGlPQ5cdnxusaU4rKOACOIm25PmPGKUTBjFJUwB9RQAo2CNGBWVWJlMt0Mq61hlZGha7OH9+LhbQBxbfslCK7+sSq2nKXEw0qKfPynwY2dRGBaJlaAG5Mjx7ziMpV4sUxw8A8cBKCEN/5Nt0JzJjeV5DilTK1QH+DLfEVxInFK7j+OspuJKGD/ILZk3W+T5q1gOlqrmJ3M1ByDKG18AhQplImNBYIDGAApejYFTFIyLBqbTqgkWb0LhVuCglOCCIjxyTK4/sHdlQgHCYNxTEL42KKViPQiIaKndgpZEPHx+iQD+MeKEWQJLjPmwDExBSdbUQIfRPJuOiZAa66M8OX07IZlFEgfGHxwemE5e6xJDl+6cLzbNoaI41sne95Nb5feyaXCWMU/Oi2QEz5khZFoBTRw0QJRlRZJc2rPTXuG6KJNbiAtqYBGfvwWl3FzTKegQ1nowJfZeJypp2N6Y3TWaJGH8RD5GNa+J6M4U0QCI6poBQ5msIcXZLC7GzF1QHXd3qgIAgK6//QVDMs+kMQSIKICSXdPjCBl7uDXXVN3NNbRojiCrFwbADCIKkAQiQBgAAc+8FBUsFmftUd4Uja9C9sKkupTcBfW8bmU6CZ1JK/d4QnYUIHea8LF7aDY763H05dnMopSPAzNBDl5QeynClU0wBwO/m570/6cNd5Ret4wycCiR/GnTBxJfwksTV5wjniTSe1avbvriQX4mjBjIPaLyQWwi4HeP94+saoPNFXrkKETBFTeqLvQfUZ02+157UkZQlHZkm4jHMEf8+3L+l3q+rCPWJxlJkpBGNLD+2hCcWjtLUTNwcJt4q5sSEBtIPhCBdIA0KqtodTu7NDoyDjwAzZDt//YwghmZju0ZwBzRxcQxN4YA9hoIhj+4dJ6ogQakL8ISFKA6McsBykgSS7KYOg+IKIkwiKOL+imgjNyqgxC5wPeIIf0aG5oKSb27yyeDoSObzsEUCamjyZE6B9UTwWzrn7woNwmhGvUay8cAkmQ4y2QQ9SC5BQeoCW0pSYqYjcGxMHQoBAawv8eggNMZSIASgy1ChgGBbJy4h8w4YsigxEJcSZW4RNWAQMCpQk0wj04LCo4T4Yqp3/YSZlGJDeIjO/UL8sgJCFcsDFcKYxWLYrEkAj+gZgEAhgwiSAK4BEEYk8QphzShxmMRxN4AAlw5ZVUQgIbgjk4DPMmLymGD4f/kuPVHsI9OAKK/uEZUECQPOUfboAVBK0noJFwvA8ylIPKRO6DavEfIIMgfsAgUGpwLuAfJmZw5ucfMkEJmAAK8gVgWO8hRMemnOPyUqICWiODHAsgJY8A6uYDhgAF0OAaBgAZ/iE7sAD1YgKA0mcCLGEdBQIX1Wlw/PARw0gJbMCokEPhcCfCsGq/6gKuIKDuOhKNjuRNKEIMmgANsMBThuEf7C0BkMAGPnINq49waOAfiqAAuoqf+GgLUSIFBCIDQEGLMGIRdREkT4LNBGIGQMlrfBCatskCZyVNWg0vmEk50E+GcOWU1KRlLEQ5JkcMKuwBroAVeCEfFoEuIdIG/3BNJjomy3KHFAzFEr4QJvxBAK9wnBAkHQsiBrgOMvakAyADMalyIPrhH5CJEmbgK+7w7UAme0rJb/ZyoOqje/4j6OgPUthqM1wgomBAGpBBGnhBH6wjFK4BGV6g3BzjJEMjT44yJpTyj/oCCMCQIMwhDBuCBdExFpmyICATJFHhH1oBA4zgGdxu+R6spgDSOpMwUgADBbLtjBYtMEKvpgZiD1oACwRCGgxCrFghxFBCaHKqIkmxJ26hL7rQMXqzJ2DxHwJzJqZyKrUR+1oBFyzmAhRMpCgnM1PLM7HHGBe0PrYhCP7BG2hHTfjlH+6ADoyAQZqBIKyOLq1OSvLuqP8EIiEmFC2MIzIx4j5hwgJkkS3CYzlR9BoEwhj6AQpIMMvaLZkQ7ebk4wnEwGhIyzVOoSUgZb/cKf5sJw6ysSAgwer0IRT+gQaKQO/iUy9RtCaezyA8oAN+AJ8awj9p5DgNYhGpkj+uwRigoR8eAJiCUsf8jGeYcU0+oLzc68qUMHpMwgUeTyf5geT+IR9WZEUMoR+WzVQEwny0YxCaYLCutD7RokULojLaER8gQzKMUxEFggWzQSB2cwCv4Ux5QEzqYh/fokoFovIEggyILJEwIjwFAkNE9DoMwk//YWXyJEuvlDsgFRaBZx1rQCDWsQPa0Ra/qBd4TUYfwhgKAj3/ecA/EtQD2/QkGipOH4DI6rBVi0R0nkEhzFMgnLQg7gEllFEm7DRXCyLdfnIgDBUmmHIoGyIFfqAbHqKrOgATLnUgdKFT+YkrzrQgjAEZEgAKrvVUX5WdbAcbOCT+nAM+NOMoHKSOcDO15gMbXiABxIqyUKICmoADZSgaRUqNStQhClMmhid91iJdEaQbPEAg8KEbVqBTCyBmMUJf1ccYjIEraKFflbUglu0vomNU1FAJP8MhimROI/QbyPEhtrUaUYADlLSlYkJ7RqU0S5FI3JMmVBQjIHV9cNW/CmI92eInvHRm8wS7uENA/0HNBIIr0PMfrPIfpiGrJg88Q8Z2/7DTbiEpOhwkw2hip1rgChZhAPIhAjbBEaT12RoJXKQOJlHgenjNUWsCDbr2JCqpkmaiGmCiRs6AWFZOO9A2bS3mH3b2H9r2HzbFPMvCUjJTbahiVBquvwa2TFxgsAAkafWFJUqmEBKgHj5UIG4AJXYNa821L5aKIv8hOWPCEpgSFP4hNRpiUweiU3FRq0bXZg2CF3jWB5pAOlUiN1npCf6DPiiwaOSvtG6uDBwgCBRqJrZhQJ6hAnwABlJxJt7sIXhpeNFiblrgI6PAax0jBUhhCgYCbAcCeWFiel0KbaFBWXdWGqRhdP/BGViIOcClggmGjhL2WaEjm/4mWmECaP8IAAoeYHfnFyXEAAQjFn97Ym444G1aZXIFwnJlgpKi1CCA0yFolp8W2Gahgc0a4BpggG3pBD1p4AEsp/5WqXLqxX8wLDMrJ42a4IpiAmZOYQ/8oU7+tG9talSeoAL2VGT7AobxJGtPYl3fxgA4QIz7AnP/QV4LIjnvVTzCCBdmICbU1iCQ1Rik4Rr6AQ0cNxpzlE14zuIKQopjQgHmJgNbIB/MM95CIppMVYUfwg/lZW5QFkHOkd4yISYgmCCwl4CvtlXRImQxIhwEYg6g4Blw5QHAJCH++D1PRZIJYl0JwgBsmZYPpkVjIIfXBwMMry/Qkz9sY0KykEJ/YxMP5v3/PkVbSKR9k5aUZVkmzrgPEYaNHeIMdCGOqbIBjGEXigMjinmQW4QLYFJfBOLVgmsyyNghLrkvrm+de0JF25k7zlEjFWFY/U5dYoIWIDJeXjkmbBc9Mi7cYoJR0wdytUwA4Rk9iDNMXQpZUQIXINohZNQYqOEaokEgjOCfYbljX5JFmAJEHGIMonkgBvMfFpqay2plr/RtMeIaimEXhDmUxdWG0CIEesLUKgLQSnqSz1VejkhpBFggmFIjtaNGwDTFBpqTqeEfvJk9/8HWTgJCqUQgqKSqMWIOCGKpYcJgBMGQzXWeU1R9qmE/MYJ5BeIEBmK5tqqnSdet4Zog1Hg7/8w6HmcCXpczqeN6r036SjlAAgaNLSyXCCrpB1g6V0uArxU7jH4SHDBChg3FXWfiSf4BKhf7smXZeLVDD/LEsjH7s80Vl2FCrDFCshtja0E7tUt6qeY6FEy7IEjAMTxbtWkbJFO6MYa6L167tnmbr+eaNgzY7zq5t4kbfWK7uJG7IRCaKkk7aWY7ud16uUGyuRHGqKE7rkc2V2/7JAJgO3J7sdXsjmmir1L4upPmLKj7IYayW80bJpS1GFLhJNTBDAQiBBTgLK4n1T4aYW5bCgcwKTFimtM7aSw3SjC7GPgDJZw3C2ZgBpSKEKdQltHbIAY8adA6rq2hGLAXI9JBINPE2xf+ISdTS338cLtR1B8A2+5QeiBGzNFkGLpn4Y7ZG8dypzfTzQD2FyXg+UAqHEE0W2kmur2146lntCEiipEkLX0mHC228GSnTptpw7pB26XRohisoS+CvCEi6PF++1zj+SFGbJ2V0sSHxQkOAiV6HCU2VMhPYrh7YlMIYhcmc5ahgCGkO3fOgsyPCrCh966JJaUNNc3ZvCHEGy36+SH0oU4UgEEWHQGSiMkhCCL8QbTXJz8P5s4H3e9SIR9gwFMSwA16og8QRM8RpHTTh9JlOSAAACH5BAUNAP8ALGgAAABpAfIAAAj/AP8JHEiwoMGDCBMqXFhrocOHC139G6Vt3D8fL4y4QdJi4JaBaFrYsPTPnCVGBhUU/POvE0SCkwiifKmw1ESBCxImukSzp8+fQIMKHUq0qFGfOXlWsiCwCBMkR/5xQPNvi9WqBNG8UEDjXwaDGVy6LJrMxL+ZBMci/CSQWMFEOf+R0vav0tG7ePPq3cv3n6e+CXOSgmu3RdcXDz8KRINEokCVCr6SHVoKWEHHAu2a+0cXsOfPoEOL1jvi36VlRf61EohYoBEkNoz4UHwQTRFXkyxJ9olWoKR/MQX+HRi85UKeDykNXDa6ufPnEJXRbAj94AJXQGysNmLjHxqq/gRy/zjiI6E/fyM7DOxE0rLDTp1YAj+ITtdASTMtTYo7EMdAV5a4UlpC2tgl0CjVJajgggnVkgwi1UlSgEA8kbJACkXggAQHQ6FnTm4uSZZIQSTBNSJwvQlk1kCI5CBTTilmIZApA5HyX0F0KUdQZwz26CN09gkkQV/DGSRJJ+79k5M2lnRUFHoLTFIaSQIlotJCaj20BkK4QNMIROYwJxCPAjH145loQuRimgcpctBO/yBB1U9o+BAeQf4UoY2IArlkSVxUPpSlQPIhBE1dBr70pY5sNuroXm6G5gkmwcXkSmpCHcFhQXkusdtA/AkU6EuTuFTcQeZgdpArmxmkyaOwxv9KFAuAYYIJQZN6EpwI2mAqVQvl+WTEP3dixSFmoxJ0okIjDgoRmQaZw5ZBX8pqraO/CDmUdApx+9Ktfv3zCEGVYGrbCy9sMSdIR4CXULAgoTcCMJ0su8BYJzn7kCQrKqQNKTZGS2a1BLV67cEIJ9QLhHcxXFBwlQAhUAsrLDReVAlhbEBWFiwggkEnwvgbX5jpiCCjBIFCEI2qJOyyrAcI1ItAtayp0AHgJDSuQIqAW1AtiRAT8D+8OqlQC0h4VNCwBvkzziXALBD1VynmMGioNOlol6oDFVgXQQQ3og3KL5cdqwm3/pVDkQaN+4i3CjlM3D/6UdICVZS8wgRE4W3/apDfAp1nQyOXeEz3PwBiffhlL1HTtaiVVOKK5AUxKso/powNrdmcO9rLuA3lUIvcP41LekHd/bOMJeW0ptCdeRpNkOyB//OCDUgwUfg/e//Z0qdDpUqQjZck6tCrnSfvKCI7/2NCMjL/g05PBzxCa6QHnfBPETRWwsS6r0sVJwfFChRV+Wh0d97tTLhC/LJDC1WJcgbb6ApyBJH9DyiXv7S58gDcy/X+ESnS9WshtPpHthayDBu8wB2m0YbrIMKh1BTrBRgjSAYD548XjGMJcXHFlfrCNbkIBHkG+wfyAshCBXmCbbgSyOkIgr2H8KQFFhjM9ojFt8X8Q3YtYBpI/4pQvoH4owVFUEAlEAQwnBwkfghJoUA+5hCUeUAg/WuhFpujDDfBkCAzc0gCXwIEungvKBkhiA82aLsJGjFwSGSVQWxUCcHwKCfGq4sSC3IJkriiESlICMH0t8VCeiaM/0CkQRSZkBo+RAHuA0VriogQ2ggkdX0blt9eQMmmcQAIm7EAU3KIPxpgZkSfYNQlikcKlR3kEiUE2z+yaMha+iRmjiRK844yjs18InU0Kd94BoIRgZTnBYBbyBGLwBS65NBGZhLI3v7xiRXe6CfmoJEpaGTLbgLQHN4zRxHA15ON+QNeyBzI7f6xMYcoZplMMEcjzBGwPOalf9z0pj57kv9L59RiQiQhASg6UC6BWLIg5GznG4vFIYzZgJwKseSdkKAAUWhjM1yz5lDyicXKCYSQ+wzpghhhAZWpZ0P+gGhB1kgeDnVyaazpCW2owgHEjOMEm9GeQAbwD1XUgEJAUc4VC8LRh/xPpAD0GV+G9JARkWISwGBCR15qkI/c7gUqHchDqXIEIzzAq7bLqkG0ApJ/2MBX1/hHAwgiESg6hKP0EIiMkErXMQ6khjE7CgtidoC8LiQRwZGaDfzxAB6+JDxQmZMlaXcKFTgWCS/4KkTWua4WqGQAA1irCe0STYSAwhUesCddRysQvyLEkRKwa1BU65BEoCQDqRmWWGs3kK7/viZObtyhEVTwjzv84wO/bUIavsM3B3rHiN1phRcU0NmEIM8VrhzIFQmmEFo6REykTdgj+jkQ1i4EQphwpGlbayomvEAdL1kjFATShQp8owKXrJ0RnNSEfwxBIAQ4xQcqQACXKpOHycwTLgJZiW6U5B8eUFUq5SLa/nWFL9TNLpq6cwBFuIm7Q+mFMsZLk5hgogNnne2c0ocYAjxBDAT4wBCeAdxh3el26vgGcAUxhG2kWCCn+EYyq8rD9DXNBpu5hErc99EI8wQ5EjEHSAeShbn6RKMS9lFWI4VhgezyWwWpskFiMomYnIN2lTQCVfgL3H+cQiCCaOx6zdqdQRDg/80E+AcBtjEQF/wDCrMtSEYe4IN15YkSKcicQFxpAQOrzK2DdkgxglLUKMPKkRCyXnX0g4hz/ANjVCUI056AX4EAVwyCUAEUXqOOU9jZxtv4wJwF8dshnLmwL9mtCrzB58UwQRXz2wxIqwFUjx5FigOJsKN75F3hWLl0RYkLIn5RhB0nZL1ikHOc0SyQMjjgFChwLwrQ/GY5/wO92zhFft/7kDuhAb0oyLYLoMCBFnigFabwgJl4/Y9Afu0gdonuQWYx7Jcp9CAchoncMPFFgayBOhAZlyeuPLKBZCAmEMptbf7xADb8g9PRpvMdTiGIaTsAG6q+wxNY7YJplznOgv8QBDa+ARHFvKAC3hhCyZ+ggsLaQCWZ0IbKUpATEUhOcvpGHIKB3R9+Z00g2FUIlPvtmUhV7yCIMIvb/CKZSJlhOAdcyCMWpou/JKsgmChANpK2Mdoc1Jhs6LZAou1pAkS740+Yto0FQmcxnPkfdY/ztMvtHSSggNUEEARwUUDnjCzBFa9whb0FQvR/UIIS1h2KsJmepivz7B+IUGpBHmGCHPQi6w8ZjgJGWCWCFAAR5h2IAf5tkDKM/CGqLnNBWO3tgZT57g+hSnnc/o+MDx4bafABRsYhjlEguiAP/sktZjl5yjcq4P9wEQxnhjZM7LIhwBvII5qXDE9EPUUjSET/JUYgNWJkowDjPO76FAM+I8zdIR2vvUBY/ubbdxrN2z4sEgZximij+M3PYHf8JQgP8ALaoArKsWRDgQqU4B/O9ygDFF//0FcJkRrjkks54CIQUiS9UHAHQRJuMSIZUAC1EB4b4wNmEAJcIARJc1wX8Q+0pxB613H/h3dP8AHb0HGyVxAf8Azw9RIdAVzPsA0aF3i/BYNy5gMXMC17gQNOthAG8lkP+BzqoUCNFBMLlBAxoRbBkQOnkwMmsAaMQHp08zGkoB8jMFgbYwZCUAIXwAV70APqR3EowHFIqGoG8XZul2IsJ202hmJs54cE8YMLYU7qQHinEG7bgA0EgWL//4ACaVAEeMAXOLABpuCEU2gtxSYzbsBIW2Zs/0AdrDUJ6FAqyuI7VdIJIrA+c8AF5VAF4SAQXLAEPOQD4gZccfcB24Z7B6Fq2/AAtxhn90UQLhB/81cGaZB7FKcC4jYIfTgQbEcHuvgPONBoNOGACHGJ2LgQS5eJPwJ9COEJCPcIDmMCZuA8ZsEIgbIAA2IallAKluAPEqAE/1AOsfgP4RCLXBAsbnBm+bcN8OUN+WUQtDdtggAB3pZiRugCTfAGclYGMfiCD8EBKvEAhfUAaeANd/cB30AADsBp03RgPzEBT0gQAVCSb+WNeuFsCbGJ4FgQNgN2iIAO+gIq4QcXlv8QDjbQAj1QBfhYDvhYBSFQBXPANFWwbR+QcttmZym3dzi2g9tWWDOIci6gBknwBHbmAn34AJlmREcAAQj5DwjZB31whBwpEC4wLDgFFKJAAxswEAFQELZAjc+iklqkNn2CEAvAHwtQDkDgD0JQDlxwj/goEOEgBD6pLhAgbmynix+ADfpVEM/wD2kwbd4wfzC4d8b4D3TgAoT3D5e5EB/xEWBVVbSRYoJgA8agU0HhgKjwD3FplwvSESzZNqDnExuIEGA4EAj3FnIxAq/AAfkoDgNxAf8wB+Gwgm5ggv7gAPxFEIv4AaoWg7oYfPk3iE55cS7Aaf9QXzj2D11AQUL/JBBlORBpgAIukJRHUAJeEHlA8Zp5sY2yCYRnsgYMYDVOxDhTkB1CwAUl8A9VEKBVsARcwAXkIxAGsAVj4AMuUAHAKGdDkGa1Vwb/AF+8aGbY4AMV0AQoEGfAxXJgIBBN4FtiQGfJ6BBoYARheRB+QwDlIQoToIAQMQEGIZ/JMZ/PoXl34QkmMAmMsIXqSBAkoQArYAwVOgfuFQTbkAYf4ALbgALn0QIS0AV/UAV/sAQv4APAqJVpVoysRoQU5wPeWRCFpaUb2qGBRwYDwWnpxgYqAC8KYQMrehDAGHgv8J9Z8JYPIQp8mhevQC5yhaMKUZsgkWc+YWk9YQIjYAK9/8AIkjECVMITFiAAtvMPZSBjYiAG6JluPsABfxACbTiYbqgED3CZgvAEN+h2hCcIbxB831kQhEhYaXAKd1dfVomVD7AFaVABZ2cQLbBmB/ERf6cONjADNBCbD0Gj1KisRPUPNLoad2Gjgjo7QKEMLqIIcCMuPCN94bIQ/fIXB+APHOBafvIPyyIAvIAF/lAG0vlbYsCQ3sAY/TmY4QCL+XgBCpCMHUdjZtagbDAbMBiRZhZucMpB5wEBdAAGvlUV54leC0EVK7qiG4OQdPAP51ACJOCs1cWnAcBNyDpLpqBrAoEK7gkRHjBUA7F80zpWAwFmEFELFqYQeLkQk+AWAv8RE4hhAORXL9fBCKWQAirrOBU6kL+FAny2gsswmPYoEHOwDCXgBlCwd28mCCHAlQwKAXSWELBmELx1A3d2Eb5FAA7qEMB0EFEgEA/ABGsFnzSRBfDJTS1zOagQAJT6msy6EAhysiv7GX+BPYzkCTZzmw8zH6FiBjnAjgsABK6gPbTwD7OACv/5DyAnEDL3AIFQAlWAAP+wB6AaAsc5BxdgBB05EN5AB1D6D30wBmIZBNeJY5fZTjeQAP/QD7F7BQPhtQIBAcAFkHPKYwixMWNQsS0ADaLwmh+rEEN1twNBI8tHqYGqEEm3t8o4FDFRCxbxD94CLmsjEIZ7KtZRJTP/4Q9HEA5mYAM2MArXMAUqawsBYAE4AExxVgYP0AVVoA4VoA5dsAdLcAQtcB4jIARmRnt05gL+AAEOgLq5+wEUOhDhpl9GcA9IwAr/ILsRIAMEcZod914Fe8EEsQMIrBgPAF/dobIPISPYyLbB9g9uO1coLL1EQag0cb0ElIVr4ibWyqMIsYWSkAyJ0AkjIAEvdg6HOQIvcAIwIAC7MJcqswxQgQIH/AGncATPEHMfoAJsQD7nIY9CsARGcGYE0KCw5g8bEwRiSZnUiXfi8Q8koAcSM8FeWwhYAQFJQAd3II2BVwEbHB7i2x3lOVPd+Q8KsFZxOZe803hMthCikE/W/0gQHtA/R2V0/9DCLhwUNROKitAQyqAMt9J56OiB8/EbPRwOd0OgXIAAXFAFJYAA/tAACXANtgAKdqENS2CLblYGv9ihmfoNWyDG5qME/3kEZwZcEOADsXsD6LUFvkXGAjEI9gVcBNAEZEAEJ7ACAjAFNqABNxAeZNwHTdADTwCRRQuQG3xJSNC7AqG6KPAC10DCBJF8AWALWQDJCtEyjUAjzTcjhPQKq/AP7DzJP1G2B3EO46AM1MEt1PcPa7AiPVx6DtdlOUCLEtCf9piPAlEF6KIEHuAOAHIgLwAFLLcNtJaIKDAEu9wCUXAEEmADHGAGVZCM3/ANC6sBERABE/9MLAubX1mrdwzpJETAOwNBxhBwB0nwD2DAlPQHkFubFRdRngThAE3gDRTZADRwKIumwgYhAPJZsv9AXXpaEMImWv7MF+dwDpgwM9Y6LgQ9Op6gFlTSCQugjqXyoyNQBEbQk7GIAPd4ARcgBA40B4tnCcFwDiHMakGwq8+wRm4glJdbAv7ZCT5wmQf8D7ibAF4buzZ9cWccZ3TWZ+w0EHdAxkFQBmrwD2/QrvnFakPoDb2LHuOpGMNoVv9QDPxmdKwgyQ/BMpizTR27Fh51zyMb1obKVA6xM7VgApesDBCyGyeRCMDQwz3cCZJQKp3wAojpuQNRBUsbAkrgBkVwCSf/4tbjEBUS2gWFRaD/Sa9VcMos+ABqpxIJIAM0LRB5gLpqOn+bKRAVEJayx64CEaL/4AJeLH/JCKziMSxb0AfhQRUoEK/EogD9cAuAIM9B8Qls0TKzZBCQhzn5QxCzkAW30D/HK70u6xPFIR0N8Rec/IFxwQgjAgwZoA0tEAjHeQGeOwchEA6gWgUV4AZ7YAOlsCyJYAlRQQDyawRGIAQIUA7MUQ5AGQn/UKBG4A1NQAcOUAR6EB5eKwMUcA96jJB7F4jABVxpwN4W+qV79wQrNxC0ShBQgGn+YATpwkNZGyxacQ1GJ+H8jOcKkUqmAK0zQhAbgJIGcQvSKqgw/BBZ/0gQ2bcerfUP0Z2GKQiUAuG5ghkI5eEDELAFqUElLJ4TcIYRSmCvXPDkpB6Lc8AB21DHPsAEJEAERiC7/wDHnUosaUBn2dlps1bGNiYI3/CuAvEEOYh3Z+YNKPAN3gAFnE3nLWgegUzI/2ALs+0T2qAJ0y4K20gDfyojWXBFKBvWoeG9MEEoC8EIjODDNmDdN860h+kkaUAGEFAE5CAqI8AIdsGI6sABQlABbGAG32DLYlsFgRCLe+ADg8BpiLEFrf4PFlzALlAGigHFM1YQmo1taYCQAB53pP2I4UZj+dUHIeygbyquH9FBKr0NFncExRIeNpBWVT0Lzm4LspA1qv/wJSX5hBtQ6Ach6N7+EANXheGYl8QRguALH9SN3eFgj0MpBBwQllEQBFugADWQCM2SCGmYBiqQjGXgDYIQgOkpCC7gDV2guUvQBxUwBBDABALQ0y54Byf6grZ8EHQW9wr8ABBQASnXcWQw1Ek5ugB5EaeAxxIZOEZQAY6FEP7ACtIwl5AsAIDQE2xBCY0WAMh6iVa983mBCMVtAiESfbwTEzmgVDjccAzwD8QA5CPQCZdgA2ZQArFoj69YAmbQ9DsQBLTvAzlQCYlARZNQCkYABYNAa//AcqzWcbteAf5pBg/6DyvgC0TAAX0AAWOQBoMAXN6QBg4ZBBXgzAQhbnL/Jm6CAF8G2d//LW56F5o+oA57wCHBkuDLfhD+gAQJ4LzPHvNz2bgOsY2XYwofK8+yvRBVTZcA8U/gQIIFDR5EmFDhQoYNHT40yMGhpxw5/k0i6KnTRYImBBYYyMhgpwX/Eo14sYBLFYIXQnAIAmEgGR/nRllK9G/jPyRpBh3xNuRDQQJivI34h0DgqQ9o/PkzsIMggQ+nvPlo8uDfIEGCqH4T6OLf0H+CPgwh86AClK533tApC1YggX8o/m15oOKfDx8C/f078kIhmmvXsMwCNAuLrTgQcQx8nOXxwVsNZ/0LAFHzZs6dPWuW+BAjQWIYPf6TVBDRQWCW/pX8B4wR/0ouAsv9KxeuhJs+ZAgGeWDzn4h/IySV6oniAV26AgUNVCfuQm0CTe7KJBMkzcBtp079e/B8i4vnUwcOJYtiixAo24YIKoPCrMCqdNNUUIcGihE0fQf6QwKNhPw5oR8BBPhHgMoeoqQVHBxEZTLPEPyHFoFo2SBBgi77rEMPPywoNBAPymCgAizCRCBPFhoNNUaAuSiDTkaw4Z/aagunCi628E0qgXaAIIdKTFogkZzO8QYK/wYqz6zv/umiL7sEimmuoQjwpoIQfEDhA7HeuMO5fwggoLwxyfLmCCXc2KasK+e6g4BvKvBGIB8Cu4sgNIr456+DXmggM4EWTJAahv80+ecxU1BpSBaIsvDQ0REnpfQgEQWSSMCGUkPtH0wQWTEhiwYKdSATUiOGkQUmGeWFP0oIQaBw5lgCgiD+2aFHIG1YxrXiGBGmCGweeGBKgr4R5JkuuJjDjYG0goAMMu4oY0wXToHiHyMeYAoFH8z0yiCyyAonAQWe+UYMAsr44Bs6KjjFBQgg0IrYB5YcqAUj+vxvIDRO2EWAy2zR7LFWTBGIw4ECkPChYgiCtFKJJ6bYoRL/8QSd1RZSRsV/RhWok40YmaSTkjnggotw/lmGCyR4FAgCqYLow4hRckIqkQWMOIVY7rp6Ysx/Yq0tLN/IcIFMAtrsuQQhtv0nDQL/gvChWm+eOWgbAsS6w4UjnO6iTi+963leF+bcRgUf+jvoCHwLQkIIW2aRJTGBInZIFFX+wTvRhgk6xiEKAa+4cMMp3Skjhjo+baCSC+pkiRceSPmfKuaowocggvBRIJpESOSSfxjQ+YUKtsDmyYIEqaC2JbT6gAAUlB5C6Ki5CESIF9oUaJs4PxCkTTPLGsuBO8L0oYoSeFhiOxS+80EtsslewoennkKDAx8k4g+hFowpppl/pEGmICwYQuUCURpppSBoFpIGIUnlP7x++znrpIDEwVnIk45zKEAyMNYJE0yiRajRCBIgIIglpCwcXPBPTIIQhR0E4QVIEQgjStEJ/yikoS9yGYgLaieQEvzDG0sTQ+zGBBbgOUAd/yhBOI7Auw+kwQVIc4GXPnAHuPwjh0EI0z+A+AIlIEAIXPAGBKIHJShg43nY8EYatLKFIwAGMD7Y10C24Kf/MAEGF3LUBDxwt4FE4x/I0FtBVLGMT4hCIIJiyPsqlLCBFGN+Bbnj/fRYqRZABCSMqMU/AskQE2zsHxnQyEU4dZFOSGISnljACwQhhgYyywEH8aACKkGKkpRCGd+wAQQqMLy5bKMEVUCAf+RUOzmViUym/IcQ/hCFbaTBd9sQizfu4JUTbgOXPiTI8YqDB1n6QyvZegEWAXMEez0gWyHaV43+oSmCvP/gGhdixT8AETiBPIwgxTBF+yagika8wiDeHIgt8njGwNniYXZkyDr3OM89Xkwgj2gIPheSGkmYwAQZyAGXHlCFcszBAZqTikz+ocRzlEIgGbjEEb7BgTR8Q4UE8cYeBCIBbwBPDAXZBvD+UQEhhEMJLbjVdgRyhzSQhQzPySHvbiVEIVKtB/+oUQigoMQWrK1P2oNOX/7CxbcVxB80uEY2BwIIOhYEEK3IAsI+8Y9yXqCOBKGBxAhGT67aLwMH5MxpctBPjP3DBBvpxBpm5IPuQGEOKtvCb/5BExtcwhIjuMQftvWANMhnaaVsHRf2EAX6vMdM6iKAA4RgxMxRaaX/afBGEFxwB95xJw0OUGmYXBCFJSiBBzmhJr8I4p/8hEggmgptn5AAAwEo9R/c/McsYDs+CwUAGg/6R/sQ4tqDDEMg5etqcIVLkEEWpLgQ2Zg/UzOaTlgiA5WwQbFcUIFZ3asg82pBJXKygIC6wAen0NpztiFejZbACFITy0XpojUyxMqqRyBsQXxzB8ma8B8sdYFKHfuPKDhgC2wYAQc6oQStDGgLCigwQWywrxYIxiDfKx9vCTKMfBCEFoyawD9MQYkMQ2arApGFMwjiDH00QxoWOqNAjoHi4bbYfgcoVUE6EOOG6EIgjROIJCzBhAVYYgktaIIg9FKCP2xhczPd/1wfFhAMS7SqPQ9owje+0ZylBXYJVUwamcb00blgYyB76IMDgjAGmq50pWJpk2YPMoZb+QMP/+BBCW6zEAFdCjAvsIE0CeIPpyBVwr8dhj6QYYhm+NYXmSkBDGYQW24KSmEiNog0QiwN8VUocPFzcaYrJpHjfmwj9nyIPifhkYvl5DVMcMXkTiGIcIQDAUYeiAQPXIpRZMAfBIBAd9qEnm18QwiXQ8N3cqjDO4BXaCSNxD9eYCuGbCcNvjGITGbqjz/gQTdCEBAXD+IPhRKkigu+lJ8cPAvXOuPE4hP0GRMgBCwwAQg24JMAjLEZZMiCFYHjJi1o8WdN9xtEICNNbP820wudMKITiWCCSexKCiaIwBwcQIELUNmsmcxLZjZYxTJeYARBiFJ4YyKAs6qghBl26QPYAMsJ/4GNOwyBdzxQykFulWDPxfofZC7zf7QhhDkgAA9z9otot42GuNpAItLU9jRp0IBZYBoZscjHMIYBi2GkAgYwoAGDbXCCExzjGMVoH6MaAgtnIKPSzZBFM+rtb7Z/yM7GXVHiFKIxfVLkHyJJnCVI8Q+7ZuIFHxBDmxDwB4Ra3FYtQMAvfPCGPhCPPlbJ0T/MwCUX1Ml2YrKcEP5xAd3hvMwz9bxBqrTQPA11DiWcwwWU4GAuxnUhDe5TFg2SxfgNox7lQ0Y9/nH/dSz00YoK0Hwx4PgZ4CrkGKzgLSsQFIdbNKbtz1dIDjyB44SsQZA6OSBs/kEKvZPCAsZQAFZcEIiCSuRW0YpWC8IfiD5sAwWIBe82aiOE8AskPk86RZn+8QxjY0Agv5aIxhuDIFqImdovAxAI10sKDECAedsMDsiiF6ii1EIDAVg035q6f1gEGLgBLHgBP9kif+CAFki4w5gAhqEYVkAGfoO+FhQQRTgIT8AngEMIkSCITlAAgViCRHCF7XsNUvAAGoCGE2gBFMgSLoiEP+iDWzk/W+GACvABn5iLfxiCU+CCECiaqOErhYICb3iSatGLSJiDf5gDIfABnPOHtzHA/5gpM5noNolQAc0zolgiuTTkgDvcjGwzKg5AhhmQBmmosH+4BgFogb+gQIFAgn8QhczIjA+jFHtTqnwovhZ8vrfLCH3SDFUpjgUYkuJwBQv4hwmYh1swBg5gitaZg3AwMia0FeDgK7sQBO9IkyJAghzkgAfwhgdQB2eTIp+JmmcqIf/rh9qgJm7rNoQAvYGAgi1KitMbASTIsyrqC0tMCN8TOj1pASwABOQjAST4wIX4CxtIAEHcDEJZCGcIHGcYBkxLBc6ANEo8nNDIBoHIgVrotBTpjD8gCFDwABIggWKYABLAhSoaAhdQGSE4Aje0FYtTixPChl5cgCUIBG/8Lv+BgKzvOAW7sIpcDIxz0KikCJmDiCsgOj/+ipnN8RM0CATJGwgEKAIFuI1E1AxrpDMR5ACnOK2DIDo9+4eEA4Rr+i3PqDRZkCeCKEqDYEF4NJwXyIaLATWMmQRD2gzY8IANSIc3agcSmAEF6Ktv6AIEmBX/ILOYGAMfkLLv8MpACAQEaIEjqCKCGITveAYzqQC9mIEFsIFOwINImIH3ykmC2II+ILNLIr02G4hAqCIl+Af/I4h+eKF+GQxlo0mE+AsFDLqEUCbXOx9WkIZZAMqFELuEGIY8mkTNqDSltB8OcDCCACvOyEGDyJB/QIVMmIUAwAJ/oBMzuABxCIED6Av/N5ygBxAlbqkABOCCHwOPf1AHbHjMOvkGFVCBAhNOLnAakGTAf1gZ30s6O/EBCIiCsSQqxbSqMQTJgeDJh3iByRwQyFQI7fyHFqAFaXCnWWCxg2iEgXAjhHA+gxCfSjsGR0nKY9hP1JQYCSgIA60YX8mQALjKVigGdpgAdvAAiCMAdUiZOYBCzrm5eZmgb1hJIQgBN/ABB7iXI1CBLoCAQRgILzMIJOCBoikB8iwBq3KWPilDN0itu2BGahpBg1AKAOCBt4SIZLLMSTECvugLIJgBgjlNhOiwcyQcpfKtSnOt2TIIR2hSAtVSghCA0ISGE0SFVpgAaCjCD/gANsAc/yHwPSCJCSDxgZVwAw6IgjuIAu9Mg+9QKb1ACGlCgDlItkiwqhn9B338B4loA1k6CAQUCAWwKh4YiNpwmhJQghLIUYboo6eYmH1hAjRAgwZIqvpMGBIQiAk4Hw9BJ8J5R4FQwS2tFAQdCFetmHkYo4GAhwAIgAmIhwCQpDJYFyycg8mDtoWMCTltReCQifuAgGyBAprrFxelzupMgB6Auc2LJaFaACXwLDPoFz/pCzQIB0ftuRJaghxgAlrsI4mIqy0oqoJwG0yVGB/IsxP4hxVIkNJsR4IwR87wLVbVtEo9nFYAgQkABVFoAfDamj2oApfQHCqRGQgYS5xrPN/wsv8HQIM+eCaEMAIlEAI5W4BOsIgimNExLIEeKIdzRQDPMkODKIEZddSkKCF3MAIm6IRyMAN/2IKbDY2+UE/XcxuKERAj4ABbKAJrurfSHAhUGJzQhIhhsFLC+Yd3TFV+BREDVc+KCQVfEAgnIIgJoARRuIS/cyUVQIBlKIHKalgJyrns+IdlHYh1Fa0ctIFxYAIFWIIlcIeV/YcZQIASUgJtKEQOKEMl0NZn+QcgbUmQTIBJ5YFE3M6BsESg3bNK4YAFS4AKRIZQSLFFaIjBaQhQPYhmmBsJIxjPjVqpvZ8h0YT7fAgqoIJ/eAVNuIQV6CNeoq5wCAEkaLyBAJL9Yjb/gXimtrzMtr0LG9jb/8NWHriAJYi5mOM8HsAAJOi5C+iBLNzYgliGFmgwwdiDftCUIrWUQv3LSiG6PrGBFxCCFTgBGMimpCSIxZiUAHgYpXVa07VUh6haiAABzgAB1uWkDDgCuyiTb0jFgnJLsqygqJG2mCADqfBFBSQ6DqgiDvgLB1s0XGACwbCBJVg0gfA//4sEOTQGzRMCR60iXCgBXBgIzbMNaCRSavLXgdiXIwitxu0MfxBSG6CFYjiBBsimUKgHWFgI5TuILxIIfQgF4NpXhVAYhNAH+v2M+62favgHZmgEUpgESYCapPGS1kElwhs9IYqCmTpbCHAb731g/zcwgz9IT83jAhv4i2X4v4FIPRiKJXd41M2jP2cBgIPY25XtAdmLXITIFPB94QTElGmiYS0SiD5CAyM4gUFkBVkoH2lIYoQYnK2SFElESuPbpgE1iCYWiNJ1YkoUAWLwBAawASggk6pwAY0aWyEgli+WOb6i2LuQYAHZAiOgzlRUghzgghfggESMMzougT6FsxIwhzwj3hJaLATAgCqAgh5QChQmIZDMhL3lor+YRheupiPA3mmKHpxMCHOdpoaAQIE4gkdGvnt7WoZQvqZN4kqrZA+xB/4c5YSAVa7qhh9IBF0oAEawge0Qr+5QGVczgyUsTCFSKDcEY079DzRYAv/Nm7MqAGZLkMOiUQqR1QYjMAIFgGPF5AlXKKES6AI3aNmDmFElMIKhypNydtuVFrr+kGCFWE7w8KmFcDAb8KLX+gcp/Yej7Gl+Y4Ul/ocsTYimJQijVtWfvmfhQpAnZc0rRuU6UZqq4ILcmIMtWcOC2AE22xw3UMA73INfKyiBwDMhkGYEiDkY6gFjsIgMUIoZWJ4L4BNXuAAMUD0kgAIeyASFwLaWNirLjKvQGF/A3B6DgM6CoJMCa1x/KILQUIAGMAisZYhYMIh1muelTup/2Fel/gcgbmqlJAfikARPkIQYOIdiCanYaeVWs13N6TaZIb3YBo69eIAWqIASIsP/cNAX3DgHPAAABFgCQVWKFlgCgVCK6vwHd1i0tW4BM+CBPfZRzvsDPoMIG4Dilta2J7AoH/qA7lABsP7ejUPEASBKDxEfphox3aOfzA7tavQMGhSISsiqDnnSAIgH1pSEZCiAcxDoXluay3kgGdrqg5iXNKCXMXADIxCC49YWlMHOKjgHIcCAcMjBEigCGzAHjUUAqxICvHQHPiCITJgDxTxu8iyIEuKBb1QIbQtmOpsmQFadEfIKOvGBuNIUf4gmGhCQHIwFZ7jXzqi0sisI9y5Vzh4I9n5vhsjn4YLqj0CEAtCFTsCXrakW14YJgwjjnAsCvICANNgC4cbOf3AD/xW2ERiaA0soAcnRhlgiwwvggTmwgWstIYzeW6UwAkcV1BTfg0QuCDTIboOwgdf8BxAiiNp5glMQ73L2hxdAgxeQhiral2E44nrmBc4WZfcuCE6wB90zBHuo54KQVwGwbH0Idc1ecs9o8oPQ2hGZgAmgAim+uwIyoBiwgVNgJa0xg6Lhgrc8RgO8lbHMNR/4Ay74cA6oAoneg5VBApPCqY1Q4RgdVCRQCqtaXj9VgmzxB547iDnggRzw84iAXO/9B0UtiGpZNSYpC6aoiylCZP6wpqxSgM9UiH0F7YRYBCUfCE7Qd4HQ9ISIzdA20FXnjAkIeA95BeL4B2L4hxmbBP9MSIQXkBqv8KVtYAkbEYxjJAg2XajNIeMSwu7Tw86VsRHdHIE+ctQLmFGQz4CNzULktqosjGiVjQQeoMa2QQOjK2dq7AsWPRZx4Y5u7ZMj2AI0YIVShYFmSDeFaIZTF/KHIOpUlxj57ZAC8JV+IgZJkAT/7QoCWDUsYYmUqSKN75yTZDMf6IJFq5EsVArd+GUEyHO1/ocPLoEXgHO5FwguIPG9VeHG+wuVHwgVX4JwPy0B8T1NAaqC6CM9JQjVaXy6EIMKgIAcP4I7FADfSt9QAOWDuAeBMIT7ERiCoPpUD/SBgGoSOBCGkHXR+AeQwAhHMhkuMYvw2gY2GNs5SMj/Kuk2JBuIefEBzeuEksKNoTnzJYBAJbCB00OAchACOCYma7eqxey5MfyLHRCDxlMTkk8KI4LphpBAyNWT8GWCxySIoEmIFHI5anKbCgTKL8Jcgmhi3+IEfRCxWNj8wnFEqX/P/W9PhWCHSgEIT/8GEhs4ENikUr8enDIo5tS2Kly4lHAThQyEgUEM/tsIwQWEIBDc/FsixF25gVxULkHywocSI3gQIFCyxIYSBJHm8CiBZ2CknUr8/dsBZ8e/jBd4RJoxcM41NBynGpT6zwZWqlv+cTDIpMu/hlSpfuB46tQDji3QsLphg8bYgan+OZIWShayuHr38u271x7HvH4H/xMubPjwwEYTPAwk98/x3mRTJRnsNCmhjQeDPhAQRMDbtgpcqsxxk/F0lNMdMwYRCaHFBS7uwnEJl7JKiX8VjvxDE+hcCQT/cjBREg5PDyFVyoUrMUcJj6H/yJDh2MdfjzkGhbAhOtiIka57kagjwLEsXzEfvoVOa7AFh2P/mGAZMCwuK8ED9SEe6IgjYP/IIt9YsxjUzEAC9Lcgg/210J8v/wQwFTwTGOSFQZDpJZkklkwyUCcdHGTJMi88M0RDZXDmjWjlILCED9V1ZJBID6Sx0T9joDGROxOl9E85gfzxhw+9/bMHEniUsMASSlygxAgt+DPllGgc4caUO5CBlP9BVvyDhJNzICCEEd719UIRNhg5lVX/KDDIQIIMdIoYcTVknnmnDPHNVv8cgQYS+dhniD6GTMWJQdw4Y1A+/wRYz1hz8cXfQAjGJYuBY1FqECt+EdggqAM9SNWooTJowj8fTlKQJJ4UMAlClyTCwRDbfHDnB9uUsRICVXAQBJcDQbCDSEdsFEQUOcyhjUG9UrTEC0T1qQBwC7hjnBIcZDlsHzv0cV0fMk61Q0blBPePEG32dQQSfL3wDzacyfkPAej59YQgZRTpZ6l8NRNLpYYaVE89w4QyWD2Q/MMJpAYhGlcxBtnyT6d63efoVJKaurFfEvRnIagLdJIIZQMV8I//JYmUYokPKMhpHr2hzTFHOHP4kIZB4j4QBc5pROGNcD/OgdscR3i31VZmZFDCBUKE84W2W+ywAyFaUk1G1dWJOxAhW/nzBQI8cGFmX0jYoC5VRdD7BDYLflBBRldxIIA+hwzUsF71LMJfPZvcs8jCoQo8FjIC3MIx4oj1m/jGnUgycqrJAPMPMSqfc8QQL4tR7zbflBBOzW74gKxBIUGQEbk+/NGrSriVIx4a6r6ARAmfK2HDlFtOXRS5UwfLERnXSVWkEAqQvdcLNrzLUZ8DLd/QvHvVSVVn/7jHwQsw7P0Pf5tSVehAAXI0eH+Gkm/QMYBkOtDFfQH+MOPxy4+Y/ySTJJIqIqlaYokIo7zgQhPqZB7PhIYLNJtDF0b3u5wV5QgrMUgIaNaV5nEkeTxAAB7C4Q+p6Q4pWjPIAq3mj32Z4Qq48wsa1IQGA/zjeL1RwDeeQIDq7cU82xjLB1DgjYEcoVH/yIU9DJGP//AjLvcYyCHu4Ygj/tB7hfnPXmhxuInJ4h/3Ed+h5qfFLR6mVQXwhCRO1gHL/CMRlWhB5gzCGXx9YyUXqMIebqYXchlhJbSRCBc4gDaOpFAIYUPDBq22wL2QYQto2AJR/HGBffVlC2VyoUHU9I8n/OMDaewPAU6xlXcBJh+GAtz5qAKLhRXRVFgcSxb+oSCK/cOHU/9hIhdjibiT/WMEffFEB1z1D1q+ihEpK0UnWsYZg3hGEF0w4FMe0JqptMY1RxDCP7QTDiGQZI8VFEIPquAPNIRrd8HaGgOnswUKbpAwy4PkqMoAqs5kUjz/OMQQ7QG4AcCSL3b7xxEdcU/C5KKIeOOLLWZBi06d8m6yTNzyDJLQgXgMVCJC2f34gohaGCR/k5CEJIAxinNAAAUuUOMHQtrGf7yxBEYokmogkJqO9MENXPhRCPbAl00KQQmI9MeWBkKuscRtKuU0EyTjYk2D8OYJHxDEN4bpFzkJInoDQUGRFMC9wd2nlH2pB6L2ucXDCWgv9TwoWBfUiQYBo6yfeIH/Or4hFph1po0048IbOZCGuLUGdadzQ24u8I8SQMEvHFCCO6a0hZxy5JvTAeE/4NDCcZaTMFuQZFy6QgA6bM6pS50kR+6AgrRg4Z0+dISl9kK+hjmioIPJBWFWGdbV/qOhrNWLiCxxiREUqan/kNMNj+qNEAwEj6NjTVHi5q0/PPACIeBNX9DAB6P5ow9HGeRh40IGf2grqAsyggr+sTl7Lcg8KOBNZ8G3F0TpZyxWfe1gABNa9O6FBRwbR3++SEuToUwElyCSyxpyCjx1Zht70M5ohICEkDSTIyL5Q27+wYU4+mULQhDslhRrWMUOBA4yCtYGkVaYs+nlBVKVV/Us/0svG4olLnI6hRFasIgBQMK0BlnEP0MFRdbyYlHslWUtTICqw0hmIPnrQAGYsAAgLCMzddoGkudlyd2G4x+2CccetoCzYCElCH1YApD2wAUkMDIuW+GADyCMkW8VpQ8Z6YNB0DyduBVSj9alypQgC2fsTo8A26gXzCrJmbJMT4145kydXLDZc/RDH/oAHODikuiBxOEfx6iHixkU6cTl5VM3poqcx3KAvSgiVZ0wASMI86F/ZGAqD71ECiwhDrSi4B9lyPPaIjI0mpnLPTPSSBB8UAUnl6AC6mjwQKbk3KJYQQpSAIOX+mDsZYNzul6joLsWOpVSwZoAfUYPrA1ibf881ZkAUoUBDP5x3sFELBYxZtAw4De/9V5afjn4B2VGPRhPeGJyVElEIkQgggVsoQKbi1Ml8ewC0YyG16ITyYzIlWvtMKcLtp5pC/3xAG8ZGw5WuEOx4dAHK9DBCsWWwj/UXMgNJpIvZnInVZBwCqMa5M5KPQ8O6xVw9CBVSog6d1+q6Ax1U+WfhprxWCbd7rC6dyBFJ4wC3u2JsSLmQ2Is6yU0WoSOzvAb9OKIID7Qhdw0eQ4hgNEdZiSSGs0BrntQx8P34gNATmfjUrACHMAwB48b2yiEeLuxrdCtMuCh5IPxhxGG+q5T3MpelmXrWGBWzH9Y/bYc0cfBCnOfyBv/RKtTCeVYQmkPyoNKYUOXnwR+wSB7k/ofHUBEBgpADEsAYxktMIJH7dy2lterC03edRXCUYXfGhgCD5h1F7DRZbVPiXdgIAPc99ADZFs8sYSwwvMtLoUtBKMHUSAMUdAgbbIIYhsiJoteysLdq4PKGU5EXICETpivste1fTn6YOB7GBHoZRIFAEa+L6EANLBhIA+RU5+JQdYhAG852YsM1q11RBRgmddhAzZAW2Td1BYom8fBASFwQRsAgMc9H9f8Q/QVmxC0Qf811kylUAsZRJ88CJ+VmF58H9ZpV/zkAvvpxQzyxaI1SA1+3o39wFREFEcAgzZkhjoRAL5U0swR/4ALiMXtCUFDVcfY+YAP1MbXCcIONViYEQUc0AEcWBwZCMEXfAHIeRz0eeBAeBwKfEEbQNObGQRRtECXmUlfEZMatdyc9IX4VVLjIU4OGsQwuFJh8ByD3KAODgb8GcYCmMolXIL/sIglhYUgiEVZbMMp7NYD1QYHQMAYHAEE9MEDQIAPjIByzAEBoEAc9sXaecfbeYkVoAAefKF2gJyxDQTINQEdtOIXZMsJ+hVy/YOGcYACyMmr5aHj9VlfMJXjjZ+pnN9YxFj7jMXBIBqD1IPlDSJVFOJBAYNAjAX9XUIlMAEHuExT2Vb03FlTdcHQkIYNENcfdEIXsIEbGEEFlP8AabDBM6DFmuyFm4UcGFggIRDCGfZAG/SAlljYUYgB1pBBErRBQFLA8PEFB0DgP7yLDDneVCBj+BnhHb7WJxmGPdjDPTRjf3QkNXbM/PigQVzCP4iAbA0EKYyCK6hCZriMI55C9MyLZ6gDaUxEC5QAFwQCRVwAgEnEHljdKTRkXPAGUaQB9PXjPwAkD4wDlUSlVHJAC5jBEgjWYMTOWKgDNrDgWHyDC05FnQAga0GCJ0mjXxjCfdhYXGieX6jfSMrPh1iCKyyASU7OSpKCCNSAO7TAfgnCEMBcnLDHDJVBFwRCEHjDNyxmBexak5UDF/DWDZ1CT+0FGqyFsIDB80n/wRN4g5RUyQuYwZAggRtIgA/clFQ2CFpVEuEZ4UDYyx1mJKjsYt4MxCawz0AA4kBcwz8UTDPmgjJOhT2AJGHgzTSiT1zG0iFSRSVwhAiQwiVUwytYwhE0wa2EBVU8wX7RZH+BBArYirWlVQXoRgWUgRmYhwpA5FikxfCkASEET1QiwRyUwBfwAA/gAXTwQE0JgdNUgREcAXwU32EQhQq83Fi0jTGGZVcyyH94Ekc0yiJgHkeg1l5QaIMIokHsIVW8Q3LOTydYwkDgm0GUgg+SQjcAAwK0wIl0X+aImL3YCgp8BJ7kip19gAvcgTco5jNUwAOsoUEUiVZGHJUYgRDw/8DMXMAFRAICIClQXgAC+MQ/6KcSMAgHpFUlzV5c1ElsslY+xQV/5MPF8CalSGhhpJ8fDkyH6uAlHCLp/cMPAMNzfoICPECrFRM2WJZnCFC+eAMEVIALmMfszdAM0QFNvoE3qOdYnOZASAUgjRB+zkEkYAAC4MIFGMOTIoCkBkdw0EQkCMHYZJ9j+Ul2cQbiXeTGeOWDIsYiSAPHWN7gYOg/eB5yTkVeIIOlpWmo2OVe0B/91YC+RYINeIPMARz4HdU3oMAW0OQTJBlGkthuHAYENI8/vEA4KIGYzIGk0oS2bqu2iklwcIEQLMA2GYY/HMEgZJtgJg6WxkU+IEMsNP/DmU6F99QDq+qFPiCDxhAGXHIEu01Fv+IqQ8WPSdYASl6CJrRABQzTDO0XVahTUvFoGtCkETLsI94KCqgDymHfaT6AAuyBEszAk86BEMwMAoBscFiqmFgqLlzqpiqBVKENovYGFCxoDSVOWO7HIuDrXuTDIowbewnMWk6FfLAlwHIRKVDFJSzDAhzBKbDHP9yZ94Hfnd0BBJRBksFMHg7BZg2VX2zTFpSAEpTDBWwqAojJkv6DcCgYR6TttorsEnDtWMwOJX2fneHJDW3MsJLfVEhDu+6HPegmRwCdXuQDpPWHofHFw1QRpgyELCzKvxbtFpWCfWkCOSyAD1gnDH7/38uM4j8IGjnO4QxVwCneY2HAoRJgQDig7F6NBS6srXC0rpPyJw8sj1RAktd42MtMBVli59Wha38kVUOgxzOAAYBwBOD2Rz38RyjYGKIcr0HYg4X2xTSwkmAQ5xZhwtDtG0rqxSXoGzPkwAN0hpbWkBhsQxB8Z9sEphq5wBtwgNnk4oCayRGU7LYCZTTd7xzo1UDQBNoKRwkggTj4kU0wqmUagTpYZLMy3kDcLcdw1912Vig87kCMEkfAql4syjDcKxTtXOb1RRC5GC2wEuQmztFWwtGOhSYMRPcyw5yiQFkY4168MDbMEJY2VQUYAU4AweKQa3xqg6SubOtSRYJN/0VujMkLlIMSgO0cELBWcMU/qIDvwrC26e2CxCHbGChiDIBewOr7IMMiOAOMOQqZxsUhlBKZwsKisAI3jPBBVUI3kEMppOQruAFDfAAlIbBD4NkjgmcRIhUH4AIoXMXiwO1YUMkLlMAy8CTaktT++kUJCEELtMASgK027UULFEF5hN1rzuF1Oh4DM0iR7FD1ZFsTEEa8TgWGIghbllf55APgIsMwIAMrs3FcWGNcxHFc/EA3/MMrxPEllMIrFEEd80X0iB+eWJ12ApA/eEAmeEErbMDZlFPtli6VtEACGEQQ/0Prvq5eME3tTMkLdMIVgMVYNA82qK9DDCNFpocwcv+E72YbMf5DFfpFecFCxfyDM/wHoswYJ+gDwGDMQOQD520MpHDCLLNXpyHGpnEMMNRAL1+CrwIDsKYBvsQzVSAVZ0Dta97BJMINEsyAFzSCBfwDDbjTEQApNU/JXzVys/iFEJSDEFwlIP0VSfhUC2GFOtjWJm+pHS7IR3FMaDmDNAAG0RoE0ZLpvvJFKNwzLSMOSprkK/zDOtTALovAKiABFLiw42nuDI2YZ7zmKQgaAQAKLngACWyAF0DDCYjHFiCBVMQsnG0TdfXEQLRuEOuvU1CFMXSCEnhstFRXFViTP6jNfineVq8zg0jxQKQBCiCh73JEqw2GNBiCYDiCF9P/xT+Mlm4K7hgXRjNEw0AUdbudw2rByj+g5CiY6EBYAjMUQcSWxWNv8kWzR1j7HhIggBeIQhbQgAeUABBYxREgFyH7FJVwAH8+xT9EQhVcUGwM8UA0jdkoAVzJ9ZSk3UBwgBG8wDYMwfjKJh6nK1Uc2VgEZikm3kDQAYP07YuFNlWU1rgZGj+IV38cw0Fz0SP4hTKs1gKMtCbE8Qlrwiq08Cfr7pwIKkfUSiZ5Ax14gxE0ACiggihsQBZsQAAUgde0C2JUyTa5wwXtVQkwgQ2UAF0PxAyIyUDczrsJAXNh5VgUAQecc+bGeF/c0IBTDxVPhWZxzGVPhaAkNV3og6w6/y/HBIjgapEivFuphRUojMI/MMslrENy50Aa0EFY4svNet83IOEhS/gG0MAG8NCv+UmmYV+j+gOYeGrTlEPyXMAMeGoRNA3TgCu1/sMfCKmAcgQH2AB21wpGuqZe3G3mxDYd8gWqxskHWHSZ/gM03mZf6MM/9XN/AM4o8aZfiPb83Dcm5LebOPVqj4XBVkIKJ4YmvMIrMIE3oACixwk66+6dsNWoTIAooMItXAMT0CkUABKGw3UhbxMg2cBz8GQIRouH/UMCvO+UossL8AAOZB+VkIqaZLUcOlU7uzO6VvuNJ54YpNHmNMQzXHthKAyjL4iQ64WYFicFh88WKYMyZP+DMsgf4pDCCRuEVP2DK/wDJdD7P4CCBTSCOVz1A5RBWNpWJKYzjWOD9xGAD1wAKvxDl3sALjBBR6HYVRilydl5lYS4EDCNEkTLNh1yOGAFD6DLArzAxntHs0/Fu/DGPO80XxzVVOAJRYq3iQ06R9SJ1a1rLJ1SBsurX+RDvW7MKTcIImACEzDO9k7Fc/5DJTQCR2gCKFDCK1ACEtApA2dbJrkzMVnbQNgZCnBAAODALYjCPzDGOTD225zNvPMi9gWbVKKBAtQU2OJOo9bREnRFTdkAAuCOyR9PCyBXV4r3WNZsC2JWMRoEFEjsa8qcINxpf0BRPvjsggDOwWDeoi3/mhZT4yPc93DEjyvA+0DE8XLee6ibAyWYgyYsveg97V4wsGxiHQEMwRbQAzTQwGJswDVwVKvdEFZ0BQsFW9davFyjQZPUVA4AUuwAXiD4Q5P8QyesONtPxQSVB8x89xzWOAwSRrfHRR62lUFM+16sJRRJsF/EWGkVLoD4+FhYcPyAQ/7Ij8owp73fO9M3+Sc4hjYgwRH4KQxevX4tcNYjFUAI+jdw4IcKPmhAu5Xl34Z/RdIQ+EeAgDp1Lwhm1Lhxoz+PH/1tWVKCgjs0/o54bIHEH5I2Nk56JOhv5j8f/9B4QyFQoESOPzUKIiBoCMdT2yZmLPMPxRGgG30+5ehM/2rVn/kc5ct4KN8AfYv+xbI6lmxZs2cJTvqp6Z8mbY3+Uao0sNS/V/+O7HzyDylSgUg3fuD4RNCpI0gaeEAlClU/JD5Q3Pn35NQ/I2g5gvzI4QUCIZf/fcRoLpJmjmgIGiEg+NvkjILLfhDIcTbmf7Vt5x6oT2M+ZIuQ9f5nT3dx47qJMeqUCOhdgnPZXvq3zGYF2N8InCr8U6CYb2I2Nnlw7t+tAP+wzEDygMDS4x1Dxvd4ZM+eLWjwn0QjBGRVHypmi+ofMYZ6TyIBNdoJqMqSeg+zTQjKJR+w/uHHEKz4ceQfCh3sULdxfkruKekumSujt0qpxBJmcnBKI8CAEv9MsNqE2saFFxqgARVUSPjnMRRcWI0iFVroULOP0DiCg4Hw84cDHz6qigMoCjIOtwUdGCgqdRB8TUsPc5NGI94Gqmej4MBM0yogBpqEuYwkGQgYgt4UYSBSNBplIOf+seSICp7pkrvb+NJotW+OgGYDh7x46IGdKPqGjkFAe4+mLT7CFCXUtvgHUx9ukokmoPzxZiJs1PxyoL0KOoUAKCAAc5h/aAHTnlz6WYS4ewjSZxMyUw22qhyqSsSSBf4h5h9kB6oGT47spO4I7WL7B7YXJYIGrn8SsglILQV5AgkPR8UpNJSipMmHTmXyT4V/GBR2oO2CIkiga3WTJc1+ONH/6syNvJJXXkv+yWAggzdi5J9EFliAOWaBomQg6SpZYl6OtjkFvIy6I8gn7QRBwQdSJrgElH8COOGm7SQCD7UOOc3IqfhOui/KqrYwAoUP4j2uNdoIgvGpjW1rBkx/OcwoFTM1WgRYgaUilmDbQHzKkmetckUbVwbqxKkrrQ372sI+WM1aibZ5wqcjilCFIIaKJDopF4W9aaB2QyPrgbALLY5AfLWUccCBhvg5owPL9hgtfzs05Cx/ibMNzeJMgPqsSy5xxaln6uXptgLLpoiiiUb/5hsoXgChGy8ktonQBi2n2zYfunAV3uKEgh2tAD1HVSpBLSeL8eAz8iRV6YiR/64s5Rc4QlDBZ7ztFBkJcGEbisq+/p8WPvmnGhosGOgZ8K41PFgfMNKNJkiNExLwiQS36onEL5aKQKGJ/2mAehz/KWmoJ7GGVDnsLKS4Wvo0Ap6O5c5jBQIPUhRYBg6IQhMe8MAUoGEEnbwvVZ36x5KOgwKyZOcpHduIAs2CQrn9xFVRAV7wFuG4evxvIxoSWDL+IYlO5A8ormCCJZZwhKIERmx8MVuVNiKUyjChFTTYAA0Uh79gXeo4/tBeVaRIFupx8Cdc5EhU8OUeHhKkf1V5WrA8ocMxaoQS2vgHKbi2jBf07GKeE8ropHKHNzzkH1P4hwACgAWjKOBuYPLgqP88mBsfVCBVYFPT6AqUpuFZZQBAEVMzsgK1XhhvjT+5RAr+sQSMXEkQ0OvbCwVxvQ9AoQXnsYUAioGFFgxiIoIADwHeJaxyFScn06NDRqzHMb4Axov2es0Lq4JMgkwvWGKqij1sOK6fOMMZyHCmsHRRuTQxQzelsFMiXvGCIwYNLferCBpocJ5isAIGL3gXNiQiIwWAkFyFHEgiMYOGm1DrS1EpDAGGgL/sAA4b2jmQ58xyIDG4iplrnKRVJMARWPxjov9gWqpwqItOcsQcc6nLdAiVRcwUxh80MAYr/oFSBTyKfh+gw0WE9QLUINA2pSLAN/YiGJEWCkaOJIown0L/x3/4jn6d1Ec9bHicyQkrGTgci8LIwhbMmGhPIljFC7ADpiIkAKX/4MU1HvCBMlzxH4RM1ahagIZKKRIFEqkMUgTEUAR9YAiA4wkepeK7LyqTeJAgCz2n8g+q/IMT2KzKJAyGsLEEoxOSUAtZtpWRS3QjHC/wxhAd9ACMoJQVskgARj7Aqjt8QB3kEVaRqvgoBW6DrK87HFLeVxnfuQCoihuICP9By7PsdKO9JQgnOTIJYEwCsZMArlSYI4k1JGJOT8ETKUChiVeYqBr/6IYIEPCCjH0RM2V7BhqgUauBCFKEH/sACqSZJpq8ACNKOg61Aqo77r4QX0MgUBIHIlTf/+YGsPsFiiUqp82ydGKHCpvaxDZCVZBeQgSluEQNImGDIHWIAHcoqTX+YQtuPaAM+DJbfz20BRvgpYppyAhvPcRX/+ZGEb0lVsIIUoDjWgWqz/lHZDPiNoKwhRTKuwQGtCsoZfJMI/GSiAKucQwNa9ABPvHJExRAUzBxYMSodRBDk9mgnh1Iyz29zTeEgh3MokW/fDvcGjGBiDEiAh3DKs4IBkIdgpBCE65oHUH0hCe2vCANZZaK+YYa6G98IA0taECGr+GDMtzhSsV00Ig5ILvcvEyLVSLyz/qiEdlQT016rV8nf/GIXwzkocGq3Iw7pLyJKfgpbqyExIB8WbTwrP82t9QYAbbBgXUe2m6ahtqI1Zq3MRIoKuTrG0FQCJX3lDKetiMXpc9SxqpIGy0CVrO8UjQKOVvlLppQBZ+PchZUtQbXZ0scCvxBAlnAYCB0KKqjzXWcEf/DBiDG3Vh+Njbexk8jWSUIvH/iaS8XRMVS2aXVstEBJvyjxWYpbG4eaznquFEqHhiIJjRBCVxY9h9iTErBCeUdLYmhAkdgAgy0Uga1/UQFLjKARi5zcLS0YMQ2kLLlijoQVA0RxRphVVAT+vGJFMXZuh3LyzWCdKnYCTPUzo0nHiHgqkjdQQp2WyMosWdTbSTTA+n6RuKFjW2g6lUtUML2/kFbQ00ECgr/gDZBcmacLWCEysErd8j8DKZy95wgtMWfZHKjdIK8XSNEACVaIrdi24iiEqLA8UBM0QhjtGAbRXGhfK1SBkGAmSKn8EcC+EKHFf4bGypQx8s65cEXSBozNvAHGpBAeDXRMjt5fwrIfwJyIf3EO7gtjr0VT+NJMIIRkvjDP44PY9uY4s7/iPwE/gFurh/7xGb2eqFKeRuRIUE1H8BG9us1kLVmZPxocRJqbGBlA+3lesTUiImfkkXbW4XfYwEbIwNPE5mvWJsarYrCmIP43iQjoErVjAMHjOEFJuz2eA7zzoxQoAANvqGtCMLThELzymre8qZTgk038CP6ioAmZA8t/9wABVirDAYNXmrDVGwP0HKn3HJDKBzpJ34uTUZwxVANKIiP6lJFFAZixGpwLFCsbAQBG1pABWirlGRDmGQEGxSgUlJve8rPNubtBs/iGzpsIsDMdPamAhOq/mbtbIJvIyzgasaoxpCLIzoBzu7kwM7i8TSiFXxQAXNvhKhvXk4BAh6lMFbu3zxmelrDCCjt9ejt5lrP5vCpOKDgFKbnFFAA/rQjYzwt4DLivnCP9wggCMdwEzmCEdhwIOKEIAgGztDQOKiAIPiMMNKkAh4AAnyv/f5NQIgsvchP/WyjBYwAFx3EH6BAQFCgNVoDoYKnbHKOEwtgIJTlBxSPAT4xI/9GIBQV6yn4BC3mcAbvzVX6oDIqA6/mxa1YRgXmSf885R/UwQMH4giOwAZ6Te7+oTVMBUYu0UMYiBPl5eF0A2IyQln+QYD+wRUghgHM4hPgUCMmAAGOgHNsAzdgo6dQQA8Z5OuqRAYHwnRabiNo0TbQYPVYLzf8oQW60BsIQAwEIQnHiBiBRxN9qwPoMR93SBRdwRw0ogYI4hX0ZCNq0vl+whQ+YQJUwRhWJfyqgkGKcCAeyGMeEQKcLXoIZIGyr/NOgyMJggM4AAmAzzZQQCgNyuvMZvRSRWOeQu2qYv9WEkzwkSNCMSOwphT0hNUyIvJwsi0HAvr+QQgMMSGNKCL/TyEbu859pm8ohoBeNOIic0MwcyOXrs/XrDF/xHKjVHIsOSL58mQgKsEAB6J7CKJ7TAEENsIUBqIV/iEBgScxYwQo0qACBk5A4lHYzkL/imAj0QK3JhFo9kvwSMUxN7ERxGEgbhIoGqERKE4jVAEH/gEVLqAuR0rsqI8AvOEm8s021mrEFnMsYM9yWmujXg5vOukYPSSp0mQAnyISphEoLPMpJgAaJm9nhO7McI9aYGuoUKAPMoZBKOK+yoKRoGBJ0DFvolMqXmAKzQIlDYWfbDN4lHFACaIlAfInPkHHMKMVLqAVTGECGgEXcKAFpucvYIcrY+M7BiINXPF2zK0s/+aHHFXgAdBAAzkSCWwxTbzPQImnA9jERQeiFDViFY7DFFABF7KAA/CPy9QT9woqO8CDEQ9EG4nwElGAMk6hAp6wCKryr8ZlP60CmYQSTDRURg0UIL3zJz6qKhj0KaCBHjigDB6IJ0SzKmZDlYbABSoAAiKilKjlTH2NIrahDE6hHI1DF+0pN6ToA7ZObMwG4OKPL8YMSzugAHTB//brN9VkPBV0IAIAA2jAB/Yov4hCTsdioXgmOyBDGNHCfHDJOPxBHY9DUGhkcDB1Sl1rIAQvEcfCVctCSjkCUR2TEQCyLM0CLjhzILQhEoBCFThTAXygMuwoTQrjAe5AGzEDtv++QZVWFVbHwh+KwAqF8A5PqEMw9Clo8+iCRzuDhy0JglF/YjdtQy7jzEZrtBVwoBVm4ASOoAkI4i/n7ywEgxHTgKVwpwwkQlIK1SzKpT/bR6hgwyvn0TiQYl7JIgoFxlvxgSNigHiCYVc1oksJgkaBIhiqghm2jSA4MxJMQT0gAzA2b1Xbh7a8gZG8wd/odZiIcn4IA09twwD8IReFpVlTEygEAiHTBFqTrmf/YVs5ogOUsTE34tp6C1ylQk9egTLLgkHV41EAQzsE1EA2dRsgAP8wg8uKlGRXMzSm8iRc1Cc8Ln/eTlY1whUOb8VgUjdGoRS4Bi0g9MZmQIMm4gn/VNGRYENQifJwBEEQVAACvEG2dotv9iKVBuQJhoBKJu0IZMpFT+EOwGATV9QsiBZLx6IVIuFLgcIIenG7pNYag1Qq7upKSM4b+qyufCJVC3Yi6OAJ6AD//OE60QJ9ntS3vgFVGERvpUJhCcIAgPYndola/0FoOyAF9HEgiOAfHlYjzoCHqANp3yMSHPUnZuAIvGHz+tZMjQkbAG/vpILfBONv7hUFQNILK+01BIKR4oBJMkIEpXMgOMBUemLF7kAkI2kln5QJgKEDmosgfiAbMsJbMwIT/DdVNhYoTsY2cOATVgFdfyISSsB6JUJeC6PW8ktLIAkoki1oVuMUvOFR/1LX+jDjDXygEP5hEwYANMxWI2jiXQyH76CG6FbXX3+CZ23D3lagT/6BCHQgI3p4I8BhIJb3Hx7hETzEVznigH/CFaa3LABgIDT3HywTLjiuQGYDbFrDL6yVp4qoXhwgVoiwWXXjZAG2f/ShCHSjS3QXaq60psYxWE5geAcCRuWEIH54IwT4W6vC8ZpYNwDgFeACF6IPGxLHU5XiQBDZDxcqY1ApMiAA03zUycZWg5uAlWYAFsjkBuhNeFlo+tpRTTjZ5xD2Z59ihbFzhasCawYiBdLWKjqgcjvEV8VVefBEYkTBFLThLpD4AP9BkGeAAwaBtkyJ62AQKCByMFwAMv8IDn+OmTueAN3igN3+ITio4gkdBIbziVXVRCx/1yrwCTVQWU6Sd+EIwhxgeSDUbIgzwmjVRGL/QU/I1RVMQRUa4RUuAEwotAVEB1U5YmOGAneNIjtEE/7AzHYuT5nStAl8QJqiYSMUACZUkyOMcyMAbTDOAjUMoHbVh4W7mX3DsibIwuL+AQjuWCPu+AcY1Gh1gJ3BRHOVOCOkShvo+T2uQZAp4QIqFP+uZfT+Uow3D5s34glE1if2chvA7Ck+IKnpgJUSAE12QUy+whkG4KHj7SzamDaq1Cw0mixoc/862iq+miw6IIfH4trUzBKaSyWPkaUFRsOCBfpwgR6m5aj/tURfwY6EsGx3KgBWtNZQcG2SDcUg0CCaB6KSKGRC+uEGaAAjoE1KtxHkRtZyu9Y2kteOw0eyoQYBcGBYiQZtMIYvxBioNeKlhtVZq4/vBOMBLmN4ruEf9CEfjuoapKEE7OllKtqT6Y8Avi9VMVs3VHkgLCBGy6IDECGPe5sjjOHQPnNapvT7ZAswMWNv+hZouDGof2k4BqCS+CEjDNtC+sEejiGtaOIBvsE6sHUg2OC4N+LlDEB4bdHiyNqHW/knzrkqjFu9/+EaSkAaQKsqPsCo/UKM0eKmHKBTyen6em4bREYBQE9CMgIsBgBDLCoB4mBJAkVqO6QCgsBBfHA2/0kZM5KXTaCIIA7vh5vvWR62BoQbKAj4H1ocvwlCTGxgKOANy/J6Ze+1QXx0LFxFBWiCOPgBQghiuwdAyAeiEPoTXrmWHWGcI8IaM04gjjniwOykvgNYF9DBiBXPY6XCGARZI4ohv6+hGBLgIKsVwPlijZPiG8YgVoLkvx2QiIqiAvDJTCaJ2ph7AUVbS/Z8T4Eih3eVnMdocn3XKnD4KbAgvjVCBKTqHwo0Ix6heQeiANj6H8DhxYMlijXzHy4gE3Z5I6DhH0JdIxpATKRBxgMXrp5CXocKukfoA+AVMAp3i5UtbOjJwauiAp4ALG3jZn/3ZRL4PP6B6VZZYA4gI/8mOpyBYr5/ghxGXAQsgNn/QdLlWCMQocUVNU1M4dMJ4suBYtShIcx/4ho8S4Lvt/qI8C+/rDrlBzwegN3NooSxFLAi6imYwMqhpt51QwDKQqTn7B/69ymonSAwXV6gIRI8szgwbCPYLRoSYN42dSIEVlkZamMaakoJ4FFyg2f8QZMHAgYGwOliRSrmj7c1IpSfgtgd3XKe/CzqAC0u+yzuW1iEs49F3SpwoRiMoQRaWyOcKQGQACmzzJa4GKl/grWGqpjH4gO+gbZ8wKz6gSAGAPQ8BAWu2/wi2iq29B+kXUYVPVmsIuBzowMaTlgyQU124R9SAQZoYAsqtYQkXlX/bg9xASc7dJb3vkjlQoYDqF5ePmAMwETfq2IF8J2HtrrJg0fcf6IBjAEGsAANAHQjLK+ZNS00NViEX8MF6EC1JRvE+uvrg+/wNwL0w14j3Noqsv0nyl5YlLsqQl3cW5sXjOGr2G0XqIID7v5LIl+ZsAfzh+BPM6IL/0HJT4wARMiEr6Dvg8cAtgBowxo1OGByM4LfOwloUT5V6iIF/P0nCmDgDVTnOUK5rwEZQFaEBEJAWSsTBYojOA3zpQL+qvofzICeFjrqXfN1bhYzWj4qBWb/NwINAMLAv4H/WqAhiDChwoUIdTBcmILgpROk/hH51+Ghxo0cO3rceE2jsQYz//yheMJw2z+VBAmIISDooZiNRxSq+FfhFMMS6tSlSVPz35iUHz8eTOivqNKlBLf82yLwKdOBUBVyINhiKkOHGiNq5Koxo9axD1txNFbM2MJi/9jaSoAEAh0xT06J+fbh20ICBAb2XSjor8cqA70NfLCRQJObOhd+EITtA9mlUSdrrTwQs+WCBA0knfyD4Ip/o5V6/Vhgc6TND3FptCaN1zUY/ipIljwz5sDAgf/1FYzw99gKDOcMzEvgw7YhDIP4Za3waEI0aAwcNBD1M/TtHaNc5d5RwD8LDGkgjAE+/eRr16QVY6UESVaNH5L/nanwttIq5QaKSQOdc+otlFSB//9oN+BC0oFn4D8LDljaQkAwBc4/FSaonjQaarjLgd/w9Y9k2CT3j24bvRQTfh+FA8A/hfzjzgLGJcaRgBgmtIU/Aml2Y2bpOeXUdEoBU5QeY53R40IwjDUDQdeolZA1/6hFzVsKoNHEP08MEZOJ6nERzkBzdPHdRk8I0lh9QiX5EIJsMsQjQQ92VOabBJGnlC52LpRAk0p18Q+gA+GwUHupIDTfPxC4QNYDNoInmAPgBelRnHt+FBWlCHmG0HeW/nNVnRqdMBmel37UT4JQIhPoqa6+CutAniKk3aex3gqSelEsiWuvvrK5o5wDzemjQhL8eqtryC7LLK62MuSmrFf/JcrmLM0mKei12m4bnYMIaaqtedyOS265bEbrEbXmrssQr+xyi+6pxC5kXVMMzUsWCeItdAJYH+kZq7LbwRDSuwYfPFWEG5EgykZYIAyxtsFGzNR8cWaFb0Ik/LPxR7YoZKpCFWnbAHfsUYwyrqK+2XHKLr8M80cr/wPKjSKQpknM8hr8rM5CejRzy5Od5nPRwHIErtEdVZPQvh2xwlRqSicE5VRReBTm1ForFceNE0JMWMkdFUMNNA+ZzVBQvXJw7IEK9dyRAUn7CuRHdZMV6tYelSASQ1Vv9HdHs9DQQAIENeGl3jfOvNlVcysl3QGK91gwWQEoVFMTknnEBbt3/xuMxgv2Pj45s1IqdHpCaCPEVlsb2HL5QArM98HmHYVDWFH1KiW3gxknBHdnv6o7YN6lQ1e52FpRk1A0GykwEHEJLXG0RiuLSunvVKmnvVaIbNa9LAlNcXxHIV3TQOu7qAVN5We3lbpCqLRFyz+0wIIQBDdplPVG8RrFEYEwLlbBq9mmkOYqcSXMIjormfJK1qT4LeQdA7GWMagxi3QgRBqysMU0aOGMgeTjH9K4wghfYIR59ecfLdLK1R4yQBxtximi+1qShAa8Tf1PQQrxR/fKZxkHOmkggfuItRASwoEcY1/IGOE/RggDQBiBIDNCCAvGsoXqGAUzc2tB8AgSwzBmfZEgSRljQi6klGNMbknKQ0gbO0INshWFVdIYAFle+JA+IDAhUTgIuIgHsx+WLiAAIfkEBQ0A/wAsZgAAAG0B8gAACP8A/wkcSLCgwYMIEypcyBChCU//TDScOOJfJSQ2jrR40SLKloFoBPpocSADpUquRhD7t2bhiD8vF/4hKEniv04DZ07cybOnz59AgwodSrSo0YOI/kEUusDVAoI2FCiwYaOFQH/+Ql4V+IKJhQU4cwhUIJDJ0EkFl95M1JNBjH81jsqdS7eu3bsEESHCFBFvT7YCLzGx8WLFPwUttCb0NzCq2ac4LeFkaOnfJEYGPUnytLRXUoKVeV7yS7q06dN1JSb7l0Ot0ck/R/9zZaOIQNsZM77gwAHrwZAvLD39yagTZtiW/0n6l9TE6p+lUEufTt20on98EypSBLGWp889XQ//bTlwgaVlNsZlGlfV6kDGWxGiacFEQYbhhxkmwiwQM36lAqGjEHI+jVLdgQgmuFN2C3nCoEHgpfUIJoo8QlQnCzT1zyWjFMEEPTZ8JBQH98E22X8CEZjiPxkINAlaLIFjE0sEYTibT67c+I82BZmj4I9AmlYLT4hYiNCQOTwiHnZJNsSXLgj9oUBlIlhSiQJMKDYUiZJh1qJAloTGEIwwDqSMeGw9tcQSPeXI444DvRnknHTOdY5PmDwiwU+czZhQkhEO5J8CiVhJihHwGdDTFiIS1IJ5k4UmHEGAGcRfQZ2c8dCK/yTi1CU57kRJnaSWaioLDA3J3D9KDiVJJ4kA/1MJE+4F5QN8BL0w5UFiLrQGMJUqVFmvCoVq0T+NvNlIJXKa6uyzdh1gw3UGPUeQqmZMpAgmygRKqUVMcPCeb4se8c9HjSKxRKXBMmRcaPhN8kciX2LG5j8e4LKQNqGaUwklmixUCbQEFwyUDf8ccFAvAiHCXU/XbfegQEYuJ5h7/vhgLrn/oMGBD2jgetARWv7DGBPmGSSmigyN8KVAT1kwakLG3lhJI/8EfFCzBvfsM0JGKlwQw0t5u9MvmEzccKekKPCCQGggYURCaDxgREiKfqSoQEf4cNALOYa6Mk2XToQTjBa4UkxDye4oCigemGPKP6b4KJArlNj98958//+DqpkHWQglXTkQc4lt/7xwgislG4QGYe8RhMbTjitASpiJZN7pP/wx8uJO7TZkSc05C6RNI+bkTVDAoPTtOpDUFiXWTizEzlAGlhCBlQ2iqPK0yAgZgShBW5t8UBE4/BPmP6Qwv3lBoQ/VfNgDHxSwNqLobfrr3P+sTMPZLcmQ7QRNzBYNlGszChP+NLrQCzYAH5/k/6SX4ZXLM2LJTCwnxFbZ/2DHQUZDClIYS3v/UJ2PEFiQZXTvgXQhH8X+5hOj1QUIAlEF3V4gv9/c5gjty5W4CsIBc/2jBUhgQiXMk4jmlUdgAxtOmRYiG1KAwhU6K8jcTJFDgvAMgkCsy57//EYQhQntIN+rizJ+oZcViWAU8MMZKIrQQcdB7WmNa5wP3DcfJDSFCbhbIUMG1rzhVG8i5nBhQmYWxDZKR4ILQYSq5jLHgZyHCU9bgAFH2JMicNB4iePjVab2tSIAYwGXeAoiBaJGgrhCbGz5xFB66MZKnmaICcFkQXLAsH/A0ScTclB2MJEInNiACT5Cj09ChgYFiOwI7uOaQjjgoWWccSCXMODdzlgR0jWkkSf5BwOBIgpLGpMoFBwIOO4yOOW5okXusMQnkFBFhIjMB8PjmrjQYEJCLuRx9HBHGsN2iUpcwkcWiNOG/jGBf0RnIrociAeOSc96LqQDGSCFNtgn/5BY7mQ3A3lBSLZgQp7Q0hyieKQwXTEwc5DlH2xq3ivIYc+KBrGTd5nAqFxhAY75hDElJAhjpiaiaopUICg0xzJMYcBQ8asS+jQHzgLTEwsM06I4LU0yIXZEhiACLc2bgAVGN8JqAq9RHwmJ1zpmrskFciciC4kCcKCNSqTtjPlCVkFcIQKEhIqjCJlpTsdqF9slw4IGuU5P9ZNAyyyhBYzxJ0EI6oPexJVqxuNACxDGE/j4RqrmSF7a/pGFf8CApq5o5EJqhrNiFpOskFWIDXaqEDgaSSCfBErK7hO/hjDqCEdAmF0V0gIQDsRcWDQpSPzBRw7Y4ATmoIFA+vGPa/8wsnlmIYUIoneQxw4keZENbhF9Qr5ePCIpFrKJBDObEP64oggDHUjxFILNE5KLMX69EyEVcAp1qOMFRiDZREALyH8AIQEw4AUMrtGKRqTTAi7sRgoUYo4fCsS3WZhbTz6xjNYJt2+UnYi3qHVZil2IGIlIARA4iIQtqFZye/2HH/9oEA7o6gMf+AYBhtCFbTBGvBMJCa5sMIMZ5MwVsmHoPywwMNkYJEcW4BFC2yqQwtJtKAYSSI7/2zelGaSOBilAHR9RYJ4AoyBURIIgFeKPFhgBCf/wLhKQgEUk64oOgiCAGP6R5VP841bnaoiII8cBHKhCBKAaTTppoNiJzPP/LpLkMcEUxoJH/OInj8AoX15mFMDUArpIqNVB/PqPJ6tjEAQQCAEIoA4bhOTRfiyCl/+xjUSfYhuCeIIKwDyRFxQUJExwxeiaF08aI2R6AhmVb+uyYzkT7M7hEUjSCDLfuQDDE+OoH+W+CYUjvKAL/0j0NsrwDw2rQMkCgbIRVCCIU4jBy5MO9imw0ZudiJZjLyAFfCnhitbl0NsDSWc1/uFfgXigmKYQxaqPQklXT6dxCUEVCzTJFzh2Czu12A5BMCiXAkyCKg+YiNdeoAICCILSA9nGP56hAnVMzgbLPrgghsBlQXxD4VoudlZ2og4FQAECnH5B2niEt390gxS1/8YlqRXbusKu293B1fdn4MiXmgtkdgXwpEGK/Eu0YAJh8EaI1xL9DYIQwMsEwEYFoKAOJKgAGwI5xcGL/g2pE/sfp4BC0OcqEA6o4B8VCPYDbmWDEnggnZQQhVUF0lVyz0axMP6HugkLcznfuRM+HognFJEDZUiEzw1BRBITMokM1OIXVWlIXE9BAIUfXCBZLjbkv1H1ZxCg6o7/gLAxXuxTBJwh8DGXCxbdhDJAwckKuEACH3mJKt2Nkc4byMAaK3eGAPco5a77gQKMHRYpwmhJIU9DeJ4MifAWfFITiAHg0zjsEgAFW1b01SUuEGwUPdEfYPyit59o7F/9HxCAqv8ZGI/po1cgDVZTgCguoI1L6OAf821d63J0y50AFxW6t2gt0Ao4oJiAWAPBAAJhAtlATVvjUQbxAE3gAgchcReHcAoXgZTWeAgnEB8wEB9QAdsgVwbxYRPIeP/gAihABwTwAA/gBpZQQK13YrkHFDaWf/R0ALXQLUDmF/hRGcuhANXWdUYABUsmEv9wByiQaIoWdVh3hCDYfRMoEJW2fRiIAttQAQ/wYAOhDpQncaeQhdtwCijwDFAABUbQAZ8ACnBDhgnxgg2BhjAIRBSECHfCf3LxFKTAFjhRC4lAOVnhBuFQAkKwB2igJR8RBChwgRZYdFxWcQe3DU+QcIJwcAT/QIgW+HjYMHEEoAJ9sBPi8ngfcHAY9g+biHWepw4IQAlnZwEngBA3tYbPImhAITSwhln/oAxicR0YJRfRcwnGIQnYdQRz8A9cUAX/IARmgCsh8QAVoIT/gAIDAW3NdoTBhg3Y9w9b9ngCQXH/sIgfMARloA4PJjKLlmVZtmViIAaCsGVvAAFYgAtvlhAvtxCzoIoPtFYEoVYEIT5FkTILkAiSMAJ3hgZ/IAQXEA7hIBAXsAeCdARh940EUAZBYHBSx2VD0IgDYYiDkGme+IhE6A2n8ASNSIQosFQLoSgmdAoqMGmngGGJJggf4AKD8A+4QIq+hC//IFs7YQvwOCc//8gTzDUQiqAMd6J3BGECOceTPIEioxAS4dAD4TAH5dCLIVAFQgBCaOADEPAGBNAEXLaQPsCA1Xh0GwZ5AlEB3mCMhEiNBFABLnCBjyh5gsCBVuQNafAPaQAF2/ANyqhomqeMroALNNAIcLOOBNGON+kz25QQfDFDC/GKBPE9amECfkcxPDcR55EB/lAFJTAQIRAO5TCQ4VAFWYEV/uAA3YeMnjgQGLmFCgd2Q5eaiuYNxEYAd/AB26B56gB+POEDfeA1ugkBafB8rPkAUKYJcHNfaaeGqCGYgykUWycQEBGZlUUxuGYCnzEJOWACa2CPwkJT/xAJLUAWwDgQcwCMVf+gBH/AGCETBQkpEEOYmmJAhBZIhAf3DOfyDF5mjVnmAk3ABwu4aP+ADQrnlsbzYeF3ibYpEt5QgYWGC61zboBJHcX0CWKVnETxaHhRnRCRHWhlEy3iCYhJEAsQDkVwBDywBAOpelzABf9QBT0gAebJGCYYlxDgmplGhJBYaRtYBhmWZQTQKIkmmolGB/8ABrH5jGXgBiFmpAkRBANRBg9wAWw0J/oloatUEFGAF55gE7WgIq6hC8nACJIwCRAhfMrDPIlQChzVAj1gmSc6EHsAlXDVMRzgBm6gDl0wdskYde6pcMr4Bj7gDU0An/8ABWHZeEnIldeYfU9wluEHekf/sAMFQaDtMwhXdwT6gi+3kAU2iX9SepPe8Q+1YBOzMzSr8aXLAUByyAQeUARmEAJc0AWCEJd3AJcfmThdUAVcEAIlwAWBwAVoEJfbgA0XyInBdgdvcC5gUBBF5zUPUAZW+YgqORBgkJaP+AansJynNRCL2k+M4QZHtw1IgAByV1gCsKkPxIrTcWQDIRE5wAhrIIvJ8Q/5OKYLcALGoACsKpti8AT6igKnYKT/yAUloJm2apkvUGknqWHBFpGpyRiaZxBdAB8+UJJhh3VJkAQCQQebCAHfMAaq9RFxSRCQ6g8QUAEfAAUKYAxZgAq3R67dw0fWShTWSRAANIAdc0L//wAMmJEml1AZJJAANkCyh9qRWncEnaAAVfCU/xAOIfAPy1ACR3CgR6d5iyaEbzCFDbuIxfarH3CXczV2uGmxdOBXPsCnoPcAjioQBDpdysgEuIADNsYKE9EImioQOKMNT8qyqJGTNvsnvReLPxGqNYKukSMQy2AeFWEJuUQCtvAOMFAEPqCMi0YHQ3AKPnANQvAPc5CrfCgEc6CUXPAC0aavWfYGcekPQfAANCp10QYFVeQP/UBbcRV+xKa3BOFNaMsoQCgQNAAN7cQTxikQphCheIsgLxuUcwEbAjgQctopo2AJK2ABAiAA0SAAM8AEPlAGLrCIgoACEKAOb9AF4P+7qlUwBnDlDyEQAj5gkZD3faYrEL35D0NQlwQBAXlwD/cQAYVQvxEQAQlwBTdwBeeSBkFwB83mDSbVZNlqEBCwaEZgDDQQAELxgtoQZ8NbGrxhEOaKEJ2wHMtxJEVjNpzTHxXRMaxVFRyBCyQgALQQC7SQDnHTAhXAidAYgv7ZeBUQBaD5D39QAkvgA2gZbAf3sUoafkHQkAZnmkWXBoVwA/UgAwlAAf9wAzfwDzJAAYWAK0NwdKeQBkYFkgTaKGOAAt7wdbaACjZZweVKu3zRwQpRCxbyPbVIeMXRCWsQDFYxUFA5B+FwDi0wA7SQCteACjalDS9QkbW5iD6AaAT/4AIVAGYhcQQ98A9IcASNN6QE+g+XSAZRoKR3oL0Il2EDoQcLBmX/oAH9e8VBUKUMyHinIIXMVxAeY7vn8hH+oA4TawyicMZxQJMKoQrIicbQAiVLMQ65VhAU0pOIgJ014jmcMwIjwEpu0ANcIJBcIASdYAMADA2UMCpzYANxSY3cmml8ClIf0wItIKfqMJv/gJX+EAH/wA+fRxCDiHTYCH4kcM//oAdo8L838ACKAgF34Ikf8ARlYH5c3E8FEVqXmMD/EHAE4E0QLBBYwBC9C8x00gIG8LKX1ZNpxZw8MRmMsADD+ALh0IflMBBKa2E8QAqjsQBLYAZHMAi/OhDA/4oNW4BNa/KLv/gHaLANdwCbxHYF/psAVDrAAyGRsokCWmEHAkAEaFAP/tAHStoHarDOsdmwFke5B4Eu2hpXIggFL1ACtoAD70gXxVTRFk0atEsQzikQvaAM2yERnlCDljIJ9CIO8CMECNCUBBEOANsCRbAKoTECVQC6nlgBEKBwAbcEADsHc8Cq4YAAQrBpTXAHx7oPAHwDGvAPV8AP/1A8TzBpUMdlJNk+u9EHR2AAShpwAR2kozdpieYNIAeS8zsQDobJKNAEgipbtxDRclFYqNAIUZrWd5HBPrEUs4MkCzEJkpADI3AOLyAEJ720A/mLJy0EQtACHYAfUIRpjP+XBurAAUgQsJxZBZspEK2ajC7ABgOh2UQ9PwVBjddIAEt1B71ZqAIhpLA52pgmCBBAUCKiVKkdZsbzDWXwES8AAwIwC1ggC3IhChPgAcNN3MUtFJ4gFrOjoQBYEMVhCSMQ1kl70tOs00KwBGPABeXQAjWwEgswAm4QcIKAlRwAjH69tFzQi1xwAUvbw8k4Bnz1DxEgA+5cDwTR2hpGhCB4Cs8Qfvipo8H2BIQQpE042hWAAlDHugEHiASRBlhpQv6ABQ0gAMVwxnMhvBROJ6pSC2w8EBnQP5bhOSNgA2ZwuQTxlI/9HkZgAzsrgJeABDBObHOeohWwBF3ABmxwtL//iABm8L5bAAS+IMlT/GUmAx8QUAat3X2QmGgPcIkLqGjuSQYUOHHh1wRjqQ6xRFAD8XmfFxI2IAQCEAAOfuauBhihgyGd8OFCMLDo/Q8IMAccAAFBAAEusAVIUAmakwgLYIymdwRK0AVoOZsu4AKC4ALfkJkIAAVpgAJ3oBj8JhAPkAZgtgV/OppHZ4Hq+Q8HepFfeaxrSdppsAUO0NA+4INf9mgg5A9pUAFSCDUDYQzG8A+0UBBwGxQaRBS3IOuk8QiAN0OwAj0ZEOc5ELBJS+O3+utkEH474AMmUAmVwQgMsABHcHBjAAXPsA2Wl6iLlo2rirlHAAWLCARMgEHj/+A1Uh0Edglmd0BsF1hp5k4QSYwC0hqEFznTzfYNW5AGb4DljfMNgyCFjWMDMIAFAU8Qx/ATwZtAv8sTczu8xn0XfucJnfAQHYqYCxDScV4F4IqiTFkOQjAGwA4BF08GPnAOYsQ5l/ACB4e6wcaf2HdwwDYHKPoP7G0DAmAHViHV/1DQchmFmFyB7omjB5GFjniBTdCwkEdxPvAADQdSCI2QX9coxYMG1wADUy8QrBDrPYFuDYrwRlG8sYbcArHmBnHrlDOQ5VAO7tCqfUAG2ApyNhAMh6Qc9fMM2NAH2rf3nmiRjB0OPI61ArGoY3CSi2aCKMDFKV+Ez1oQiyxtF//oAmCAlf0Z0B/gn43sD1/4DyAWEkZg3FKlBAIgCzZpC1lw8AYhAIDQEOr2yxMBDaxPoakBIADR4d9AggUJAkuEhMvAcO64hOPCYSCZgRCCQDBSacHGf5eOeFMB4dQ/Qf8I/Hsy8CSXckKOOPj3wN/AO/8gmNxGwEGFMWn+VYAg6KRBogR97PFWcluTJi4IfHhC4KmgbXtkIvnn74i/LQRtzMxKFA2MWbYICiiK6p+sogRNtYUb99+sY2tvebglV+9evn39/gUcWLDgTv8STQr2okqIcP8uDOwS5V8QgpQhtNC2YCCxUi8EeetjcBtBQYIehjBZYWYaigOfuPhwyo3/EDdpnqQRJNKFXtguHsQc8uQDgScl/32IjeKbD5/+XviwWbDFkX9o2jKZIWDWQLUEpQ3cDm0w4Lr/aGHxMF79evbt20rkm0HSpMA54iZK1GnBudnu5vwrZw4FbrJosiAuok6jBYgZ5YUKBqpAkA/KEAMlbE7xZon/2DjiDQi6CmKHNIJ4ajgz9vjHhlM+yCmNk4wrarSaBlooKQK2EUooFFB44Lc0RvLBHx+66sor6Ir8pyvrXmiAFbb+QcsgfQaihpW2AnDvrGGezLJLL79sL5HBEPmHPoIyMKiwTiRaKIRlEDBDMgIrgoCDBUZIxBIGLHlBnReoM06qF7dZqIQj/775wB8IPqDsnyY+kNCbCoRAwAZvttktDQiGIkqQOz7w5gOftnlBiH+QEEQMQb6JMI0+HvBmx28uRIOroh6wrq0ToJSFllm4JAqZZsBsixaziEU2WWXl8sSvZpsliJGCMrCkFm1eMOKf/8q5wB0fyLhpoAOD8KGTUixZgAFGHlCHg99SMsibxf5hY4wKWKvgCRRMGkqQ2eZAgDqCToHAKYJGG+iDf2r69J9tjvhDiB7McGHf3/45hQ2TTkFBhQpUsG6LIYdswbqv2rIhAVuGoaWoLKb5Bwa9KCkoL/O2644vK5fluWeflQFskkkkQZMgoYleYotTKpjjggDHkKwyCP/I6AOJSyxJZAQkejzigZEIEvSkKgI5Io1tOPaGKNm4KIEghRP+J22DTtpmNBd2M+gCJYDywZu0s1UHwzQeOAI6HwwXeQuBswKrIDSwuMa8f4YxCIt/zILFLYNWGcgULMHDohgcssDi2L9M9zl1n62DLzBMknnEBMHMNMgESYLJAQIUBKkinDm42CKIB3agjAzhj3jFkn+0Zu4BKEYSo4xvvhEECqa5WMLR0c4W5BRsBirDhS5M/acKt/G+AzbkFGa/JLwHGuOBTnjg4gUononpH+h+61Eddbo4xcX+YYCB+IA6RyhZ4wiCBBgIwBbOGAjqtvMPCM5FFQMBwT+Y0Qj/DP4DS7PIy+cEc4woqc6EJ/xLAbJkgsJIYg0ZGMERPuACKHChCnMwQ7j+sYMdQsAHYgJGJZ4jCB9sA1HHod4/zIC9QGzhGwQ4Rd0I8g2HEcAFbPsHDwRGGQcEASbe8MmnZjSZf6ThfQNpwT+WcYE2PAg6/+AAV9Dggwd8QwUFIRJf0GAMAciCFeUhynestB1REISDjbggQSynHRQ20pF6aV0j6SMJRtBnEuJwwwN2h41AhEMIURMXBFyVg1KQYgESqYAPvlah7nljD+4owdZwEkXqYUMQTzjFM9ighEiUgAsCdIEXybCNNMzoDmN0wTEbRZN//KENJSiMDpNkkItB/wFJaIRjC14QloGARQEDaZlBbCELtkBwSsX4Bw5aQZAJeDCRZ3lkPOU5kEj+pRaSyEEt1NMJaEmrE/pBVwtWtI0KcCEEbOgDRXi4AwhA4AWVuIQZ/HEKTe3uA4j6hgu+wYULVAENDzLJQEZTGgmlgXz/UEe4xrjShTkgmIw6Y0H84YZg2KBtiyuKyJbwxoGgwWQSyZVBbHANVvyRKBMcyDDqgs50iiILosCBKIwBGGQYhJzzxCp70rieDMhuPAsQWifyI6YMkMIIEKjAM5gWDkNN7R9k2IHxfKCASPzDCB7yRhO6R5V/QAFFhlLHvhx2ill1j4ouQBHAAvFGyozhrf8EcUExP4U3B4yhJo4lSLjmoIQ5zAFFcpHIFoKaIom0oJ5hKaFBmuGMZoTCGaEYRlXfYYwZCGEGDeBjMQLA1IFMYGdEGdY/YGaPqoKTt1lFrh4JUlrBAOOf46HdQBghgkQswBLA2EMaCSAv3zlAThUhlxHC4YMKeIO8u8HfdrtgUHX4oClvS0pJSqIwtoVDCUiI2jKLwjD9YrYoQgAANuWCBgF2hQPbPMILfEoU7KylhMNqBuWQAUFWwKAfJxhHEYDAhBPMIgtEQV1bItyMZgAruSfuWQcmISZLKO8v9lEeMP5hXTy12BWWwAUTHtCEZ5RhDuEIgQ8oM2SLYMt6cVv/ERSp0oUSQAQNKKAbpOpmROQI4o7/mMEFTlsZg9SkskWBCUGyl4ksPsYg1wxLyPwxEyOAZasEMbAxilEMZ8hiWLDQB2t54Yxh5OMKrPiKP1pgAyDQAHLi+bBfIEi5cJ6Ocn35LYqRiwhE2OcfXhWMmAqiGVcsgBKiwAUHTkGHnfzODX0gckP7cAQVmHcQ2/heRr9h5hb8ZhuTlaId8fcPLvwHASmCy4EW1pZGUYanjjFVCX79RiQp0CC5es5AXjCToFqHBv+Y8zBa+48phYIVA0gADLL1jxcg4RoJ+IcQZuGBDYhzcqxoRqO9ZDl6Q8kgkZb0iSn9j1qYABGesHRc/8xApmkR5RL/gKgmKLGBGRThARXYRhcuUAI2RGFcFwHXA1TQNxQobFUqEMJ/flMBKDeBimBLyjbu+J+BxKkmFvdvQRxbE5+E+czd1BZBSkCPwLxgm0niQK0M8oIECGDC/6hHKurBiXwkIB/f7OkCLUcDESI1FtoWbr61jkJFELwXmMC0XIpWFFLMmCCgwEEAvBAAD7SgDB9wQ++44AMIWLyHlkHrbk5B2D2YYQm/oWPg/zEGMEoFBSMxHwJ+XYI5jFaHc6KMZCIvLjLifNxYNhUXbJW4IY1WLJff8j+YkABWaLseA4HBABRgHWfPhANMSKdaZlHIf0gjwvheDyC3vv97uHQAEwbJwdjhogDhF0UzlPDCP9ixgQBMoQE22MYQCPCN33n3H1FjaBD68AZvYCMNdEeCDZagTR98Q1JRvFRFCOKGLPLgH21Twh+iPRAQFeXxNhnjmt+PAQTgoRNFOBX6E61c8TyD4IAjKECCyBUFgIFrgAFpGAYsAMC2mAkDG4fIMQbx+IcJGwa0kLeCsIUNyJm4YIviKggSAkHe2z37gJa+kBai0IyCoLoAwJJbIAESaAUmIK/PqIIS+APo0CHiaagAcoMl6DUeYL+uIQjcCKl/QAGEUbAXKAwEKIHH+JMzC43Ko7zJiII7KBKtIDclIIgFoEI+gALAOAIjQLP/97ABg3C2blqwJWlDaCgGWiixCtILD2inf9BAv8C9v8BDFUwuBYjBvmAHEoAHVJiHAEgHEpgAC/ABqfC7C5gDIBSXiyAXb+iC9wMQiTCCvgGppcmfUxgKb+ACHkCCFyiCGUAA/hMC05omAsosZiKjmwgN6zCDbFE2XBiIEjAGBBACrPiLIyicvXC2BCwI6nA92BOAYmAFadiZd2iLDZgAU9gA2oOLYYiFDfyOgrCS39I9QRRHgsiPwECFdFALVAiACbgFL5gAURMDAijCOXCAN2Kom7iIPlCAJpMa8voGCFCBIYCAOqqAb4AC51EHZRNDX+osKpyDNyyIPggNYbuD/yysDlOxAfr5tYEAGCUCjOkIPaJYw7gQGa9ogGKYBVoohmH5QLdIvuQbCAFILYOgBWRghWswwXGUpzRKo58jt8AQgRnTtL6YAHb4B3j4h3TYgFvYABBQhT9pgrqpAgSoAovDR4uYmoF0gyNIKOHxAWzYOFaJiQqogIMMqiXAg43kv18DxnJoHC7Yg61wQ5myjjnYipAzCFyoq8vzixY4tiyZDlikgZOEhuJChlQgivRABVRItAiSCxMrCsjUSd6zBFKglsKQC7RopwwqCCwJgGoAhU8UDoIKB7KxjFq0iMIJAsd6AOgACm94g8OpgPbyPGH8BwR4DAT4j0iglFyhDv8l4AIzKIqZmAkfMJUFUILcJAhgfEXA4IAWGMn2eIEWyBaim4XjiotZUAssQYVr24tHI4jgCgzxnMzBeDPBWAIXs4QMKES4EABf+AcnoAKiAAFNcAVgyAYfgLIb6TV1ACWpYc0x8CGv+Z4g44CyRBIksQ8EqC0xVAJTEQLe/AcFKE7ycYkzI07SaoMLmIF/ILPptIEXaEMfKkY62oKQVJaZEFF7e0aDiIaCaCdbmIUNsJK8CLG+CMfBIM/yFIzz7AvlUc+9aDeicAIL0IREiIH8vIMbEZ85qAIgjIKr3AGLy8eR+4dveIAtcB5uspWBWAIhwJ7wC9Hp3EcE4AIlKIH/IPmHJeABIVCCPXjDF1CCxliIC2hTBFgCX+IBzVgzf2Ad8iscngILH0BR9ZgJbcICDhgHIRAAWoCgHSWKvEALm9GZHc3RuLhUHv1I9rixgSg7uYBPuKCZFCiADnAvqfiACmAr+NCvwTuQ/UGpwUmSMywKNJAIAEQCBSiHctiDEuhQg1CCSvFTV0AA97PNsOi/jsSAXK0CISgBHuADnJrLtlAwZNkC5/gmDuAFVtjG4JIGmCmKstgZLJFMb+SzY+hGZEAG3QtEvRgGe9DUwZCA9QAGVyAFDtoLEhgIJygIUsgTSaiFI4Cy4dgo7AkeLQwXHxiDi4CVrvGHLCWK/fEH/yQQAvJpm3Rzh29qG18rATR1Bw5QADDlg+wZiIi5zV8DGCQIv39ogRaAujQjSZZ9Tohkj8bhABtQAibwzn/I1H/IC0C60bj4LS45BkoFBBHai5yM14KY172QAB+NC81YAHMYiEuYgPksCnCVC/xIhAKYBP0cjhu5oRIQzovYwihwLOGhVcAjCH+QiGMzgxnYg2RDkzTqBDzQljlQtl8zBiV4JsY7U/PxB2elwuUcCDctAXcA1C3oCj+NiyMoEmSEi+gkCrCYiWsQABq4hmbgVmQIhXClBci8BaWFtL44uqUFDJ9kDxf7h7cgiglAWoOYgE8giAJghByIgdwZAgmxIv82CIcA2UKDIJD4GYPCiSMBZBw/9Yc2/Ic9qNYFaBv3G4jHKAFTuQAFcLG2UYUe+AohMLOCuIALwM10ozbrMCDKrY448qkThYsMlba/HM7qYD0boIUGOAFnlAt9JYqZ3N+CgFSC+EO4GJaeRV0TooI91IsCkIQFjoEMQCuh2IYyKIOHmANpkqbKgwABPV5bZT00OIIqqAIusAFjeLOKvc3phT8uQII0YoKWEIJywIAsajNnzUtOxIWJibqs6IrDmdY5shU/5co1DCojOBmaPbM3egFpsIVi4F9MtQWzqIsA1gsCbotFaNd4hVowcQW4QOB2G9K4mIRkWGBiOAcoEIr/4XCBbQAyLlDNHZpFIpuMNFCSL8wWix2ILQ65jeS1fxBDBfC5TgDGgVCAc/CPSOABG2gBXmqLX+OB0bJcNFAcHwiqPLomrhgSsfAfu1IHhClUxekmyBEAKSYIs3AgnmULLKDiYQBP9piSAm6Lpm1aL9FagsgZLNHfopCEgUiE+UgEG4CC3SmDszHFAHEDAeWyS6yMMYCOoMuKPwiEh2imMEw2qiUIPiiBEEUCXEBTPs4BG/iDGVC2vTSCHtDjgojeKlBeCjQg6KA2idA/mZqmxpWIvSsDJ7RnhME5ooAC1rOcvTiW36IctvjD4ipXwHjRgaBiV2ZZ9piAKCmLSP2H/1AtigzCB68diGQI40Q4gAe4kdKQygvgglMbCB4aMje+PrOV5K7ZgvFZghCYAxENxhuag3IYB4IwM82gn3Srn06oWDOrq3P4jcaAC+aVC66A3CRB32mCIxVAlHg0CUhRGAx5Q/1LsKxgwKC9t2ORhfJ4NNyrKtIliqIyiFVW6FdOnT4siA1oBVQwyr7w2knIAU2KjdjwBtSYgxaIuaJoLMp4CR8wgos1gxYIEC7IgTw95IqtFMfoxXKYAR7IASPo6cItAQDgASMwgpwmCio0BiN+tsOZjqKuFQ4ohG2ggxf5B6c27VO4Vm5SagoliGn4X4P4FZ7NHILQvdNdhNM7Pf+4gAXyZLRjaMmynlefzGIwsRJp/AdF7I7YLYpnkQRPkAQkEFgJoYpvCGEuMIKoCRdpahQfUtgx4ABPWoKfa4xwqAIk4AH9EAIbsIFy4EReU4Jl+DkuUDw9LlabbgslCLi9gI5taty4kIgjiiJbcpuBKIlvyBWwuNYWKIbz3MbwHIicnIVIoxzy3O1/gFdOqIdW/gcr6eeBsIcNL2sUyyAatDfZ6wvamQRikIRf0E/DwpA9oMSzIgjHogiKuAmK4B/wNoME8DkzCwffuQCcXQKsoIcEUDZtaZsisAFTYewTxoCLzZYc6IGBKOd/4EXVlQsE1ArXa1/tSgkCEIomfBv/qRgJ9svQreAAAfgmBtLwgajtAOaEuLAHQ2jlRSAIR0gqg6jzfzgEuGg0ShVgTc1iLW+PVvgcXGgFdGLuoqCPArinDiAGZfCBQUgJ6lEHjYETe9TCWYSOKIgC6kCDHABTAPkH1LArJWiBIuADIdiDzjrcF1gAchZqx4gEAKir5ZrTi11OJ+XsoROSbnrnZ0MDKKjn+TqOpyiItxkIuSGIFpiJPhqIASAIPOdwwbiHgdhRVS4KQygKsxDrtvhwQJCcEW8P2h2IT3CCCVgneMgEtG4LGRsISvoHRugAYLgEJNBPJcMQ85kD/DLbiaiMV02D+PGBP/gHtloGobahlhBO/5xlm7ZBACXQhhaIYTOjwlZEWTGcpsTjRZu+L75wWxtIcDj0vIklRVY6bTHnlJBin5hoHAVDAxqQGe+E13wYCDwniCmJbT43hAsnCqDHcEeoB2of5WII2icJ2lC4dnM3iBP44pnpLYLABVyIYb8AhlItgEQAhlKwlCGIaqpgAwQIhy4QMoPgIXDRceF5gOn0XobYY9/5ppB++9vkAWUogjZ4PyFIy/BFAAD43ujoCnLuyIIwdLjwAW2qXPflACNQAaE4uSeKi5OQaq8QugQgams3CPg0BHvoeQoiiGH43L54NJ13zILQX2cIbqePi8PXCxrIIKwlCDLbiwKQd8NYgP8fqARLIY3t2gPf2SmzpYiFHYgeeQCf+Ic05IEqcG+DmAMbWKIH+NIqxAAeMANtEALL9rsz5QEl4AE8EALpbQ1C0ApemoOpwgVf+nVums6faxzGR4KAlIq9+hq4IABsGILlIIgisA4BSAAmAAgs/f7lc/TvIMJ/i+oZYuXsX66EEv85MzTxYkJn9Q5hFHBw1kFZw5ph9IUMI8qUKleybJlSgkuJjf6FkRPzYoF/wC5ZqvQC24eDBLZt64KgXLktQQ5CWLr0XxAIURBycBeuXJVwCLlwsTFCyQsOZnhwKVHinwIO/tay9efjwJIScxDuQAjHn5l/CBD8u6DkSEwbLXz/pERzBMmpb/+CCvrXGCUBQY8JH7TB4R+ra1j+wUCmrxknexMfHlykUl9MWqxY1UsZ5xjmm7Jn044Js3bCCShtqpwEDGOiS4lEVBpD4LjjU96qcLkQIspSCAgh7AjygDIEHy8ulNMabi6XcGbC/eWA5o9ZvpXWbulDZgeZ91vY/tvy/h+Z6f+ElMCAwNg/hG2h0oD/vHCQPxMl+M8RRlRAwCkEIAThRd8wttgQBEDhjwH/GOHPC6zAQMMwA6GGUWsP2WPIiaFcxElMpLmoEkm0zIjSjRKxghuPPabUgo8x6fJPB/9IksgCwingAwrb/LPNUIKwMUc4XPjwFEJRRSUd/wTZmYHAEuX8Ew6ZVZTDBQIv+IPGP0vgMccICLAHH53/0LkDdXZKJ1GCSlxgFhcLsvSCDSh1yMERKvwD1GwfEPANm//Y4A8SMDQzgD352OOIaC2hRppEoNbDSWsYrYgaSRht9o8sKe0YJKyx+uiERJrQJslBwCwQXCKVIJHGKf9ICOE2SxxVwhF72jnGP2NEBVVURyDgTjnucFFOCOX08MeBCbKpjRCR8DAnIfDhh9B7+dWVUH59JGjEFe4koI5LaNhwIEr+2KBAhBIGJZsgKBRYhD8nDLAIwpuitElColn0z8O1HQKjQhcVIyvGPOLL0m0SAbkSrdUc1A2sifyTiP8liYxiBBmnNAblNoJ0MccyJRjRlHRYBnElVH0sccEye4h5QRWBdOvtQUsIIcQ5a7m3w7oJRY3RFmgMWIUQksYUFp8JXRbhRP+2tE0ZlFnmUT36iNZpPhItdJA+JyZ0zz8cyYaaQRjRsipCqarU6cMnHVRqxoXHKsI/JMdqCSkL+ITCY8KeUuw/XMzxxxZpIMTsQcw2NYYCXGRV5T89TBppQlwIgQd76U590Ovs/uNPgWgIcQ3qKiXow3wY+aOOogiVMWxkLonxzwMHGfFCBPkYMqo+C5mGkD2L0I2QIfZwciIk/PxzfUyw2BNLSh4lRJLchqvvY8e0EZNTS5NcNEn/J/9YIkJwnRwx1EEwC1IBlURXoIQ8K2c+6MK1xvSdJaAhdwhRgRI+5A+o1SV2KtkB7RZ0hCUISiWI+gfqBjggfB1PWE5yUlDEtpJTbMhD9ejH9jiBjHy0TSIMW8SJYMQilVDsexMh3D+AiBJA0IJV/0jfTUC1viXeihiSkB9KoJgQKSKkfidbgAiW8asmTWhye+AL1qaCkiBEpQoHuZbqKHORPSiBLfFZV34OEke6SAQOEEjQgNiEhg6q5GMYuUwXJicUYSEkchhpzGNQQIAEbcYQB0ON4BLCkVxEDHv/gERrLAI+lzhik//QnsIScgxUgAQWQmQiKm/iR1hZUSK1//AEQjBBpERMAmUiWMAobCCIEg4lMkUJTzhCYAYf5McpTWHKzsyowBC4IV8h4GCC7nOQPtiJDBCY4xz/IR0yUHN2hnOBsKIkIRQuJiYEKBAM2nYie0ijUw1zRA0Rog+LVHI29USINBIyCyyYz0dKTGWsWICbDEgEZZ1YwD8YQcVYwu9kJ7PEJSJaif25LDGLOYULKsCFMSGgCjxDJlSg5YMlbBRNIVCJldYCNTisa119gMM0oza1biaIjypxIEaeIKEnEZI2dKDDNwhDg4Mw7CDDwEjEengQjjwvY+78hy1qo9R/kA+gVpXNbyyBUCsuFCWII8UlFsCkhIghMt6Y2f8/QhCOEviAcyE15s6oFQ5xzAEKKrHrWiAA0x1YAQxSkAJMBwQGOljhH91MyIAGuD4n1YYO/6iAH085EdOkj3AMkSxK7okQwmmWR8Oox0P8dlVYwUSgPbKEVhWwAEakBIrAeK39eMIMI3jjUYJw2UGG4AKSjqksfyDMnuJ4zWT2lgvJQwmb1vKPcu1gsHCQwhv+Wtgd/FUKVjjsP8RAU2+6ZGMtGVZtCFAGBvljGDDobMMQcrF/RJKJ/xwtfD32j1XC6g//qF9XL+KJSWQgZTwhRSn215ihKEYyz0BgVsy0hyspazo78AEwn6nGi9TULdSxrhWsQAY8hAAOVpBCXTL/TAcw9AFq2uyBWmy6EvquLyjeaEJYrsEP76VEH5wKyUFeZbhhXA8ZzvDILHghKx3Hl2NLRJJOUIKrhJAiOBLVH+QmF6yLZpR04bnGER6AJWR26UxV2oZiJ1I12FEXw3AgBB6+8AQrwNQKhHizFZKAEDd8YQ4c4q7uQIjTxTpGHZe5CSweIgvRYowh/3BRKJBBCwFUlUfvLTIqTfCP/LL2oJ34DUqKlBBLxDYRy7BBGkr4pG88wTFnTV04utAHnekpOyWowhwqkJeU8C5B7jEzHIbwhS+UwMOFdfM/2CyFg7zhC0pQS0sKNGaXqJA2BPiAchAST5bYYxhHlY3dVNIa//LV47MUgTS4D9K+m/zBE56oRUosseSTEYOgCdH0P4ghAohG1BUviJAhhZJRrlSuHCUwQ1NeZ011IIArKjguSjgwnwmC4cPBhkMSvtCGrP3auoRYrpsh0AOJu2FNYS6MitVHoQ9oTjajuolBOJLtlTjjqK1BYrhj7pJaTEIR2UhJARLh7kmv+x/uhjdqRaCNDIxVMQj5QGM+8A0qnbRyw1xKBQ1YAQSEYwnqaPBFAJMgDGcYpiH4Qpr/6tiLP6G6hBDCrv/BBhC6ZNkXQULhCHAHhAxhwi6Z3kqG0XKTD07mfqeN/NCNUOAchBQ6wbTPcQWMDhDjHzyphCUicQQXFP9PIrcVhNErt1YJSCdqY4BAGqIQnjmcQgUfTwgafMAeQmS4sMFOMwXwgMG20K4Pth+Bsf/RTDynxB8cuEyHEHQQ72bsG03gIawa/UmWtK1U6P079CVSP05HcQQOnQgxpHi/RJSCFN1YAGCCgg2JDAFK3tjDSa9iJTslBAJj2IIbmL4Nb6xE9QkihHU9nNY2tGFSa2nBEfhAAB7BHtFeyKGED+zZQQTPbIia2JRaIXnIEj1Ee7kEZuGGI1xb9G2gRCCORFwCKagCEjxABQhCLxEPIglCGZxFOcwBB12HNkWHAG5UOFTBKZQcSmyB6iHExf0VCogHWyxBDygBDyiBEBD/IQ8IAQ+UANEswREc4EVswQDVlIHghnj5S4QESxYiBAr8AxTs1GgRGkLcyI3BihKJIfSN2/pQUQGgW0qQQiUAgyr4gKPwzwkthmIABf2JjhAQSheYQRT4wGAcQQWEQHhwwTacwhOqhP0hSB90SVsYgREiAAAgwAUgQCRY4hxcAAbMwQwoQaDExA5OhBEoQI/c4TZ8wx06Bh0wllU9zFNJhAYGUZDYQz0AUY5woMyZzEEMHiiEIBMQ4nEM47CM3+QQwDNMiRAogD+UAH/wR3eYRTR2gRhUgN1NROohjQEWwRDuxRzgAgKUQDgiAAZcADj6Bw7wwIEooJh9HAeYIhje/wR4QchtLcptueJBTJlVxaLeIIQn2dOKnFJU6eJoJULjbZrJiAAIWgAIXsALeIMgSURQBAtQYNQSBAL8qYA6dEEX8JYZcUUXpKJdrQQHqFEB+sMRlEAPiKM4WqJL7gVMxiQCCAE7itlFFAqsuKKTgBN86QMQ1UPeXMSMoKGsDCRBghv1IYQFuMIlmIIr+EAFOMpEIFJPRUYaQCU4HcdGKkYXVMA3fAMBVADCMaIPJOBa2EAJ8NpezIBM7gU4zgECgONeGEM4Zo1EnN4fIUY88kiwNNsSnRIMwNzbTMQ/xgo3HKUuLoArwCEpRAIHGMFxSKXlSYa+VcAWuMy/OAqUfP/AB4CTIGDDECQPXiYEHgmiEvABXLYlX1jiQcBka7pkJJRAIBgBbbwAAyLmU53ILB5RtS1C8x1ERLQGP8ZKPeCdLqohEyUCa6FEDTjeQVhCc1oAKQBAEXBAsByHqB3EE2SIywwFCjzAA0COsFweTx3HKZyn6SXbgiBKCfBAW56FW+rFP+CCfNLnQRiDWShBOeyRSyTISCLmIXRKtj3MiTjCNVhELh5ELBSnS0QEbTwfYhaOyfAiS1wCMJACWKlCC4QnOPmlY5QaAeiUIEClIOjUB2DDKh4HWIplTfLJgqABBygNE+rFav7DXMyFfCJEjSLUTILFQbTojyKBKvJUdq7/z/jFxDzZQ46IRj2EQoqYxqO1zSLsZuFcYIQu0UFKBPV5oOMZ3iXQgD+gQKmZYL79w3VGRgWAnleCV7CogAlC2ylUgFv1Z1ugARSUA1vK5T9gQI7+w1nQ558exAXYAA90ohKYIkJAYQu4KXLs5foMAUsE5RE5AlAmhD6QyhH9wzX8QxEhhIMCVAWyhHFe6Wy00kVcQpEwQEKUAhN8AqhBTgrt5ROU6PEQgAtAQAW4AG59QMw4xrMRAAoYwWjqDlukHhIgFJq0ZkLwxUVcgBAswIEIARe0gVawhA2owxBo5lQyEQRexCKICotYFkNc22CyAmyQKrrySAwUHrtKhB/U/0ANiAA5XMIrmMMLNIFkgA2FSJliJOI2oIB0pFBClMEJEcDB9Q5usIUPzMAliiNC2OdFnAUTKkEnrIkN2MAVcBDbKYjyDMJ5TgQBDEE9Fs4pzB02RIajTlv2OEIkIUP25CJRpqvMukTjXYL9/IPh3c9BaIGtdAMDpEApiBUKQBsiCRLmkdMHoAAZOMAJSsSzocAg5JGPsAUH+AmNyudqMutElEA5gMWarMlfQOE/CClyHITYCILILpEgbEO3HsRQ+cJ7Tdvy/UPMzqzMHQBtwJJXiUA3LOY/VIPhcSm9ikAp/MAnZEB4Qkl2aaGZUghCPEEi7lQ8PtsQDAIaKEChAP8pS3xt1V7ifIajjkrEOP7DDLQnjO4RGuwB70nEEdCLYoxTF5mp4UDJFuKWbOTDIoQqkR1lPv2DpNqtSrBWJZSCCLjCB/6DJlxC8nbDJRAH4qIAT0rGkK7iYryM4v5qVnrDILxjAiiAA4ltvnxtC/DAGc1oWfDbQZSAAvyJENgAXzBjirlFSryAkGarY/wDT/IUVWLMM8wqo+AjGCAEDJyGrIwq8I6WG+QAJmCCpGGEzf7D8KIEKWhCKcgBKXyCETyDhNzWrM5jTzlGvh4PtEEJmvoAEvDACczAOFBh8NFGBvlDCygBC5JvOSiAMWTNn3DBDORAOWQNByThZVDtSjz/SHKYaT1iwxHjo4+A5TdIGUKcQlAcDw6uzwBgz0YccJH9AkLkAEuAggMfRLyKwCogQW0hXUKgYnZJLhILhQl6pxs8AAoHgAcYw8YoF/j6DluMb1/AHfkiARIo4QUcxAwUwX5Ywgu0AdxVGBQeQbCIQdoerWMMKebFihjhoyHZKn5sAd/8AyxgsUpAqFWZlkv8QgYssI/gwz/gwwXbwCAM0iB5sPCIV2OIAVhC8Te0wAyIwgZ4wAb8AwkgFhXeRE0VYAskAH/kwDkU4R7sixLIJw8QKg+0gDZcw9ciiIrZC1T+ixbuK7FYlI+cwhBAwXF5g2L4pYR04T/QCypZqSdf/+kDK+Ql6NLaWghCoPH9IkRZUZ7S3QEKHMELlIAH+PI/ZAHEBsiC3DGF5bEzHhsa/HEP5MALLGEPL0ERKIElcEAJNBBpXoR2+ECEeOhBFKlKxAxlSsbaXsQ45+NE8OQzHJ/hdLIuThUquRuzzKlLJANuUILNXgI5VMIqMDIiSe4HP8pFIMdtEYA/44IoTIAHMPUtiMKH/MMHDWvvzQ7VIgAfKEELWKwQYIA/zAEPuIMSIIE7tEELuIOabPRECCBUOipuVNTl6SNu0F+sZBuMYGo7w0oOmEBe7J5VNUIlvMI/vMIqGAEiGZ3YwAwBiDRCUB4BpAG9QMM/iIIHNDUOeP+AEdCmh/zZbAjKSS5AVqfY5fIAGphDXwQCoULrA4DvCxBgZAZJzDyyyCoxRhwpE2HKRPyuzNUtKuUAOsTEJDRwj3zCP3xCN2BwRfUPIRFPiIJsPH6ACnBAMbTCLt/CPwCIA6jAEx4BYHgTVSe0Va+FG/TAFQDJWtAmBzCBECRAJ9RMSa7J6iaEAJqpY5VpbQxYhEAqj9h3bSyC99BYXi+RX6tELWTDJMjPcspGJSivOWjCMrzCpznJy+CvRBwPOZltOUnmN7wjNGTBZJMANCgAsCzJ2Gru5nLuEpg1grBJCzBBIJSADRybWiQXrf0D/YG0j+B4gK8PKEOf/OwcbSz/AyUswye4wicAddoWtYQcj30/hjUqAH3SgHXjggJIBgqoAxq0AE76yHzMh2GMLXchgT+Yw6EiwIfwJ0tAgSK1LW7wN6myM0DB+RKNw2z4Ro+Agjb8wyiUwqeRaead8ZMwdkJ08AOcQwP8gwBkwQTgAj2kgdydAhIEcXzXxtf6gwooEwwTYAmorpgrFyMGSAUAlI7PBov1iE+Gm/ZADHyZqiz1iPG2hCbYymD/QDDcW1w7SaOSp9OCF9n8QxM8gA00wATgwC2gAh1f5zeoABKcA2V8d0wo7CIKBhq8gBIgcwYlmwogB23LysjmZEKwQhXzyHBeVVEVVXxlQC0AOW2Y/4ypqkSeX8Io/IMI5BKZGlIKUSZGoOJxuACQiEIAeDguPOSzTY4pbjnG0F4L4IsZcIEEeHpVH4RdbUNZZZeb9wi+5zg2oLNRpWsqcGBS9kiWskSeawMFy/srvIB5XvJB3JZtJ4QHq2gLlEAAoMI/0PxDnudECl+sBLNVa/laPIAbbDVCHwQhbruswHWFNK45JSJUcHa66nbMoRbGTELIo4StgOAlBIMNSPjRdX1K6CT+ngIaiAIOTMAG3AKMp0H5TZk6pMUSDcikHMQOOjxLqABQPJvhMAbK3sFjzN0/0LNL4P3cjZfMyrTMWcDN+shyEkPVXwQlIO9BXEIkoHy+Vf98BIbTBp+CD3gANPDyL/9KsECgwRo8rAgKH2WjVbNEpDzDyk+urOB6OYEXCqTBWO44Shi+3w0ebqwBplkRhaZEJSAUWIWDwGPEqE8IF/oAE1xAQHsALiCBdDTGHViIxheOoNhApPwZ3ecLQuQq7K5PCRkd3tMGrveq7ec1I/x+SxheCL7AY4gaLX/w414+MQrL8mQC4v9DMQTjyi8G5EQ3QPwTOJBgwX9bDCb092/hwn8vBDZk6DBhxW//CBAQU5Fjx4rbvhEY+MGjxyEYRWIsuZJlS5cvYcaUaZCRQEszRYyy4e3Jh5Qdt2ETNEQQyX8+N/o08sKUQEo4sPj45+L/37ZT/wSpm/nyBYetAtOU2Sbo6FeXRlmSxbp2m0qSaM3GlTuXbt2VwDTZeLZRbcUyIglcFVyQgKAKHBppojTwCJ0ngq5iVTEQod2BLeRWCKzSsuWQp8j27TyadGnSlVz9I/UvxYgX3gjATdg2qCDbH3yiFfmNgwJNpBqBeoj7nzqy2NQhYVi6hdevaP4N+rDRtEy1fWmXrV4wX1xnMu1tN71glMFLlwaWT0m9o8ZTstVeReHDEg5N/7zgglj11LYh2wYRDyIDzIrsnyfEa+kUkfraTKAFExRoEXsMiXAgfTix0LJK/iklEYIqOc8SYLQx4oMhZDMILtAqEoSONIyY/+GfCU645oh/UNiGgG/GEkQ505wza4ufUtSwIEGEOmmgtkJbazt9FtHnHymNrHLDSjhM77x/gjlAEAY9IksMFisiAIU7bLhmglsmKOYfqf5Z8BQxBlHHhn8MoKguf5zTU6YHRuLMSoJOMlAlMNty6c6BFoHJnlz+4YRKDScdVCBJMlgtS5ZKWeYScwTS5h8OFxChxDtSeuunf5LSrsyBUOCglX9QQcWYf7wiACTIPrDTT7ug+yfYrbb4ZggxCLhDkKREi2nVgZY9CjCOPmiSILLGEpSlNLyCQaZ6LP3HEXHDFWg14V55yRz0ONRGE/RE+MSItlISU7BpWYoNBYiKsf/lH1r+aaECyIaK8xskhgVyK3/8eQAFQS6CzKwv2bv2KqKc9KjImKhqYqAbyg05Jk8WsITDSsp7CRQLNEltVBH+EQeJNAIlydqW3ppPgRlsAXgGI9Io7J9n/rnjlB+ra+HG50ArjCjitvLpH2wIIgBjHT26qC4EBUpU5K9fQs8cUWGq5BP0BELvkkiMKArawuaE0GuWnoDCCIGQ+QeGQlASyT8Eb/y1LjRaWPSrByogmteMna0Im3tVMtS0MgQKEOzLXSrFpQ8H2jTtZc4hSDYXvkHyrA+uKqHff4oxRiodg/oAwbuZ4wANw7dCg2pVoXYWKe3eOioogoiujqxnMU//PqHVBJrEJbT/Qbubf15ZWznkoZXcI2yEqsCHLIwBOAG+14JQkMkSrqsyzGxIfyuRWGzWJTEJEow90ZQsiSSut9Je+f8v8aECwEQ4INpSpxRAM44IhWppwQoBIKCABthCADBAgjfocB2yXMV9dWkBRGj3FX9soWltkZ1lUjK8rhUEBfwzCJLkt6SVbOx/dUmGXBbgIZh8QiCg0ka6BFKJUkTCDIwjzBCw1xe1ZKQqKHgAEhoggGLA4AinMFpgEjUErYymMjc6wtLMQrMdIatZ2INJgwyFjZTcKEVCcxBBEmVGDf3iHz+ooYVcUQlzeCBU90nbQDqhrZaAKTaAQdVR/45wK4Ec4RtGyY6AHoK7rfiAcjBcld9i6BI5sooA2OigDLNVEf9pKBt2/AcmvoaIf5ggZKPQxH00ka5MMAFrZ0RJoAjwhG2g4Aiz8MU14nCEChgNMvgKYUUE95IWoIEDSAujZjDCHnqZhgAuqMCNLCcofBFGkFZKJkxCAa643LAzxJiJK0qRrlf48R8IUABGaMiR2LjNIFTxAQ2u8Q8FAEpXPKrKgUa5HLNA5wUIMQJm4oIGuQ1kI48cDcQ+gAKB0GE2RhyI1r5mSpnkY1x08YQnRPaJZSyGEqBoRCOM8c4vwUQMlKvXTwRRBigox4IYRF2cSLKRilVmIBRZCE9fwv8BqRzTLBAYmBu1OZqc4mgbCJnbWjYpMh2kQAed4cdMdIG5TzSCEie9gAKoEs/ZxEZaA7lKYb5kBGl4C1q2EV1yBDfChGIGoXNJAwqo4lDTsIdyd6xIB2yAiBT4NWRN+QcIBKIAKES1RRoRWpwyIhKStAAGwRQIwZp2oJQYQa4SERacDjITqXDACEESEgre059uWkZMumJshAjUEkUAQwQnWIFZOkpYszTCFKr4x/VqWaYPeM2E0mqSjtx2BEAU4q4uAA1R6sU1BYCxIM0R0kBAGxcfoBYtjiyNRkpXJiZVlCp2ia2Fcqvbr6gCBI1ogBHIKsda/mSb2QHMEFxgtwr/5EZbKbmKOkBLoIZQVyZbcA4UIiIXbwgkI4JAEEZRKJgUaW2bBZGTC+UC1JXwcLBxGYB6v2IKw7ZtuK6qCEmISxAMs8p7UCid1LBHh22oQB37Wc5C0ACouDTTK9+EiQqYeJTHCYLAdLFNJquiq38aRK9zOW9L+Hi5NYRLFAlphG9BgIP3ioFeEC7JNJksEJJ4Iw1KIsljCdLSGU+XIltAiI01DBOIoMHHMNGMRgYRULlYDTIIwjCS/KY9GM7JMm7eSodLEx4QF2QCA5lXiWHy1IR84CKgGcrGvEY1MA6roDt+Qfvo4oMKlHkgA0vQVThwo2fkzyLBBRvnPJLeRX/l/z5NOUJs+qpJbam2aiiAwKq7ybWV7kYd6qDuQpBQZ4/g+HYClYsR3oBgL2+HJKUVs0de+z9IHeIfHxbPTbbD24SYohGEEYSkPdLQVP3TJ5KNkzeC4ECByPQfRkgYGogqEw74AwmmNYsPHuCDU5yieOKRmEA4sNgvK6+qlhHnTGpSE7tUAogE8W1CVNGIVszqCDkaSEpeS9Y4YQRBSmYVZABF4VE+AX5kGQKNl7aQwsVlIedQ9kuaUAFdRzUwQS5JXUVJEAfMmiBXjUmFZLKA0VBiGQJRRVMuPpBy/6MVpggADmyQkewUF9s2Ewg23gJhnzzAAWglU0HeMhiN/IPGQf86go2/8na6oCDbcPSIVdCdELV8kugcqZR4ltAZVyxGFaLyrSpmVRAcUB0aARCIRA/F4JWIRElfot9l4QaBBx28IDwqA3+5dhUVHCFY1o5zSwiEb77PRKxVW23V9Nx3DXEbc6OgeMr+IeKmG+QCs4LGP26UwkANZMXwrFdR3lO1an0jDS4oA2zoqffb6Ao3IlFBERBqg5uXRO5zaX2YO0I6Fco+eSNwCYfMT5ep5z73UedI4sHIF6wIhmBoXyl1IhtZFvHlFGSGZ1+t4loEIiSERmtkTJ/UATo4K7S2wtq+ou4KAsyoZcnIrwJNQ1TIjf0I4vegAQcCQBRmBb5cZbz/hoIsguemIjA2UKRqviQA30P4+kJVtq4wiONLLsKLtq8jesws8m7hfNACWUKVgLAltkoUrqzKOOLqAiAAbATy0ChO6q9JhKav8i+ySkdoHswbHMBjmGhVIGdaAEMFkYUAlgAJbOAFcpAjbOBunmw7Kiwh3nAIOWKAdCv9ZoKHnE7EDMKw/sG3PLAETAQwVAVbouX7jGLddOXI4mQbYMcjyisCE6Xs1EEB4G4mkKASXyJ4TLAlqKJiVOTapEYOE0KVOkAUOQIPK8K3LmAgfMsUWkHL4Av/bgkCqSUjsKEt0mCDpmYmfE6fMNElEKIFEvDGbEn+7EJHyMgUCaID6FAg/5pReRLB/OwwLhphFSriEyIBB3ABEEGxLMbrK/qDwvIryf5h2l5iG3oCwtKwINZwHVflBC2Dv8SDYaqkFNVr98wCFQli8QSCh0whE3DBGERQ8rpQz8BwtQimwfrjjcwRJjopYBYGDaarJUwHWtZipV4iDpcEMIqvM9owQmBm0f7AMvjxH66MvbTxAm6NFlnln7LDE3cR7KrlFJoPyX6Qm95pYZqJI4buSAyCJSWvB8WsLRqyLj5SGWFCHKojEkqCHzMBB4Tp+34y8pLon8jCWGAjeTjgAdTB37YAGyBPIMSAy2wyI19PLDNCKsmv4cAGS3BPQy7g4nBhCYygP5ClI/+4ZlW2rmjQriwgozaeYdgMAiZVbIVEaYtE6wFCKFjKoIzO8iWOhyPoiOVQJ5PYwAKJICQxZxnwEUTEIxJMIRKy8W7ussK8LCQcsySSAjRK5+zO7C8M4hQeZxtgsyDKoCdQYLoScB0FAiGOINU6Aj7EwwsNIrtADBgIAnpMo1FmTTTdAb4KSVUEQvy0w63U8oUEwRskR8nQjTb86amK4gNUwB8SIA44AOgSbCWEigGv0zSI8kCajOjYEinp4hN8axVGswmQpTTZ48zWg9CEkiOQhBG/QShaciP8Ru8iA3tIwh9Y4R9uwJnSkyX8DUHKMkE+oIE44vT+BzktZBPkQnP/EmQV0uUTtvFuCrGsBjAyyYJoHjEmstPkQEnvXJIcB+IZ3sAHcvIfEsAGEKY3XcI5PO7aymXo/AOjLKcyjpI+WcLbOgP3XGY0rBEPecAYHKb1hOamks8hY+OQXg8jOcMFV4gqXMAGEoAVYGARPuwFkAChOJQh0gcFPIabwObMYi8mDGBJR2M+F60UGOAflK40Gk1GeEAB6jJAj8KteJAAgiY+y0Iq74AOtqAf8gkZ8kEfYGAYvGJp3rSiQExPmdQlIuAfRrUuzEY8FInqcIEGHAbdYOpBAhAbEIQwaUiyUKWQnmp/4BA0IKQsHoB8pCFv/qEeYIAV2LTIgHSRKrBT/0P1JTSg7/qhAfqQEmYACRDMIkODAI2PdyhQdMIrQROlNRWogU4wMooymnTF2P4hDgSCFyz1H4YhAWhAIunMffytWUXRQ4WwWYuBt1QBBzBAHZ5ByQJDYgzF8gDjSzQSQVVibhZkwSKjSS60IO6gEDhAHxpgGJBhF7pNIPjBEBKACVqABjjAtNCAwHKNNPwhtkD1jragZfFVQ36vD2fgAURuLCKWasiKWxtKnmr0Ea1CgdYCHleCogjgCN5JWAWiO7otPHr0BSyWMh4gDU4hZdszd2I2QewxVK8hn/6hH4zgcQBDTlBHJPjCsdC1wgpj7QiyV28RNhqIfib2sqbmBf/S9B9AlCAaZRO6AwZogE39DUwfJGtH4147owA06h+2tlkbABquYSfEsj34i7+6syNCIwguIryIliWOgHw2QR/yVlwGIEo2AVz6AQaWwge+4SpcjXCJrhQXN2ZnwSCKoVobUUx/ctgUVpA+4PNMLHuycxcv0qJaRCAwox4i4FEKYhM2oVQFAguOIA10Lix9MlwsgDVctyRqoCCecSD2lfygIVXdZCBuBWCMAGitQlFFR1pyStJQLO9mMg0WzBtCwiiCp5sOkSo4gF2lJB/yYRGQoVEGoDvugSB8YLEeEu2A8iWChQNAdTH+AQhmzSvQcyuYhyM6AJVE8VbGtwG89h//vLYYlOCdhE+e+BN5DrFZnoBXBQEF5FfyhuyBIOtVCMAbjOAKJKRSIKEiUOAAf1IsIs8ynMNwB6LRBCIFEO1r8pQghmWI5cICgKAa/oGHakCKswopcUEgWqcYPHggxpcXaAEGwKpbT2xnYyNFkKwEM0KBRONOBSIwl/YfmJYiLQXofjGCj3jReIqIY4IIaIUgEpc+i+EabuUaeEEaBOLDpGEGsOAB5nTk9A4MLY9OdyQ2obAMFIjy6LZ19W5fum0T+mFUZe0f+mDRaEGCByuJjYSPC6KUDeIFKjgmBusEBkIENJM+jcFxAzKfUhVvBgAQiuxFlS+OaDQU7e5Lzkwt/3TDIrqmP07BbuT4g+dYiWEC3P7BElQ5ZNpwiYXFeOuCjxyv3Dw0e/9BGvJpF5ABdak3mqaSeAUQshqoYAcuNkYJeZKvlpoADYYBZP5B0bCNjFli6Ji1JQzMmxPitrBXIPwYtgRi9QbiIwmHLq53IATAJnBZIGbWa7tWIMz5GpChUNOAohjqKxyJuBbyaomPJKAAoWCArWZI5KoEOihYICQpZKDDoRMCoXDaJWiZlutAFggCGGKXIL530YyhdbxWGjg2Ff6BF8r5GmCAFh5ApPuSbgfzSNYWW9witaqWKk7PG7KJIKDgFCZjD+AVh5uBBt5EONcXUT2CQAzg9N40tv+cIwoK4hEspGVHKKILwiu4WVjQoINsLMpiQoonenomWiAarqhlT5EcNyG81hikoRgS4Ah0VgwiRszwxWu02mukCaBlQgF46gFagF1V7CQSJVH6IjLcWg59bGU5wgCGJViYyVJSOWZ7mSAQWRqAGhAcxgVW+Hcjk75Wa1oIU8dcYg6gAArG4B90jLowcoFNEbZf1iD+OqgE4slYWSBouSNouaLronvvaGb/IXyzuCIkuxh8QQle4BQeQxG3tEaPiyMc9St6mAAeGQ5BorUJlzdHY5zlQrwrEBqKwU0+WCCM4ZB3wU0QuZHxKjCMgu4iTy2l23KFBsma+x9SlpwJQkn/8QQIU8Cc6sgUObAjzLmcKfsItLMtdJYR+6Jn0Y0wrURoOfzDt2O7E0LAX2Ko5TBVEbkgONYaiiFvUmEGrkHUKM3uiFsjCXImRjIuMrzGB2KnvwLHByKbzeIHdLzv3KSL/8HLCaIBaOGcr8EZlAAJHOYOqlrMOrvCOeJuyqEKpJwuZDtc1FogAjkuGLvvEJMgsqAivtgZxscfmjsI1Hwb4q0i1JwjaJwjuMAjyuEy5xwp8cEsUgB6YsAlNBgI3cQYJmPSQb00sHwmdACh5xyRG+DTQ33VO0OK48LUZWLPZa8kWb3WXQINWMC7bf0rznXX5VACfH3WXDrYmTSW4wKv/y3linWLvIm92eXC2J1dIM472ql9K6xcPHi82i3Qs7S927392/GEysF93Mm93M19Jvz73NWd1dN93b8i27M3AKK8OOvJ3SfdC2rdrH/8JRyPIPaACzgrJP4hDYxGeKukBXQ6uwc6VMV9yu1dZGb3H/wlAQBBaSpgQctlIUr24avkQdVdyNOBdfTmH04ADaAAmvjbSBqe47/CDrx9fMmXFqyhvBstC2yhpfuNZjrSShZeeWA2a++c3K+BGgZiZouBGwTiGrQCCiTKUAJ1O+ps5cOl51le9gIA5kU+4q+hFfpdjtFUIBDTY/wBOkoAT2N7JWLZdqreIwh7K/wYwOlz5nllIuRFviDcxKlpIeIFgq3y4Qb6IWCOACHQ4EfMejsSRsOknjKsWxmhfVDanj7z6cBlAub1XiBYQWkJYlKEVQHuJAGuvSR46uf57uerHgtY4kGDPmallS72/SWkARagWglAWEJZwpWxtiJW/iPtWg6NsyQSn6KTJyAAACH5BAUNAP8ALGYAAABqAfIAAAj/AP8JHEiwoMGDCBMqXMiQILqGEA1W+ldpRKVzPtBs4cABjUEO/2yMc6XtUqJEnkwMZBTxH6MFLBE+bElQ2sBGCUklXKCTps+fQIMKHUq0qNFeAhU9MjpQRKJKlkhpW8HBnz8j/4z48GfQ4z+vAjNM8iSwU1mIiQROmnTQBDiCZgtmMDhPQLuBPyT9EyExoTamgAMLHky48EGkAh+RDTwikTYg/tDYWGGj5bmylgYumAuxkySxnAmq/Pd2JUNQ/zQR5GvwEkXDsGPLnk0bobKlgkf8W1AJiOQTFmy8wPrvyIt/W7Yc5GrDlSWzkha0TAs9c8GUS3Wp1RvRNcKJfxEG/yMYvrb58+jT/3ukSGiygdw1V2LyYsWnIlWtDvS3xQdIhP68kAETbNHUSSeTZNBJaDMJ5AliasUV10KkSPcdQqP8k+FAG6rn4YcgFoUIbkFh8s8aablWYSU2UKJACz95xJwlc0n3x0LSLaBXXDH9E59BZvxDTFr/EOnTRBAhGeKSTDb5D4QHIfJPez+ZiEgyB0p3yQILLFFOZT5tAZZANmTGloL/0AhRjw2ZYN0/rphXnpN01lkUlQaZyBBSUi7UQWKeWAlMJ5ZYskAiIlRiAYxAHWGQDboR9CZQbBqlmp2YZpreAQJx+s9t/yDSJ0KYSLmYQSwoJGqCljBiyXxDHf8xpkBFFHlgmnMpAJSFg5mi6a/AEsbCAaka5MmxCqGjkjIJ9TmqQM+m0NuYs/rEVWTA8CrQpD5VCpE5A7lSCbgDgUtJsOimOxSnxQ70SC17/qMnQo+QSFB7mORQpCtM7NeCrARFdsR/CR330QLWFfrPhJl5K1icBJFrjij/nCsQTupmrPFBVLZL0FL6XicQs72MhhCVpw6kSAfRzSfjC0gcNysa/jiqnHICcSWQwY9qY4l3ExYZWAC4HJSCua54IFAlplAiijYUIxT1xlQD66lBypisEJQ/AfMPEx6hUSvPCWmVkKMG+TNObwinOVBckvy4bVE6WaBtQeCVG3HVfDP/ebVCOeCGiAnwEqaXAhks+k8LTETSL0RoH1TtPy9oEycplrC2W9AIedeQNVkIRC6cPbWE8eh9p45pL2RJ+WxhnQRH+QnBjFIVRDb4gBDB+3EARAYQv2ko50DZIpDFA2HeUHl/aYOa6tAHW4BBWhtlTr8tfJKIKmBC1MI51RqXdmVeIWF5mtK12pDnAy2Bg0DcTACnQBD7VdDUBPka/f6F/f0T1/9IWUP8RxC9LOAvHLCARR7XkiK8QGcD8QHZBLKFyA3EBkyoBMIy4Jq7DSQz/YpUuP7RiAlQTFfhEmFBwqOkhFyKfzBkEnscEhhSXIIULSDCKFxxAka1xHccwJlA/3xgQTLp7iM2AMI/dGJDgZRORUw0CMb+QQNS0OAfrrlh6Y4nENdoQ2nIi6EYZ+MxhLAgG3jyn70aooh2watwiPAarSxXiQlGpAVIKIgPfCgQyUiujzbIgSuW8Q8UOrEg9VvaIeeXyL1oiRIpCBe5GkmQT/gqjGPMZFDwFJQ1MqRdxxrVS+pGii8hpyE3+4pAjFBER+mnjwQRIkE48AJdaeIEArGADSsRJ3EtsiDd6IkulZRIJoLiEufyQCNUoclmFmZYjyAgRAD4SYG8xxOFS1MkXWGOWuUMKEaA4D9AEpmdRcQfGDSFOXg5kfpRsnO6RIgFXGFDTVTCAxNzpj4L4//JgzDLKIioxXsKJBBNuEaJRPHPBT2inKv4BA21xAnEaDCuiCRSc6n5Bygghk+n7fOjQ2HBI1JVxthwMmWoOMECmCBOiEDwWqcc50BghLaWKkRnATLDBf5hjsu1sCWk4KVBLEYP/IH0qD7hpEKwudSIkOh1KegEMCaXNv/I8h8GOIgPlPNAyhXkqgbJqir/wZV+qYIeHthiS57H04wKxKhIjStEqNRPjn2sICVNCJWkVAuVkKKXvFMIGtAgQSO0wKYfwUoR0MARjrySIVw54uIE0i9WFORxFviJ/uTK2YbM6x+/YAg6lDKlJ6HqH3klCFJy4AlE6GISz1licFqK2IL/oAFt1RITrQSCBF3pCgmV2QpEOECcPq7gH4sYgECgQYlEZraz0BWMNBES2k+ltiGIISiCGNAJV4wDDS0Q7jmtwqjJ2YBRBPjHKT6AAmyog4hkhcgLJPsVGiihHQFYokGaWBC20g81XyQhQ5TGkDlFl2ptZMioBDiQkNk1IpPKwAuIW9uDbOFfSEACFP6hjnF0NYLnVUd6//GBIXzgFCiIL80awhWGXnAGmvhrJUrB0Xe69cA4pq5C/OcJRZzqdQUJ7QEU4alhoXYhnpneVSpckBdgWAX/6MIpToGNfyCBd0bgQB4N8g31nqKcNx2IH5tcCZ24BnURAYWBi+LfHKsH/0aBZUhdO6UIKv3pSdmgCYmUapBEAIMBHTDDcHB6UyP0BwpNEMM/npBeAnSZIEaAGYkPMuV/QIHJBjkOfUU3v3u2sBsMidMVG3ILHOQ3vwn56T9e4WbziDUwv2gPIjBhAkQ4OCIs4LNCzpCVCUPkZhWY9Af+0ehtCKILunoAQU5hEEH8QxAoVjamCbJhdSh7IEVwxyUw1ghQaCIFGF3ICxWCgyy8byFrFgirW803wYWqJSJtyQJcM4IDVGULr27IE54wBIE4+x9lSC+0oSwQAjAbIczGRgXEG+axNuHZA2GgQHxVjUTUYCCZfS5BJtJmdquOqkGpBWlHVItHvIcmyv8gi3YGQtB/MCADDHigP/IdX4R4ZdiKJoAghr0NAjT6FOlNw7NH7G8SY2Mb/9jGKb4x7YP8WyBaNkcrVMFWnYjAzK5whcbp9w8PoBWuBwndQGZB4IOk2+MbwwRSrFQqBitET7pwO8sXYAOrDJaV+0HsFk4xBGg7WxA+H0ijA490MQS+IKcQRN9PsY0KPKDpA1EHwQXS73BdoBWXEAEwRCCCbmji85QwRzWKcot/oEIgZU8ICdCuOmUgwhOPuPU0kyF3t03CKpGpQiCEUFyEpAEFz3j2wXlOYqIPZNjDNgjSna10F/yD4RD5N/MJgIJtENEIFrgEKPjiGm+3syFoPsj/6QfyCdY7Uxev/4fsCSJAlXji5Ae5xCRKcQQDoEECQkCAOPYghAWsuOYCcQSM9w8okGJDR2zE5nM9RxDOZnxJx3iJB3RLF1NC0XMokF4PYANFQAyroAmUQAmfp1FsFX4DgUkFUXoBcAsmZH7OpAjwZxCcMWsnlQPVIxALQAxFAgxW4QY9UAV7EA4XUAVCYAa4V3P+kAZpUAFp8ABkUH0DuA3f4A1ERwBI9wE754AC8QSCJ3xMRxR08Aw7l2LegAZIUAKa0G3/sHU2RhCNIArGw4JLEmeDUV0H0XJv4y6PAGSagRAmgE5KUAVcEA7/EA7hwAU9MHNG6ANp8AY+8ABL/+gDp+B8A4ENhzdsgFd9T4cQzpZ4jvdNPrENH5BeoegCBKCFW+AOFdN1qWEBU7QQlDAB5waHmkSHzKIsqqJaijAin7ITFoIwy2ADHNCDF0CIVSCEVWAVBvBq/uADb9AHD4ACU5YG/iCFT9dogncKacBsyVcQgBeKikZsZUAUAreNYmCAipRZ9QN252IxYieLmUSLByF7yOIuojEQQXI3lgBYQlAOO1UOXBACQnAOVsEBBnAt+gEBEFABxkZ9BPAASJd4AnEK35h0KICEF7iNAnFigKeFAlcB3tASk6cQycdxoQd2UrRZ/7ABgyE/7hgYINcQHhNaeGICOVA4sqcnpf9ih5ohHaTABAjQAksQiOJQDuGwByHQBQN5BG7wBxXABVVgaLrzDdr4DNTnAhApCFhZZQSAkR9ZEKEIeFYJioLwDc62aQ0BAQTRiAOBdP9ABrVyAqPjAR7QjgkRNanXkk1CMC+JcpfxTzVZEDX4bv+gk/+wBFi0AMswDjYgBFUQAiWwB2VwCpdmAzdSAiXABZeJAFygiBDoc4YHeH4nkcS2DUFggEgHieo1YkQXCGmQfF2WiQqhHNcmEGg5EH0gdE/nKLj0D3RZECj5D6gmEL1JFK2IlzQBEnIYFKH1C5eRFAIRMpOgL+snELVAFiFjFkRiKAKhDS8QAuEQAlxAAGn/MAQPcARCiAD/4J2EGA5VUAJH4A1DsJUEcAdb+QHLNxAu0AQ+4HNU+A1l4AJomQbY8HdV9g9vQGLOt3Pq9WgN0XsG8QAVYIlHAA1e0Ag0cJcEYYJdV3rjZ5x1wkdCQRaxxzqeMBbqpyqeIAkpuhYmkAiZERUVYgGWcA4lUIjfIAZNcARIIAQXQJSBOAeFCKRzgATPaIWAV3SOBnCNt4RNsA1aqIUq4AAPUAhlgJVlIAYmdgdJ8A9NMGw+52yzKVgOalvfkF4owAHG8A+xKBDBeTEaOnFC0SEGwZL/8JseWhv/NBqKUCrVaRAtdyz6sgbT4xluUxbBMQIh8AbeIAZl//AACsCY/xipABkCxYgAnfAABPAMoRiKULiAJJZ4PnCBbDkQp6AV3sAGwfYNpPgPWhoCdHAHAkEHFdAEYHUQ3bMDBNEHsimBaDOcBoGhbHgxLaFqAqFmdypYMzUQyQkRKfNZ//Qpr3dnBqEXM2ECnlADkyAJWDI3USEdwWAEXOCRiYcCDxACqNqpXVAFf7AFdicEf/CMpMhoYhCBxPYBZYANEFBpKNCNAxFsyPEAB1dwAxGOPncHKjCqCcEVYyoQWRWq3lABL3ANNBAAWdChBTFqv4kK7zNFb2o6EAGseBk2QLGneOJ6uSgviGECrDOYcHEWHeAJGWACDMABjGACk/+wBhIyN7hgAypgBo3mDe+5DS6AlQTABvlhFXPQAwqAqViZdDqXdGXqkT4AAftqYsPGbIxXAbWZlv9Qm2BQEH3wawubHM9XBk+wDS9QAhtgauIXOgRmsf8gPxRDMcVpEKe3gscaIr1gInrCWq6Xcr0QMp4QJAixIMSwFgUwAlzRC4lgAp3ACFtyKP+QAh4gDSTgAxVwB01ABy6Aud1IfVHAH8noD2aABOrgDVNWBkr3BOO6dGPwAEHwD0EwCOp1pEQ3qjSXdFdQCIhHgRaWq7bZB69GAFBgAw2QBaW3EB5weq1gkqmIECo5EASmCesmEOPRsXkLGDnwWaHCLIBbPXL/JwnAUCAjcFgGMAIKkAiMQAxMQAqI8g8kIAu+YAwvcLYnFgRHoHMfgKN94A8c0AJGYAZLUAVd0ALrhXTpZZVS2x9RMBBY2Y1n+w3bgAJBMKVp4AN9oA6FkAcy8A8JIAMUsBVBAAGhyKA2xwFog6sEcXP6eQIXcHrJyxDLtFnOOxCioDSiYIIkIacG8Ya/mr1AsRayVzLKkAPMwr0JETeWMAkj0AmR4Q9/sAbKMAniQAlM4AEUIwC+YDzjkI1gmZDegAJD4B/qUAVzUAJCwAXlIARQkAZbiQIf8AHN6A99EAQOsLUk/A/9lnh9d3SncARXIAM3cANXcAMeLBBXIBBB/xAEblxwYZppBhO2XUsQyvbHJVAMvnoQ70OnQVG9qUi9B7EBtxA6MQzEPzEac/GsInMQbrcYCMIII1B3NHN/nZADNoAEPAAN8UsNApBfONACKmCVS1gGwdaImkmU4bAM5QCIRpCNy6cCy3jBipwGCBmrGamFo/kEEvQPRGADCpAAEdDBz0ebYZteEzzJFDQQyiFBFtQHwisQ34ACUGAEMJAFsyAQWJAQvlLDB0ExsUgKqKEJpkBgopDJA2E8q2fKRVELSOxJZJFNfioQhysOh/UP5+AOXPAPczAHCmADApAA1CANtwAKlRAO55CE2/AMY8CIZmCZhRgOQgrTCGAGz/+oc2WwBftAAQmQyHlAx3cAAbG7DX13fM9GB0EABALwD1PwD0WQyPdAx2SwA0GQBJobeOnVlQCoHEaAFVs7EGhZAUeQBcZwz8XgwwhhCm0atz7hgahXEHWr0D7RArmLCKVhEOMgFGhiFh4xAoEQiOz5nSXAAQqgBDQALgswAn9wBAGXBlBQS0JAjFWwBF2QngKBAG7wAK/6BmhQCIEcAfwQAYXMFT/9D62pc+uFzbCKM0uNHI6ytV8bwRMZsB+hAH2AlsLbUG6gXi0ACLOQBfl1zwkRALbwPqKQ1nZ6EKPjyUJh0O4Iop41EMqQDdsLQH26ENzRCUuABstQAu5QiAP/UQ4IkABGwASqkAKSKw42MKDPZwQ9uAR/YJ8usA1JqMZzYAatiQJ94AMUIMiD/A+CTAE+DdQoUKbE9m8SSashgRzuTAYICQHYTAdXmInb8MgDcW2SPM7Vx9Qz8A/F8A9veAylzJsebsOnp7H5Y6dTNG4QkclmDcTLWhCY0B4QnRBkERoJYRbaQJmDuOMZ/Q8lYANbEAJlkgKJEB3EoACY+w1HoAIo4A3PQIo+Z4Xf4JQl0MyCsA3+0NkRkAAaEAH+HQFevshBAKvOhpER6c6xa8dpcAds/g9b2gQu8A2hqMdXjoTS9k0cYANo4M6wdAr6+RXGAA3/AAjH0BJiZwqm/8DPr+EaOVwQnEwQzA3Xi0MwHYEQSFF7EV0QRjJ3tvzYXDAHGi0Qge0DQVBBOaANnXDYJ2EDVOYDKqB4J/alnBgC5TAHbuANb7AFhgCMQPAClXED4fwPeSAQzvcEdBB4sEkAnajsBNAEUlnUGblopDpl3vAAurNVWBUSNqMcSvcPY6BKSAADAjELsjAQstDiUjMQxz0QKm4QcCUKIS7pCgESPiRyDRYRhFkQkjAJLNEC3r3jgxjYfcDgZOADB7AMJ3ESImADLgAFDzAILmB4pNhz3hDnc1AFCHAE23AHD1AfAmAH/wAELZAAAjHsFEQGQUCFk6Z8SsiEKHAHEkyWbv9+fFUWidD2fI9HX2hAHFsgdJPHFRzABZhMCwIhAMaD7gWR1o1ASAfx6AsRNahwRWCXz/JOEDASWrVQC6MiFjSRGQoAE0byuIyQCLbcA/8wjBkdDkJwBBBABl7tA+ZgHYdtBkfwDAALmhF483+gxlxwBE3wAFBABALgKHrwDjZA8gwbusjhAMgHkQURjuol3ymGtQPxBI9Wik0AAWx5BI+HHA1FOWhglYUABbOiADOQ1GZd6AeN9JSgP+WHEGtalxtQ3Dgwfope9QWBGw4mrRFBPHf4AoSY0eUwiHPQBXX8DyoMAS9wKInQGInwB838AENgYlt5dEknBl0QiO5wBGP/UAiFQAQkcAJjoAdH0AJgcQcOsMjPp/IIy41KlwZYLQiKpgZJ92yhaHA+8LBHYJYCkdsJwQEA0SCOrH8FDR5EeFDVsk/LCoo6iAPVv1aAEl40eEtAgIJYMH4EGVLkSJIlTZ78h+YfB5G/MHnq1cHTpBz/xtVCeXFEwU7/SoT7Vy5ouDlbyBjc8S/ICwWJLhVMpO3IMx8VthEweEpQBRcVwpUw8yDNFn9b0JjdYsBHwSDb7jT592YsG0FYt5X5d+rg1YKnHhgcojfJP0F6CRMw/K+CEZUq//lbqaDCkYJbEPqjkYCgwc0jI/1TBRHjrWIhUXn4J2oiq4KoZmXJGVv2/2zatRE6PvgI079aJnAW9JQQUe/gJS0xyoEEwUGiCnwECYKwD5JRnSz9Y/DPSJoHp7D++4BNkCAx/7pwCbH9gYE+fSC43zHmn4sHQ+78+5YmzRsfaYaMN+iDxMAj7J+/xiOvIBTuwOqDggjwpqAj1KHsH8ss/MeGCnE7qEJAZvlHFo9CsiW1fz75x5TZYPNANNhsgzFGGW1jiSRPJCnIBIwmecQTREy6bgQjFjCIi3DC4cKNKKI76Kh/Rknkn0QYKcUIKCCwyjsC6/KminAQ8GEbH+4IIo0gyCiTwTtQeGKIbf4p400C/OEKhbrE+A4hMQQZIopAjNBKQTH0EsPBb//0quAfH9R5YSWyRkLDH1auOabEf26ZMTZa/mHtIkszBTVUURGqpZYMMgiVkQWMWGIOJKv4B6gjooMAISb/IaWTREZAgjvv8hzvg2/YQKCKIyCA4MwgXHDhgydQIAArQb6BgoslgvgAhbXgAo8AAT8gwAWDmhXkHzcCyauuugwK19shvkmUAyNYgsyxGi9MyIaCjoFRNINoSGhTkpwZtWCDZ8OtxotymKSTSUrSkaQFGBmhiCq4KAfJf0LgYowdam1yqX9KKYiRYKzEMlqVuzWjHAT+8MEbOqBF4RRooz1MjG2WUMKMNytDwUFssPmnTQcLKlfcgoQIJA29ov3mTXD/T6mAgCspROMIyAra+p9GE7IMDSykEWDTT01q5aAsOHKCohf/QSWd2jo7aIOD7y6YQ5AeBsZgG4TwMpwL/plDApBt7aMgSxLZ9RwIusOZsHLJ6OKrFtIAt9lvCbhK6W3M+CcQM9IoFwUImp2v3IPC3cZBMgh4wIweuDACmyHAuwqF/HyAIA0VhlDBH70NMqLrhIAoaBZZLKrNI0r8FSULVmihGyV+8cY+e5P6Lug3kXoZqWEhFRDiSKKKxRepghZ4ihRGlrHhcau+wwrCLkqowodToiagMJzj7EtBECAEI8znQS4QBLiOZpDWfeBnaSCAD5YjBDfwZX8PeBxiwIMN/71Yxh8fBKE/5PWRFjSgNNVDyABGwpHSJK8gHsDU2bQ3QxrGaBIPOwj4QAK+XkyiOP8wAQ4R0gkFQGAQF8tYOEJwq4MEYS2lEMECGDCCF/THO3Iq1HxCMAcbPAAFLogagBz0s3+kAVYFMcJ9CoI5CBKGQR9IYAL/cQcHEOgB5+DBHErQBWykAQI+gEIFmqCXUxQSG1BICYY40AIOvMAfNmgBRvxxggZwyiACABhCUvEPgqkCI59ABWyyIIB/0IIEBWEFNBDCkRAVRIYYud5HQFRDWookkiOpyY8MUgtEKEKHIdFFLR6WASEeZBKSyMEIbOCNZ1QgBCUoSBVYcrggfP9MX/+4hCWk8gAX2OkfKiPAM/5QDi5wwE6ncBC4CNAFAuAFKwr4x2eWQBkH3OE+d/gAg/7hFrjUb44E+ocDXiAEYwhhD/7ojjq2BgWGGsgHPvgLB3ywhYdO1AYcQAIHFHYQfbHiGJ35VAuHwQtppChFrfhEQ16xigngoCAtPMimkIGQYQzjGCCKhsBAotNa9nRGkdyoEDtAEvAFRxnF7N4/jjmJNXRgAS344rAMIoHoJKUgtTqCCERgiVFoCAKn8MZ3tpG0P8yhCz744ng05y0C4OkDaTiIWP55H3F9wEnbaJa4mBXQMs7RB+cQwhzGoYQ9qOABwjsCB85CGRUkCgr/HnyMhFRyzYT4AwvGYIUsWCOAEWHqHzMtiCr/4dJ/OOQV/2iEQQDBU5+21rUXYYkNxoHUoZbkRz+8CA4zcIntBM0bKpgDF/aQOISQwQeWqIErLNGoNKCAaPsEz7u4EIgjeGda4FpXW6O1jQrwYDkGgesd0iDeOdaxWT/LqwPgytdtcKAHJVBCCeihkhb4AA24oagDVGAgyHRNXv742kUKyKlOdUoAxbgeLD47S9CowpOqmIBJkOELgnHytRfGMEqQmpBOHAEFY/XGHpbjhucYJFk+cIMIKtHFB3gDnZyDsWL+ATOu7NNQYHyTC7ARoXj+QwFRAIkLsMVGb9hzrv8Y/0MQxtACJVTiBX8TyQMKcRl/GKEIGTKeQdBQjGsIoFMHscUwnKGPfzSjIAGYpSlUFGFo+EskzkCGM8xc4VjGkjMJyWSG9WywiB0EtwUhxj9cEZUXbKOQg+KCkZRSqyA87nELcIUPVFCVutiFc9uoghB8EK0yAKh/ehnPKdKAAGiWQAgGkc9B6ljegtzBLdtIQx1XfRAO8AAPShACPIenZa4Z7wiNskGAEcIEVBrEzMdAxjD00QwF/+MY1IBGA67RAGNUBBoMfjMnuQHaEG1KGigsiDNK5Nk9l3slBWnBrhHCCJP80iCSyAC7UWUJetObCZbYAldO4YIlFMQNSjEIkP85YIkuHgEKv4LuNrbxBiEg4QhPcKDCGbjPJ5DhFIGNxKlPsl62dPwgc0DAZ0qQ5YuUxSD1qlABSc4BYwDCFtNrBjJoIXOC5WMY/7hGAoRAAiasgAk0yEIxsH2SY9w8RBU2d9JllAHuiaQWfw7iPzJgiZ4wghQLwDowLmAEI6BADHRwQ6ILkmpUR9Kwp8CGBt/1ATYEYg5o+AZhWte6fxx8POEKQUEwUIIXAPki90TIHcigRiQX/iDexcUFlADPy5Q8shdyDGUP4g8FzEAAyDiGM4ZhCGTYYxjNGAYMEqAPBbwADS8ANiVVWQzmnYQVN3eGLGLfyosQRHnH+LLStaf/bpP0WaknKc51DJIISyhgAUwQBQk4EM5vPOEbXFjOWpqonQdA4cPfeAZACWeELfyHc9/wxt2vEh51CAEBruIBwEHm9+mvcSQ+MP8/vuuYrqUPIy1IuUq6BplIEmymi7iGf6ipnGsGJNgafygCAYCBgsAUD0ANTXGG0sisj7g5btO9CxQJHwKSnkAIUsAmV/ACSkICKBiEZzgFB4CVM/oYg3CiNLCKb/iGujgFM4C+JfAHCGI+BBmPb8iWNOCCf8AAg1gC6WO0i4iOOiI7VIuOrfm35SiBAUKCRLI/kOCACuGayqIBGGAFZICFYYgFe7iBBBiG4qkMyDMCgAmADQiA/4mQDWloNgw0N97DHqwjElxBiA0gAWiAKjcggDvogiqgrn9AFqXwuweIQQdyNW8oh2fCP2+oGe+AxEPxDjFQB5AziDnQNCYxABPzOIDrxMNxjAuYg4IogRLggVMDoUgBIZColyssOVqYAWeQhgFIgH/AguLJsk0sCDSwgWsogQkABFaCqZxAupDQPDhExoJQBGGqDQ+0AE3YgA24hQkgAXkoAqdxgThZAgTYAw4gA5AJAiDzARisiw8LhHP0gSN4gHXEID9qxwe4A2/whgqIrxLggiDkAWM5iOfguPbzxH/QxcowPz26AF7xGkWpoiPwAQ4gucm7qI/oLw4wsBNogf8sw5f0eYFbmIFhDBUz47bcS8aQNIkpyaaLIIEI24AsgKpnaBNFLAEjWBITW5Iw+QYCOAIjMAMzkKgHiBl1eACiwYZtkEdvEIubNAJL2IMSiAQEWI5bOgj3UKMiBIm/AB35EwJLGAdtOKhEKokWQD2REB56OQmvFABjMIahmxE4W4Q7E8mkk4RsCAldIQlS8IJ/kJt2+Ad2yMt/iIcX+I8rup8qWBKQARmxcIAjWIIS2INGcQOxeIPqSxfD0AsVIJ9AqAIFcIcgnIFSlCiDuJAtSJY6YqLKMIgH2IOCmANjmIMLEIJT3EqUeLKSCMiGDMh9lIZraCELlJGZ4oecALf/tjQYh5ERD6ABUmJAAZgIEmgFJoAAFMg+F1CHrxiDWpFKQXQALsg1lljHq3JBbkK7UPOGb4An1pS/C4CmfxACoSBNhABN6DgIfPEHJTjICxicgggHHnAk2PzKkYCMKcQI6WMJJpgBtJQRZAM9C/tN4IwRp0QJVPkHDmQEDkSIOhyJCQABu+QIjpiADYgwU+AAdqoLfuOCEvCBozgK+diBRosCILuDKEg1oqyAdfzLClCBQPIG7ISmwXFCg6hPlXgAdRgey5A+ITyj8zSIAfKBhvwIy/g1fZHD2FDI/KSFACyGCCwljGBDopuNYVhLBT0Y3YrLf6DQkkibg2iFFNCE/xcIq3IhAHV4JjMgQhNLljmNjt+StL8opEHwyYfagiMYUVIbxeW4AAS4gB80UulTgI3yTIPgAPksIKAY1KU5KCUFCa+0r1BhDAPUF1uQhmJoBjvjiA1oBFYyiIm4hRExGDPz0lFhhAKQ0ITwwIJwhdp4BVJwVcVgU34LrllJUauqJjqtFRUgSkTyHWzgyV0sCCTogeUwBlIrATwgxe8aMH/gAgrgATPIMpP7hx6wQb0DQgRYghxYAiTYAoraAoZUxQ8ioRdQrCeNjQuJQp1ChlAoCFX9B+NMCAHQiFXlV5AgEg4cU5SgBJKRhIdRnXDxihL4N8K8qo+5FRXoHYgS1v/02ZocEAIumAEhEIISuIAFyIAcYMqDGDAkUAIl4IEB45ACKgdojacnLIFzQIJx8IeHkqiKeijKSFKSw7+CgSQF4MV+CJFhkD1k4LZZwIFTytcYsVeEkIUE7deQkIB/iFqRiNWLIIVYndWTiDCESIQOYAAbMMEH2YYj4QLiKggTpc5k+QfDgqha8U9/WAIe4IJOsIG6HQcFUIB+G8UgXBomaKQFgK8SSB+V+EGf+IdM0AbzLFkuUNeEuC+JwllVRDcrFBUjQAIskBRamIZ6hQVk2CR+Ya2DwBQ7iw1Z+FSMcNqnNRhLqARX0ISToIKDSIEF6IkCwAQvKggHqoJyIKD/IQW4RkOWv8AQyiC5SGmkf0CCJRAKjYUvIaDP7yoILjhFBFAAjj21KCRFPfoMn+CikXUk41FSilqLDzreUNka1BOCF2CCWtS8krqILAUVAlVdjBC2kqjfkaiEgqgEENhalDitBZCEBYiBGCgAI8i+EEUiLigxJANe9+yd0mTPraEMMzhFIZiBCygoHugJG9Aj+ZsDTCQcBPCuvVsOd/iHI+AB0voHXCCcf6jFXMsayiCLA8SIcj03hgwJ/4SUgmikyysCLdwUL1xanyKz+Y0NRT2IfmM8hFji/pUNpmIEXSjgA+acLpiDKtij50CWw2EL3oGAMTALx7iviboQM0jP/xFlmtJjgjmQTyck1HDQ2PQUoO96gS7gge09iM9gTWzl4XRULEp9vHSMrJEAKpIoC5XggMxgAhLw1GbQh2I0mNS9CIIhCKMzYpQI2H/IX5EIACcmiSkpgAKAAhcYgsypAC5QhSORvtHsOCWTvrC81LKoMlOU4xYwgow1hsEBOVJrTXdQABuoXh4oARYuCNCB3oTggUhxxYpq3EG2EH+wQopCV7AxCCQogiOIAtzgPWhuFMsyyxKhhU0SwD3TzUvG5IuANFBhhD+QhAKIgSPAk2/yBjbgAi/JH/VholsZX668rwLiAhtog9JDAiPIgIt11khVggsovSJYAiGILzOY1f/BeQEKDtmEmAEbbMg+Zchmrpf8tBf7akgkgCdsUIFT6IIrCQkRGrBxWEBbvB7SNYk4MBh7MOeQYNCEMAdBOwhSoARKKAlU8AV8DYkpIWA+9JYy6AqMuYAh5MSr+sZvTBYZthAO2IIW0CPsPIdg4wMkKIeEbtZIuAAe4IFysIEiIJJTE4IiQIMiQAAMGJwWSEqMgIb07a//HN96iZSdTYjEMp5Iqh+VEQMUqIBv0GEePgeWMIJr2NyCcIRxjo1mAMnQNUaRAMmnvV+DuOmCsICCIDaMeKWEYA1bEGqD6AaEuJEO+IMCQAQ3+LC2gkEjCYfFFMTZ/ocoIExG0xomPYL/FsCfEc0Bf6iCHiiCDMgjXDtFJagCGzgHUzMoJVgGAEPPfxDFlci0+kSIJ1RXQK6vVawydWuMgmgUHmOXcLqLCvBdhHiBImiUFkiFzpBFx0aJ6TEIaShnkRjimnYtUjVTXLhQjGAESUAEdfCOJyCAbyAaBCgHEhWJpIACiBoDbwBu7AyHF1iC6l2GF7iCOViCP7ABHyi9HOABJSDUC2iDlQgH+fzBGYgEJfAHl73usO4E2nRc4p1ZyXNcIf2HCDlYB3kC7+CgCkBiyFDfAmqBZoiGf+AFg4gGmJ5sAVRVTiCzzbskkLDkkfhsOMzsnrKbg+jvi0DtGCAGI4AxcHmT/93lAivk4tkuQj8Kgi0wAvM7gEYRghxYgBdw7hDyBzcwA3btidbkABvANaEoxWXoGe5lymNGz/wkiT5Nt4cs7H9oARW4Cn0CFkFIO2mxv62RhSjkgGGYV4RohvseCXvgvHr4h8a+JM46CHoFCUg24sueoS4vCFlPiAJoGGIgBmVQJ9euAgRYBuqijFuRj+hgkrVQsijwASVYAA74g4vdXTSwBCEAIR8wgy+ggDb4gjlgV2Z4gWXwLiAEuUhoAzeADCNQAkO9RPNr5srCEMu4phconrctCA/niwAiJDY9iH7EkBfAAiw4PWlYBH3o0lC5OZDMB5EoDSbH75yYACrYUP+UKNOSuKH/JoYcEJc3OQU38BJyIjFaYcGPx6Bk2YJAOOzgqgIzfwEeCKFw6IE2wIM2+INgaIMR8IcWaM2DaE2t4ZoSsEQeVQIyNIlIYQzKbTx16J/vABAEYZeCOJT3vBAsmClYX/gayvIY8SxPzpSHSQRc7xVwwrR/EAdjAJ1GK66kaLQ0SFEI6AIjsAEe2IMjiRVt6IRzKIs+SIo24IE2mIMdeABx8AE4SFIOFoIv4AEDLAurWosL4FtSjJRHPwg0KJ4XuCUaTwh1+Id4Rpp714qnwYryQAitqZEnA9rZqGxQgQ0Du9d+nVqpVTpgyIAFAIZLmIpoyZx+K4elXuD/31WK6LBt6FjIERCCfjtN6d7wD+oDMtiBO/gCl9+B5j/+HUB+EHKDSHEPMoj+WMED6BWCP1j3kPg1aN5HvYGMuBOQfxCPApmWn3mC3LUdIDWIJFUJGCiCa0J1g6DpnDB1Ufk21Y3a1S+YtgGIfwIHEixocKCIRJYWALtk5Nk/Av8EPfn2r9ycKj6C/NvBccy/IBwhCEzz4Ei4ElX+hSv3bw6XKi/8+dtBBk6fL3gq7EgTJI3NHTaB9oFAiIzQoP84CERQ4p+SI/7+bTl40AjTqgR9EPRnQ8FAMdgk/jtVlsApQWXECDxF4NupBwNnMv13AqzARQNDXbPqV6C9WH8H/xP+x8oZQVkF9RGUVvgx5MiSBwv4C2rDQCrdnEwuCOzfiH9LgIk4gk3Qvw8EXHQJ929POQ5kBEb5VzvKGK4hH3AwI2TJhX/Bw1UR8gKNvy1Jd3zgAwFCn6DLpwsdSEa3kDn/SnBBHhnri39orPpjOgh1QUGot/3bZlGgmA9m/8kVeLyFQAE0EgzLN0xvZwPl81g9hB0ToFWsILhgZxL8g59klRkEAmYCeTCQHOQw+M8CpCyQyAhG/PMNAQRs88E2c5RTDhdHFBQEBEE8ECOMD9hwgUv/cLHiP0IogwZyfSC13IxwVCeUkUgNuUNBYlSlxBxOLUETZC4eMZ5faCChAv96/7AVEVpoCWIWAV+29Q02/0xlw3jNxFJPKAMi8w9jDKZCWIGE0WLLPwf+gxgyhgy4IaGFEuSgoX51U6gIloiwwBJHoCCQaicKp00JEkQBI0EcPTCSDyq4puNKKb3gjj/IQSCdUNFB0MYdTP4TXR8dKckkBCE4sEVV4YWjxEySvQChQVP5I+KYLqRp1RBdFvTBP7odwUF4w8CQTz35hIKMYAw2M5A9hjBW5yH2JGpQnuequ+4/XhzUSEEaFtrhApVYkoZAghBQKRctzfFHrTH+M1sauAkEgRFccBGCS/2W80IJSwiknJFCKUmGA230sOo/cBAihRU79AFHRx318AX/lSzxUAIHUxW2hctVaWUQV9ikNZFbVs1nkBhN6IYVEjAYws8/9RjCLoO0IL000wcR65e86i60ACJpoOBCaiW6UMUFVRDHAacHPwcjjGYgEA4XLP2DwDlc9MCVPyMv10etfXTxhRLQwSEFHVZ8jNNAQXzxhVQw94iqy4Ux9XSxlHqZmrMEqWdVifUd0YICMPxDtLgC1Yn0IU2LPjrpAbpCjAjEXIKEIF+WuFoI4YRQRQljyMVRSLhDcF0VDKsdUyDhuDje7khFR8jecJz8RQhwOC8FyHRA/w8hL33RRmip/nOEGSkXdsQLdU1ckBHqmEUmamYStM3NBqHmzUAthDIA/53/5OOI6LkM2tkwpfv/P2EkMRhLcMgSiViAEZ6gmqzFhw0sYZEZdPMPgXFkBxCQS3AaVoWMjEcrQhKKFUAmBTg0AQ+D68HeqEITmtBtCxDoQRvaEAI1DcR7hDkWDQWSuKlgjgCoQQ20oGUQ97CHcsviABYSUKCjCYQTACyduZ4oxc4gIgOIKIBfGGAJVyzgEgu4V87AtJolhGBHGdkISWYDkhkFwUUvcQ2LSsAVDmApKckjhBXgII7rtSEKK2zBEXzggxUS0gwuopKxIANI8cisIFPh0hPK8sOBkIWSpyjiQVDAFRv84xD52MQ/nAigpR0tXeh60yinOEVExe9/iP9AhCcw8Q9ETOIfxCCIJex1CTfAbyDbKJMLvnG2cIRjDhIsmUAs+IAZ9s5rQhARVbSyKjKM0ArWJMMXerAAmrzAKTwQwjd5ABOvYYUm3gkQ4wryAnWwL1+peWfkBOIerEELa/LU5D+MkIDP1e8gBeJngCARmTmpsqAHYSUAsWiQBYggGEe4gw/FaKIuKIyYJYjgbAzyADM8ZQ5oQxuWfKAV5YAhhNZUHh6Q448cKAEBuEAAAi6QCafMAQ9CUAI4c6Cmmf2Fp1PB0kHQoA5sKLAM6TvImP5xmp3l7C0feMIpRBQ0QQnkaKEAXScjY8rI5MKgXhXdoxYQhUm1pz372lf/TIgTEzQapA9LSJvswiGE+lAFqHDIo9+kkAQyrBABPLgATAMrWMHO4SmJiwwawhPUf3zlWVb5BnsEAdn3sKeyH7jD9v6BBILoJYqE6V9knFiYexDEs5EB6Fclg9CBsECKCiUIKTh0CUu8wgYuKBFbXqe1PXiUC+6QY0dwF5IJ/mEgxdyDBEVqHTBATwrioQkHeIAHAMwAATN9KXadQtM58GAmPPVLVRIXnsOKZylDlQhbJum+m6FFIBJ5r5cIgAIRJXFz/zBEKgeDWskw8TGg/Qsn9pdaQjlotaPDoif+0YGDLOAflrDEKFz0gUqZqMIu2AMxvcYFaA5XIByR2AO5/wAFgmwhKwIREgRgRhMfiDMSJQAsjAeLgCjNAQBCGBVQCVMVG4hPo2VlD1sqa9YiTk49XRJyZVHwADRgAQZ5ckQ97rHVrZ52Mqh1xjAAwaeDmHbAG+KkQAxMmF/8oxMCScRkakmQ1xLkEqS4BBMk5V6J7qsKCBBOOBCgEaTojiNd0M7a9uDGielGxTqkyTlaql2YGgOmhZUxAjBwASUogLyDGQ+brMIUm835vTlDjUSSmpb5wBct0JIIGoaRAIKIixNdDhBpC4WYv+zXywA0s5nXEJkOFEDN//A1QSoxgtl+wgZpUKB6KntWF7AhbSxB2xFI4uF/gEQB/aJdF6Q9EP8JAmk85UFADxwN6XEjIBJnU8Jmy/sXl6EBzFfh0jfQ455Ql1VyE2mWvOsd32ihYRH9sEc+BKMPe+Q3Uf39y1b/O5Ba23pDrUVQDhbMIET4RQSXcIWknnCas0QkLYL480cVBs0gyCoIYzCDR+ewBBV8N1pwmwoH3PGF7Dya0dqtbglcqt2XwrS66Lb0QaYCPoMYwLwqeEIlO16igZyvLE73EqnDaNQy0FUgAwrXYBzB8IN0taqFKTiV//HqhgPQBm4wlMQF8hmBkKLto0DCEeiAtSBbxIeCCCapymFnN0RhUyGJkQ+KK7tvVH0rgjxCF4TAncCaW8aA5UJMIX2BFyv/4RyP8UcLekwQF912gQLBxrwH8g3IRrIgkZ3I5AgyiMEQFEHN+NZkurx1svuvwAORZaHW/g/du/kSKbhAeIaQliEoFUyjhl8IPIoRdfiASRWEURdYUoVvcLhYaFAAH5QAAAxYd8Y6jxLPaz7Yl3JhGQmIBLoL448XGG58alLAKejg+YE0yyDYgFY72yLqpD6GqoS6qmTMHu09kZgtzSUcYArUgAI8wBOoR5oIwjbcH+oJgjewgUrMDnB5GIzcwRa81Rx8gwoUniMJxB8oGrmdYGCVgDYUwQUIQRsMml+UR44NxBEoAHoIkf5JYETkC+jFWyQ9Afv8ULMsi1+8GmPU/4MTiZbojN0ANiGhkEIl/EM3XIIIqIKxNYHojcl7vY5kQR7tlMNUQEBtFIUPjAEXwAT7yMUMHhoNvUAC5FykaVej5dxL0eHOySEPLMM/dBMFpNMIDkQjacmk+FCJnE8QEUTTjdqYRE48WYVe4A9BhM7BGYpo5cnBhZ0TZiKCuJkIiIA2+EAF4OCI/BIpfoNqeEMF9MsctMAfVIECdAEb7EEX0I7sLAEq1koMCgSWuAOUOAXkvdidBUfaBAdMrU1gtWAUokE5CMFgAN0ecgkdQBS0pJ495cskuQfqIaKRBYgkaqI3jk4GFEoUusI/VAIpRAISpMEpvAf/gdpElIFqOP/AW8mEEuwBd7DInV3bHaDAKRyTI8UMDRmBEPTABfzVAqwMTTXFP/DcQhoj+m0WGuzB2cWgpfkDEsQffBQi/0UEW8jHPwxBECoiEZoF8QkEHQyGXnTVIjCRQJESQczaN3pZOHaCmd0Sg4ACFB7gMryAWawHQdSdZJEiAagjBHyDCxzlNngDZKnA6EkSFLRcV6SM9rTAr8iVJdiAEDBjzq0NV74EQcQUsIiHCqxhDR3EC4CFAj3dmGDSD4HeRPwD1qAHarjlWr4lJkGGE2HihpiSXsZkQdVCmaFZoWhCKVTCMpzAC4TiQYSaW5xCpfyDSaDAU+2LY67GvnTBKVRAGpD/ZVRKJU10ghKUgBLYgD9M2nb8gzGcZmo+xeLBVI6gwSANBGfWEDSdZPGFyXwIAvElYtIJRBm8BQH85vmk3mMMACcg4bm05EBM4oaEjlUohl+mmQnQ5IaM4yUEgyWowjptQzyVojyRCFyQhA99gD2dlRhwJ1qIIHkQ0gq9ABcEghK0gEW2VFeWQEq8xJ3dWUtAyMx00F+0gDpsIUH0EjtCVnt8Qy8ZhIEKmW4GSLakVl8SBkxGZ2FMgpltiO65QjeQgiakwAOgAHHmS/ssVSG6wANUgEDU3VuqRiGeAlT+oZqwZ6owgRBcATd9k0JewALgwWluZRV0z2QYgQIUYunt/2Al8V97+YUWvqUkTQYvfMu3HGGiaF1hmBIk+sWVKmFBIMaEUqhfTIJgdsYPCMSY/oMrkEIKiIMRqM+zhJpEqIYYaFJaNKBkyRN7KNApYIM/qp+MYl4F8AF0teBAzEEn3GdTIEA57ME5OeNB7Mw7yZO+PQaSYYMDpImBih5/IU2Xdd1BxNpB4E+E/olAOMOWeenS1IBA4CQoaIM5HEEFVGNBRNQi7gtkWs0/lB6pvc4QaNKL3hAhqZQ37MEKYYAQ3NkMqMICFGtTzEAlFIIR2FBhHCkl7aBk/GZ7uEVTjsVAlAFkFNy6HA1z/oWnZhX+OOfYKchAKI2pLk0plCMpmP+DJQCA2eFb5JwV6pXJiTzAvhAnejQgWihXgPQpTZSYOWXHUwjBOZRAJBhjTMHdCkHGiDnO+pCFKA7EB0QOEB6oe9yBpaLHA3BrYfgHExLKyPpFPWyVpwqYQdBCM8gCl65rZEThCDCCCEBGNxSmK2iCNtiAx9WfO4GabqGFC0BABQThT+pLZu5pZwgsGqhYCyjBS8yBNhwkMToFEjzrw+bQYr1ObonkQKRJ++wge0gE+7yHxYKkb/5DE6inmCwGKeWJuVzpYNSDck5GM7ACN8AehRagVRwQmoWpVaDqmV2cJnyCGVhNEEXU0ymbarjAKUDAJbVFEe3rIHyD0gaswK7/kBEoQSRoRxEIQdWWQPkYC7QaBH6wRUdOxKVequn9mMYeRBHdX3AaxFuoh9m65D/8h0GULMwaysM9RoM9RtT8Qym8giusghGcQnotnTv9g7WWiCCggDfgC2rMhxiUyAc0ARTEJqGQbp+q2B4oARfwQML26FJIBenmog2wDr1BVdsmXVqAbKk15l1GREm+FxbiC0EQADZgA0SgBw08hmN0hiPobe82XC0xAGTISw2UAimAgisYQf1ZprMYqL5gzVC6QBFNmL5sgwtUwJJpHoN4r8ByQHYIwTj8llM8RQvATVn+xZBS7IFupOtCKpKVlQu0jjW2R5qQ4j9Ir3u5xyJa/wQWMg0yIIMBH3Dv4sMlgILxEu3YOuZbLmKzuEWJJOUgrscVo8DQ6aIOjfCh9SlyIEEJzMA5lEMJpPEyXAAaSEVXkIdAeAMKiMElvZcQMZU7whf/LdCoGS16rGNvuhfZjsUpQMS55FcoFIMSN2HNFka7/kMNdEMlMEMnpAHzSiw71p8PY6PqfsAzrK0R8AAl9MW6ZG6q0IMQFEFKlEA52AAYtt9jwA+LTtgvWcUNE9EU2+VEPCBBQAtXmC2t6rFAYNaGfEvrLTIyE0QNNMIrAIE6suUUKyKZ7KAijoi+qIMbCoQxTEENMSpkuAx7woyKbVYRoHF8KoA6pEp46ZgROP+A6BEfyNpbmpSacJZFkBliSZ4CVJkVWdVbGBGEZBbKI07GuCYzYYDEhrRdZJRCu1pCDdQAM7yAN3jeIV6sIMwz/pXVxe7LZJ6CP4xDK4CCKFwIQdCRoWQuGghBJ3ABpVmkVKhU6R6EviqpekBLxt5yvQVyalysVUTssvyStibKwNGPVv2DtxpK/3TpV/1uZ1yCQDj1IxMGvJBD8Y4COTCDDXjDEKTX4/yxvpgJfMkH9qJAGhwBE+DCP5BAFmxAALzwbEoGe8aoPxjDAoRmyxgBkGTtY6BAkRapmOxM0pXaYBwVUsUq7NoJMiyCyhZGuIrOMa+rAk9GJWjCJWjnbQX/sm5eNA5iUkY3ribRAA78QxaQgF0cQcRywOXCdTd7jw0sQXxiHh0REmTUxSI6amb7UqSaSE4vZsUWRIL+AxRU2LQ6r4Mi4aAsggAORD3Ygz7IrUGrSyJ8RiJE9VMTb2z5RSMsgyY0gg0Et3ox6Zy9E71Baoko2QngAg2gAg00QBFgDSe9NYLIdmIBCxpwgH3HtTfvoZqoA7fCF9M9nV/4d6NO42OogHyoRaQiiHMWtdEUSoE89nMPxGxV90AQEBP8w5sNhCuUAihYQCNogyaIgCa8gAQeIhHptg1LzphUiiZZACBMABa4iw3Mhgpw0rAkCgm3MXIwBUw/bH7rt4vU/6+k6raQ46BjOur+wiqsFopzVxmhDNw/8IJfHLVVkDKFOrVARGFBaILOUkIp4MMo2MBYcHUjetqY4Cp8bIN5X4MHkMB6v8B71Ti7aW3A0tAKbQFXjMd4yfaPKxYM6vRfCHjxha2gF4QKMA3WRbgTYvlBaEJBeIA5NEIUikAjvMAzMC/7qANk2fFABGXp1fRqoAAEKAACBDBmvAB6DEGNhw9VdO8XP5fLHEdcF4YPDE8XFKlf4DomVZal4OCX7KZV6Es+QSpH+kUvkYEI724SDwQsIENyAwYyJ8NgACaDoFnwWkV2U8I/vMK2/wM5iEAphHlT6QsQCQSwlySxZ40PDf9BGiiAMaT3LTwI6BEfAahAESjWjy+ty0yLOnvHj2+BXPjDibpjHl8rEVoFp52PfyM5YTD8ELFHb0tGLCBDk0/5giz2FClCQXE7KHD5QGj7hu5eMLzAEKjGIbpPiMLuUJIxCYT2f//DHQyBAhTB6LyAG+n1pWHJEbiFENFqZUFWI1psp+N2ZAW9LeP2ECW4VSx7QRyGMyS3li7NsxcU7v1FIjACQUQ2QWjDQEShJrzCdV+nDVz69Zp8eowaWQC6iRhBA3jALcR7p/2SIKiDtzFNrYfxZHCAClTSqVFr2Pby0D/de3Hr3xfG2IL3/j5G3iaKXlD5uRBNE/6C5f0F1gv/UKNbRSc2FJxX7AewYy4b/Tb80hHgAglMwARcgxF86FPliwoo1tIkxxfne7GkQQWghymGflld9EFYxC/djLDr23cbRG/T6tEfvv84/mAotamG4zmMgyQQ0EF8BtZjOPU/RimIwxEQAK5HxKmRyPNSK+2q+RHMAgmgwoUsoGTaZhmcQroxDRo8a4wGyAOogBRv68sLP/iLtWEzJv7/gztPK0AI+jeQIEEC/74VVFiw2UKHDxU6yrcpH0SLFzFmzGhCY8daGhlZsvSP2L9EJSG6IvVvgYhL4XwI+gBx27+aN206/EDgyQNRE/6BanXCRxMX/2Y+GdKRKUR/A58yRTNw/4sKgQW9Edj2bdvVgi6e1Jw50GvBmgIFjr2oduHNhAPfFjwycEBThcgI6hug1y7BRRUr9hU82O5Ih4kIMhq40uGoRgVFVKpko+DBnQQEETg18GDnf2KQVhaEwocFUP8oBRBlg0Drf09OfVDgg3BHyv+2DPb3oAJogqcEbQZOUK3Yfwe3YTto0HNZgkcXHvTNefnxgZsLeg1S2yG/gYsGG8oHfqGjTdzRd+zEZODHf8AOw9d4qRJBUiIWvBhydCxX6/8E2co6rrARBJuBxhKEjgfGEcWCCTwI4IWBxNjpOBWMICiq9Dgs6IFvDsJOkCEEKTEngXBCMC6HcCrrlGceOv9oKYIyq6mgmdgaaBsUagOsIEP+AbIjfhYZJpSB+oHhH30Gqoc8JjuM0iFXotSkktMGUomUEY4Y4gnPOjuFgCE0+2c4sgAUjoCEUEijCEpAMWUCXIy4w7PQNJSyoAm58+cbMTkTzsxBAa0Oo86WApQs4LC7qLXWTvENM0MF0+e8f+ohyDuCDtGTrnty8VTUjhbIiJT6/kF1IGAW8GHG4k78x5vrDDKRLLEEeUEVV2iwwBgj0gjLQs3UmWrUf3zgk7CnUMjqDuBMLHGrFInDUyHQbjrFRrLQwqhErQj4ADscF3LOLk6aJCgwuwwZZiAY7HFkIXftwSiWY0XFTyNQGEv/4Z9LuAQOTOuC+601gQ4KLuHjWjtCFS8o+WcCBR7gTFwz1Zlwww6jamGujfvi4B8VxBjiAxKF86pgQ0Ez1zobY6NxM5MV2gwzzgiqCTQxluOZsPEWyqeu9Da5ZwB7BpBmIHmHxNeiTrhT9SFzFkpkCdoGzdk/HXMy60SuvnGhCR9owGEDGgJQYC7OMhvZaTTWJgwNf9Io40DMSARwxuwUyvGhsobYVqEKptX7OJ+rJTdKpgsisi8gGV/S6Q4N62uEUjuqhMp/uRzo7uPUfM266jRbrlECmniAiQCmQGUCW9Z+4okyzFQBin9ATi8qNDJEzwcUsGM0698UphFAjBSl/7VRgrxBARvhweXbq+X2LvefNx6CkqDIB7pUMCX9WsiZyQmDmikGWCJIPoIuWQjLf0gZ5Y/fBdfaQDS97nYmFxYcBxoSbCGAaxxBEE+430Ho0LtRRcUfWNPNA1CAlptlxlyG8ttCzsS2b0ynINpK2HLUwh+khMsmNSGACwLkkDv8AwUrfMhe/rGpglSEPEwZR0YaQj6mKOAfGWgKYgiSiBEoZH0EMUUlLiGCgVxiAUdAwQdgA5rlYCaDlRkdhY7zgW+8wBgDmcU/jLANAhzoOMpRgMiO9QIO+ENZfUED3E5xiieMUCDT4VlmWvYZPZqpfjciiM/atpA7mGs6BRud8P8KJEeFzLErlFLIIjjhvYLIC12dYkobHZIKHf6jFx3JQZTgAwzGEEQb/yiFJUhBn9/lCGYIeQhOtlXAU7RAGsX44j+QUDGy7IQOPMSXx9iIu2VlRSYJcaQfyTUTl/0GRyS8oEKwccFuocU/gtiaTi6Sj0NoryBK81QON4kRqHXCE/8oZ0dKUZD2DSQRiKkc+1KliVIwwxJOPB5nQHQRE45ujuEizS2uIYuB0ICFBtmMCgZiLE9x4AgNzE1tfteZmylkUtNUXh8BZKi2UcqR3ygDByFlJhyJSU3LY6ZJQbYJ89wDEgoRkqjEE06LlNM9TAFiQRJhAYJYAnMQOeXvoJP/s649BBs3KWo0u8agGQjAFtKIw0OFilB8sdEfR4DqA4+3DTrcE0F9s9YFxxIusf6HbwOpQEEK9A1YKbNr1XTUP8jgt3wYol4L0ceRRIWXgegDXZ7qZEYkYU5MdMBTryhINf7RjX+8oolP7EsizYQWbYnhGTZYqi2sAQjaLOcqm8kdh54SzGRFyZkOsePx8viQOx4TIksJzvTIOsbXQnYhK2IYRiChPW7qCUp9lWlBzlmbdGYkicMtRSVWWa2z2OwikJVRcCZ0i3/MAAvJHRABfLlAq24Bk270hzfG9Y+tejWZoXlm4mJE1pwVCKkzKdxZqmlbhdjoQF/KSD2A5Fs9/41vMNz77UJc8YnNmWoUlVgFKZwou3sy6rUOSYjCSMqZHR1BANQgQQKMkJWDJIR2c2zBRT6rkdD6oKEKrA0ELNYE1b5lmhN1yKNYexERwcUsjTLpgjmTKI1456X/9bFF3ome+kTCFb/r6k6seRYyKsRc7g0LaRRwDVYga3lIHciHj5Wspyi0NhXbxmaaPMWwWFGfgvmA37QCoBJ94Cz/cKHF1MyUunaEsLUxxG4ZMpCX6lemwCDGTS8RMYJIzSGqqoQmhFJPxxr0H7SNjqHKZCaebMMICcBLC94Q1Ks8QarH8ocN/GEENHLnQwKpolANglHCvPlQBIuZQVpGgNRy9f/HD7EkRub8X0tIwhKAVkgpM7KMgYBCFUThEZMBxF6H2IogK2wZbLgIRm8YkCBIXaERQoye0E7oCHGrzXe/EUhrSUkmHMyIe2P1rYmCcNxS4oDI/AGOY6ErU1FKBCNuyiFNZOQTn9i3JoZyhFPQjiYssiCDCXCHChwBCwlw4gdUdgps0IzLevo03EwMUdAtzFw3tovpSofNyjCqTIISlPHOEiJRtaDierJkrmttESqN8iGC/ocq6FEUwdTPmgN5Bjbu4A0FzGALZ/VjQRR4VdD+Y41IECaHEqI4PcGSKW/ZSonQwuyugua86DFAzH9cXLt8YiBAycQ/INiXO4lOR2T/4okRoKCO2y4ENgr4cO6y3ZGpcMAH/kAClrWN0MzMhIOCWrJD0nCRnpN8INVzCHAgi7LIKpIsaYatlAC/SbxuUojpoYQpTDEQU+DC2PujCbu5piN2s/kZKHiADyogE5VtZTrFeogPHKibgRjB2+jpAovjknAPzerwGEQ2dnAi9cer7HjTZLYYwosveYO9KT21yA0zIplGrPMilKDCQHDwD4od++Rk1lbKTG5MJqvgAU0oHpqusiZcPiU39CdIy5vij6e0AAn4H0z7WeZF0K4gImhS0gtMIuUzygA5HoWDdAn+dKIzCmZ5Yiw9lI76ygciJkExIIIxliEYMuIVVOEf/0RB/IDKJq7pK2jN6rjCkWTiFCCgRgKHxkgIIRKi/wgCqkKNQ2ygu2qDjMTgCUBjCLChYsAM68St4KjFLNLMIpRv2ZYJA6VQnIIIJQhiiK5w3/5hFDKCEhrhE1pBFGYACR4ONv7k8tJkuQxktirINaBA4lAEM8ItZsTlQJBAZIwlKnzgNtDjBXKvT+LIgE7h2HbkeL4BZZTj8XBmIcQARDzoYAoukPAIxspgRKJQplKgiHSIA21KMTiRIHzJfCADC9OHCzGiEUzhCz/hMZAgudoMi1JPb1JoLQ4EG4LAg3puG4zKkAhAHf4uT3Yv8wZDjZ4uPdBABf6kIIxJWMZijv8cwoC6xWtgRdVopeAGgpFmBQN/YCCAYApJ8R++UTBWAtguohGc4DEoYQbOAYKoSNJeDHSGh1bySSG+YRDSICGSsVASxVBOQQGMgMtyw/YG4+tawGN6jzu2oBAEIqjwJyOOSaLQkCks6h8GAUZ0h0OCDAPzjRHQ5xOZYhQK7H0w4hNArwTWkUcChysuEetsBN2ODhtA5AEe4PfyR1s8Z4ROQR3UoQU2xu/QYy5swP8Eo4E+pFnoYCfcqwJpJBr/4SgEJ81sxGXGayEgjiwmzuhq4+v0BBF+K7AyolQ0cSG4jyBAENiYASNK8B9wQE48oAGKAIIyAztMZyYOBv2kiTr/YmMI/gT2tuIyQoPjSISEEuII0Cg3OKAIuOON0IAPOaQMhuNRWOwzXEwjXAZMjukxbawgmiAbLcIAlE4rp7Apbm0hksFphO0fQPATyHEhfuIfskBi/sELSoAMF81aGgxFZHEhlmIpNmxbYDANPIjwUE0yxchMbjAoCeIFDlIwXkAYueMJvUoiGzInqA6Dwo1Eim8gQDM0uZNDKoHsUgU8LSILUCH0cKAVGmEG3vLYjO8/5Cs6/qNRmiXtfgMmJSpM0mIITmEpFIAYB4I27M8uQotDnmc4rccuKvOKHiKO3LEzMwI08647fUw1tXAhRoEZTnMhcCALAkD01nICSuAt/1Vs2dKEM3VkjpxR9cSiqCQMBs/vvaizhHZiG85M1mCEh27DCC7QjZazL3ATPuEMFtdi7ZajOhXxGRxvIH/sAB7iXiCCzwiiCtIDfexiGQ5NI1qhIKBBDI0AghBHva7jNrUikUyHLByx2s4qDXgkZTxHcIQHT8JF4tRmMaFiMHJDWXY0I96zIGINcfYJI77MEpOnq7DIXLDHAn1MEbbRIZikHkZzIGSoNvJtIMJRI/bNFFfTIlrhPEPPFLJgDCEABURImvImO65JjG6iLDJDHR4AOPIIvn6jLTJKXGrCFxlTN3q0KSowrNCNGmuMLEoVIsYCK1mtTiUUIrjyISRJT/+Giyke4yFGQdgqVCM49R88AAa6lCcWRhFThorWxHTiT6RCQxAq4EMmj1Bcw5G2gXZ8w70q4Bs6rTa2IFcvomSYz3ooaIo6Io4mUCmdYzsfwljQIE+1k2AJY1EtIgYI4he647+szyFsbiGeVRtGECPW8h9MQRSEoAhClSFFgyx2pk1j9G/SLCGCoAw+gA5shepSJGyIQ9aOcqvUYdQIgzBzcF8TIvlQTsLIiuvwJFrKCkDMDdnarSkMVlTC8lhx6mEJwhxEYVoLAkMX6+YywhRwYARNgQeOIA226oKQg6iK52JeLQ1vZhvqRgwK9IoGBmGuJaNKBhuQIEJB7B8W8yn/ANZR9LU6/pQ6XIPM0uQfkpR87hZfHuEfdGAwbkBTNqkSDONhH6MRMvUhhK1iK1YcHmIEQeAfXiANWAsnhhZA/KNFCuSesOFLWu8BAZdrjLQheVWsfFFuMUI5M8Ix1eJkYhFbuKo4i9Z4LnFUHioqDGBwowQTlNYiLuchPIAELaISIgFjz/JZH2IV/iETVAEaTGEGjoB/wPS2jqnBrKjkHqVRvOEB4nVgvlVM/INIJwgFMAR2LcIfzqEFckN4CSJR6FJBD6ny5m7Zys+0RmU73bc2EIEIMqIAdKF4O+ISgmEZIkEbxPMiVuFqQdTN9tQJ1QxHIC50ueIJoEhArAkC/8CLyVpkOJJjCTvDQp7AHxloKIsgxNKgIsdoWxpRI3iVMEpLVAL4fTUiaRHYISL3IabVFDtCFWYgJgi1I27zM9Cv0VpmRrahXIGzgmxiifGX7oZABUDNWPOvBbLYIRJRd78hmjrjc6WEjDvkdxEyI7oxI5I1nJp3cqT3crP05kCgBAioBiVyRAiP2oLjJuZoJoyJALwhVFM3RccVdN+Lo8hCZZ/BH7/NBrZghRFiJwJnyWir61jGhqGoQ76Ofh90Y7QSdjvAX7pzLU1BiLeQO5jhE0AQgkWvFcDwAnDhBdQKHk0uCl/r/IJvH4+D4AgiDfRyw9LEQgzqYGTtIXliq/9eANQiWTDQ4AXwbwE7SEgpSm2d48sK1CYBtYeb4nC5U9hKqVIf4hXGEiIy1CFUwXIHAjxXYQQv4MMSRM1+9CHiQr66hVo+oAxQbBYFx1z4wwVrUeH+oR+wgG6d2R9EBo2CilqWkE+RsrTYInQBtwK2AyIOIju5+SG6cY1D8wIwVhvO2SIaAXovApUrlmojoZ3jeCCaFxc4wLHE1pCaa7ZGrtHud4pOoVWL00Dkb2y/7CDsKzqCAzoK+gpOgGYF1Hi+d2T9t456hh4FwRsqoAKK9SFQ0uuC1x+OVk9ImSB0oAC6sxwKYhTKeSHMgaQtopXpOC0V4jHI7nkxgAdeIKj/LapGdAIf64etcNNGtAIFQjUp0eIDoE8am9I5yKh5jsDpjuQK7M45M2IL3jBmpqNl2dYJnSlc4iJtC/gf3MBc2HNZfIyjM3oghMEj/2GlMeLsoOEfQCASWgEBFqJ5n3UGHM7jDGKoXuxzzfc1xCQI3mIO28wr9rPkBIF2JsUy9FNkQmEABmAYrgAJ/DAjQIZB0ys90pQgDEAoCUIRHOKzCWOr/yF4uYMIVuAfynsgUuASdApZubOdFiKkL2JTAyD0CsKjFaIBpgsBXqBvF8Ip+4Jln8NFceQtlE8QxEAg8LE//kjFsEBJmkEaiuQKehCNMQIrfUwrC3ohCnchPJkp/z5TVE7gvN+DfCIVPUawFBaAaozogTUiAOb4H3Dhvq9hIDKhFXAhV++Xr6mSRC3jZZDiTx4gUBFixvJIYQ7kEdcEWsoACuZCAFJBaexhEZDhBrg4xPTQbTy2q/i66+RmKvyvq9Ojwx3bITrcIUJ8Cgi4I1i8L4YmAoaGQ1IcItr5tAcCvh/ixf/hGmYAYz8BB+y4CX/jyzCKDQnkfoTqAw4Er22C/YgZRZ8R/pYsZl1ABfyhH6SBv5YkH0KhH4bh0wbUId5wCJ7hxjTj6r6hgtFjzAtiw0cFjSquzNEjIxX2H9rYIeosNE0hc1laI1ZbIYwBPF8bF+gzO2Zk7QhlAv8bFIMKxu1OwQGMR+Ison//QcXe4AgUIAFYwZvUZSBYAQ1aeMsIQpf2E9kXUUcibLTtQgIc1FNWYAVEezBuvSBaSqZOcxXUWjBwABouIBP+nOf4CAp7DgVpT6kV3SvGQKMUr0SWDGahYELWJc//YTz0wV1u6AU2Zm0OESGSUMu/JsvRXTDEW1TinSlGPjQjwRRIsi8CYMaL4RpwARqMYS7YYoKqmMEga3StiA2Z6wHoYKwIwpALAmWqw2QqAA3iIAHyIQIeAkj6QQAUQP92bzTgj7mqsWAc7eOlsOS9uaN9zJu6aCAQ4AEAfSldicnyJsn+dr5YdFrEiAD+Go+nc2f/XUlc0sAIruAfaug7+oEg6qUenpuhMgq8+pjWaCS+At5pklem9s0HnUYkCaPW/0t6QcC+MTb8BKMBjCHzZ6Fi/IYCp1l4Qod7bbl0tIJzTy0el1ozXKACOCAB3GUReswhrqAQ/uF24ihhgIOXa4a4T99Ttn6T1jtgCyKHF2LKFgKxLgKsMaIXiDc9PJryawP67aIY/qEYGiDstTk7gDA7sI5ApmVFrgJsVC/hIOihg5aYb0KQbcKvCyEO6uEa3r8i3hxT/iEB6LYFvqGF1P/n3MJE16uEAULQNwL/Cho8iBChP4NoEh4U5TCixIkUE7YouLCiwYsTLdCQyKRiDIe6/xAi0ogyZcVIBVmqTHiNWoNiRgp+Q3iq4LZ/H04JyunTp8EnEn8KGmLwlDcoOSWWEcTzn5h/BAj6/HbqQSAsCQzlO7goYgUCHwx++CYIG1SoZXciJPjSYcN/HAtyOJhiA5F/lkiR+gdKRFyJBv5tKTy44JbEjA/i01iyseTJFa8VawDoAcqja79tq1rR80Go/9KgoCjIBc+mnwU+SaNCQQJH/cJq3OmWJza0/0RLzE2ZYoeCTIAFN4i4YPK4GeUyPH6QGEVdkaFbl0ztX7QG1/6dthmx5z+gBE5NpVimvEGkFR6cwjbxm5hTT6zafP9Pnb98N/LBSHkKXAatVRBoCf+Rdp1DzvxDimAIFTDZQgstdthENkS03EQtvDAZKBoN908BMZx0UkGYJIgiRcYYtOI/NSFU1lplFSSIGHBto1ZToyH000EEPCCIgAnNSJUgH6gT1QcuVOCPEVcM819BN1SE2z87fUDgKcARsFMZKTpEg3QFpSCYDtcVlmFwd0HHhAUOQRgRnCZ9eRwuBhXj0DUN/DOALAk4FBROBR4kVFIFfUCAUYVSNSBKXCL6z1FPqDDXP/qkRFCmVOlIJ0OVRrRXQqF2GtFipEr0g0ollXgqinj+06JDtCSABQQEIRUVgRMdtSNpcPk62DMHIXrHG/7E0dWfKE1V5U4IJuTCGGn/MnZXcwYd8Ok/If1zQqsOTavRmmtSZqZBbkZ0ki5yehscNHZCY8xlDu3yT3b/7HkacEEReSCn4p0SY6RCQXWTXUcktA2uBTXUgjdDBAjGAwf/Uw8WBjVBFqMOEZAeVV6+5M9i2Sp3HAkUHRBhQssZgMa4zV00smHP0WXQR4mdW1ApKI3E7mR2/oPDP3j+XJA0966IJyv/IOENoP9ggyO/VgJ36IG9JTzYBf+YsaZm/iggwAvZ/jooeEQNKIggFbRqKmV1VQQutW8jNG5B1U32V0GV9Mwu0dZ0V9CrvBhEC3cQ/HOHxoz2+JZEBBHZowqNzVGCGcKS8Y8P/miW0DeQ/0bkE1xra9Q2cv9YW1HpCaKJukatRzT3cy3cxYEE/zwS3Aov3b0u34NBE7hGxijdG1lk5YRUTkYaVCXCVhY8ngtw7fEsQqr9c7hB5YRTjkEqEHTadw6lJbWh/7jhQ8oYKgTdyK9DF7ddHHDQckEg9lwAIr37/ntBxgD/D8AVjV4FSYVBfNAE4JQPdGUrSFCCZJAQVERyWjNIBQ2CDS5R7SBBKBu/xNcqA6iOfymJn0FGeJHY4Y+EwcEFNF5FkWvIgi5QgBSnhuUQHFWNRkL5GGPCEY6ClEFYKelgihBjQuW8j4WTWU7MmAjFxhhDGgJECAGNMbjTvSFxL2HNgHySvP+EIAAh2/vHGxzSvcQYMVJRRNES2wjHOFKEaDH8h9IUcIQmTMQFgqDaNm4CPTZu0CFVKMceuKCASgWxe0FsjANsZx0DSFKSchxM3OpmnXJVkn9mSIw0eMEFNEzMSgf5TETGMIaEcE4lfziIA/4RiVZucpaMeSOKUoAzWg6migYpAYqMhpL06XKYxORbtxLEKnZZo2e8LKYzn8k3HXRLBzZ7SQo2SYsAeosL0OymN1tFAw/8w2IpEactZvmJb6pznZThEDvfCc94vtOdKnuiZIjgpmOekzHV2KQqEmInDMhzoARV2TDFWcl/IiRWBW2oM+fCIVvKsZqjcqhFL4rRL+X/MooByKhHnfkCsfVsCrn7h3E+6jtMotSZF2rVMU12UXP8g44rralNb4rTnGZUaRsoCA0EcEydtmqUCYkCjAYp1KQahJwFEcBNjdGAPXEzIgA8yIqW0JiqQoEeH1FACx7QBM1IbzzV6xkkw7U+uyg1JQ2x5z90t9aIEA0XzZxMR/8xiwAqQR1oUEEZtlGWgSB1k+47neniKhGVIhaO0KBGXv9xTuKhAAVjfSb9FGuYES52sxrZE0q6cw07MbQx9ipIAAQwgz+h4QFjEdIwnXgQiXKWIpxQ6gwqYowVeRZWCeqoMUKBjH8A0wgE0KM6m5NEpWIWIdlM6p5mUNeExAqAacv8ZTMK0g88RspLiBHCTQ24UmFOhBLHqehsVYIMXlzqGgn4DxKM4NaEaNZ18JtMNuA6jHgmZ1rL3exu5ViMfOiDXs3oTnbfmVyJTKOgso0I7hLD1PNShoD/IGA+YqEsnLrJEc9U4UoDAgA7`
+
+func newTestServer(contentType string, body string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", contentType)
+		w.Write([]byte(body))
+	}))
+}
+
+func TestWithProperties(t *testing.T) {
+	srv := newTestServer("text/html", `<html><head>
+		<title>Page Title</title>
+		<meta property="og:title" content="OG Title">
+		<meta name="parsely-author" content="Jane Doe">
+		<meta property="al:ios:url" content="example://post/1">
+		<meta property="al:ios:url" content="example://post/2">
+	</head></html>`)
+	defer srv.Close()
+
+	p := NewParser().WithProperties(map[string]float64{
+		"parsely-author": 0.75,
+		"al:ios:url":     1,
+		"og:title":       0,
+	})
+
+	res, err := p.Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "Page Title", res.Title)
+	assert.Equal(t, map[string]string{
+		"parsely-author": "Jane Doe",
+		"al:ios:url":     "example://post/1",
+	}, res.Extras)
+
+	res, err = NewParser().Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "OG Title", res.Title)
+	assert.Nil(t, res.Extras)
+}
+
+func TestDuplicateStrategy(t *testing.T) {
+	srv := newTestServer("text/html", `<html><head>
+		<title>Page Title</title>
+		<meta property="og:title" content="Site Default">
+		<meta property="og:title" content="The Article's Own Title">
+		<meta property="og:title" content="Article">
+		<meta name="parsely-section" content="News">
+		<meta name="parsely-section" content="Sports">
+	</head></html>`)
+	defer srv.Close()
+
+	props := map[string]float64{"parsely-section": 1}
+
+	tests := []struct {
+		strategy DuplicateStrategy
+		title    string
+		section  string
+	}{
+		{"", "Site Default", "News"},
+		{DuplicateFirst, "Site Default", "News"},
+		{DuplicateLast, "Article", "Sports"},
+		{DuplicateLongest, "The Article's Own Title", "Sports"},
+		{DuplicateAll, "Site Default", "News"},
+		{"unknown", "Site Default", "News"},
+	}
+
+	for _, test := range tests {
+		res, err := NewParser().WithProperties(props).WithDuplicateStrategy(test.strategy).Parse(srv.URL)
+		assert.Nil(t, err, string(test.strategy))
+		assert.Equal(t, test.title, res.Title, string(test.strategy))
+		assert.Equal(t, test.section, res.Extras["parsely-section"], string(test.strategy))
+
+		if test.strategy == DuplicateAll {
+			assert.Equal(t, map[string][]string{
+				"title":           {"Site Default", "The Article's Own Title", "Article"},
+				"parsely-section": {"News", "Sports"},
+			}, res.AllValues)
+		} else {
+			assert.Nil(t, res.AllValues, string(test.strategy))
+		}
+	}
+}
+
+func TestConcurrentParse(t *testing.T) {
+	srv := newImageServer(`<html><head>
+		<title>Shared</title>
+		<meta property="og:image" content="/a.png">
+		<script type="application/ld+json">{"@type": "Recipe", "name": "Toast"}</script>
+	</head><body><article><p>One parser, many goroutines.</p></article><img src="/b.png"></body></html>`,
+		map[string][2]int{"/a.png": {200, 100}, "/b.png": {100, 100}})
+	defer srv.Close()
+
+	var events int64
+	p := NewParser().
+		WithTextExtraction().
+		WithWordCount().
+		WithPlaceholder(8).
+		WithResultCache(time.Minute, 10).
+		WithTrackingParamsStripped().
+		WithEventHandler(func(Event) { atomic.AddInt64(&events, 1) })
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			res, err := p.Parse(fmt.Sprintf("%s/%d?utm_source=test", srv.URL, i%5))
+			assert.Nil(t, err)
+			assert.Equal(t, "Shared", res.Title)
+			assert.Equal(t, fmt.Sprintf("%s/%d", srv.URL, i%5), res.URL)
+			if assert.Len(t, res.Images, 2) {
+				assert.Equal(t, srv.URL+"/a.png", res.Images[0].URL)
+			}
+			assert.NotEmpty(t, res.Placeholder)
+			if assert.NotNil(t, res.Recipe) {
+				assert.Equal(t, "Toast", res.Recipe.Name)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	assert.NotZero(t, atomic.LoadInt64(&events))
+}
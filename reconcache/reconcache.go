@@ -0,0 +1,111 @@
+// Package reconcache provides reference implementations of recon.Cache: an in-process LRU and a Redis-backed store
+// that lets multiple instances share scraped Results.
+package reconcache
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/jimmysawczuk/recon"
+	"github.com/jimmysawczuk/recon/internal/lru"
+)
+
+// LRU is an in-process recon.Cache that holds a fixed number of entries, evicting the least recently used one when
+// it's full. It's safe for concurrent use.
+type LRU struct {
+	mu    sync.Mutex
+	cache *lru.Cache[string, recon.CacheEntry]
+}
+
+// NewLRU returns an LRU that holds up to size entries.
+func NewLRU(size int) *LRU {
+	return &LRU{
+		cache: lru.New[string, recon.CacheEntry](size),
+	}
+}
+
+// Get implements recon.Cache.
+func (l *LRU) Get(ctx context.Context, key string) (recon.CacheEntry, bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entry, ok := l.cache.Get(key)
+	return entry, ok, nil
+}
+
+// Set implements recon.Cache.
+func (l *LRU) Set(ctx context.Context, key string, entry recon.CacheEntry) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.cache.Add(key, entry)
+	return nil
+}
+
+// RedisClient is the subset of a Redis client used by Redis. It's small enough to adapt any Redis library to; for
+// example, with github.com/redis/go-redis:
+//
+//	type goRedis struct{ *redis.Client }
+//
+//	func (c goRedis) Get(ctx context.Context, key string) ([]byte, bool, error) {
+//		b, err := c.Client.Get(ctx, key).Bytes()
+//		if err == redis.Nil {
+//			return nil, false, nil
+//		}
+//		return b, err == nil, err
+//	}
+//
+//	func (c goRedis) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+//		return c.Client.Set(ctx, key, value, ttl).Err()
+//	}
+type RedisClient interface {
+	// Get returns the value stored under key, and false if there isn't one.
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+
+	// Set stores value under key, expiring it after ttl. A ttl of 0 means the value doesn't expire.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+}
+
+// Redis is a recon.Cache that stores entries as JSON in Redis, so that multiple instances can share them.
+type Redis struct {
+	client RedisClient
+	prefix string
+	ttl    time.Duration
+}
+
+// NewRedis returns a Redis cache that stores entries using client. Keys are prefixed with prefix, and entries
+// expire after ttl (0 means never).
+func NewRedis(client RedisClient, prefix string, ttl time.Duration) *Redis {
+	return &Redis{
+		client: client,
+		prefix: prefix,
+		ttl:    ttl,
+	}
+}
+
+// Get implements recon.Cache.
+func (r *Redis) Get(ctx context.Context, key string) (recon.CacheEntry, bool, error) {
+	b, ok, err := r.client.Get(ctx, r.prefix+key)
+	if err != nil || !ok {
+		return recon.CacheEntry{}, false, err
+	}
+
+	var entry recon.CacheEntry
+	if err := json.Unmarshal(b, &entry); err != nil {
+		return recon.CacheEntry{}, false, err
+	}
+
+	return entry, true, nil
+}
+
+// Set implements recon.Cache.
+func (r *Redis) Set(ctx context.Context, key string, entry recon.CacheEntry) error {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	return r.client.Set(ctx, r.prefix+key, b, r.ttl)
+}
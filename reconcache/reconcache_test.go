@@ -0,0 +1,85 @@
+package reconcache
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jimmysawczuk/recon"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	_ recon.Cache = &LRU{}
+	_ recon.Cache = &Redis{}
+)
+
+func TestLRU(t *testing.T) {
+	ctx := context.Background()
+	c := NewLRU(1)
+
+	assert.Nil(t, c.Set(ctx, "a", recon.CacheEntry{ETag: "a"}))
+	entry, ok, err := c.Get(ctx, "a")
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "a", entry.ETag)
+
+	assert.Nil(t, c.Set(ctx, "b", recon.CacheEntry{ETag: "b"}))
+	_, ok, _ = c.Get(ctx, "a")
+	assert.False(t, ok)
+}
+
+type fakeRedis struct {
+	mu   sync.Mutex
+	data map[string][]byte
+	ttls map[string]time.Duration
+}
+
+func (f *fakeRedis) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	b, ok := f.data[key]
+	return b, ok, nil
+}
+
+func (f *fakeRedis) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.data[key] = value
+	f.ttls[key] = ttl
+	return nil
+}
+
+func TestRedis(t *testing.T) {
+	ctx := context.Background()
+	client := &fakeRedis{data: map[string][]byte{}, ttls: map[string]time.Duration{}}
+	c := NewRedis(client, "recon:", time.Hour)
+
+	_, ok, err := c.Get(ctx, "https://example.com/")
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	published := time.Date(2016, 10, 1, 0, 0, 0, 0, time.UTC)
+	in := recon.CacheEntry{
+		Result: recon.Result{
+			URL:       "https://example.com/",
+			Title:     "Example",
+			Published: &published,
+			Images:    []recon.Image{{URL: "https://example.com/a.png", Width: 10, Height: 5, AspectRatio: 2}},
+		},
+		ETag: `"v1"`,
+	}
+	assert.Nil(t, c.Set(ctx, "https://example.com/", in))
+	assert.Equal(t, time.Hour, client.ttls["recon:https://example.com/"])
+
+	out, ok, err := c.Get(ctx, "https://example.com/")
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, in.ETag, out.ETag)
+	assert.Equal(t, in.Result.Title, out.Result.Title)
+	assert.Equal(t, in.Result.Images, out.Result.Images)
+	assert.True(t, published.Equal(*out.Result.Published))
+}
@@ -0,0 +1,228 @@
+// Package reconchrome provides a recon.Renderer that loads pages in headless Chrome via chromedp, so that metadata
+// added to a page by JavaScript is visible to recon.
+//
+//	p := recon.NewParser().WithRenderer(reconchrome.New())
+//
+// Chrome (or Chromium) must be installed. Each page is loaded in a fresh browser, so pages can't see each other's
+// cookies or storage.
+package reconchrome
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chromedp/cdproto/cdp"
+	"github.com/chromedp/cdproto/fetch"
+	"github.com/chromedp/cdproto/network"
+	"github.com/chromedp/chromedp"
+	"github.com/jimmysawczuk/recon"
+	"github.com/pkg/errors"
+)
+
+// DefaultBudget is how long a Renderer lets a page's scripts run by default, once it has loaded.
+const DefaultBudget = 2 * time.Second
+
+// Renderer renders pages by loading them in headless Chrome and reading back the resulting DOM.
+type Renderer struct {
+	// Path is the Chrome executable to run. If empty, chromedp looks for common executable names on $PATH.
+	Path string
+
+	// Options are extra options for Chrome, e.g. chromedp.NoSandbox when running as root in a container. They're
+	// applied after chromedp.DefaultExecAllocatorOptions.
+	Options []chromedp.ExecAllocatorOption
+
+	// Budget is how long the page's scripts are given to run after it loads, before its DOM is read.
+	Budget time.Duration
+}
+
+// New returns a Renderer with the default budget.
+func New() *Renderer {
+	return &Renderer{
+		Budget: DefaultBudget,
+	}
+}
+
+// Render implements recon.Renderer. The body it returns implements recon.PageReporter, describing the response the
+// page was loaded from. Navigations of the page to hosts the parser isn't allowed to fetch from are blocked, per
+// recon.CheckURL.
+func (r *Renderer) Render(ctx context.Context, rawURL string) (io.ReadCloser, error) {
+	u, err := checkScheme(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := append(chromedp.DefaultExecAllocatorOptions[:], r.Options...)
+	if r.Path != "" {
+		opts = append(opts, chromedp.ExecPath(r.Path))
+	}
+
+	allocCtx, cancelAlloc := chromedp.NewExecAllocator(ctx, opts...)
+	defer cancelAlloc()
+
+	tabCtx, cancelTab := chromedp.NewContext(allocCtx)
+	defer cancelTab()
+
+	// the tab has to exist before its events can be listened to
+	if err := chromedp.Run(tabCtx); err != nil {
+		return nil, errors.Wrap(err, "start chrome")
+	}
+
+	nav := &navigation{mainFrame: cdp.FrameID(chromedp.FromContext(tabCtx).Target.TargetID)}
+	chromedp.ListenTarget(tabCtx, func(ev interface{}) {
+		switch ev := ev.(type) {
+		case *fetch.EventRequestPaused:
+			// the listener mustn't block, and answering the event is a round trip to the browser
+			go nav.intercept(ctx, tabCtx, ev)
+		case *network.EventRequestWillBeSent:
+			nav.requested(ev)
+		case *network.EventResponseReceived:
+			nav.responded(ev)
+		}
+	})
+
+	var html string
+	err = chromedp.Run(tabCtx,
+		network.Enable(),
+		fetch.Enable().WithPatterns([]*fetch.RequestPattern{{URLPattern: "*", ResourceType: network.ResourceTypeDocument}}),
+		chromedp.Navigate(u.String()),
+		chromedp.Sleep(r.Budget),
+		chromedp.OuterHTML("html", &html, chromedp.ByQuery),
+	)
+	if blocked := nav.blockedErr(); blocked != nil {
+		return nil, blocked
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "render")
+	}
+
+	return &page{Reader: strings.NewReader(html), info: nav.page()}, nil
+}
+
+// checkScheme parses rawURL, only allowing absolute http(s) URLs, so that Chrome can't be pointed at local files or
+// its own internal pages.
+func checkScheme(rawURL string) (*url.URL, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse url")
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, errors.Errorf("unsupported url scheme %q", u.Scheme)
+	}
+
+	return u, nil
+}
+
+// navigation follows the loading of a tab's main document: its redirects, its final response, and whether any of
+// its navigations were blocked.
+type navigation struct {
+	mainFrame cdp.FrameID
+
+	mu        sync.Mutex
+	requestID network.RequestID
+	redirects []string
+	response  *network.Response
+	blocked   error
+}
+
+// intercept lets a paused document request continue if the parser is allowed to load it, and fails it otherwise.
+func (n *navigation) intercept(parserCtx, tabCtx context.Context, ev *fetch.EventRequestPaused) {
+	c := chromedp.FromContext(tabCtx)
+	execCtx := cdp.WithExecutor(tabCtx, c.Target)
+
+	if err := recon.CheckURL(parserCtx, ev.Request.URL); err != nil {
+		if ev.FrameID == n.mainFrame {
+			n.mu.Lock()
+			n.blocked = err
+			n.mu.Unlock()
+		}
+		fetch.FailRequest(ev.RequestID, network.ErrorReasonBlockedByClient).Do(execCtx)
+		return
+	}
+
+	fetch.ContinueRequest(ev.RequestID).Do(execCtx)
+}
+
+func (n *navigation) requested(ev *network.EventRequestWillBeSent) {
+	if ev.Type != network.ResourceTypeDocument || ev.FrameID != n.mainFrame {
+		return
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	// only the first document counts; later ones are navigations by the page's scripts
+	if n.requestID == "" {
+		n.requestID = ev.RequestID
+	}
+	if ev.RequestID == n.requestID && ev.RedirectResponse != nil {
+		n.redirects = append(n.redirects, ev.RedirectResponse.URL)
+	}
+}
+
+func (n *navigation) responded(ev *network.EventResponseReceived) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if ev.RequestID == n.requestID && n.response == nil {
+		n.response = ev.Response
+	}
+}
+
+func (n *navigation) blockedErr() error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return n.blocked
+}
+
+// page describes the main document's response, as a recon.RenderedPage.
+func (n *navigation) page() recon.RenderedPage {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	info := recon.RenderedPage{
+		Redirects: n.redirects,
+	}
+	if n.response != nil {
+		info.URL = n.response.URL
+		info.StatusCode = int(n.response.Status)
+		info.Header = httpHeader(n.response.Headers)
+	}
+
+	return info
+}
+
+// httpHeader converts the headers of a DevTools response, which joins repeated headers with newlines.
+func httpHeader(headers network.Headers) http.Header {
+	h := http.Header{}
+	for k, v := range headers {
+		for _, line := range strings.Split(fmt.Sprint(v), "\n") {
+			h.Add(k, line)
+		}
+	}
+
+	return h
+}
+
+// page is a rendered page's DOM, serialized as HTML.
+type page struct {
+	*strings.Reader
+	info recon.RenderedPage
+}
+
+// Close implements io.Closer.
+func (p *page) Close() error {
+	return nil
+}
+
+// RenderedPage implements recon.PageReporter.
+func (p *page) RenderedPage() recon.RenderedPage {
+	return p.info
+}
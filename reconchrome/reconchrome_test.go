@@ -0,0 +1,130 @@
+package reconchrome
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/chromedp/cdproto/network"
+	"github.com/chromedp/chromedp"
+	"github.com/jimmysawczuk/recon"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _ recon.Renderer = &Renderer{}
+var _ recon.PageReporter = &page{}
+
+func TestCheckScheme(t *testing.T) {
+	u, err := checkScheme("https://example.com/spa#/route")
+	assert.Nil(t, err)
+	assert.Equal(t, "https://example.com/spa#/route", u.String())
+
+	for _, u := range []string{"--user-data-dir=/tmp", "file:///etc/passwd", "javascript:alert(1)", "chrome://settings"} {
+		_, err := checkScheme(u)
+		assert.NotNil(t, err, u)
+	}
+}
+
+func TestNavigation(t *testing.T) {
+	nav := &navigation{mainFrame: "main"}
+
+	nav.requested(&network.EventRequestWillBeSent{RequestID: "img", Type: network.ResourceTypeImage, FrameID: "main"})
+	nav.requested(&network.EventRequestWillBeSent{RequestID: "frame", Type: network.ResourceTypeDocument, FrameID: "child"})
+	nav.requested(&network.EventRequestWillBeSent{RequestID: "doc", Type: network.ResourceTypeDocument, FrameID: "main"})
+	nav.requested(&network.EventRequestWillBeSent{
+		RequestID:        "doc",
+		Type:             network.ResourceTypeDocument,
+		FrameID:          "main",
+		RedirectResponse: &network.Response{URL: "http://example.com/", Status: 301},
+	})
+	nav.responded(&network.EventResponseReceived{RequestID: "frame", Response: &network.Response{URL: "https://ads.example.com/", Status: 200}})
+	nav.responded(&network.EventResponseReceived{RequestID: "doc", Response: &network.Response{
+		URL:     "https://example.com/",
+		Status:  200,
+		Headers: network.Headers{"Content-Type": "text/html", "Set-Cookie": "a=1\nb=2"},
+	}})
+
+	// a later navigation by the page's scripts
+	nav.requested(&network.EventRequestWillBeSent{RequestID: "spa", Type: network.ResourceTypeDocument, FrameID: "main"})
+	nav.responded(&network.EventResponseReceived{RequestID: "spa", Response: &network.Response{URL: "https://example.com/app", Status: 200}})
+
+	assert.Equal(t, recon.RenderedPage{
+		URL:        "https://example.com/",
+		StatusCode: 200,
+		Header:     http.Header{"Content-Type": {"text/html"}, "Set-Cookie": {"a=1", "b=2"}},
+		Redirects:  []string{"http://example.com/"},
+	}, nav.page())
+}
+
+// chromePath returns a Chrome executable to test with, from $CHROME_PATH or $PATH.
+func chromePath() string {
+	if path := os.Getenv("CHROME_PATH"); path != "" {
+		return path
+	}
+
+	for _, name := range []string{"google-chrome", "google-chrome-stable", "chromium", "chromium-browser", "chrome", "headless-shell"} {
+		if path, err := exec.LookPath(name); err == nil {
+			return path
+		}
+	}
+
+	return ""
+}
+
+func TestRender(t *testing.T) {
+	path := chromePath()
+	if path == "" {
+		t.Skip("chrome isn't installed")
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, `<html><head><title>Loading</title>
+<script>
+var m = document.createElement("meta");
+m.setAttribute("property", "og:title");
+m.setAttribute("content", "Rendered");
+document.head.appendChild(m);
+</script></head><body></body></html>`)
+	})
+	mux.HandleFunc("/moved", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/", http.StatusFound)
+	})
+	mux.HandleFunc("/metadata", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "http://169.254.169.254/latest/meta-data/", http.StatusFound)
+	})
+	mux.HandleFunc("/feed", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{}`)
+	})
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	r := New()
+	r.Path = path
+	r.Options = []chromedp.ExecAllocatorOption{chromedp.NoSandbox}
+	r.Budget = 500 * time.Millisecond
+
+	p := recon.NewParser().WithRenderer(r).WithBlockedHosts("169.254.169.254")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	res, err := p.ParseContext(ctx, ts.URL+"/moved")
+	require.Nil(t, err)
+	assert.Equal(t, "Rendered", res.Title)
+	assert.Equal(t, ts.URL+"/", res.FinalURL)
+
+	_, err = p.ParseContext(ctx, ts.URL+"/metadata")
+	assert.True(t, errors.Is(err, recon.ErrHostNotAllowed), "%v", err)
+
+	_, err = p.ParseContext(ctx, ts.URL+"/feed")
+	assert.True(t, errors.Is(err, recon.ErrNotHTML), "%v", err)
+}
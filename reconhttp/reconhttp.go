@@ -0,0 +1,377 @@
+// Package reconhttp provides an http.Handler that serves recon results, so that Go services can mount a link preview
+// endpoint directly:
+//
+//	http.Handle("/preview", reconhttp.NewHandler(recon.NewParser(), reconhttp.Options{}))
+//
+// Requests look like GET /preview?url=https://example.com/, and are answered with the Result for the URL as JSON.
+package reconhttp
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/jimmysawczuk/recon"
+	"github.com/pkg/errors"
+)
+
+// ErrForbiddenAddress is returned when a URL resolves to an address that isn't publicly routable, such as a loopback
+// or private network address.
+var ErrForbiddenAddress = errors.New("forbidden address")
+
+// DefaultTimeout is how long a Handler spends parsing a URL by default.
+const DefaultTimeout = 15 * time.Second
+
+// Options configures a Handler.
+type Options struct {
+	// Timeout bounds the time spent parsing each URL. If it's 0, DefaultTimeout is used.
+	Timeout time.Duration
+
+	// MaxAge is the max-age of the Cache-Control header sent with successful responses. If it's 0, responses are sent
+	// with Cache-Control: no-cache, so clients revalidate them using their ETag.
+	MaxAge time.Duration
+
+	// AllowPrivate disables the check that URLs resolve to publicly routable addresses. It's meant for tests and
+	// trusted internal deployments.
+	AllowPrivate bool
+
+	// Resolver resolves hostnames while checking URLs. If it's nil, net.DefaultResolver is used.
+	Resolver *net.Resolver
+}
+
+// Handler serves the Result for the URL in its url query parameter as JSON.
+//
+// URLs must be absolute http or https URLs without credentials, and unless Options.AllowPrivate is set, must resolve
+// to publicly routable addresses. That's checked before the page is fetched, and again by the parser's dialer for
+// every connection it makes, so that redirects and DNS rebinding can't reach internal addresses either (though if the
+// parser uses a proxy, only the proxy's address can be checked). Concurrent requests for the same URL share a single
+// parse.
+type Handler struct {
+	parser *recon.Parser
+	opts   Options
+
+	mu       sync.Mutex
+	inFlight map[string]*call
+}
+
+type call struct {
+	done chan struct{}
+	res  recon.Result
+	err  error
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// NewHandler returns a Handler that parses URLs with p. Unless opts.AllowPrivate is set, it sets p's dialer to
+// NewSafeDialer, replacing any set with WithDialer, so that every connection p makes is checked.
+func NewHandler(p *recon.Parser, opts Options) *Handler {
+	if opts.Timeout <= 0 {
+		opts.Timeout = DefaultTimeout
+	}
+
+	if opts.Resolver == nil {
+		opts.Resolver = net.DefaultResolver
+	}
+
+	if !opts.AllowPrivate {
+		p.WithDialer(NewSafeDialer())
+	}
+
+	return &Handler{
+		parser:   p,
+		opts:     opts,
+		inFlight: map[string]*call{},
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	target, err := h.validate(r.Context(), r.URL.Query().Get("url"))
+	if errors.Is(err, ErrForbiddenAddress) {
+		writeError(w, http.StatusForbidden, err.Error())
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	res, err := h.parse(r.Context(), target)
+	if errors.Is(err, context.DeadlineExceeded) {
+		writeError(w, http.StatusGatewayTimeout, err.Error())
+		return
+	}
+	if errors.Is(err, ErrForbiddenAddress) {
+		writeError(w, http.StatusForbidden, err.Error())
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	body, err := json.Marshal(res)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	etag, err := etagOf(res)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	w.Header().Set("ETag", etag)
+	if h.opts.MaxAge > 0 {
+		w.Header().Set("Cache-Control", "public, max-age="+strconv.Itoa(int(h.opts.MaxAge.Seconds())))
+	} else {
+		w.Header().Set("Cache-Control", "no-cache")
+	}
+
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Write(append(body, '\n'))
+}
+
+// etagOf returns an ETag identifying the content of res, which ignores when it was scraped.
+func etagOf(res recon.Result) (string, error) {
+	res.Scraped = time.Time{}
+	buf, err := json.Marshal(res)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(buf)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`, nil
+}
+
+// validate checks that raw is an absolute http or https URL that's safe to fetch, returning it in normalized form.
+func (h *Handler) validate(ctx context.Context, raw string) (string, error) {
+	if raw == "" {
+		return "", errors.New("missing url parameter")
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid url")
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", errors.New("url must be an http or https URL")
+	}
+
+	if u.Hostname() == "" {
+		return "", errors.New("url must have a host")
+	}
+
+	if u.User != nil {
+		return "", errors.New("url must not contain credentials")
+	}
+
+	if !h.opts.AllowPrivate {
+		if err := checkHost(ctx, h.opts.Resolver, u.Hostname()); err != nil {
+			return "", err
+		}
+	}
+
+	u.Fragment = ""
+	return u.String(), nil
+}
+
+// parse parses target, sharing the parse with any other in-flight requests for the same URL. The parse isn't bound
+// to ctx, so that one caller going away doesn't fail the others, but ctx still bounds how long this caller waits.
+func (h *Handler) parse(ctx context.Context, target string) (recon.Result, error) {
+	h.mu.Lock()
+	c, ok := h.inFlight[target]
+	if !ok {
+		c = &call{done: make(chan struct{})}
+		h.inFlight[target] = c
+
+		go func() {
+			pctx, cancel := context.WithTimeout(context.Background(), h.opts.Timeout)
+			defer cancel()
+
+			c.res, c.err = h.parser.ParseContext(pctx, target)
+
+			h.mu.Lock()
+			delete(h.inFlight, target)
+			h.mu.Unlock()
+			close(c.done)
+		}()
+	}
+	h.mu.Unlock()
+
+	select {
+	case <-c.done:
+		return c.res, c.err
+	case <-ctx.Done():
+		return recon.Result{}, ctx.Err()
+	}
+}
+
+// NewSafeClient returns an http.Client whose connections fail with ErrForbiddenAddress if they're made to an address
+// that isn't publicly routable, and that refuses to follow redirects to such addresses or to schemes other than http
+// and https. Use it with recon.Parser.WithClient for parsers that aren't used through a Handler.
+func NewSafeClient() *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = NewSafeDialer().DialContext
+	transport.Proxy = nil
+
+	return &http.Client{
+		Transport:     transport,
+		CheckRedirect: checkRedirect,
+	}
+}
+
+// NewSafeDialer returns a dialer whose connections fail with ErrForbiddenAddress if they're made to an address that
+// isn't publicly routable. Since it checks the address that's actually connected to, after the host is resolved,
+// redirects and DNS rebinding can't get around it.
+func NewSafeDialer() *net.Dialer {
+	return &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control: func(network, address string, c syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+
+			if ip := net.ParseIP(host); ip == nil || !isPublic(ip) {
+				return errors.Wrapf(ErrForbiddenAddress, "%s", host)
+			}
+
+			return nil
+		},
+	}
+}
+
+// checkRedirect stops a client from following redirects to URLs the Handler wouldn't accept, failing early on those
+// that name a forbidden address outright; hostnames are left to the dialer, which sees what they resolve to.
+func checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= 10 {
+		return errors.New("stopped after 10 redirects")
+	}
+
+	if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+		return errors.Errorf("redirect to unsupported url scheme %q", req.URL.Scheme)
+	}
+
+	if ip := net.ParseIP(req.URL.Hostname()); ip != nil && !isPublic(ip) {
+		return errors.Wrapf(ErrForbiddenAddress, "%s", ip)
+	}
+
+	return nil
+}
+
+// checkHost returns ErrForbiddenAddress if host is, or resolves to, an address that isn't publicly routable.
+func checkHost(ctx context.Context, resolver *net.Resolver, host string) error {
+	if ip := net.ParseIP(host); ip != nil {
+		if !isPublic(ip) {
+			return errors.Wrapf(ErrForbiddenAddress, "%s", host)
+		}
+		return nil
+	}
+
+	addrs, err := resolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return errors.Wrap(err, "resolve host")
+	}
+
+	for _, addr := range addrs {
+		if !isPublic(addr.IP) {
+			return errors.Wrapf(ErrForbiddenAddress, "%s resolves to %s", host, addr.IP)
+		}
+	}
+
+	return nil
+}
+
+// forbiddenNetworks are the address ranges that aren't publicly routable, or that can be used to reach ones that
+// aren't, per the IANA special-purpose address registries.
+var forbiddenNetworks = parseCIDRs(
+	// IPv4
+	"0.0.0.0/8",       // "this" network
+	"10.0.0.0/8",      // private
+	"100.64.0.0/10",   // carrier-grade NAT
+	"127.0.0.0/8",     // loopback
+	"169.254.0.0/16",  // link-local, including cloud metadata services
+	"172.16.0.0/12",   // private
+	"192.0.0.0/24",    // IETF protocol assignments
+	"192.0.2.0/24",    // documentation
+	"192.88.99.0/24",  // 6to4 relay anycast
+	"192.168.0.0/16",  // private
+	"198.18.0.0/15",   // benchmarking
+	"198.51.100.0/24", // documentation
+	"203.0.113.0/24",  // documentation
+	"224.0.0.0/4",     // multicast
+	"240.0.0.0/4",     // reserved, including broadcast
+	// IPv6; IPv4-mapped addresses are checked as IPv4
+	"::/128",         // unspecified
+	"::1/128",        // loopback
+	"::/96",          // IPv4-compatible
+	"64:ff9b::/96",   // NAT64
+	"64:ff9b:1::/48", // local-use NAT64
+	"100::/64",       // discard-only
+	"2001::/32",      // Teredo
+	"2001:db8::/32",  // documentation
+	"2002::/16",      // 6to4
+	"fc00::/7",       // unique local
+	"fe80::/10",      // link-local
+	"fec0::/10",      // site-local
+	"ff00::/8",       // multicast
+)
+
+func parseCIDRs(cidrs ...string) []*net.IPNet {
+	nets := make([]*net.IPNet, len(cidrs))
+	for i, cidr := range cidrs {
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		nets[i] = n
+	}
+
+	return nets
+}
+
+// isPublic reports whether ip is a publicly routable unicast address.
+func isPublic(ip net.IP) bool {
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+
+	for _, n := range forbiddenNetworks {
+		if n.Contains(ip) {
+			return false
+		}
+	}
+
+	return len(ip) == net.IPv4len || len(ip) == net.IPv6len
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(errorResponse{Error: msg})
+}
@@ -0,0 +1,173 @@
+package recon
+
+import (
+	"context"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// Renderer produces the HTML of a page in place of a plain HTTP GET, for example by loading it in a headless
+// browser so that tags added by JavaScript are present. Images are still fetched with the parser's HTTP client.
+//
+// A Renderer whose bodies implement PageReporter has its pages checked the way fetched pages are: their redirects
+// against the parser's limit and host rules, and their status and Content-Type. Renderers that follow navigations on
+// their own should also call CheckURL before each one, so that disallowed hosts are never loaded.
+type Renderer interface {
+	Render(ctx context.Context, url string) (io.ReadCloser, error)
+}
+
+// RenderedPage describes the HTTP response a Renderer loaded a page from.
+type RenderedPage struct {
+	// URL is the page's final URL, after any redirects.
+	URL string
+
+	// StatusCode and Header are those of the final response.
+	StatusCode int
+	Header     http.Header
+
+	// Redirects are the URLs that redirected on the way to URL, in order, starting with the URL that was rendered.
+	Redirects []string
+}
+
+// PageReporter is implemented by the bodies of Renderers that can describe the response each page was loaded from.
+type PageReporter interface {
+	RenderedPage() RenderedPage
+}
+
+// renderContextKey is the context key under which the rendering parser's host rules are kept (see CheckURL).
+type renderContextKey struct{}
+
+// CheckURL returns ErrHostNotAllowed if the parser rendering a page with ctx isn't allowed to load rawURL, per
+// WithAllowedHosts and WithBlockedHosts. It returns nil for contexts that didn't come from a parser.
+func CheckURL(ctx context.Context, rawURL string) error {
+	rules, ok := ctx.Value(renderContextKey{}).(hostRules)
+	if !ok {
+		return nil
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return errors.Wrap(err, "parse url")
+	}
+
+	return rules.check(u)
+}
+
+// WithRenderer sets a Renderer the parser uses to fetch pages, which allows recon to unfurl client-rendered sites.
+// See the reconchrome package for an implementation that uses headless Chrome.
+func (p *Parser) WithRenderer(r Renderer) *Parser {
+	p.renderer = r
+	return p
+}
+
+// render fetches the page for req using the parser's Renderer, wrapping the output in a synthetic response so the
+// rest of the pipeline can treat it like any other page.
+func (p *Parser) render(req *http.Request) (*http.Response, error) {
+	if err := p.hosts.check(req.URL); err != nil {
+		return nil, err
+	}
+
+	emit(p.events, Event{Type: EventFetchStarted, URL: req.URL.String()})
+
+	ctx := context.WithValue(req.Context(), renderContextKey{}, p.hosts)
+	body, err := p.renderer.Render(ctx, req.URL.String())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": {"text/html; charset=utf-8"}},
+		Body:          body,
+		ContentLength: -1,
+		Request:       req,
+	}
+
+	reporter, ok := body.(PageReporter)
+	if !ok {
+		return resp, nil
+	}
+
+	page := reporter.RenderedPage()
+	if page.StatusCode != 0 {
+		resp.StatusCode = page.StatusCode
+		resp.Status = strconv.Itoa(page.StatusCode) + " " + http.StatusText(page.StatusCode)
+	}
+	if page.Header != nil {
+		resp.Header = renderedHeader(page.Header)
+	}
+
+	if err := p.checkRendered(resp, page); err != nil {
+		body.Close()
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// renderedHeader returns a copy of the header of a rendered page's original response that describes the rendered body:
+// the serialized DOM, which is neither compressed nor the length of the original, and is always UTF-8. The media type
+// is kept, so that pages that aren't HTML are still rejected.
+func renderedHeader(h http.Header) http.Header {
+	h = h.Clone()
+	h.Del("Content-Encoding")
+	h.Del("Content-Length")
+
+	mediaType, _, err := mime.ParseMediaType(h.Get("Content-Type"))
+	if err != nil {
+		mediaType = "text/html"
+	}
+	h.Set("Content-Type", mime.FormatMediaType(mediaType, map[string]string{"charset": "utf-8"}))
+
+	return h
+}
+
+// checkRendered applies the parser's redirect limit and host rules to the redirects a Renderer followed, and
+// records them on resp the way the HTTP client would, so they show up in the Result.
+func (p *Parser) checkRendered(resp *http.Response, page RenderedPage) error {
+	if len(page.Redirects) > p.maxRedirects {
+		return errors.Wrapf(ErrTooManyRedirects, "stopped after %d redirects", p.maxRedirects)
+	}
+
+	var hops []string
+	if len(page.Redirects) > 0 {
+		hops = append(hops, page.Redirects[1:]...)
+	}
+	if page.URL != "" {
+		hops = append(hops, page.URL)
+	}
+
+	prev := resp.Request
+	for _, hop := range hops {
+		u, err := prev.URL.Parse(hop)
+		if err != nil {
+			return errors.Wrap(err, "parse rendered url")
+		}
+		if err := p.hosts.check(u); err != nil {
+			return err
+		}
+		if u.String() == prev.URL.String() {
+			continue
+		}
+
+		emit(p.events, Event{Type: EventRedirectFollowed, URL: u.String(), From: prev.URL.String()})
+
+		next := prev.Clone(prev.Context())
+		next.URL = u
+		next.Host = u.Host
+		next.Response = &http.Response{StatusCode: http.StatusFound, Request: prev}
+		prev = next
+	}
+	resp.Request = prev
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package recon
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/jimmysawczuk/recon/internal/lru"
+)
+
+// resultCache holds recently parsed Results in memory, keyed by cache key (see Parser.cacheKey). Results are kept
+// encoded as JSON, so that callers each get their own copy and can't change the cached one.
+type resultCache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries *lru.Cache[string, cachedResult]
+}
+
+type cachedResult struct {
+	res     []byte
+	expires time.Time
+}
+
+// WithResultCache makes repeated calls to Parse and ParseContext for the same URL within ttl return the Result of the
+// first call without fetching the page again. URLs are compared after normalization, so "HTTP://Example.com:80/#top"
+// and "http://example.com/" are the same URL. Up to maxEntries Results are kept, evicting the least recently used; a
+// maxEntries of 0 means no limit. Failed parses aren't cached.
+//
+// The result cache sits in front of a Cache set via WithCache: a Result found in it is returned without revalidation,
+// and on a miss the page is fetched, conditionally if the Cache has an entry for it, and the new Result is stored in
+// both. ParseIfModified always fetches the page, and so bypasses the result cache.
+func (p *Parser) WithResultCache(ttl time.Duration, maxEntries int) *Parser {
+	p.results = &resultCache{
+		ttl:     ttl,
+		entries: lru.New[string, cachedResult](maxEntries),
+	}
+	return p
+}
+
+func (c *resultCache) get(key string) (Result, bool) {
+	if c == nil {
+		return Result{}, false
+	}
+
+	c.mu.Lock()
+	entry, ok := c.entries.Get(key)
+	if ok && time.Now().After(entry.expires) {
+		c.entries.Remove(key)
+		ok = false
+	}
+	c.mu.Unlock()
+
+	if !ok {
+		return Result{}, false
+	}
+
+	var res Result
+	if err := json.Unmarshal(entry.res, &res); err != nil {
+		return Result{}, false
+	}
+
+	return res, true
+}
+
+func (c *resultCache) set(key string, res Result) {
+	if c == nil {
+		return
+	}
+
+	buf, err := json.Marshal(res)
+	if err != nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries.Add(key, cachedResult{res: buf, expires: time.Now().Add(c.ttl)})
+}
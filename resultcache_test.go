@@ -0,0 +1,90 @@
+package recon
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResultCache(t *testing.T) {
+	var hits int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<html><head><title>Cached</title></head></html>`))
+	}))
+	defer srv.Close()
+
+	var cacheHits int
+	p := NewParser().WithResultCache(50*time.Millisecond, 10).WithStats(StatsFunc(func(s ParseStats) {
+		if s.CacheHit {
+			cacheHits++
+		}
+	}))
+
+	for _, u := range []string{srv.URL, srv.URL + "/", strings.Replace(srv.URL, "http://", "HTTP://", 1) + "/#top"} {
+		res, err := p.Parse(u)
+		assert.Nil(t, err)
+		assert.Equal(t, "Cached", res.Title)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&hits))
+	assert.Equal(t, 2, cacheHits)
+
+	time.Sleep(60 * time.Millisecond)
+
+	_, err := p.Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&hits))
+}
+
+func TestCacheKey(t *testing.T) {
+	tests := map[string]string{
+		"HTTP://Example.COM":                 "http://example.com/",
+		"https://example.com:443/a?b=c":      "https://example.com/a?b=c",
+		"http://example.com:8080/a#frag":     "http://example.com:8080/a",
+		"http://[::1]:80/":                   "http://[::1]/",
+		"https://Bücher.example/":            "https://xn--bcher-kva.example/",
+		"https://example.com/a?utm_source=x": "https://example.com/a",
+	}
+
+	p := NewParser().WithTrackingParamsStripped()
+	for in, want := range tests {
+		assert.Equal(t, want, p.cacheKey(in), in)
+	}
+}
+
+func TestResultCacheCopies(t *testing.T) {
+	srv := newTestServer("text/html", `<html><head><title>Cached</title>
+		<meta property="og:type" content="article">
+		<meta property="article:tag" content="news">
+	</head></html>`)
+	defer srv.Close()
+
+	p := NewParser().WithResultCache(time.Minute, 10)
+
+	res, err := p.Parse(srv.URL)
+	assert.Nil(t, err)
+	if assert.NotNil(t, res.Article) && assert.Len(t, res.Article.Tags, 1) {
+		res.Article.Tags[0] = "changed"
+	}
+	res.Title = "Changed"
+
+	res, err = p.Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "Cached", res.Title)
+	if assert.NotNil(t, res.Article) && assert.Len(t, res.Article.Tags, 1) {
+		assert.Equal(t, "news", res.Article.Tags[0])
+		res.Article.Tags[0] = "changed"
+	}
+
+	res, err = p.Parse(srv.URL)
+	assert.Nil(t, err)
+	if assert.NotNil(t, res.Article) {
+		assert.Equal(t, []string{"news"}, res.Article.Tags)
+	}
+}
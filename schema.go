@@ -0,0 +1,16 @@
+package recon
+
+import _ "embed"
+
+// SchemaVersion is the version of the JSON Schema that describes a Result's JSON encoding.
+//
+// Within a version, the schema only changes compatibly: fields are added as optional properties, but never removed,
+// renamed or changed to a different type, so data persisted by one release of recon can be read by any later release
+// with the same SchemaVersion. Any incompatible change to Result's JSON encoding comes with a new SchemaVersion and a
+// new schema file alongside the old one.
+const SchemaVersion = 1
+
+// ResultSchema is the JSON Schema (draft 2020-12) for the JSON encoding of a Result, at SchemaVersion.
+//
+//go:embed schema/result.v1.schema.json
+var ResultSchema []byte
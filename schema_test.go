@@ -0,0 +1,96 @@
+package recon
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type jsonSchema struct {
+	Required   []string              `json:"required"`
+	Properties map[string]jsonSchema `json:"properties"`
+	Defs       map[string]jsonSchema `json:"$defs"`
+}
+
+// jsonFields returns the JSON names of t's fields, and which of them are always present.
+func jsonFields(t reflect.Type) (fields []string, required []string) {
+	for i := 0; i < t.NumField(); i++ {
+		name, opts, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+
+		fields = append(fields, name)
+		if !strings.Contains(opts, "omitempty") {
+			required = append(required, name)
+		}
+	}
+
+	sort.Strings(fields)
+	sort.Strings(required)
+	return fields, required
+}
+
+func schemaFields(s jsonSchema) (fields []string, required []string) {
+	for name := range s.Properties {
+		fields = append(fields, name)
+	}
+
+	required = append(required, s.Required...)
+
+	sort.Strings(fields)
+	sort.Strings(required)
+	return fields, required
+}
+
+// TestResultSchema fails if Result, or a type it contains, changes without a matching change to the schema. Adding
+// a field only requires adding it to the schema; removing, renaming or retyping one requires a new SchemaVersion.
+func TestResultSchema(t *testing.T) {
+	var schema jsonSchema
+	assert.Nil(t, json.Unmarshal(ResultSchema, &schema))
+
+	types := map[string]reflect.Type{
+		"":              reflect.TypeOf(Result{}),
+		"image":         reflect.TypeOf(Image{}),
+		"author":        reflect.TypeOf(Author{}),
+		"oembed":        reflect.TypeOf(OEmbed{}),
+		"video":         reflect.TypeOf(Video{}),
+		"audio":         reflect.TypeOf(Audio{}),
+		"embed":         reflect.TypeOf(Embed{}),
+		"content":       reflect.TypeOf(Content{}),
+		"product":       reflect.TypeOf(Product{}),
+		"recipe":        reflect.TypeOf(Recipe{}),
+		"rating":        reflect.TypeOf(Rating{}),
+		"event":         reflect.TypeOf(EventDetails{}),
+		"venue":         reflect.TypeOf(Venue{}),
+		"article":       reflect.TypeOf(Article{}),
+		"profile":       reflect.TypeOf(Profile{}),
+		"book":          reflect.TypeOf(Book{}),
+		"video_details": reflect.TypeOf(VideoDetails{}),
+		"actor":         reflect.TypeOf(Actor{}),
+		"music":         reflect.TypeOf(Music{}),
+		"music_track":   reflect.TypeOf(MusicTrack{}),
+		"facebook":      reflect.TypeOf(Facebook{}),
+		"pinterest":     reflect.TypeOf(Pinterest{}),
+		"app_links":     reflect.TypeOf(AppLinks{}),
+		"app_link":      reflect.TypeOf(AppLink{}),
+		"web_app_link":  reflect.TypeOf(WebAppLink{}),
+		"app_reference": reflect.TypeOf(AppReference{}),
+	}
+
+	for def, typ := range types {
+		s := schema
+		if def != "" {
+			s = schema.Defs[def]
+		}
+
+		wantFields, wantRequired := jsonFields(typ)
+		fields, required := schemaFields(s)
+		assert.Equal(t, wantFields, fields, "properties of %s", typ)
+		assert.Equal(t, wantRequired, required, "required properties of %s", typ)
+	}
+}
@@ -0,0 +1,107 @@
+package recon
+
+import (
+	"context"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+type link struct {
+	href      string
+	prominent bool
+}
+
+// ParseSite parses the page at root along with up to limit of the most prominent pages it links to on the same
+// site. This function instanciates a fresh Parser each time it's invoked.
+func ParseSite(ctx context.Context, root string, limit int) ([]Result, error) {
+	return NewParser().ParseSite(ctx, root, limit)
+}
+
+// ParseSite parses the page at root (typically a homepage), discovers the most prominent internal links on it
+// (links in headlines first, then in document order) and parses up to limit of them. The root page's Result comes
+// first, followed by the linked pages in order of prominence; a negative limit parses none of them. Linked pages that
+// fail to parse are left out; an error is only returned if the root page can't be parsed.
+func (p *Parser) ParseSite(ctx context.Context, root string, limit int) ([]Result, error) {
+	res, doc, err := p.parse(ctx, root)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse root")
+	}
+
+	links := doc.internalLinks()
+	if limit < 0 {
+		limit = 0
+	}
+	if len(links) > limit {
+		links = links[:limit]
+	}
+
+	linked := make([]*Result, len(links))
+	wg := sync.WaitGroup{}
+	for i, u := range links {
+		wg.Add(1)
+		go func(i int, u string) {
+			defer wg.Done()
+
+			res, err := p.ParseContext(ctx, u)
+			if err == nil {
+				linked[i] = &res
+			}
+		}(i, u)
+	}
+	wg.Wait()
+
+	results := []Result{res}
+	for _, res := range linked {
+		if res != nil {
+			results = append(results, *res)
+		}
+	}
+
+	return results, nil
+}
+
+// internalLinks returns the absolute URLs of the pages on the same site that the document links to, most
+// prominent first.
+func (d *Document) internalLinks() []string {
+	seen := map[string]bool{d.URL.String(): true}
+	candidates := []link{}
+	base := d.baseURL()
+
+	for _, l := range d.links {
+		u, err := url.Parse(l.href)
+		if err != nil {
+			continue
+		}
+
+		u = base.ResolveReference(u)
+		u.Fragment = ""
+		if u.Scheme != "http" && u.Scheme != "https" || !sameSite(u, d.URL) {
+			continue
+		}
+		if u.Path == "" || u.Path == "/" || seen[u.String()] {
+			continue
+		}
+
+		seen[u.String()] = true
+		candidates = append(candidates, link{href: u.String(), prominent: l.prominent})
+	}
+
+	sort.SliceStable(candidates, func(a, b int) bool {
+		return candidates[a].prominent && !candidates[b].prominent
+	})
+
+	out := make([]string, len(candidates))
+	for i, c := range candidates {
+		out[i] = c.href
+	}
+
+	return out
+}
+
+func sameSite(a, b *url.URL) bool {
+	return strings.TrimPrefix(a.Hostname(), "www.") == strings.TrimPrefix(b.Hostname(), "www.")
+}
@@ -0,0 +1,49 @@
+package recon
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSite(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			fmt.Fprintf(w, `<html><head><title>Page %s</title></head></html>`, r.URL.Path)
+			return
+		}
+
+		fmt.Fprint(w, `<html><head><title>Home</title></head><body>
+			<nav><a href="/">Home</a> <a href="/about">About</a></nav>
+			<h2><a href="/posts/1#comments">First post</a></h2>
+			<a href="https://elsewhere.example.com/">Elsewhere</a>
+			<h2><a href="/posts/2">Second post</a></h2>
+			<a href="/posts/1">First post, again</a>
+		</body></html>`)
+	})
+
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	results, err := ParseSite(context.Background(), srv.URL, 2)
+	assert.Nil(t, err)
+
+	titles := []string{}
+	for _, res := range results {
+		titles = append(titles, res.Title)
+	}
+	assert.Equal(t, []string{"Home", "Page /posts/1", "Page /posts/2"}, titles)
+
+	results, err = ParseSite(context.Background(), srv.URL, 10)
+	assert.Nil(t, err)
+	assert.Len(t, results, 4)
+
+	results, err = ParseSite(context.Background(), srv.URL, -1)
+	assert.Nil(t, err)
+	assert.Len(t, results, 1)
+}
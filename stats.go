@@ -0,0 +1,127 @@
+package recon
+
+import (
+	"io"
+	"sync/atomic"
+	"time"
+)
+
+// Stats receives measurements of each page the parser fetches, for reporting to a metrics system.
+type Stats interface {
+	ObserveParse(ParseStats)
+}
+
+// StatsFunc adapts an ordinary function to the Stats interface.
+type StatsFunc func(ParseStats)
+
+// ObserveParse calls f(s).
+func (f StatsFunc) ObserveParse(s ParseStats) {
+	f(s)
+}
+
+// ParseStats describes the work done to parse a single page. If the parser follows a translation of the page (see
+// WithAcceptLanguage), fetching and parsing the translation is included too.
+type ParseStats struct {
+	// URL is the URL of the page as-passed.
+	URL string
+
+	// Fetch is the time spent waiting for the page's response headers.
+	Fetch time.Duration
+
+	// Tokenize is the time spent reading and tokenizing the page's body.
+	Tokenize time.Duration
+
+	// Images is the time spent downloading and analyzing the page's images.
+	Images time.Duration
+
+	// Bytes is the number of bytes read off the wire for the page and its images, before decompression.
+	Bytes int64
+
+	// ImagesFound is the number of candidate images found on the page, and ImagesAnalyzed is the number whose
+	// dimensions were read and that were included in the Result; images that failed to download or decode aren't.
+	ImagesFound    int
+	ImagesAnalyzed int
+
+	// CacheHit is true if the page was served from the parser's cache rather than parsed again.
+	CacheHit bool
+
+	// Err is the error the parse failed with, if any.
+	Err error
+}
+
+// WithStats sets the Stats the parser reports a ParseStats to after each page it fetches. ObserveParse may be called
+// from multiple goroutines at once.
+func (p *Parser) WithStats(s Stats) *Parser {
+	p.stats = s
+	return p
+}
+
+// statsRecorder collects the measurements of a single parse. Its methods are safe to call on a nil recorder, and
+// addBytes is safe to call concurrently.
+type statsRecorder struct {
+	stats ParseStats
+	bytes atomic.Int64
+}
+
+func (r *statsRecorder) addBytes(n int64) {
+	if r == nil {
+		return
+	}
+
+	r.bytes.Add(n)
+}
+
+// countBody wraps rc so that the bytes read from it are recorded.
+func (r *statsRecorder) countBody(rc io.ReadCloser) io.ReadCloser {
+	if r == nil {
+		return rc
+	}
+
+	return &wrappedBody{Reader: &recordingReader{r: rc, rec: r}, closer: rc}
+}
+
+// observeImages records an image analysis that started at start and found and analyzed the given numbers of images.
+func (r *statsRecorder) observeImages(start time.Time, found, analyzed int) {
+	if r == nil {
+		return
+	}
+
+	r.stats.Images += time.Since(start)
+	r.stats.ImagesFound += found
+	r.stats.ImagesAnalyzed += analyzed
+}
+
+// measuredImages returns the number of images whose dimensions were read.
+func measuredImages(images []Image) int {
+	n := 0
+	for _, img := range images {
+		if img.Width > 0 && img.Height > 0 {
+			n++
+		}
+	}
+
+	return n
+}
+
+// report sends the recorded measurements to s.
+func (r *statsRecorder) report(s Stats, err error) {
+	if r == nil || s == nil {
+		return
+	}
+
+	stats := r.stats
+	stats.Bytes = r.bytes.Load()
+	stats.Err = err
+	s.ObserveParse(stats)
+}
+
+type recordingReader struct {
+	r   io.Reader
+	rec *statsRecorder
+}
+
+func (c *recordingReader) Read(b []byte) (int, error) {
+	n, err := c.r.Read(b)
+	c.rec.addBytes(int64(n))
+	return n, err
+}
@@ -0,0 +1,72 @@
+package recon
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStats(t *testing.T) {
+	img, err := parseImgFromData(imgTag{url: obnoxiouslyLongDataURL})
+	assert.Nil(t, err)
+	data, err := io.ReadAll(img.data)
+	assert.Nil(t, err)
+
+	page := `<html><head><meta property="og:image" content="/image.gif"></head><body><img src="/missing.gif"></body></html>`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/image.gif":
+			w.Header().Set("Content-Type", "image/gif")
+			w.Write(data)
+
+		case "/missing.gif":
+			w.WriteHeader(http.StatusNotFound)
+
+		default:
+			w.Header().Set("Content-Type", "text/html")
+			w.Write([]byte(page))
+		}
+	}))
+	defer srv.Close()
+
+	var stats []ParseStats
+	_, err = NewParser().WithStats(StatsFunc(func(s ParseStats) {
+		stats = append(stats, s)
+	})).Parse(srv.URL + "/")
+	assert.Nil(t, err)
+
+	if assert.Len(t, stats, 1) {
+		s := stats[0]
+		assert.Equal(t, srv.URL+"/", s.URL)
+		assert.Equal(t, 2, s.ImagesFound)
+		// the missing image is found, but it can't be measured
+		assert.Equal(t, 1, s.ImagesAnalyzed)
+		// only as much of each image is read as is needed to find its dimensions
+		assert.True(t, s.Bytes > int64(len(page)), "bytes should include the page and image data")
+		assert.False(t, s.CacheHit)
+		assert.Nil(t, s.Err)
+		assert.True(t, s.Fetch > 0)
+	}
+}
+
+func TestStatsAlternate(t *testing.T) {
+	srv := newMultilingualServer()
+	defer srv.Close()
+
+	var stats []ParseStats
+	res, err := NewParser().WithAcceptLanguage("fr").WithStats(StatsFunc(func(s ParseStats) {
+		stats = append(stats, s)
+	})).Parse(srv.URL + "/")
+	assert.Nil(t, err)
+	assert.Equal(t, "Bonjour", res.Title)
+
+	if assert.Len(t, stats, 1) {
+		s := stats[0]
+		assert.Equal(t, srv.URL+"/", s.URL)
+		assert.Equal(t, int64(len(multilingualPage)+len(`<html lang="fr"><head><title>Bonjour</title></head></html>`)), s.Bytes)
+		assert.Nil(t, s.Err)
+	}
+}
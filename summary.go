@@ -0,0 +1,47 @@
+package recon
+
+import (
+	"context"
+)
+
+// Summarizer summarizes the main text of a page, for example with a language model or an extractive summarizer.
+type Summarizer interface {
+	Summarize(ctx context.Context, text string) (string, error)
+}
+
+// SummarizerFunc adapts an ordinary function to the Summarizer interface.
+type SummarizerFunc func(ctx context.Context, text string) (string, error)
+
+// Summarize calls f(ctx, text).
+func (f SummarizerFunc) Summarize(ctx context.Context, text string) (string, error) {
+	return f(ctx, text)
+}
+
+// WithSummarizer sets a Summarizer the parser calls with the page's main text, as found by WithTextExtraction, to fill
+// in Result.Summary. The page's main content is extracted for the summarizer whether or not WithTextExtraction is
+// enabled. If the summarizer fails, Result.Summary is left empty; the parse doesn't fail.
+func (p *Parser) WithSummarizer(s Summarizer) *Parser {
+	p.summarizer = s
+	return p
+}
+
+// summaryExtractor summarizes the page's main content with the parser's Summarizer, if one is set.
+type summaryExtractor struct {
+	parser *Parser
+}
+
+func (e summaryExtractor) Extract(doc *Document, res *Result) error {
+	if e.parser.summarizer == nil || doc.content == nil {
+		return nil
+	}
+
+	// a page without a summary is still worth a preview, so failures are ignored
+	summary, err := e.parser.summarizer.Summarize(doc.context(), doc.content.Text)
+	if err != nil {
+		return nil
+	}
+
+	res.Summary = summary
+
+	return nil
+}
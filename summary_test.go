@@ -0,0 +1,36 @@
+package recon
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummarizer(t *testing.T) {
+	srv := newTestServer("text/html", contentPage)
+	defer srv.Close()
+
+	var got string
+	first := SummarizerFunc(func(ctx context.Context, text string) (string, error) {
+		got = text
+		return strings.SplitN(text, "\n\n", 3)[1], nil
+	})
+
+	res, err := NewParser().WithSummarizer(first).Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "The first paragraph of the story is long enough to count, with a comma or two, and then some.", res.Summary)
+	assert.Contains(t, got, "The third paragraph")
+	assert.Nil(t, res.Content, "content is only in the result if text extraction is enabled")
+
+	failing := SummarizerFunc(func(ctx context.Context, text string) (string, error) {
+		return "", errors.New("out of tokens")
+	})
+
+	res, err = NewParser().WithSummarizer(failing).Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "A story", res.Title)
+	assert.Empty(t, res.Summary)
+}
@@ -0,0 +1,109 @@
+package recon
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// minParagraphLength is the shortest paragraph, in bytes, that's considered meaningful body text rather than a
+// byline, caption or similar fragment.
+const minParagraphLength = 80
+
+// wordsPerMinute is the reading speed used to estimate a page's reading time.
+const wordsPerMinute = 230
+
+// maxFallbackDescriptionLength is the longest description, in characters, that recon will derive from body text.
+const maxFallbackDescriptionLength = 300
+
+// textCapture accumulates the text content of an element while the page is tokenized.
+type textCapture struct {
+	tag   string
+	depth int
+	text  strings.Builder
+	done  func(string)
+}
+
+// textCaptures tracks the elements whose text is currently being captured. Text is written to every open capture,
+// so captures may nest.
+type textCaptures []*textCapture
+
+// start begins capturing the text of the element with the given tag; done is called with the text when the
+// element's end tag is reached.
+func (c *textCaptures) start(tag string, done func(string)) {
+	*c = append(*c, &textCapture{tag: tag, depth: 1, done: done})
+}
+
+// enter records that an element with the given tag has been opened, so that captures of the same tag wait for the
+// matching end tag rather than the nested one.
+func (c *textCaptures) enter(tag string) {
+	for _, capture := range *c {
+		if capture.tag == tag {
+			capture.depth++
+		}
+	}
+}
+
+func (c *textCaptures) active(tag string) bool {
+	for _, capture := range *c {
+		if capture.tag == tag {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *textCaptures) write(text string) {
+	for _, capture := range *c {
+		capture.text.WriteString(text)
+	}
+}
+
+// end records that an element with the given tag has been closed, finishing any captures whose element it was.
+func (c *textCaptures) end(tag string) {
+	for i := len(*c) - 1; i >= 0; i-- {
+		capture := (*c)[i]
+		if capture.tag != tag {
+			continue
+		}
+
+		capture.depth--
+		if capture.depth > 0 {
+			continue
+		}
+
+		*c = append((*c)[:i], (*c)[i+1:]...)
+		capture.done(capture.text.String())
+	}
+}
+
+func collapseWhitespace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// truncateWords shortens s to at most n characters, cutting at a word boundary and appending an ellipsis if any
+// text was removed.
+func truncateWords(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+
+	runes := []rune(s)
+	cut := string(runes[:n-1])
+	if i := strings.LastIndexAny(cut, " \t\n"); i > 0 {
+		cut = cut[:i]
+	}
+
+	return strings.TrimRight(cut, " ,;:.-") + "…"
+}
+
+// firstNonEmpty returns the first of its arguments that isn't empty.
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,13 @@
+package recon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTruncateWords(t *testing.T) {
+	assert.Equal(t, "short", truncateWords("short", 10))
+	assert.Equal(t, "The quick brown…", truncateWords("The quick brown fox jumps over the lazy dog", 20))
+	assert.Equal(t, "Über die…", truncateWords("Über die Brücke", 12))
+}
@@ -0,0 +1,216 @@
+package recon
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ownTransport makes sure the parser's client has an *http.Transport of its own that options can safely modify,
+// copying the client and cloning its transport the first time it's called, and returns it. It returns nil if the
+// client uses some other kind of http.RoundTripper.
+func (p *Parser) ownTransport() *http.Transport {
+	if p.transport != nil && p.client.Transport == p.transport {
+		return p.transport
+	}
+
+	var t *http.Transport
+	switch rt := p.client.Transport.(type) {
+	case nil:
+		t = http.DefaultTransport.(*http.Transport).Clone()
+	case *http.Transport:
+		t = rt.Clone()
+	default:
+		return nil
+	}
+
+	client := *p.client
+	client.Transport = t
+	p.client = &client
+	p.transport = t
+
+	return t
+}
+
+// setTransport applies fn to the parser's own transport (see ownTransport), and remembers it so that WithClient can
+// apply it again to the transport of a client set later.
+func (p *Parser) setTransport(fn func(*http.Transport)) *Parser {
+	p.transportOptions = append(p.transportOptions, fn)
+	if t := p.ownTransport(); t != nil {
+		fn(t)
+	}
+
+	return p
+}
+
+// WithProxy sends the parser's requests through the forward proxy at u.
+func (p *Parser) WithProxy(u *url.URL) *Parser {
+	return p.WithProxyFunc(http.ProxyURL(u))
+}
+
+// WithProxyFunc sets a function that picks the proxy for each of the parser's requests, like http.Transport's
+// Proxy field; a nil URL means no proxy. See RotateProxies for spreading requests across several proxies.
+//
+// The proxy is set on a copy of the parser's current client, so this works with the default client or one set via
+// WithClient, as long as its transport is an *http.Transport (or nil); otherwise it has no effect. It's kept if
+// WithClient is called afterwards, as are the transport options below.
+func (p *Parser) WithProxyFunc(fn func(*http.Request) (*url.URL, error)) *Parser {
+	return p.setTransport(func(t *http.Transport) {
+		t.Proxy = fn
+	})
+}
+
+// RotateProxies returns a proxy function for WithProxyFunc that cycles through the given proxies, one request at a
+// time.
+func RotateProxies(proxies ...*url.URL) func(*http.Request) (*url.URL, error) {
+	var next uint64
+
+	return func(*http.Request) (*url.URL, error) {
+		if len(proxies) == 0 {
+			return nil, nil
+		}
+
+		i := atomic.AddUint64(&next, 1) - 1
+		return proxies[i%uint64(len(proxies))], nil
+	}
+}
+
+// WithMaxIdleConns sets how many idle connections the parser's transport keeps open for reuse, in total and per host.
+// The standard library's default of 2 per host is low for parsing many pages from the same site; a limit of 0
+// means no limit in total, or the default per host. Like WithProxyFunc, it's set on a copy of the parser's client,
+// and only has an effect if its transport is an *http.Transport (or nil). The same goes for the transport options
+// below.
+func (p *Parser) WithMaxIdleConns(total, perHost int) *Parser {
+	return p.setTransport(func(t *http.Transport) {
+		t.MaxIdleConns = total
+		t.MaxIdleConnsPerHost = perHost
+	})
+}
+
+// WithMaxConnsPerHost limits the number of connections the parser's transport opens to each host, whether active or
+// idle. Requests beyond the limit wait for a connection to free up. A limit of 0, the default, means no limit.
+func (p *Parser) WithMaxConnsPerHost(n int) *Parser {
+	return p.setTransport(func(t *http.Transport) {
+		t.MaxConnsPerHost = n
+	})
+}
+
+// WithIdleConnTimeout sets how long the parser's transport keeps an idle connection open before closing it. A
+// timeout of 0 means idle connections are kept until the server closes them.
+func (p *Parser) WithIdleConnTimeout(d time.Duration) *Parser {
+	return p.setTransport(func(t *http.Transport) {
+		t.IdleConnTimeout = d
+	})
+}
+
+// WithTLSHandshakeTimeout sets how long the parser's transport waits for a TLS handshake. A timeout of 0 means no
+// limit.
+func (p *Parser) WithTLSHandshakeTimeout(d time.Duration) *Parser {
+	return p.setTransport(func(t *http.Transport) {
+		t.TLSHandshakeTimeout = d
+	})
+}
+
+// WithHTTP2 enables or disables HTTP/2 for the parser's requests to servers that support it. It's enabled by
+// default, though a custom *http.Transport passed via WithClient may not attempt it until it's enabled here.
+func (p *Parser) WithHTTP2(enabled bool) *Parser {
+	return p.setTransport(func(t *http.Transport) {
+		var protos []string
+		if t.TLSClientConfig != nil {
+			t.TLSClientConfig = t.TLSClientConfig.Clone()
+			for _, proto := range t.TLSClientConfig.NextProtos {
+				if proto != "h2" {
+					protos = append(protos, proto)
+				}
+			}
+			t.TLSClientConfig.NextProtos = protos
+		}
+
+		t.ForceAttemptHTTP2 = enabled
+		if enabled {
+			// the transport adds HTTP/2 back to the TLS config when it's first used
+			t.TLSNextProto = nil
+		} else {
+			// a non-nil, empty map stops the transport from adding HTTP/2
+			t.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+		}
+	})
+}
+
+// Dialer makes the network connections for the parser's requests. *net.Dialer implements it, as do the dialers of
+// most proxy and tunneling packages.
+type Dialer interface {
+	DialContext(ctx context.Context, network, address string) (net.Conn, error)
+}
+
+// Resolver looks up the IP addresses of the hosts the parser connects to. *net.Resolver implements it, and it's small
+// enough to wrap a DNS-over-HTTPS client or a fixed set of addresses.
+type Resolver interface {
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// WithDialer sets the Dialer that makes the connections for the parser's requests, in place of the transport's own.
+// Like WithProxyFunc, it's set on a copy of the parser's client, and only has an effect if its transport is an
+// *http.Transport (or nil).
+func (p *Parser) WithDialer(d Dialer) *Parser {
+	p.dialer = d
+	return p.setDialContext()
+}
+
+// WithResolver sets the Resolver that looks up the hosts the parser connects to, in place of the system's. Each of a
+// host's addresses is tried in turn until one connects. Like WithDialer, it only has an effect if the client's
+// transport is an *http.Transport (or nil).
+func (p *Parser) WithResolver(r Resolver) *Parser {
+	p.resolver = r
+	return p.setDialContext()
+}
+
+// setDialContext sets the DialContext of the parser's transport from its Dialer and Resolver.
+func (p *Parser) setDialContext() *Parser {
+	return p.setTransport(func(t *http.Transport) {
+		t.DialContext = dialContext(p.dialer, p.resolver)
+	})
+}
+
+// dialContext returns a DialContext function that connects with d, or a dialer configured like
+// http.DefaultTransport's if d is nil, after looking up the host with r, if it isn't nil.
+func dialContext(d Dialer, r Resolver) func(ctx context.Context, network, address string) (net.Conn, error) {
+	if d == nil {
+		d = &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}
+	}
+	if r == nil {
+		return d.DialContext
+	}
+
+	return func(ctx context.Context, network, address string) (net.Conn, error) {
+		host, port, err := net.SplitHostPort(address)
+		if err != nil || net.ParseIP(host) != nil {
+			return d.DialContext(ctx, network, address)
+		}
+
+		addrs, err := r.LookupIPAddr(ctx, host)
+		if err != nil {
+			return nil, errors.Wrapf(err, "lookup %s", host)
+		}
+
+		err = errors.Errorf("lookup %s: no addresses", host)
+		for _, addr := range addrs {
+			if (network == "tcp4" && addr.IP.To4() == nil) || (network == "tcp6" && addr.IP.To4() != nil) {
+				continue
+			}
+
+			var conn net.Conn
+			if conn, err = d.DialContext(ctx, network, net.JoinHostPort(addr.String(), port)); err == nil {
+				return conn, nil
+			}
+		}
+
+		return nil, err
+	}
+}
@@ -0,0 +1,228 @@
+package recon
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newProxyServer is a forward proxy stand-in that answers every request itself, recording the URLs it was asked
+// for.
+func newProxyServer(name string, seen *[]string, mu *sync.Mutex) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		*seen = append(*seen, name+" "+r.URL.String())
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<html><head><title>Proxied by ` + name + `</title></head></html>`))
+	}))
+}
+
+func TestWithProxy(t *testing.T) {
+	mu := sync.Mutex{}
+	seen := []string{}
+	proxy := newProxyServer("a", &seen, &mu)
+	defer proxy.Close()
+
+	proxyURL, _ := url.Parse(proxy.URL)
+	client := &http.Client{}
+	p := NewParser().WithClient(client).WithProxy(proxyURL)
+
+	res, err := p.Parse("http://example.invalid/page")
+	assert.Nil(t, err)
+	assert.Equal(t, "Proxied by a", res.Title)
+	assert.Equal(t, []string{"a http://example.invalid/page"}, seen)
+	assert.Nil(t, client.Transport, "the caller's client shouldn't be modified")
+}
+
+func TestWithProxyBeforeClient(t *testing.T) {
+	mu := sync.Mutex{}
+	seen := []string{}
+	proxy := newProxyServer("a", &seen, &mu)
+	defer proxy.Close()
+
+	proxyURL, _ := url.Parse(proxy.URL)
+	client := &http.Client{Timeout: 5 * time.Second}
+	p := NewParser().WithProxy(proxyURL).WithMaxConnsPerHost(3).WithClient(client)
+
+	res, err := p.Parse("http://example.invalid/page")
+	assert.Nil(t, err)
+	assert.Equal(t, "Proxied by a", res.Title)
+	assert.Equal(t, []string{"a http://example.invalid/page"}, seen)
+	assert.Nil(t, client.Transport, "the caller's client shouldn't be modified")
+	assert.Equal(t, 5*time.Second, p.client.Timeout)
+	if assert.NotNil(t, p.transport) {
+		assert.Equal(t, 3, p.transport.MaxConnsPerHost)
+	}
+}
+
+func TestRotateProxies(t *testing.T) {
+	mu := sync.Mutex{}
+	seen := []string{}
+	a := newProxyServer("a", &seen, &mu)
+	defer a.Close()
+	b := newProxyServer("b", &seen, &mu)
+	defer b.Close()
+
+	aURL, _ := url.Parse(a.URL)
+	bURL, _ := url.Parse(b.URL)
+	p := NewParser().WithProxyFunc(RotateProxies(aURL, bURL))
+
+	for _, path := range []string{"/1", "/2", "/3"} {
+		_, err := p.Parse("http://example.invalid" + path)
+		assert.Nil(t, err)
+	}
+
+	assert.Equal(t, []string{
+		"a http://example.invalid/1",
+		"b http://example.invalid/2",
+		"a http://example.invalid/3",
+	}, seen)
+}
+
+func TestDefaultClientIsolation(t *testing.T) {
+	jar := http.DefaultClient.Jar
+
+	a := NewParser()
+	b := NewParser()
+	assert.True(t, http.DefaultClient.Jar == jar, "http.DefaultClient shouldn't be modified")
+	assert.False(t, a.client == http.DefaultClient)
+	assert.NotNil(t, a.client.Jar)
+	assert.False(t, a.client.Jar == b.client.Jar, "parsers shouldn't share a cookie jar")
+}
+
+func TestWithCookieJar(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := r.Cookie("session"); err != nil {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "1"})
+			w.Write([]byte(`<html><head><title>New visitor</title></head></html>`))
+			return
+		}
+
+		w.Write([]byte(`<html><head><title>Welcome back</title></head></html>`))
+	}))
+	defer srv.Close()
+
+	jar, _ := cookiejar.New(nil)
+	client := &http.Client{}
+	p := NewParser().WithClient(client).WithCookieJar(jar)
+
+	res, _ := p.Parse(srv.URL)
+	assert.Equal(t, "New visitor", res.Title)
+
+	res, _ = NewParser().WithCookieJar(jar).Parse(srv.URL)
+	assert.Equal(t, "Welcome back", res.Title)
+	assert.Nil(t, client.Jar)
+
+	res, _ = NewParser().WithCookieJar(nil).Parse(srv.URL)
+	assert.Equal(t, "New visitor", res.Title)
+}
+
+// recordingDialer is a Dialer that records the addresses it's asked to connect to.
+type recordingDialer struct {
+	net.Dialer
+
+	mu        sync.Mutex
+	addresses []string
+}
+
+func (d *recordingDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	d.mu.Lock()
+	d.addresses = append(d.addresses, address)
+	d.mu.Unlock()
+
+	return d.Dialer.DialContext(ctx, network, address)
+}
+
+// staticResolver is a Resolver that looks hosts up in a map.
+type staticResolver map[string][]net.IPAddr
+
+func (r staticResolver) LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error) {
+	addrs, ok := r[host]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+
+	return addrs, nil
+}
+
+func TestWithDialer(t *testing.T) {
+	srv := newTestServer("text/html", `<html><head><title>Dialed</title></head></html>`)
+	defer srv.Close()
+
+	d := &recordingDialer{}
+	client := &http.Client{}
+	res, err := NewParser().WithClient(client).WithDialer(d).Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "Dialed", res.Title)
+	assert.Equal(t, []string{srv.Listener.Addr().String()}, d.addresses)
+	assert.Nil(t, client.Transport, "the caller's client shouldn't be modified")
+}
+
+func TestWithResolver(t *testing.T) {
+	srv := newTestServer("text/html", `<html><head><title>Resolved</title></head></html>`)
+	defer srv.Close()
+
+	_, port, _ := net.SplitHostPort(srv.Listener.Addr().String())
+	resolver := staticResolver{
+		// the first address refuses connections, so the second is tried
+		"example.test": {{IP: net.ParseIP("::1")}, {IP: net.ParseIP("127.0.0.1")}},
+	}
+
+	d := &recordingDialer{}
+	p := NewParser().WithResolver(resolver).WithDialer(d)
+
+	res, err := p.Parse("http://example.test:" + port + "/")
+	assert.Nil(t, err)
+	assert.Equal(t, "Resolved", res.Title)
+	assert.Equal(t, []string{"[::1]:" + port, "127.0.0.1:" + port}, d.addresses)
+
+	_, err = p.Parse("http://unknown.test:" + port + "/")
+	assert.NotNil(t, err)
+}
+
+func TestTransportOptions(t *testing.T) {
+	client := &http.Client{}
+	p := NewParser().WithClient(client).
+		WithMaxIdleConns(200, 20).
+		WithMaxConnsPerHost(50).
+		WithIdleConnTimeout(time.Minute).
+		WithTLSHandshakeTimeout(5 * time.Second)
+
+	assert.Equal(t, 200, p.transport.MaxIdleConns)
+	assert.Equal(t, 20, p.transport.MaxIdleConnsPerHost)
+	assert.Equal(t, 50, p.transport.MaxConnsPerHost)
+	assert.Equal(t, time.Minute, p.transport.IdleConnTimeout)
+	assert.Equal(t, 5*time.Second, p.transport.TLSHandshakeTimeout)
+	assert.Nil(t, client.Transport, "the caller's client shouldn't be modified")
+}
+
+func TestWithHTTP2(t *testing.T) {
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<html><head><title>` + r.Proto + `</title></head></html>`))
+	}))
+	srv.EnableHTTP2 = true
+	srv.StartTLS()
+	defer srv.Close()
+
+	res, err := NewParser().WithClient(srv.Client()).Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "HTTP/2.0", res.Title)
+
+	res, err = NewParser().WithClient(srv.Client()).WithHTTP2(false).Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "HTTP/1.1", res.Title)
+
+	res, err = NewParser().WithClient(srv.Client()).WithHTTP2(false).WithHTTP2(true).Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "HTTP/2.0", res.Title)
+}
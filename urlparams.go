@@ -0,0 +1,285 @@
+package recon
+
+import (
+	"net"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/idna"
+)
+
+// DefaultTrackingParams are the query parameters WithTrackingParamsStripped strips by default: those added by
+// analytics and ad platforms to attribute visits, which don't change the page. A trailing "*" matches any suffix.
+var DefaultTrackingParams = []string{
+	"utm_*",
+	"fbclid",
+	"gclid",
+	"dclid",
+	"gbraid",
+	"wbraid",
+	"msclkid",
+	"yclid",
+	"twclid",
+	"igshid",
+	"mc_cid",
+	"mc_eid",
+	"_hsenc",
+	"_hsmi",
+}
+
+// QueryPolicy decides which query parameters a host's URLs keep in Result.URL. Parameters are given as names, and a
+// trailing "*" matches any suffix, so "*" matches every parameter.
+type QueryPolicy struct {
+	// Allow lists the only parameters that are kept, e.g. "v" for YouTube. If it's empty, every parameter that isn't
+	// denied is kept.
+	Allow []string
+
+	// Deny lists parameters that are stripped, e.g. "*" for a news site whose articles don't depend on any.
+	Deny []string
+}
+
+// keeps returns whether the policy keeps the query parameter name.
+func (q QueryPolicy) keeps(name string) bool {
+	if len(q.Allow) > 0 && !matchParam(q.Allow, name) {
+		return false
+	}
+
+	return !matchParam(q.Deny, name)
+}
+
+// queryRules are the rules for stripping query parameters from a Result's URL.
+type queryRules struct {
+	// tracking are the tracking parameters stripped from every URL.
+	tracking []string
+
+	// hosts are the query policies of hosts and their subdomains, keyed by host in ASCII.
+	hosts map[string]QueryPolicy
+}
+
+// WithTrackingParamsStripped makes the parser strip tracking parameters from Result.URL and from the URLs its caches
+// are keyed by, so that the same page shared with different tracking parameters has the same URL. The parameters in
+// DefaultTrackingParams are stripped, along with any given; a trailing "*" matches any suffix, e.g. "ref_*". Pages
+// are still fetched with their tracking parameters.
+func (p *Parser) WithTrackingParamsStripped(params ...string) *Parser {
+	p.query.tracking = append(append([]string{}, DefaultTrackingParams...), params...)
+	return p
+}
+
+// WithQueryPolicy sets the policy for which query parameters the URLs of host and its subdomains keep in Result.URL
+// and in the URLs the parser's caches are keyed by. A subdomain's own policy takes precedence over its parent's.
+// Tracking parameters (see WithTrackingParamsStripped) are stripped whatever the policy. Pages are still fetched with
+// all of their parameters.
+func (p *Parser) WithQueryPolicy(host string, policy QueryPolicy) *Parser {
+	hosts := make(map[string]QueryPolicy, len(p.query.hosts)+1)
+	for k, v := range p.query.hosts {
+		hosts[k] = v
+	}
+	hosts[hostToASCII(strings.ToLower(host))] = policy
+
+	p.query.hosts = hosts
+	return p
+}
+
+// matchParam returns whether the query parameter name matches any of patterns, which may end in "*" to match any
+// suffix.
+func matchParam(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if prefix := strings.TrimSuffix(pattern, "*"); prefix != pattern {
+			if strings.HasPrefix(name, prefix) {
+				return true
+			}
+		} else if name == pattern {
+			return true
+		}
+	}
+
+	return false
+}
+
+// policy returns the query policy of host, the most specific of those set for it and its parent domains.
+func (r queryRules) policy(host string) (QueryPolicy, bool) {
+	host = strings.ToLower(host)
+	for {
+		if policy, ok := r.hosts[host]; ok {
+			return policy, true
+		}
+
+		i := strings.IndexByte(host, '.')
+		if i < 0 {
+			return QueryPolicy{}, false
+		}
+		host = host[i+1:]
+	}
+}
+
+// clean strips the query parameters the rules don't keep from rawURL. The remaining parameters are kept in their
+// order and encoding. URLs that can't be parsed are returned as they are.
+func (r queryRules) clean(rawURL string) string {
+	if len(r.tracking) == 0 && len(r.hosts) == 0 {
+		return rawURL
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil || u.RawQuery == "" {
+		return rawURL
+	}
+
+	policy, _ := r.policy(u.Hostname())
+
+	var kept []string
+	for _, param := range strings.Split(u.RawQuery, "&") {
+		name, _, _ := strings.Cut(param, "=")
+		if unescaped, err := url.QueryUnescape(name); err == nil {
+			name = unescaped
+		}
+
+		if param != "" && !matchParam(r.tracking, name) && policy.keeps(name) {
+			kept = append(kept, param)
+		}
+	}
+
+	u.RawQuery = strings.Join(kept, "&")
+	u.ForceQuery = false
+
+	return u.String()
+}
+
+// cleanURL strips the query parameters the parser's rules don't keep from rawURL.
+func (p *Parser) cleanURL(rawURL string) string {
+	return p.query.clean(rawURL)
+}
+
+// cacheKey returns the key the parser's result cache stores rawURL's Result under: rawURL cleaned (see cleanURL), with its
+// scheme and host lowercased, its host in ASCII, its default port and fragment removed, and an empty path replaced
+// with "/". URLs that can't be parsed are only cleaned.
+func (p *Parser) cacheKey(rawURL string) string {
+	cleaned := p.cleanURL(strings.TrimSpace(rawURL))
+
+	u, err := url.Parse(cleaned)
+	if err != nil {
+		return cleaned
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = hostToASCII(strings.ToLower(u.Host))
+	if port := u.Port(); (u.Scheme == "http" && port == "80") || (u.Scheme == "https" && port == "443") {
+		u.Host = u.Hostname()
+		if strings.Contains(u.Host, ":") {
+			u.Host = "[" + u.Host + "]"
+		}
+	}
+
+	if u.Path == "" {
+		u.Path = "/"
+	}
+	u.Fragment = ""
+	u.RawFragment = ""
+
+	return u.String()
+}
+
+// idnaProfile converts internationalized hosts the way browsers look them up (UTS #46), but without STD3's limits
+// on ASCII characters, so that hosts with underscores and host patterns like "*.example.com" get through.
+var idnaProfile = idna.New(idna.MapForLookup(), idna.StrictDomainName(false))
+
+// hostToASCII returns host, which may include a port, in its ASCII form, e.g. "bücher.example" becomes
+// "xn--bcher-kva.example". Hosts that are already ASCII, and those that aren't valid IDNs, are returned as they are.
+func hostToASCII(host string) string {
+	name, port := splitHostPort(host)
+	if isASCII(name) {
+		return host
+	}
+
+	ascii, err := idnaProfile.ToASCII(name)
+	if err != nil {
+		return host
+	}
+
+	return joinHostPort(ascii, port)
+}
+
+// hostToUnicode returns host, which may include a port, with its punycode labels decoded, e.g.
+// "xn--bcher-kva.example" becomes "bücher.example". Hosts that aren't valid IDNs are returned as they are.
+func hostToUnicode(host string) string {
+	name, port := splitHostPort(host)
+
+	unicode, err := idnaProfile.ToUnicode(name)
+	if err != nil {
+		return host
+	}
+
+	return joinHostPort(unicode, port)
+}
+
+// setHost sets the Result's Host to host in ASCII, and its HostUnicode to host in Unicode if they differ.
+func (r *Result) setHost(host string) {
+	r.Host = hostToASCII(host)
+	r.HostUnicode = ""
+	if unicode := hostToUnicode(r.Host); unicode != r.Host {
+		r.HostUnicode = unicode
+	}
+}
+
+// asciiURL returns rawURL with its host in ASCII (see hostToASCII) and any non-ASCII characters in its path and
+// query percent-encoded, so that it's safe to send in a request. URLs that can't be parsed are returned as they are.
+func asciiURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return rawURL
+	}
+
+	u.Host = hostToASCII(u.Host)
+	u.RawQuery = escapeNonASCII(u.RawQuery)
+
+	// the path is escaped by String, via EscapedPath
+	return u.String()
+}
+
+// escapeNonASCII percent-encodes the non-ASCII bytes in s, leaving the rest of it as it is.
+func escapeNonASCII(s string) string {
+	if isASCII(s) {
+		return s
+	}
+
+	const hex = "0123456789ABCDEF"
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c >= utf8.RuneSelf {
+			b.WriteByte('%')
+			b.WriteByte(hex[c>>4])
+			b.WriteByte(hex[c&0xf])
+		} else {
+			b.WriteByte(c)
+		}
+	}
+
+	return b.String()
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+
+	return true
+}
+
+// splitHostPort splits host into its name and port, if it has one.
+func splitHostPort(host string) (string, string) {
+	if name, port, err := net.SplitHostPort(host); err == nil {
+		return name, port
+	}
+
+	return host, ""
+}
+
+func joinHostPort(name, port string) string {
+	if port == "" {
+		return name
+	}
+
+	return net.JoinHostPort(name, port)
+}
@@ -0,0 +1,118 @@
+package recon
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryRules(t *testing.T) {
+	tests := map[string]string{
+		"https://example.com/a?utm_source=x&id=1&utm_medium=y": "https://example.com/a?id=1",
+		"https://example.com/a?b=2&fbclid=abc&a=1":             "https://example.com/a?b=2&a=1",
+		"https://example.com/a?gclid=abc":                      "https://example.com/a",
+		"https://example.com/a?ref_src=tw&ref=home":            "https://example.com/a?ref=home",
+		"https://example.com/a?q=a%20b&utm%5Fcampaign=z":       "https://example.com/a?q=a%20b",
+		"https://example.com/a#utm_source=x":                   "https://example.com/a#utm_source=x",
+	}
+
+	patterns := append(append([]string{}, DefaultTrackingParams...), "ref_*")
+	for in, want := range tests {
+		assert.Equal(t, want, queryRules{tracking: patterns}.clean(in), in)
+	}
+
+	assert.Equal(t, "https://example.com/a?utm_source=x", queryRules{}.clean("https://example.com/a?utm_source=x"))
+}
+
+func TestTrackingParamsStripped(t *testing.T) {
+	var hits int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<html><head><title>Tracked</title></head></html>`))
+	}))
+	defer srv.Close()
+
+	p := NewParser().WithTrackingParamsStripped("share").WithResultCache(time.Minute, 10)
+
+	res, err := p.Parse(srv.URL + "/story?id=1&utm_source=newsletter&share=abc")
+	assert.Nil(t, err)
+	assert.Equal(t, srv.URL+"/story?id=1", res.URL)
+
+	res, err = p.Parse(srv.URL + "/story?fbclid=xyz&id=1")
+	assert.Nil(t, err)
+	assert.Equal(t, srv.URL+"/story?id=1", res.URL)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&hits))
+
+	res, err = Parse(srv.URL + "/story?id=1&utm_source=newsletter")
+	assert.Nil(t, err)
+	assert.Equal(t, srv.URL+"/story?id=1&utm_source=newsletter", res.URL)
+}
+
+func TestQueryPolicy(t *testing.T) {
+	p := NewParser().
+		WithTrackingParamsStripped().
+		WithQueryPolicy("YouTube.com", QueryPolicy{Allow: []string{"v", "t"}}).
+		WithQueryPolicy("news.example", QueryPolicy{Deny: []string{"*"}}).
+		WithQueryPolicy("live.news.example", QueryPolicy{Deny: []string{"session"}}).
+		WithQueryPolicy("bücher.example", QueryPolicy{Deny: []string{"ref"}})
+
+	tests := map[string]string{
+		"https://www.youtube.com/watch?v=abc&feature=share&t=10&utm_source=x": "https://www.youtube.com/watch?v=abc&t=10",
+		"https://news.example/story?id=1&page=2":                              "https://news.example/story",
+		"https://www.news.example/story?id=1":                                 "https://www.news.example/story",
+		"https://live.news.example/feed?id=1&session=abc":                     "https://live.news.example/feed?id=1",
+		"https://xn--bcher-kva.example/a?ref=x&id=1":                          "https://xn--bcher-kva.example/a?id=1",
+		"https://other.example/a?id=1&gclid=x":                                "https://other.example/a?id=1",
+	}
+
+	for in, want := range tests {
+		assert.Equal(t, want, p.cleanURL(in), in)
+	}
+}
+
+func TestHostToASCII(t *testing.T) {
+	tests := []struct {
+		unicode string
+		ascii   string
+	}{
+		{"bücher.example", "xn--bcher-kva.example"},
+		{"bücher.example:8080", "xn--bcher-kva.example:8080"},
+		{"日本語。jp", "xn--wgv71a119e.jp"},
+		{"BÜCHER.example", "xn--bcher-kva.example"},
+		{"ｅｘａｍｐｌｅ．ｃｏｍ", "example.com"},
+		{"example.com", "example.com"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.ascii, hostToASCII(test.unicode), test.unicode)
+	}
+
+	assert.Equal(t, "bücher.example:8080", hostToUnicode("XN--BCHER-KVA.example:8080"))
+	assert.Equal(t, "xn--!!.example", hostToUnicode("xn--!!.example"))
+}
+
+func TestIDNResult(t *testing.T) {
+	srv := newTestServer("text/html", `<html><head>
+		<meta property="og:url" content="https://Bücher.example/straße?q=ü">
+	</head></html>`)
+	defer srv.Close()
+
+	res, err := Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "https://xn--bcher-kva.example/stra%C3%9Fe?q=%C3%BC", res.URL)
+	assert.Equal(t, "xn--bcher-kva.example", res.Host)
+	assert.Equal(t, "bücher.example", res.HostUnicode)
+
+	srv2 := newTestServer("text/html", `<html><head><title>No og:url</title></head></html>`)
+	defer srv2.Close()
+
+	res, err = Parse(srv2.URL + "/straße?q=ü")
+	assert.Nil(t, err)
+	assert.Equal(t, srv2.URL+"/stra%C3%9Fe?q=%C3%BC", res.URL)
+	assert.Equal(t, "", res.HostUnicode)
+}
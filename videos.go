@@ -0,0 +1,184 @@
+package recon
+
+import (
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Video is a video found on a page, either declared via og:video or embedded from a known video host.
+type Video struct {
+	// URL is the video's canonical watch URL if it's from a known host, or else its og:video URL.
+	URL string `json:"url"`
+
+	// EmbedURL is the URL of the video's player, as embedded in the page.
+	EmbedURL string `json:"embed_url,omitempty"`
+
+	// Provider and ID identify the video on a known host (VideoProviderYouTube, etc.).
+	Provider string `json:"provider,omitempty"`
+	ID       string `json:"id,omitempty"`
+
+	// Type is the video's media type, as defined via og:video:type.
+	Type string `json:"type,omitempty"`
+
+	Width  int `json:"width,omitempty"`
+	Height int `json:"height,omitempty"`
+}
+
+// Video hosts recognized in embeds.
+const (
+	VideoProviderYouTube     = "youtube"
+	VideoProviderVimeo       = "vimeo"
+	VideoProviderWistia      = "wistia"
+	VideoProviderDailymotion = "dailymotion"
+	VideoProviderLoom        = "loom"
+)
+
+type videoHost struct {
+	provider string
+
+	// player matches the host and path of an embedded player, capturing the video's ID.
+	player *regexp.Regexp
+
+	// watch is the canonical watch URL, with %s in place of the ID.
+	watch string
+}
+
+var videoHosts = []videoHost{
+	{
+		provider: VideoProviderYouTube,
+		player:   regexp.MustCompile(`^(?:www\.)?youtube(?:-nocookie)?\.com/(?:embed|v)/([\w-]+)`),
+		watch:    "https://www.youtube.com/watch?v=%s",
+	},
+	{
+		provider: VideoProviderVimeo,
+		player:   regexp.MustCompile(`^player\.vimeo\.com/video/(\d+)`),
+		watch:    "https://vimeo.com/%s",
+	},
+	{
+		provider: VideoProviderWistia,
+		player:   regexp.MustCompile(`^fast\.wistia\.(?:net|com)/embed/(?:iframe|medias)/(\w+)`),
+		watch:    "https://fast.wistia.net/embed/iframe/%s",
+	},
+	{
+		provider: VideoProviderDailymotion,
+		player:   regexp.MustCompile(`^(?:www\.)?dailymotion\.com/embed/video/(\w+)`),
+		watch:    "https://www.dailymotion.com/video/%s",
+	},
+	{
+		provider: VideoProviderLoom,
+		player:   regexp.MustCompile(`^(?:www\.)?loom\.com/embed/(\w+)`),
+		watch:    "https://www.loom.com/share/%s",
+	},
+}
+
+// identifyVideo fills in the video's provider, ID and canonical URL if its EmbedURL is a known host's player.
+func identifyVideo(v *Video) {
+	u, err := url.Parse(v.EmbedURL)
+	if err != nil {
+		return
+	}
+
+	hostPath := strings.ToLower(u.Host) + u.Path
+	for _, host := range videoHosts {
+		if m := host.player.FindStringSubmatch(hostPath); m != nil {
+			v.Provider = host.provider
+			v.ID = m[1]
+			v.URL = strings.Replace(host.watch, "%s", m[1], 1)
+			return
+		}
+	}
+}
+
+// iframe is an <iframe> found on a page.
+type iframe struct {
+	src    string
+	title  string
+	width  int
+	height int
+}
+
+// videoExtractor collects the page's og:video videos and its embedded players from known video hosts into
+// Result.Videos.
+type videoExtractor struct{}
+
+func (videoExtractor) Extract(doc *Document, res *Result) error {
+	var videos []Video
+	seen := map[string]bool{}
+
+	add := func(v Video) {
+		if v.EmbedURL == "" {
+			return
+		}
+
+		v.EmbedURL = doc.resolve(v.EmbedURL)
+		if v.URL == "" {
+			v.URL = v.EmbedURL
+		}
+		identifyVideo(&v)
+
+		if seen[v.URL] {
+			return
+		}
+		seen[v.URL] = true
+		videos = append(videos, v)
+	}
+
+	// og:video:url is an alias of og:video, so it only starts a new video if the current one already has one
+	var current *Video
+	hasURL := false
+	for _, m := range doc.Meta {
+		switch m.Name {
+		case "og:video":
+			if current != nil {
+				add(*current)
+			}
+			current = &Video{EmbedURL: m.Content}
+			hasURL = false
+
+		case "og:video:url":
+			if current == nil || hasURL {
+				if current != nil {
+					add(*current)
+				}
+				current = &Video{EmbedURL: m.Content}
+			}
+			hasURL = true
+
+		case "og:video:secure_url":
+			if current != nil {
+				current.EmbedURL = m.Content
+			}
+
+		case "og:video:type":
+			if current != nil {
+				current.Type = m.Content
+			}
+
+		case "og:video:width":
+			if current != nil {
+				current.Width, _ = strconv.Atoi(m.Content)
+			}
+
+		case "og:video:height":
+			if current != nil {
+				current.Height, _ = strconv.Atoi(m.Content)
+			}
+		}
+	}
+	if current != nil {
+		add(*current)
+	}
+
+	for _, frame := range doc.iframes {
+		v := Video{EmbedURL: frame.src, Width: frame.width, Height: frame.height}
+		if identifyVideo(&v); v.Provider != "" {
+			add(v)
+		}
+	}
+
+	res.Videos = videos
+
+	return nil
+}
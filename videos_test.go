@@ -0,0 +1,83 @@
+package recon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVideos(t *testing.T) {
+	srv := newTestServer("text/html", `<html><head>
+		<meta property="og:video" content="https://cdn.example.com/video.mp4">
+		<meta property="og:video:type" content="video/mp4">
+		<meta property="og:video:width" content="1280">
+		<meta property="og:video:height" content="720">
+	</head><body>
+		<iframe width="560" height="315" src="https://www.youtube-nocookie.com/embed/dQw4w9WgXcQ?rel=0"></iframe>
+		<iframe src="about:blank" data-src="//player.vimeo.com/video/76979871"></iframe>
+		<iframe src="https://fast.wistia.net/embed/iframe/abc123xyz"></iframe>
+		<iframe src="https://www.youtube.com/embed/dQw4w9WgXcQ"></iframe>
+		<iframe src="https://example.com/widget"></iframe>
+	</body></html>`)
+	defer srv.Close()
+
+	res, err := Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, []Video{
+		{
+			URL:      "https://cdn.example.com/video.mp4",
+			EmbedURL: "https://cdn.example.com/video.mp4",
+			Type:     "video/mp4",
+			Width:    1280,
+			Height:   720,
+		},
+		{
+			URL:      "https://www.youtube.com/watch?v=dQw4w9WgXcQ",
+			EmbedURL: "https://www.youtube-nocookie.com/embed/dQw4w9WgXcQ?rel=0",
+			Provider: VideoProviderYouTube,
+			ID:       "dQw4w9WgXcQ",
+			Width:    560,
+			Height:   315,
+		},
+		{
+			URL:      "https://vimeo.com/76979871",
+			EmbedURL: "http://player.vimeo.com/video/76979871",
+			Provider: VideoProviderVimeo,
+			ID:       "76979871",
+		},
+		{
+			URL:      "https://fast.wistia.net/embed/iframe/abc123xyz",
+			EmbedURL: "https://fast.wistia.net/embed/iframe/abc123xyz",
+			Provider: VideoProviderWistia,
+			ID:       "abc123xyz",
+		},
+	}, res.Videos)
+}
+
+func TestVideoURLAlias(t *testing.T) {
+	srv := newTestServer("text/html", `<html><head>
+		<meta property="og:video" content="https://cdn.example.com/one.mp4">
+		<meta property="og:video:url" content="https://cdn.example.com/one.mp4">
+		<meta property="og:video:type" content="video/mp4">
+		<meta property="og:video:width" content="1280">
+		<meta property="og:video:url" content="https://cdn.example.com/two.webm">
+		<meta property="og:video:type" content="video/webm">
+	</head></html>`)
+	defer srv.Close()
+
+	res, err := Parse(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, []Video{
+		{
+			URL:      "https://cdn.example.com/one.mp4",
+			EmbedURL: "https://cdn.example.com/one.mp4",
+			Type:     "video/mp4",
+			Width:    1280,
+		},
+		{
+			URL:      "https://cdn.example.com/two.webm",
+			EmbedURL: "https://cdn.example.com/two.webm",
+			Type:     "video/webm",
+		},
+	}, res.Videos)
+}
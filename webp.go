@@ -0,0 +1,52 @@
+package recon
+
+import (
+	"encoding/binary"
+	"image"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// webpConfig reads a WebP image's dimensions, and whether it has an alpha channel, from its RIFF header. The
+// standard library has no WebP decoder, but the header is all recon needs.
+func webpConfig(r io.Reader) (image.Config, bool, error) {
+	var b [30]byte
+	if _, err := io.ReadFull(r, b[:]); err != nil {
+		return image.Config{}, false, errors.Wrap(err, "read webp header")
+	}
+
+	if string(b[0:4]) != "RIFF" || string(b[8:12]) != "WEBP" {
+		return image.Config{}, false, errors.New("not a webp image")
+	}
+
+	le24 := func(b []byte) int {
+		return int(b[0]) | int(b[1])<<8 | int(b[2])<<16
+	}
+
+	switch chunk, data := string(b[12:16]), b[20:]; chunk {
+	case "VP8 ":
+		// lossy: a 3-byte frame tag and 3-byte start code, then 14-bit dimensions
+		return image.Config{
+			Width:  int(binary.LittleEndian.Uint16(data[6:8]) & 0x3fff),
+			Height: int(binary.LittleEndian.Uint16(data[8:10]) & 0x3fff),
+		}, false, nil
+
+	case "VP8L":
+		// lossless: a signature byte, then 14-bit dimensions less one and the alpha flag, packed
+		bits := binary.LittleEndian.Uint32(data[1:5])
+		return image.Config{
+			Width:  int(bits&0x3fff) + 1,
+			Height: int(bits>>14&0x3fff) + 1,
+		}, bits>>28&1 == 1, nil
+
+	case "VP8X":
+		// extended: a flags byte, 3 reserved bytes, then 24-bit canvas dimensions less one
+		return image.Config{
+			Width:  le24(data[4:7]) + 1,
+			Height: le24(data[7:10]) + 1,
+		}, data[0]&0x10 != 0, nil
+	}
+
+	return image.Config{}, false, errors.Errorf("unknown webp chunk %q", b[12:16])
+}